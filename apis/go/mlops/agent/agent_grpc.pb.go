@@ -17,6 +17,7 @@ package agent
 
 import (
 	context "context"
+	scheduler "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -32,6 +33,7 @@ const (
 	AgentService_Subscribe_FullMethodName           = "/seldon.mlops.agent.AgentService/Subscribe"
 	AgentService_ModelScalingTrigger_FullMethodName = "/seldon.mlops.agent.AgentService/ModelScalingTrigger"
 	AgentService_AgentDrain_FullMethodName          = "/seldon.mlops.agent.AgentService/AgentDrain"
+	AgentService_ExperimentRequests_FullMethodName  = "/seldon.mlops.agent.AgentService/ExperimentRequests"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -42,6 +44,7 @@ type AgentServiceClient interface {
 	Subscribe(ctx context.Context, in *AgentSubscribeRequest, opts ...grpc.CallOption) (AgentService_SubscribeClient, error)
 	ModelScalingTrigger(ctx context.Context, opts ...grpc.CallOption) (AgentService_ModelScalingTriggerClient, error)
 	AgentDrain(ctx context.Context, in *AgentDrainRequest, opts ...grpc.CallOption) (*AgentDrainResponse, error)
+	ExperimentRequests(ctx context.Context, in *scheduler.ExperimentRequestsRequest, opts ...grpc.CallOption) (*scheduler.ExperimentRequestsResponse, error)
 }
 
 type agentServiceClient struct {
@@ -140,6 +143,16 @@ func (c *agentServiceClient) AgentDrain(ctx context.Context, in *AgentDrainReque
 	return out, nil
 }
 
+func (c *agentServiceClient) ExperimentRequests(ctx context.Context, in *scheduler.ExperimentRequestsRequest, opts ...grpc.CallOption) (*scheduler.ExperimentRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(scheduler.ExperimentRequestsResponse)
+	err := c.cc.Invoke(ctx, AgentService_ExperimentRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServiceServer is the server API for AgentService service.
 // All implementations must embed UnimplementedAgentServiceServer
 // for forward compatibility
@@ -148,6 +161,7 @@ type AgentServiceServer interface {
 	Subscribe(*AgentSubscribeRequest, AgentService_SubscribeServer) error
 	ModelScalingTrigger(AgentService_ModelScalingTriggerServer) error
 	AgentDrain(context.Context, *AgentDrainRequest) (*AgentDrainResponse, error)
+	ExperimentRequests(context.Context, *scheduler.ExperimentRequestsRequest) (*scheduler.ExperimentRequestsResponse, error)
 	mustEmbedUnimplementedAgentServiceServer()
 }
 
@@ -167,6 +181,9 @@ func (UnimplementedAgentServiceServer) ModelScalingTrigger(AgentService_ModelSca
 func (UnimplementedAgentServiceServer) AgentDrain(context.Context, *AgentDrainRequest) (*AgentDrainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AgentDrain not implemented")
 }
+func (UnimplementedAgentServiceServer) ExperimentRequests(context.Context, *scheduler.ExperimentRequestsRequest) (*scheduler.ExperimentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExperimentRequests not implemented")
+}
 func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
 
 // UnsafeAgentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -263,6 +280,24 @@ func _AgentService_AgentDrain_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_ExperimentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(scheduler.ExperimentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ExperimentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ExperimentRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ExperimentRequests(ctx, req.(*scheduler.ExperimentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -278,6 +313,10 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AgentDrain",
 			Handler:    _AgentService_AgentDrain_Handler,
 		},
+		{
+			MethodName: "ExperimentRequests",
+			Handler:    _AgentService_ExperimentRequests_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
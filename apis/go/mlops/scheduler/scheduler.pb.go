@@ -77,6 +77,205 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{0}
 }
 
+type DataflowSpec_Mode int32
+
+const (
+	DataflowSpec_KAFKA       DataflowSpec_Mode = 0
+	DataflowSpec_SYNCHRONOUS DataflowSpec_Mode = 1
+)
+
+// Enum value maps for DataflowSpec_Mode.
+var (
+	DataflowSpec_Mode_name = map[int32]string{
+		0: "KAFKA",
+		1: "SYNCHRONOUS",
+	}
+	DataflowSpec_Mode_value = map[string]int32{
+		"KAFKA":       0,
+		"SYNCHRONOUS": 1,
+	}
+)
+
+func (x DataflowSpec_Mode) Enum() *DataflowSpec_Mode {
+	p := new(DataflowSpec_Mode)
+	*p = x
+	return p
+}
+
+func (x DataflowSpec_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataflowSpec_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[1].Descriptor()
+}
+
+func (DataflowSpec_Mode) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[1]
+}
+
+func (x DataflowSpec_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataflowSpec_Mode.Descriptor instead.
+func (DataflowSpec_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type EnsembleSpec_Aggregation int32
+
+const (
+	EnsembleSpec_MEAN             EnsembleSpec_Aggregation = 0
+	EnsembleSpec_VOTE             EnsembleSpec_Aggregation = 1
+	EnsembleSpec_CONCAT           EnsembleSpec_Aggregation = 2
+	EnsembleSpec_FIRST_SUCCESSFUL EnsembleSpec_Aggregation = 3
+)
+
+// Enum value maps for EnsembleSpec_Aggregation.
+var (
+	EnsembleSpec_Aggregation_name = map[int32]string{
+		0: "MEAN",
+		1: "VOTE",
+		2: "CONCAT",
+		3: "FIRST_SUCCESSFUL",
+	}
+	EnsembleSpec_Aggregation_value = map[string]int32{
+		"MEAN":             0,
+		"VOTE":             1,
+		"CONCAT":           2,
+		"FIRST_SUCCESSFUL": 3,
+	}
+)
+
+func (x EnsembleSpec_Aggregation) Enum() *EnsembleSpec_Aggregation {
+	p := new(EnsembleSpec_Aggregation)
+	*p = x
+	return p
+}
+
+func (x EnsembleSpec_Aggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnsembleSpec_Aggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[2].Descriptor()
+}
+
+func (EnsembleSpec_Aggregation) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[2]
+}
+
+func (x EnsembleSpec_Aggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnsembleSpec_Aggregation.Descriptor instead.
+func (EnsembleSpec_Aggregation) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type RateLimitSpec_Unit int32
+
+const (
+	RateLimitSpec_SECOND RateLimitSpec_Unit = 0
+	RateLimitSpec_MINUTE RateLimitSpec_Unit = 1
+	RateLimitSpec_HOUR   RateLimitSpec_Unit = 2
+)
+
+// Enum value maps for RateLimitSpec_Unit.
+var (
+	RateLimitSpec_Unit_name = map[int32]string{
+		0: "SECOND",
+		1: "MINUTE",
+		2: "HOUR",
+	}
+	RateLimitSpec_Unit_value = map[string]int32{
+		"SECOND": 0,
+		"MINUTE": 1,
+		"HOUR":   2,
+	}
+)
+
+func (x RateLimitSpec_Unit) Enum() *RateLimitSpec_Unit {
+	p := new(RateLimitSpec_Unit)
+	*p = x
+	return p
+}
+
+func (x RateLimitSpec_Unit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitSpec_Unit) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[3].Descriptor()
+}
+
+func (RateLimitSpec_Unit) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[3]
+}
+
+func (x RateLimitSpec_Unit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitSpec_Unit.Descriptor instead.
+func (RateLimitSpec_Unit) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type RoutingSpec_LoadBalancer int32
+
+const (
+	RoutingSpec_LEAST_REQUEST RoutingSpec_LoadBalancer = 0
+	RoutingSpec_ROUND_ROBIN   RoutingSpec_LoadBalancer = 1
+	RoutingSpec_RING_HASH     RoutingSpec_LoadBalancer = 2
+	RoutingSpec_RANDOM        RoutingSpec_LoadBalancer = 3
+)
+
+// Enum value maps for RoutingSpec_LoadBalancer.
+var (
+	RoutingSpec_LoadBalancer_name = map[int32]string{
+		0: "LEAST_REQUEST",
+		1: "ROUND_ROBIN",
+		2: "RING_HASH",
+		3: "RANDOM",
+	}
+	RoutingSpec_LoadBalancer_value = map[string]int32{
+		"LEAST_REQUEST": 0,
+		"ROUND_ROBIN":   1,
+		"RING_HASH":     2,
+		"RANDOM":        3,
+	}
+)
+
+func (x RoutingSpec_LoadBalancer) Enum() *RoutingSpec_LoadBalancer {
+	p := new(RoutingSpec_LoadBalancer)
+	*p = x
+	return p
+}
+
+func (x RoutingSpec_LoadBalancer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoutingSpec_LoadBalancer) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[4].Descriptor()
+}
+
+func (RoutingSpec_LoadBalancer) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[4]
+}
+
+func (x RoutingSpec_LoadBalancer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoutingSpec_LoadBalancer.Descriptor instead.
+func (RoutingSpec_LoadBalancer) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{9, 0}
+}
+
 type ModelStatusResponse_ModelOperation int32
 
 const (
@@ -110,11 +309,11 @@ func (x ModelStatusResponse_ModelOperation) String() string {
 }
 
 func (ModelStatusResponse_ModelOperation) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[1].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[5].Descriptor()
 }
 
 func (ModelStatusResponse_ModelOperation) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[1]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[5]
 }
 
 func (x ModelStatusResponse_ModelOperation) Number() protoreflect.EnumNumber {
@@ -123,7 +322,7 @@ func (x ModelStatusResponse_ModelOperation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelStatusResponse_ModelOperation.Descriptor instead.
 func (ModelStatusResponse_ModelOperation) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{20, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type ModelStatus_ModelState int32
@@ -183,11 +382,11 @@ func (x ModelStatus_ModelState) String() string {
 }
 
 func (ModelStatus_ModelState) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[2].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[6].Descriptor()
 }
 
 func (ModelStatus_ModelState) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[2]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[6]
 }
 
 func (x ModelStatus_ModelState) Number() protoreflect.EnumNumber {
@@ -196,7 +395,59 @@ func (x ModelStatus_ModelState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelStatus_ModelState.Descriptor instead.
 func (ModelStatus_ModelState) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{22, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{34, 0}
+}
+
+type ModelRolloutStatus_RolloutState int32
+
+const (
+	ModelRolloutStatus_RolloutStateUnknown ModelRolloutStatus_RolloutState = 0
+	ModelRolloutStatus_RolloutProgressing  ModelRolloutStatus_RolloutState = 1
+	ModelRolloutStatus_RolloutSucceeded    ModelRolloutStatus_RolloutState = 2
+	ModelRolloutStatus_RolloutRolledBack   ModelRolloutStatus_RolloutState = 3
+)
+
+// Enum value maps for ModelRolloutStatus_RolloutState.
+var (
+	ModelRolloutStatus_RolloutState_name = map[int32]string{
+		0: "RolloutStateUnknown",
+		1: "RolloutProgressing",
+		2: "RolloutSucceeded",
+		3: "RolloutRolledBack",
+	}
+	ModelRolloutStatus_RolloutState_value = map[string]int32{
+		"RolloutStateUnknown": 0,
+		"RolloutProgressing":  1,
+		"RolloutSucceeded":    2,
+		"RolloutRolledBack":   3,
+	}
+)
+
+func (x ModelRolloutStatus_RolloutState) Enum() *ModelRolloutStatus_RolloutState {
+	p := new(ModelRolloutStatus_RolloutState)
+	*p = x
+	return p
+}
+
+func (x ModelRolloutStatus_RolloutState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModelRolloutStatus_RolloutState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[7].Descriptor()
+}
+
+func (ModelRolloutStatus_RolloutState) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[7]
+}
+
+func (x ModelRolloutStatus_RolloutState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModelRolloutStatus_RolloutState.Descriptor instead.
+func (ModelRolloutStatus_RolloutState) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{35, 0}
 }
 
 type ModelReplicaStatus_ModelReplicaState int32
@@ -262,11 +513,11 @@ func (x ModelReplicaStatus_ModelReplicaState) String() string {
 }
 
 func (ModelReplicaStatus_ModelReplicaState) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[3].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[8].Descriptor()
 }
 
 func (ModelReplicaStatus_ModelReplicaState) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[3]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[8]
 }
 
 func (x ModelReplicaStatus_ModelReplicaState) Number() protoreflect.EnumNumber {
@@ -275,7 +526,7 @@ func (x ModelReplicaStatus_ModelReplicaState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelReplicaStatus_ModelReplicaState.Descriptor instead.
 func (ModelReplicaStatus_ModelReplicaState) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{23, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{36, 0}
 }
 
 // Type of SterverStatus update. At the moment the scheduler doesn't combine multiple types of
@@ -318,11 +569,11 @@ func (x ServerStatusResponse_Type) String() string {
 }
 
 func (ServerStatusResponse_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[4].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[9].Descriptor()
 }
 
 func (ServerStatusResponse_Type) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[4]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[9]
 }
 
 func (x ServerStatusResponse_Type) Number() protoreflect.EnumNumber {
@@ -331,7 +582,53 @@ func (x ServerStatusResponse_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServerStatusResponse_Type.Descriptor instead.
 func (ServerStatusResponse_Type) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{25, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type BanditConfig_BanditStrategy int32
+
+const (
+	BanditConfig_EPSILON_GREEDY    BanditConfig_BanditStrategy = 0
+	BanditConfig_THOMPSON_SAMPLING BanditConfig_BanditStrategy = 1
+)
+
+// Enum value maps for BanditConfig_BanditStrategy.
+var (
+	BanditConfig_BanditStrategy_name = map[int32]string{
+		0: "EPSILON_GREEDY",
+		1: "THOMPSON_SAMPLING",
+	}
+	BanditConfig_BanditStrategy_value = map[string]int32{
+		"EPSILON_GREEDY":    0,
+		"THOMPSON_SAMPLING": 1,
+	}
+)
+
+func (x BanditConfig_BanditStrategy) Enum() *BanditConfig_BanditStrategy {
+	p := new(BanditConfig_BanditStrategy)
+	*p = x
+	return p
+}
+
+func (x BanditConfig_BanditStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BanditConfig_BanditStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_mlops_scheduler_scheduler_proto_enumTypes[10].Descriptor()
+}
+
+func (BanditConfig_BanditStrategy) Type() protoreflect.EnumType {
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[10]
+}
+
+func (x BanditConfig_BanditStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BanditConfig_BanditStrategy.Descriptor instead.
+func (BanditConfig_BanditStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type PipelineStep_JoinOp int32
@@ -367,11 +664,11 @@ func (x PipelineStep_JoinOp) String() string {
 }
 
 func (PipelineStep_JoinOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[5].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[11].Descriptor()
 }
 
 func (PipelineStep_JoinOp) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[5]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[11]
 }
 
 func (x PipelineStep_JoinOp) Number() protoreflect.EnumNumber {
@@ -380,7 +677,7 @@ func (x PipelineStep_JoinOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineStep_JoinOp.Descriptor instead.
 func (PipelineStep_JoinOp) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{46, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{66, 0}
 }
 
 type PipelineInput_JoinOp int32
@@ -416,11 +713,11 @@ func (x PipelineInput_JoinOp) String() string {
 }
 
 func (PipelineInput_JoinOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[6].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[12].Descriptor()
 }
 
 func (PipelineInput_JoinOp) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[6]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[12]
 }
 
 func (x PipelineInput_JoinOp) Number() protoreflect.EnumNumber {
@@ -429,7 +726,7 @@ func (x PipelineInput_JoinOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineInput_JoinOp.Descriptor instead.
 func (PipelineInput_JoinOp) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{48, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{68, 0}
 }
 
 type PipelineOutput_JoinOp int32
@@ -465,11 +762,11 @@ func (x PipelineOutput_JoinOp) String() string {
 }
 
 func (PipelineOutput_JoinOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[7].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[13].Descriptor()
 }
 
 func (PipelineOutput_JoinOp) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[7]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[13]
 }
 
 func (x PipelineOutput_JoinOp) Number() protoreflect.EnumNumber {
@@ -478,7 +775,7 @@ func (x PipelineOutput_JoinOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineOutput_JoinOp.Descriptor instead.
 func (PipelineOutput_JoinOp) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{49, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{69, 0}
 }
 
 type PipelineStatusResponse_PipelineOperation int32
@@ -514,11 +811,11 @@ func (x PipelineStatusResponse_PipelineOperation) String() string {
 }
 
 func (PipelineStatusResponse_PipelineOperation) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[8].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[14].Descriptor()
 }
 
 func (PipelineStatusResponse_PipelineOperation) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[8]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[14]
 }
 
 func (x PipelineStatusResponse_PipelineOperation) Number() protoreflect.EnumNumber {
@@ -527,7 +824,7 @@ func (x PipelineStatusResponse_PipelineOperation) Number() protoreflect.EnumNumb
 
 // Deprecated: Use PipelineStatusResponse_PipelineOperation.Descriptor instead.
 func (PipelineStatusResponse_PipelineOperation) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{55, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{75, 0}
 }
 
 type PipelineVersionState_PipelineStatus int32
@@ -584,11 +881,11 @@ func (x PipelineVersionState_PipelineStatus) String() string {
 }
 
 func (PipelineVersionState_PipelineStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[9].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[15].Descriptor()
 }
 
 func (PipelineVersionState_PipelineStatus) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[9]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[15]
 }
 
 func (x PipelineVersionState_PipelineStatus) Number() protoreflect.EnumNumber {
@@ -597,7 +894,7 @@ func (x PipelineVersionState_PipelineStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PipelineVersionState_PipelineStatus.Descriptor instead.
 func (PipelineVersionState_PipelineStatus) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{57, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{77, 0}
 }
 
 type ControlPlaneResponse_Event int32
@@ -639,11 +936,11 @@ func (x ControlPlaneResponse_Event) String() string {
 }
 
 func (ControlPlaneResponse_Event) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[10].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[16].Descriptor()
 }
 
 func (ControlPlaneResponse_Event) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[10]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[16]
 }
 
 func (x ControlPlaneResponse_Event) Number() protoreflect.EnumNumber {
@@ -652,7 +949,7 @@ func (x ControlPlaneResponse_Event) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ControlPlaneResponse_Event.Descriptor instead.
 func (ControlPlaneResponse_Event) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{61, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{86, 0}
 }
 
 type ModelUpdateMessage_ModelOperation int32
@@ -688,11 +985,11 @@ func (x ModelUpdateMessage_ModelOperation) String() string {
 }
 
 func (ModelUpdateMessage_ModelOperation) Descriptor() protoreflect.EnumDescriptor {
-	return file_mlops_scheduler_scheduler_proto_enumTypes[11].Descriptor()
+	return file_mlops_scheduler_scheduler_proto_enumTypes[17].Descriptor()
 }
 
 func (ModelUpdateMessage_ModelOperation) Type() protoreflect.EnumType {
-	return &file_mlops_scheduler_scheduler_proto_enumTypes[11]
+	return &file_mlops_scheduler_scheduler_proto_enumTypes[17]
 }
 
 func (x ModelUpdateMessage_ModelOperation) Number() protoreflect.EnumNumber {
@@ -701,7 +998,7 @@ func (x ModelUpdateMessage_ModelOperation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelUpdateMessage_ModelOperation.Descriptor instead.
 func (ModelUpdateMessage_ModelOperation) EnumDescriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{62, 0}
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{87, 0}
 }
 
 type LoadModelRequest struct {
@@ -907,6 +1204,8 @@ type DataflowSpec struct {
 	unknownFields protoimpl.UnknownFields
 
 	CleanTopicsOnDelete bool `protobuf:"varint,1,opt,name=cleanTopicsOnDelete,proto3" json:"cleanTopicsOnDelete,omitempty"` // clean up the kafka topic on model delete
+	// how the steps of a pipeline are run, SYNCHRONOUS pipelines are run by the pipeline gateway without kafka
+	Mode DataflowSpec_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=seldon.mlops.scheduler.DataflowSpec_Mode" json:"mode,omitempty"`
 }
 
 func (x *DataflowSpec) Reset() {
@@ -948,19 +1247,26 @@ func (x *DataflowSpec) GetCleanTopicsOnDelete() bool {
 	return false
 }
 
-type DeploymentSpec struct {
+func (x *DataflowSpec) GetMode() DataflowSpec_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return DataflowSpec_KAFKA
+}
+
+type EnsembleSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Replicas    uint32 `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	MinReplicas uint32 `protobuf:"varint,2,opt,name=minReplicas,proto3" json:"minReplicas,omitempty"`
-	MaxReplicas uint32 `protobuf:"varint,3,opt,name=maxReplicas,proto3" json:"maxReplicas,omitempty"`
-	LogPayloads bool   `protobuf:"varint,4,opt,name=logPayloads,proto3" json:"logPayloads,omitempty"`
+	// how the responses of the steps are combined into the response of the ensemble
+	Aggregation EnsembleSpec_Aggregation `protobuf:"varint,1,opt,name=aggregation,proto3,enum=seldon.mlops.scheduler.EnsembleSpec_Aggregation" json:"aggregation,omitempty"`
+	// timeout of the requests to the steps, no timeout when zero
+	TimeoutMs uint32 `protobuf:"varint,2,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
 }
 
-func (x *DeploymentSpec) Reset() {
-	*x = DeploymentSpec{}
+func (x *EnsembleSpec) Reset() {
+	*x = EnsembleSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,13 +1274,13 @@ func (x *DeploymentSpec) Reset() {
 	}
 }
 
-func (x *DeploymentSpec) String() string {
+func (x *EnsembleSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploymentSpec) ProtoMessage() {}
+func (*EnsembleSpec) ProtoMessage() {}
 
-func (x *DeploymentSpec) ProtoReflect() protoreflect.Message {
+func (x *EnsembleSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -986,64 +1292,50 @@ func (x *DeploymentSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploymentSpec.ProtoReflect.Descriptor instead.
-func (*DeploymentSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnsembleSpec.ProtoReflect.Descriptor instead.
+func (*EnsembleSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeploymentSpec) GetReplicas() uint32 {
-	if x != nil {
-		return x.Replicas
-	}
-	return 0
-}
-
-func (x *DeploymentSpec) GetMinReplicas() uint32 {
+func (x *EnsembleSpec) GetAggregation() EnsembleSpec_Aggregation {
 	if x != nil {
-		return x.MinReplicas
+		return x.Aggregation
 	}
-	return 0
+	return EnsembleSpec_MEAN
 }
 
-func (x *DeploymentSpec) GetMaxReplicas() uint32 {
+func (x *EnsembleSpec) GetTimeoutMs() uint32 {
 	if x != nil {
-		return x.MaxReplicas
+		return x.TimeoutMs
 	}
 	return 0
 }
 
-func (x *DeploymentSpec) GetLogPayloads() bool {
-	if x != nil {
-		return x.LogPayloads
-	}
-	return false
-}
-
-// ModelDetails
-type ModelSpec struct {
+type DeploymentSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri              string            `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                 // storage uri from where to download the artifacts
-	ArtifactVersion  *uint32           `protobuf:"varint,2,opt,name=artifactVersion,proto3,oneof" json:"artifactVersion,omitempty"`  // Optional v2 version folder to select
-	StorageConfig    *StorageConfig    `protobuf:"bytes,3,opt,name=storageConfig,proto3,oneof" json:"storageConfig,omitempty"`       // Storage auth configuration
-	Requirements     []string          `protobuf:"bytes,4,rep,name=requirements,proto3" json:"requirements,omitempty"`               // list of capabilities the server must satisfy to run this model
-	MemoryBytes      *uint64           `protobuf:"varint,5,opt,name=memoryBytes,proto3,oneof" json:"memoryBytes,omitempty"`          // Requested memory
-	Server           *string           `protobuf:"bytes,6,opt,name=server,proto3,oneof" json:"server,omitempty"`                     // the particular model server to load the model. If unspecified will be chosen.
-	Parameters       []*ParameterSpec  `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`                   // parameters to load with model
-	ModelRuntimeInfo *ModelRuntimeInfo `protobuf:"bytes,9,opt,name=modelRuntimeInfo,proto3,oneof" json:"modelRuntimeInfo,omitempty"` // model specific settings that are sent by the agent
-	// ensure only one of explainer or llm is specified at a time
-	//
-	// Types that are assignable to ModelSpec:
-	//
-	//	*ModelSpec_Explainer
-	//	*ModelSpec_Llm
-	ModelSpec isModelSpec_ModelSpec `protobuf_oneof:"model_spec"`
+	Replicas    uint32 `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	MinReplicas uint32 `protobuf:"varint,2,opt,name=minReplicas,proto3" json:"minReplicas,omitempty"`
+	MaxReplicas uint32 `protobuf:"varint,3,opt,name=maxReplicas,proto3" json:"maxReplicas,omitempty"`
+	LogPayloads bool   `protobuf:"varint,4,opt,name=logPayloads,proto3" json:"logPayloads,omitempty"`
+	// models with a higher priority can preempt lower priority models when there is not enough memory
+	Priority int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// progressively shift traffic to new versions of the model, rolling back if they are unhealthy
+	Canary *CanarySpec `protobuf:"bytes,6,opt,name=canary,proto3,oneof" json:"canary,omitempty"`
+	// log the request and response payloads to kafka from the agent
+	PayloadLogging *PayloadLoggingSpec `protobuf:"bytes,7,opt,name=payloadLogging,proto3,oneof" json:"payloadLogging,omitempty"`
+	// callers allowed to infer with the model, checked against their JWT by envoy
+	Auth *AuthSpec `protobuf:"bytes,8,opt,name=auth,proto3,oneof" json:"auth,omitempty"`
+	// rate of inference requests allowed by envoy
+	RateLimit *RateLimitSpec `protobuf:"bytes,9,opt,name=rateLimit,proto3,oneof" json:"rateLimit,omitempty"`
+	// timeouts, retries, outlier detection and load balancing of the inference requests routed by envoy
+	Routing *RoutingSpec `protobuf:"bytes,10,opt,name=routing,proto3,oneof" json:"routing,omitempty"`
 }
 
-func (x *ModelSpec) Reset() {
-	*x = ModelSpec{}
+func (x *DeploymentSpec) Reset() {
+	*x = DeploymentSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,13 +1343,13 @@ func (x *ModelSpec) Reset() {
 	}
 }
 
-func (x *ModelSpec) String() string {
+func (x *DeploymentSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelSpec) ProtoMessage() {}
+func (*DeploymentSpec) ProtoMessage() {}
 
-func (x *ModelSpec) ProtoReflect() protoreflect.Message {
+func (x *DeploymentSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,115 +1361,94 @@ func (x *ModelSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelSpec.ProtoReflect.Descriptor instead.
-func (*ModelSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeploymentSpec.ProtoReflect.Descriptor instead.
+func (*DeploymentSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ModelSpec) GetUri() string {
+func (x *DeploymentSpec) GetReplicas() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.Replicas
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelSpec) GetArtifactVersion() uint32 {
-	if x != nil && x.ArtifactVersion != nil {
-		return *x.ArtifactVersion
+func (x *DeploymentSpec) GetMinReplicas() uint32 {
+	if x != nil {
+		return x.MinReplicas
 	}
 	return 0
 }
 
-func (x *ModelSpec) GetStorageConfig() *StorageConfig {
+func (x *DeploymentSpec) GetMaxReplicas() uint32 {
 	if x != nil {
-		return x.StorageConfig
+		return x.MaxReplicas
 	}
-	return nil
+	return 0
 }
 
-func (x *ModelSpec) GetRequirements() []string {
+func (x *DeploymentSpec) GetLogPayloads() bool {
 	if x != nil {
-		return x.Requirements
+		return x.LogPayloads
 	}
-	return nil
+	return false
 }
 
-func (x *ModelSpec) GetMemoryBytes() uint64 {
-	if x != nil && x.MemoryBytes != nil {
-		return *x.MemoryBytes
+func (x *DeploymentSpec) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
 	}
 	return 0
 }
 
-func (x *ModelSpec) GetServer() string {
-	if x != nil && x.Server != nil {
-		return *x.Server
-	}
-	return ""
-}
-
-func (x *ModelSpec) GetParameters() []*ParameterSpec {
+func (x *DeploymentSpec) GetCanary() *CanarySpec {
 	if x != nil {
-		return x.Parameters
+		return x.Canary
 	}
 	return nil
 }
 
-func (x *ModelSpec) GetModelRuntimeInfo() *ModelRuntimeInfo {
+func (x *DeploymentSpec) GetPayloadLogging() *PayloadLoggingSpec {
 	if x != nil {
-		return x.ModelRuntimeInfo
+		return x.PayloadLogging
 	}
 	return nil
 }
 
-func (m *ModelSpec) GetModelSpec() isModelSpec_ModelSpec {
-	if m != nil {
-		return m.ModelSpec
+func (x *DeploymentSpec) GetAuth() *AuthSpec {
+	if x != nil {
+		return x.Auth
 	}
 	return nil
 }
 
-func (x *ModelSpec) GetExplainer() *ExplainerSpec {
-	if x, ok := x.GetModelSpec().(*ModelSpec_Explainer); ok {
-		return x.Explainer
+func (x *DeploymentSpec) GetRateLimit() *RateLimitSpec {
+	if x != nil {
+		return x.RateLimit
 	}
 	return nil
 }
 
-func (x *ModelSpec) GetLlm() *LlmSpec {
-	if x, ok := x.GetModelSpec().(*ModelSpec_Llm); ok {
-		return x.Llm
+func (x *DeploymentSpec) GetRouting() *RoutingSpec {
+	if x != nil {
+		return x.Routing
 	}
 	return nil
 }
 
-type isModelSpec_ModelSpec interface {
-	isModelSpec_ModelSpec()
-}
-
-type ModelSpec_Explainer struct {
-	Explainer *ExplainerSpec `protobuf:"bytes,7,opt,name=explainer,proto3,oneof"` // optional black box explainer details
-}
-
-type ModelSpec_Llm struct {
-	Llm *LlmSpec `protobuf:"bytes,10,opt,name=llm,proto3,oneof"` // optional LLM specific settings
-}
-
-func (*ModelSpec_Explainer) isModelSpec_ModelSpec() {}
-
-func (*ModelSpec_Llm) isModelSpec_ModelSpec() {}
-
-type ParameterSpec struct {
+type AuthSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// audiences one of which must be in the aud claim of the JWT
+	Audiences []string `protobuf:"bytes,1,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// groups one of which must be in the groups claim of the JWT
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *ParameterSpec) Reset() {
-	*x = ParameterSpec{}
+func (x *AuthSpec) Reset() {
+	*x = AuthSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,13 +1456,13 @@ func (x *ParameterSpec) Reset() {
 	}
 }
 
-func (x *ParameterSpec) String() string {
+func (x *AuthSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParameterSpec) ProtoMessage() {}
+func (*AuthSpec) ProtoMessage() {}
 
-func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
+func (x *AuthSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1203,38 +1474,43 @@ func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParameterSpec.ProtoReflect.Descriptor instead.
-func (*ParameterSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthSpec.ProtoReflect.Descriptor instead.
+func (*AuthSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ParameterSpec) GetName() string {
+func (x *AuthSpec) GetAudiences() []string {
 	if x != nil {
-		return x.Name
+		return x.Audiences
 	}
-	return ""
+	return nil
 }
 
-func (x *ParameterSpec) GetValue() string {
+func (x *AuthSpec) GetGroups() []string {
 	if x != nil {
-		return x.Value
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type ExplainerSpec struct {
+type RateLimitSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// 1 of semantic either model or pipeline reference
-	ModelRef    *string `protobuf:"bytes,2,opt,name=modelRef,proto3,oneof" json:"modelRef,omitempty"`
-	PipelineRef *string `protobuf:"bytes,3,opt,name=pipelineRef,proto3,oneof" json:"pipelineRef,omitempty"`
+	// requests allowed per unit of time
+	RequestsPerUnit uint32             `protobuf:"varint,1,opt,name=requestsPerUnit,proto3" json:"requestsPerUnit,omitempty"`
+	Unit            RateLimitSpec_Unit `protobuf:"varint,2,opt,name=unit,proto3,enum=seldon.mlops.scheduler.RateLimitSpec_Unit" json:"unit,omitempty"`
+	// requests allowed in a burst, the requests per unit when zero
+	Burst uint32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	// deprecated and ignored, tenants are identified by the x-seldon-tenant header set by envoy
+	Header string `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	// limits of the tenants with their own token bucket, other requests share the default one
+	Tenants []*TenantRateLimit `protobuf:"bytes,5,rep,name=tenants,proto3" json:"tenants,omitempty"`
 }
 
-func (x *ExplainerSpec) Reset() {
-	*x = ExplainerSpec{}
+func (x *RateLimitSpec) Reset() {
+	*x = RateLimitSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1242,13 +1518,13 @@ func (x *ExplainerSpec) Reset() {
 	}
 }
 
-func (x *ExplainerSpec) String() string {
+func (x *RateLimitSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExplainerSpec) ProtoMessage() {}
+func (*RateLimitSpec) ProtoMessage() {}
 
-func (x *ExplainerSpec) ProtoReflect() protoreflect.Message {
+func (x *RateLimitSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1260,43 +1536,59 @@ func (x *ExplainerSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExplainerSpec.ProtoReflect.Descriptor instead.
-func (*ExplainerSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimitSpec.ProtoReflect.Descriptor instead.
+func (*RateLimitSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ExplainerSpec) GetType() string {
+func (x *RateLimitSpec) GetRequestsPerUnit() uint32 {
 	if x != nil {
-		return x.Type
+		return x.RequestsPerUnit
 	}
-	return ""
+	return 0
 }
 
-func (x *ExplainerSpec) GetModelRef() string {
-	if x != nil && x.ModelRef != nil {
-		return *x.ModelRef
+func (x *RateLimitSpec) GetUnit() RateLimitSpec_Unit {
+	if x != nil {
+		return x.Unit
 	}
-	return ""
+	return RateLimitSpec_SECOND
 }
 
-func (x *ExplainerSpec) GetPipelineRef() string {
-	if x != nil && x.PipelineRef != nil {
-		return *x.PipelineRef
+func (x *RateLimitSpec) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimitSpec) GetHeader() string {
+	if x != nil {
+		return x.Header
 	}
 	return ""
 }
 
-type LlmSpec struct {
+func (x *RateLimitSpec) GetTenants() []*TenantRateLimit {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelRef    *string `protobuf:"bytes,1,opt,name=modelRef,proto3,oneof" json:"modelRef,omitempty"`
-	PipelineRef *string `protobuf:"bytes,2,opt,name=pipelineRef,proto3,oneof" json:"pipelineRef,omitempty"`
+	// tenant in the x-seldon-tenant header
+	Value           string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	RequestsPerUnit uint32 `protobuf:"varint,2,opt,name=requestsPerUnit,proto3" json:"requestsPerUnit,omitempty"`
+	Burst           uint32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
 }
 
-func (x *LlmSpec) Reset() {
-	*x = LlmSpec{}
+func (x *TenantRateLimit) Reset() {
+	*x = TenantRateLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1304,13 +1596,13 @@ func (x *LlmSpec) Reset() {
 	}
 }
 
-func (x *LlmSpec) String() string {
+func (x *TenantRateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LlmSpec) ProtoMessage() {}
+func (*TenantRateLimit) ProtoMessage() {}
 
-func (x *LlmSpec) ProtoReflect() protoreflect.Message {
+func (x *TenantRateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1322,39 +1614,50 @@ func (x *LlmSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LlmSpec.ProtoReflect.Descriptor instead.
-func (*LlmSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use TenantRateLimit.ProtoReflect.Descriptor instead.
+func (*TenantRateLimit) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LlmSpec) GetModelRef() string {
-	if x != nil && x.ModelRef != nil {
-		return *x.ModelRef
+func (x *TenantRateLimit) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-func (x *LlmSpec) GetPipelineRef() string {
-	if x != nil && x.PipelineRef != nil {
-		return *x.PipelineRef
+func (x *TenantRateLimit) GetRequestsPerUnit() uint32 {
+	if x != nil {
+		return x.RequestsPerUnit
 	}
-	return ""
+	return 0
 }
 
-type ModelRuntimeInfo struct {
+func (x *TenantRateLimit) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type RoutingSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to ModelRuntimeInfo:
-	//
-	//	*ModelRuntimeInfo_Mlserver
-	//	*ModelRuntimeInfo_Triton
-	ModelRuntimeInfo isModelRuntimeInfo_ModelRuntimeInfo `protobuf_oneof:"modelRuntimeInfo"`
+	// timeout of a request including its retries, no timeout when zero
+	TimeoutMs uint32 `protobuf:"varint,1,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+	// timeout of the connections to the endpoints, 5 seconds when zero
+	ConnectTimeoutMs uint32                   `protobuf:"varint,2,opt,name=connectTimeoutMs,proto3" json:"connectTimeoutMs,omitempty"`
+	Retry            *RetrySpec               `protobuf:"bytes,3,opt,name=retry,proto3,oneof" json:"retry,omitempty"`
+	OutlierDetection *OutlierDetectionSpec    `protobuf:"bytes,4,opt,name=outlierDetection,proto3,oneof" json:"outlierDetection,omitempty"`
+	LoadBalancer     RoutingSpec_LoadBalancer `protobuf:"varint,5,opt,name=loadBalancer,proto3,enum=seldon.mlops.scheduler.RoutingSpec_LoadBalancer" json:"loadBalancer,omitempty"`
+	// header hashed to pick the endpoint of a request with RING_HASH
+	HashHeader string `protobuf:"bytes,6,opt,name=hashHeader,proto3" json:"hashHeader,omitempty"`
 }
 
-func (x *ModelRuntimeInfo) Reset() {
-	*x = ModelRuntimeInfo{}
+func (x *RoutingSpec) Reset() {
+	*x = RoutingSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1362,13 +1665,13 @@ func (x *ModelRuntimeInfo) Reset() {
 	}
 }
 
-func (x *ModelRuntimeInfo) String() string {
+func (x *RoutingSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelRuntimeInfo) ProtoMessage() {}
+func (*RoutingSpec) ProtoMessage() {}
 
-func (x *ModelRuntimeInfo) ProtoReflect() protoreflect.Message {
+func (x *RoutingSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1380,58 +1683,71 @@ func (x *ModelRuntimeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelRuntimeInfo.ProtoReflect.Descriptor instead.
-func (*ModelRuntimeInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoutingSpec.ProtoReflect.Descriptor instead.
+func (*RoutingSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *ModelRuntimeInfo) GetModelRuntimeInfo() isModelRuntimeInfo_ModelRuntimeInfo {
-	if m != nil {
-		return m.ModelRuntimeInfo
+func (x *RoutingSpec) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (x *ModelRuntimeInfo) GetMlserver() *MLServerModelSettings {
-	if x, ok := x.GetModelRuntimeInfo().(*ModelRuntimeInfo_Mlserver); ok {
-		return x.Mlserver
+func (x *RoutingSpec) GetConnectTimeoutMs() uint32 {
+	if x != nil {
+		return x.ConnectTimeoutMs
 	}
-	return nil
+	return 0
 }
 
-func (x *ModelRuntimeInfo) GetTriton() *TritonModelConfig {
-	if x, ok := x.GetModelRuntimeInfo().(*ModelRuntimeInfo_Triton); ok {
-		return x.Triton
+func (x *RoutingSpec) GetRetry() *RetrySpec {
+	if x != nil {
+		return x.Retry
 	}
 	return nil
 }
 
-type isModelRuntimeInfo_ModelRuntimeInfo interface {
-	isModelRuntimeInfo_ModelRuntimeInfo()
+func (x *RoutingSpec) GetOutlierDetection() *OutlierDetectionSpec {
+	if x != nil {
+		return x.OutlierDetection
+	}
+	return nil
 }
 
-type ModelRuntimeInfo_Mlserver struct {
-	Mlserver *MLServerModelSettings `protobuf:"bytes,1,opt,name=mlserver,proto3,oneof"`
+func (x *RoutingSpec) GetLoadBalancer() RoutingSpec_LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return RoutingSpec_LEAST_REQUEST
 }
 
-type ModelRuntimeInfo_Triton struct {
-	Triton *TritonModelConfig `protobuf:"bytes,2,opt,name=triton,proto3,oneof"`
+func (x *RoutingSpec) GetHashHeader() string {
+	if x != nil {
+		return x.HashHeader
+	}
+	return ""
 }
 
-func (*ModelRuntimeInfo_Mlserver) isModelRuntimeInfo_ModelRuntimeInfo() {}
-
-func (*ModelRuntimeInfo_Triton) isModelRuntimeInfo_ModelRuntimeInfo() {}
-
-type MLServerModelSettings struct {
+type RetrySpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParallelWorkers uint32 `protobuf:"varint,1,opt,name=parallelWorkers,proto3" json:"parallelWorkers,omitempty"`
+	// envoy retry-on conditions, 5xx and connect-failure when empty
+	RetryOn    []string `protobuf:"bytes,1,rep,name=retryOn,proto3" json:"retryOn,omitempty"`
+	MaxRetries *uint32  `protobuf:"varint,2,opt,name=maxRetries,proto3,oneof" json:"maxRetries,omitempty"`
+	// timeout of each try, the timeout of the request when zero
+	PerTryTimeoutMs uint32 `protobuf:"varint,3,opt,name=perTryTimeoutMs,proto3" json:"perTryTimeoutMs,omitempty"`
+	// base interval of the exponential backoff between retries, 500ms when zero
+	BackoffBaseIntervalMs uint32 `protobuf:"varint,4,opt,name=backoffBaseIntervalMs,proto3" json:"backoffBaseIntervalMs,omitempty"`
+	// percentage of the active requests to an endpoint that can be retries
+	BudgetPercent *uint32 `protobuf:"varint,5,opt,name=budgetPercent,proto3,oneof" json:"budgetPercent,omitempty"`
 }
 
-func (x *MLServerModelSettings) Reset() {
-	*x = MLServerModelSettings{}
+func (x *RetrySpec) Reset() {
+	*x = RetrySpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1439,13 +1755,13 @@ func (x *MLServerModelSettings) Reset() {
 	}
 }
 
-func (x *MLServerModelSettings) String() string {
+func (x *RetrySpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MLServerModelSettings) ProtoMessage() {}
+func (*RetrySpec) ProtoMessage() {}
 
-func (x *MLServerModelSettings) ProtoReflect() protoreflect.Message {
+func (x *RetrySpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1457,28 +1773,63 @@ func (x *MLServerModelSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MLServerModelSettings.ProtoReflect.Descriptor instead.
-func (*MLServerModelSettings) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetrySpec.ProtoReflect.Descriptor instead.
+func (*RetrySpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MLServerModelSettings) GetParallelWorkers() uint32 {
+func (x *RetrySpec) GetRetryOn() []string {
 	if x != nil {
-		return x.ParallelWorkers
+		return x.RetryOn
+	}
+	return nil
+}
+
+func (x *RetrySpec) GetMaxRetries() uint32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
 	}
 	return 0
 }
 
-type TritonModelConfig struct {
+func (x *RetrySpec) GetPerTryTimeoutMs() uint32 {
+	if x != nil {
+		return x.PerTryTimeoutMs
+	}
+	return 0
+}
+
+func (x *RetrySpec) GetBackoffBaseIntervalMs() uint32 {
+	if x != nil {
+		return x.BackoffBaseIntervalMs
+	}
+	return 0
+}
+
+func (x *RetrySpec) GetBudgetPercent() uint32 {
+	if x != nil && x.BudgetPercent != nil {
+		return *x.BudgetPercent
+	}
+	return 0
+}
+
+type OutlierDetectionSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cpu []*TritonCPU `protobuf:"bytes,1,rep,name=cpu,proto3" json:"cpu,omitempty"`
+	// consecutive 5xx responses ejecting an endpoint, 5 when zero
+	Consecutive5Xx uint32 `protobuf:"varint,1,opt,name=consecutive5xx,proto3" json:"consecutive5xx,omitempty"`
+	// interval between the ejection sweeps, 10 seconds when zero
+	IntervalSeconds uint32 `protobuf:"varint,2,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"`
+	// time an endpoint is ejected for, multiplied by the times it has been ejected, 30 seconds when zero
+	BaseEjectionTimeSeconds uint32 `protobuf:"varint,3,opt,name=baseEjectionTimeSeconds,proto3" json:"baseEjectionTimeSeconds,omitempty"`
+	// maximum percentage of the endpoints that can be ejected, 10 when zero
+	MaxEjectionPercent uint32 `protobuf:"varint,4,opt,name=maxEjectionPercent,proto3" json:"maxEjectionPercent,omitempty"`
 }
 
-func (x *TritonModelConfig) Reset() {
-	*x = TritonModelConfig{}
+func (x *OutlierDetectionSpec) Reset() {
+	*x = OutlierDetectionSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1486,13 +1837,13 @@ func (x *TritonModelConfig) Reset() {
 	}
 }
 
-func (x *TritonModelConfig) String() string {
+func (x *OutlierDetectionSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TritonModelConfig) ProtoMessage() {}
+func (*OutlierDetectionSpec) ProtoMessage() {}
 
-func (x *TritonModelConfig) ProtoReflect() protoreflect.Message {
+func (x *OutlierDetectionSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1504,28 +1855,52 @@ func (x *TritonModelConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TritonModelConfig.ProtoReflect.Descriptor instead.
-func (*TritonModelConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutlierDetectionSpec.ProtoReflect.Descriptor instead.
+func (*OutlierDetectionSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TritonModelConfig) GetCpu() []*TritonCPU {
+func (x *OutlierDetectionSpec) GetConsecutive5Xx() uint32 {
 	if x != nil {
-		return x.Cpu
+		return x.Consecutive5Xx
 	}
-	return nil
+	return 0
 }
 
-type TritonCPU struct {
+func (x *OutlierDetectionSpec) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *OutlierDetectionSpec) GetBaseEjectionTimeSeconds() uint32 {
+	if x != nil {
+		return x.BaseEjectionTimeSeconds
+	}
+	return 0
+}
+
+func (x *OutlierDetectionSpec) GetMaxEjectionPercent() uint32 {
+	if x != nil {
+		return x.MaxEjectionPercent
+	}
+	return 0
+}
+
+type PayloadLoggingSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceCount uint32 `protobuf:"varint,1,opt,name=instanceCount,proto3" json:"instanceCount,omitempty"`
+	// percentage of requests whose payloads are logged
+	SamplePercent uint32 `protobuf:"varint,1,opt,name=samplePercent,proto3" json:"samplePercent,omitempty"`
+	// names of the tensors whose data is removed from the logged payloads
+	RedactTensors []string `protobuf:"bytes,2,rep,name=redactTensors,proto3" json:"redactTensors,omitempty"`
 }
 
-func (x *TritonCPU) Reset() {
-	*x = TritonCPU{}
+func (x *PayloadLoggingSpec) Reset() {
+	*x = PayloadLoggingSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,13 +1908,13 @@ func (x *TritonCPU) Reset() {
 	}
 }
 
-func (x *TritonCPU) String() string {
+func (x *PayloadLoggingSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TritonCPU) ProtoMessage() {}
+func (*PayloadLoggingSpec) ProtoMessage() {}
 
-func (x *TritonCPU) ProtoReflect() protoreflect.Message {
+func (x *PayloadLoggingSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1551,29 +1926,44 @@ func (x *TritonCPU) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TritonCPU.ProtoReflect.Descriptor instead.
-func (*TritonCPU) Descriptor() ([]byte, []int) {
+// Deprecated: Use PayloadLoggingSpec.ProtoReflect.Descriptor instead.
+func (*PayloadLoggingSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TritonCPU) GetInstanceCount() uint32 {
+func (x *PayloadLoggingSpec) GetSamplePercent() uint32 {
 	if x != nil {
-		return x.InstanceCount
+		return x.SamplePercent
 	}
 	return 0
 }
 
-type KubernetesMeta struct {
+func (x *PayloadLoggingSpec) GetRedactTensors() []string {
+	if x != nil {
+		return x.RedactTensors
+	}
+	return nil
+}
+
+type CanarySpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Generation int64  `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	// percentage of traffic sent to a new version once it is available
+	InitialTrafficPercent uint32 `protobuf:"varint,1,opt,name=initialTrafficPercent,proto3" json:"initialTrafficPercent,omitempty"`
+	// percentage of traffic added to the new version at each step
+	StepTrafficPercent uint32 `protobuf:"varint,2,opt,name=stepTrafficPercent,proto3" json:"stepTrafficPercent,omitempty"`
+	// seconds between steps, the new version must stay healthy over each interval to progress
+	StepIntervalSeconds uint32 `protobuf:"varint,3,opt,name=stepIntervalSeconds,proto3" json:"stepIntervalSeconds,omitempty"`
+	// roll back if the percentage of failed requests to the new version exceeds this, 0 disables the check
+	MaxErrorPercent uint32 `protobuf:"varint,4,opt,name=maxErrorPercent,proto3" json:"maxErrorPercent,omitempty"`
+	// roll back if the mean latency of the new version exceeds this, 0 disables the check
+	MaxLatencyMs uint32 `protobuf:"varint,5,opt,name=maxLatencyMs,proto3" json:"maxLatencyMs,omitempty"`
 }
 
-func (x *KubernetesMeta) Reset() {
-	*x = KubernetesMeta{}
+func (x *CanarySpec) Reset() {
+	*x = CanarySpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,13 +1971,13 @@ func (x *KubernetesMeta) Reset() {
 	}
 }
 
-func (x *KubernetesMeta) String() string {
+func (x *CanarySpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesMeta) ProtoMessage() {}
+func (*CanarySpec) ProtoMessage() {}
 
-func (x *KubernetesMeta) ProtoReflect() protoreflect.Message {
+func (x *CanarySpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1599,36 +1989,75 @@ func (x *KubernetesMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesMeta.ProtoReflect.Descriptor instead.
-func (*KubernetesMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanarySpec.ProtoReflect.Descriptor instead.
+func (*CanarySpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *KubernetesMeta) GetNamespace() string {
+func (x *CanarySpec) GetInitialTrafficPercent() uint32 {
 	if x != nil {
-		return x.Namespace
+		return x.InitialTrafficPercent
 	}
-	return ""
+	return 0
 }
 
-func (x *KubernetesMeta) GetGeneration() int64 {
+func (x *CanarySpec) GetStepTrafficPercent() uint32 {
 	if x != nil {
-		return x.Generation
+		return x.StepTrafficPercent
 	}
 	return 0
 }
 
-type StreamSpec struct {
+func (x *CanarySpec) GetStepIntervalSeconds() uint32 {
+	if x != nil {
+		return x.StepIntervalSeconds
+	}
+	return 0
+}
+
+func (x *CanarySpec) GetMaxErrorPercent() uint32 {
+	if x != nil {
+		return x.MaxErrorPercent
+	}
+	return 0
+}
+
+func (x *CanarySpec) GetMaxLatencyMs() uint32 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+// ModelDetails
+type ModelSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InputTopic  string `protobuf:"bytes,2,opt,name=inputTopic,proto3" json:"inputTopic,omitempty"`
-	OutputTopic string `protobuf:"bytes,3,opt,name=outputTopic,proto3" json:"outputTopic,omitempty"`
+	Uri              string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`                                 // storage uri from where to download the artifacts
+	ArtifactVersion  *uint32            `protobuf:"varint,2,opt,name=artifactVersion,proto3,oneof" json:"artifactVersion,omitempty"`  // Optional v2 version folder to select
+	StorageConfig    *StorageConfig     `protobuf:"bytes,3,opt,name=storageConfig,proto3,oneof" json:"storageConfig,omitempty"`       // Storage auth configuration
+	Requirements     []string           `protobuf:"bytes,4,rep,name=requirements,proto3" json:"requirements,omitempty"`               // list of capabilities the server must satisfy to run this model
+	MemoryBytes      *uint64            `protobuf:"varint,5,opt,name=memoryBytes,proto3,oneof" json:"memoryBytes,omitempty"`          // Requested memory
+	Server           *string            `protobuf:"bytes,6,opt,name=server,proto3,oneof" json:"server,omitempty"`                     // the particular model server to load the model. If unspecified will be chosen.
+	Parameters       []*ParameterSpec   `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`                   // parameters to load with model
+	ModelRuntimeInfo *ModelRuntimeInfo  `protobuf:"bytes,9,opt,name=modelRuntimeInfo,proto3,oneof" json:"modelRuntimeInfo,omitempty"` // model specific settings that are sent by the agent
+	Batching         *BatchingSpec      `protobuf:"bytes,11,opt,name=batching,proto3,oneof" json:"batching,omitempty"`                // batch concurrent inference requests in the agent before sending them to the model
+	ResponseCache    *ResponseCacheSpec `protobuf:"bytes,12,opt,name=responseCache,proto3,oneof" json:"responseCache,omitempty"`      // cache responses of identical inference requests in the agent
+	Concurrency      *ConcurrencySpec   `protobuf:"bytes,13,opt,name=concurrency,proto3,oneof" json:"concurrency,omitempty"`          // limit concurrent inference requests sent by the agent to the model
+	Pinned           bool               `protobuf:"varint,14,opt,name=pinned,proto3" json:"pinned,omitempty"`                         // keep the model in memory on the agents, it is never evicted when the server is over committed
+	// ensure only one of explainer or llm is specified at a time
+	//
+	// Types that are assignable to ModelSpec:
+	//
+	//	*ModelSpec_Explainer
+	//	*ModelSpec_Llm
+	ModelSpec isModelSpec_ModelSpec `protobuf_oneof:"model_spec"`
 }
 
-func (x *StreamSpec) Reset() {
-	*x = StreamSpec{}
+func (x *ModelSpec) Reset() {
+	*x = ModelSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1636,13 +2065,13 @@ func (x *StreamSpec) Reset() {
 	}
 }
 
-func (x *StreamSpec) String() string {
+func (x *ModelSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamSpec) ProtoMessage() {}
+func (*ModelSpec) ProtoMessage() {}
 
-func (x *StreamSpec) ProtoReflect() protoreflect.Message {
+func (x *ModelSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1654,129 +2083,160 @@ func (x *StreamSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamSpec.ProtoReflect.Descriptor instead.
-func (*StreamSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelSpec.ProtoReflect.Descriptor instead.
+func (*ModelSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *StreamSpec) GetInputTopic() string {
+func (x *ModelSpec) GetUri() string {
 	if x != nil {
-		return x.InputTopic
+		return x.Uri
 	}
 	return ""
 }
 
-func (x *StreamSpec) GetOutputTopic() string {
+func (x *ModelSpec) GetArtifactVersion() uint32 {
+	if x != nil && x.ArtifactVersion != nil {
+		return *x.ArtifactVersion
+	}
+	return 0
+}
+
+func (x *ModelSpec) GetStorageConfig() *StorageConfig {
 	if x != nil {
-		return x.OutputTopic
+		return x.StorageConfig
 	}
-	return ""
+	return nil
 }
 
-type StorageConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ModelSpec) GetRequirements() []string {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
 
-	// Types that are assignable to Config:
-	//
-	//	*StorageConfig_StorageSecretName
-	//	*StorageConfig_StorageRcloneConfig
-	Config isStorageConfig_Config `protobuf_oneof:"config"`
+func (x *ModelSpec) GetMemoryBytes() uint64 {
+	if x != nil && x.MemoryBytes != nil {
+		return *x.MemoryBytes
+	}
+	return 0
 }
 
-func (x *StorageConfig) Reset() {
-	*x = StorageConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ModelSpec) GetServer() string {
+	if x != nil && x.Server != nil {
+		return *x.Server
 	}
+	return ""
 }
 
-func (x *StorageConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ModelSpec) GetParameters() []*ParameterSpec {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
 }
 
-func (*StorageConfig) ProtoMessage() {}
+func (x *ModelSpec) GetModelRuntimeInfo() *ModelRuntimeInfo {
+	if x != nil {
+		return x.ModelRuntimeInfo
+	}
+	return nil
+}
 
-func (x *StorageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ModelSpec) GetBatching() *BatchingSpec {
+	if x != nil {
+		return x.Batching
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
-func (*StorageConfig) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{15}
+func (x *ModelSpec) GetResponseCache() *ResponseCacheSpec {
+	if x != nil {
+		return x.ResponseCache
+	}
+	return nil
 }
 
-func (m *StorageConfig) GetConfig() isStorageConfig_Config {
+func (x *ModelSpec) GetConcurrency() *ConcurrencySpec {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *ModelSpec) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (m *ModelSpec) GetModelSpec() isModelSpec_ModelSpec {
 	if m != nil {
-		return m.Config
+		return m.ModelSpec
 	}
 	return nil
 }
 
-func (x *StorageConfig) GetStorageSecretName() string {
-	if x, ok := x.GetConfig().(*StorageConfig_StorageSecretName); ok {
-		return x.StorageSecretName
+func (x *ModelSpec) GetExplainer() *ExplainerSpec {
+	if x, ok := x.GetModelSpec().(*ModelSpec_Explainer); ok {
+		return x.Explainer
 	}
-	return ""
+	return nil
 }
 
-func (x *StorageConfig) GetStorageRcloneConfig() string {
-	if x, ok := x.GetConfig().(*StorageConfig_StorageRcloneConfig); ok {
-		return x.StorageRcloneConfig
+func (x *ModelSpec) GetLlm() *LlmSpec {
+	if x, ok := x.GetModelSpec().(*ModelSpec_Llm); ok {
+		return x.Llm
 	}
-	return ""
+	return nil
 }
 
-type isStorageConfig_Config interface {
-	isStorageConfig_Config()
+type isModelSpec_ModelSpec interface {
+	isModelSpec_ModelSpec()
 }
 
-type StorageConfig_StorageSecretName struct {
-	StorageSecretName string `protobuf:"bytes,1,opt,name=storageSecretName,proto3,oneof"`
+type ModelSpec_Explainer struct {
+	Explainer *ExplainerSpec `protobuf:"bytes,7,opt,name=explainer,proto3,oneof"` // optional black box explainer details
 }
 
-type StorageConfig_StorageRcloneConfig struct {
-	StorageRcloneConfig string `protobuf:"bytes,2,opt,name=storageRcloneConfig,proto3,oneof"`
+type ModelSpec_Llm struct {
+	Llm *LlmSpec `protobuf:"bytes,10,opt,name=llm,proto3,oneof"` // optional LLM specific settings
 }
 
-func (*StorageConfig_StorageSecretName) isStorageConfig_Config() {}
+func (*ModelSpec_Explainer) isModelSpec_ModelSpec() {}
 
-func (*StorageConfig_StorageRcloneConfig) isStorageConfig_Config() {}
+func (*ModelSpec_Llm) isModelSpec_ModelSpec() {}
 
-type LoadModelResponse struct {
+type BatchingSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// maximum number of rows (along the first dimension of the inputs) sent to the model in one request
+	MaxBatchSize uint32 `protobuf:"varint,1,opt,name=maxBatchSize,proto3" json:"maxBatchSize,omitempty"`
+	// maximum time a request waits for others to be batched with
+	MaxWaitMs uint32 `protobuf:"varint,2,opt,name=maxWaitMs,proto3" json:"maxWaitMs,omitempty"`
 }
 
-func (x *LoadModelResponse) Reset() {
-	*x = LoadModelResponse{}
+func (x *BatchingSpec) Reset() {
+	*x = BatchingSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[16]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadModelResponse) String() string {
+func (x *BatchingSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadModelResponse) ProtoMessage() {}
+func (*BatchingSpec) ProtoMessage() {}
 
-func (x *LoadModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[16]
+func (x *BatchingSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,38 +2247,53 @@ func (x *LoadModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadModelResponse.ProtoReflect.Descriptor instead.
-func (*LoadModelResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BatchingSpec.ProtoReflect.Descriptor instead.
+func (*BatchingSpec) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{15}
 }
 
-// ModelReference represents a unique model
-type ModelReference struct {
+func (x *BatchingSpec) GetMaxBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *BatchingSpec) GetMaxWaitMs() uint32 {
+	if x != nil {
+		return x.MaxWaitMs
+	}
+	return 0
+}
+
+type ResponseCacheSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version *uint32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// maximum number of responses cached per model replica, least recently used are evicted first
+	MaxEntries uint32 `protobuf:"varint,1,opt,name=maxEntries,proto3" json:"maxEntries,omitempty"`
+	// seconds a response stays cached, 0 keeps it until evicted or the model version is replaced
+	TtlSeconds uint32 `protobuf:"varint,2,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"`
 }
 
-func (x *ModelReference) Reset() {
-	*x = ModelReference{}
+func (x *ResponseCacheSpec) Reset() {
+	*x = ResponseCacheSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[17]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModelReference) String() string {
+func (x *ResponseCacheSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelReference) ProtoMessage() {}
+func (*ResponseCacheSpec) ProtoMessage() {}
 
-func (x *ModelReference) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[17]
+func (x *ResponseCacheSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,51 +2304,53 @@ func (x *ModelReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelReference.ProtoReflect.Descriptor instead.
-func (*ModelReference) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ResponseCacheSpec.ProtoReflect.Descriptor instead.
+func (*ResponseCacheSpec) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ModelReference) GetName() string {
+func (x *ResponseCacheSpec) GetMaxEntries() uint32 {
 	if x != nil {
-		return x.Name
+		return x.MaxEntries
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelReference) GetVersion() uint32 {
-	if x != nil && x.Version != nil {
-		return *x.Version
+func (x *ResponseCacheSpec) GetTtlSeconds() uint32 {
+	if x != nil {
+		return x.TtlSeconds
 	}
 	return 0
 }
 
-type UnloadModelRequest struct {
+type ConcurrencySpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Model          *ModelReference `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
-	KubernetesMeta *KubernetesMeta `protobuf:"bytes,2,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	// maximum number of inference requests sent to the model at the same time by each agent
+	MaxInflight uint32 `protobuf:"varint,1,opt,name=maxInflight,proto3" json:"maxInflight,omitempty"`
+	// maximum number of requests waiting for one in flight to complete, others are rejected
+	MaxQueueSize uint32 `protobuf:"varint,2,opt,name=maxQueueSize,proto3" json:"maxQueueSize,omitempty"`
 }
 
-func (x *UnloadModelRequest) Reset() {
-	*x = UnloadModelRequest{}
+func (x *ConcurrencySpec) Reset() {
+	*x = ConcurrencySpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[18]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnloadModelRequest) String() string {
+func (x *ConcurrencySpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnloadModelRequest) ProtoMessage() {}
+func (*ConcurrencySpec) ProtoMessage() {}
 
-func (x *UnloadModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[18]
+func (x *ConcurrencySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,48 +2361,51 @@ func (x *UnloadModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnloadModelRequest.ProtoReflect.Descriptor instead.
-func (*UnloadModelRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ConcurrencySpec.ProtoReflect.Descriptor instead.
+func (*ConcurrencySpec) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UnloadModelRequest) GetModel() *ModelReference {
+func (x *ConcurrencySpec) GetMaxInflight() uint32 {
 	if x != nil {
-		return x.Model
+		return x.MaxInflight
 	}
-	return nil
+	return 0
 }
 
-func (x *UnloadModelRequest) GetKubernetesMeta() *KubernetesMeta {
+func (x *ConcurrencySpec) GetMaxQueueSize() uint32 {
 	if x != nil {
-		return x.KubernetesMeta
+		return x.MaxQueueSize
 	}
-	return nil
+	return 0
 }
 
-type UnloadModelResponse struct {
+type ParameterSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *UnloadModelResponse) Reset() {
-	*x = UnloadModelResponse{}
+func (x *ParameterSpec) Reset() {
+	*x = ParameterSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[19]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnloadModelResponse) String() string {
+func (x *ParameterSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnloadModelResponse) ProtoMessage() {}
+func (*ParameterSpec) ProtoMessage() {}
 
-func (x *UnloadModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[19]
+func (x *ParameterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,42 +2416,53 @@ func (x *UnloadModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnloadModelResponse.ProtoReflect.Descriptor instead.
-func (*UnloadModelResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ParameterSpec.ProtoReflect.Descriptor instead.
+func (*ParameterSpec) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{18}
 }
 
-// ModelStatusResponse provides the current assignment of the model onto a server
-type ModelStatusResponse struct {
+func (x *ParameterSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParameterSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ExplainerSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelName  string                             `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
-	Versions   []*ModelVersionStatus              `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
-	Deleted    bool                               `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	KeepTopics bool                               `protobuf:"varint,4,opt,name=keepTopics,proto3" json:"keepTopics,omitempty"`
-	Timestamp  uint64                             `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Operation  ModelStatusResponse_ModelOperation `protobuf:"varint,6,opt,name=operation,proto3,enum=seldon.mlops.scheduler.ModelStatusResponse_ModelOperation" json:"operation,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 1 of semantic either model or pipeline reference
+	ModelRef    *string `protobuf:"bytes,2,opt,name=modelRef,proto3,oneof" json:"modelRef,omitempty"`
+	PipelineRef *string `protobuf:"bytes,3,opt,name=pipelineRef,proto3,oneof" json:"pipelineRef,omitempty"`
 }
 
-func (x *ModelStatusResponse) Reset() {
-	*x = ModelStatusResponse{}
+func (x *ExplainerSpec) Reset() {
+	*x = ExplainerSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[20]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModelStatusResponse) String() string {
+func (x *ExplainerSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelStatusResponse) ProtoMessage() {}
+func (*ExplainerSpec) ProtoMessage() {}
 
-func (x *ModelStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[20]
+func (x *ExplainerSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1982,68 +2473,101 @@ func (x *ModelStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelStatusResponse.ProtoReflect.Descriptor instead.
-func (*ModelStatusResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ExplainerSpec.ProtoReflect.Descriptor instead.
+func (*ExplainerSpec) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ModelStatusResponse) GetModelName() string {
+func (x *ExplainerSpec) GetType() string {
 	if x != nil {
-		return x.ModelName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ModelStatusResponse) GetVersions() []*ModelVersionStatus {
-	if x != nil {
-		return x.Versions
+func (x *ExplainerSpec) GetModelRef() string {
+	if x != nil && x.ModelRef != nil {
+		return *x.ModelRef
 	}
-	return nil
+	return ""
 }
 
-func (x *ModelStatusResponse) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
+func (x *ExplainerSpec) GetPipelineRef() string {
+	if x != nil && x.PipelineRef != nil {
+		return *x.PipelineRef
 	}
-	return false
+	return ""
 }
 
-func (x *ModelStatusResponse) GetKeepTopics() bool {
-	if x != nil {
-		return x.KeepTopics
+type LlmSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelRef    *string `protobuf:"bytes,1,opt,name=modelRef,proto3,oneof" json:"modelRef,omitempty"`
+	PipelineRef *string `protobuf:"bytes,2,opt,name=pipelineRef,proto3,oneof" json:"pipelineRef,omitempty"`
+}
+
+func (x *LlmSpec) Reset() {
+	*x = LlmSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ModelStatusResponse) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
+func (x *LlmSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LlmSpec) ProtoMessage() {}
+
+func (x *LlmSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ModelStatusResponse) GetOperation() ModelStatusResponse_ModelOperation {
-	if x != nil {
-		return x.Operation
+// Deprecated: Use LlmSpec.ProtoReflect.Descriptor instead.
+func (*LlmSpec) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LlmSpec) GetModelRef() string {
+	if x != nil && x.ModelRef != nil {
+		return *x.ModelRef
 	}
-	return ModelStatusResponse_ModelOperationUnknown
+	return ""
 }
 
-type ModelVersionStatus struct {
+func (x *LlmSpec) GetPipelineRef() string {
+	if x != nil && x.PipelineRef != nil {
+		return *x.PipelineRef
+	}
+	return ""
+}
+
+type ModelRuntimeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version           uint32                        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	ServerName        string                        `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	KubernetesMeta    *KubernetesMeta               `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
-	ModelReplicaState map[int32]*ModelReplicaStatus `protobuf:"bytes,5,rep,name=modelReplicaState,proto3" json:"modelReplicaState,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	State             *ModelStatus                  `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	ModelDefn         *Model                        `protobuf:"bytes,7,opt,name=modelDefn,proto3,oneof" json:"modelDefn,omitempty"`
+	// Types that are assignable to ModelRuntimeInfo:
+	//
+	//	*ModelRuntimeInfo_Mlserver
+	//	*ModelRuntimeInfo_Triton
+	ModelRuntimeInfo isModelRuntimeInfo_ModelRuntimeInfo `protobuf_oneof:"modelRuntimeInfo"`
 }
 
-func (x *ModelVersionStatus) Reset() {
-	*x = ModelVersionStatus{}
+func (x *ModelRuntimeInfo) Reset() {
+	*x = ModelRuntimeInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2051,13 +2575,13 @@ func (x *ModelVersionStatus) Reset() {
 	}
 }
 
-func (x *ModelVersionStatus) String() string {
+func (x *ModelRuntimeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelVersionStatus) ProtoMessage() {}
+func (*ModelRuntimeInfo) ProtoMessage() {}
 
-func (x *ModelVersionStatus) ProtoReflect() protoreflect.Message {
+func (x *ModelRuntimeInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2069,69 +2593,58 @@ func (x *ModelVersionStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelVersionStatus.ProtoReflect.Descriptor instead.
-func (*ModelVersionStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelRuntimeInfo.ProtoReflect.Descriptor instead.
+func (*ModelRuntimeInfo) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ModelVersionStatus) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
+func (m *ModelRuntimeInfo) GetModelRuntimeInfo() isModelRuntimeInfo_ModelRuntimeInfo {
+	if m != nil {
+		return m.ModelRuntimeInfo
 	}
-	return 0
+	return nil
 }
 
-func (x *ModelVersionStatus) GetServerName() string {
-	if x != nil {
-		return x.ServerName
+func (x *ModelRuntimeInfo) GetMlserver() *MLServerModelSettings {
+	if x, ok := x.GetModelRuntimeInfo().(*ModelRuntimeInfo_Mlserver); ok {
+		return x.Mlserver
 	}
-	return ""
+	return nil
 }
 
-func (x *ModelVersionStatus) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
+func (x *ModelRuntimeInfo) GetTriton() *TritonModelConfig {
+	if x, ok := x.GetModelRuntimeInfo().(*ModelRuntimeInfo_Triton); ok {
+		return x.Triton
 	}
 	return nil
 }
 
-func (x *ModelVersionStatus) GetModelReplicaState() map[int32]*ModelReplicaStatus {
-	if x != nil {
-		return x.ModelReplicaState
-	}
-	return nil
+type isModelRuntimeInfo_ModelRuntimeInfo interface {
+	isModelRuntimeInfo_ModelRuntimeInfo()
 }
 
-func (x *ModelVersionStatus) GetState() *ModelStatus {
-	if x != nil {
-		return x.State
-	}
-	return nil
+type ModelRuntimeInfo_Mlserver struct {
+	Mlserver *MLServerModelSettings `protobuf:"bytes,1,opt,name=mlserver,proto3,oneof"`
 }
 
-func (x *ModelVersionStatus) GetModelDefn() *Model {
-	if x != nil {
-		return x.ModelDefn
-	}
-	return nil
+type ModelRuntimeInfo_Triton struct {
+	Triton *TritonModelConfig `protobuf:"bytes,2,opt,name=triton,proto3,oneof"`
 }
 
-type ModelStatus struct {
+func (*ModelRuntimeInfo_Mlserver) isModelRuntimeInfo_ModelRuntimeInfo() {}
+
+func (*ModelRuntimeInfo_Triton) isModelRuntimeInfo_ModelRuntimeInfo() {}
+
+type MLServerModelSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State               ModelStatus_ModelState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelStatus_ModelState" json:"state,omitempty"`
-	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	AvailableReplicas   uint32                 `protobuf:"varint,3,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
-	UnavailableReplicas uint32                 `protobuf:"varint,4,opt,name=unavailableReplicas,proto3" json:"unavailableReplicas,omitempty"`
-	LastChangeTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
-	ModelGwState        ModelStatus_ModelState `protobuf:"varint,6,opt,name=modelGwState,proto3,enum=seldon.mlops.scheduler.ModelStatus_ModelState" json:"modelGwState,omitempty"`
-	ModelGwReason       string                 `protobuf:"bytes,7,opt,name=modelGwReason,proto3" json:"modelGwReason,omitempty"`
+	ParallelWorkers uint32 `protobuf:"varint,1,opt,name=parallelWorkers,proto3" json:"parallelWorkers,omitempty"`
 }
 
-func (x *ModelStatus) Reset() {
-	*x = ModelStatus{}
+func (x *MLServerModelSettings) Reset() {
+	*x = MLServerModelSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2139,13 +2652,13 @@ func (x *ModelStatus) Reset() {
 	}
 }
 
-func (x *ModelStatus) String() string {
+func (x *MLServerModelSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelStatus) ProtoMessage() {}
+func (*MLServerModelSettings) ProtoMessage() {}
 
-func (x *ModelStatus) ProtoReflect() protoreflect.Message {
+func (x *MLServerModelSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,72 +2670,28 @@ func (x *ModelStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelStatus.ProtoReflect.Descriptor instead.
-func (*ModelStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use MLServerModelSettings.ProtoReflect.Descriptor instead.
+func (*MLServerModelSettings) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ModelStatus) GetState() ModelStatus_ModelState {
-	if x != nil {
-		return x.State
-	}
-	return ModelStatus_ModelStateUnknown
-}
-
-func (x *ModelStatus) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *ModelStatus) GetAvailableReplicas() uint32 {
-	if x != nil {
-		return x.AvailableReplicas
-	}
-	return 0
-}
-
-func (x *ModelStatus) GetUnavailableReplicas() uint32 {
+func (x *MLServerModelSettings) GetParallelWorkers() uint32 {
 	if x != nil {
-		return x.UnavailableReplicas
+		return x.ParallelWorkers
 	}
 	return 0
 }
 
-func (x *ModelStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastChangeTimestamp
-	}
-	return nil
-}
-
-func (x *ModelStatus) GetModelGwState() ModelStatus_ModelState {
-	if x != nil {
-		return x.ModelGwState
-	}
-	return ModelStatus_ModelStateUnknown
-}
-
-func (x *ModelStatus) GetModelGwReason() string {
-	if x != nil {
-		return x.ModelGwReason
-	}
-	return ""
-}
-
-type ModelReplicaStatus struct {
+type TritonModelConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State               ModelReplicaStatus_ModelReplicaState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelReplicaStatus_ModelReplicaState" json:"state,omitempty"`
-	Reason              string                               `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	LastChangeTimestamp *timestamppb.Timestamp               `protobuf:"bytes,3,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
+	Cpu []*TritonCPU `protobuf:"bytes,1,rep,name=cpu,proto3" json:"cpu,omitempty"`
 }
 
-func (x *ModelReplicaStatus) Reset() {
-	*x = ModelReplicaStatus{}
+func (x *TritonModelConfig) Reset() {
+	*x = TritonModelConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2230,13 +2699,13 @@ func (x *ModelReplicaStatus) Reset() {
 	}
 }
 
-func (x *ModelReplicaStatus) String() string {
+func (x *TritonModelConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelReplicaStatus) ProtoMessage() {}
+func (*TritonModelConfig) ProtoMessage() {}
 
-func (x *ModelReplicaStatus) ProtoReflect() protoreflect.Message {
+func (x *TritonModelConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2248,43 +2717,28 @@ func (x *ModelReplicaStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelReplicaStatus.ProtoReflect.Descriptor instead.
-func (*ModelReplicaStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use TritonModelConfig.ProtoReflect.Descriptor instead.
+func (*TritonModelConfig) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ModelReplicaStatus) GetState() ModelReplicaStatus_ModelReplicaState {
-	if x != nil {
-		return x.State
-	}
-	return ModelReplicaStatus_ModelReplicaStateUnknown
-}
-
-func (x *ModelReplicaStatus) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *ModelReplicaStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
+func (x *TritonModelConfig) GetCpu() []*TritonCPU {
 	if x != nil {
-		return x.LastChangeTimestamp
+		return x.Cpu
 	}
 	return nil
 }
 
-type ServerStatusRequest struct {
+type TritonCPU struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all servers
+	InstanceCount uint32 `protobuf:"varint,1,opt,name=instanceCount,proto3" json:"instanceCount,omitempty"`
 }
 
-func (x *ServerStatusRequest) Reset() {
-	*x = ServerStatusRequest{}
+func (x *TritonCPU) Reset() {
+	*x = TritonCPU{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2292,13 +2746,13 @@ func (x *ServerStatusRequest) Reset() {
 	}
 }
 
-func (x *ServerStatusRequest) String() string {
+func (x *TritonCPU) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStatusRequest) ProtoMessage() {}
+func (*TritonCPU) ProtoMessage() {}
 
-func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *TritonCPU) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2310,42 +2764,29 @@ func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStatusRequest.ProtoReflect.Descriptor instead.
-func (*ServerStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TritonCPU.ProtoReflect.Descriptor instead.
+func (*TritonCPU) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ServerStatusRequest) GetSubscriberName() string {
+func (x *TritonCPU) GetInstanceCount() uint32 {
 	if x != nil {
-		return x.SubscriberName
-	}
-	return ""
-}
-
-func (x *ServerStatusRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.InstanceCount
 	}
-	return ""
+	return 0
 }
 
-// ServerStatusResponse provides details of current server status
-type ServerStatusResponse struct {
+type KubernetesMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type                   ServerStatusResponse_Type `protobuf:"varint,7,opt,name=type,proto3,enum=seldon.mlops.scheduler.ServerStatusResponse_Type" json:"type,omitempty"`
-	ServerName             string                    `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	Resources              []*ServerReplicaResources `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
-	ExpectedReplicas       int32                     `protobuf:"varint,3,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
-	AvailableReplicas      int32                     `protobuf:"varint,4,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
-	NumLoadedModelReplicas int32                     `protobuf:"varint,5,opt,name=numLoadedModelReplicas,proto3" json:"numLoadedModelReplicas,omitempty"`
-	KubernetesMeta         *KubernetesMeta           `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Generation int64  `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
-func (x *ServerStatusResponse) Reset() {
-	*x = ServerStatusResponse{}
+func (x *KubernetesMeta) Reset() {
+	*x = KubernetesMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2353,13 +2794,13 @@ func (x *ServerStatusResponse) Reset() {
 	}
 }
 
-func (x *ServerStatusResponse) String() string {
+func (x *KubernetesMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStatusResponse) ProtoMessage() {}
+func (*KubernetesMeta) ProtoMessage() {}
 
-func (x *ServerStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *KubernetesMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2371,74 +2812,36 @@ func (x *ServerStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStatusResponse.ProtoReflect.Descriptor instead.
-func (*ServerStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KubernetesMeta.ProtoReflect.Descriptor instead.
+func (*KubernetesMeta) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ServerStatusResponse) GetType() ServerStatusResponse_Type {
-	if x != nil {
-		return x.Type
-	}
-	return ServerStatusResponse_StatusResponseTypeUnknown
-}
-
-func (x *ServerStatusResponse) GetServerName() string {
+func (x *KubernetesMeta) GetNamespace() string {
 	if x != nil {
-		return x.ServerName
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ServerStatusResponse) GetResources() []*ServerReplicaResources {
-	if x != nil {
-		return x.Resources
-	}
-	return nil
-}
-
-func (x *ServerStatusResponse) GetExpectedReplicas() int32 {
-	if x != nil {
-		return x.ExpectedReplicas
-	}
-	return 0
-}
-
-func (x *ServerStatusResponse) GetAvailableReplicas() int32 {
-	if x != nil {
-		return x.AvailableReplicas
-	}
-	return 0
-}
-
-func (x *ServerStatusResponse) GetNumLoadedModelReplicas() int32 {
+func (x *KubernetesMeta) GetGeneration() int64 {
 	if x != nil {
-		return x.NumLoadedModelReplicas
+		return x.Generation
 	}
 	return 0
 }
 
-func (x *ServerStatusResponse) GetKubernetesMeta() *KubernetesMeta {
-	if x != nil {
-		return x.KubernetesMeta
-	}
-	return nil
-}
-
-type ServerReplicaResources struct {
+type StreamSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicaIdx           uint32 `protobuf:"varint,1,opt,name=replicaIdx,proto3" json:"replicaIdx,omitempty"`
-	TotalMemoryBytes     uint64 `protobuf:"varint,2,opt,name=totalMemoryBytes,proto3" json:"totalMemoryBytes,omitempty"`
-	AvailableMemoryBytes uint64 `protobuf:"varint,3,opt,name=availableMemoryBytes,proto3" json:"availableMemoryBytes,omitempty"`
-	NumLoadedModels      int32  `protobuf:"varint,4,opt,name=numLoadedModels,proto3" json:"numLoadedModels,omitempty"`
-	OverCommitPercentage uint32 `protobuf:"varint,5,opt,name=overCommitPercentage,proto3" json:"overCommitPercentage,omitempty"`
+	InputTopic  string `protobuf:"bytes,2,opt,name=inputTopic,proto3" json:"inputTopic,omitempty"`
+	OutputTopic string `protobuf:"bytes,3,opt,name=outputTopic,proto3" json:"outputTopic,omitempty"`
 }
 
-func (x *ServerReplicaResources) Reset() {
-	*x = ServerReplicaResources{}
+func (x *StreamSpec) Reset() {
+	*x = StreamSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2446,13 +2849,13 @@ func (x *ServerReplicaResources) Reset() {
 	}
 }
 
-func (x *ServerReplicaResources) String() string {
+func (x *StreamSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerReplicaResources) ProtoMessage() {}
+func (*StreamSpec) ProtoMessage() {}
 
-func (x *ServerReplicaResources) ProtoReflect() protoreflect.Message {
+func (x *StreamSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2464,57 +2867,39 @@ func (x *ServerReplicaResources) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerReplicaResources.ProtoReflect.Descriptor instead.
-func (*ServerReplicaResources) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamSpec.ProtoReflect.Descriptor instead.
+func (*StreamSpec) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ServerReplicaResources) GetReplicaIdx() uint32 {
-	if x != nil {
-		return x.ReplicaIdx
-	}
-	return 0
-}
-
-func (x *ServerReplicaResources) GetTotalMemoryBytes() uint64 {
-	if x != nil {
-		return x.TotalMemoryBytes
-	}
-	return 0
-}
-
-func (x *ServerReplicaResources) GetAvailableMemoryBytes() uint64 {
-	if x != nil {
-		return x.AvailableMemoryBytes
-	}
-	return 0
-}
-
-func (x *ServerReplicaResources) GetNumLoadedModels() int32 {
+func (x *StreamSpec) GetInputTopic() string {
 	if x != nil {
-		return x.NumLoadedModels
+		return x.InputTopic
 	}
-	return 0
+	return ""
 }
 
-func (x *ServerReplicaResources) GetOverCommitPercentage() uint32 {
+func (x *StreamSpec) GetOutputTopic() string {
 	if x != nil {
-		return x.OverCommitPercentage
+		return x.OutputTopic
 	}
-	return 0
+	return ""
 }
 
-type ModelSubscriptionRequest struct {
+type StorageConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
-	IsModelGateway bool   `protobuf:"varint,2,opt,name=isModelGateway,proto3" json:"isModelGateway,omitempty"`
+	// Types that are assignable to Config:
+	//
+	//	*StorageConfig_StorageSecretName
+	//	*StorageConfig_StorageRcloneConfig
+	Config isStorageConfig_Config `protobuf_oneof:"config"`
 }
 
-func (x *ModelSubscriptionRequest) Reset() {
-	*x = ModelSubscriptionRequest{}
+func (x *StorageConfig) Reset() {
+	*x = StorageConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2522,13 +2907,13 @@ func (x *ModelSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ModelSubscriptionRequest) String() string {
+func (x *StorageConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelSubscriptionRequest) ProtoMessage() {}
+func (*StorageConfig) ProtoMessage() {}
 
-func (x *ModelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2540,37 +2925,56 @@ func (x *ModelSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ModelSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
+func (*StorageConfig) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ModelSubscriptionRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
+func (m *StorageConfig) GetConfig() isStorageConfig_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *StorageConfig) GetStorageSecretName() string {
+	if x, ok := x.GetConfig().(*StorageConfig_StorageSecretName); ok {
+		return x.StorageSecretName
 	}
 	return ""
 }
 
-func (x *ModelSubscriptionRequest) GetIsModelGateway() bool {
-	if x != nil {
-		return x.IsModelGateway
+func (x *StorageConfig) GetStorageRcloneConfig() string {
+	if x, ok := x.GetConfig().(*StorageConfig_StorageRcloneConfig); ok {
+		return x.StorageRcloneConfig
 	}
-	return false
+	return ""
 }
 
-type ModelStatusRequest struct {
+type isStorageConfig_Config interface {
+	isStorageConfig_Config()
+}
+
+type StorageConfig_StorageSecretName struct {
+	StorageSecretName string `protobuf:"bytes,1,opt,name=storageSecretName,proto3,oneof"`
+}
+
+type StorageConfig_StorageRcloneConfig struct {
+	StorageRcloneConfig string `protobuf:"bytes,2,opt,name=storageRcloneConfig,proto3,oneof"`
+}
+
+func (*StorageConfig_StorageSecretName) isStorageConfig_Config() {}
+
+func (*StorageConfig_StorageRcloneConfig) isStorageConfig_Config() {}
+
+type LoadModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SubscriberName string          `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Model          *ModelReference `protobuf:"bytes,2,opt,name=model,proto3,oneof" json:"model,omitempty"`
-	AllVersions    bool            `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
 }
 
-func (x *ModelStatusRequest) Reset() {
-	*x = ModelStatusRequest{}
+func (x *LoadModelResponse) Reset() {
+	*x = LoadModelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2578,13 +2982,13 @@ func (x *ModelStatusRequest) Reset() {
 	}
 }
 
-func (x *ModelStatusRequest) String() string {
+func (x *LoadModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelStatusRequest) ProtoMessage() {}
+func (*LoadModelResponse) ProtoMessage() {}
 
-func (x *ModelStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *LoadModelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2596,43 +3000,23 @@ func (x *ModelStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelStatusRequest.ProtoReflect.Descriptor instead.
-func (*ModelStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoadModelResponse.ProtoReflect.Descriptor instead.
+func (*LoadModelResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ModelStatusRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
-	}
-	return ""
-}
-
-func (x *ModelStatusRequest) GetModel() *ModelReference {
-	if x != nil {
-		return x.Model
-	}
-	return nil
-}
-
-func (x *ModelStatusRequest) GetAllVersions() bool {
-	if x != nil {
-		return x.AllVersions
-	}
-	return false
-}
-
-type ServerNotifyRequest struct {
+// ModelReference represents a unique model
+type ModelReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Servers     []*ServerNotify `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-	IsFirstSync bool            `protobuf:"varint,2,opt,name=isFirstSync,proto3" json:"isFirstSync,omitempty"`
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version *uint32 `protobuf:"varint,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
 }
 
-func (x *ServerNotifyRequest) Reset() {
-	*x = ServerNotifyRequest{}
+func (x *ModelReference) Reset() {
+	*x = ModelReference{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2640,13 +3024,13 @@ func (x *ServerNotifyRequest) Reset() {
 	}
 }
 
-func (x *ServerNotifyRequest) String() string {
+func (x *ModelReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerNotifyRequest) ProtoMessage() {}
+func (*ModelReference) ProtoMessage() {}
 
-func (x *ServerNotifyRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelReference) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2658,40 +3042,36 @@ func (x *ServerNotifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerNotifyRequest.ProtoReflect.Descriptor instead.
-func (*ServerNotifyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelReference.ProtoReflect.Descriptor instead.
+func (*ModelReference) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ServerNotifyRequest) GetServers() []*ServerNotify {
+func (x *ModelReference) GetName() string {
 	if x != nil {
-		return x.Servers
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ServerNotifyRequest) GetIsFirstSync() bool {
-	if x != nil {
-		return x.IsFirstSync
+func (x *ModelReference) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
-	return false
+	return 0
 }
 
-type ServerNotify struct {
+type UnloadModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ExpectedReplicas uint32          `protobuf:"varint,2,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
-	MinReplicas      uint32          `protobuf:"varint,5,opt,name=minReplicas,proto3" json:"minReplicas,omitempty"`
-	MaxReplicas      uint32          `protobuf:"varint,6,opt,name=maxReplicas,proto3" json:"maxReplicas,omitempty"`
-	Shared           bool            `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
-	KubernetesMeta   *KubernetesMeta `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Model          *ModelReference `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	KubernetesMeta *KubernetesMeta `protobuf:"bytes,2,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
 }
 
-func (x *ServerNotify) Reset() {
-	*x = ServerNotify{}
+func (x *UnloadModelRequest) Reset() {
+	*x = UnloadModelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2699,13 +3079,13 @@ func (x *ServerNotify) Reset() {
 	}
 }
 
-func (x *ServerNotify) String() string {
+func (x *UnloadModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerNotify) ProtoMessage() {}
+func (*UnloadModelRequest) ProtoMessage() {}
 
-func (x *ServerNotify) ProtoReflect() protoreflect.Message {
+func (x *UnloadModelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2717,61 +3097,33 @@ func (x *ServerNotify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerNotify.ProtoReflect.Descriptor instead.
-func (*ServerNotify) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnloadModelRequest.ProtoReflect.Descriptor instead.
+func (*UnloadModelRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ServerNotify) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ServerNotify) GetExpectedReplicas() uint32 {
-	if x != nil {
-		return x.ExpectedReplicas
-	}
-	return 0
-}
-
-func (x *ServerNotify) GetMinReplicas() uint32 {
-	if x != nil {
-		return x.MinReplicas
-	}
-	return 0
-}
-
-func (x *ServerNotify) GetMaxReplicas() uint32 {
-	if x != nil {
-		return x.MaxReplicas
-	}
-	return 0
-}
-
-func (x *ServerNotify) GetShared() bool {
+func (x *UnloadModelRequest) GetModel() *ModelReference {
 	if x != nil {
-		return x.Shared
+		return x.Model
 	}
-	return false
+	return nil
 }
 
-func (x *ServerNotify) GetKubernetesMeta() *KubernetesMeta {
+func (x *UnloadModelRequest) GetKubernetesMeta() *KubernetesMeta {
 	if x != nil {
 		return x.KubernetesMeta
 	}
 	return nil
 }
 
-type ServerNotifyResponse struct {
+type UnloadModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ServerNotifyResponse) Reset() {
-	*x = ServerNotifyResponse{}
+func (x *UnloadModelResponse) Reset() {
+	*x = UnloadModelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2779,13 +3131,13 @@ func (x *ServerNotifyResponse) Reset() {
 	}
 }
 
-func (x *ServerNotifyResponse) String() string {
+func (x *UnloadModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerNotifyResponse) ProtoMessage() {}
+func (*UnloadModelResponse) ProtoMessage() {}
 
-func (x *ServerNotifyResponse) ProtoReflect() protoreflect.Message {
+func (x *UnloadModelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2797,21 +3149,27 @@ func (x *ServerNotifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerNotifyResponse.ProtoReflect.Descriptor instead.
-func (*ServerNotifyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnloadModelResponse.ProtoReflect.Descriptor instead.
+func (*UnloadModelResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{31}
 }
 
-type ServerSubscriptionRequest struct {
+// ModelStatusResponse provides the current assignment of the model onto a server
+type ModelStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+	ModelName  string                             `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
+	Versions   []*ModelVersionStatus              `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	Deleted    bool                               `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	KeepTopics bool                               `protobuf:"varint,4,opt,name=keepTopics,proto3" json:"keepTopics,omitempty"`
+	Timestamp  uint64                             `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Operation  ModelStatusResponse_ModelOperation `protobuf:"varint,6,opt,name=operation,proto3,enum=seldon.mlops.scheduler.ModelStatusResponse_ModelOperation" json:"operation,omitempty"`
 }
 
-func (x *ServerSubscriptionRequest) Reset() {
-	*x = ServerSubscriptionRequest{}
+func (x *ModelStatusResponse) Reset() {
+	*x = ModelStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2819,13 +3177,13 @@ func (x *ServerSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ServerSubscriptionRequest) String() string {
+func (x *ModelStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSubscriptionRequest) ProtoMessage() {}
+func (*ModelStatusResponse) ProtoMessage() {}
 
-func (x *ServerSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2837,43 +3195,83 @@ func (x *ServerSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ServerSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelStatusResponse.ProtoReflect.Descriptor instead.
+func (*ModelStatusResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ServerSubscriptionRequest) GetSubscriberName() string {
+func (x *ModelStatusResponse) GetModelName() string {
 	if x != nil {
-		return x.SubscriberName
+		return x.ModelName
 	}
 	return ""
 }
 
-type StartExperimentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+func (x *ModelStatusResponse) GetVersions() []*ModelVersionStatus {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
 }
 
-func (x *StartExperimentRequest) Reset() {
-	*x = StartExperimentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ModelStatusResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
 	}
+	return false
 }
 
-func (x *StartExperimentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ModelStatusResponse) GetKeepTopics() bool {
+	if x != nil {
+		return x.KeepTopics
+	}
+	return false
 }
 
-func (*StartExperimentRequest) ProtoMessage() {}
-
-func (x *StartExperimentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
+func (x *ModelStatusResponse) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ModelStatusResponse) GetOperation() ModelStatusResponse_ModelOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return ModelStatusResponse_ModelOperationUnknown
+}
+
+type ModelVersionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version           uint32                        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ServerName        string                        `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	KubernetesMeta    *KubernetesMeta               `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	ModelReplicaState map[int32]*ModelReplicaStatus `protobuf:"bytes,5,rep,name=modelReplicaState,proto3" json:"modelReplicaState,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	State             *ModelStatus                  `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	ModelDefn         *Model                        `protobuf:"bytes,7,opt,name=modelDefn,proto3,oneof" json:"modelDefn,omitempty"`
+}
+
+func (x *ModelVersionStatus) Reset() {
+	*x = ModelVersionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelVersionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelVersionStatus) ProtoMessage() {}
+
+func (x *ModelVersionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2884,34 +3282,73 @@ func (x *StartExperimentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartExperimentRequest.ProtoReflect.Descriptor instead.
-func (*StartExperimentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelVersionStatus.ProtoReflect.Descriptor instead.
+func (*ModelVersionStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *StartExperimentRequest) GetExperiment() *Experiment {
+func (x *ModelVersionStatus) GetVersion() uint32 {
 	if x != nil {
-		return x.Experiment
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ModelVersionStatus) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ModelVersionStatus) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
 	}
 	return nil
 }
 
-type Experiment struct {
+func (x *ModelVersionStatus) GetModelReplicaState() map[int32]*ModelReplicaStatus {
+	if x != nil {
+		return x.ModelReplicaState
+	}
+	return nil
+}
+
+func (x *ModelVersionStatus) GetState() *ModelStatus {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ModelVersionStatus) GetModelDefn() *Model {
+	if x != nil {
+		return x.ModelDefn
+	}
+	return nil
+}
+
+type ModelStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Default        *string                `protobuf:"bytes,2,opt,name=default,proto3,oneof" json:"default,omitempty"`
-	Candidates     []*ExperimentCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
-	Mirror         *ExperimentMirror      `protobuf:"bytes,4,opt,name=mirror,proto3,oneof" json:"mirror,omitempty"`
-	Config         *ExperimentConfig      `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
-	KubernetesMeta *KubernetesMeta        `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
-	ResourceType   ResourceType           `protobuf:"varint,7,opt,name=resourceType,proto3,enum=seldon.mlops.scheduler.ResourceType" json:"resourceType,omitempty"`
+	State               ModelStatus_ModelState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelStatus_ModelState" json:"state,omitempty"`
+	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	AvailableReplicas   uint32                 `protobuf:"varint,3,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
+	UnavailableReplicas uint32                 `protobuf:"varint,4,opt,name=unavailableReplicas,proto3" json:"unavailableReplicas,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
+	ModelGwState        ModelStatus_ModelState `protobuf:"varint,6,opt,name=modelGwState,proto3,enum=seldon.mlops.scheduler.ModelStatus_ModelState" json:"modelGwState,omitempty"`
+	ModelGwReason       string                 `protobuf:"bytes,7,opt,name=modelGwReason,proto3" json:"modelGwReason,omitempty"`
+	// Set when the model was scheduled but a soft placement constraint, e.g. topology spread, could not be satisfied
+	SchedulingWarning string `protobuf:"bytes,8,opt,name=schedulingWarning,proto3" json:"schedulingWarning,omitempty"`
+	// Set when the version is being rolled out as a canary
+	Rollout *ModelRolloutStatus `protobuf:"bytes,9,opt,name=rollout,proto3,oneof" json:"rollout,omitempty"`
 }
 
-func (x *Experiment) Reset() {
-	*x = Experiment{}
+func (x *ModelStatus) Reset() {
+	*x = ModelStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2919,13 +3356,13 @@ func (x *Experiment) Reset() {
 	}
 }
 
-func (x *Experiment) String() string {
+func (x *ModelStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Experiment) ProtoMessage() {}
+func (*ModelStatus) ProtoMessage() {}
 
-func (x *Experiment) ProtoReflect() protoreflect.Message {
+func (x *ModelStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,70 +3374,89 @@ func (x *Experiment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
-func (*Experiment) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelStatus.ProtoReflect.Descriptor instead.
+func (*ModelStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Experiment) GetName() string {
+func (x *ModelStatus) GetState() ModelStatus_ModelState {
 	if x != nil {
-		return x.Name
+		return x.State
 	}
-	return ""
+	return ModelStatus_ModelStateUnknown
 }
 
-func (x *Experiment) GetDefault() string {
-	if x != nil && x.Default != nil {
-		return *x.Default
+func (x *ModelStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Experiment) GetCandidates() []*ExperimentCandidate {
+func (x *ModelStatus) GetAvailableReplicas() uint32 {
 	if x != nil {
-		return x.Candidates
+		return x.AvailableReplicas
 	}
-	return nil
+	return 0
 }
 
-func (x *Experiment) GetMirror() *ExperimentMirror {
+func (x *ModelStatus) GetUnavailableReplicas() uint32 {
 	if x != nil {
-		return x.Mirror
+		return x.UnavailableReplicas
 	}
-	return nil
+	return 0
 }
 
-func (x *Experiment) GetConfig() *ExperimentConfig {
+func (x *ModelStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Config
+		return x.LastChangeTimestamp
 	}
 	return nil
 }
 
-func (x *Experiment) GetKubernetesMeta() *KubernetesMeta {
+func (x *ModelStatus) GetModelGwState() ModelStatus_ModelState {
 	if x != nil {
-		return x.KubernetesMeta
+		return x.ModelGwState
 	}
-	return nil
+	return ModelStatus_ModelStateUnknown
 }
 
-func (x *Experiment) GetResourceType() ResourceType {
+func (x *ModelStatus) GetModelGwReason() string {
 	if x != nil {
-		return x.ResourceType
+		return x.ModelGwReason
 	}
-	return ResourceType_MODEL
+	return ""
 }
 
-type ExperimentConfig struct {
+func (x *ModelStatus) GetSchedulingWarning() string {
+	if x != nil {
+		return x.SchedulingWarning
+	}
+	return ""
+}
+
+func (x *ModelStatus) GetRollout() *ModelRolloutStatus {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+type ModelRolloutStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StickySessions bool `protobuf:"varint,1,opt,name=stickySessions,proto3" json:"stickySessions,omitempty"`
+	State ModelRolloutStatus_RolloutState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelRolloutStatus_RolloutState" json:"state,omitempty"`
+	// the version receiving the remaining traffic while the rollout is in progress
+	StableVersion       uint32                 `protobuf:"varint,2,opt,name=stableVersion,proto3" json:"stableVersion,omitempty"`
+	TrafficPercent      uint32                 `protobuf:"varint,3,opt,name=trafficPercent,proto3" json:"trafficPercent,omitempty"`
+	Reason              string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
 }
 
-func (x *ExperimentConfig) Reset() {
-	*x = ExperimentConfig{}
+func (x *ModelRolloutStatus) Reset() {
+	*x = ModelRolloutStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3008,13 +3464,13 @@ func (x *ExperimentConfig) Reset() {
 	}
 }
 
-func (x *ExperimentConfig) String() string {
+func (x *ModelRolloutStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentConfig) ProtoMessage() {}
+func (*ModelRolloutStatus) ProtoMessage() {}
 
-func (x *ExperimentConfig) ProtoReflect() protoreflect.Message {
+func (x *ModelRolloutStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3026,29 +3482,58 @@ func (x *ExperimentConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentConfig.ProtoReflect.Descriptor instead.
-func (*ExperimentConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelRolloutStatus.ProtoReflect.Descriptor instead.
+func (*ModelRolloutStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ExperimentConfig) GetStickySessions() bool {
+func (x *ModelRolloutStatus) GetState() ModelRolloutStatus_RolloutState {
 	if x != nil {
-		return x.StickySessions
+		return x.State
 	}
-	return false
+	return ModelRolloutStatus_RolloutStateUnknown
 }
 
-type ExperimentCandidate struct {
+func (x *ModelRolloutStatus) GetStableVersion() uint32 {
+	if x != nil {
+		return x.StableVersion
+	}
+	return 0
+}
+
+func (x *ModelRolloutStatus) GetTrafficPercent() uint32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
+func (x *ModelRolloutStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModelRolloutStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChangeTimestamp
+	}
+	return nil
+}
+
+type ModelReplicaStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	State               ModelReplicaStatus_ModelReplicaState `protobuf:"varint,1,opt,name=state,proto3,enum=seldon.mlops.scheduler.ModelReplicaStatus_ModelReplicaState" json:"state,omitempty"`
+	Reason              string                               `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp               `protobuf:"bytes,3,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
 }
 
-func (x *ExperimentCandidate) Reset() {
-	*x = ExperimentCandidate{}
+func (x *ModelReplicaStatus) Reset() {
+	*x = ModelReplicaStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3056,13 +3541,13 @@ func (x *ExperimentCandidate) Reset() {
 	}
 }
 
-func (x *ExperimentCandidate) String() string {
+func (x *ModelReplicaStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentCandidate) ProtoMessage() {}
+func (*ModelReplicaStatus) ProtoMessage() {}
 
-func (x *ExperimentCandidate) ProtoReflect() protoreflect.Message {
+func (x *ModelReplicaStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3074,36 +3559,43 @@ func (x *ExperimentCandidate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentCandidate.ProtoReflect.Descriptor instead.
-func (*ExperimentCandidate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelReplicaStatus.ProtoReflect.Descriptor instead.
+func (*ModelReplicaStatus) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ExperimentCandidate) GetName() string {
+func (x *ModelReplicaStatus) GetState() ModelReplicaStatus_ModelReplicaState {
 	if x != nil {
-		return x.Name
+		return x.State
+	}
+	return ModelReplicaStatus_ModelReplicaStateUnknown
+}
+
+func (x *ModelReplicaStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ExperimentCandidate) GetWeight() uint32 {
+func (x *ModelReplicaStatus) GetLastChangeTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Weight
+		return x.LastChangeTimestamp
 	}
-	return 0
+	return nil
 }
 
-type ExperimentMirror struct {
+type ServerStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Percent uint32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all servers
 }
 
-func (x *ExperimentMirror) Reset() {
-	*x = ExperimentMirror{}
+func (x *ServerStatusRequest) Reset() {
+	*x = ServerStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3111,13 +3603,13 @@ func (x *ExperimentMirror) Reset() {
 	}
 }
 
-func (x *ExperimentMirror) String() string {
+func (x *ServerStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentMirror) ProtoMessage() {}
+func (*ServerStatusRequest) ProtoMessage() {}
 
-func (x *ExperimentMirror) ProtoReflect() protoreflect.Message {
+func (x *ServerStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3129,33 +3621,42 @@ func (x *ExperimentMirror) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentMirror.ProtoReflect.Descriptor instead.
-func (*ExperimentMirror) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerStatusRequest.ProtoReflect.Descriptor instead.
+func (*ServerStatusRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ExperimentMirror) GetName() string {
+func (x *ServerStatusRequest) GetSubscriberName() string {
 	if x != nil {
-		return x.Name
+		return x.SubscriberName
 	}
 	return ""
 }
 
-func (x *ExperimentMirror) GetPercent() uint32 {
-	if x != nil {
-		return x.Percent
+func (x *ServerStatusRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
+	return ""
 }
 
-type StartExperimentResponse struct {
+// ServerStatusResponse provides details of current server status
+type ServerStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type                   ServerStatusResponse_Type `protobuf:"varint,7,opt,name=type,proto3,enum=seldon.mlops.scheduler.ServerStatusResponse_Type" json:"type,omitempty"`
+	ServerName             string                    `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	Resources              []*ServerReplicaResources `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	ExpectedReplicas       int32                     `protobuf:"varint,3,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
+	AvailableReplicas      int32                     `protobuf:"varint,4,opt,name=availableReplicas,proto3" json:"availableReplicas,omitempty"`
+	NumLoadedModelReplicas int32                     `protobuf:"varint,5,opt,name=numLoadedModelReplicas,proto3" json:"numLoadedModelReplicas,omitempty"`
+	KubernetesMeta         *KubernetesMeta           `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
 }
 
-func (x *StartExperimentResponse) Reset() {
-	*x = StartExperimentResponse{}
+func (x *ServerStatusResponse) Reset() {
+	*x = ServerStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3163,13 +3664,13 @@ func (x *StartExperimentResponse) Reset() {
 	}
 }
 
-func (x *StartExperimentResponse) String() string {
+func (x *ServerStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartExperimentResponse) ProtoMessage() {}
+func (*ServerStatusResponse) ProtoMessage() {}
 
-func (x *StartExperimentResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3181,21 +3682,74 @@ func (x *StartExperimentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartExperimentResponse.ProtoReflect.Descriptor instead.
-func (*StartExperimentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerStatusResponse.ProtoReflect.Descriptor instead.
+func (*ServerStatusResponse) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{38}
 }
 
-type StopExperimentRequest struct {
+func (x *ServerStatusResponse) GetType() ServerStatusResponse_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ServerStatusResponse_StatusResponseTypeUnknown
+}
+
+func (x *ServerStatusResponse) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerStatusResponse) GetResources() []*ServerReplicaResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ServerStatusResponse) GetExpectedReplicas() int32 {
+	if x != nil {
+		return x.ExpectedReplicas
+	}
+	return 0
+}
+
+func (x *ServerStatusResponse) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *ServerStatusResponse) GetNumLoadedModelReplicas() int32 {
+	if x != nil {
+		return x.NumLoadedModelReplicas
+	}
+	return 0
+}
+
+func (x *ServerStatusResponse) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+type ServerReplicaResources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReplicaIdx           uint32 `protobuf:"varint,1,opt,name=replicaIdx,proto3" json:"replicaIdx,omitempty"`
+	TotalMemoryBytes     uint64 `protobuf:"varint,2,opt,name=totalMemoryBytes,proto3" json:"totalMemoryBytes,omitempty"`
+	AvailableMemoryBytes uint64 `protobuf:"varint,3,opt,name=availableMemoryBytes,proto3" json:"availableMemoryBytes,omitempty"`
+	NumLoadedModels      int32  `protobuf:"varint,4,opt,name=numLoadedModels,proto3" json:"numLoadedModels,omitempty"`
+	OverCommitPercentage uint32 `protobuf:"varint,5,opt,name=overCommitPercentage,proto3" json:"overCommitPercentage,omitempty"`
 }
 
-func (x *StopExperimentRequest) Reset() {
-	*x = StopExperimentRequest{}
+func (x *ServerReplicaResources) Reset() {
+	*x = ServerReplicaResources{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3203,13 +3757,13 @@ func (x *StopExperimentRequest) Reset() {
 	}
 }
 
-func (x *StopExperimentRequest) String() string {
+func (x *ServerReplicaResources) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopExperimentRequest) ProtoMessage() {}
+func (*ServerReplicaResources) ProtoMessage() {}
 
-func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerReplicaResources) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3221,26 +3775,57 @@ func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopExperimentRequest.ProtoReflect.Descriptor instead.
-func (*StopExperimentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerReplicaResources.ProtoReflect.Descriptor instead.
+func (*ServerReplicaResources) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *StopExperimentRequest) GetName() string {
+func (x *ServerReplicaResources) GetReplicaIdx() uint32 {
 	if x != nil {
-		return x.Name
+		return x.ReplicaIdx
 	}
-	return ""
+	return 0
 }
 
-type StopExperimentResponse struct {
+func (x *ServerReplicaResources) GetTotalMemoryBytes() uint64 {
+	if x != nil {
+		return x.TotalMemoryBytes
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetAvailableMemoryBytes() uint64 {
+	if x != nil {
+		return x.AvailableMemoryBytes
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetNumLoadedModels() int32 {
+	if x != nil {
+		return x.NumLoadedModels
+	}
+	return 0
+}
+
+func (x *ServerReplicaResources) GetOverCommitPercentage() uint32 {
+	if x != nil {
+		return x.OverCommitPercentage
+	}
+	return 0
+}
+
+type ModelSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+	IsModelGateway bool   `protobuf:"varint,2,opt,name=isModelGateway,proto3" json:"isModelGateway,omitempty"`
 }
 
-func (x *StopExperimentResponse) Reset() {
-	*x = StopExperimentResponse{}
+func (x *ModelSubscriptionRequest) Reset() {
+	*x = ModelSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3248,13 +3833,13 @@ func (x *StopExperimentResponse) Reset() {
 	}
 }
 
-func (x *StopExperimentResponse) String() string {
+func (x *ModelSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopExperimentResponse) ProtoMessage() {}
+func (*ModelSubscriptionRequest) ProtoMessage() {}
 
-func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
+func (x *ModelSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3266,21 +3851,37 @@ func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopExperimentResponse.ProtoReflect.Descriptor instead.
-func (*StopExperimentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ModelSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{40}
 }
 
-type ExperimentSubscriptionRequest struct {
+func (x *ModelSubscriptionRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+func (x *ModelSubscriptionRequest) GetIsModelGateway() bool {
+	if x != nil {
+		return x.IsModelGateway
+	}
+	return false
+}
+
+type ModelStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+	SubscriberName string          `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Model          *ModelReference `protobuf:"bytes,2,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	AllVersions    bool            `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
 }
 
-func (x *ExperimentSubscriptionRequest) Reset() {
-	*x = ExperimentSubscriptionRequest{}
+func (x *ModelStatusRequest) Reset() {
+	*x = ModelStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3288,13 +3889,13 @@ func (x *ExperimentSubscriptionRequest) Reset() {
 	}
 }
 
-func (x *ExperimentSubscriptionRequest) String() string {
+func (x *ModelStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentSubscriptionRequest) ProtoMessage() {}
+func (*ModelStatusRequest) ProtoMessage() {}
 
-func (x *ExperimentSubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3306,33 +3907,43 @@ func (x *ExperimentSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ExperimentSubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelStatusRequest.ProtoReflect.Descriptor instead.
+func (*ModelStatusRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ExperimentSubscriptionRequest) GetSubscriberName() string {
+func (x *ModelStatusRequest) GetSubscriberName() string {
 	if x != nil {
 		return x.SubscriberName
 	}
 	return ""
 }
 
-type ExperimentStatusResponse struct {
+func (x *ModelStatusRequest) GetModel() *ModelReference {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *ModelStatusRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+type ServerNotifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExperimentName    string          `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
-	Active            bool            `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
-	CandidatesReady   bool            `protobuf:"varint,3,opt,name=candidatesReady,proto3" json:"candidatesReady,omitempty"`
-	MirrorReady       bool            `protobuf:"varint,4,opt,name=mirrorReady,proto3" json:"mirrorReady,omitempty"`
-	StatusDescription string          `protobuf:"bytes,5,opt,name=statusDescription,proto3" json:"statusDescription,omitempty"`
-	KubernetesMeta    *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Servers     []*ServerNotify `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	IsFirstSync bool            `protobuf:"varint,2,opt,name=isFirstSync,proto3" json:"isFirstSync,omitempty"`
 }
 
-func (x *ExperimentStatusResponse) Reset() {
-	*x = ExperimentStatusResponse{}
+func (x *ServerNotifyRequest) Reset() {
+	*x = ServerNotifyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3340,13 +3951,13 @@ func (x *ExperimentStatusResponse) Reset() {
 	}
 }
 
-func (x *ExperimentStatusResponse) String() string {
+func (x *ServerNotifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentStatusResponse) ProtoMessage() {}
+func (*ServerNotifyRequest) ProtoMessage() {}
 
-func (x *ExperimentStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerNotifyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3358,78 +3969,135 @@ func (x *ExperimentStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentStatusResponse.ProtoReflect.Descriptor instead.
-func (*ExperimentStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerNotifyRequest.ProtoReflect.Descriptor instead.
+func (*ServerNotifyRequest) Descriptor() ([]byte, []int) {
 	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ExperimentStatusResponse) GetExperimentName() string {
+func (x *ServerNotifyRequest) GetServers() []*ServerNotify {
 	if x != nil {
-		return x.ExperimentName
+		return x.Servers
 	}
-	return ""
+	return nil
 }
 
-func (x *ExperimentStatusResponse) GetActive() bool {
+func (x *ServerNotifyRequest) GetIsFirstSync() bool {
 	if x != nil {
-		return x.Active
+		return x.IsFirstSync
 	}
 	return false
 }
 
-func (x *ExperimentStatusResponse) GetCandidatesReady() bool {
-	if x != nil {
-		return x.CandidatesReady
-	}
-	return false
-}
+type ServerNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ExperimentStatusResponse) GetMirrorReady() bool {
-	if x != nil {
-		return x.MirrorReady
+	Name             string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedReplicas uint32          `protobuf:"varint,2,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
+	MinReplicas      uint32          `protobuf:"varint,5,opt,name=minReplicas,proto3" json:"minReplicas,omitempty"`
+	MaxReplicas      uint32          `protobuf:"varint,6,opt,name=maxReplicas,proto3" json:"maxReplicas,omitempty"`
+	Shared           bool            `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+	KubernetesMeta   *KubernetesMeta `protobuf:"bytes,4,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+}
+
+func (x *ServerNotify) Reset() {
+	*x = ServerNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ExperimentStatusResponse) GetStatusDescription() string {
+func (x *ServerNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerNotify) ProtoMessage() {}
+
+func (x *ServerNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerNotify.ProtoReflect.Descriptor instead.
+func (*ServerNotify) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ServerNotify) GetName() string {
 	if x != nil {
-		return x.StatusDescription
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExperimentStatusResponse) GetKubernetesMeta() *KubernetesMeta {
+func (x *ServerNotify) GetExpectedReplicas() uint32 {
+	if x != nil {
+		return x.ExpectedReplicas
+	}
+	return 0
+}
+
+func (x *ServerNotify) GetMinReplicas() uint32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *ServerNotify) GetMaxReplicas() uint32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *ServerNotify) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *ServerNotify) GetKubernetesMeta() *KubernetesMeta {
 	if x != nil {
 		return x.KubernetesMeta
 	}
 	return nil
 }
 
-type LoadPipelineRequest struct {
+type ServerNotifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 }
 
-func (x *LoadPipelineRequest) Reset() {
-	*x = LoadPipelineRequest{}
+func (x *ServerNotifyResponse) Reset() {
+	*x = ServerNotifyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadPipelineRequest) String() string {
+func (x *ServerNotifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadPipelineRequest) ProtoMessage() {}
+func (*ServerNotifyResponse) ProtoMessage() {}
 
-func (x *LoadPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[43]
+func (x *ServerNotifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3440,44 +4108,36 @@ func (x *LoadPipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadPipelineRequest.ProtoReflect.Descriptor instead.
-func (*LoadPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *LoadPipelineRequest) GetPipeline() *Pipeline {
-	if x != nil {
-		return x.Pipeline
-	}
-	return nil
+// Deprecated: Use ServerNotifyResponse.ProtoReflect.Descriptor instead.
+func (*ServerNotifyResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{44}
 }
 
-type ExperimentStatusRequest struct {
+type ServerSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all experiments
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
 }
 
-func (x *ExperimentStatusRequest) Reset() {
-	*x = ExperimentStatusRequest{}
+func (x *ServerSubscriptionRequest) Reset() {
+	*x = ServerSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExperimentStatusRequest) String() string {
+func (x *ServerSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExperimentStatusRequest) ProtoMessage() {}
+func (*ServerSubscriptionRequest) ProtoMessage() {}
 
-func (x *ExperimentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[44]
+func (x *ServerSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3488,59 +4148,101 @@ func (x *ExperimentStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExperimentStatusRequest.ProtoReflect.Descriptor instead.
-func (*ExperimentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ServerSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ServerSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ExperimentStatusRequest) GetSubscriberName() string {
+func (x *ServerSubscriptionRequest) GetSubscriberName() string {
 	if x != nil {
 		return x.SubscriberName
 	}
 	return ""
 }
 
-func (x *ExperimentStatusRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+type StartExperimentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+}
+
+func (x *StartExperimentRequest) Reset() {
+	*x = StartExperimentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type Pipeline struct {
+func (x *StartExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartExperimentRequest) ProtoMessage() {}
+
+func (x *StartExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartExperimentRequest.ProtoReflect.Descriptor instead.
+func (*StartExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StartExperimentRequest) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type Experiment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uid             string          `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Version         uint32          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	Steps           []*PipelineStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
-	Output          *PipelineOutput `protobuf:"bytes,5,opt,name=output,proto3,oneof" json:"output,omitempty"`
-	KubernetesMeta  *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
-	Input           *PipelineInput  `protobuf:"bytes,7,opt,name=input,proto3,oneof" json:"input,omitempty"`
-	DataflowSpec    *DataflowSpec   `protobuf:"bytes,8,opt,name=dataflowSpec,proto3,oneof" json:"dataflowSpec,omitempty"`   // Dataflow specific config
-	AllowCycles     bool            `protobuf:"varint,9,opt,name=allowCycles,proto3" json:"allowCycles,omitempty"`          // Allow cycles in the pipeline
-	MaxStepRevisits uint32          `protobuf:"varint,10,opt,name=maxStepRevisits,proto3" json:"maxStepRevisits,omitempty"` // Max number of times a step can be revisited
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default    *string                `protobuf:"bytes,2,opt,name=default,proto3,oneof" json:"default,omitempty"`
+	Candidates []*ExperimentCandidate `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// deprecated: use mirrors
+	Mirror         *ExperimentMirror   `protobuf:"bytes,4,opt,name=mirror,proto3,oneof" json:"mirror,omitempty"`
+	Config         *ExperimentConfig   `protobuf:"bytes,5,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	KubernetesMeta *KubernetesMeta     `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	ResourceType   ResourceType        `protobuf:"varint,7,opt,name=resourceType,proto3,enum=seldon.mlops.scheduler.ResourceType" json:"resourceType,omitempty"`
+	Bandit         *BanditConfig       `protobuf:"bytes,8,opt,name=bandit,proto3,oneof" json:"bandit,omitempty"`
+	Mirrors        []*ExperimentMirror `protobuf:"bytes,9,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	// capture the responses of the default model and its mirrors to kafka so they can be compared offline
+	CaptureResponses bool `protobuf:"varint,10,opt,name=captureResponses,proto3" json:"captureResponses,omitempty"`
 }
 
-func (x *Pipeline) Reset() {
-	*x = Pipeline{}
+func (x *Experiment) Reset() {
+	*x = Experiment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[45]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pipeline) String() string {
+func (x *Experiment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pipeline) ProtoMessage() {}
+func (*Experiment) ProtoMessage() {}
 
-func (x *Pipeline) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[45]
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3551,113 +4253,106 @@ func (x *Pipeline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
-func (*Pipeline) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Pipeline) GetName() string {
+func (x *Experiment) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Pipeline) GetUid() string {
-	if x != nil {
-		return x.Uid
+func (x *Experiment) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
 	}
 	return ""
 }
 
-func (x *Pipeline) GetVersion() uint32 {
+func (x *Experiment) GetCandidates() []*ExperimentCandidate {
 	if x != nil {
-		return x.Version
+		return x.Candidates
 	}
-	return 0
+	return nil
 }
 
-func (x *Pipeline) GetSteps() []*PipelineStep {
+func (x *Experiment) GetMirror() *ExperimentMirror {
 	if x != nil {
-		return x.Steps
+		return x.Mirror
 	}
 	return nil
 }
 
-func (x *Pipeline) GetOutput() *PipelineOutput {
+func (x *Experiment) GetConfig() *ExperimentConfig {
 	if x != nil {
-		return x.Output
+		return x.Config
 	}
 	return nil
 }
 
-func (x *Pipeline) GetKubernetesMeta() *KubernetesMeta {
+func (x *Experiment) GetKubernetesMeta() *KubernetesMeta {
 	if x != nil {
 		return x.KubernetesMeta
 	}
 	return nil
 }
 
-func (x *Pipeline) GetInput() *PipelineInput {
+func (x *Experiment) GetResourceType() ResourceType {
 	if x != nil {
-		return x.Input
+		return x.ResourceType
 	}
-	return nil
+	return ResourceType_MODEL
 }
 
-func (x *Pipeline) GetDataflowSpec() *DataflowSpec {
+func (x *Experiment) GetBandit() *BanditConfig {
 	if x != nil {
-		return x.DataflowSpec
+		return x.Bandit
 	}
 	return nil
 }
 
-func (x *Pipeline) GetAllowCycles() bool {
+func (x *Experiment) GetMirrors() []*ExperimentMirror {
 	if x != nil {
-		return x.AllowCycles
+		return x.Mirrors
 	}
-	return false
+	return nil
 }
 
-func (x *Pipeline) GetMaxStepRevisits() uint32 {
+func (x *Experiment) GetCaptureResponses() bool {
 	if x != nil {
-		return x.MaxStepRevisits
+		return x.CaptureResponses
 	}
-	return 0
+	return false
 }
 
-type PipelineStep struct {
+type ExperimentConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Inputs       []string            `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	JoinWindowMs *uint32             `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"`                                                                            // Join window millisecs, some nonzero default (TBD)
-	TensorMap    map[string]string   `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
-	InputsJoin   PipelineStep_JoinOp `protobuf:"varint,5,opt,name=inputsJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"inputsJoin,omitempty"`
-	Triggers     []string            `protobuf:"bytes,6,rep,name=triggers,proto3" json:"triggers,omitempty"`
-	TriggersJoin PipelineStep_JoinOp `protobuf:"varint,7,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"triggersJoin,omitempty"`
-	Batch        *Batch              `protobuf:"bytes,8,opt,name=batch,proto3" json:"batch,omitempty"`
+	StickySessions bool `protobuf:"varint,1,opt,name=stickySessions,proto3" json:"stickySessions,omitempty"`
 }
 
-func (x *PipelineStep) Reset() {
-	*x = PipelineStep{}
+func (x *ExperimentConfig) Reset() {
+	*x = ExperimentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[46]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineStep) String() string {
+func (x *ExperimentConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStep) ProtoMessage() {}
+func (*ExperimentConfig) ProtoMessage() {}
 
-func (x *PipelineStep) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[46]
+func (x *ExperimentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,93 +4363,111 @@ func (x *PipelineStep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStep.ProtoReflect.Descriptor instead.
-func (*PipelineStep) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ExperimentConfig.ProtoReflect.Descriptor instead.
+func (*ExperimentConfig) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PipelineStep) GetName() string {
+func (x *ExperimentConfig) GetStickySessions() bool {
 	if x != nil {
-		return x.Name
+		return x.StickySessions
 	}
-	return ""
+	return false
 }
 
-func (x *PipelineStep) GetInputs() []string {
-	if x != nil {
-		return x.Inputs
-	}
-	return nil
+type ExperimentCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// requests matching all of these rules are sent to this candidate ahead of the weighted split
+	Matches []*ExperimentMatch `protobuf:"bytes,3,rep,name=matches,proto3" json:"matches,omitempty"`
 }
 
-func (x *PipelineStep) GetJoinWindowMs() uint32 {
-	if x != nil && x.JoinWindowMs != nil {
-		return *x.JoinWindowMs
+func (x *ExperimentCandidate) Reset() {
+	*x = ExperimentCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *PipelineStep) GetTensorMap() map[string]string {
-	if x != nil {
-		return x.TensorMap
-	}
-	return nil
+func (x *ExperimentCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PipelineStep) GetInputsJoin() PipelineStep_JoinOp {
-	if x != nil {
-		return x.InputsJoin
+func (*ExperimentCandidate) ProtoMessage() {}
+
+func (x *ExperimentCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return PipelineStep_INNER
+	return mi.MessageOf(x)
 }
 
-func (x *PipelineStep) GetTriggers() []string {
+// Deprecated: Use ExperimentCandidate.ProtoReflect.Descriptor instead.
+func (*ExperimentCandidate) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExperimentCandidate) GetName() string {
 	if x != nil {
-		return x.Triggers
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PipelineStep) GetTriggersJoin() PipelineStep_JoinOp {
+func (x *ExperimentCandidate) GetWeight() uint32 {
 	if x != nil {
-		return x.TriggersJoin
+		return x.Weight
 	}
-	return PipelineStep_INNER
+	return 0
 }
 
-func (x *PipelineStep) GetBatch() *Batch {
+func (x *ExperimentCandidate) GetMatches() []*ExperimentMatch {
 	if x != nil {
-		return x.Batch
+		return x.Matches
 	}
 	return nil
 }
 
-type Batch struct {
+// ExperimentMatch matches either a request header or a query parameter by an exact value or a regex
+type ExperimentMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Size     *uint32 `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
-	WindowMs *uint32 `protobuf:"varint,2,opt,name=windowMs,proto3,oneof" json:"windowMs,omitempty"`
+	Header         string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	QueryParameter string `protobuf:"bytes,2,opt,name=queryParameter,proto3" json:"queryParameter,omitempty"`
+	Exact          string `protobuf:"bytes,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	Regex          string `protobuf:"bytes,4,opt,name=regex,proto3" json:"regex,omitempty"`
 }
 
-func (x *Batch) Reset() {
-	*x = Batch{}
+func (x *ExperimentMatch) Reset() {
+	*x = ExperimentMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[47]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Batch) String() string {
+func (x *ExperimentMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Batch) ProtoMessage() {}
+func (*ExperimentMatch) ProtoMessage() {}
 
-func (x *Batch) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[47]
+func (x *ExperimentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3765,55 +4478,65 @@ func (x *Batch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
-func (*Batch) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ExperimentMatch.ProtoReflect.Descriptor instead.
+func (*ExperimentMatch) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Batch) GetSize() uint32 {
-	if x != nil && x.Size != nil {
-		return *x.Size
+func (x *ExperimentMatch) GetHeader() string {
+	if x != nil {
+		return x.Header
 	}
-	return 0
+	return ""
 }
 
-func (x *Batch) GetWindowMs() uint32 {
-	if x != nil && x.WindowMs != nil {
-		return *x.WindowMs
+func (x *ExperimentMatch) GetQueryParameter() string {
+	if x != nil {
+		return x.QueryParameter
 	}
-	return 0
+	return ""
 }
 
-type PipelineInput struct {
+func (x *ExperimentMatch) GetExact() string {
+	if x != nil {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *ExperimentMatch) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+type ExperimentMirror struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExternalInputs   []string             `protobuf:"bytes,1,rep,name=externalInputs,proto3" json:"externalInputs,omitempty"`
-	ExternalTriggers []string             `protobuf:"bytes,2,rep,name=externalTriggers,proto3" json:"externalTriggers,omitempty"`
-	JoinWindowMs     *uint32              `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
-	JoinType         PipelineInput_JoinOp `protobuf:"varint,4,opt,name=joinType,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"joinType,omitempty"`
-	TriggersJoin     PipelineInput_JoinOp `protobuf:"varint,5,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"triggersJoin,omitempty"`
-	TensorMap        map[string]string    `protobuf:"bytes,6,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Percent uint32 `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
 }
 
-func (x *PipelineInput) Reset() {
-	*x = PipelineInput{}
+func (x *ExperimentMirror) Reset() {
+	*x = ExperimentMirror{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[48]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineInput) String() string {
+func (x *ExperimentMirror) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineInput) ProtoMessage() {}
+func (*ExperimentMirror) ProtoMessage() {}
 
-func (x *PipelineInput) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[48]
+func (x *ExperimentMirror) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3824,81 +4547,54 @@ func (x *PipelineInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineInput.ProtoReflect.Descriptor instead.
-func (*PipelineInput) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ExperimentMirror.ProtoReflect.Descriptor instead.
+func (*ExperimentMirror) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *PipelineInput) GetExternalInputs() []string {
+func (x *ExperimentMirror) GetName() string {
 	if x != nil {
-		return x.ExternalInputs
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PipelineInput) GetExternalTriggers() []string {
+func (x *ExperimentMirror) GetPercent() uint32 {
 	if x != nil {
-		return x.ExternalTriggers
-	}
-	return nil
-}
-
-func (x *PipelineInput) GetJoinWindowMs() uint32 {
-	if x != nil && x.JoinWindowMs != nil {
-		return *x.JoinWindowMs
+		return x.Percent
 	}
 	return 0
 }
 
-func (x *PipelineInput) GetJoinType() PipelineInput_JoinOp {
-	if x != nil {
-		return x.JoinType
-	}
-	return PipelineInput_INNER
-}
-
-func (x *PipelineInput) GetTriggersJoin() PipelineInput_JoinOp {
-	if x != nil {
-		return x.TriggersJoin
-	}
-	return PipelineInput_INNER
-}
-
-func (x *PipelineInput) GetTensorMap() map[string]string {
-	if x != nil {
-		return x.TensorMap
-	}
-	return nil
-}
-
-type PipelineOutput struct {
+// BanditConfig adapts the candidate weights over time to the rewards submitted for the requests each served
+type BanditConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Steps        []string              `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
-	JoinWindowMs uint32                `protobuf:"varint,2,opt,name=joinWindowMs,proto3" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
-	StepsJoin    PipelineOutput_JoinOp `protobuf:"varint,3,opt,name=stepsJoin,proto3,enum=seldon.mlops.scheduler.PipelineOutput_JoinOp" json:"stepsJoin,omitempty"`
-	TensorMap    map[string]string     `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	Strategy BanditConfig_BanditStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=seldon.mlops.scheduler.BanditConfig_BanditStrategy" json:"strategy,omitempty"`
+	// share of traffic split evenly across all candidates to keep exploring, for epsilon-greedy
+	Epsilon               float32 `protobuf:"fixed32,2,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	UpdateIntervalSeconds uint32  `protobuf:"varint,3,opt,name=updateIntervalSeconds,proto3" json:"updateIntervalSeconds,omitempty"`
 }
 
-func (x *PipelineOutput) Reset() {
-	*x = PipelineOutput{}
+func (x *BanditConfig) Reset() {
+	*x = BanditConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[49]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineOutput) String() string {
+func (x *BanditConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineOutput) ProtoMessage() {}
+func (*BanditConfig) ProtoMessage() {}
 
-func (x *PipelineOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[49]
+func (x *BanditConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3909,62 +4605,60 @@ func (x *PipelineOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineOutput.ProtoReflect.Descriptor instead.
-func (*PipelineOutput) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use BanditConfig.ProtoReflect.Descriptor instead.
+func (*BanditConfig) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *PipelineOutput) GetSteps() []string {
+func (x *BanditConfig) GetStrategy() BanditConfig_BanditStrategy {
 	if x != nil {
-		return x.Steps
+		return x.Strategy
 	}
-	return nil
+	return BanditConfig_EPSILON_GREEDY
 }
 
-func (x *PipelineOutput) GetJoinWindowMs() uint32 {
+func (x *BanditConfig) GetEpsilon() float32 {
 	if x != nil {
-		return x.JoinWindowMs
+		return x.Epsilon
 	}
 	return 0
 }
 
-func (x *PipelineOutput) GetStepsJoin() PipelineOutput_JoinOp {
-	if x != nil {
-		return x.StepsJoin
-	}
-	return PipelineOutput_INNER
-}
-
-func (x *PipelineOutput) GetTensorMap() map[string]string {
+func (x *BanditConfig) GetUpdateIntervalSeconds() uint32 {
 	if x != nil {
-		return x.TensorMap
+		return x.UpdateIntervalSeconds
 	}
-	return nil
+	return 0
 }
 
-type LoadPipelineResponse struct {
+type ExperimentFeedbackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ExperimentName string `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	RequestId      string `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// reward in the range [0,1]
+	Reward float64 `protobuf:"fixed64,3,opt,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (x *LoadPipelineResponse) Reset() {
-	*x = LoadPipelineResponse{}
+func (x *ExperimentFeedbackRequest) Reset() {
+	*x = ExperimentFeedbackRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[50]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadPipelineResponse) String() string {
+func (x *ExperimentFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadPipelineResponse) ProtoMessage() {}
+func (*ExperimentFeedbackRequest) ProtoMessage() {}
 
-func (x *LoadPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[50]
+func (x *ExperimentFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3975,81 +4669,55 @@ func (x *LoadPipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadPipelineResponse.ProtoReflect.Descriptor instead.
-func (*LoadPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ExperimentFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{53}
 }
 
-type UnloadPipelineRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *UnloadPipelineRequest) Reset() {
-	*x = UnloadPipelineRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[51]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExperimentFeedbackRequest) GetExperimentName() string {
+	if x != nil {
+		return x.ExperimentName
 	}
+	return ""
 }
 
-func (x *UnloadPipelineRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnloadPipelineRequest) ProtoMessage() {}
-
-func (x *UnloadPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[51]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExperimentFeedbackRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnloadPipelineRequest.ProtoReflect.Descriptor instead.
-func (*UnloadPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{51}
+	return ""
 }
 
-func (x *UnloadPipelineRequest) GetName() string {
+func (x *ExperimentFeedbackRequest) GetReward() float64 {
 	if x != nil {
-		return x.Name
+		return x.Reward
 	}
-	return ""
+	return 0
 }
 
-type UnloadPipelineResponse struct {
+type ExperimentFeedbackResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnloadPipelineResponse) Reset() {
-	*x = UnloadPipelineResponse{}
+func (x *ExperimentFeedbackResponse) Reset() {
+	*x = ExperimentFeedbackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[52]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnloadPipelineResponse) String() string {
+func (x *ExperimentFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnloadPipelineResponse) ProtoMessage() {}
+func (*ExperimentFeedbackResponse) ProtoMessage() {}
 
-func (x *UnloadPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[52]
+func (x *ExperimentFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4060,38 +4728,41 @@ func (x *UnloadPipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnloadPipelineResponse.ProtoReflect.Descriptor instead.
-func (*UnloadPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ExperimentFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*ExperimentFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{54}
 }
 
-type PipelineStatusRequest struct {
+// ExperimentRequest records the candidate of a bandit experiment that served a request, so the feedback for the
+// request is added to the rewards of that candidate
+type ExperimentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all pipelines
-	AllVersions    bool    `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
+	ExperimentName string `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	RequestId      string `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// the model or pipeline candidate that served the request
+	Candidate string `protobuf:"bytes,3,opt,name=candidate,proto3" json:"candidate,omitempty"`
 }
 
-func (x *PipelineStatusRequest) Reset() {
-	*x = PipelineStatusRequest{}
+func (x *ExperimentRequest) Reset() {
+	*x = ExperimentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[53]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineStatusRequest) String() string {
+func (x *ExperimentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStatusRequest) ProtoMessage() {}
+func (*ExperimentRequest) ProtoMessage() {}
 
-func (x *PipelineStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[53]
+func (x *ExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4102,59 +4773,57 @@ func (x *PipelineStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStatusRequest.ProtoReflect.Descriptor instead.
-func (*PipelineStatusRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ExperimentRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *PipelineStatusRequest) GetSubscriberName() string {
+func (x *ExperimentRequest) GetExperimentName() string {
 	if x != nil {
-		return x.SubscriberName
+		return x.ExperimentName
 	}
 	return ""
 }
 
-func (x *PipelineStatusRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ExperimentRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *PipelineStatusRequest) GetAllVersions() bool {
+func (x *ExperimentRequest) GetCandidate() string {
 	if x != nil {
-		return x.AllVersions
+		return x.Candidate
 	}
-	return false
+	return ""
 }
 
-type PipelineSubscriptionRequest struct {
+type ExperimentRequestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName    string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`        //Name of the subscription caller
-	SubscriberIp      string `protobuf:"bytes,2,opt,name=subscriberIp,proto3" json:"subscriberIp,omitempty"`            // IP of the subscription caller
-	IsPipelineGateway bool   `protobuf:"varint,3,opt,name=isPipelineGateway,proto3" json:"isPipelineGateway,omitempty"` // If true, the subscription is for a pipeline gateway
+	Requests []*ExperimentRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *PipelineSubscriptionRequest) Reset() {
-	*x = PipelineSubscriptionRequest{}
+func (x *ExperimentRequestsRequest) Reset() {
+	*x = ExperimentRequestsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[54]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineSubscriptionRequest) String() string {
+func (x *ExperimentRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineSubscriptionRequest) ProtoMessage() {}
+func (*ExperimentRequestsRequest) ProtoMessage() {}
 
-func (x *PipelineSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[54]
+func (x *ExperimentRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4165,60 +4834,41 @@ func (x *PipelineSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*PipelineSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *PipelineSubscriptionRequest) GetSubscriberName() string {
-	if x != nil {
-		return x.SubscriberName
-	}
-	return ""
-}
-
-func (x *PipelineSubscriptionRequest) GetSubscriberIp() string {
-	if x != nil {
-		return x.SubscriberIp
-	}
-	return ""
+// Deprecated: Use ExperimentRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *PipelineSubscriptionRequest) GetIsPipelineGateway() bool {
+func (x *ExperimentRequestsRequest) GetRequests() []*ExperimentRequest {
 	if x != nil {
-		return x.IsPipelineGateway
+		return x.Requests
 	}
-	return false
+	return nil
 }
 
-type PipelineStatusResponse struct {
+type ExperimentRequestsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PipelineName string                                   `protobuf:"bytes,1,opt,name=pipelineName,proto3" json:"pipelineName,omitempty"`
-	Versions     []*PipelineWithState                     `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
-	Timestamp    uint64                                   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Operation    PipelineStatusResponse_PipelineOperation `protobuf:"varint,4,opt,name=operation,proto3,enum=seldon.mlops.scheduler.PipelineStatusResponse_PipelineOperation" json:"operation,omitempty"`
 }
 
-func (x *PipelineStatusResponse) Reset() {
-	*x = PipelineStatusResponse{}
+func (x *ExperimentRequestsResponse) Reset() {
+	*x = ExperimentRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[55]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineStatusResponse) String() string {
+func (x *ExperimentRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineStatusResponse) ProtoMessage() {}
+func (*ExperimentRequestsResponse) ProtoMessage() {}
 
-func (x *PipelineStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[55]
+func (x *ExperimentRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4229,65 +4879,74 @@ func (x *PipelineStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineStatusResponse.ProtoReflect.Descriptor instead.
-func (*PipelineStatusResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ExperimentRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ExperimentRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *PipelineStatusResponse) GetPipelineName() string {
-	if x != nil {
-		return x.PipelineName
-	}
-	return ""
+type StartExperimentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PipelineStatusResponse) GetVersions() []*PipelineWithState {
-	if x != nil {
-		return x.Versions
+func (x *StartExperimentResponse) Reset() {
+	*x = StartExperimentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PipelineStatusResponse) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+func (x *StartExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PipelineStatusResponse) GetOperation() PipelineStatusResponse_PipelineOperation {
-	if x != nil {
-		return x.Operation
+func (*StartExperimentResponse) ProtoMessage() {}
+
+func (x *StartExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return PipelineStatusResponse_PipelineOperationUnknown
+	return mi.MessageOf(x)
 }
 
-type PipelineWithState struct {
+// Deprecated: Use StartExperimentResponse.ProtoReflect.Descriptor instead.
+func (*StartExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{58}
+}
+
+type StopExperimentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pipeline *Pipeline             `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	State    *PipelineVersionState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PipelineWithState) Reset() {
-	*x = PipelineWithState{}
+func (x *StopExperimentRequest) Reset() {
+	*x = StopExperimentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[56]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineWithState) String() string {
+func (x *StopExperimentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineWithState) ProtoMessage() {}
+func (*StopExperimentRequest) ProtoMessage() {}
 
-func (x *PipelineWithState) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[56]
+func (x *StopExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4298,56 +4957,41 @@ func (x *PipelineWithState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineWithState.ProtoReflect.Descriptor instead.
-func (*PipelineWithState) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *PipelineWithState) GetPipeline() *Pipeline {
-	if x != nil {
-		return x.Pipeline
-	}
-	return nil
+// Deprecated: Use StopExperimentRequest.ProtoReflect.Descriptor instead.
+func (*StopExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PipelineWithState) GetState() *PipelineVersionState {
+func (x *StopExperimentRequest) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type PipelineVersionState struct {
+type StopExperimentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PipelineVersion     uint32                              `protobuf:"varint,1,opt,name=pipelineVersion,proto3" json:"pipelineVersion,omitempty"`
-	Status              PipelineVersionState_PipelineStatus `protobuf:"varint,2,opt,name=status,proto3,enum=seldon.mlops.scheduler.PipelineVersionState_PipelineStatus" json:"status,omitempty"`
-	Reason              string                              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	LastChangeTimestamp *timestamppb.Timestamp              `protobuf:"bytes,4,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
-	ModelsReady         bool                                `protobuf:"varint,5,opt,name=modelsReady,proto3" json:"modelsReady,omitempty"`
-	PipelineGwStatus    PipelineVersionState_PipelineStatus `protobuf:"varint,6,opt,name=pipelineGwStatus,proto3,enum=seldon.mlops.scheduler.PipelineVersionState_PipelineStatus" json:"pipelineGwStatus,omitempty"`
-	PipelineGwReason    string                              `protobuf:"bytes,7,opt,name=pipelineGwReason,proto3" json:"pipelineGwReason,omitempty"`
 }
 
-func (x *PipelineVersionState) Reset() {
-	*x = PipelineVersionState{}
+func (x *StopExperimentResponse) Reset() {
+	*x = StopExperimentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[57]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PipelineVersionState) String() string {
+func (x *StopExperimentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PipelineVersionState) ProtoMessage() {}
+func (*StopExperimentResponse) ProtoMessage() {}
 
-func (x *PipelineVersionState) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[57]
+func (x *StopExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4358,85 +5002,88 @@ func (x *PipelineVersionState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PipelineVersionState.ProtoReflect.Descriptor instead.
-func (*PipelineVersionState) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use StopExperimentResponse.ProtoReflect.Descriptor instead.
+func (*StopExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *PipelineVersionState) GetPipelineVersion() uint32 {
-	if x != nil {
-		return x.PipelineVersion
-	}
-	return 0
-}
+type ExperimentSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *PipelineVersionState) GetStatus() PipelineVersionState_PipelineStatus {
-	if x != nil {
-		return x.Status
-	}
-	return PipelineVersionState_PipelineStatusUnknown
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
 }
 
-func (x *PipelineVersionState) GetReason() string {
-	if x != nil {
-		return x.Reason
+func (x *ExperimentSubscriptionRequest) Reset() {
+	*x = ExperimentSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PipelineVersionState) GetLastChangeTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastChangeTimestamp
-	}
-	return nil
+func (x *ExperimentSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PipelineVersionState) GetModelsReady() bool {
-	if x != nil {
-		return x.ModelsReady
+func (*ExperimentSubscriptionRequest) ProtoMessage() {}
+
+func (x *ExperimentSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *PipelineVersionState) GetPipelineGwStatus() PipelineVersionState_PipelineStatus {
-	if x != nil {
-		return x.PipelineGwStatus
-	}
-	return PipelineVersionState_PipelineStatusUnknown
+// Deprecated: Use ExperimentSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *PipelineVersionState) GetPipelineGwReason() string {
+func (x *ExperimentSubscriptionRequest) GetSubscriberName() string {
 	if x != nil {
-		return x.PipelineGwReason
+		return x.SubscriberName
 	}
 	return ""
 }
 
-type SchedulerStatusRequest struct {
+type ExperimentStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	ExperimentName    string          `protobuf:"bytes,1,opt,name=experimentName,proto3" json:"experimentName,omitempty"`
+	Active            bool            `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	CandidatesReady   bool            `protobuf:"varint,3,opt,name=candidatesReady,proto3" json:"candidatesReady,omitempty"`
+	MirrorReady       bool            `protobuf:"varint,4,opt,name=mirrorReady,proto3" json:"mirrorReady,omitempty"`
+	StatusDescription string          `protobuf:"bytes,5,opt,name=statusDescription,proto3" json:"statusDescription,omitempty"`
+	KubernetesMeta    *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
 }
 
-func (x *SchedulerStatusRequest) Reset() {
-	*x = SchedulerStatusRequest{}
+func (x *ExperimentStatusResponse) Reset() {
+	*x = ExperimentStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[58]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SchedulerStatusRequest) String() string {
+func (x *ExperimentStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchedulerStatusRequest) ProtoMessage() {}
+func (*ExperimentStatusResponse) ProtoMessage() {}
 
-func (x *SchedulerStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[58]
+func (x *ExperimentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4447,90 +5094,78 @@ func (x *SchedulerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchedulerStatusRequest.ProtoReflect.Descriptor instead.
-func (*SchedulerStatusRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ExperimentStatusResponse.ProtoReflect.Descriptor instead.
+func (*ExperimentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *SchedulerStatusRequest) GetSubscriberName() string {
+func (x *ExperimentStatusResponse) GetExperimentName() string {
 	if x != nil {
-		return x.SubscriberName
+		return x.ExperimentName
 	}
 	return ""
 }
 
-type SchedulerStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ApplicationVersion string `protobuf:"bytes,1,opt,name=applicationVersion,proto3" json:"applicationVersion,omitempty"`
-}
-
-func (x *SchedulerStatusResponse) Reset() {
-	*x = SchedulerStatusResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[59]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExperimentStatusResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
+	return false
 }
 
-func (x *SchedulerStatusResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExperimentStatusResponse) GetCandidatesReady() bool {
+	if x != nil {
+		return x.CandidatesReady
+	}
+	return false
 }
 
-func (*SchedulerStatusResponse) ProtoMessage() {}
-
-func (x *SchedulerStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[59]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ExperimentStatusResponse) GetMirrorReady() bool {
+	if x != nil {
+		return x.MirrorReady
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use SchedulerStatusResponse.ProtoReflect.Descriptor instead.
-func (*SchedulerStatusResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{59}
+func (x *ExperimentStatusResponse) GetStatusDescription() string {
+	if x != nil {
+		return x.StatusDescription
+	}
+	return ""
 }
 
-func (x *SchedulerStatusResponse) GetApplicationVersion() string {
+func (x *ExperimentStatusResponse) GetKubernetesMeta() *KubernetesMeta {
 	if x != nil {
-		return x.ApplicationVersion
+		return x.KubernetesMeta
 	}
-	return ""
+	return nil
 }
 
-type ControlPlaneSubscriptionRequest struct {
+type LoadPipelineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 }
 
-func (x *ControlPlaneSubscriptionRequest) Reset() {
-	*x = ControlPlaneSubscriptionRequest{}
+func (x *LoadPipelineRequest) Reset() {
+	*x = LoadPipelineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[60]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ControlPlaneSubscriptionRequest) String() string {
+func (x *LoadPipelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlPlaneSubscriptionRequest) ProtoMessage() {}
+func (*LoadPipelineRequest) ProtoMessage() {}
 
-func (x *ControlPlaneSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[60]
+func (x *LoadPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4541,43 +5176,44 @@ func (x *ControlPlaneSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlPlaneSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*ControlPlaneSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use LoadPipelineRequest.ProtoReflect.Descriptor instead.
+func (*LoadPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ControlPlaneSubscriptionRequest) GetSubscriberName() string {
+func (x *LoadPipelineRequest) GetPipeline() *Pipeline {
 	if x != nil {
-		return x.SubscriberName
+		return x.Pipeline
 	}
-	return ""
+	return nil
 }
 
-type ControlPlaneResponse struct {
+type ExperimentStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event ControlPlaneResponse_Event `protobuf:"varint,1,opt,name=event,proto3,enum=seldon.mlops.scheduler.ControlPlaneResponse_Event" json:"event,omitempty"`
+	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all experiments
 }
 
-func (x *ControlPlaneResponse) Reset() {
-	*x = ControlPlaneResponse{}
+func (x *ExperimentStatusRequest) Reset() {
+	*x = ExperimentStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[61]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ControlPlaneResponse) String() string {
+func (x *ExperimentStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlPlaneResponse) ProtoMessage() {}
+func (*ExperimentStatusRequest) ProtoMessage() {}
 
-func (x *ControlPlaneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[61]
+func (x *ExperimentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4588,48 +5224,63 @@ func (x *ControlPlaneResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlPlaneResponse.ProtoReflect.Descriptor instead.
-func (*ControlPlaneResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ExperimentStatusRequest.ProtoReflect.Descriptor instead.
+func (*ExperimentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ControlPlaneResponse) GetEvent() ControlPlaneResponse_Event {
+func (x *ExperimentStatusRequest) GetSubscriberName() string {
 	if x != nil {
-		return x.Event
+		return x.SubscriberName
 	}
-	return ControlPlaneResponse_UNKNOWN_EVENT
+	return ""
 }
 
-type ModelUpdateMessage struct {
+func (x *ExperimentStatusRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type Pipeline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Op        ModelUpdateMessage_ModelOperation `protobuf:"varint,1,opt,name=op,proto3,enum=seldon.mlops.scheduler.ModelUpdateMessage_ModelOperation" json:"op,omitempty"`
-	Model     string                            `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
-	Version   uint32                            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	Uid       string                            `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
-	Timestamp uint64                            `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Stream    string                            `protobuf:"bytes,6,opt,name=stream,proto3" json:"stream,omitempty"`
+	Name            string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid             string          `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Version         uint32          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Steps           []*PipelineStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	Output          *PipelineOutput `protobuf:"bytes,5,opt,name=output,proto3,oneof" json:"output,omitempty"`
+	KubernetesMeta  *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+	Input           *PipelineInput  `protobuf:"bytes,7,opt,name=input,proto3,oneof" json:"input,omitempty"`
+	DataflowSpec    *DataflowSpec   `protobuf:"bytes,8,opt,name=dataflowSpec,proto3,oneof" json:"dataflowSpec,omitempty"`   // Dataflow specific config
+	AllowCycles     bool            `protobuf:"varint,9,opt,name=allowCycles,proto3" json:"allowCycles,omitempty"`          // Allow cycles in the pipeline
+	MaxStepRevisits uint32          `protobuf:"varint,10,opt,name=maxStepRevisits,proto3" json:"maxStepRevisits,omitempty"` // Max number of times a step can be revisited
+	Auth            *AuthSpec       `protobuf:"bytes,11,opt,name=auth,proto3,oneof" json:"auth,omitempty"`                  // callers allowed to infer with the pipeline, checked against their JWT by envoy
+	RateLimit       *RateLimitSpec  `protobuf:"bytes,12,opt,name=rateLimit,proto3,oneof" json:"rateLimit,omitempty"`        // rate of inference requests allowed by envoy
+	Routing         *RoutingSpec    `protobuf:"bytes,13,opt,name=routing,proto3,oneof" json:"routing,omitempty"`            // timeouts, retries, outlier detection and load balancing by envoy
+	Ensemble        *EnsembleSpec   `protobuf:"bytes,14,opt,name=ensemble,proto3,oneof" json:"ensemble,omitempty"`          // steps inferred in parallel by the pipeline gateway, without kafka
 }
 
-func (x *ModelUpdateMessage) Reset() {
-	*x = ModelUpdateMessage{}
+func (x *Pipeline) Reset() {
+	*x = Pipeline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[62]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModelUpdateMessage) String() string {
+func (x *Pipeline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelUpdateMessage) ProtoMessage() {}
+func (*Pipeline) ProtoMessage() {}
 
-func (x *ModelUpdateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[62]
+func (x *Pipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4640,80 +5291,141 @@ func (x *ModelUpdateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelUpdateMessage.ProtoReflect.Descriptor instead.
-func (*ModelUpdateMessage) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
+func (*Pipeline) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ModelUpdateMessage) GetOp() ModelUpdateMessage_ModelOperation {
+func (x *Pipeline) GetName() string {
 	if x != nil {
-		return x.Op
+		return x.Name
 	}
-	return ModelUpdateMessage_Unknown
+	return ""
 }
 
-func (x *ModelUpdateMessage) GetModel() string {
+func (x *Pipeline) GetUid() string {
 	if x != nil {
-		return x.Model
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *ModelUpdateMessage) GetVersion() uint32 {
+func (x *Pipeline) GetVersion() uint32 {
 	if x != nil {
 		return x.Version
 	}
 	return 0
 }
 
-func (x *ModelUpdateMessage) GetUid() string {
+func (x *Pipeline) GetSteps() []*PipelineStep {
 	if x != nil {
-		return x.Uid
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *ModelUpdateMessage) GetTimestamp() uint64 {
+func (x *Pipeline) GetOutput() *PipelineOutput {
 	if x != nil {
-		return x.Timestamp
+		return x.Output
+	}
+	return nil
+}
+
+func (x *Pipeline) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
+func (x *Pipeline) GetInput() *PipelineInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Pipeline) GetDataflowSpec() *DataflowSpec {
+	if x != nil {
+		return x.DataflowSpec
+	}
+	return nil
+}
+
+func (x *Pipeline) GetAllowCycles() bool {
+	if x != nil {
+		return x.AllowCycles
+	}
+	return false
+}
+
+func (x *Pipeline) GetMaxStepRevisits() uint32 {
+	if x != nil {
+		return x.MaxStepRevisits
 	}
 	return 0
 }
 
-func (x *ModelUpdateMessage) GetStream() string {
+func (x *Pipeline) GetAuth() *AuthSpec {
 	if x != nil {
-		return x.Stream
+		return x.Auth
 	}
-	return ""
+	return nil
 }
 
-type ModelUpdateStatusMessage struct {
+func (x *Pipeline) GetRateLimit() *RateLimitSpec {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Pipeline) GetRouting() *RoutingSpec {
+	if x != nil {
+		return x.Routing
+	}
+	return nil
+}
+
+func (x *Pipeline) GetEnsemble() *EnsembleSpec {
+	if x != nil {
+		return x.Ensemble
+	}
+	return nil
+}
+
+type PipelineStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Update  *ModelUpdateMessage `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
-	Success bool                `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Reason  string              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Inputs       []string            `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	JoinWindowMs *uint32             `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"`                                                                            // Join window millisecs, some nonzero default (TBD)
+	TensorMap    map[string]string   `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+	InputsJoin   PipelineStep_JoinOp `protobuf:"varint,5,opt,name=inputsJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"inputsJoin,omitempty"`
+	Triggers     []string            `protobuf:"bytes,6,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	TriggersJoin PipelineStep_JoinOp `protobuf:"varint,7,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineStep_JoinOp" json:"triggersJoin,omitempty"`
+	Batch        *Batch              `protobuf:"bytes,8,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *ModelUpdateStatusMessage) Reset() {
-	*x = ModelUpdateStatusMessage{}
+func (x *PipelineStep) Reset() {
+	*x = PipelineStep{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[63]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModelUpdateStatusMessage) String() string {
+func (x *PipelineStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelUpdateStatusMessage) ProtoMessage() {}
+func (*PipelineStep) ProtoMessage() {}
 
-func (x *ModelUpdateStatusMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[63]
+func (x *PipelineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4724,55 +5436,93 @@ func (x *ModelUpdateStatusMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelUpdateStatusMessage.ProtoReflect.Descriptor instead.
-func (*ModelUpdateStatusMessage) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use PipelineStep.ProtoReflect.Descriptor instead.
+func (*PipelineStep) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ModelUpdateStatusMessage) GetUpdate() *ModelUpdateMessage {
+func (x *PipelineStep) GetName() string {
 	if x != nil {
-		return x.Update
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStep) GetInputs() []string {
+	if x != nil {
+		return x.Inputs
 	}
 	return nil
 }
 
-func (x *ModelUpdateStatusMessage) GetSuccess() bool {
+func (x *PipelineStep) GetJoinWindowMs() uint32 {
+	if x != nil && x.JoinWindowMs != nil {
+		return *x.JoinWindowMs
+	}
+	return 0
+}
+
+func (x *PipelineStep) GetTensorMap() map[string]string {
 	if x != nil {
-		return x.Success
+		return x.TensorMap
 	}
-	return false
+	return nil
 }
 
-func (x *ModelUpdateStatusMessage) GetReason() string {
+func (x *PipelineStep) GetInputsJoin() PipelineStep_JoinOp {
 	if x != nil {
-		return x.Reason
+		return x.InputsJoin
 	}
-	return ""
+	return PipelineStep_INNER
 }
 
-type ModelUpdateStatusResponse struct {
+func (x *PipelineStep) GetTriggers() []string {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+func (x *PipelineStep) GetTriggersJoin() PipelineStep_JoinOp {
+	if x != nil {
+		return x.TriggersJoin
+	}
+	return PipelineStep_INNER
+}
+
+func (x *PipelineStep) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type Batch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Size     *uint32 `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	WindowMs *uint32 `protobuf:"varint,2,opt,name=windowMs,proto3,oneof" json:"windowMs,omitempty"`
 }
 
-func (x *ModelUpdateStatusResponse) Reset() {
-	*x = ModelUpdateStatusResponse{}
+func (x *Batch) Reset() {
+	*x = Batch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[64]
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModelUpdateStatusResponse) String() string {
+func (x *Batch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelUpdateStatusResponse) ProtoMessage() {}
+func (*Batch) ProtoMessage() {}
 
-func (x *ModelUpdateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[64]
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4783,951 +5533,2699 @@ func (x *ModelUpdateStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelUpdateStatusResponse.ProtoReflect.Descriptor instead.
-func (*ModelUpdateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{67}
 }
 
-var File_mlops_scheduler_scheduler_proto protoreflect.FileDescriptor
+func (x *Batch) GetSize() uint32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
 
-var file_mlops_scheduler_scheduler_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x16, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x22, 0xdc, 0x02, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65,
-	0x63, 0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
-	0x63, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f,
-	0x77, 0x53, 0x70, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x22,
-	0xd3, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x17, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x02, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f,
-	0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x4f,
-	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f,
-	0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x88, 0x05, 0x0a,
-	0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x0f,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x0d, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x0d, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x25, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63,
-	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x59, 0x0a, 0x10,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x48, 0x05, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6c, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x70, 0x65,
-	0x63, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x33,
-	0x0a, 0x03, 0x6c, 0x6c, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6c, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x03,
-	0x6c, 0x6c, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73, 0x70, 0x65,
-	0x63, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x39, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x22, 0x6e, 0x0a,
-	0x07, 0x4c, 0x6c, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x22, 0xb8, 0x01,
-	0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x6c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x4c,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x43, 0x0a, 0x06, 0x74, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06, 0x74, 0x72,
-	0x69, 0x74, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x41, 0x0a, 0x15, 0x4d, 0x4c, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61,
-	0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x11, 0x54,
-	0x72, 0x69, 0x74, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x33, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x43, 0x50, 0x55,
-	0x52, 0x03, 0x63, 0x70, 0x75, 0x22, 0x31, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x43,
-	0x50, 0x55, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x4b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x7d, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x11, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x13, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01,
-	0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xfc, 0x02, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x65, 0x65,
-	0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b,
-	0x65, 0x65, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x58, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x4d, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0f,
-	0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02,
-	0x22, 0xa4, 0x04, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x41, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x66,
-	0x6e, 0x88, 0x01, 0x01, 0x1a, 0x70, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x6e, 0x22, 0x87, 0x05, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13,
-	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x52, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x77, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x47, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x47, 0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf1, 0x01,
-	0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x0f, 0x0a,
-	0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x14,
-	0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x53, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x09, 0x12, 0x12, 0x0a,
-	0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10,
-	0x0a, 0x22, 0xd0, 0x03, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c,
-	0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x6f, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64,
-	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x65, 0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x55,
-	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14,
-	0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x69,
-	0x6e, 0x67, 0x10, 0x0c, 0x22, 0x5f, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb3, 0x04, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12,
-	0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x36, 0x0a,
-	0x16, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6e,
-	0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x22, 0x6c, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10,
-	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e,
-	0x66, 0x6f, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x04, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0xf6, 0x01, 0x0a, 0x16,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x49, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x49, 0x64, 0x78, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61,
-	0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0f, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x12, 0x32, 0x0a, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
-	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x22, 0xab, 0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x41, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88,
-	0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x77,
-	0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74,
-	0x53, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
-	0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+func (x *Batch) GetWindowMs() uint32 {
+	if x != nil && x.WindowMs != nil {
+		return *x.WindowMs
+	}
+	return 0
+}
+
+type PipelineInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalInputs   []string             `protobuf:"bytes,1,rep,name=externalInputs,proto3" json:"externalInputs,omitempty"`
+	ExternalTriggers []string             `protobuf:"bytes,2,rep,name=externalTriggers,proto3" json:"externalTriggers,omitempty"`
+	JoinWindowMs     *uint32              `protobuf:"varint,3,opt,name=joinWindowMs,proto3,oneof" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
+	JoinType         PipelineInput_JoinOp `protobuf:"varint,4,opt,name=joinType,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"joinType,omitempty"`
+	TriggersJoin     PipelineInput_JoinOp `protobuf:"varint,5,opt,name=triggersJoin,proto3,enum=seldon.mlops.scheduler.PipelineInput_JoinOp" json:"triggersJoin,omitempty"`
+	TensorMap        map[string]string    `protobuf:"bytes,6,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+}
+
+func (x *PipelineInput) Reset() {
+	*x = PipelineInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineInput) ProtoMessage() {}
+
+func (x *PipelineInput) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineInput.ProtoReflect.Descriptor instead.
+func (*PipelineInput) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PipelineInput) GetExternalInputs() []string {
+	if x != nil {
+		return x.ExternalInputs
+	}
+	return nil
+}
+
+func (x *PipelineInput) GetExternalTriggers() []string {
+	if x != nil {
+		return x.ExternalTriggers
+	}
+	return nil
+}
+
+func (x *PipelineInput) GetJoinWindowMs() uint32 {
+	if x != nil && x.JoinWindowMs != nil {
+		return *x.JoinWindowMs
+	}
+	return 0
+}
+
+func (x *PipelineInput) GetJoinType() PipelineInput_JoinOp {
+	if x != nil {
+		return x.JoinType
+	}
+	return PipelineInput_INNER
+}
+
+func (x *PipelineInput) GetTriggersJoin() PipelineInput_JoinOp {
+	if x != nil {
+		return x.TriggersJoin
+	}
+	return PipelineInput_INNER
+}
+
+func (x *PipelineInput) GetTensorMap() map[string]string {
+	if x != nil {
+		return x.TensorMap
+	}
+	return nil
+}
+
+type PipelineOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps        []string              `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	JoinWindowMs uint32                `protobuf:"varint,2,opt,name=joinWindowMs,proto3" json:"joinWindowMs,omitempty"` // Join window millisecs for output, default 0
+	StepsJoin    PipelineOutput_JoinOp `protobuf:"varint,3,opt,name=stepsJoin,proto3,enum=seldon.mlops.scheduler.PipelineOutput_JoinOp" json:"stepsJoin,omitempty"`
+	TensorMap    map[string]string     `protobuf:"bytes,4,rep,name=tensorMap,proto3" json:"tensorMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional map of tensor name mappings
+}
+
+func (x *PipelineOutput) Reset() {
+	*x = PipelineOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineOutput) ProtoMessage() {}
+
+func (x *PipelineOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineOutput.ProtoReflect.Descriptor instead.
+func (*PipelineOutput) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PipelineOutput) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PipelineOutput) GetJoinWindowMs() uint32 {
+	if x != nil {
+		return x.JoinWindowMs
+	}
+	return 0
+}
+
+func (x *PipelineOutput) GetStepsJoin() PipelineOutput_JoinOp {
+	if x != nil {
+		return x.StepsJoin
+	}
+	return PipelineOutput_INNER
+}
+
+func (x *PipelineOutput) GetTensorMap() map[string]string {
+	if x != nil {
+		return x.TensorMap
+	}
+	return nil
+}
+
+type LoadPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LoadPipelineResponse) Reset() {
+	*x = LoadPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadPipelineResponse) ProtoMessage() {}
+
+func (x *LoadPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadPipelineResponse.ProtoReflect.Descriptor instead.
+func (*LoadPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{70}
+}
+
+type UnloadPipelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UnloadPipelineRequest) Reset() {
+	*x = UnloadPipelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnloadPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadPipelineRequest) ProtoMessage() {}
+
+func (x *UnloadPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadPipelineRequest.ProtoReflect.Descriptor instead.
+func (*UnloadPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UnloadPipelineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnloadPipelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnloadPipelineResponse) Reset() {
+	*x = UnloadPipelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnloadPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadPipelineResponse) ProtoMessage() {}
+
+func (x *UnloadPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadPipelineResponse.ProtoReflect.Descriptor instead.
+func (*UnloadPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{72}
+}
+
+type PipelineStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string  `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"` // Leave empty for all pipelines
+	AllVersions    bool    `protobuf:"varint,3,opt,name=allVersions,proto3" json:"allVersions,omitempty"`
+}
+
+func (x *PipelineStatusRequest) Reset() {
+	*x = PipelineStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStatusRequest) ProtoMessage() {}
+
+func (x *PipelineStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStatusRequest.ProtoReflect.Descriptor instead.
+func (*PipelineStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PipelineStatusRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+func (x *PipelineStatusRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PipelineStatusRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+type PipelineSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName    string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`        //Name of the subscription caller
+	SubscriberIp      string `protobuf:"bytes,2,opt,name=subscriberIp,proto3" json:"subscriberIp,omitempty"`            // IP of the subscription caller
+	IsPipelineGateway bool   `protobuf:"varint,3,opt,name=isPipelineGateway,proto3" json:"isPipelineGateway,omitempty"` // If true, the subscription is for a pipeline gateway
+}
+
+func (x *PipelineSubscriptionRequest) Reset() {
+	*x = PipelineSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineSubscriptionRequest) ProtoMessage() {}
+
+func (x *PipelineSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PipelineSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PipelineSubscriptionRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+func (x *PipelineSubscriptionRequest) GetSubscriberIp() string {
+	if x != nil {
+		return x.SubscriberIp
+	}
+	return ""
+}
+
+func (x *PipelineSubscriptionRequest) GetIsPipelineGateway() bool {
+	if x != nil {
+		return x.IsPipelineGateway
+	}
+	return false
+}
+
+type PipelineStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineName string                                   `protobuf:"bytes,1,opt,name=pipelineName,proto3" json:"pipelineName,omitempty"`
+	Versions     []*PipelineWithState                     `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	Timestamp    uint64                                   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Operation    PipelineStatusResponse_PipelineOperation `protobuf:"varint,4,opt,name=operation,proto3,enum=seldon.mlops.scheduler.PipelineStatusResponse_PipelineOperation" json:"operation,omitempty"`
+}
+
+func (x *PipelineStatusResponse) Reset() {
+	*x = PipelineStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStatusResponse) ProtoMessage() {}
+
+func (x *PipelineStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStatusResponse.ProtoReflect.Descriptor instead.
+func (*PipelineStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PipelineStatusResponse) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+func (x *PipelineStatusResponse) GetVersions() []*PipelineWithState {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *PipelineStatusResponse) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PipelineStatusResponse) GetOperation() PipelineStatusResponse_PipelineOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return PipelineStatusResponse_PipelineOperationUnknown
+}
+
+type PipelineWithState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline *Pipeline             `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	State    *PipelineVersionState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *PipelineWithState) Reset() {
+	*x = PipelineWithState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineWithState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineWithState) ProtoMessage() {}
+
+func (x *PipelineWithState) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineWithState.ProtoReflect.Descriptor instead.
+func (*PipelineWithState) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PipelineWithState) GetPipeline() *Pipeline {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *PipelineWithState) GetState() *PipelineVersionState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type PipelineVersionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineVersion     uint32                              `protobuf:"varint,1,opt,name=pipelineVersion,proto3" json:"pipelineVersion,omitempty"`
+	Status              PipelineVersionState_PipelineStatus `protobuf:"varint,2,opt,name=status,proto3,enum=seldon.mlops.scheduler.PipelineVersionState_PipelineStatus" json:"status,omitempty"`
+	Reason              string                              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastChangeTimestamp *timestamppb.Timestamp              `protobuf:"bytes,4,opt,name=lastChangeTimestamp,proto3" json:"lastChangeTimestamp,omitempty"`
+	ModelsReady         bool                                `protobuf:"varint,5,opt,name=modelsReady,proto3" json:"modelsReady,omitempty"`
+	PipelineGwStatus    PipelineVersionState_PipelineStatus `protobuf:"varint,6,opt,name=pipelineGwStatus,proto3,enum=seldon.mlops.scheduler.PipelineVersionState_PipelineStatus" json:"pipelineGwStatus,omitempty"`
+	PipelineGwReason    string                              `protobuf:"bytes,7,opt,name=pipelineGwReason,proto3" json:"pipelineGwReason,omitempty"`
+}
+
+func (x *PipelineVersionState) Reset() {
+	*x = PipelineVersionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineVersionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineVersionState) ProtoMessage() {}
+
+func (x *PipelineVersionState) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineVersionState.ProtoReflect.Descriptor instead.
+func (*PipelineVersionState) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PipelineVersionState) GetPipelineVersion() uint32 {
+	if x != nil {
+		return x.PipelineVersion
+	}
+	return 0
+}
+
+func (x *PipelineVersionState) GetStatus() PipelineVersionState_PipelineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PipelineVersionState_PipelineStatusUnknown
+}
+
+func (x *PipelineVersionState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PipelineVersionState) GetLastChangeTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChangeTimestamp
+	}
+	return nil
+}
+
+func (x *PipelineVersionState) GetModelsReady() bool {
+	if x != nil {
+		return x.ModelsReady
+	}
+	return false
+}
+
+func (x *PipelineVersionState) GetPipelineGwStatus() PipelineVersionState_PipelineStatus {
+	if x != nil {
+		return x.PipelineGwStatus
+	}
+	return PipelineVersionState_PipelineStatusUnknown
+}
+
+func (x *PipelineVersionState) GetPipelineGwReason() string {
+	if x != nil {
+		return x.PipelineGwReason
+	}
+	return ""
+}
+
+type SchedulerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"`
+}
+
+func (x *SchedulerStatusRequest) Reset() {
+	*x = SchedulerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerStatusRequest) ProtoMessage() {}
+
+func (x *SchedulerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerStatusRequest.ProtoReflect.Descriptor instead.
+func (*SchedulerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SchedulerStatusRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+type SchedulerStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationVersion string `protobuf:"bytes,1,opt,name=applicationVersion,proto3" json:"applicationVersion,omitempty"`
+}
+
+func (x *SchedulerStatusResponse) Reset() {
+	*x = SchedulerStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerStatusResponse) ProtoMessage() {}
+
+func (x *SchedulerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerStatusResponse.ProtoReflect.Descriptor instead.
+func (*SchedulerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SchedulerStatusResponse) GetApplicationVersion() string {
+	if x != nil {
+		return x.ApplicationVersion
+	}
+	return ""
+}
+
+type ExplainScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposed model, it does not need to exist in the scheduler
+	Model *Model `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+}
+
+func (x *ExplainScheduleRequest) Reset() {
+	*x = ExplainScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainScheduleRequest) ProtoMessage() {}
+
+func (x *ExplainScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ExplainScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExplainScheduleRequest) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+type ExplainScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelName string `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
+	Version   uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// server the model would be scheduled on, empty if it can not be scheduled
+	ServerName  string  `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	ReplicaIdxs []int32 `protobuf:"varint,4,rep,packed,name=replicaIdxs,proto3" json:"replicaIdxs,omitempty"`
+	Reason      string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// decisions for all servers, with accepted servers first in the order they would be tried
+	Servers []*ServerScheduleDecision `protobuf:"bytes,6,rep,name=servers,proto3" json:"servers,omitempty"`
+	// soft constraints checked against the chosen replicas
+	ReplicaSetFilters []*FilterDecision `protobuf:"bytes,7,rep,name=replicaSetFilters,proto3" json:"replicaSetFilters,omitempty"`
+}
+
+func (x *ExplainScheduleResponse) Reset() {
+	*x = ExplainScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainScheduleResponse) ProtoMessage() {}
+
+func (x *ExplainScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ExplainScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ExplainScheduleResponse) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *ExplainScheduleResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ExplainScheduleResponse) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ExplainScheduleResponse) GetReplicaIdxs() []int32 {
+	if x != nil {
+		return x.ReplicaIdxs
+	}
+	return nil
+}
+
+func (x *ExplainScheduleResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExplainScheduleResponse) GetServers() []*ServerScheduleDecision {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *ExplainScheduleResponse) GetReplicaSetFilters() []*FilterDecision {
+	if x != nil {
+		return x.ReplicaSetFilters
+	}
+	return nil
+}
+
+type ServerScheduleDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string            `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	Accepted   bool              `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Filters    []*FilterDecision `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	// decisions for the server replicas if the server was accepted, with accepted replicas first in sorted order
+	Replicas []*ReplicaScheduleDecision `protobuf:"bytes,4,rep,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *ServerScheduleDecision) Reset() {
+	*x = ServerScheduleDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerScheduleDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerScheduleDecision) ProtoMessage() {}
+
+func (x *ServerScheduleDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerScheduleDecision.ProtoReflect.Descriptor instead.
+func (*ServerScheduleDecision) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ServerScheduleDecision) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerScheduleDecision) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ServerScheduleDecision) GetFilters() []*FilterDecision {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ServerScheduleDecision) GetReplicas() []*ReplicaScheduleDecision {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+type ReplicaScheduleDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicaIdx int32             `protobuf:"varint,1,opt,name=replicaIdx,proto3" json:"replicaIdx,omitempty"`
+	Accepted   bool              `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Filters    []*FilterDecision `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *ReplicaScheduleDecision) Reset() {
+	*x = ReplicaScheduleDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaScheduleDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaScheduleDecision) ProtoMessage() {}
+
+func (x *ReplicaScheduleDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaScheduleDecision.ProtoReflect.Descriptor instead.
+func (*ReplicaScheduleDecision) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ReplicaScheduleDecision) GetReplicaIdx() int32 {
+	if x != nil {
+		return x.ReplicaIdx
+	}
+	return 0
+}
+
+func (x *ReplicaScheduleDecision) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ReplicaScheduleDecision) GetFilters() []*FilterDecision {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type FilterDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Accepted    bool   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FilterDecision) Reset() {
+	*x = FilterDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterDecision) ProtoMessage() {}
+
+func (x *FilterDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterDecision.ProtoReflect.Descriptor instead.
+func (*FilterDecision) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *FilterDecision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FilterDecision) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *FilterDecision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ControlPlaneSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriberName string `protobuf:"bytes,1,opt,name=subscriberName,proto3" json:"subscriberName,omitempty"` //Name of the subscription caller
+}
+
+func (x *ControlPlaneSubscriptionRequest) Reset() {
+	*x = ControlPlaneSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlPlaneSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlPlaneSubscriptionRequest) ProtoMessage() {}
+
+func (x *ControlPlaneSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlPlaneSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ControlPlaneSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ControlPlaneSubscriptionRequest) GetSubscriberName() string {
+	if x != nil {
+		return x.SubscriberName
+	}
+	return ""
+}
+
+type ControlPlaneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event ControlPlaneResponse_Event `protobuf:"varint,1,opt,name=event,proto3,enum=seldon.mlops.scheduler.ControlPlaneResponse_Event" json:"event,omitempty"`
+}
+
+func (x *ControlPlaneResponse) Reset() {
+	*x = ControlPlaneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlPlaneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlPlaneResponse) ProtoMessage() {}
+
+func (x *ControlPlaneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlPlaneResponse.ProtoReflect.Descriptor instead.
+func (*ControlPlaneResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ControlPlaneResponse) GetEvent() ControlPlaneResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return ControlPlaneResponse_UNKNOWN_EVENT
+}
+
+type ModelUpdateMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op        ModelUpdateMessage_ModelOperation `protobuf:"varint,1,opt,name=op,proto3,enum=seldon.mlops.scheduler.ModelUpdateMessage_ModelOperation" json:"op,omitempty"`
+	Model     string                            `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Version   uint32                            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Uid       string                            `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Timestamp uint64                            `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Stream    string                            `protobuf:"bytes,6,opt,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (x *ModelUpdateMessage) Reset() {
+	*x = ModelUpdateMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelUpdateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelUpdateMessage) ProtoMessage() {}
+
+func (x *ModelUpdateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelUpdateMessage.ProtoReflect.Descriptor instead.
+func (*ModelUpdateMessage) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ModelUpdateMessage) GetOp() ModelUpdateMessage_ModelOperation {
+	if x != nil {
+		return x.Op
+	}
+	return ModelUpdateMessage_Unknown
+}
+
+func (x *ModelUpdateMessage) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ModelUpdateMessage) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ModelUpdateMessage) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ModelUpdateMessage) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ModelUpdateMessage) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type ModelUpdateStatusMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Update  *ModelUpdateMessage `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
+	Success bool                `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string              `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ModelUpdateStatusMessage) Reset() {
+	*x = ModelUpdateStatusMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelUpdateStatusMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelUpdateStatusMessage) ProtoMessage() {}
+
+func (x *ModelUpdateStatusMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelUpdateStatusMessage.ProtoReflect.Descriptor instead.
+func (*ModelUpdateStatusMessage) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ModelUpdateStatusMessage) GetUpdate() *ModelUpdateMessage {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *ModelUpdateStatusMessage) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ModelUpdateStatusMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModelUpdateStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ModelUpdateStatusResponse) Reset() {
+	*x = ModelUpdateStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_scheduler_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelUpdateStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelUpdateStatusResponse) ProtoMessage() {}
+
+func (x *ModelUpdateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_scheduler_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelUpdateStatusResponse.ProtoReflect.Descriptor instead.
+func (*ModelUpdateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_scheduler_proto_rawDescGZIP(), []int{89}
+}
+
+var File_mlops_scheduler_scheduler_proto protoreflect.FileDescriptor
+
+var file_mlops_scheduler_scheduler_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c,
+	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x22, 0xdc, 0x02, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f,
+	0x77, 0x53, 0x70, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x22,
+	0xd3, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x02, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x66, 0x6c,
+	0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x30, 0x0a, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x59,
+	0x4e, 0x43, 0x48, 0x52, 0x4f, 0x4e, 0x4f, 0x55, 0x53, 0x10, 0x01, 0x22, 0xc5, 0x01, 0x0a, 0x0c,
+	0x45, 0x6e, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x52, 0x0a, 0x0b,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x73, 0x65, 0x6d,
+	0x62, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x43,
+	0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a,
+	0x04, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4f, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e, 0x43, 0x41, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55,
+	0x4c, 0x10, 0x03, 0x22, 0xd2, 0x04, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x6f, 0x67,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x48, 0x01, 0x52, 0x0e, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x70, 0x65, 0x63, 0x48, 0x02,
+	0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x09, 0x72, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x48, 0x03, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x48, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x40, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0d, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x55, 0x52, 0x10,
+	0x02, 0x22, 0x67, 0x0a, 0x0f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72,
+	0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x22, 0xd8, 0x03, 0x0a, 0x0b, 0x52,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x5d, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x48, 0x01, 0x52, 0x10, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x54, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x73,
+	0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x41, 0x53, 0x54,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52,
+	0x49, 0x4e, 0x47, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41,
+	0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x03, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf6, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x65, 0x72,
+	0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x73, 0x12, 0x29, 0x0a, 0x0d, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0d, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xd2,
+	0x01, 0x0a, 0x14, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x35, 0x78, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x35, 0x78, 0x78, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x62, 0x61, 0x73,
+	0x65, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x54, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x74,
+	0x65, 0x70, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73, 0x74, 0x65, 0x70, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x74,
+	0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0xbc, 0x07, 0x0a, 0x09, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x0f, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x0d, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x25, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x59, 0x0a, 0x10, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x05,
+	0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x48, 0x06, 0x52,
+	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x70, 0x65, 0x63, 0x48, 0x07,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x48, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x09, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x70, 0x65, 0x63, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x33, 0x0a, 0x03, 0x6c, 0x6c, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6c, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x48,
+	0x00, 0x52, 0x03, 0x6c, 0x6c, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
+	0x73, 0x70, 0x65, 0x63, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x50, 0x0a, 0x0c, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x57, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x22,
+	0x6e, 0x0a, 0x07, 0x4c, 0x6c, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x66, 0x22,
+	0xb8, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x08, 0x6d, 0x6c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x4d, 0x4c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6c, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x43, 0x0a, 0x06, 0x74, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x74, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06,
+	0x74, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x41, 0x0a, 0x15, 0x4d, 0x4c,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a,
+	0x11, 0x54, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x33, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x74, 0x6f, 0x6e, 0x43,
+	0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x22, 0x31, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x74, 0x6f,
+	0x6e, 0x43, 0x50, 0x55, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x0e, 0x4b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x7d, 0x0a, 0x0d, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x11, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x13, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x6f, 0x61,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f,
+	0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xba, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
 	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75,
-	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x16, 0x0a, 0x14,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x16, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13,
+	0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xfc, 0x02, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6b,
+	0x65, 0x65, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6b, 0x65, 0x65, 0x70, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x58, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x10, 0x02, 0x22, 0xa4, 0x04, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x66,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44,
+	0x65, 0x66, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x70, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x6e, 0x22, 0x8c, 0x06, 0x0a, 0x0b, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x52, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x47, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x47, 0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2c, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a,
+	0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x04, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x06, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x64, 0x44, 0x6f, 0x77, 0x6e, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10, 0x0a, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x85, 0x03, 0x0a, 0x12, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x4d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0x6c, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x10, 0x03,
+	0x22, 0xd0, 0x03, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x10,
+	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x55, 0x6e,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x55,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x10, 0x0c, 0x22, 0x5f, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb3, 0x04, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
 	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xee, 0x03, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x61, 0x6e,
-	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x2c,
+	0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x36, 0x0a, 0x16,
+	0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6e, 0x75,
+	0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x22, 0x6c, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x66,
+	0x6f, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x04, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x49, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x64, 0x78, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6f,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22,
+	0xab, 0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41,
+	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x77, 0x0a,
+	0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xac, 0x05, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x01, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x48, 0x03, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x04, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x64,
+	0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
 	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
 	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x48, 0x03, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
-	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e,
-	0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x40, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62,
+	0x61, 0x6e, 0x64, 0x69, 0x74, 0x22, 0x3a, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x69,
+	0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x78, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0x40, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72,
 	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x1d, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x64,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
-	0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2c, 0x0a,
-	0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x6b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x6b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d,
-	0x65, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x42, 0x61,
+	0x6e, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x73,
 	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x63, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x04,
-	0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74,
-	0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x65, 0x70,
+	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x3b, 0x0a, 0x0e, 0x42,
+	0x61, 0x6e, 0x64, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a,
+	0x0e, 0x45, 0x50, 0x53, 0x49, 0x4c, 0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x44, 0x59, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x48, 0x4f, 0x4d, 0x50, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x41,
+	0x4d, 0x50, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x22, 0x79, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x77, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x62, 0x0a, 0x19, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64,
 	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52,
-	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x1c,
+	0x0a, 0x1a, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
+	0x0a, 0x1d, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53,
+	0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
 	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00,
-	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x6b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x01, 0x52, 0x0e, 0x6b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x88, 0x01, 0x01,
-	0x12, 0x40, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x02, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70,
-	0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x48, 0x03,
-	0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x88, 0x01,
-	0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x79, 0x63,
-	0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61,
-	0x78, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x5f,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c,
-	0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x22, 0x9d, 0x04, 0x0a, 0x0c, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x6f, 0x69,
-	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x09,
-	0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
 	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12,
-	0x4b, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70,
-	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x3c,
-	0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06,
-	0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0x57, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22,
-	0xf4, 0x03, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c, 0x6a,
-	0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x48,
-	0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x08,
-	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0c, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x52, 0x0a, 0x09, 0x74, 0x65,
-	0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x3c,
-	0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06,
-	0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x4d, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a, 0x6f, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a, 0x6f, 0x69, 0x6e,
-	0x12, 0x53, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x73,
-	0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73,
-	0x6f, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a,
-	0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x22, 0x16, 0x0a, 0x14,
-	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x63, 0x0a, 0x17, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xf6, 0x06, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x15,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x97, 0x01, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x70, 0x12, 0x2c, 0x0a,
-	0x11, 0x69, 0x73, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0xdc, 0x02, 0x0a, 0x16,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
 	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x5e, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x40, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x53,
+	0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x48, 0x01,
+	0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
 	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x59, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x3c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x02, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f,
+	0x77, 0x53, 0x70, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65,
+	0x63, 0x48, 0x03, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65,
+	0x63, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x79, 0x63,
+	0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73,
+	0x12, 0x39, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x70, 0x65, 0x63,
+	0x48, 0x04, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x09, 0x72,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x48, 0x05, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x48, 0x06, 0x52, 0x07, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x08, 0x65, 0x6e, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x48, 0x07, 0x52, 0x08, 0x65, 0x6e, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x65, 0x6e, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x65, 0x22, 0x9d, 0x04, 0x0a, 0x0c, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0c,
+	0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
 	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x42,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0xb1, 0x05, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d,
+	0x61, 0x70, 0x12, 0x4b, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x4f, 0x70, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x0c,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0x57, 0x0a, 0x05, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x4d, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x48, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f,
+	0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52,
+	0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x52, 0x0a,
+	0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61,
+	0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x0e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a,
+	0x6f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x4a,
+	0x6f, 0x69, 0x6e, 0x12, 0x53, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x54,
+	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74,
+	0x65, 0x6e, 0x73, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x73,
+	0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x06, 0x4a, 0x6f, 0x69, 0x6e, 0x4f, 0x70,
+	0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f,
+	0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x02, 0x22,
+	0x16, 0x0a, 0x14, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83,
+	0x01, 0x0a, 0x15, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x1b, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x70,
+	0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0xdc,
+	0x02, 0x0a, 0x16, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x5e, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
 	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6c, 0x61, 0x73,
-	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x79, 0x12, 0x67, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x77,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x47, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47,
-	0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x6b, 0x6e,
 	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12,
-	0x11, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x64, 0x79,
-	0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10, 0x05, 0x12, 0x17, 0x0a,
-	0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x10, 0x07, 0x12, 0x17,
-	0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x69, 0x6e, 0x67, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x10, 0x09, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x1f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc9,
-	0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x67, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a,
-	0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x53, 0x10, 0x02,
-	0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e,
-	0x45, 0x53, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x50,
-	0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x04, 0x22, 0x8e, 0x02, 0x0a, 0x12, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x49, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x22, 0x35, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x22, 0x90, 0x01, 0x0a, 0x18,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x22, 0x95, 0x01,
+	0x0a, 0x11, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb1, 0x05, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
 	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1b,
-	0x0a, 0x19, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x27, 0x0a, 0x0c, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4d,
-	0x4f, 0x44, 0x45, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49,
-	0x4e, 0x45, 0x10, 0x01, 0x32, 0xd9, 0x11, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x12, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x62, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x67, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x47, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x3b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x47, 0x77, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x47, 0x77, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x0e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x10, 0x05,
+	0x12, 0x17, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x10,
+	0x07, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x09, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0xcb, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x78, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x64, 0x78, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a,
+	0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
 	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
-	0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e,
-	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x17, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x49, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x49, 0x64, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x12, 0x40, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x1f, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x73, 0x65, 0x6c,
 	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x55, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2d, 0x2e, 0x73,
+	0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x11,
+	0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x4c, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x49, 0x50,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x53, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x4e, 0x44,
+	0x5f, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x04, 0x22, 0x8e,
+	0x02, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x39, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6f, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x35, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x02, 0x22,
+	0x90, 0x01, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x06,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
 	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a,
-	0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x27, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x49,
+	0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x32, 0xcd, 0x14, 0x0a, 0x09, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
 	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
 	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x73, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
-	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x65, 0x6c,
+	0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x28, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x6c,
 	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65,
-	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71,
+	0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
 	0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
-	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f,
+	0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x73, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
 	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x88, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35,
-	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x17,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0f, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x73,
 	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x7e, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x32, 0x2e, 0x73, 0x65, 0x6c,
-	0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x79, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
-	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7c, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65,
+	0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x79, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x88, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
 	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x15,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x37, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x33, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c,
+	0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7e, 0x0a, 0x13, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x32, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70,
+	0x73, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x73,
+	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x31,
 	0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
-	0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63,
-	0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x12, 0x37, 0x2e,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x69, 0x6f, 0x2f,
+	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5742,205 +8240,275 @@ func file_mlops_scheduler_scheduler_proto_rawDescGZIP() []byte {
 	return file_mlops_scheduler_scheduler_proto_rawDescData
 }
 
-var file_mlops_scheduler_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_mlops_scheduler_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_mlops_scheduler_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_mlops_scheduler_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_mlops_scheduler_scheduler_proto_goTypes = []any{
 	(ResourceType)(0),                             // 0: seldon.mlops.scheduler.ResourceType
-	(ModelStatusResponse_ModelOperation)(0),       // 1: seldon.mlops.scheduler.ModelStatusResponse.ModelOperation
-	(ModelStatus_ModelState)(0),                   // 2: seldon.mlops.scheduler.ModelStatus.ModelState
-	(ModelReplicaStatus_ModelReplicaState)(0),     // 3: seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
-	(ServerStatusResponse_Type)(0),                // 4: seldon.mlops.scheduler.ServerStatusResponse.Type
-	(PipelineStep_JoinOp)(0),                      // 5: seldon.mlops.scheduler.PipelineStep.JoinOp
-	(PipelineInput_JoinOp)(0),                     // 6: seldon.mlops.scheduler.PipelineInput.JoinOp
-	(PipelineOutput_JoinOp)(0),                    // 7: seldon.mlops.scheduler.PipelineOutput.JoinOp
-	(PipelineStatusResponse_PipelineOperation)(0), // 8: seldon.mlops.scheduler.PipelineStatusResponse.PipelineOperation
-	(PipelineVersionState_PipelineStatus)(0),      // 9: seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
-	(ControlPlaneResponse_Event)(0),               // 10: seldon.mlops.scheduler.ControlPlaneResponse.Event
-	(ModelUpdateMessage_ModelOperation)(0),        // 11: seldon.mlops.scheduler.ModelUpdateMessage.ModelOperation
-	(*LoadModelRequest)(nil),                      // 12: seldon.mlops.scheduler.LoadModelRequest
-	(*Model)(nil),                                 // 13: seldon.mlops.scheduler.Model
-	(*MetaData)(nil),                              // 14: seldon.mlops.scheduler.MetaData
-	(*DataflowSpec)(nil),                          // 15: seldon.mlops.scheduler.DataflowSpec
-	(*DeploymentSpec)(nil),                        // 16: seldon.mlops.scheduler.DeploymentSpec
-	(*ModelSpec)(nil),                             // 17: seldon.mlops.scheduler.ModelSpec
-	(*ParameterSpec)(nil),                         // 18: seldon.mlops.scheduler.ParameterSpec
-	(*ExplainerSpec)(nil),                         // 19: seldon.mlops.scheduler.ExplainerSpec
-	(*LlmSpec)(nil),                               // 20: seldon.mlops.scheduler.LlmSpec
-	(*ModelRuntimeInfo)(nil),                      // 21: seldon.mlops.scheduler.ModelRuntimeInfo
-	(*MLServerModelSettings)(nil),                 // 22: seldon.mlops.scheduler.MLServerModelSettings
-	(*TritonModelConfig)(nil),                     // 23: seldon.mlops.scheduler.TritonModelConfig
-	(*TritonCPU)(nil),                             // 24: seldon.mlops.scheduler.TritonCPU
-	(*KubernetesMeta)(nil),                        // 25: seldon.mlops.scheduler.KubernetesMeta
-	(*StreamSpec)(nil),                            // 26: seldon.mlops.scheduler.StreamSpec
-	(*StorageConfig)(nil),                         // 27: seldon.mlops.scheduler.StorageConfig
-	(*LoadModelResponse)(nil),                     // 28: seldon.mlops.scheduler.LoadModelResponse
-	(*ModelReference)(nil),                        // 29: seldon.mlops.scheduler.ModelReference
-	(*UnloadModelRequest)(nil),                    // 30: seldon.mlops.scheduler.UnloadModelRequest
-	(*UnloadModelResponse)(nil),                   // 31: seldon.mlops.scheduler.UnloadModelResponse
-	(*ModelStatusResponse)(nil),                   // 32: seldon.mlops.scheduler.ModelStatusResponse
-	(*ModelVersionStatus)(nil),                    // 33: seldon.mlops.scheduler.ModelVersionStatus
-	(*ModelStatus)(nil),                           // 34: seldon.mlops.scheduler.ModelStatus
-	(*ModelReplicaStatus)(nil),                    // 35: seldon.mlops.scheduler.ModelReplicaStatus
-	(*ServerStatusRequest)(nil),                   // 36: seldon.mlops.scheduler.ServerStatusRequest
-	(*ServerStatusResponse)(nil),                  // 37: seldon.mlops.scheduler.ServerStatusResponse
-	(*ServerReplicaResources)(nil),                // 38: seldon.mlops.scheduler.ServerReplicaResources
-	(*ModelSubscriptionRequest)(nil),              // 39: seldon.mlops.scheduler.ModelSubscriptionRequest
-	(*ModelStatusRequest)(nil),                    // 40: seldon.mlops.scheduler.ModelStatusRequest
-	(*ServerNotifyRequest)(nil),                   // 41: seldon.mlops.scheduler.ServerNotifyRequest
-	(*ServerNotify)(nil),                          // 42: seldon.mlops.scheduler.ServerNotify
-	(*ServerNotifyResponse)(nil),                  // 43: seldon.mlops.scheduler.ServerNotifyResponse
-	(*ServerSubscriptionRequest)(nil),             // 44: seldon.mlops.scheduler.ServerSubscriptionRequest
-	(*StartExperimentRequest)(nil),                // 45: seldon.mlops.scheduler.StartExperimentRequest
-	(*Experiment)(nil),                            // 46: seldon.mlops.scheduler.Experiment
-	(*ExperimentConfig)(nil),                      // 47: seldon.mlops.scheduler.ExperimentConfig
-	(*ExperimentCandidate)(nil),                   // 48: seldon.mlops.scheduler.ExperimentCandidate
-	(*ExperimentMirror)(nil),                      // 49: seldon.mlops.scheduler.ExperimentMirror
-	(*StartExperimentResponse)(nil),               // 50: seldon.mlops.scheduler.StartExperimentResponse
-	(*StopExperimentRequest)(nil),                 // 51: seldon.mlops.scheduler.StopExperimentRequest
-	(*StopExperimentResponse)(nil),                // 52: seldon.mlops.scheduler.StopExperimentResponse
-	(*ExperimentSubscriptionRequest)(nil),         // 53: seldon.mlops.scheduler.ExperimentSubscriptionRequest
-	(*ExperimentStatusResponse)(nil),              // 54: seldon.mlops.scheduler.ExperimentStatusResponse
-	(*LoadPipelineRequest)(nil),                   // 55: seldon.mlops.scheduler.LoadPipelineRequest
-	(*ExperimentStatusRequest)(nil),               // 56: seldon.mlops.scheduler.ExperimentStatusRequest
-	(*Pipeline)(nil),                              // 57: seldon.mlops.scheduler.Pipeline
-	(*PipelineStep)(nil),                          // 58: seldon.mlops.scheduler.PipelineStep
-	(*Batch)(nil),                                 // 59: seldon.mlops.scheduler.Batch
-	(*PipelineInput)(nil),                         // 60: seldon.mlops.scheduler.PipelineInput
-	(*PipelineOutput)(nil),                        // 61: seldon.mlops.scheduler.PipelineOutput
-	(*LoadPipelineResponse)(nil),                  // 62: seldon.mlops.scheduler.LoadPipelineResponse
-	(*UnloadPipelineRequest)(nil),                 // 63: seldon.mlops.scheduler.UnloadPipelineRequest
-	(*UnloadPipelineResponse)(nil),                // 64: seldon.mlops.scheduler.UnloadPipelineResponse
-	(*PipelineStatusRequest)(nil),                 // 65: seldon.mlops.scheduler.PipelineStatusRequest
-	(*PipelineSubscriptionRequest)(nil),           // 66: seldon.mlops.scheduler.PipelineSubscriptionRequest
-	(*PipelineStatusResponse)(nil),                // 67: seldon.mlops.scheduler.PipelineStatusResponse
-	(*PipelineWithState)(nil),                     // 68: seldon.mlops.scheduler.PipelineWithState
-	(*PipelineVersionState)(nil),                  // 69: seldon.mlops.scheduler.PipelineVersionState
-	(*SchedulerStatusRequest)(nil),                // 70: seldon.mlops.scheduler.SchedulerStatusRequest
-	(*SchedulerStatusResponse)(nil),               // 71: seldon.mlops.scheduler.SchedulerStatusResponse
-	(*ControlPlaneSubscriptionRequest)(nil),       // 72: seldon.mlops.scheduler.ControlPlaneSubscriptionRequest
-	(*ControlPlaneResponse)(nil),                  // 73: seldon.mlops.scheduler.ControlPlaneResponse
-	(*ModelUpdateMessage)(nil),                    // 74: seldon.mlops.scheduler.ModelUpdateMessage
-	(*ModelUpdateStatusMessage)(nil),              // 75: seldon.mlops.scheduler.ModelUpdateStatusMessage
-	(*ModelUpdateStatusResponse)(nil),             // 76: seldon.mlops.scheduler.ModelUpdateStatusResponse
-	nil,                                           // 77: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
-	nil,                                           // 78: seldon.mlops.scheduler.PipelineStep.TensorMapEntry
-	nil,                                           // 79: seldon.mlops.scheduler.PipelineInput.TensorMapEntry
-	nil,                                           // 80: seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
-	(*timestamppb.Timestamp)(nil),                 // 81: google.protobuf.Timestamp
-	(*chainer.PipelineUpdateStatusMessage)(nil),   // 82: seldon.mlops.chainer.PipelineUpdateStatusMessage
-	(*chainer.PipelineUpdateStatusResponse)(nil),  // 83: seldon.mlops.chainer.PipelineUpdateStatusResponse
+	(DataflowSpec_Mode)(0),                        // 1: seldon.mlops.scheduler.DataflowSpec.Mode
+	(EnsembleSpec_Aggregation)(0),                 // 2: seldon.mlops.scheduler.EnsembleSpec.Aggregation
+	(RateLimitSpec_Unit)(0),                       // 3: seldon.mlops.scheduler.RateLimitSpec.Unit
+	(RoutingSpec_LoadBalancer)(0),                 // 4: seldon.mlops.scheduler.RoutingSpec.LoadBalancer
+	(ModelStatusResponse_ModelOperation)(0),       // 5: seldon.mlops.scheduler.ModelStatusResponse.ModelOperation
+	(ModelStatus_ModelState)(0),                   // 6: seldon.mlops.scheduler.ModelStatus.ModelState
+	(ModelRolloutStatus_RolloutState)(0),          // 7: seldon.mlops.scheduler.ModelRolloutStatus.RolloutState
+	(ModelReplicaStatus_ModelReplicaState)(0),     // 8: seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
+	(ServerStatusResponse_Type)(0),                // 9: seldon.mlops.scheduler.ServerStatusResponse.Type
+	(BanditConfig_BanditStrategy)(0),              // 10: seldon.mlops.scheduler.BanditConfig.BanditStrategy
+	(PipelineStep_JoinOp)(0),                      // 11: seldon.mlops.scheduler.PipelineStep.JoinOp
+	(PipelineInput_JoinOp)(0),                     // 12: seldon.mlops.scheduler.PipelineInput.JoinOp
+	(PipelineOutput_JoinOp)(0),                    // 13: seldon.mlops.scheduler.PipelineOutput.JoinOp
+	(PipelineStatusResponse_PipelineOperation)(0), // 14: seldon.mlops.scheduler.PipelineStatusResponse.PipelineOperation
+	(PipelineVersionState_PipelineStatus)(0),      // 15: seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
+	(ControlPlaneResponse_Event)(0),               // 16: seldon.mlops.scheduler.ControlPlaneResponse.Event
+	(ModelUpdateMessage_ModelOperation)(0),        // 17: seldon.mlops.scheduler.ModelUpdateMessage.ModelOperation
+	(*LoadModelRequest)(nil),                      // 18: seldon.mlops.scheduler.LoadModelRequest
+	(*Model)(nil),                                 // 19: seldon.mlops.scheduler.Model
+	(*MetaData)(nil),                              // 20: seldon.mlops.scheduler.MetaData
+	(*DataflowSpec)(nil),                          // 21: seldon.mlops.scheduler.DataflowSpec
+	(*EnsembleSpec)(nil),                          // 22: seldon.mlops.scheduler.EnsembleSpec
+	(*DeploymentSpec)(nil),                        // 23: seldon.mlops.scheduler.DeploymentSpec
+	(*AuthSpec)(nil),                              // 24: seldon.mlops.scheduler.AuthSpec
+	(*RateLimitSpec)(nil),                         // 25: seldon.mlops.scheduler.RateLimitSpec
+	(*TenantRateLimit)(nil),                       // 26: seldon.mlops.scheduler.TenantRateLimit
+	(*RoutingSpec)(nil),                           // 27: seldon.mlops.scheduler.RoutingSpec
+	(*RetrySpec)(nil),                             // 28: seldon.mlops.scheduler.RetrySpec
+	(*OutlierDetectionSpec)(nil),                  // 29: seldon.mlops.scheduler.OutlierDetectionSpec
+	(*PayloadLoggingSpec)(nil),                    // 30: seldon.mlops.scheduler.PayloadLoggingSpec
+	(*CanarySpec)(nil),                            // 31: seldon.mlops.scheduler.CanarySpec
+	(*ModelSpec)(nil),                             // 32: seldon.mlops.scheduler.ModelSpec
+	(*BatchingSpec)(nil),                          // 33: seldon.mlops.scheduler.BatchingSpec
+	(*ResponseCacheSpec)(nil),                     // 34: seldon.mlops.scheduler.ResponseCacheSpec
+	(*ConcurrencySpec)(nil),                       // 35: seldon.mlops.scheduler.ConcurrencySpec
+	(*ParameterSpec)(nil),                         // 36: seldon.mlops.scheduler.ParameterSpec
+	(*ExplainerSpec)(nil),                         // 37: seldon.mlops.scheduler.ExplainerSpec
+	(*LlmSpec)(nil),                               // 38: seldon.mlops.scheduler.LlmSpec
+	(*ModelRuntimeInfo)(nil),                      // 39: seldon.mlops.scheduler.ModelRuntimeInfo
+	(*MLServerModelSettings)(nil),                 // 40: seldon.mlops.scheduler.MLServerModelSettings
+	(*TritonModelConfig)(nil),                     // 41: seldon.mlops.scheduler.TritonModelConfig
+	(*TritonCPU)(nil),                             // 42: seldon.mlops.scheduler.TritonCPU
+	(*KubernetesMeta)(nil),                        // 43: seldon.mlops.scheduler.KubernetesMeta
+	(*StreamSpec)(nil),                            // 44: seldon.mlops.scheduler.StreamSpec
+	(*StorageConfig)(nil),                         // 45: seldon.mlops.scheduler.StorageConfig
+	(*LoadModelResponse)(nil),                     // 46: seldon.mlops.scheduler.LoadModelResponse
+	(*ModelReference)(nil),                        // 47: seldon.mlops.scheduler.ModelReference
+	(*UnloadModelRequest)(nil),                    // 48: seldon.mlops.scheduler.UnloadModelRequest
+	(*UnloadModelResponse)(nil),                   // 49: seldon.mlops.scheduler.UnloadModelResponse
+	(*ModelStatusResponse)(nil),                   // 50: seldon.mlops.scheduler.ModelStatusResponse
+	(*ModelVersionStatus)(nil),                    // 51: seldon.mlops.scheduler.ModelVersionStatus
+	(*ModelStatus)(nil),                           // 52: seldon.mlops.scheduler.ModelStatus
+	(*ModelRolloutStatus)(nil),                    // 53: seldon.mlops.scheduler.ModelRolloutStatus
+	(*ModelReplicaStatus)(nil),                    // 54: seldon.mlops.scheduler.ModelReplicaStatus
+	(*ServerStatusRequest)(nil),                   // 55: seldon.mlops.scheduler.ServerStatusRequest
+	(*ServerStatusResponse)(nil),                  // 56: seldon.mlops.scheduler.ServerStatusResponse
+	(*ServerReplicaResources)(nil),                // 57: seldon.mlops.scheduler.ServerReplicaResources
+	(*ModelSubscriptionRequest)(nil),              // 58: seldon.mlops.scheduler.ModelSubscriptionRequest
+	(*ModelStatusRequest)(nil),                    // 59: seldon.mlops.scheduler.ModelStatusRequest
+	(*ServerNotifyRequest)(nil),                   // 60: seldon.mlops.scheduler.ServerNotifyRequest
+	(*ServerNotify)(nil),                          // 61: seldon.mlops.scheduler.ServerNotify
+	(*ServerNotifyResponse)(nil),                  // 62: seldon.mlops.scheduler.ServerNotifyResponse
+	(*ServerSubscriptionRequest)(nil),             // 63: seldon.mlops.scheduler.ServerSubscriptionRequest
+	(*StartExperimentRequest)(nil),                // 64: seldon.mlops.scheduler.StartExperimentRequest
+	(*Experiment)(nil),                            // 65: seldon.mlops.scheduler.Experiment
+	(*ExperimentConfig)(nil),                      // 66: seldon.mlops.scheduler.ExperimentConfig
+	(*ExperimentCandidate)(nil),                   // 67: seldon.mlops.scheduler.ExperimentCandidate
+	(*ExperimentMatch)(nil),                       // 68: seldon.mlops.scheduler.ExperimentMatch
+	(*ExperimentMirror)(nil),                      // 69: seldon.mlops.scheduler.ExperimentMirror
+	(*BanditConfig)(nil),                          // 70: seldon.mlops.scheduler.BanditConfig
+	(*ExperimentFeedbackRequest)(nil),             // 71: seldon.mlops.scheduler.ExperimentFeedbackRequest
+	(*ExperimentFeedbackResponse)(nil),            // 72: seldon.mlops.scheduler.ExperimentFeedbackResponse
+	(*ExperimentRequest)(nil),                     // 73: seldon.mlops.scheduler.ExperimentRequest
+	(*ExperimentRequestsRequest)(nil),             // 74: seldon.mlops.scheduler.ExperimentRequestsRequest
+	(*ExperimentRequestsResponse)(nil),            // 75: seldon.mlops.scheduler.ExperimentRequestsResponse
+	(*StartExperimentResponse)(nil),               // 76: seldon.mlops.scheduler.StartExperimentResponse
+	(*StopExperimentRequest)(nil),                 // 77: seldon.mlops.scheduler.StopExperimentRequest
+	(*StopExperimentResponse)(nil),                // 78: seldon.mlops.scheduler.StopExperimentResponse
+	(*ExperimentSubscriptionRequest)(nil),         // 79: seldon.mlops.scheduler.ExperimentSubscriptionRequest
+	(*ExperimentStatusResponse)(nil),              // 80: seldon.mlops.scheduler.ExperimentStatusResponse
+	(*LoadPipelineRequest)(nil),                   // 81: seldon.mlops.scheduler.LoadPipelineRequest
+	(*ExperimentStatusRequest)(nil),               // 82: seldon.mlops.scheduler.ExperimentStatusRequest
+	(*Pipeline)(nil),                              // 83: seldon.mlops.scheduler.Pipeline
+	(*PipelineStep)(nil),                          // 84: seldon.mlops.scheduler.PipelineStep
+	(*Batch)(nil),                                 // 85: seldon.mlops.scheduler.Batch
+	(*PipelineInput)(nil),                         // 86: seldon.mlops.scheduler.PipelineInput
+	(*PipelineOutput)(nil),                        // 87: seldon.mlops.scheduler.PipelineOutput
+	(*LoadPipelineResponse)(nil),                  // 88: seldon.mlops.scheduler.LoadPipelineResponse
+	(*UnloadPipelineRequest)(nil),                 // 89: seldon.mlops.scheduler.UnloadPipelineRequest
+	(*UnloadPipelineResponse)(nil),                // 90: seldon.mlops.scheduler.UnloadPipelineResponse
+	(*PipelineStatusRequest)(nil),                 // 91: seldon.mlops.scheduler.PipelineStatusRequest
+	(*PipelineSubscriptionRequest)(nil),           // 92: seldon.mlops.scheduler.PipelineSubscriptionRequest
+	(*PipelineStatusResponse)(nil),                // 93: seldon.mlops.scheduler.PipelineStatusResponse
+	(*PipelineWithState)(nil),                     // 94: seldon.mlops.scheduler.PipelineWithState
+	(*PipelineVersionState)(nil),                  // 95: seldon.mlops.scheduler.PipelineVersionState
+	(*SchedulerStatusRequest)(nil),                // 96: seldon.mlops.scheduler.SchedulerStatusRequest
+	(*SchedulerStatusResponse)(nil),               // 97: seldon.mlops.scheduler.SchedulerStatusResponse
+	(*ExplainScheduleRequest)(nil),                // 98: seldon.mlops.scheduler.ExplainScheduleRequest
+	(*ExplainScheduleResponse)(nil),               // 99: seldon.mlops.scheduler.ExplainScheduleResponse
+	(*ServerScheduleDecision)(nil),                // 100: seldon.mlops.scheduler.ServerScheduleDecision
+	(*ReplicaScheduleDecision)(nil),               // 101: seldon.mlops.scheduler.ReplicaScheduleDecision
+	(*FilterDecision)(nil),                        // 102: seldon.mlops.scheduler.FilterDecision
+	(*ControlPlaneSubscriptionRequest)(nil),       // 103: seldon.mlops.scheduler.ControlPlaneSubscriptionRequest
+	(*ControlPlaneResponse)(nil),                  // 104: seldon.mlops.scheduler.ControlPlaneResponse
+	(*ModelUpdateMessage)(nil),                    // 105: seldon.mlops.scheduler.ModelUpdateMessage
+	(*ModelUpdateStatusMessage)(nil),              // 106: seldon.mlops.scheduler.ModelUpdateStatusMessage
+	(*ModelUpdateStatusResponse)(nil),             // 107: seldon.mlops.scheduler.ModelUpdateStatusResponse
+	nil,                                           // 108: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
+	nil,                                           // 109: seldon.mlops.scheduler.PipelineStep.TensorMapEntry
+	nil,                                           // 110: seldon.mlops.scheduler.PipelineInput.TensorMapEntry
+	nil,                                           // 111: seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
+	(*timestamppb.Timestamp)(nil),                 // 112: google.protobuf.Timestamp
+	(*chainer.PipelineUpdateStatusMessage)(nil),   // 113: seldon.mlops.chainer.PipelineUpdateStatusMessage
+	(*chainer.PipelineUpdateStatusResponse)(nil),  // 114: seldon.mlops.chainer.PipelineUpdateStatusResponse
 }
 var file_mlops_scheduler_scheduler_proto_depIdxs = []int32{
-	13, // 0: seldon.mlops.scheduler.LoadModelRequest.model:type_name -> seldon.mlops.scheduler.Model
-	14, // 1: seldon.mlops.scheduler.Model.meta:type_name -> seldon.mlops.scheduler.MetaData
-	17, // 2: seldon.mlops.scheduler.Model.modelSpec:type_name -> seldon.mlops.scheduler.ModelSpec
-	16, // 3: seldon.mlops.scheduler.Model.deploymentSpec:type_name -> seldon.mlops.scheduler.DeploymentSpec
-	26, // 4: seldon.mlops.scheduler.Model.streamSpec:type_name -> seldon.mlops.scheduler.StreamSpec
-	15, // 5: seldon.mlops.scheduler.Model.dataflowSpec:type_name -> seldon.mlops.scheduler.DataflowSpec
-	25, // 6: seldon.mlops.scheduler.MetaData.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	27, // 7: seldon.mlops.scheduler.ModelSpec.storageConfig:type_name -> seldon.mlops.scheduler.StorageConfig
-	18, // 8: seldon.mlops.scheduler.ModelSpec.parameters:type_name -> seldon.mlops.scheduler.ParameterSpec
-	21, // 9: seldon.mlops.scheduler.ModelSpec.modelRuntimeInfo:type_name -> seldon.mlops.scheduler.ModelRuntimeInfo
-	19, // 10: seldon.mlops.scheduler.ModelSpec.explainer:type_name -> seldon.mlops.scheduler.ExplainerSpec
-	20, // 11: seldon.mlops.scheduler.ModelSpec.llm:type_name -> seldon.mlops.scheduler.LlmSpec
-	22, // 12: seldon.mlops.scheduler.ModelRuntimeInfo.mlserver:type_name -> seldon.mlops.scheduler.MLServerModelSettings
-	23, // 13: seldon.mlops.scheduler.ModelRuntimeInfo.triton:type_name -> seldon.mlops.scheduler.TritonModelConfig
-	24, // 14: seldon.mlops.scheduler.TritonModelConfig.cpu:type_name -> seldon.mlops.scheduler.TritonCPU
-	29, // 15: seldon.mlops.scheduler.UnloadModelRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
-	25, // 16: seldon.mlops.scheduler.UnloadModelRequest.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	33, // 17: seldon.mlops.scheduler.ModelStatusResponse.versions:type_name -> seldon.mlops.scheduler.ModelVersionStatus
-	1,  // 18: seldon.mlops.scheduler.ModelStatusResponse.operation:type_name -> seldon.mlops.scheduler.ModelStatusResponse.ModelOperation
-	25, // 19: seldon.mlops.scheduler.ModelVersionStatus.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	77, // 20: seldon.mlops.scheduler.ModelVersionStatus.modelReplicaState:type_name -> seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
-	34, // 21: seldon.mlops.scheduler.ModelVersionStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus
-	13, // 22: seldon.mlops.scheduler.ModelVersionStatus.modelDefn:type_name -> seldon.mlops.scheduler.Model
-	2,  // 23: seldon.mlops.scheduler.ModelStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus.ModelState
-	81, // 24: seldon.mlops.scheduler.ModelStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
-	2,  // 25: seldon.mlops.scheduler.ModelStatus.modelGwState:type_name -> seldon.mlops.scheduler.ModelStatus.ModelState
-	3,  // 26: seldon.mlops.scheduler.ModelReplicaStatus.state:type_name -> seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
-	81, // 27: seldon.mlops.scheduler.ModelReplicaStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
-	4,  // 28: seldon.mlops.scheduler.ServerStatusResponse.type:type_name -> seldon.mlops.scheduler.ServerStatusResponse.Type
-	38, // 29: seldon.mlops.scheduler.ServerStatusResponse.resources:type_name -> seldon.mlops.scheduler.ServerReplicaResources
-	25, // 30: seldon.mlops.scheduler.ServerStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	29, // 31: seldon.mlops.scheduler.ModelStatusRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
-	42, // 32: seldon.mlops.scheduler.ServerNotifyRequest.servers:type_name -> seldon.mlops.scheduler.ServerNotify
-	25, // 33: seldon.mlops.scheduler.ServerNotify.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	46, // 34: seldon.mlops.scheduler.StartExperimentRequest.experiment:type_name -> seldon.mlops.scheduler.Experiment
-	48, // 35: seldon.mlops.scheduler.Experiment.candidates:type_name -> seldon.mlops.scheduler.ExperimentCandidate
-	49, // 36: seldon.mlops.scheduler.Experiment.mirror:type_name -> seldon.mlops.scheduler.ExperimentMirror
-	47, // 37: seldon.mlops.scheduler.Experiment.config:type_name -> seldon.mlops.scheduler.ExperimentConfig
-	25, // 38: seldon.mlops.scheduler.Experiment.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	0,  // 39: seldon.mlops.scheduler.Experiment.resourceType:type_name -> seldon.mlops.scheduler.ResourceType
-	25, // 40: seldon.mlops.scheduler.ExperimentStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	57, // 41: seldon.mlops.scheduler.LoadPipelineRequest.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
-	58, // 42: seldon.mlops.scheduler.Pipeline.steps:type_name -> seldon.mlops.scheduler.PipelineStep
-	61, // 43: seldon.mlops.scheduler.Pipeline.output:type_name -> seldon.mlops.scheduler.PipelineOutput
-	25, // 44: seldon.mlops.scheduler.Pipeline.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
-	60, // 45: seldon.mlops.scheduler.Pipeline.input:type_name -> seldon.mlops.scheduler.PipelineInput
-	15, // 46: seldon.mlops.scheduler.Pipeline.dataflowSpec:type_name -> seldon.mlops.scheduler.DataflowSpec
-	78, // 47: seldon.mlops.scheduler.PipelineStep.tensorMap:type_name -> seldon.mlops.scheduler.PipelineStep.TensorMapEntry
-	5,  // 48: seldon.mlops.scheduler.PipelineStep.inputsJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
-	5,  // 49: seldon.mlops.scheduler.PipelineStep.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
-	59, // 50: seldon.mlops.scheduler.PipelineStep.batch:type_name -> seldon.mlops.scheduler.Batch
-	6,  // 51: seldon.mlops.scheduler.PipelineInput.joinType:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
-	6,  // 52: seldon.mlops.scheduler.PipelineInput.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
-	79, // 53: seldon.mlops.scheduler.PipelineInput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineInput.TensorMapEntry
-	7,  // 54: seldon.mlops.scheduler.PipelineOutput.stepsJoin:type_name -> seldon.mlops.scheduler.PipelineOutput.JoinOp
-	80, // 55: seldon.mlops.scheduler.PipelineOutput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
-	68, // 56: seldon.mlops.scheduler.PipelineStatusResponse.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
-	8,  // 57: seldon.mlops.scheduler.PipelineStatusResponse.operation:type_name -> seldon.mlops.scheduler.PipelineStatusResponse.PipelineOperation
-	57, // 58: seldon.mlops.scheduler.PipelineWithState.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
-	69, // 59: seldon.mlops.scheduler.PipelineWithState.state:type_name -> seldon.mlops.scheduler.PipelineVersionState
-	9,  // 60: seldon.mlops.scheduler.PipelineVersionState.status:type_name -> seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
-	81, // 61: seldon.mlops.scheduler.PipelineVersionState.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
-	9,  // 62: seldon.mlops.scheduler.PipelineVersionState.pipelineGwStatus:type_name -> seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
-	10, // 63: seldon.mlops.scheduler.ControlPlaneResponse.event:type_name -> seldon.mlops.scheduler.ControlPlaneResponse.Event
-	11, // 64: seldon.mlops.scheduler.ModelUpdateMessage.op:type_name -> seldon.mlops.scheduler.ModelUpdateMessage.ModelOperation
-	74, // 65: seldon.mlops.scheduler.ModelUpdateStatusMessage.update:type_name -> seldon.mlops.scheduler.ModelUpdateMessage
-	35, // 66: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry.value:type_name -> seldon.mlops.scheduler.ModelReplicaStatus
-	41, // 67: seldon.mlops.scheduler.Scheduler.ServerNotify:input_type -> seldon.mlops.scheduler.ServerNotifyRequest
-	12, // 68: seldon.mlops.scheduler.Scheduler.LoadModel:input_type -> seldon.mlops.scheduler.LoadModelRequest
-	30, // 69: seldon.mlops.scheduler.Scheduler.UnloadModel:input_type -> seldon.mlops.scheduler.UnloadModelRequest
-	55, // 70: seldon.mlops.scheduler.Scheduler.LoadPipeline:input_type -> seldon.mlops.scheduler.LoadPipelineRequest
-	63, // 71: seldon.mlops.scheduler.Scheduler.UnloadPipeline:input_type -> seldon.mlops.scheduler.UnloadPipelineRequest
-	45, // 72: seldon.mlops.scheduler.Scheduler.StartExperiment:input_type -> seldon.mlops.scheduler.StartExperimentRequest
-	51, // 73: seldon.mlops.scheduler.Scheduler.StopExperiment:input_type -> seldon.mlops.scheduler.StopExperimentRequest
-	36, // 74: seldon.mlops.scheduler.Scheduler.ServerStatus:input_type -> seldon.mlops.scheduler.ServerStatusRequest
-	40, // 75: seldon.mlops.scheduler.Scheduler.ModelStatus:input_type -> seldon.mlops.scheduler.ModelStatusRequest
-	65, // 76: seldon.mlops.scheduler.Scheduler.PipelineStatus:input_type -> seldon.mlops.scheduler.PipelineStatusRequest
-	56, // 77: seldon.mlops.scheduler.Scheduler.ExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentStatusRequest
-	70, // 78: seldon.mlops.scheduler.Scheduler.SchedulerStatus:input_type -> seldon.mlops.scheduler.SchedulerStatusRequest
-	44, // 79: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:input_type -> seldon.mlops.scheduler.ServerSubscriptionRequest
-	39, // 80: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:input_type -> seldon.mlops.scheduler.ModelSubscriptionRequest
-	53, // 81: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentSubscriptionRequest
-	66, // 82: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:input_type -> seldon.mlops.scheduler.PipelineSubscriptionRequest
-	82, // 83: seldon.mlops.scheduler.Scheduler.PipelineStatusEvent:input_type -> seldon.mlops.chainer.PipelineUpdateStatusMessage
-	75, // 84: seldon.mlops.scheduler.Scheduler.ModelStatusEvent:input_type -> seldon.mlops.scheduler.ModelUpdateStatusMessage
-	72, // 85: seldon.mlops.scheduler.Scheduler.SubscribeControlPlane:input_type -> seldon.mlops.scheduler.ControlPlaneSubscriptionRequest
-	43, // 86: seldon.mlops.scheduler.Scheduler.ServerNotify:output_type -> seldon.mlops.scheduler.ServerNotifyResponse
-	28, // 87: seldon.mlops.scheduler.Scheduler.LoadModel:output_type -> seldon.mlops.scheduler.LoadModelResponse
-	31, // 88: seldon.mlops.scheduler.Scheduler.UnloadModel:output_type -> seldon.mlops.scheduler.UnloadModelResponse
-	62, // 89: seldon.mlops.scheduler.Scheduler.LoadPipeline:output_type -> seldon.mlops.scheduler.LoadPipelineResponse
-	64, // 90: seldon.mlops.scheduler.Scheduler.UnloadPipeline:output_type -> seldon.mlops.scheduler.UnloadPipelineResponse
-	50, // 91: seldon.mlops.scheduler.Scheduler.StartExperiment:output_type -> seldon.mlops.scheduler.StartExperimentResponse
-	52, // 92: seldon.mlops.scheduler.Scheduler.StopExperiment:output_type -> seldon.mlops.scheduler.StopExperimentResponse
-	37, // 93: seldon.mlops.scheduler.Scheduler.ServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
-	32, // 94: seldon.mlops.scheduler.Scheduler.ModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
-	67, // 95: seldon.mlops.scheduler.Scheduler.PipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
-	54, // 96: seldon.mlops.scheduler.Scheduler.ExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
-	71, // 97: seldon.mlops.scheduler.Scheduler.SchedulerStatus:output_type -> seldon.mlops.scheduler.SchedulerStatusResponse
-	37, // 98: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
-	32, // 99: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
-	54, // 100: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
-	67, // 101: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
-	83, // 102: seldon.mlops.scheduler.Scheduler.PipelineStatusEvent:output_type -> seldon.mlops.chainer.PipelineUpdateStatusResponse
-	76, // 103: seldon.mlops.scheduler.Scheduler.ModelStatusEvent:output_type -> seldon.mlops.scheduler.ModelUpdateStatusResponse
-	73, // 104: seldon.mlops.scheduler.Scheduler.SubscribeControlPlane:output_type -> seldon.mlops.scheduler.ControlPlaneResponse
-	86, // [86:105] is the sub-list for method output_type
-	67, // [67:86] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+	19,  // 0: seldon.mlops.scheduler.LoadModelRequest.model:type_name -> seldon.mlops.scheduler.Model
+	20,  // 1: seldon.mlops.scheduler.Model.meta:type_name -> seldon.mlops.scheduler.MetaData
+	32,  // 2: seldon.mlops.scheduler.Model.modelSpec:type_name -> seldon.mlops.scheduler.ModelSpec
+	23,  // 3: seldon.mlops.scheduler.Model.deploymentSpec:type_name -> seldon.mlops.scheduler.DeploymentSpec
+	44,  // 4: seldon.mlops.scheduler.Model.streamSpec:type_name -> seldon.mlops.scheduler.StreamSpec
+	21,  // 5: seldon.mlops.scheduler.Model.dataflowSpec:type_name -> seldon.mlops.scheduler.DataflowSpec
+	43,  // 6: seldon.mlops.scheduler.MetaData.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	1,   // 7: seldon.mlops.scheduler.DataflowSpec.mode:type_name -> seldon.mlops.scheduler.DataflowSpec.Mode
+	2,   // 8: seldon.mlops.scheduler.EnsembleSpec.aggregation:type_name -> seldon.mlops.scheduler.EnsembleSpec.Aggregation
+	31,  // 9: seldon.mlops.scheduler.DeploymentSpec.canary:type_name -> seldon.mlops.scheduler.CanarySpec
+	30,  // 10: seldon.mlops.scheduler.DeploymentSpec.payloadLogging:type_name -> seldon.mlops.scheduler.PayloadLoggingSpec
+	24,  // 11: seldon.mlops.scheduler.DeploymentSpec.auth:type_name -> seldon.mlops.scheduler.AuthSpec
+	25,  // 12: seldon.mlops.scheduler.DeploymentSpec.rateLimit:type_name -> seldon.mlops.scheduler.RateLimitSpec
+	27,  // 13: seldon.mlops.scheduler.DeploymentSpec.routing:type_name -> seldon.mlops.scheduler.RoutingSpec
+	3,   // 14: seldon.mlops.scheduler.RateLimitSpec.unit:type_name -> seldon.mlops.scheduler.RateLimitSpec.Unit
+	26,  // 15: seldon.mlops.scheduler.RateLimitSpec.tenants:type_name -> seldon.mlops.scheduler.TenantRateLimit
+	28,  // 16: seldon.mlops.scheduler.RoutingSpec.retry:type_name -> seldon.mlops.scheduler.RetrySpec
+	29,  // 17: seldon.mlops.scheduler.RoutingSpec.outlierDetection:type_name -> seldon.mlops.scheduler.OutlierDetectionSpec
+	4,   // 18: seldon.mlops.scheduler.RoutingSpec.loadBalancer:type_name -> seldon.mlops.scheduler.RoutingSpec.LoadBalancer
+	45,  // 19: seldon.mlops.scheduler.ModelSpec.storageConfig:type_name -> seldon.mlops.scheduler.StorageConfig
+	36,  // 20: seldon.mlops.scheduler.ModelSpec.parameters:type_name -> seldon.mlops.scheduler.ParameterSpec
+	39,  // 21: seldon.mlops.scheduler.ModelSpec.modelRuntimeInfo:type_name -> seldon.mlops.scheduler.ModelRuntimeInfo
+	33,  // 22: seldon.mlops.scheduler.ModelSpec.batching:type_name -> seldon.mlops.scheduler.BatchingSpec
+	34,  // 23: seldon.mlops.scheduler.ModelSpec.responseCache:type_name -> seldon.mlops.scheduler.ResponseCacheSpec
+	35,  // 24: seldon.mlops.scheduler.ModelSpec.concurrency:type_name -> seldon.mlops.scheduler.ConcurrencySpec
+	37,  // 25: seldon.mlops.scheduler.ModelSpec.explainer:type_name -> seldon.mlops.scheduler.ExplainerSpec
+	38,  // 26: seldon.mlops.scheduler.ModelSpec.llm:type_name -> seldon.mlops.scheduler.LlmSpec
+	40,  // 27: seldon.mlops.scheduler.ModelRuntimeInfo.mlserver:type_name -> seldon.mlops.scheduler.MLServerModelSettings
+	41,  // 28: seldon.mlops.scheduler.ModelRuntimeInfo.triton:type_name -> seldon.mlops.scheduler.TritonModelConfig
+	42,  // 29: seldon.mlops.scheduler.TritonModelConfig.cpu:type_name -> seldon.mlops.scheduler.TritonCPU
+	47,  // 30: seldon.mlops.scheduler.UnloadModelRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
+	43,  // 31: seldon.mlops.scheduler.UnloadModelRequest.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	51,  // 32: seldon.mlops.scheduler.ModelStatusResponse.versions:type_name -> seldon.mlops.scheduler.ModelVersionStatus
+	5,   // 33: seldon.mlops.scheduler.ModelStatusResponse.operation:type_name -> seldon.mlops.scheduler.ModelStatusResponse.ModelOperation
+	43,  // 34: seldon.mlops.scheduler.ModelVersionStatus.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	108, // 35: seldon.mlops.scheduler.ModelVersionStatus.modelReplicaState:type_name -> seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry
+	52,  // 36: seldon.mlops.scheduler.ModelVersionStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus
+	19,  // 37: seldon.mlops.scheduler.ModelVersionStatus.modelDefn:type_name -> seldon.mlops.scheduler.Model
+	6,   // 38: seldon.mlops.scheduler.ModelStatus.state:type_name -> seldon.mlops.scheduler.ModelStatus.ModelState
+	112, // 39: seldon.mlops.scheduler.ModelStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	6,   // 40: seldon.mlops.scheduler.ModelStatus.modelGwState:type_name -> seldon.mlops.scheduler.ModelStatus.ModelState
+	53,  // 41: seldon.mlops.scheduler.ModelStatus.rollout:type_name -> seldon.mlops.scheduler.ModelRolloutStatus
+	7,   // 42: seldon.mlops.scheduler.ModelRolloutStatus.state:type_name -> seldon.mlops.scheduler.ModelRolloutStatus.RolloutState
+	112, // 43: seldon.mlops.scheduler.ModelRolloutStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	8,   // 44: seldon.mlops.scheduler.ModelReplicaStatus.state:type_name -> seldon.mlops.scheduler.ModelReplicaStatus.ModelReplicaState
+	112, // 45: seldon.mlops.scheduler.ModelReplicaStatus.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	9,   // 46: seldon.mlops.scheduler.ServerStatusResponse.type:type_name -> seldon.mlops.scheduler.ServerStatusResponse.Type
+	57,  // 47: seldon.mlops.scheduler.ServerStatusResponse.resources:type_name -> seldon.mlops.scheduler.ServerReplicaResources
+	43,  // 48: seldon.mlops.scheduler.ServerStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	47,  // 49: seldon.mlops.scheduler.ModelStatusRequest.model:type_name -> seldon.mlops.scheduler.ModelReference
+	61,  // 50: seldon.mlops.scheduler.ServerNotifyRequest.servers:type_name -> seldon.mlops.scheduler.ServerNotify
+	43,  // 51: seldon.mlops.scheduler.ServerNotify.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	65,  // 52: seldon.mlops.scheduler.StartExperimentRequest.experiment:type_name -> seldon.mlops.scheduler.Experiment
+	67,  // 53: seldon.mlops.scheduler.Experiment.candidates:type_name -> seldon.mlops.scheduler.ExperimentCandidate
+	69,  // 54: seldon.mlops.scheduler.Experiment.mirror:type_name -> seldon.mlops.scheduler.ExperimentMirror
+	66,  // 55: seldon.mlops.scheduler.Experiment.config:type_name -> seldon.mlops.scheduler.ExperimentConfig
+	43,  // 56: seldon.mlops.scheduler.Experiment.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	0,   // 57: seldon.mlops.scheduler.Experiment.resourceType:type_name -> seldon.mlops.scheduler.ResourceType
+	70,  // 58: seldon.mlops.scheduler.Experiment.bandit:type_name -> seldon.mlops.scheduler.BanditConfig
+	69,  // 59: seldon.mlops.scheduler.Experiment.mirrors:type_name -> seldon.mlops.scheduler.ExperimentMirror
+	68,  // 60: seldon.mlops.scheduler.ExperimentCandidate.matches:type_name -> seldon.mlops.scheduler.ExperimentMatch
+	10,  // 61: seldon.mlops.scheduler.BanditConfig.strategy:type_name -> seldon.mlops.scheduler.BanditConfig.BanditStrategy
+	73,  // 62: seldon.mlops.scheduler.ExperimentRequestsRequest.requests:type_name -> seldon.mlops.scheduler.ExperimentRequest
+	43,  // 63: seldon.mlops.scheduler.ExperimentStatusResponse.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	83,  // 64: seldon.mlops.scheduler.LoadPipelineRequest.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
+	84,  // 65: seldon.mlops.scheduler.Pipeline.steps:type_name -> seldon.mlops.scheduler.PipelineStep
+	87,  // 66: seldon.mlops.scheduler.Pipeline.output:type_name -> seldon.mlops.scheduler.PipelineOutput
+	43,  // 67: seldon.mlops.scheduler.Pipeline.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	86,  // 68: seldon.mlops.scheduler.Pipeline.input:type_name -> seldon.mlops.scheduler.PipelineInput
+	21,  // 69: seldon.mlops.scheduler.Pipeline.dataflowSpec:type_name -> seldon.mlops.scheduler.DataflowSpec
+	24,  // 70: seldon.mlops.scheduler.Pipeline.auth:type_name -> seldon.mlops.scheduler.AuthSpec
+	25,  // 71: seldon.mlops.scheduler.Pipeline.rateLimit:type_name -> seldon.mlops.scheduler.RateLimitSpec
+	27,  // 72: seldon.mlops.scheduler.Pipeline.routing:type_name -> seldon.mlops.scheduler.RoutingSpec
+	22,  // 73: seldon.mlops.scheduler.Pipeline.ensemble:type_name -> seldon.mlops.scheduler.EnsembleSpec
+	109, // 74: seldon.mlops.scheduler.PipelineStep.tensorMap:type_name -> seldon.mlops.scheduler.PipelineStep.TensorMapEntry
+	11,  // 75: seldon.mlops.scheduler.PipelineStep.inputsJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
+	11,  // 76: seldon.mlops.scheduler.PipelineStep.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineStep.JoinOp
+	85,  // 77: seldon.mlops.scheduler.PipelineStep.batch:type_name -> seldon.mlops.scheduler.Batch
+	12,  // 78: seldon.mlops.scheduler.PipelineInput.joinType:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
+	12,  // 79: seldon.mlops.scheduler.PipelineInput.triggersJoin:type_name -> seldon.mlops.scheduler.PipelineInput.JoinOp
+	110, // 80: seldon.mlops.scheduler.PipelineInput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineInput.TensorMapEntry
+	13,  // 81: seldon.mlops.scheduler.PipelineOutput.stepsJoin:type_name -> seldon.mlops.scheduler.PipelineOutput.JoinOp
+	111, // 82: seldon.mlops.scheduler.PipelineOutput.tensorMap:type_name -> seldon.mlops.scheduler.PipelineOutput.TensorMapEntry
+	94,  // 83: seldon.mlops.scheduler.PipelineStatusResponse.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
+	14,  // 84: seldon.mlops.scheduler.PipelineStatusResponse.operation:type_name -> seldon.mlops.scheduler.PipelineStatusResponse.PipelineOperation
+	83,  // 85: seldon.mlops.scheduler.PipelineWithState.pipeline:type_name -> seldon.mlops.scheduler.Pipeline
+	95,  // 86: seldon.mlops.scheduler.PipelineWithState.state:type_name -> seldon.mlops.scheduler.PipelineVersionState
+	15,  // 87: seldon.mlops.scheduler.PipelineVersionState.status:type_name -> seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
+	112, // 88: seldon.mlops.scheduler.PipelineVersionState.lastChangeTimestamp:type_name -> google.protobuf.Timestamp
+	15,  // 89: seldon.mlops.scheduler.PipelineVersionState.pipelineGwStatus:type_name -> seldon.mlops.scheduler.PipelineVersionState.PipelineStatus
+	19,  // 90: seldon.mlops.scheduler.ExplainScheduleRequest.model:type_name -> seldon.mlops.scheduler.Model
+	100, // 91: seldon.mlops.scheduler.ExplainScheduleResponse.servers:type_name -> seldon.mlops.scheduler.ServerScheduleDecision
+	102, // 92: seldon.mlops.scheduler.ExplainScheduleResponse.replicaSetFilters:type_name -> seldon.mlops.scheduler.FilterDecision
+	102, // 93: seldon.mlops.scheduler.ServerScheduleDecision.filters:type_name -> seldon.mlops.scheduler.FilterDecision
+	101, // 94: seldon.mlops.scheduler.ServerScheduleDecision.replicas:type_name -> seldon.mlops.scheduler.ReplicaScheduleDecision
+	102, // 95: seldon.mlops.scheduler.ReplicaScheduleDecision.filters:type_name -> seldon.mlops.scheduler.FilterDecision
+	16,  // 96: seldon.mlops.scheduler.ControlPlaneResponse.event:type_name -> seldon.mlops.scheduler.ControlPlaneResponse.Event
+	17,  // 97: seldon.mlops.scheduler.ModelUpdateMessage.op:type_name -> seldon.mlops.scheduler.ModelUpdateMessage.ModelOperation
+	105, // 98: seldon.mlops.scheduler.ModelUpdateStatusMessage.update:type_name -> seldon.mlops.scheduler.ModelUpdateMessage
+	54,  // 99: seldon.mlops.scheduler.ModelVersionStatus.ModelReplicaStateEntry.value:type_name -> seldon.mlops.scheduler.ModelReplicaStatus
+	60,  // 100: seldon.mlops.scheduler.Scheduler.ServerNotify:input_type -> seldon.mlops.scheduler.ServerNotifyRequest
+	18,  // 101: seldon.mlops.scheduler.Scheduler.LoadModel:input_type -> seldon.mlops.scheduler.LoadModelRequest
+	48,  // 102: seldon.mlops.scheduler.Scheduler.UnloadModel:input_type -> seldon.mlops.scheduler.UnloadModelRequest
+	81,  // 103: seldon.mlops.scheduler.Scheduler.LoadPipeline:input_type -> seldon.mlops.scheduler.LoadPipelineRequest
+	89,  // 104: seldon.mlops.scheduler.Scheduler.UnloadPipeline:input_type -> seldon.mlops.scheduler.UnloadPipelineRequest
+	64,  // 105: seldon.mlops.scheduler.Scheduler.StartExperiment:input_type -> seldon.mlops.scheduler.StartExperimentRequest
+	77,  // 106: seldon.mlops.scheduler.Scheduler.StopExperiment:input_type -> seldon.mlops.scheduler.StopExperimentRequest
+	71,  // 107: seldon.mlops.scheduler.Scheduler.ExperimentFeedback:input_type -> seldon.mlops.scheduler.ExperimentFeedbackRequest
+	74,  // 108: seldon.mlops.scheduler.Scheduler.ExperimentRequests:input_type -> seldon.mlops.scheduler.ExperimentRequestsRequest
+	55,  // 109: seldon.mlops.scheduler.Scheduler.ServerStatus:input_type -> seldon.mlops.scheduler.ServerStatusRequest
+	59,  // 110: seldon.mlops.scheduler.Scheduler.ModelStatus:input_type -> seldon.mlops.scheduler.ModelStatusRequest
+	91,  // 111: seldon.mlops.scheduler.Scheduler.PipelineStatus:input_type -> seldon.mlops.scheduler.PipelineStatusRequest
+	82,  // 112: seldon.mlops.scheduler.Scheduler.ExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentStatusRequest
+	96,  // 113: seldon.mlops.scheduler.Scheduler.SchedulerStatus:input_type -> seldon.mlops.scheduler.SchedulerStatusRequest
+	98,  // 114: seldon.mlops.scheduler.Scheduler.ExplainSchedule:input_type -> seldon.mlops.scheduler.ExplainScheduleRequest
+	63,  // 115: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:input_type -> seldon.mlops.scheduler.ServerSubscriptionRequest
+	58,  // 116: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:input_type -> seldon.mlops.scheduler.ModelSubscriptionRequest
+	79,  // 117: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:input_type -> seldon.mlops.scheduler.ExperimentSubscriptionRequest
+	92,  // 118: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:input_type -> seldon.mlops.scheduler.PipelineSubscriptionRequest
+	113, // 119: seldon.mlops.scheduler.Scheduler.PipelineStatusEvent:input_type -> seldon.mlops.chainer.PipelineUpdateStatusMessage
+	106, // 120: seldon.mlops.scheduler.Scheduler.ModelStatusEvent:input_type -> seldon.mlops.scheduler.ModelUpdateStatusMessage
+	103, // 121: seldon.mlops.scheduler.Scheduler.SubscribeControlPlane:input_type -> seldon.mlops.scheduler.ControlPlaneSubscriptionRequest
+	62,  // 122: seldon.mlops.scheduler.Scheduler.ServerNotify:output_type -> seldon.mlops.scheduler.ServerNotifyResponse
+	46,  // 123: seldon.mlops.scheduler.Scheduler.LoadModel:output_type -> seldon.mlops.scheduler.LoadModelResponse
+	49,  // 124: seldon.mlops.scheduler.Scheduler.UnloadModel:output_type -> seldon.mlops.scheduler.UnloadModelResponse
+	88,  // 125: seldon.mlops.scheduler.Scheduler.LoadPipeline:output_type -> seldon.mlops.scheduler.LoadPipelineResponse
+	90,  // 126: seldon.mlops.scheduler.Scheduler.UnloadPipeline:output_type -> seldon.mlops.scheduler.UnloadPipelineResponse
+	76,  // 127: seldon.mlops.scheduler.Scheduler.StartExperiment:output_type -> seldon.mlops.scheduler.StartExperimentResponse
+	78,  // 128: seldon.mlops.scheduler.Scheduler.StopExperiment:output_type -> seldon.mlops.scheduler.StopExperimentResponse
+	72,  // 129: seldon.mlops.scheduler.Scheduler.ExperimentFeedback:output_type -> seldon.mlops.scheduler.ExperimentFeedbackResponse
+	75,  // 130: seldon.mlops.scheduler.Scheduler.ExperimentRequests:output_type -> seldon.mlops.scheduler.ExperimentRequestsResponse
+	56,  // 131: seldon.mlops.scheduler.Scheduler.ServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
+	50,  // 132: seldon.mlops.scheduler.Scheduler.ModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
+	93,  // 133: seldon.mlops.scheduler.Scheduler.PipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
+	80,  // 134: seldon.mlops.scheduler.Scheduler.ExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
+	97,  // 135: seldon.mlops.scheduler.Scheduler.SchedulerStatus:output_type -> seldon.mlops.scheduler.SchedulerStatusResponse
+	99,  // 136: seldon.mlops.scheduler.Scheduler.ExplainSchedule:output_type -> seldon.mlops.scheduler.ExplainScheduleResponse
+	56,  // 137: seldon.mlops.scheduler.Scheduler.SubscribeServerStatus:output_type -> seldon.mlops.scheduler.ServerStatusResponse
+	50,  // 138: seldon.mlops.scheduler.Scheduler.SubscribeModelStatus:output_type -> seldon.mlops.scheduler.ModelStatusResponse
+	80,  // 139: seldon.mlops.scheduler.Scheduler.SubscribeExperimentStatus:output_type -> seldon.mlops.scheduler.ExperimentStatusResponse
+	93,  // 140: seldon.mlops.scheduler.Scheduler.SubscribePipelineStatus:output_type -> seldon.mlops.scheduler.PipelineStatusResponse
+	114, // 141: seldon.mlops.scheduler.Scheduler.PipelineStatusEvent:output_type -> seldon.mlops.chainer.PipelineUpdateStatusResponse
+	107, // 142: seldon.mlops.scheduler.Scheduler.ModelStatusEvent:output_type -> seldon.mlops.scheduler.ModelUpdateStatusResponse
+	104, // 143: seldon.mlops.scheduler.Scheduler.SubscribeControlPlane:output_type -> seldon.mlops.scheduler.ControlPlaneResponse
+	122, // [122:144] is the sub-list for method output_type
+	100, // [100:122] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
 }
 
 func init() { file_mlops_scheduler_scheduler_proto_init() }
@@ -5961,8 +8529,152 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Model); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Model); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*DataflowSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*EnsembleSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DeploymentSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*AuthSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RateLimitSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*TenantRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RoutingSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RetrySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*OutlierDetectionSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*PayloadLoggingSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*CanarySpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5973,8 +8685,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*MetaData); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ModelSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5985,8 +8697,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*DataflowSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchingSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5997,8 +8709,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*DeploymentSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ResponseCacheSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6009,8 +8721,8 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ModelSpec); i {
+		file_mlops_scheduler_scheduler_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ConcurrencySpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6021,7 +8733,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[6].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[18].Exporter = func(v any, i int) any {
 			switch v := v.(*ParameterSpec); i {
 			case 0:
 				return &v.state
@@ -6033,7 +8745,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*ExplainerSpec); i {
 			case 0:
 				return &v.state
@@ -6045,7 +8757,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*LlmSpec); i {
 			case 0:
 				return &v.state
@@ -6057,7 +8769,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelRuntimeInfo); i {
 			case 0:
 				return &v.state
@@ -6069,7 +8781,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*MLServerModelSettings); i {
 			case 0:
 				return &v.state
@@ -6081,7 +8793,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*TritonModelConfig); i {
 			case 0:
 				return &v.state
@@ -6093,7 +8805,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*TritonCPU); i {
 			case 0:
 				return &v.state
@@ -6105,7 +8817,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[25].Exporter = func(v any, i int) any {
 			switch v := v.(*KubernetesMeta); i {
 			case 0:
 				return &v.state
@@ -6117,7 +8829,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[26].Exporter = func(v any, i int) any {
 			switch v := v.(*StreamSpec); i {
 			case 0:
 				return &v.state
@@ -6129,7 +8841,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*StorageConfig); i {
 			case 0:
 				return &v.state
@@ -6141,7 +8853,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*LoadModelResponse); i {
 			case 0:
 				return &v.state
@@ -6153,7 +8865,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelReference); i {
 			case 0:
 				return &v.state
@@ -6165,7 +8877,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*UnloadModelRequest); i {
 			case 0:
 				return &v.state
@@ -6177,7 +8889,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*UnloadModelResponse); i {
 			case 0:
 				return &v.state
@@ -6189,7 +8901,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6201,7 +8913,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelVersionStatus); i {
 			case 0:
 				return &v.state
@@ -6213,7 +8925,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelStatus); i {
 			case 0:
 				return &v.state
@@ -6225,7 +8937,19 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ModelRolloutStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelReplicaStatus); i {
 			case 0:
 				return &v.state
@@ -6237,7 +8961,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[24].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerStatusRequest); i {
 			case 0:
 				return &v.state
@@ -6249,7 +8973,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[25].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6261,7 +8985,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerReplicaResources); i {
 			case 0:
 				return &v.state
@@ -6273,7 +8997,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelSubscriptionRequest); i {
 			case 0:
 				return &v.state
@@ -6285,7 +9009,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelStatusRequest); i {
 			case 0:
 				return &v.state
@@ -6297,7 +9021,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerNotifyRequest); i {
 			case 0:
 				return &v.state
@@ -6309,7 +9033,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerNotify); i {
 			case 0:
 				return &v.state
@@ -6321,7 +9045,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerNotifyResponse); i {
 			case 0:
 				return &v.state
@@ -6333,7 +9057,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*ServerSubscriptionRequest); i {
 			case 0:
 				return &v.state
@@ -6345,7 +9069,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[33].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*StartExperimentRequest); i {
 			case 0:
 				return &v.state
@@ -6357,7 +9081,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[47].Exporter = func(v any, i int) any {
 			switch v := v.(*Experiment); i {
 			case 0:
 				return &v.state
@@ -6369,7 +9093,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[48].Exporter = func(v any, i int) any {
 			switch v := v.(*ExperimentConfig); i {
 			case 0:
 				return &v.state
@@ -6381,7 +9105,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*ExperimentCandidate); i {
 			case 0:
 				return &v.state
@@ -6393,7 +9117,19 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ExperimentMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*ExperimentMirror); i {
 			case 0:
 				return &v.state
@@ -6405,7 +9141,79 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[38].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*BanditConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ExperimentFeedbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ExperimentFeedbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ExperimentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ExperimentRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ExperimentRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*StartExperimentResponse); i {
 			case 0:
 				return &v.state
@@ -6417,7 +9225,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[39].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*StopExperimentRequest); i {
 			case 0:
 				return &v.state
@@ -6429,7 +9237,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[40].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*StopExperimentResponse); i {
 			case 0:
 				return &v.state
@@ -6441,7 +9249,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[41].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*ExperimentSubscriptionRequest); i {
 			case 0:
 				return &v.state
@@ -6453,7 +9261,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[42].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*ExperimentStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6465,7 +9273,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[43].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*LoadPipelineRequest); i {
 			case 0:
 				return &v.state
@@ -6477,7 +9285,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[44].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*ExperimentStatusRequest); i {
 			case 0:
 				return &v.state
@@ -6489,7 +9297,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[45].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[65].Exporter = func(v any, i int) any {
 			switch v := v.(*Pipeline); i {
 			case 0:
 				return &v.state
@@ -6501,7 +9309,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[46].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[66].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineStep); i {
 			case 0:
 				return &v.state
@@ -6513,7 +9321,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[47].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[67].Exporter = func(v any, i int) any {
 			switch v := v.(*Batch); i {
 			case 0:
 				return &v.state
@@ -6525,7 +9333,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[48].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineInput); i {
 			case 0:
 				return &v.state
@@ -6537,7 +9345,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[49].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[69].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineOutput); i {
 			case 0:
 				return &v.state
@@ -6549,7 +9357,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[50].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[70].Exporter = func(v any, i int) any {
 			switch v := v.(*LoadPipelineResponse); i {
 			case 0:
 				return &v.state
@@ -6561,7 +9369,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[51].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[71].Exporter = func(v any, i int) any {
 			switch v := v.(*UnloadPipelineRequest); i {
 			case 0:
 				return &v.state
@@ -6573,7 +9381,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[52].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[72].Exporter = func(v any, i int) any {
 			switch v := v.(*UnloadPipelineResponse); i {
 			case 0:
 				return &v.state
@@ -6585,7 +9393,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[53].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[73].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineStatusRequest); i {
 			case 0:
 				return &v.state
@@ -6597,7 +9405,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[54].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[74].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineSubscriptionRequest); i {
 			case 0:
 				return &v.state
@@ -6609,7 +9417,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[55].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[75].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6621,7 +9429,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[56].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[76].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineWithState); i {
 			case 0:
 				return &v.state
@@ -6633,7 +9441,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[57].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[77].Exporter = func(v any, i int) any {
 			switch v := v.(*PipelineVersionState); i {
 			case 0:
 				return &v.state
@@ -6645,7 +9453,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[58].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[78].Exporter = func(v any, i int) any {
 			switch v := v.(*SchedulerStatusRequest); i {
 			case 0:
 				return &v.state
@@ -6657,7 +9465,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[59].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[79].Exporter = func(v any, i int) any {
 			switch v := v.(*SchedulerStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6669,7 +9477,67 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[60].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*ExplainScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerScheduleDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplicaScheduleDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_scheduler_proto_msgTypes[85].Exporter = func(v any, i int) any {
 			switch v := v.(*ControlPlaneSubscriptionRequest); i {
 			case 0:
 				return &v.state
@@ -6681,7 +9549,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[61].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[86].Exporter = func(v any, i int) any {
 			switch v := v.(*ControlPlaneResponse); i {
 			case 0:
 				return &v.state
@@ -6693,7 +9561,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[62].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[87].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelUpdateMessage); i {
 			case 0:
 				return &v.state
@@ -6705,7 +9573,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[63].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[88].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelUpdateStatusMessage); i {
 			case 0:
 				return &v.state
@@ -6717,7 +9585,7 @@ func file_mlops_scheduler_scheduler_proto_init() {
 				return nil
 			}
 		}
-		file_mlops_scheduler_scheduler_proto_msgTypes[64].Exporter = func(v any, i int) any {
+		file_mlops_scheduler_scheduler_proto_msgTypes[89].Exporter = func(v any, i int) any {
 			switch v := v.(*ModelUpdateStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6731,42 +9599,46 @@ func file_mlops_scheduler_scheduler_proto_init() {
 		}
 	}
 	file_mlops_scheduler_scheduler_proto_msgTypes[2].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[5].OneofWrappers = []any{
+	file_mlops_scheduler_scheduler_proto_msgTypes[5].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[9].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[10].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[14].OneofWrappers = []any{
 		(*ModelSpec_Explainer)(nil),
 		(*ModelSpec_Llm)(nil),
 	}
-	file_mlops_scheduler_scheduler_proto_msgTypes[7].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[8].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[9].OneofWrappers = []any{
+	file_mlops_scheduler_scheduler_proto_msgTypes[19].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[20].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[21].OneofWrappers = []any{
 		(*ModelRuntimeInfo_Mlserver)(nil),
 		(*ModelRuntimeInfo_Triton)(nil),
 	}
-	file_mlops_scheduler_scheduler_proto_msgTypes[15].OneofWrappers = []any{
+	file_mlops_scheduler_scheduler_proto_msgTypes[27].OneofWrappers = []any{
 		(*StorageConfig_StorageSecretName)(nil),
 		(*StorageConfig_StorageRcloneConfig)(nil),
 	}
-	file_mlops_scheduler_scheduler_proto_msgTypes[17].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[18].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[21].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[24].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[25].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[28].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[29].OneofWrappers = []any{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[30].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[33].OneofWrappers = []any{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[34].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[42].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[44].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[45].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[46].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[37].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[38].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[41].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[43].OneofWrappers = []any{}
 	file_mlops_scheduler_scheduler_proto_msgTypes[47].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[48].OneofWrappers = []any{}
-	file_mlops_scheduler_scheduler_proto_msgTypes[53].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[62].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[64].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[65].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[66].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[67].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[68].OneofWrappers = []any{}
+	file_mlops_scheduler_scheduler_proto_msgTypes[73].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mlops_scheduler_scheduler_proto_rawDesc,
-			NumEnums:      12,
-			NumMessages:   69,
+			NumEnums:      18,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,11 +36,14 @@ const (
 	Scheduler_UnloadPipeline_FullMethodName            = "/seldon.mlops.scheduler.Scheduler/UnloadPipeline"
 	Scheduler_StartExperiment_FullMethodName           = "/seldon.mlops.scheduler.Scheduler/StartExperiment"
 	Scheduler_StopExperiment_FullMethodName            = "/seldon.mlops.scheduler.Scheduler/StopExperiment"
+	Scheduler_ExperimentFeedback_FullMethodName        = "/seldon.mlops.scheduler.Scheduler/ExperimentFeedback"
+	Scheduler_ExperimentRequests_FullMethodName        = "/seldon.mlops.scheduler.Scheduler/ExperimentRequests"
 	Scheduler_ServerStatus_FullMethodName              = "/seldon.mlops.scheduler.Scheduler/ServerStatus"
 	Scheduler_ModelStatus_FullMethodName               = "/seldon.mlops.scheduler.Scheduler/ModelStatus"
 	Scheduler_PipelineStatus_FullMethodName            = "/seldon.mlops.scheduler.Scheduler/PipelineStatus"
 	Scheduler_ExperimentStatus_FullMethodName          = "/seldon.mlops.scheduler.Scheduler/ExperimentStatus"
 	Scheduler_SchedulerStatus_FullMethodName           = "/seldon.mlops.scheduler.Scheduler/SchedulerStatus"
+	Scheduler_ExplainSchedule_FullMethodName           = "/seldon.mlops.scheduler.Scheduler/ExplainSchedule"
 	Scheduler_SubscribeServerStatus_FullMethodName     = "/seldon.mlops.scheduler.Scheduler/SubscribeServerStatus"
 	Scheduler_SubscribeModelStatus_FullMethodName      = "/seldon.mlops.scheduler.Scheduler/SubscribeModelStatus"
 	Scheduler_SubscribeExperimentStatus_FullMethodName = "/seldon.mlops.scheduler.Scheduler/SubscribeExperimentStatus"
@@ -61,11 +64,14 @@ type SchedulerClient interface {
 	UnloadPipeline(ctx context.Context, in *UnloadPipelineRequest, opts ...grpc.CallOption) (*UnloadPipelineResponse, error)
 	StartExperiment(ctx context.Context, in *StartExperimentRequest, opts ...grpc.CallOption) (*StartExperimentResponse, error)
 	StopExperiment(ctx context.Context, in *StopExperimentRequest, opts ...grpc.CallOption) (*StopExperimentResponse, error)
+	ExperimentFeedback(ctx context.Context, in *ExperimentFeedbackRequest, opts ...grpc.CallOption) (*ExperimentFeedbackResponse, error)
+	ExperimentRequests(ctx context.Context, in *ExperimentRequestsRequest, opts ...grpc.CallOption) (*ExperimentRequestsResponse, error)
 	ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (Scheduler_ServerStatusClient, error)
 	ModelStatus(ctx context.Context, in *ModelStatusRequest, opts ...grpc.CallOption) (Scheduler_ModelStatusClient, error)
 	PipelineStatus(ctx context.Context, in *PipelineStatusRequest, opts ...grpc.CallOption) (Scheduler_PipelineStatusClient, error)
 	ExperimentStatus(ctx context.Context, in *ExperimentStatusRequest, opts ...grpc.CallOption) (Scheduler_ExperimentStatusClient, error)
 	SchedulerStatus(ctx context.Context, in *SchedulerStatusRequest, opts ...grpc.CallOption) (*SchedulerStatusResponse, error)
+	ExplainSchedule(ctx context.Context, in *ExplainScheduleRequest, opts ...grpc.CallOption) (*ExplainScheduleResponse, error)
 	SubscribeServerStatus(ctx context.Context, in *ServerSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeServerStatusClient, error)
 	SubscribeModelStatus(ctx context.Context, in *ModelSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeModelStatusClient, error)
 	SubscribeExperimentStatus(ctx context.Context, in *ExperimentSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeExperimentStatusClient, error)
@@ -154,6 +160,26 @@ func (c *schedulerClient) StopExperiment(ctx context.Context, in *StopExperiment
 	return out, nil
 }
 
+func (c *schedulerClient) ExperimentFeedback(ctx context.Context, in *ExperimentFeedbackRequest, opts ...grpc.CallOption) (*ExperimentFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExperimentFeedbackResponse)
+	err := c.cc.Invoke(ctx, Scheduler_ExperimentFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ExperimentRequests(ctx context.Context, in *ExperimentRequestsRequest, opts ...grpc.CallOption) (*ExperimentRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExperimentRequestsResponse)
+	err := c.cc.Invoke(ctx, Scheduler_ExperimentRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) ServerStatus(ctx context.Context, in *ServerStatusRequest, opts ...grpc.CallOption) (Scheduler_ServerStatusClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Scheduler_ServiceDesc.Streams[0], Scheduler_ServerStatus_FullMethodName, cOpts...)
@@ -296,6 +322,16 @@ func (c *schedulerClient) SchedulerStatus(ctx context.Context, in *SchedulerStat
 	return out, nil
 }
 
+func (c *schedulerClient) ExplainSchedule(ctx context.Context, in *ExplainScheduleRequest, opts ...grpc.CallOption) (*ExplainScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainScheduleResponse)
+	err := c.cc.Invoke(ctx, Scheduler_ExplainSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) SubscribeServerStatus(ctx context.Context, in *ServerSubscriptionRequest, opts ...grpc.CallOption) (Scheduler_SubscribeServerStatusClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Scheduler_ServiceDesc.Streams[4], Scheduler_SubscribeServerStatus_FullMethodName, cOpts...)
@@ -492,11 +528,14 @@ type SchedulerServer interface {
 	UnloadPipeline(context.Context, *UnloadPipelineRequest) (*UnloadPipelineResponse, error)
 	StartExperiment(context.Context, *StartExperimentRequest) (*StartExperimentResponse, error)
 	StopExperiment(context.Context, *StopExperimentRequest) (*StopExperimentResponse, error)
+	ExperimentFeedback(context.Context, *ExperimentFeedbackRequest) (*ExperimentFeedbackResponse, error)
+	ExperimentRequests(context.Context, *ExperimentRequestsRequest) (*ExperimentRequestsResponse, error)
 	ServerStatus(*ServerStatusRequest, Scheduler_ServerStatusServer) error
 	ModelStatus(*ModelStatusRequest, Scheduler_ModelStatusServer) error
 	PipelineStatus(*PipelineStatusRequest, Scheduler_PipelineStatusServer) error
 	ExperimentStatus(*ExperimentStatusRequest, Scheduler_ExperimentStatusServer) error
 	SchedulerStatus(context.Context, *SchedulerStatusRequest) (*SchedulerStatusResponse, error)
+	ExplainSchedule(context.Context, *ExplainScheduleRequest) (*ExplainScheduleResponse, error)
 	SubscribeServerStatus(*ServerSubscriptionRequest, Scheduler_SubscribeServerStatusServer) error
 	SubscribeModelStatus(*ModelSubscriptionRequest, Scheduler_SubscribeModelStatusServer) error
 	SubscribeExperimentStatus(*ExperimentSubscriptionRequest, Scheduler_SubscribeExperimentStatusServer) error
@@ -533,6 +572,12 @@ func (UnimplementedSchedulerServer) StartExperiment(context.Context, *StartExper
 func (UnimplementedSchedulerServer) StopExperiment(context.Context, *StopExperimentRequest) (*StopExperimentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopExperiment not implemented")
 }
+func (UnimplementedSchedulerServer) ExperimentFeedback(context.Context, *ExperimentFeedbackRequest) (*ExperimentFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExperimentFeedback not implemented")
+}
+func (UnimplementedSchedulerServer) ExperimentRequests(context.Context, *ExperimentRequestsRequest) (*ExperimentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExperimentRequests not implemented")
+}
 func (UnimplementedSchedulerServer) ServerStatus(*ServerStatusRequest, Scheduler_ServerStatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method ServerStatus not implemented")
 }
@@ -548,6 +593,9 @@ func (UnimplementedSchedulerServer) ExperimentStatus(*ExperimentStatusRequest, S
 func (UnimplementedSchedulerServer) SchedulerStatus(context.Context, *SchedulerStatusRequest) (*SchedulerStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SchedulerStatus not implemented")
 }
+func (UnimplementedSchedulerServer) ExplainSchedule(context.Context, *ExplainScheduleRequest) (*ExplainScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSchedule not implemented")
+}
 func (UnimplementedSchedulerServer) SubscribeServerStatus(*ServerSubscriptionRequest, Scheduler_SubscribeServerStatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeServerStatus not implemented")
 }
@@ -708,6 +756,42 @@ func _Scheduler_StopExperiment_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_ExperimentFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExperimentFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ExperimentFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ExperimentFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ExperimentFeedback(ctx, req.(*ExperimentFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ExperimentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExperimentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ExperimentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ExperimentRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ExperimentRequests(ctx, req.(*ExperimentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Scheduler_ServerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ServerStatusRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -810,6 +894,24 @@ func _Scheduler_SchedulerStatus_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_ExplainSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ExplainSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ExplainSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ExplainSchedule(ctx, req.(*ExplainScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Scheduler_SubscribeServerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ServerSubscriptionRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -986,10 +1088,22 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopExperiment",
 			Handler:    _Scheduler_StopExperiment_Handler,
 		},
+		{
+			MethodName: "ExperimentFeedback",
+			Handler:    _Scheduler_ExperimentFeedback_Handler,
+		},
+		{
+			MethodName: "ExperimentRequests",
+			Handler:    _Scheduler_ExperimentRequests_Handler,
+		},
 		{
 			MethodName: "SchedulerStatus",
 			Handler:    _Scheduler_SchedulerStatus_Handler,
 		},
+		{
+			MethodName: "ExplainSchedule",
+			Handler:    _Scheduler_ExplainSchedule_Handler,
+		},
 		{
 			MethodName: "PipelineStatusEvent",
 			Handler:    _Scheduler_PipelineStatusEvent_Handler,
@@ -158,6 +158,270 @@ func (x *ExperimentSnapshot) GetDeleted() bool {
 	return false
 }
 
+// the rewards received for each candidate of a bandit experiment, so the weights of the candidates
+// are not learnt again from scratch when the scheduler restarts
+type BanditRewardsSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidates []*BanditRewardTotals `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *BanditRewardsSnapshot) Reset() {
+	*x = BanditRewardsSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_storage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanditRewardsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanditRewardsSnapshot) ProtoMessage() {}
+
+func (x *BanditRewardsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_storage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanditRewardsSnapshot.ProtoReflect.Descriptor instead.
+func (*BanditRewardsSnapshot) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BanditRewardsSnapshot) GetCandidates() []*BanditRewardTotals {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type BanditRewardTotals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidate string  `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Count     float64 `protobuf:"fixed64,2,opt,name=count,proto3" json:"count,omitempty"`
+	Sum       float64 `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+}
+
+func (x *BanditRewardTotals) Reset() {
+	*x = BanditRewardTotals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_storage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanditRewardTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanditRewardTotals) ProtoMessage() {}
+
+func (x *BanditRewardTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_storage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanditRewardTotals.ProtoReflect.Descriptor instead.
+func (*BanditRewardTotals) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_storage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BanditRewardTotals) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *BanditRewardTotals) GetCount() float64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BanditRewardTotals) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+type ModelSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// all versions of the model with their replica assignments and states as held by the scheduler
+	Versions []*ModelVersionStatus `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	Deleted  bool                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *ModelSnapshot) Reset() {
+	*x = ModelSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_storage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelSnapshot) ProtoMessage() {}
+
+func (x *ModelSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_storage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelSnapshot.ProtoReflect.Descriptor instead.
+func (*ModelSnapshot) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_storage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ModelSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModelSnapshot) GetVersions() []*ModelVersionStatus {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ModelSnapshot) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ServerSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shared bool   `protobuf:"varint,2,opt,name=shared,proto3" json:"shared,omitempty"`
+	// -1 if the expected number of replicas has not been notified yet
+	ExpectedReplicas int32           `protobuf:"varint,3,opt,name=expectedReplicas,proto3" json:"expectedReplicas,omitempty"`
+	MinReplicas      int32           `protobuf:"varint,4,opt,name=minReplicas,proto3" json:"minReplicas,omitempty"`
+	MaxReplicas      int32           `protobuf:"varint,5,opt,name=maxReplicas,proto3" json:"maxReplicas,omitempty"`
+	KubernetesMeta   *KubernetesMeta `protobuf:"bytes,6,opt,name=kubernetesMeta,proto3,oneof" json:"kubernetesMeta,omitempty"`
+}
+
+func (x *ServerSnapshot) Reset() {
+	*x = ServerSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mlops_scheduler_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSnapshot) ProtoMessage() {}
+
+func (x *ServerSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_mlops_scheduler_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSnapshot.ProtoReflect.Descriptor instead.
+func (*ServerSnapshot) Descriptor() ([]byte, []int) {
+	return file_mlops_scheduler_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServerSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerSnapshot) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *ServerSnapshot) GetExpectedReplicas() int32 {
+	if x != nil {
+		return x.ExpectedReplicas
+	}
+	return 0
+}
+
+func (x *ServerSnapshot) GetMinReplicas() int32 {
+	if x != nil {
+		return x.MinReplicas
+	}
+	return 0
+}
+
+func (x *ServerSnapshot) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *ServerSnapshot) GetKubernetesMeta() *KubernetesMeta {
+	if x != nil {
+		return x.KubernetesMeta
+	}
+	return nil
+}
+
 var File_mlops_scheduler_storage_proto protoreflect.FileDescriptor
 
 var file_mlops_scheduler_storage_proto_rawDesc = []byte{
@@ -184,7 +448,45 @@ var file_mlops_scheduler_storage_proto_rawDesc = []byte{
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
 	0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18,
 	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x63, 0x0a, 0x15, 0x42, 0x61, 0x6e, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x4a, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x42,
+	0x61, 0x6e, 0x64, 0x69, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x5a, 0x0a,
+	0x12, 0x42, 0x61, 0x6e, 0x64, 0x69, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x46, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x94, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x12, 0x53, 0x0a, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x6c, 0x64, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x0e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x69, 0x6f, 0x2f,
 	0x73, 0x65, 0x6c, 0x64, 0x6f, 0x6e, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x73,
 	0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x6c, 0x6f, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x68,
@@ -203,21 +505,30 @@ func file_mlops_scheduler_storage_proto_rawDescGZIP() []byte {
 	return file_mlops_scheduler_storage_proto_rawDescData
 }
 
-var file_mlops_scheduler_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mlops_scheduler_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_mlops_scheduler_storage_proto_goTypes = []any{
-	(*PipelineSnapshot)(nil),   // 0: seldon.mlops.scheduler.PipelineSnapshot
-	(*ExperimentSnapshot)(nil), // 1: seldon.mlops.scheduler.ExperimentSnapshot
-	(*PipelineWithState)(nil),  // 2: seldon.mlops.scheduler.PipelineWithState
-	(*Experiment)(nil),         // 3: seldon.mlops.scheduler.Experiment
+	(*PipelineSnapshot)(nil),      // 0: seldon.mlops.scheduler.PipelineSnapshot
+	(*ExperimentSnapshot)(nil),    // 1: seldon.mlops.scheduler.ExperimentSnapshot
+	(*BanditRewardsSnapshot)(nil), // 2: seldon.mlops.scheduler.BanditRewardsSnapshot
+	(*BanditRewardTotals)(nil),    // 3: seldon.mlops.scheduler.BanditRewardTotals
+	(*ModelSnapshot)(nil),         // 4: seldon.mlops.scheduler.ModelSnapshot
+	(*ServerSnapshot)(nil),        // 5: seldon.mlops.scheduler.ServerSnapshot
+	(*PipelineWithState)(nil),     // 6: seldon.mlops.scheduler.PipelineWithState
+	(*Experiment)(nil),            // 7: seldon.mlops.scheduler.Experiment
+	(*ModelVersionStatus)(nil),    // 8: seldon.mlops.scheduler.ModelVersionStatus
+	(*KubernetesMeta)(nil),        // 9: seldon.mlops.scheduler.KubernetesMeta
 }
 var file_mlops_scheduler_storage_proto_depIdxs = []int32{
-	2, // 0: seldon.mlops.scheduler.PipelineSnapshot.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
-	3, // 1: seldon.mlops.scheduler.ExperimentSnapshot.experiment:type_name -> seldon.mlops.scheduler.Experiment
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: seldon.mlops.scheduler.PipelineSnapshot.versions:type_name -> seldon.mlops.scheduler.PipelineWithState
+	7, // 1: seldon.mlops.scheduler.ExperimentSnapshot.experiment:type_name -> seldon.mlops.scheduler.Experiment
+	3, // 2: seldon.mlops.scheduler.BanditRewardsSnapshot.candidates:type_name -> seldon.mlops.scheduler.BanditRewardTotals
+	8, // 3: seldon.mlops.scheduler.ModelSnapshot.versions:type_name -> seldon.mlops.scheduler.ModelVersionStatus
+	9, // 4: seldon.mlops.scheduler.ServerSnapshot.kubernetesMeta:type_name -> seldon.mlops.scheduler.KubernetesMeta
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_mlops_scheduler_storage_proto_init() }
@@ -251,14 +562,63 @@ func file_mlops_scheduler_storage_proto_init() {
 				return nil
 			}
 		}
+		file_mlops_scheduler_storage_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BanditRewardsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_storage_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*BanditRewardTotals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_storage_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ModelSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mlops_scheduler_storage_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_mlops_scheduler_storage_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mlops_scheduler_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
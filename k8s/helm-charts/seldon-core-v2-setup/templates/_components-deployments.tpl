@@ -71,6 +71,14 @@ rules:
   verbs:
   - create
   - patch
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - get
+  - list
+  - watch
 - apiGroups:
   - apps
   resources:
@@ -91,6 +99,18 @@ rules:
   - statefulsets/status
   verbs:
   - get
+- apiGroups:
+  - batch
+  resources:
+  - jobs
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
 - apiGroups:
   - discovery.k8s.io
   resources:
@@ -101,6 +121,8 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - batchjobs
+  - ensembles
   - experiments
   - models
   - pipelines
@@ -119,18 +141,8 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
-  - experiments/finalizers
-  - models/finalizers
-  - pipelines/finalizers
-  - seldonconfigs/finalizers
-  - seldonruntimes/finalizers
-  - serverconfigs/finalizers
-  - servers/finalizers
-  verbs:
-  - update
-- apiGroups:
-  - mlops.seldon.io
-  resources:
+  - batchjobs/status
+  - ensembles/status
   - experiments/status
   - models/status
   - pipelines/status
@@ -142,6 +154,19 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - mlops.seldon.io
+  resources:
+  - ensembles/finalizers
+  - experiments/finalizers
+  - models/finalizers
+  - pipelines/finalizers
+  - seldonconfigs/finalizers
+  - seldonruntimes/finalizers
+  - serverconfigs/finalizers
+  - servers/finalizers
+  verbs:
+  - update
 - apiGroups:
   - rbac.authorization.k8s.io
   resources:
@@ -219,6 +244,14 @@ rules:
   verbs:
   - create
   - patch
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - get
+  - list
+  - watch
 - apiGroups:
   - apps
   resources:
@@ -239,6 +272,18 @@ rules:
   - statefulsets/status
   verbs:
   - get
+- apiGroups:
+  - batch
+  resources:
+  - jobs
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
 - apiGroups:
   - discovery.k8s.io
   resources:
@@ -249,6 +294,8 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - batchjobs
+  - ensembles
   - experiments
   - models
   - pipelines
@@ -267,18 +314,8 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
-  - experiments/finalizers
-  - models/finalizers
-  - pipelines/finalizers
-  - seldonconfigs/finalizers
-  - seldonruntimes/finalizers
-  - serverconfigs/finalizers
-  - servers/finalizers
-  verbs:
-  - update
-- apiGroups:
-  - mlops.seldon.io
-  resources:
+  - batchjobs/status
+  - ensembles/status
   - experiments/status
   - models/status
   - pipelines/status
@@ -290,6 +327,19 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - mlops.seldon.io
+  resources:
+  - ensembles/finalizers
+  - experiments/finalizers
+  - models/finalizers
+  - pipelines/finalizers
+  - seldonconfigs/finalizers
+  - seldonruntimes/finalizers
+  - serverconfigs/finalizers
+  - servers/finalizers
+  verbs:
+  - update
 - apiGroups:
   - rbac.authorization.k8s.io
   resources:
@@ -518,6 +568,11 @@ spec:
         - --envoy-accesslog-path=$(ENVOY_ACCESSLOG_PATH)
         - --enable-envoy-accesslog=$(ENABLE_ENVOY_ACCESSLOG)
         - --include-successful-requests-envoy-accesslog=$(INCLUDE_SUCCESSFUL_REQUESTS_ENVOY_ACCESSLOG)
+        - --envoy-jwt-issuer=$(ENVOY_JWT_ISSUER)
+        - --envoy-jwt-jwks-uri=$(ENVOY_JWT_JWKS_URI)
+        - --envoy-jwt-groups-claim=$(ENVOY_JWT_GROUPS_CLAIM)
+        - --envoy-jwt-tenant-claim=$(ENVOY_JWT_TENANT_CLAIM)
+        - --envoy-ext-authz-address=$(ENVOY_EXT_AUTHZ_ADDRESS)
         - --enable-model-autoscaling=$(ENABLE_MODEL_AUTOSCALING)
         - --enable-server-autoscaling=$(ENABLE_SERVER_AUTOSCALING)
         - --log-level=$(LOG_LEVEL)
@@ -587,6 +642,16 @@ spec:
           value: '{{ .Values.envoy.enableAccesslog }}'
         - name: INCLUDE_SUCCESSFUL_REQUESTS_ENVOY_ACCESSLOG
           value: '{{ .Values.envoy.includeSuccessfulRequests }}'
+        - name: ENVOY_JWT_ISSUER
+          value: '{{ .Values.envoy.auth.jwtIssuer }}'
+        - name: ENVOY_JWT_JWKS_URI
+          value: '{{ .Values.envoy.auth.jwksUri }}'
+        - name: ENVOY_JWT_GROUPS_CLAIM
+          value: '{{ .Values.envoy.auth.groupsClaim }}'
+        - name: ENVOY_JWT_TENANT_CLAIM
+          value: '{{ .Values.envoy.auth.tenantClaim }}'
+        - name: ENVOY_EXT_AUTHZ_ADDRESS
+          value: '{{ .Values.envoy.auth.extAuthzAddress }}'
         - name: ENABLE_MODEL_AUTOSCALING
           value: '{{ .Values.autoscaling.autoscalingModelEnabled }}'
         - name: ENABLE_SERVER_AUTOSCALING
@@ -1400,6 +1465,7 @@ spec:
         name: mlserver-models
     - args:
       - --tracing-config-path=/mnt/tracing/tracing.json
+      - --kafka-config-path=/mnt/kafka/kafka.json
       command:
       - /bin/agent
       env:
@@ -1508,6 +1574,14 @@ spec:
         valueFrom:
           fieldRef:
             fieldPath: metadata.namespace
+      - name: NODE_NAME
+        valueFrom:
+          fieldRef:
+            fieldPath: spec.nodeName
+      - name: TOPOLOGY_ZONE
+        valueFrom:
+          fieldRef:
+            fieldPath: metadata.labels['topology.kubernetes.io/zone']
       - name: MEMORY_REQUEST
         valueFrom:
           resourceFieldRef:
@@ -1545,6 +1619,8 @@ spec:
         name: config-volume
       - mountPath: /mnt/tracing
         name: tracing-config-volume
+      - mountPath: /mnt/kafka
+        name: kafka-config-volume
     - env:
       - name: MLSERVER_PARALLEL_WORKERS
         value: '{{ .Values.serverConfig.mlserver.parallel_workers }}'
@@ -1626,6 +1702,9 @@ spec:
     - configMap:
         name: seldon-tracing
       name: tracing-config-volume
+    - configMap:
+        name: seldon-kafka
+      name: kafka-config-volume
     - emptyDir: {}
       name: mlserver-models
 ---
@@ -1673,6 +1752,7 @@ spec:
         name: triton-models
     - args:
       - --tracing-config-path=/mnt/tracing/tracing.json
+      - --kafka-config-path=/mnt/kafka/kafka.json
       command:
       - /bin/agent
       env:
@@ -1773,6 +1853,14 @@ spec:
         valueFrom:
           fieldRef:
             fieldPath: metadata.namespace
+      - name: NODE_NAME
+        valueFrom:
+          fieldRef:
+            fieldPath: spec.nodeName
+      - name: TOPOLOGY_ZONE
+        valueFrom:
+          fieldRef:
+            fieldPath: metadata.labels['topology.kubernetes.io/zone']
       - name: MEMORY_REQUEST
         valueFrom:
           resourceFieldRef:
@@ -1810,6 +1898,8 @@ spec:
         name: config-volume
       - mountPath: /mnt/tracing
         name: tracing-config-volume
+      - mountPath: /mnt/kafka
+        name: kafka-config-volume
     - args:
       - -c
       - tritonserver --model-repository=$(SERVER_MODELS_DIR) --http-port=$(SERVER_HTTP_PORT)
@@ -1889,6 +1979,9 @@ spec:
     - configMap:
         name: seldon-tracing
       name: tracing-config-volume
+    - configMap:
+        name: seldon-kafka
+      name: kafka-config-volume
     - emptyDir:
         medium: Memory
         sizeLimit: 256Mi
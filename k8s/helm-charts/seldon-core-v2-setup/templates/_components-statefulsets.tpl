@@ -101,6 +101,7 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - ensembles
   - experiments
   - models
   - pipelines
@@ -119,6 +120,7 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - ensembles/finalizers
   - experiments/finalizers
   - models/finalizers
   - pipelines/finalizers
@@ -131,6 +133,7 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - ensembles/status
   - experiments/status
   - models/status
   - pipelines/status
@@ -249,6 +252,7 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - ensembles
   - experiments
   - models
   - pipelines
@@ -267,6 +271,7 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - ensembles/finalizers
   - experiments/finalizers
   - models/finalizers
   - pipelines/finalizers
@@ -279,6 +284,7 @@ rules:
 - apiGroups:
   - mlops.seldon.io
   resources:
+  - ensembles/status
   - experiments/status
   - models/status
   - pipelines/status
@@ -518,6 +524,11 @@ spec:
         - --envoy-accesslog-path=$(ENVOY_ACCESSLOG_PATH)
         - --enable-envoy-accesslog=$(ENABLE_ENVOY_ACCESSLOG)
         - --include-successful-requests-envoy-accesslog=$(INCLUDE_SUCCESSFUL_REQUESTS_ENVOY_ACCESSLOG)
+        - --envoy-jwt-issuer=$(ENVOY_JWT_ISSUER)
+        - --envoy-jwt-jwks-uri=$(ENVOY_JWT_JWKS_URI)
+        - --envoy-jwt-groups-claim=$(ENVOY_JWT_GROUPS_CLAIM)
+        - --envoy-jwt-tenant-claim=$(ENVOY_JWT_TENANT_CLAIM)
+        - --envoy-ext-authz-address=$(ENVOY_EXT_AUTHZ_ADDRESS)
         - --enable-model-autoscaling=$(ENABLE_MODEL_AUTOSCALING)
         - --enable-server-autoscaling=$(ENABLE_SERVER_AUTOSCALING)
         - --log-level=$(LOG_LEVEL)
@@ -587,6 +598,16 @@ spec:
           value: '{{ .Values.envoy.enableAccesslog }}'
         - name: INCLUDE_SUCCESSFUL_REQUESTS_ENVOY_ACCESSLOG
           value: '{{ .Values.envoy.includeSuccessfulRequests }}'
+        - name: ENVOY_JWT_ISSUER
+          value: '{{ .Values.envoy.auth.jwtIssuer }}'
+        - name: ENVOY_JWT_JWKS_URI
+          value: '{{ .Values.envoy.auth.jwksUri }}'
+        - name: ENVOY_JWT_GROUPS_CLAIM
+          value: '{{ .Values.envoy.auth.groupsClaim }}'
+        - name: ENVOY_JWT_TENANT_CLAIM
+          value: '{{ .Values.envoy.auth.tenantClaim }}'
+        - name: ENVOY_EXT_AUTHZ_ADDRESS
+          value: '{{ .Values.envoy.auth.extAuthzAddress }}'
         - name: ENABLE_MODEL_AUTOSCALING
           value: '{{ .Values.autoscaling.autoscalingModelEnabled }}'
         - name: ENABLE_SERVER_AUTOSCALING
@@ -1421,6 +1442,7 @@ spec:
         name: mlserver-models
     - args:
       - --tracing-config-path=/mnt/tracing/tracing.json
+      - --kafka-config-path=/mnt/kafka/kafka.json
       command:
       - /bin/agent
       env:
@@ -1527,6 +1549,14 @@ spec:
         valueFrom:
           fieldRef:
             fieldPath: metadata.namespace
+      - name: NODE_NAME
+        valueFrom:
+          fieldRef:
+            fieldPath: spec.nodeName
+      - name: TOPOLOGY_ZONE
+        valueFrom:
+          fieldRef:
+            fieldPath: metadata.labels['topology.kubernetes.io/zone']
       - name: MEMORY_REQUEST
         valueFrom:
           resourceFieldRef:
@@ -1578,6 +1608,8 @@ spec:
         name: config-volume
       - mountPath: /mnt/tracing
         name: tracing-config-volume
+      - mountPath: /mnt/kafka
+        name: kafka-config-volume
     - env:
       - name: MLSERVER_PARALLEL_WORKERS
         value: '{{ .Values.serverConfig.mlserver.parallel_workers }}'
@@ -1661,6 +1693,9 @@ spec:
     - configMap:
         name: seldon-tracing
       name: tracing-config-volume
+    - configMap:
+        name: seldon-kafka
+      name: kafka-config-volume
   volumeClaimTemplates:
   - name: mlserver-models
     spec:
@@ -1735,6 +1770,7 @@ spec:
         name: triton-models
     - args:
       - --tracing-config-path=/mnt/tracing/tracing.json
+      - --kafka-config-path=/mnt/kafka/kafka.json
       command:
       - /bin/agent
       env:
@@ -1833,6 +1869,14 @@ spec:
         valueFrom:
           fieldRef:
             fieldPath: metadata.namespace
+      - name: NODE_NAME
+        valueFrom:
+          fieldRef:
+            fieldPath: spec.nodeName
+      - name: TOPOLOGY_ZONE
+        valueFrom:
+          fieldRef:
+            fieldPath: metadata.labels['topology.kubernetes.io/zone']
       - name: MEMORY_REQUEST
         valueFrom:
           resourceFieldRef:
@@ -1884,6 +1928,8 @@ spec:
         name: config-volume
       - mountPath: /mnt/tracing
         name: tracing-config-volume
+      - mountPath: /mnt/kafka
+        name: kafka-config-volume
     - args:
       - -c
       - tritonserver --model-repository=$(SERVER_MODELS_DIR) --http-port=$(SERVER_HTTP_PORT)
@@ -1963,6 +2009,9 @@ spec:
     - configMap:
         name: seldon-tracing
       name: tracing-config-volume
+    - configMap:
+        name: seldon-kafka
+      name: kafka-config-volume
     - emptyDir:
         medium: Memory
         sizeLimit: 256Mi
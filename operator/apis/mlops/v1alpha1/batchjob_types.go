@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// BatchJobSpec defines the desired state of BatchJob
+type BatchJobSpec struct {
+	// Model to send the records to, exactly one of model and pipeline is required
+	// +optional
+	Model string `json:"model,omitempty"`
+	// Pipeline to send the records to
+	// +optional
+	Pipeline string `json:"pipeline,omitempty"`
+	// Records to score
+	Input BatchJobInput `json:"input"`
+	// Local path or rclone URI of the directory the outputs and the status report are written to
+	OutputUri string `json:"outputUri"`
+	// Secret with the rclone configuration of the input and output storage
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+	// Number of requests sent at the same time, defaults to 4
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	// Number of retries of requests failing with a transient error, defaults to 3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// Timeout of each request in seconds, defaults to 60
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequestTimeoutSeconds *int32 `json:"requestTimeoutSeconds,omitempty"`
+	// Resources of the job runner
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type BatchJobInputFormat string
+
+const (
+	CsvBatchJobInputFormat     BatchJobInputFormat = "csv"
+	JsonlBatchJobInputFormat   BatchJobInputFormat = "jsonl"
+	ParquetBatchJobInputFormat BatchJobInputFormat = "parquet"
+)
+
+// BatchJobInput is a file in storage or a Kafka topic, exactly one of which is required
+type BatchJobInput struct {
+	// Local path or rclone URI of the input file
+	// +optional
+	StorageUri string `json:"storageUri,omitempty"`
+	// Format of the input file, defaults to jsonl
+	// +kubebuilder:validation:Enum=csv;jsonl;parquet
+	// +optional
+	Format BatchJobInputFormat `json:"format,omitempty"`
+	// Kafka topic whose messages are read from the earliest offset to the latest when the job starts
+	// +optional
+	KafkaTopic string `json:"kafkaTopic,omitempty"`
+	// Send the columns of each record as a single tensor with this name instead of a tensor per column
+	// +optional
+	TensorName string `json:"tensorName,omitempty"`
+}
+
+type BatchJobState string
+
+const (
+	BatchJobPending   BatchJobState = "Pending"
+	BatchJobRunning   BatchJobState = "Running"
+	BatchJobSucceeded BatchJobState = "Succeeded"
+	BatchJobFailed    BatchJobState = "Failed"
+)
+
+// BatchJobStatus defines the observed state of BatchJob
+type BatchJobStatus struct {
+	duckv1.Status `json:",inline"`
+	State         BatchJobState `json:"state,omitempty"`
+	// Number of records read, set once the job has finished
+	Total int64 `json:"total,omitempty"`
+	// Number of records with a response
+	Succeeded int64 `json:"succeeded,omitempty"`
+	// Number of records that could not be converted to a request or whose request failed
+	Failed         int64        `json:"failed,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=mlbj
+//+kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="Job state"
+//+kubebuilder:printcolumn:name="Succeeded",type=integer,JSONPath=`.status.succeeded`,description="Records with a response"
+//+kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`,description="Records without a response"
+//+kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=='Ready')].message`,description="Status message"
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+//+genclient
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BatchJob is the Schema for the batchjobs API
+type BatchJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BatchJobSpec   `json:"spec,omitempty"`
+	Status BatchJobStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BatchJobList contains a list of BatchJob
+type BatchJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BatchJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BatchJob{}, &BatchJobList{})
+}
+
+func (b *BatchJob) Validate() error {
+	if (b.Spec.Model == "") == (b.Spec.Pipeline == "") {
+		return fmt.Errorf("batch job %s requires exactly one of model or pipeline", b.Name)
+	}
+	if (b.Spec.Input.StorageUri == "") == (b.Spec.Input.KafkaTopic == "") {
+		return fmt.Errorf("batch job %s requires exactly one of input storageUri or kafkaTopic", b.Name)
+	}
+	if b.Spec.OutputUri == "" {
+		return fmt.Errorf("batch job %s requires an outputUri", b.Name)
+	}
+	return nil
+}
+
+const (
+	BatchJobComplete apis.ConditionType = "JobComplete"
+)
+
+var batchJobConditionSet = apis.NewLivingConditionSet(
+	BatchJobComplete,
+)
+
+var _ apis.ConditionsAccessor = (*BatchJobStatus)(nil)
+
+func (bs *BatchJobStatus) InitializeConditions() {
+	batchJobConditionSet.Manage(bs).InitializeConditions()
+}
+
+func (bs *BatchJobStatus) IsReady() bool {
+	return batchJobConditionSet.Manage(bs).IsHappy()
+}
+
+func (bs *BatchJobStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return batchJobConditionSet.Manage(bs).GetCondition(t)
+}
+
+func (bs *BatchJobStatus) SetCondition(conditionType apis.ConditionType, condition *apis.Condition) {
+	switch {
+	case condition == nil:
+		batchJobConditionSet.Manage(bs).MarkUnknown(conditionType, "", "")
+	case condition.Status == v1.ConditionUnknown:
+		batchJobConditionSet.Manage(bs).MarkUnknown(conditionType, condition.Reason, condition.Message)
+	case condition.Status == v1.ConditionTrue:
+		batchJobConditionSet.Manage(bs).MarkTrueWithReason(conditionType, condition.Reason, condition.Message)
+	case condition.Status == v1.ConditionFalse:
+		batchJobConditionSet.Manage(bs).MarkFalse(conditionType, condition.Reason, condition.Message)
+	}
+}
+
+// SetState sets the state with the job condition, which is unknown until the job has finished
+func (bs *BatchJobStatus) SetState(state BatchJobState, message string) {
+	bs.State = state
+	switch state {
+	case BatchJobSucceeded:
+		batchJobConditionSet.Manage(bs).MarkTrueWithReason(BatchJobComplete, string(state), message)
+	case BatchJobFailed:
+		batchJobConditionSet.Manage(bs).MarkFalse(BatchJobComplete, string(state), message)
+	default:
+		batchJobConditionSet.Manage(bs).MarkUnknown(BatchJobComplete, string(state), message)
+	}
+}
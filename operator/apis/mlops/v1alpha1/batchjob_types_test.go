@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBatchJobValidate(t *testing.T) {
+	t.Parallel()
+
+	g := NewGomegaWithT(t)
+	type test struct {
+		name  string
+		spec  BatchJobSpec
+		valid bool
+	}
+
+	tests := []test{
+		{
+			name: "model with file input",
+			spec: BatchJobSpec{
+				Model:     "iris",
+				Input:     BatchJobInput{StorageUri: "s3://bucket/input.csv", Format: CsvBatchJobInputFormat},
+				OutputUri: "s3://bucket/results",
+			},
+			valid: true,
+		},
+		{
+			name: "pipeline with kafka input",
+			spec: BatchJobSpec{
+				Pipeline:  "p",
+				Input:     BatchJobInput{KafkaTopic: "requests"},
+				OutputUri: "s3://bucket/results",
+			},
+			valid: true,
+		},
+		{
+			name: "model and pipeline",
+			spec: BatchJobSpec{
+				Model:     "iris",
+				Pipeline:  "p",
+				Input:     BatchJobInput{KafkaTopic: "requests"},
+				OutputUri: "s3://bucket/results",
+			},
+		},
+		{
+			name: "no input",
+			spec: BatchJobSpec{
+				Model:     "iris",
+				OutputUri: "s3://bucket/results",
+			},
+		},
+		{
+			name: "file and kafka input",
+			spec: BatchJobSpec{
+				Model:     "iris",
+				Input:     BatchJobInput{StorageUri: "s3://bucket/input.csv", KafkaTopic: "requests"},
+				OutputUri: "s3://bucket/results",
+			},
+		},
+		{
+			name: "no output",
+			spec: BatchJobSpec{
+				Model: "iris",
+				Input: BatchJobInput{KafkaTopic: "requests"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			batchJob := &BatchJob{ObjectMeta: metav1.ObjectMeta{Name: "job"}, Spec: test.spec}
+			err := batchJob.Validate()
+			if test.valid {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).ToNot(BeNil())
+			}
+		})
+	}
+}
+
+func TestBatchJobSetState(t *testing.T) {
+	t.Parallel()
+
+	g := NewGomegaWithT(t)
+	type test struct {
+		name            string
+		state           BatchJobState
+		expectedStatus  v1.ConditionStatus
+		expectedIsReady bool
+	}
+
+	tests := []test{
+		{name: "pending", state: BatchJobPending, expectedStatus: v1.ConditionUnknown},
+		{name: "running", state: BatchJobRunning, expectedStatus: v1.ConditionUnknown},
+		{name: "succeeded", state: BatchJobSucceeded, expectedStatus: v1.ConditionTrue, expectedIsReady: true},
+		{name: "failed", state: BatchJobFailed, expectedStatus: v1.ConditionFalse},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := &BatchJobStatus{}
+			status.SetState(test.state, "message")
+			g.Expect(status.State).To(Equal(test.state))
+			g.Expect(status.GetCondition(BatchJobComplete).Status).To(Equal(test.expectedStatus))
+			g.Expect(status.GetCondition(BatchJobComplete).Message).To(Equal("message"))
+			g.Expect(status.IsReady()).To(Equal(test.expectedIsReady))
+		})
+	}
+}
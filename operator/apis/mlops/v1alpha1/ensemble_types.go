@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+// EnsembleSpec defines the desired state of Ensemble
+type EnsembleSpec struct {
+	// Models each request is sent to in parallel
+	// +kubebuilder:validation:MinItems=1
+	Models []string `json:"models"`
+	// How the responses of the models are combined, defaults to mean
+	// +optional
+	Aggregation EnsembleAggregation `json:"aggregation,omitempty"`
+	// Timeout of the requests to the models in milliseconds, no timeout by default
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutMs int32 `json:"timeoutMs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=mean;vote;concat;first-successful
+type EnsembleAggregation string
+
+const (
+	// element-wise mean of the tensors of the models with the same name
+	EnsembleAggregationMean EnsembleAggregation = "mean"
+	// element-wise most common value of the tensors of the models with the same name
+	EnsembleAggregationVote EnsembleAggregation = "vote"
+	// tensors of all the models, named after their model
+	EnsembleAggregationConcat EnsembleAggregation = "concat"
+	// response of the first model to succeed
+	EnsembleAggregationFirstSuccessful EnsembleAggregation = "first-successful"
+)
+
+// EnsembleStatus defines the observed state of Ensemble
+type EnsembleStatus struct {
+	duckv1.Status `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=mle
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=='Ready')].status`,description="Ensemble ready status"
+//+kubebuilder:printcolumn:name="Models ready",type=string,JSONPath=`.status.conditions[?(@.type=='ModelsReady')].status`,description="Models ready status",priority=1
+//+kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=='Ready')].message`,description="Status message"
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+//+genclient
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Ensemble is the Schema for the ensembles API
+type Ensemble struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnsembleSpec   `json:"spec,omitempty"`
+	Status EnsembleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EnsembleList contains a list of Ensemble
+type EnsembleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Ensemble `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Ensemble{}, &EnsembleList{})
+}
+
+// AsSchedulerPipeline returns the ensemble as a pipeline with a step per model, which the scheduler routes
+// like a model to the pipeline gateway
+func (e *Ensemble) AsSchedulerPipeline() *scheduler.Pipeline {
+	steps := make([]*scheduler.PipelineStep, 0, len(e.Spec.Models))
+	for _, model := range e.Spec.Models {
+		steps = append(steps, &scheduler.PipelineStep{Name: model})
+	}
+	ensemble := &scheduler.EnsembleSpec{
+		TimeoutMs: uint32(e.Spec.TimeoutMs),
+	}
+	switch e.Spec.Aggregation {
+	case EnsembleAggregationVote:
+		ensemble.Aggregation = scheduler.EnsembleSpec_VOTE
+	case EnsembleAggregationConcat:
+		ensemble.Aggregation = scheduler.EnsembleSpec_CONCAT
+	case EnsembleAggregationFirstSuccessful:
+		ensemble.Aggregation = scheduler.EnsembleSpec_FIRST_SUCCESSFUL
+	default:
+		ensemble.Aggregation = scheduler.EnsembleSpec_MEAN
+	}
+	return &scheduler.Pipeline{
+		Name:           e.GetName(),
+		Uid:            "", // set on scheduler side as for pipelines
+		Steps:          steps,
+		KubernetesMeta: &scheduler.KubernetesMeta{Namespace: e.Namespace, Generation: e.Generation},
+		Ensemble:       ensemble,
+	}
+}
+
+const (
+	EnsembleReady apis.ConditionType = "EnsembleReady"
+)
+
+var ensembleConditionSet = apis.NewLivingConditionSet(
+	EnsembleReady,
+	ModelsReady,
+)
+
+var _ apis.ConditionsAccessor = (*EnsembleStatus)(nil)
+
+func (es *EnsembleStatus) InitializeConditions() {
+	ensembleConditionSet.Manage(es).InitializeConditions()
+}
+
+func (es *EnsembleStatus) IsReady() bool {
+	return ensembleConditionSet.Manage(es).IsHappy()
+}
+
+func (es *EnsembleStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return ensembleConditionSet.Manage(es).GetCondition(t)
+}
+
+func (es *EnsembleStatus) SetCondition(conditionType apis.ConditionType, condition *apis.Condition) {
+	switch {
+	case condition == nil:
+		return
+	case condition.Status == v1.ConditionUnknown:
+		ensembleConditionSet.Manage(es).MarkUnknown(conditionType, condition.Reason, condition.Message)
+	case condition.Status == v1.ConditionTrue:
+		ensembleConditionSet.Manage(es).MarkTrueWithReason(conditionType, condition.Reason, condition.Message)
+	case condition.Status == v1.ConditionFalse:
+		ensembleConditionSet.Manage(es).MarkFalse(conditionType, condition.Reason, condition.Message)
+	}
+}
+
+func (es *EnsembleStatus) CreateAndSetCondition(
+	conditionType apis.ConditionType,
+	isTrue bool,
+	message string,
+	reason string,
+) {
+	condition := apis.Condition{}
+	if isTrue {
+		condition.Status = v1.ConditionTrue
+	} else {
+		condition.Status = v1.ConditionFalse
+	}
+	condition.Type = conditionType
+	condition.Message = message
+	condition.Reason = reason
+	condition.LastTransitionTime = apis.VolatileTime{
+		Inner: metav1.Now(),
+	}
+	es.SetCondition(conditionType, &condition)
+}
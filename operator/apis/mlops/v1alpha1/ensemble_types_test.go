@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	scheduler "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestEnsembleAsSchedulerPipeline(t *testing.T) {
+	t.Parallel()
+
+	g := NewGomegaWithT(t)
+	type test struct {
+		name     string
+		ensemble *Ensemble
+		proto    *scheduler.Pipeline
+	}
+
+	tests := []test{
+		{
+			name: "default aggregation",
+			ensemble: &Ensemble{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: EnsembleSpec{
+					Models: []string{"a", "b"},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+					{
+						Name: "b",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Ensemble: &scheduler.EnsembleSpec{
+					Aggregation: scheduler.EnsembleSpec_MEAN,
+				},
+			},
+		},
+		{
+			name: "vote with timeout",
+			ensemble: &Ensemble{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Spec: EnsembleSpec{
+					Models:      []string{"a", "b", "c"},
+					Aggregation: EnsembleAggregationVote,
+					TimeoutMs:   2000,
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+					{
+						Name: "b",
+					},
+					{
+						Name: "c",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Ensemble: &scheduler.EnsembleSpec{
+					Aggregation: scheduler.EnsembleSpec_VOTE,
+					TimeoutMs:   2000,
+				},
+			},
+		},
+		{
+			name: "first successful",
+			ensemble: &Ensemble{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: EnsembleSpec{
+					Models:      []string{"a"},
+					Aggregation: EnsembleAggregationFirstSuccessful,
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace: "default",
+				},
+				Ensemble: &scheduler.EnsembleSpec{
+					Aggregation: scheduler.EnsembleSpec_FIRST_SUCCESSFUL,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			proto := test.ensemble.AsSchedulerPipeline()
+			g.Expect(proto).To(Equal(test.proto))
+		})
+	}
+}
@@ -34,11 +34,52 @@ type ExperimentSpec struct {
 	Candidates   []ExperimentCandidate `json:"candidates"`
 	Mirror       *ExperimentMirror     `json:"mirror,omitempty"`
 	ResourceType ResourceType          `json:"resourceType,omitempty"`
+	// Additional mirrors receiving a copy of the traffic to the default
+	// +optional
+	Mirrors []ExperimentMirror `json:"mirrors,omitempty"`
+	// Capture the responses of the default and its mirrors to kafka so they can be compared offline
+	// +optional
+	CaptureResponses bool `json:"captureResponses,omitempty"`
+	// Adapt the candidate weights to the rewards submitted for the requests each candidate served
+	// +optional
+	Bandit *ExperimentBandit `json:"bandit,omitempty"`
+}
+
+type BanditStrategy string
+
+const (
+	EpsilonGreedyBanditStrategy    BanditStrategy = "epsilon-greedy"
+	ThompsonSamplingBanditStrategy BanditStrategy = "thompson-sampling"
+)
+
+type ExperimentBandit struct {
+	// +kubebuilder:validation:Enum=epsilon-greedy;thompson-sampling
+	Strategy BanditStrategy `json:"strategy"`
+	// Percentage of traffic split evenly across all candidates to keep exploring, for epsilon-greedy
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	EpsilonPercent int32 `json:"epsilonPercent,omitempty"`
+	// Seconds between recomputing the candidate weights, defaults to 60
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	UpdateIntervalSeconds int32 `json:"updateIntervalSeconds,omitempty"`
 }
 
 type ExperimentCandidate struct {
 	Name   string `json:"name"`
 	Weight uint32 `json:"weight"`
+	// Requests matching all the rules are routed to this candidate ahead of the weighted split
+	// +optional
+	Matches []ExperimentMatch `json:"matches,omitempty"`
+}
+
+// ExperimentMatch matches a request header or query parameter on an exact value or regex
+type ExperimentMatch struct {
+	Header         string `json:"header,omitempty"`
+	QueryParameter string `json:"queryParameter,omitempty"`
+	Exact          string `json:"exact,omitempty"`
+	Regex          string `json:"regex,omitempty"`
 }
 
 type ExperimentMirror struct {
@@ -86,9 +127,19 @@ func init() {
 }
 
 func asSchedulerCandidate(candidate *ExperimentCandidate) *scheduler.ExperimentCandidate {
+	var matches []*scheduler.ExperimentMatch
+	for _, match := range candidate.Matches {
+		matches = append(matches, &scheduler.ExperimentMatch{
+			Header:         match.Header,
+			QueryParameter: match.QueryParameter,
+			Exact:          match.Exact,
+			Regex:          match.Regex,
+		})
+	}
 	return &scheduler.ExperimentCandidate{
-		Name:   candidate.Name,
-		Weight: candidate.Weight,
+		Name:    candidate.Name,
+		Weight:  candidate.Weight,
+		Matches: matches,
 	}
 }
 
@@ -104,6 +155,13 @@ func (e *Experiment) AsSchedulerExperimentRequest() *scheduler.Experiment {
 			Percent: e.Spec.Mirror.Percent,
 		}
 	}
+	var mirrors []*scheduler.ExperimentMirror
+	for _, m := range e.Spec.Mirrors {
+		mirrors = append(mirrors, &scheduler.ExperimentMirror{
+			Name:    m.Name,
+			Percent: m.Percent,
+		})
+	}
 	var resourceType scheduler.ResourceType
 	switch e.Spec.ResourceType {
 	case PipelineResourceType:
@@ -111,6 +169,21 @@ func (e *Experiment) AsSchedulerExperimentRequest() *scheduler.Experiment {
 	default:
 		resourceType = scheduler.ResourceType_MODEL
 	}
+	var bandit *scheduler.BanditConfig
+	if e.Spec.Bandit != nil {
+		var strategy scheduler.BanditConfig_BanditStrategy
+		switch e.Spec.Bandit.Strategy {
+		case ThompsonSamplingBanditStrategy:
+			strategy = scheduler.BanditConfig_THOMPSON_SAMPLING
+		default:
+			strategy = scheduler.BanditConfig_EPSILON_GREEDY
+		}
+		bandit = &scheduler.BanditConfig{
+			Strategy:              strategy,
+			Epsilon:               float32(e.Spec.Bandit.EpsilonPercent) / 100,
+			UpdateIntervalSeconds: uint32(e.Spec.Bandit.UpdateIntervalSeconds),
+		}
+	}
 	return &scheduler.Experiment{
 		Name:       e.Name,
 		Default:    e.Spec.Default,
@@ -120,7 +193,10 @@ func (e *Experiment) AsSchedulerExperimentRequest() *scheduler.Experiment {
 			Namespace:  e.Namespace,
 			Generation: e.Generation,
 		},
-		ResourceType: resourceType,
+		ResourceType:     resourceType,
+		Bandit:           bandit,
+		Mirrors:          mirrors,
+		CaptureResponses: e.Spec.CaptureResponses,
 	}
 }
 
@@ -84,6 +84,176 @@ func TestAsSchedulerExperimentRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "model with matches",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 100,
+						},
+						{
+							Name:   "model2",
+							Weight: 0,
+							Matches: []ExperimentMatch{
+								{
+									Header: "x-tenant",
+									Exact:  "foo",
+								},
+								{
+									QueryParameter: "region",
+									Regex:          "eu-.*",
+								},
+							},
+						},
+					},
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+					{
+						Name:   "model2",
+						Weight: 0,
+						Matches: []*scheduler.ExperimentMatch{
+							{
+								Header: "x-tenant",
+								Exact:  "foo",
+							},
+							{
+								QueryParameter: "region",
+								Regex:          "eu-.*",
+							},
+						},
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
+		{
+			name: "model with bandit",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 50,
+						},
+						{
+							Name:   "model2",
+							Weight: 50,
+						},
+					},
+					Bandit: &ExperimentBandit{
+						Strategy:              EpsilonGreedyBanditStrategy,
+						EpsilonPercent:        10,
+						UpdateIntervalSeconds: 30,
+					},
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Bandit: &scheduler.BanditConfig{
+					Strategy:              scheduler.BanditConfig_EPSILON_GREEDY,
+					Epsilon:               0.1,
+					UpdateIntervalSeconds: 30,
+				},
+			},
+		},
+		{
+			name: "model with mirrors and capture",
+			experiment: &Experiment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: ExperimentSpec{
+					Default: getStrPtr("model1"),
+					Candidates: []ExperimentCandidate{
+						{
+							Name:   "model1",
+							Weight: 100,
+						},
+					},
+					Mirror: &ExperimentMirror{
+						Name:    "model2",
+						Percent: 100,
+					},
+					Mirrors: []ExperimentMirror{
+						{
+							Name:    "model3",
+							Percent: 50,
+						},
+					},
+					CaptureResponses: true,
+				},
+			},
+			proto: &scheduler.Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: scheduler.ResourceType_MODEL,
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+				},
+				Mirror: &scheduler.ExperimentMirror{
+					Name:    "model2",
+					Percent: 100,
+				},
+				Mirrors: []*scheduler.ExperimentMirror{
+					{
+						Name:    "model3",
+						Percent: 50,
+					},
+				},
+				CaptureResponses: true,
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+			},
+		},
 		{
 			name: "pipeline",
 			experiment: &Experiment{
@@ -17,6 +17,13 @@ import (
 
 const (
 	ModelReady apis.ConditionType = "ModelReady"
+	// ModelPlacementPreferred is false when the replicas of the model are not placed as preferred by the
+	// scheduler, e.g. not spread across zones. It does not affect the readiness of the model.
+	ModelPlacementPreferred apis.ConditionType = "ModelPlacementPreferred"
+)
+
+const (
+	SchedulingWarningReason = "SchedulingWarning"
 )
 
 var modelConditionSet = apis.NewLivingConditionSet(
@@ -74,3 +81,13 @@ func (ms *ModelStatus) CreateAndSetCondition(
 	}
 	ms.SetCondition(conditionType, &condition)
 }
+
+// SetSchedulingWarning sets the placement condition from the scheduling warning of the model, clearing it
+// when there is no warning
+func (ms *ModelStatus) SetSchedulingWarning(warning string) {
+	if warning == "" {
+		_ = modelConditionSet.Manage(ms).ClearCondition(ModelPlacementPreferred)
+		return
+	}
+	modelConditionSet.Manage(ms).MarkFalse(ModelPlacementPreferred, SchedulingWarningReason, "%s", warning)
+}
@@ -40,6 +40,40 @@ type ModelSpec struct {
 	// Dedicated server exclusive to this model
 	// Default false
 	Dedicated bool `json:"dedicated,omitempty"`
+	// Keep the model in memory on the server replicas it is loaded on. A pinned model is
+	// never evicted when the server is over committed, whichever the cache eviction policy.
+	// Default false
+	Pinned bool `json:"pinned,omitempty"`
+	// Scheduling priority of the model. A model with a higher priority can preempt
+	// models with a lower priority from server replicas that do not have enough memory.
+	// Default 0
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+	// Canary rollout of new versions of the model. When set, traffic is progressively
+	// shifted to a new version and rolled back if the new version is unhealthy.
+	// +optional
+	Canary *CanarySpec `json:"canary,omitempty"`
+	// Batching of concurrent inference requests by the agent before they are sent to the model.
+	// Suited to small models whose throughput improves with larger batches.
+	// +optional
+	Batching *BatchingSpec `json:"batching,omitempty"`
+	// Caching by the agent of the responses to identical inference requests.
+	// Only suited to deterministic models.
+	// +optional
+	ResponseCache *ResponseCacheSpec `json:"responseCache,omitempty"`
+	// Limits on the inference requests sent by the agent to the model at the same time,
+	// requests over the limits are queued and rejected once the queue is full.
+	// +optional
+	Concurrency *ConcurrencySpec `json:"concurrency,omitempty"`
+	// Callers allowed to infer with the model, checked by envoy against their JWT.
+	// +optional
+	Auth *AuthSpec `json:"auth,omitempty"`
+	// Rate of inference requests to the model allowed by envoy, requests over the limit are rejected.
+	// +optional
+	RateLimit *RateLimitSpec `json:"rateLimit,omitempty"`
+	// Timeouts, retries, outlier detection and load balancing of the inference requests routed by envoy.
+	// +optional
+	Routing *RoutingSpec `json:"routing,omitempty"`
 	// Payload logging
 	Logger *LoggingSpec `json:"logger,omitempty"`
 	// Explainer spec
@@ -93,6 +127,296 @@ type DataflowSpec struct {
 	// should be cleaned up when the model is deleted
 	// Default false
 	CleanTopicsOnDelete bool `json:"cleanTopicsOnDelete,omitempty"`
+	// How the steps of a pipeline are run, defaults to kafka.
+	// Synchronous pipelines are run by the pipeline gateway calling the models directly, without Kafka.
+	// Only used by pipelines.
+	// +kubebuilder:validation:Enum=kafka;synchronous
+	// +optional
+	Mode DataflowMode `json:"mode,omitempty"`
+}
+
+type DataflowMode string
+
+const (
+	KafkaDataflowMode       DataflowMode = "kafka"
+	SynchronousDataflowMode DataflowMode = "synchronous"
+)
+
+// AuthSpec restricts inference to callers with a valid JWT matching all of the rules that are set
+type AuthSpec struct {
+	// Audiences one of which must be in the aud claim of the JWT
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+	// Groups one of which must be in the groups claim of the JWT
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+func (a *AuthSpec) asSchedulerAuth() *scheduler.AuthSpec {
+	if a == nil {
+		return nil
+	}
+	return &scheduler.AuthSpec{
+		Audiences: a.Audiences,
+		Groups:    a.Groups,
+	}
+}
+
+type RateLimitUnit string
+
+const (
+	SecondRateLimitUnit RateLimitUnit = "second"
+	MinuteRateLimitUnit RateLimitUnit = "minute"
+	HourRateLimitUnit   RateLimitUnit = "hour"
+)
+
+// RateLimitSpec is a token bucket limiting the inference requests on each envoy replica
+type RateLimitSpec struct {
+	// Requests allowed per unit of time
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerUnit int32 `json:"requestsPerUnit"`
+	// Unit of time of the limit, defaults to second
+	// +kubebuilder:validation:Enum=second;minute;hour
+	// +optional
+	Unit RateLimitUnit `json:"unit,omitempty"`
+	// Requests allowed in a burst, defaults to the requests per unit
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+	// Deprecated and ignored, tenants are identified by the x-seldon-tenant header set by Envoy
+	// +optional
+	Header string `json:"header,omitempty"`
+	// Limits of tenants identified by the x-seldon-tenant header set by Envoy, each with their own token bucket.
+	// Requests of other tenants share the default limit.
+	// +optional
+	Tenants []TenantRateLimit `json:"tenants,omitempty"`
+}
+
+type TenantRateLimit struct {
+	// Tenant in the x-seldon-tenant header
+	Value string `json:"value"`
+	// Requests allowed per unit of time
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerUnit int32 `json:"requestsPerUnit"`
+	// Requests allowed in a burst, defaults to the requests per unit
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+}
+
+func (r *RateLimitSpec) asSchedulerRateLimit() *scheduler.RateLimitSpec {
+	if r == nil {
+		return nil
+	}
+	var unit scheduler.RateLimitSpec_Unit
+	switch r.Unit {
+	case MinuteRateLimitUnit:
+		unit = scheduler.RateLimitSpec_MINUTE
+	case HourRateLimitUnit:
+		unit = scheduler.RateLimitSpec_HOUR
+	default:
+		unit = scheduler.RateLimitSpec_SECOND
+	}
+	rateLimit := &scheduler.RateLimitSpec{
+		RequestsPerUnit: uint32(r.RequestsPerUnit),
+		Unit:            unit,
+		Burst:           uint32(r.Burst),
+		Header:          r.Header,
+	}
+	for _, tenant := range r.Tenants {
+		rateLimit.Tenants = append(rateLimit.Tenants, &scheduler.TenantRateLimit{
+			Value:           tenant.Value,
+			RequestsPerUnit: uint32(tenant.RequestsPerUnit),
+			Burst:           uint32(tenant.Burst),
+		})
+	}
+	return rateLimit
+}
+
+type LoadBalancerPolicy string
+
+const (
+	LeastRequestLoadBalancerPolicy LoadBalancerPolicy = "least-request"
+	RoundRobinLoadBalancerPolicy   LoadBalancerPolicy = "round-robin"
+	RingHashLoadBalancerPolicy     LoadBalancerPolicy = "ring-hash"
+	RandomLoadBalancerPolicy       LoadBalancerPolicy = "random"
+)
+
+type RoutingSpec struct {
+	// Timeout of an inference request including its retries in milliseconds, no timeout by default
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutMs int32 `json:"timeoutMs,omitempty"`
+	// Timeout of the connections to the endpoints in milliseconds, defaults to 5000
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ConnectTimeoutMs int32 `json:"connectTimeoutMs,omitempty"`
+	// Retries of the requests that fail
+	// +optional
+	Retry *RetrySpec `json:"retry,omitempty"`
+	// Ejection of the endpoints returning consecutive errors from the load balancing
+	// +optional
+	OutlierDetection *OutlierDetectionSpec `json:"outlierDetection,omitempty"`
+	// Load balancing of the requests across the endpoints, defaults to least-request
+	// +kubebuilder:validation:Enum=least-request;round-robin;ring-hash;random
+	// +optional
+	LoadBalancer LoadBalancerPolicy `json:"loadBalancer,omitempty"`
+	// Header hashed to pick the endpoint of a request with ring-hash, such as a session id header
+	// +optional
+	HashHeader string `json:"hashHeader,omitempty"`
+}
+
+type RetrySpec struct {
+	// Envoy retry-on conditions, such as 5xx, gateway-error, reset, connect-failure or retriable-4xx.
+	// Defaults to 5xx and connect-failure.
+	// +optional
+	RetryOn []string `json:"retryOn,omitempty"`
+	// Maximum retries of a request, defaults to 5
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// Timeout of each try in milliseconds, defaults to the timeout of the request
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PerTryTimeoutMs int32 `json:"perTryTimeoutMs,omitempty"`
+	// Base interval of the exponential backoff between retries in milliseconds, defaults to 500
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffBaseIntervalMs int32 `json:"backoffBaseIntervalMs,omitempty"`
+	// Percentage of the active requests that can be retries, so retries do not overload the endpoints.
+	// Defaults to at most 5 retries at the same time.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BudgetPercent *int32 `json:"budgetPercent,omitempty"`
+}
+
+type OutlierDetectionSpec struct {
+	// Consecutive 5xx responses ejecting an endpoint, defaults to 5
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Consecutive5xx int32 `json:"consecutive5xx,omitempty"`
+	// Seconds between the checks of the endpoints, defaults to 10
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+	// Seconds an endpoint is ejected for, multiplied by the times it has been ejected. Defaults to 30.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BaseEjectionTimeSeconds int32 `json:"baseEjectionTimeSeconds,omitempty"`
+	// Maximum percentage of the endpoints that can be ejected, defaults to 10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
+
+func (r *RoutingSpec) asSchedulerRouting() *scheduler.RoutingSpec {
+	if r == nil {
+		return nil
+	}
+	routing := &scheduler.RoutingSpec{
+		TimeoutMs:        uint32(r.TimeoutMs),
+		ConnectTimeoutMs: uint32(r.ConnectTimeoutMs),
+		HashHeader:       r.HashHeader,
+	}
+	switch r.LoadBalancer {
+	case RoundRobinLoadBalancerPolicy:
+		routing.LoadBalancer = scheduler.RoutingSpec_ROUND_ROBIN
+	case RingHashLoadBalancerPolicy:
+		routing.LoadBalancer = scheduler.RoutingSpec_RING_HASH
+	case RandomLoadBalancerPolicy:
+		routing.LoadBalancer = scheduler.RoutingSpec_RANDOM
+	default:
+		routing.LoadBalancer = scheduler.RoutingSpec_LEAST_REQUEST
+	}
+	if r.Retry != nil {
+		routing.Retry = &scheduler.RetrySpec{
+			RetryOn:               r.Retry.RetryOn,
+			PerTryTimeoutMs:       uint32(r.Retry.PerTryTimeoutMs),
+			BackoffBaseIntervalMs: uint32(r.Retry.BackoffBaseIntervalMs),
+		}
+		if r.Retry.MaxRetries != nil {
+			maxRetries := uint32(*r.Retry.MaxRetries)
+			routing.Retry.MaxRetries = &maxRetries
+		}
+		if r.Retry.BudgetPercent != nil {
+			budgetPercent := uint32(*r.Retry.BudgetPercent)
+			routing.Retry.BudgetPercent = &budgetPercent
+		}
+	}
+	if r.OutlierDetection != nil {
+		routing.OutlierDetection = &scheduler.OutlierDetectionSpec{
+			Consecutive5Xx:          uint32(r.OutlierDetection.Consecutive5xx),
+			IntervalSeconds:         uint32(r.OutlierDetection.IntervalSeconds),
+			BaseEjectionTimeSeconds: uint32(r.OutlierDetection.BaseEjectionTimeSeconds),
+			MaxEjectionPercent:      uint32(r.OutlierDetection.MaxEjectionPercent),
+		}
+	}
+	return routing
+}
+
+type CanarySpec struct {
+	// Percentage of traffic sent to a new version once it is available
+	// Default equal to stepTrafficPercent
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	InitialTrafficPercent int32 `json:"initialTrafficPercent,omitempty"`
+	// Percentage of traffic added to the new version at each step
+	// Default 10
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	StepTrafficPercent int32 `json:"stepTrafficPercent,omitempty"`
+	// Seconds between steps
+	// Default 60
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StepIntervalSeconds int32 `json:"stepIntervalSeconds,omitempty"`
+	// Roll back if the percentage of failed requests to the new version exceeds this.
+	// Default 0, which disables the check
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxErrorPercent int32 `json:"maxErrorPercent,omitempty"`
+	// Roll back if the mean latency of the new version in milliseconds exceeds this.
+	// Default 0, which disables the check
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxLatencyMs int32 `json:"maxLatencyMs,omitempty"`
+}
+
+type BatchingSpec struct {
+	// Maximum number of rows, along the first dimension of the inputs, sent to the model in one request
+	// +kubebuilder:validation:Minimum=1
+	MaxBatchSize int32 `json:"maxBatchSize"`
+	// Maximum time in milliseconds a request waits for others to be batched with
+	// Default 5
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxWaitMs int32 `json:"maxWaitMs,omitempty"`
+}
+
+type ResponseCacheSpec struct {
+	// Maximum number of responses cached by each model replica
+	// +kubebuilder:validation:Minimum=1
+	MaxEntries int32 `json:"maxEntries"`
+	// Seconds a response stays cached, if not set responses are kept until evicted or a new version of the model is loaded
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TtlSeconds int32 `json:"ttlSeconds,omitempty"`
+}
+
+type ConcurrencySpec struct {
+	// Maximum number of inference requests sent to each model replica at the same time
+	// +kubebuilder:validation:Minimum=1
+	MaxInflight int32 `json:"maxInflight"`
+	// Maximum number of requests waiting for one in flight to complete, requests are rejected
+	// with a 429 (REST) or RESOURCE_EXHAUSTED (gRPC) status once it is full. Default 0
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxQueueSize int32 `json:"maxQueueSize,omitempty"`
 }
 
 type ScalingSpec struct {
@@ -114,6 +438,22 @@ func (s *ScalingSpec) Default() {
 type LoggingSpec struct {
 	//Percentage of payloads to log
 	Percent *uint `json:"percent,omitempty"`
+	// Names of the tensors whose data is removed from the logged payloads
+	// +optional
+	RedactTensors []string `json:"redactTensors,omitempty"`
+}
+
+const defaultPayloadLoggingPercent = 100
+
+func (l *LoggingSpec) asSchedulerPayloadLogging() *scheduler.PayloadLoggingSpec {
+	percent := uint32(defaultPayloadLoggingPercent)
+	if l.Percent != nil {
+		percent = uint32(*l.Percent)
+	}
+	return &scheduler.PayloadLoggingSpec{
+		SamplePercent: percent,
+		RedactTensors: l.RedactTensors,
+	}
 }
 
 type InferenceArtifactSpec struct {
@@ -200,11 +540,18 @@ func (m Model) AsSchedulerModel() (*scheduler.Model, error) {
 			ArtifactVersion: m.Spec.ArtifactVersion,
 			Requirements:    m.Spec.Requirements,
 			Server:          m.Spec.Server,
+			Pinned:          m.Spec.Pinned,
 		},
 		DeploymentSpec: &scheduler.DeploymentSpec{
 			LogPayloads: m.Spec.Logger != nil, // Simple boolean switch at present
+			Auth:        m.Spec.Auth.asSchedulerAuth(),
+			RateLimit:   m.Spec.RateLimit.asSchedulerRateLimit(),
+			Routing:     m.Spec.Routing.asSchedulerRouting(),
 		},
 	}
+	if m.Spec.Logger != nil {
+		md.DeploymentSpec.PayloadLogging = m.Spec.Logger.asSchedulerPayloadLogging()
+	}
 	if m.Spec.Explainer != nil {
 		md.ModelSpec.ModelSpec = &scheduler.ModelSpec_Explainer{
 			Explainer: &scheduler.ExplainerSpec{
@@ -223,6 +570,27 @@ func (m Model) AsSchedulerModel() (*scheduler.Model, error) {
 		}
 	}
 
+	if m.Spec.Batching != nil {
+		md.ModelSpec.Batching = &scheduler.BatchingSpec{
+			MaxBatchSize: uint32(m.Spec.Batching.MaxBatchSize),
+			MaxWaitMs:    uint32(m.Spec.Batching.MaxWaitMs),
+		}
+	}
+
+	if m.Spec.ResponseCache != nil {
+		md.ModelSpec.ResponseCache = &scheduler.ResponseCacheSpec{
+			MaxEntries: uint32(m.Spec.ResponseCache.MaxEntries),
+			TtlSeconds: uint32(m.Spec.ResponseCache.TtlSeconds),
+		}
+	}
+
+	if m.Spec.Concurrency != nil {
+		md.ModelSpec.Concurrency = &scheduler.ConcurrencySpec{
+			MaxInflight:  uint32(m.Spec.Concurrency.MaxInflight),
+			MaxQueueSize: uint32(m.Spec.Concurrency.MaxQueueSize),
+		}
+	}
+
 	if m.Spec.Dataflow != nil {
 		md.DataflowSpec = &scheduler.DataflowSpec{
 			CleanTopicsOnDelete: m.Spec.Dataflow.CleanTopicsOnDelete,
@@ -259,6 +627,18 @@ func (m Model) AsSchedulerModel() (*scheduler.Model, error) {
 	md.DeploymentSpec.Replicas = scalingSpec.Replicas
 	md.DeploymentSpec.MinReplicas = scalingSpec.MinReplicas
 	md.DeploymentSpec.MaxReplicas = scalingSpec.MaxReplicas
+	if m.Spec.Priority != nil {
+		md.DeploymentSpec.Priority = *m.Spec.Priority
+	}
+	if m.Spec.Canary != nil {
+		md.DeploymentSpec.Canary = &scheduler.CanarySpec{
+			InitialTrafficPercent: uint32(m.Spec.Canary.InitialTrafficPercent),
+			StepTrafficPercent:    uint32(m.Spec.Canary.StepTrafficPercent),
+			StepIntervalSeconds:   uint32(m.Spec.Canary.StepIntervalSeconds),
+			MaxErrorPercent:       uint32(m.Spec.Canary.MaxErrorPercent),
+			MaxLatencyMs:          uint32(m.Spec.Canary.MaxLatencyMs),
+		}
+	}
 
 	// Set memory bytes
 	if m.Spec.Memory != nil {
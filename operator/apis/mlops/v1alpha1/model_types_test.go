@@ -98,6 +98,8 @@ func TestAsModelDetails(t *testing.T) {
 	m1 := resource.MustParse("1M")
 	m1bytes := uint64(1_000_000)
 	incomeModel, llmModel := "income", "chat-gpt"
+	maxRetries, budgetPercent := int32(2), int32(20)
+	maxRetriesProto, budgetPercentProto := uint32(2), uint32(20)
 	tests := []test{
 		{
 			name: "simple",
@@ -201,6 +203,9 @@ func TestAsModelDetails(t *testing.T) {
 					LogPayloads: true,
 					MinReplicas: 0,
 					MaxReplicas: math.MaxUint32,
+					PayloadLogging: &scheduler.PayloadLoggingSpec{
+						SamplePercent: 100,
+					},
 				},
 			},
 		},
@@ -271,6 +276,9 @@ func TestAsModelDetails(t *testing.T) {
 					LogPayloads: true,
 					MinReplicas: 0,
 					MaxReplicas: math.MaxUint32,
+					PayloadLogging: &scheduler.PayloadLoggingSpec{
+						SamplePercent: 100,
+					},
 				},
 			},
 		},
@@ -377,6 +385,437 @@ func TestAsModelDetails(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "priority",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Priority: ptr.Int32(10),
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+					Priority:    10,
+				},
+			},
+		},
+		{
+			name: "pinned",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Pinned: true,
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri:    "gs://test",
+					Pinned: true,
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+				},
+			},
+		},
+		{
+			name: "payload logging",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Logger: &LoggingSpec{
+						Percent:       ptr.UInt(10),
+						RedactTensors: []string{"ssn"},
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					LogPayloads: true,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+					PayloadLogging: &scheduler.PayloadLoggingSpec{
+						SamplePercent: 10,
+						RedactTensors: []string{"ssn"},
+					},
+				},
+			},
+		},
+		{
+			name: "canary",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Canary: &CanarySpec{
+						InitialTrafficPercent: 5,
+						StepTrafficPercent:    20,
+						StepIntervalSeconds:   120,
+						MaxErrorPercent:       2,
+						MaxLatencyMs:          500,
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+					Canary: &scheduler.CanarySpec{
+						InitialTrafficPercent: 5,
+						StepTrafficPercent:    20,
+						StepIntervalSeconds:   120,
+						MaxErrorPercent:       2,
+						MaxLatencyMs:          500,
+					},
+				},
+			},
+		},
+		{
+			name: "batching",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Batching: &BatchingSpec{
+						MaxBatchSize: 32,
+						MaxWaitMs:    10,
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+					Batching: &scheduler.BatchingSpec{
+						MaxBatchSize: 32,
+						MaxWaitMs:    10,
+					},
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+				},
+			},
+		},
+		{
+			name: "response cache",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					ResponseCache: &ResponseCacheSpec{
+						MaxEntries: 1000,
+						TtlSeconds: 60,
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+					ResponseCache: &scheduler.ResponseCacheSpec{
+						MaxEntries: 1000,
+						TtlSeconds: 60,
+					},
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+				},
+			},
+		},
+		{
+			name: "concurrency",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Concurrency: &ConcurrencySpec{
+						MaxInflight:  4,
+						MaxQueueSize: 16,
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+					Concurrency: &scheduler.ConcurrencySpec{
+						MaxInflight:  4,
+						MaxQueueSize: 16,
+					},
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+				},
+			},
+		},
+		{
+			name: "auth",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Auth: &AuthSpec{
+						Audiences: []string{"iris"},
+						Groups:    []string{"data-science"},
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+					Auth: &scheduler.AuthSpec{
+						Audiences: []string{"iris"},
+						Groups:    []string{"data-science"},
+					},
+				},
+			},
+		},
+		{
+			name: "rate limit",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					RateLimit: &RateLimitSpec{
+						RequestsPerUnit: 100,
+						Unit:            MinuteRateLimitUnit,
+						Header:          "x-api-key",
+						Tenants: []TenantRateLimit{
+							{Value: "batch", RequestsPerUnit: 10, Burst: 20},
+						},
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+					RateLimit: &scheduler.RateLimitSpec{
+						RequestsPerUnit: 100,
+						Unit:            scheduler.RateLimitSpec_MINUTE,
+						Header:          "x-api-key",
+						Tenants: []*scheduler.TenantRateLimit{
+							{Value: "batch", RequestsPerUnit: 10, Burst: 20},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "routing",
+			model: &Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo",
+					Namespace:       "default",
+					ResourceVersion: "1",
+					Generation:      1,
+				},
+				Spec: ModelSpec{
+					InferenceArtifactSpec: InferenceArtifactSpec{
+						StorageURI: "gs://test",
+					},
+					Routing: &RoutingSpec{
+						TimeoutMs: 2000,
+						Retry: &RetrySpec{
+							RetryOn:       []string{"reset", "retriable-4xx"},
+							MaxRetries:    &maxRetries,
+							BudgetPercent: &budgetPercent,
+						},
+						OutlierDetection: &OutlierDetectionSpec{
+							Consecutive5xx: 3,
+						},
+						LoadBalancer: RingHashLoadBalancerPolicy,
+						HashHeader:   "x-session-id",
+					},
+				},
+			},
+			modelpb: &scheduler.Model{
+				Meta: &scheduler.MetaData{
+					Name: "foo",
+					KubernetesMeta: &scheduler.KubernetesMeta{
+						Namespace:  "default",
+						Generation: 1,
+					},
+				},
+				ModelSpec: &scheduler.ModelSpec{
+					Uri: "gs://test",
+				},
+				DeploymentSpec: &scheduler.DeploymentSpec{
+					Replicas:    1,
+					MinReplicas: 0,
+					MaxReplicas: math.MaxUint32,
+					Routing: &scheduler.RoutingSpec{
+						TimeoutMs: 2000,
+						Retry: &scheduler.RetrySpec{
+							RetryOn:       []string{"reset", "retriable-4xx"},
+							MaxRetries:    &maxRetriesProto,
+							BudgetPercent: &budgetPercentProto,
+						},
+						OutlierDetection: &scheduler.OutlierDetectionSpec{
+							Consecutive5Xx: 3,
+						},
+						LoadBalancer: scheduler.RoutingSpec_RING_HASH,
+						HashHeader:   "x-session-id",
+					},
+				},
+			},
+		},
 		{
 			name: "range violation min",
 			model: &Model{
@@ -549,3 +988,25 @@ func TestModelStatusPrintColumns(t *testing.T) {
 		})
 	}
 }
+
+func TestModelStatusSetSchedulingWarning(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := &ModelStatus{}
+	status.InitializeConditions()
+	status.CreateAndSetCondition(ModelReady, true, "ModelAvailable", "")
+
+	status.SetSchedulingWarning("TopologySpreadFilter: model replicas per zone [a:2,b:0] have skew 2, max skew is 1")
+	condition := status.GetCondition(ModelPlacementPreferred)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(v1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(SchedulingWarningReason))
+	g.Expect(condition.Message).To(ContainSubstring("max skew is 1"))
+	g.Expect(condition.Severity).To(Equal(apis.ConditionSeverityInfo))
+	// a warning does not make the model unready
+	g.Expect(status.IsReady()).To(BeTrue())
+
+	status.SetSchedulingWarning("")
+	g.Expect(status.GetCondition(ModelPlacementPreferred)).To(BeNil())
+	g.Expect(status.IsReady()).To(BeTrue())
+}
@@ -67,6 +67,19 @@ type PipelineSpec struct {
 	// Allow cyclic pipeline
 	AllowCycles bool `json:"allowCycles,omitempty"`
 
+	// Callers allowed to infer with the pipeline, checked by envoy against their JWT
+	// +optional
+	Auth *AuthSpec `json:"auth,omitempty"`
+
+	// Rate of inference requests to the pipeline allowed by envoy, requests over the limit are rejected
+	// +optional
+	RateLimit *RateLimitSpec `json:"rateLimit,omitempty"`
+
+	// Timeouts, retries, outlier detection and load balancing of the inference requests routed by envoy
+	// to the pipeline gateway
+	// +optional
+	Routing *RoutingSpec `json:"routing,omitempty"`
+
 	// Maximum number of times a step can be revisited
 	MaxStepRevisits uint32 `json:"maxStepRevisits,omitempty"`
 }
@@ -257,6 +270,9 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 		dataflowSpec = &scheduler.DataflowSpec{
 			CleanTopicsOnDelete: p.Spec.Dataflow.CleanTopicsOnDelete,
 		}
+		if p.Spec.Dataflow.Mode == SynchronousDataflowMode {
+			dataflowSpec.Mode = scheduler.DataflowSpec_SYNCHRONOUS
+		}
 	}
 	return &scheduler.Pipeline{
 		Name:            p.GetName(),
@@ -268,6 +284,9 @@ func (p Pipeline) AsSchedulerPipeline() *scheduler.Pipeline {
 		KubernetesMeta:  &scheduler.KubernetesMeta{Namespace: p.Namespace, Generation: p.Generation},
 		AllowCycles:     p.Spec.AllowCycles,
 		MaxStepRevisits: p.Spec.MaxStepRevisits,
+		Auth:            p.Spec.Auth.asSchedulerAuth(),
+		RateLimit:       p.Spec.RateLimit.asSchedulerRateLimit(),
+		Routing:         p.Spec.Routing.asSchedulerRouting(),
 	}
 }
 
@@ -102,6 +102,153 @@ func TestAsPipelineDetails(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "auth",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					Auth: &AuthSpec{
+						Groups: []string{"data-science"},
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Auth: &scheduler.AuthSpec{
+					Groups: []string{"data-science"},
+				},
+			},
+		},
+		{
+			name: "rate limit",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					RateLimit: &RateLimitSpec{
+						RequestsPerUnit: 5,
+						Burst:           10,
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				RateLimit: &scheduler.RateLimitSpec{
+					RequestsPerUnit: 5,
+					Unit:            scheduler.RateLimitSpec_SECOND,
+					Burst:           10,
+				},
+			},
+		},
+		{
+			name: "routing",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					Routing: &RoutingSpec{
+						TimeoutMs:        5000,
+						ConnectTimeoutMs: 1000,
+						LoadBalancer:     RoundRobinLoadBalancerPolicy,
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Routing: &scheduler.RoutingSpec{
+					TimeoutMs:        5000,
+					ConnectTimeoutMs: 1000,
+					LoadBalancer:     scheduler.RoutingSpec_ROUND_ROBIN,
+				},
+			},
+		},
+		{
+			name: "synchronous dataflow",
+			pipeline: &Pipeline{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "foo",
+					Namespace:  "default",
+					Generation: 1,
+				},
+				Spec: PipelineSpec{
+					Steps: []PipelineStep{
+						{
+							Name: "a",
+						},
+					},
+					Dataflow: &DataflowSpec{
+						Mode: SynchronousDataflowMode,
+					},
+				},
+			},
+			proto: &scheduler.Pipeline{
+				Name: "foo",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+				},
+				KubernetesMeta: &scheduler.KubernetesMeta{
+					Namespace:  "default",
+					Generation: 1,
+				},
+				DataflowSpec: &scheduler.DataflowSpec{
+					Mode: scheduler.DataflowSpec_SYNCHRONOUS,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -45,6 +45,11 @@ type ServerSpec struct {
 	// Deployment strategy
 	DeploymentStrategy appsv1.DeploymentStrategy `json:"deploymentStrategy,omitempty"`
 	// +Optional
+	// How models are chosen for eviction from memory when the server is over committed.
+	// Overrides the policy of the referenced ServerConfig
+	// +kubebuilder:validation:Enum=lru;lfu;greedy-dual-size
+	CacheEvictionPolicy string `json:"cacheEvictionPolicy,omitempty"`
+	// +Optional
 	// If set then when the referenced ServerConfig changes we will NOT update the Server immediately.
 	// Explicit changes to the Server itself will force a reconcile though
 	DisableAutoUpdate bool `json:"disableAutoUpdate,omitempty"`
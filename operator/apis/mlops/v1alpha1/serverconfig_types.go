@@ -29,6 +29,12 @@ type ServerConfigSpec struct {
 	// PodSpec
 	PodSpec              v1.PodSpec              `json:"podSpec"`
 	VolumeClaimTemplates []PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+	// +Optional
+	// How models are chosen for eviction from memory when a server is over committed:
+	// lru (least recently used, the default), lfu (least frequently used) or greedy-dual-size
+	// (weighs the memory of a model against how long it takes to reload). Pinned models are never evicted
+	// +kubebuilder:validation:Enum=lru;lfu;greedy-dual-size
+	CacheEvictionPolicy string `json:"cacheEvictionPolicy,omitempty"`
 }
 
 // We use our own type rather than v1.PersistentVolumeClaim as metadata inlined is not handled correctly by CRDs
@@ -73,6 +73,7 @@ func GetCmd() *cobra.Command {
 	cmdModelStatus := createModelStatus()
 	cmdModelMeta := createModelMetadata()
 	cmdModelList := createModelList()
+	cmdModelExplainSchedule := createModelExplainSchedule()
 
 	// Server commands
 	cmdServerStatus := createServerStatus()
@@ -109,7 +110,7 @@ func GetCmd() *cobra.Command {
 	rootCmd.DisableAutoGenTag = true
 
 	rootCmd.AddCommand(cmdModel, cmdServer, cmdExperiment, cmdPipeline, cmdConfig, cmdLoad, cmdUnload, cmdStatus)
-	cmdModel.AddCommand(cmdModelLoad, cmdModelUnload, cmdModelStatus, cmdModelInfer, cmdModelMeta, cmdModelList)
+	cmdModel.AddCommand(cmdModelLoad, cmdModelUnload, cmdModelStatus, cmdModelInfer, cmdModelMeta, cmdModelList, cmdModelExplainSchedule)
 	cmdServer.AddCommand(cmdServerStatus, cmdServerList)
 	cmdExperiment.AddCommand(cmdExperimentStart, cmdExperimentStop, cmdExperimentStatus, cmdExperimentList)
 	cmdPipeline.AddCommand(cmdPipelineLoad, cmdPipelineUnload, cmdPipelineStatus, cmdPipelineInfer, cmdPipelineList, cmdPipelineInspect)
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package mlops
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mlopsv1alpha1 "github.com/seldonio/seldon-core/operator/v2/apis/mlops/v1alpha1"
+	"github.com/seldonio/seldon-core/operator/v2/controllers/reconcilers/batchjob"
+	"github.com/seldonio/seldon-core/operator/v2/pkg/constants"
+)
+
+// BatchJobReconciler reconciles a BatchJob object
+type BatchJobReconciler struct {
+	client.Client
+	// APIReader reads the pods of finished jobs without caching all pods
+	APIReader client.Reader
+	Scheme    *runtime.Scheme
+	Recorder  record.EventRecorder
+	JobConfig batchjob.JobConfig
+}
+
+//+kubebuilder:rbac:groups=mlops.seldon.io,resources=batchjobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=mlops.seldon.io,resources=batchjobs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+// Reconcile creates the job of a batch job and mirrors its progress in the status of the batch job. The job
+// is not updated when the spec changes, a batch job is deleted and created again to run it again.
+func (r *BatchJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithName("BatchJobReconcile")
+	ctx, cancel := context.WithTimeout(ctx, constants.ReconcileTimeout)
+	defer cancel()
+
+	now := time.Now()
+	defer func() {
+		logger.Info("Finished BatchJob Reconcile", "duration", time.Since(now))
+	}()
+
+	batchJob := &mlopsv1alpha1.BatchJob{}
+	if err := r.Get(ctx, req.NamespacedName, batchJob); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch BatchJob", "name", req.Name, "namespace", req.Namespace)
+		return reconcile.Result{}, err
+	}
+	if !batchJob.ObjectMeta.DeletionTimestamp.IsZero() {
+		// the job is deleted with its owner
+		return reconcile.Result{}, nil
+	}
+
+	if err := batchJob.Validate(); err != nil {
+		batchJob.Status.SetState(mlopsv1alpha1.BatchJobFailed, err.Error())
+		return reconcile.Result{}, r.updateStatus(ctx, logger, batchJob)
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, req.NamespacedName, job)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		job = batchjob.ToJob(batchJob, r.JobConfig)
+		if err := controllerutil.SetControllerReference(batchJob, job, r.Scheme); err != nil {
+			return reconcile.Result{}, err
+		}
+		logger.Info("Creating job", "name", job.Name, "namespace", job.Namespace)
+		if err := r.Create(ctx, job); err != nil {
+			r.Recorder.Eventf(batchJob, v1.EventTypeWarning, "CreateFailed", "Failed to create job: %v", err)
+			return reconcile.Result{}, err
+		}
+	}
+
+	var pods []v1.Pod
+	if job.Status.CompletionTime != nil || job.Status.Failed > 0 {
+		podList := &v1.PodList{}
+		err := r.APIReader.List(ctx, podList,
+			client.InNamespace(job.Namespace),
+			client.MatchingLabels{batchv1.JobNameLabel: job.Name})
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		pods = podList.Items
+	}
+	batchjob.UpdateStatus(&batchJob.Status, job, pods)
+	return reconcile.Result{}, r.updateStatus(ctx, logger, batchJob)
+}
+
+func (r *BatchJobReconciler) updateStatus(ctx context.Context, logger logr.Logger, batchJob *mlopsv1alpha1.BatchJob) error {
+	existing := &mlopsv1alpha1.BatchJob{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(batchJob), existing); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if equality.Semantic.DeepEqual(existing.Status, batchJob.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, batchJob); err != nil {
+		logger.Error(err, "Failed to update status", "name", batchJob.Name, "namespace", batchJob.Namespace)
+		return err
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BatchJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mlopsv1alpha1.BatchJob{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package mlops
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	schedulerAPI "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	mlopsv1alpha1 "github.com/seldonio/seldon-core/operator/v2/apis/mlops/v1alpha1"
+	"github.com/seldonio/seldon-core/operator/v2/pkg/constants"
+	"github.com/seldonio/seldon-core/operator/v2/pkg/utils"
+)
+
+// EnsembleReconciler reconciles an Ensemble object
+type EnsembleReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Scheduler SchedulerClient
+	Recorder  record.EventRecorder
+}
+
+func (r *EnsembleReconciler) handleFinalizer(
+	ctx context.Context,
+	logger logr.Logger,
+	ensemble *mlopsv1alpha1.Ensemble,
+) (bool, error) {
+	// Check if we are being deleted or not
+	if ensemble.ObjectMeta.DeletionTimestamp.IsZero() { // Not being deleted
+
+		// Add our finalizer
+		if !utils.ContainsStr(ensemble.ObjectMeta.Finalizers, constants.EnsembleFinalizerName) {
+			ensemble.ObjectMeta.Finalizers = append(ensemble.ObjectMeta.Finalizers, constants.EnsembleFinalizerName)
+			if err := r.Update(ctx, ensemble); err != nil {
+				return true, err
+			}
+		}
+	} else { // ensemble is being deleted
+		if utils.ContainsStr(ensemble.ObjectMeta.Finalizers, constants.EnsembleFinalizerName) {
+			// Handle unload in scheduler
+			if err, retry := r.Scheduler.UnloadEnsemble(ctx, ensemble); err != nil {
+				if retry {
+					return true, err
+				} else {
+					// Remove ensemble anyway on error as we assume errors from scheduler are fatal here
+					ensemble.ObjectMeta.Finalizers = utils.RemoveStr(
+						ensemble.ObjectMeta.Finalizers,
+						constants.EnsembleFinalizerName,
+					)
+					if errUpdate := r.Update(ctx, ensemble); errUpdate != nil {
+						logger.Error(err, "Failed to remove finalizer", "ensemble", ensemble.Name)
+						return true, err
+					}
+				}
+			}
+		}
+		// Stop reconciliation as the item is being deleted
+		return true, nil
+	}
+	return false, nil
+}
+
+//+kubebuilder:rbac:groups=mlops.seldon.io,resources=ensembles,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=mlops.seldon.io,resources=ensembles/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=mlops.seldon.io,resources=ensembles/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile loads the ensemble in the scheduler, which routes inference requests to it like a model
+func (r *EnsembleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithName("EnsembleReconcile")
+	ctx, cancel := context.WithTimeout(ctx, constants.ReconcileTimeout)
+	defer cancel()
+
+	now := time.Now()
+	defer func() {
+		logger.Info("Finished Ensemble Reconcile", "duration", time.Since(now))
+	}()
+
+	ensemble := &mlopsv1alpha1.Ensemble{}
+	if err := r.Get(ctx, req.NamespacedName, ensemble); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch Ensemble", "name", req.Name, "namespace", req.Namespace)
+		return reconcile.Result{}, err
+	}
+
+	stop, err := r.handleFinalizer(ctx, logger, ensemble)
+	if stop {
+		return reconcile.Result{}, err
+	}
+
+	retry, err := r.Scheduler.LoadEnsemble(ctx, ensemble, nil)
+	if err != nil {
+		r.updateStatusFromError(ctx, logger, ensemble, retry, err)
+		if retry {
+			return ctrl.Result{}, err
+		} else {
+			return ctrl.Result{}, nil
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *EnsembleReconciler) updateStatusFromError(
+	ctx context.Context,
+	logger logr.Logger,
+	ensemble *mlopsv1alpha1.Ensemble,
+	canRetry bool,
+	err error,
+) {
+	ensembleStatus := schedulerAPI.PipelineVersionState_PipelineFailed.String()
+	if canRetry {
+		ensembleStatus = schedulerAPI.PipelineVersionState_PipelineCreating.String()
+	}
+
+	ensemble.Status.CreateAndSetCondition(
+		mlopsv1alpha1.EnsembleReady,
+		false,
+		ensembleStatus,
+		err.Error(),
+	)
+	if errSet := r.Status().Update(ctx, ensemble); errSet != nil {
+		logger.Error(
+			errSet,
+			"Failed to set status on ensemble on error",
+			"ensemble", ensemble.Name,
+			"error", err.Error(),
+		)
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EnsembleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	pred := predicate.GenerationChangedPredicate{}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mlopsv1alpha1.Ensemble{}).
+		WithEventFilter(pred).
+		Complete(r)
+}
@@ -50,6 +50,21 @@ func (m *MockSchedulerClient) EXPECT() *MockSchedulerClientMockRecorder {
 	return m.recorder
 }
 
+// LoadEnsemble mocks base method.
+func (m *MockSchedulerClient) LoadEnsemble(ctx context.Context, ensemble *v1alpha1.Ensemble, grpcClient scheduler.SchedulerClient) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadEnsemble", ctx, ensemble, grpcClient)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadEnsemble indicates an expected call of LoadEnsemble.
+func (mr *MockSchedulerClientMockRecorder) LoadEnsemble(ctx, ensemble, grpcClient any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadEnsemble", reflect.TypeOf((*MockSchedulerClient)(nil).LoadEnsemble), ctx, ensemble, grpcClient)
+}
+
 // LoadModel mocks base method.
 func (m *MockSchedulerClient) LoadModel(ctx context.Context, model *v1alpha1.Model, grpcClient scheduler.SchedulerClient) (bool, error) {
 	m.ctrl.T.Helper()
@@ -206,6 +221,21 @@ func (mr *MockSchedulerClientMockRecorder) SubscribeServerEvents(ctx, grpcClient
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeServerEvents", reflect.TypeOf((*MockSchedulerClient)(nil).SubscribeServerEvents), ctx, grpcClient, namespace)
 }
 
+// UnloadEnsemble mocks base method.
+func (m *MockSchedulerClient) UnloadEnsemble(ctx context.Context, ensemble *v1alpha1.Ensemble) (error, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnloadEnsemble", ctx, ensemble)
+	ret0, _ := ret[0].(error)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// UnloadEnsemble indicates an expected call of UnloadEnsemble.
+func (mr *MockSchedulerClientMockRecorder) UnloadEnsemble(ctx, ensemble any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadEnsemble", reflect.TypeOf((*MockSchedulerClient)(nil).UnloadEnsemble), ctx, ensemble)
+}
+
 // UnloadModel mocks base method.
 func (m *MockSchedulerClient) UnloadModel(ctx context.Context, model *v1alpha1.Model, grpcClient scheduler.SchedulerClient) (bool, error) {
 	m.ctrl.T.Helper()
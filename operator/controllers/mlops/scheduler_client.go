@@ -30,6 +30,8 @@ type SchedulerClient interface {
 	LoadPipeline(ctx context.Context, pipeline *v1alpha1.Pipeline, grpcClient scheduler.SchedulerClient) (bool, error)
 	UnloadPipeline(ctx context.Context, pipeline *v1alpha1.Pipeline) (error, bool)
 	SubscribePipelineEvents(ctx context.Context, grpcClient scheduler.SchedulerClient, namespace string) error
+	LoadEnsemble(ctx context.Context, ensemble *v1alpha1.Ensemble, grpcClient scheduler.SchedulerClient) (bool, error)
+	UnloadEnsemble(ctx context.Context, ensemble *v1alpha1.Ensemble) (error, bool)
 	ServerNotify(ctx context.Context, grpcClient scheduler.SchedulerClient, servers []v1alpha1.Server, isFirstSync bool) error
 	SubscribeServerEvents(ctx context.Context, grpcClient scheduler.SchedulerClient, namespace string) error
 	RemoveConnection(namespace string)
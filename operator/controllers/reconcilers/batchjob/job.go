@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batchjob
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	mlopsv1alpha1 "github.com/seldonio/seldon-core/operator/v2/apis/mlops/v1alpha1"
+	"github.com/seldonio/seldon-core/operator/v2/pkg/constants"
+)
+
+const (
+	BatchJobLabelValue     = "seldon-batchjob"
+	BatchJobNameLabelKey   = "seldon-batchjob-name"
+	RunnerContainerName    = "batchjob"
+	RcloneContainerName    = "rclone"
+	rclonePort             = 5572
+	workVolumeName         = "batch"
+	workPath               = "/mnt/batch"
+	rcloneConfigVolumeName = "rclone-config"
+	rcloneConfigPath       = "/mnt/rclone-config"
+	kafkaConfigVolumeName  = "kafka-config"
+	kafkaConfigPath        = "/mnt/config"
+	kafkaConfigMapName     = "seldon-kafka"
+	envoyServiceName       = "seldon-mesh"
+)
+
+type JobConfig struct {
+	Image       string
+	RcloneImage string
+}
+
+// runnerStatus is the final status written by the runner to its termination message
+type runnerStatus struct {
+	State     string `json:"state"`
+	Total     int64  `json:"total"`
+	Succeeded int64  `json:"succeeded"`
+	Failed    int64  `json:"failed"`
+	Error     string `json:"error,omitempty"`
+}
+
+// isRemote returns whether the uri is an rclone remote, which needs the rclone sidecar to be copied
+func isRemote(uri string) bool {
+	if uri == "" || strings.HasPrefix(uri, "/") || strings.HasPrefix(uri, ".") {
+		return false
+	}
+	idx := strings.Index(uri, ":")
+	return idx != -1 && !strings.Contains(uri[:idx], "/")
+}
+
+func getRunnerArgs(batchJob *mlopsv1alpha1.BatchJob) []string {
+	spec := batchJob.Spec
+	args := []string{
+		"--job-name=" + batchJob.Name,
+		"--output-uri=" + spec.OutputUri,
+		"--work-dir=" + workPath,
+		fmt.Sprintf("--envoy-host=%s.%s", envoyServiceName, batchJob.Namespace),
+	}
+	if spec.Model != "" {
+		args = append(args, "--model="+spec.Model)
+	} else {
+		args = append(args, "--pipeline="+spec.Pipeline)
+	}
+	if spec.Input.KafkaTopic != "" {
+		args = append(args,
+			"--kafka-topic="+spec.Input.KafkaTopic,
+			"--kafka-config-path="+kafkaConfigPath+"/kafka.json",
+		)
+	} else {
+		args = append(args, "--input-uri="+spec.Input.StorageUri)
+		if spec.Input.Format != "" {
+			args = append(args, "--input-format="+string(spec.Input.Format))
+		}
+	}
+	if spec.Input.TensorName != "" {
+		args = append(args, "--input-name="+spec.Input.TensorName)
+	}
+	if spec.SecretName != nil {
+		args = append(args, "--rclone-config-dir="+rcloneConfigPath)
+	}
+	if spec.Parallelism != nil {
+		args = append(args, fmt.Sprintf("--parallelism=%d", *spec.Parallelism))
+	}
+	if spec.MaxRetries != nil {
+		args = append(args, fmt.Sprintf("--max-retries=%d", *spec.MaxRetries))
+	}
+	if spec.RequestTimeoutSeconds != nil {
+		args = append(args, fmt.Sprintf("--request-timeout=%ds", *spec.RequestTimeoutSeconds))
+	}
+	return args
+}
+
+// ToJob creates the job running the batch job. Records are retried by the runner so a failed job is not
+// restarted, which would score all the records again.
+func ToJob(batchJob *mlopsv1alpha1.BatchJob, config JobConfig) *batchv1.Job {
+	labels := map[string]string{
+		constants.KubernetesNameLabelKey: BatchJobLabelValue,
+		BatchJobNameLabelKey:             batchJob.Name,
+	}
+	backoffLimit := int32(0)
+
+	workMount := v1.VolumeMount{Name: workVolumeName, MountPath: workPath}
+	runner := v1.Container{
+		Name:                     RunnerContainerName,
+		Image:                    config.Image,
+		Args:                     getRunnerArgs(batchJob),
+		VolumeMounts:             []v1.VolumeMount{workMount},
+		TerminationMessagePolicy: v1.TerminationMessageReadFile,
+	}
+	if batchJob.Spec.Resources != nil {
+		runner.Resources = *batchJob.Spec.Resources
+	}
+	podSpec := v1.PodSpec{
+		RestartPolicy: v1.RestartPolicyNever,
+		Containers:    []v1.Container{runner},
+		Volumes: []v1.Volume{
+			{Name: workVolumeName, VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+		},
+	}
+
+	if batchJob.Spec.SecretName != nil {
+		podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+			Name:         rcloneConfigVolumeName,
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: *batchJob.Spec.SecretName}},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts,
+			v1.VolumeMount{Name: rcloneConfigVolumeName, MountPath: rcloneConfigPath, ReadOnly: true})
+	}
+	if batchJob.Spec.Input.KafkaTopic != "" {
+		podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+			Name: kafkaConfigVolumeName,
+			VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: kafkaConfigMapName},
+			}},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts,
+			v1.VolumeMount{Name: kafkaConfigVolumeName, MountPath: kafkaConfigPath, ReadOnly: true})
+	}
+
+	// rclone runs as a sidecar that is stopped once the runner has finished
+	if isRemote(batchJob.Spec.Input.StorageUri) || isRemote(batchJob.Spec.OutputUri) {
+		restartAlways := v1.ContainerRestartPolicyAlways
+		podSpec.InitContainers = append(podSpec.InitContainers, v1.Container{
+			Name:          RcloneContainerName,
+			Image:         config.RcloneImage,
+			RestartPolicy: &restartAlways,
+			Ports: []v1.ContainerPort{
+				{Name: RcloneContainerName, ContainerPort: rclonePort, Protocol: v1.ProtocolTCP},
+			},
+			StartupProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt32(rclonePort)},
+				},
+				PeriodSeconds:    1,
+				FailureThreshold: 60,
+			},
+			VolumeMounts: []v1.VolumeMount{workMount},
+		})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      batchJob.Name,
+			Namespace: batchJob.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       podSpec,
+			},
+		},
+	}
+}
+
+// UpdateStatus sets the status of the batch job from its job and, once finished, from the termination message
+// of its runner
+func UpdateStatus(status *mlopsv1alpha1.BatchJobStatus, job *batchv1.Job, pods []v1.Pod) {
+	status.StartTime = job.Status.StartTime
+	status.CompletionTime = job.Status.CompletionTime
+
+	state, message := mlopsv1alpha1.BatchJobPending, "Job pending"
+	if job.Status.Active > 0 {
+		state, message = mlopsv1alpha1.BatchJobRunning, "Job running"
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			state, message = mlopsv1alpha1.BatchJobSucceeded, "Job completed"
+		case batchv1.JobFailed:
+			state, message = mlopsv1alpha1.BatchJobFailed, condition.Message
+			if status.CompletionTime == nil {
+				status.CompletionTime = &condition.LastTransitionTime
+			}
+		}
+	}
+
+	if state == mlopsv1alpha1.BatchJobSucceeded || state == mlopsv1alpha1.BatchJobFailed {
+		if result := getRunnerStatus(pods); result != nil {
+			status.Total = result.Total
+			status.Succeeded = result.Succeeded
+			status.Failed = result.Failed
+			if result.Error != "" {
+				message = result.Error
+			} else if state == mlopsv1alpha1.BatchJobSucceeded {
+				message = fmt.Sprintf("%d records succeeded and %d failed", result.Succeeded, result.Failed)
+			}
+		}
+	}
+	status.SetState(state, message)
+}
+
+func getRunnerStatus(pods []v1.Pod) *runnerStatus {
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name != RunnerContainerName || containerStatus.State.Terminated == nil {
+				continue
+			}
+			result := &runnerStatus{}
+			if err := json.Unmarshal([]byte(containerStatus.State.Terminated.Message), result); err == nil {
+				return result
+			}
+		}
+	}
+	return nil
+}
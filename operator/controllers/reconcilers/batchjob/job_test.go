@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batchjob
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+
+	mlopsv1alpha1 "github.com/seldonio/seldon-core/operator/v2/apis/mlops/v1alpha1"
+)
+
+func TestToJob(t *testing.T) {
+	t.Parallel()
+
+	g := NewGomegaWithT(t)
+	type test struct {
+		name              string
+		batchJob          *mlopsv1alpha1.BatchJob
+		expectedArgs      []string
+		expectedVolumes   []string
+		expectedSidecar   bool
+		expectedResources v1.ResourceRequirements
+	}
+
+	config := JobConfig{Image: "seldonio/seldon-batchjob:1.0", RcloneImage: "seldonio/seldon-rclone:1.0"}
+	tests := []test{
+		{
+			name: "model with remote file",
+			batchJob: &mlopsv1alpha1.BatchJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "seldon"},
+				Spec: mlopsv1alpha1.BatchJobSpec{
+					Model: "iris",
+					Input: mlopsv1alpha1.BatchJobInput{
+						StorageUri: "s3://bucket/input.csv",
+						Format:     mlopsv1alpha1.CsvBatchJobInputFormat,
+						TensorName: "predict",
+					},
+					OutputUri:             "s3://bucket/results",
+					SecretName:            ptr.String("minio"),
+					Parallelism:           ptr.Int32(8),
+					MaxRetries:            ptr.Int32(1),
+					RequestTimeoutSeconds: ptr.Int32(10),
+				},
+			},
+			expectedArgs: []string{
+				"--job-name=job",
+				"--output-uri=s3://bucket/results",
+				"--work-dir=/mnt/batch",
+				"--envoy-host=seldon-mesh.seldon",
+				"--model=iris",
+				"--input-uri=s3://bucket/input.csv",
+				"--input-format=csv",
+				"--input-name=predict",
+				"--rclone-config-dir=/mnt/rclone-config",
+				"--parallelism=8",
+				"--max-retries=1",
+				"--request-timeout=10s",
+			},
+			expectedVolumes: []string{workVolumeName, rcloneConfigVolumeName},
+			expectedSidecar: true,
+		},
+		{
+			name: "pipeline with kafka topic and local output",
+			batchJob: &mlopsv1alpha1.BatchJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "seldon"},
+				Spec: mlopsv1alpha1.BatchJobSpec{
+					Pipeline:  "p",
+					Input:     mlopsv1alpha1.BatchJobInput{KafkaTopic: "requests"},
+					OutputUri: "/mnt/results",
+					Resources: &v1.ResourceRequirements{Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}},
+				},
+			},
+			expectedArgs: []string{
+				"--job-name=job",
+				"--output-uri=/mnt/results",
+				"--work-dir=/mnt/batch",
+				"--envoy-host=seldon-mesh.seldon",
+				"--pipeline=p",
+				"--kafka-topic=requests",
+				"--kafka-config-path=/mnt/config/kafka.json",
+			},
+			expectedVolumes:   []string{workVolumeName, kafkaConfigVolumeName},
+			expectedResources: v1.ResourceRequirements{Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job := ToJob(test.batchJob, config)
+			g.Expect(job.Name).To(Equal(test.batchJob.Name))
+			g.Expect(job.Namespace).To(Equal(test.batchJob.Namespace))
+			g.Expect(*job.Spec.BackoffLimit).To(Equal(int32(0)))
+			podSpec := job.Spec.Template.Spec
+			g.Expect(podSpec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+			g.Expect(podSpec.Containers).To(HaveLen(1))
+			g.Expect(podSpec.Containers[0].Image).To(Equal(config.Image))
+			g.Expect(podSpec.Containers[0].Args).To(Equal(test.expectedArgs))
+			g.Expect(podSpec.Containers[0].Resources).To(Equal(test.expectedResources))
+			var volumes []string
+			for _, volume := range podSpec.Volumes {
+				volumes = append(volumes, volume.Name)
+			}
+			g.Expect(volumes).To(Equal(test.expectedVolumes))
+			if test.expectedSidecar {
+				g.Expect(podSpec.InitContainers).To(HaveLen(1))
+				g.Expect(podSpec.InitContainers[0].Image).To(Equal(config.RcloneImage))
+				g.Expect(*podSpec.InitContainers[0].RestartPolicy).To(Equal(v1.ContainerRestartPolicyAlways))
+			} else {
+				g.Expect(podSpec.InitContainers).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	g := NewGomegaWithT(t)
+	type test struct {
+		name            string
+		jobStatus       batchv1.JobStatus
+		pods            []v1.Pod
+		expectedState   mlopsv1alpha1.BatchJobState
+		expectedCounts  []int64
+		expectedMessage string
+	}
+
+	terminatedPod := func(message string) v1.Pod {
+		return v1.Pod{
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: RcloneContainerName, State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}}},
+					{Name: RunnerContainerName, State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Message: message}}},
+				},
+			},
+		}
+	}
+	now := metav1.Now()
+	tests := []test{
+		{
+			name:            "pending",
+			expectedState:   mlopsv1alpha1.BatchJobPending,
+			expectedCounts:  []int64{0, 0, 0},
+			expectedMessage: "Job pending",
+		},
+		{
+			name:            "running",
+			jobStatus:       batchv1.JobStatus{Active: 1, StartTime: &now},
+			expectedState:   mlopsv1alpha1.BatchJobRunning,
+			expectedCounts:  []int64{0, 0, 0},
+			expectedMessage: "Job running",
+		},
+		{
+			name: "succeeded",
+			jobStatus: batchv1.JobStatus{
+				StartTime:      &now,
+				CompletionTime: &now,
+				Conditions:     []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+			},
+			pods:            []v1.Pod{terminatedPod(`{"state":"Succeeded","total":10,"succeeded":9,"failed":1}`)},
+			expectedState:   mlopsv1alpha1.BatchJobSucceeded,
+			expectedCounts:  []int64{10, 9, 1},
+			expectedMessage: "9 records succeeded and 1 failed",
+		},
+		{
+			name: "failed",
+			jobStatus: batchv1.JobStatus{
+				StartTime:  &now,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "BackoffLimitExceeded"}},
+			},
+			pods:            []v1.Pod{terminatedPod(`{"state":"Failed","total":2,"succeeded":1,"error":"read error"}`)},
+			expectedState:   mlopsv1alpha1.BatchJobFailed,
+			expectedCounts:  []int64{2, 1, 0},
+			expectedMessage: "read error",
+		},
+		{
+			name: "failed without status",
+			jobStatus: batchv1.JobStatus{
+				StartTime:  &now,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "BackoffLimitExceeded"}},
+			},
+			pods:            []v1.Pod{terminatedPod("panic")},
+			expectedState:   mlopsv1alpha1.BatchJobFailed,
+			expectedCounts:  []int64{0, 0, 0},
+			expectedMessage: "BackoffLimitExceeded",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := &mlopsv1alpha1.BatchJobStatus{}
+			UpdateStatus(status, &batchv1.Job{Status: test.jobStatus}, test.pods)
+			g.Expect(status.State).To(Equal(test.expectedState))
+			g.Expect([]int64{status.Total, status.Succeeded, status.Failed}).To(Equal(test.expectedCounts))
+			g.Expect(status.GetCondition(mlopsv1alpha1.BatchJobComplete).Message).To(Equal(test.expectedMessage))
+			g.Expect(status.StartTime).To(Equal(test.jobStatus.StartTime))
+			if test.expectedState == mlopsv1alpha1.BatchJobSucceeded || test.expectedState == mlopsv1alpha1.BatchJobFailed {
+				g.Expect(status.CompletionTime).ToNot(BeNil())
+			}
+		})
+	}
+}
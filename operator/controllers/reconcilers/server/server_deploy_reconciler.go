@@ -94,6 +94,9 @@ func (s *ServerReconcilerWithDeployment) createDeploymentReconciler(server *mlop
 	// Update capabilities
 	updateCapabilities(server.Spec.Capabilities, server.Spec.ExtraCapabilities, podSpec)
 
+	// Update cache eviction policy
+	updateCacheEvictionPolicy(server.Spec.CacheEvictionPolicy, serverConfig.Spec.CacheEvictionPolicy, podSpec)
+
 	// Reconcile ReplicaSet
 	deploymentReconciler := NewServerDeploymentReconciler(s.ReconcilerConfig,
 		server.ObjectMeta,
@@ -25,7 +25,8 @@ import (
 )
 
 const (
-	EnvVarNameCapabilities = "SELDON_SERVER_CAPABILITIES"
+	EnvVarNameCapabilities        = "SELDON_SERVER_CAPABILITIES"
+	EnvVarNameCacheEvictionPolicy = "SELDON_CACHE_EVICTION_POLICY"
 )
 
 type ServerReconciler struct {
@@ -116,6 +117,33 @@ func updateCapabilities(capabilities []string, extraCapabilities []string, podSp
 	}
 }
 
+// The policy of the server takes precedence over the one of its ServerConfig
+func updateCacheEvictionPolicy(policy string, serverConfigPolicy string, podSpec *v1.PodSpec) {
+	if policy == "" {
+		policy = serverConfigPolicy
+	}
+	if policy == "" {
+		return
+	}
+	for idx := range podSpec.Containers {
+		container := &podSpec.Containers[idx]
+		if container.Name != mlopsv1alpha1.AgentContainerName {
+			continue
+		}
+		envVar := v1.EnvVar{Name: EnvVarNameCacheEvictionPolicy, Value: policy}
+		found := false
+		for envIdx := range container.Env {
+			if container.Env[envIdx].Name == EnvVarNameCacheEvictionPolicy {
+				container.Env[envIdx] = envVar
+				found = true
+			}
+		}
+		if !found {
+			container.Env = append(container.Env, envVar)
+		}
+	}
+}
+
 func (s *ServerReconciler) createStatefulSetReconciler(server *mlopsv1alpha1.Server, annotator *patch.Annotator) (*ServerStatefulSetReconciler, error) {
 	//Get ServerConfig
 	serverConfig, err := mlopsv1alpha1.GetServerConfigForServer(server.Spec.ServerConfig, s.Client)
@@ -132,6 +160,9 @@ func (s *ServerReconciler) createStatefulSetReconciler(server *mlopsv1alpha1.Ser
 	// Update capabilities
 	updateCapabilities(server.Spec.Capabilities, server.Spec.ExtraCapabilities, podSpec)
 
+	// Update cache eviction policy
+	updateCacheEvictionPolicy(server.Spec.CacheEvictionPolicy, serverConfig.Spec.CacheEvictionPolicy, podSpec)
+
 	// Reconcile ReplicaSet
 	statefulSetReconciler := NewServerStatefulSetReconciler(s.ReconcilerConfig,
 		server.ObjectMeta,
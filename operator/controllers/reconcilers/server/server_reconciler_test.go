@@ -490,6 +490,69 @@ func TestUpdateServerCapabilities(t *testing.T) {
 	}
 }
 
+func TestUpdateCacheEvictionPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name               string
+		policy             string
+		serverConfigPolicy string
+		podSpec            *v1.PodSpec
+		expectedEnv        []v1.EnvVar
+	}
+	podSpec := func(env []v1.EnvVar) *v1.PodSpec {
+		return &v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "server",
+					Image: "myimagec1:1",
+				},
+				{
+					Name:  mlopsv1alpha1.AgentContainerName,
+					Image: "agent:1",
+					Env:   env,
+				},
+			},
+		}
+	}
+	tests := []test{
+		{
+			name:        "no policy",
+			podSpec:     podSpec([]v1.EnvVar{{Name: EnvVarNameCapabilities, Value: "foo"}}),
+			expectedEnv: []v1.EnvVar{{Name: EnvVarNameCapabilities, Value: "foo"}},
+		},
+		{
+			name:               "server config policy",
+			serverConfigPolicy: "lfu",
+			podSpec:            podSpec([]v1.EnvVar{{Name: EnvVarNameCapabilities, Value: "foo"}}),
+			expectedEnv: []v1.EnvVar{
+				{Name: EnvVarNameCapabilities, Value: "foo"},
+				{Name: EnvVarNameCacheEvictionPolicy, Value: "lfu"},
+			},
+		},
+		{
+			name:               "server policy takes precedence",
+			policy:             "greedy-dual-size",
+			serverConfigPolicy: "lfu",
+			podSpec:            podSpec(nil),
+			expectedEnv:        []v1.EnvVar{{Name: EnvVarNameCacheEvictionPolicy, Value: "greedy-dual-size"}},
+		},
+		{
+			name:        "replace existing env",
+			policy:      "pinned",
+			podSpec:     podSpec([]v1.EnvVar{{Name: EnvVarNameCacheEvictionPolicy, Value: "lru"}}),
+			expectedEnv: []v1.EnvVar{{Name: EnvVarNameCacheEvictionPolicy, Value: "pinned"}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			updateCacheEvictionPolicy(test.policy, test.serverConfigPolicy, test.podSpec)
+			g.Expect(test.podSpec.Containers[0].Env).To(BeEmpty())
+			g.Expect(test.podSpec.Containers[1].Env).To(Equal(test.expectedEnv))
+		})
+	}
+}
+
 func TestMergeContainers(t *testing.T) {
 	g := NewGomegaWithT(t)
 
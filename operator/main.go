@@ -34,6 +34,7 @@ import (
 
 	"github.com/seldonio/seldon-core/operator/v2/apis/mlops/v1alpha1"
 	mlopscontrollers "github.com/seldonio/seldon-core/operator/v2/controllers/mlops"
+	"github.com/seldonio/seldon-core/operator/v2/controllers/reconcilers/batchjob"
 	"github.com/seldonio/seldon-core/operator/v2/scheduler"
 	"github.com/seldonio/seldon-core/operator/v2/version"
 )
@@ -62,6 +63,15 @@ func getLogLevel(l string) zap2.AtomicLevel {
 	return level
 }
 
+// getImage returns the image of the repository with the tag of this release
+func getImage(repository string) string {
+	tag := version.Tag
+	if tag == "" {
+		tag = "latest"
+	}
+	return repository + ":" + tag
+}
+
 func getWatchNamespaceConfig(namespace, watchNamespaces string, clusterwide bool) map[string]cache.Config {
 	configs := make(map[string]cache.Config)
 
@@ -105,6 +115,8 @@ func main() {
 		clusterwide              bool
 		logLevel                 string
 		useDeploymentsForServers bool
+		batchJobImage            string
+		rcloneImage              string
 	)
 
 	flag.BoolVar(&displayVersion, "version", false, "display version and exit")
@@ -121,6 +133,8 @@ func main() {
 	flag.BoolVar(&clusterwide, "clusterwide", false, "Allow clusterwide operations")
 	flag.StringVar(&logLevel, "log-level", "debug", "The log level to use for the operator.")
 	flag.BoolVar(&useDeploymentsForServers, "use-deployments-for-servers", false, "Use server with deployment instead of statefulset.")
+	flag.StringVar(&batchJobImage, "batch-job-image", getImage("seldonio/seldon-batchjob"), "The image of the batch job runner.")
+	flag.StringVar(&rcloneImage, "rclone-image", getImage("seldonio/seldon-rclone"), "The image of the rclone sidecar of batch jobs.")
 
 	opts := zap.Options{
 		Development: true,
@@ -197,6 +211,15 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "Pipeline")
 		os.Exit(1)
 	}
+	if err = (&mlopscontrollers.EnsembleReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Scheduler: schedulerClient,
+		Recorder:  mgr.GetEventRecorderFor("ensemble-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Ensemble")
+		os.Exit(1)
+	}
 	if err = (&mlopscontrollers.ServerConfigReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -229,6 +252,19 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "SeldonConfig")
 		os.Exit(1)
 	}
+	if err = (&mlopscontrollers.BatchJobReconciler{
+		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("batchjob-controller"),
+		JobConfig: batchjob.JobConfig{
+			Image:       batchJobImage,
+			RcloneImage: rcloneImage,
+		},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BatchJob")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -183,6 +183,32 @@ func (sc *SchedulerClient) LoadModel(data []byte) (*scheduler.LoadModelResponse,
 	return res, nil
 }
 
+func (sc *SchedulerClient) ExplainSchedule(data []byte) (*scheduler.ExplainScheduleResponse, error) {
+	model := &mlopsv1alpha1.Model{}
+	err := unMarshallYamlStrict(data, model)
+	if err != nil {
+		return nil, err
+	}
+	schModel, err := model.AsSchedulerModel()
+	if err != nil {
+		return nil, err
+	}
+	req := &scheduler.ExplainScheduleRequest{Model: schModel}
+	if sc.verbose {
+		printProto(req)
+	}
+	conn, err := sc.newConnection()
+	if err != nil {
+		return nil, err
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	res, err := grpcClient.ExplainSchedule(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (sc *SchedulerClient) ListModels() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -18,6 +18,7 @@ const (
 	// note: we do not have a finalizer for servers as we rely on the draining logic to reschedule models
 	ModelFinalizerName      = "seldon.model.finalizer"
 	PipelineFinalizerName   = "seldon.pipeline.finalizer"
+	EnsembleFinalizerName   = "seldon.ensemble.finalizer"
 	ExperimentFinalizerName = "seldon.experiment.finalizer"
 	RuntimeFinalizerName    = "seldon.runtime.finalizer"
 )
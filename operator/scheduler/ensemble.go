@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/operator/v2/apis/mlops/v1alpha1"
+	"github.com/seldonio/seldon-core/operator/v2/pkg/constants"
+	"github.com/seldonio/seldon-core/operator/v2/pkg/utils"
+)
+
+// Ensembles are loaded in the scheduler as pipelines with an ensemble spec, so their events arrive with
+// the pipeline events
+
+func (s *SchedulerClient) LoadEnsemble(ctx context.Context, ensemble *v1alpha1.Ensemble, grpcClient scheduler.SchedulerClient) (bool, error) {
+	logger := s.logger.WithName("LoadEnsemble")
+	var err error
+	if grpcClient == nil {
+		conn, err := s.getConnection(ensemble.Namespace)
+		if err != nil {
+			return true, err
+		}
+		grpcClient = scheduler.NewSchedulerClient(conn)
+	}
+	req := scheduler.LoadPipelineRequest{
+		Pipeline: ensemble.AsSchedulerPipeline(),
+	}
+	logger.Info("Load", "ensemble name", ensemble.Name)
+
+	err = retryFnConstBackoff(func() error {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+		defer cancel()
+
+		_, err := grpcClient.LoadPipeline(
+			ctx,
+			&req,
+		)
+		return err
+	}, func(err error, duration time.Duration) {
+		logger.Error(err, "LoadEnsemble failed, retrying", "duration", duration)
+	})
+
+	return s.checkErrorRetryable(ensemble.Kind, ensemble.Name, err), err
+}
+
+func (s *SchedulerClient) UnloadEnsemble(ctx context.Context, ensemble *v1alpha1.Ensemble) (error, bool) {
+	logger := s.logger.WithName("UnloadEnsemble")
+	conn, err := s.getConnection(ensemble.Namespace)
+	if err != nil {
+		return err, true
+	}
+	grpcClient := scheduler.NewSchedulerClient(conn)
+	req := scheduler.UnloadPipelineRequest{
+		Name: ensemble.Name,
+	}
+	logger.Info("Unload", "ensemble name", ensemble.Name)
+
+	err = retryFnConstBackoff(func() error {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+		defer cancel()
+
+		_, err = grpcClient.UnloadPipeline(
+			ctx,
+			&req,
+		)
+		return err
+	}, func(err error, duration time.Duration) {
+		logger.Error(err, "UnloadEnsemble failed, retrying", "duration", duration)
+	})
+
+	if err != nil {
+		return err, s.checkErrorRetryable(ensemble.Kind, ensemble.Name, err)
+	}
+
+	ensemble.Status.CreateAndSetCondition(
+		v1alpha1.EnsembleReady,
+		false,
+		scheduler.PipelineVersionState_PipelineTerminating.String(),
+		"Ensemble unload requested",
+	)
+	_ = s.updateEnsembleStatusImpl(ctx, ensemble)
+	return nil, false
+}
+
+// handleEnsembleEvent removes the finalizer of a deleted ensemble once the scheduler has terminated it and
+// sets its status, an ensemble being ready once the pipeline gateway can infer it
+func (s *SchedulerClient) handleEnsembleEvent(
+	ctx context.Context,
+	logger logr.Logger,
+	ensembleName string,
+	pv *scheduler.PipelineWithState,
+) {
+	namespace := pv.GetPipeline().GetKubernetesMeta().GetNamespace()
+	if canRemovePipelineFinalizer(pv.State.Status, pv.State.PipelineGwStatus) {
+		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			ctxWithTimeout, cancel := context.WithTimeout(ctx, constants.K8sAPICallsTxTimeout)
+			defer cancel()
+
+			latestEnsemble := &v1alpha1.Ensemble{}
+			if err := s.Get(ctxWithTimeout, client.ObjectKey{Name: ensembleName, Namespace: namespace}, latestEnsemble); err != nil {
+				if api_errors.IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+			if !latestEnsemble.ObjectMeta.DeletionTimestamp.IsZero() { // Ensemble is being deleted
+				latestEnsemble.ObjectMeta.Finalizers = utils.RemoveStr(
+					latestEnsemble.ObjectMeta.Finalizers,
+					constants.EnsembleFinalizerName,
+				)
+				if err := s.Update(ctxWithTimeout, latestEnsemble); err != nil {
+					if api_errors.IsNotFound(err) {
+						return nil
+					}
+					logger.Error(err, "Failed to remove finalizer", "ensemble", latestEnsemble.GetName())
+					return err
+				}
+			}
+			return nil
+		})
+		if retryErr != nil {
+			logger.Error(retryErr, "Failed to remove finalizer after retries", "ensemble", ensembleName)
+		}
+	}
+
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, constants.K8sAPICallsTxTimeout)
+		defer cancel()
+
+		ensemble := &v1alpha1.Ensemble{}
+		if err := s.Get(ctxWithTimeout, client.ObjectKey{Name: ensembleName, Namespace: namespace}, ensemble); err != nil {
+			if api_errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		if pv.GetPipeline().GetKubernetesMeta().GetGeneration() != ensemble.Generation {
+			logger.Info(
+				"Ignoring event for old generation",
+				"currentGeneration", ensemble.Generation,
+				"eventGeneration", pv.GetPipeline().GetKubernetesMeta().GetGeneration(),
+				"ensemble", ensembleName,
+			)
+			return nil
+		}
+
+		status := pv.State.PipelineGwStatus
+		if pv.State.Status != scheduler.PipelineVersionState_PipelineReady {
+			status = pv.State.Status
+		}
+		ensemble.Status.CreateAndSetCondition(
+			v1alpha1.EnsembleReady,
+			status == scheduler.PipelineVersionState_PipelineReady,
+			combineReasons(pv.State.PipelineGwReason, pv.State.Reason),
+			status.String(),
+		)
+		if pv.State.ModelsReady {
+			ensemble.Status.CreateAndSetCondition(v1alpha1.ModelsReady, true, "Models all available", "")
+		} else {
+			ensemble.Status.CreateAndSetCondition(v1alpha1.ModelsReady, false, "Some models are not available", "")
+		}
+
+		return s.updateEnsembleStatusImpl(ctxWithTimeout, ensemble)
+	})
+	if retryErr != nil {
+		logger.Error(retryErr, "Failed to update status", "ensemble", ensembleName)
+	}
+}
+
+func (s *SchedulerClient) updateEnsembleStatusImpl(ctx context.Context, ensemble *v1alpha1.Ensemble) error {
+	if err := s.Status().Update(ctx, ensemble); err != nil {
+		if api_errors.IsNotFound(err) {
+			return nil
+		}
+		s.recorder.Eventf(ensemble, v1.EventTypeWarning, "UpdateFailed",
+			"Failed to update status for ensemble %q: %v", ensemble.Name, err)
+		return err
+	}
+	return nil
+}
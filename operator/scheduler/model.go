@@ -253,6 +253,7 @@ func (s *SchedulerClient) SubscribeModelEvents(ctx context.Context, grpcClient s
 				// Handle status update
 				modelStatus := latestVersionStatus.GetState()
 				setModelStatus(modelStatus, event, latestModel, &logger)
+				latestModel.Status.SetSchedulingWarning(modelStatus.GetSchedulingWarning())
 
 				// Set modelgw status
 				latestModel.Status.ModelGwStatus = modelStatus.GetModelGwState().String()
@@ -148,6 +148,11 @@ func (s *SchedulerClient) SubscribePipelineEvents(ctx context.Context, grpcClien
 			"State", pv.GetState().String(),
 		)
 
+		if pv.GetPipeline().GetEnsemble() != nil {
+			s.handleEnsembleEvent(ctx, logger, event.PipelineName, pv)
+			continue
+		}
+
 		if canRemovePipelineFinalizer(pv.State.Status, pv.State.PipelineGwStatus) {
 			retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 				ctxWithTimeout, cancel := context.WithTimeout(ctx, constants.K8sAPICallsTxTimeout)
@@ -351,6 +351,72 @@ func (s *SchedulerClient) handlePendingDeletePipelines(
 	return nil
 }
 
+func (s *SchedulerClient) handleLoadedEnsembles(
+	ctx context.Context, grpcClient scheduler.SchedulerClient, namespace string) error {
+	ensembleList := &v1alpha1.EnsembleList{}
+	// Get all ensembles in the namespace
+	err := s.List(
+		ctx,
+		ensembleList,
+		client.InNamespace(namespace),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, ensemble := range ensembleList.Items {
+		if ensemble.ObjectMeta.DeletionTimestamp.IsZero() {
+			s.logger.V(1).Info("Calling load ensemble (on reconnect)", "ensemble", ensemble.Name)
+			if retryable, err := s.LoadEnsemble(ctx, &ensemble, grpcClient); err != nil {
+				s.logger.Error(err, "Failed to call load ensemble", "ensemble", ensemble.Name)
+				if retryable {
+					return err
+				}
+			} else {
+				s.logger.V(1).Info("Load ensemble called successfully", "ensemble", ensemble.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *SchedulerClient) handlePendingDeleteEnsembles(
+	ctx context.Context, namespace string) error {
+	ensembleList := &v1alpha1.EnsembleList{}
+	// Get all ensembles in the namespace
+	err := s.List(
+		ctx,
+		ensembleList,
+		client.InNamespace(namespace),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Check if any ensembles are being deleted
+	for _, ensemble := range ensembleList.Items {
+		if !ensemble.ObjectMeta.DeletionTimestamp.IsZero() {
+			s.logger.V(1).Info("Removing finalizer (on reconnect)", "ensemble", ensemble.Name)
+			retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				ensemble.ObjectMeta.Finalizers = utils.RemoveStr(ensemble.ObjectMeta.Finalizers, constants.EnsembleFinalizerName)
+				if errUpdate := s.Update(ctx, &ensemble); errUpdate != nil {
+					s.logger.Error(errUpdate, "Failed to remove finalizer", "ensemble", ensemble.Name)
+					return errUpdate
+				}
+				s.logger.Info("Removed finalizer", "ensemble", ensemble.Name)
+				return nil
+			})
+			if retryErr != nil {
+				s.logger.Error(retryErr, "Failed to remove finalizer after retries", "ensemble", ensemble.Name)
+				return retryErr
+			}
+		}
+	}
+
+	return nil
+}
+
 func getNumExperimentsFromScheduler(ctx context.Context, grpcClient scheduler.SchedulerClient) (int, error) {
 	req := &scheduler.ExperimentStatusRequest{
 		SubscriberName: "seldon manager",
@@ -432,7 +498,8 @@ func (s *SchedulerClient) handlePipelines(
 		return err
 	}
 	// if there are no pipelines in the scheduler state then we need to create them if they exist in k8s
-	// also remove finalizers from pipelines that are being deleted
+	// also remove finalizers from pipelines that are being deleted. Ensembles are pipelines in the scheduler
+	// state so are handled in the same way
 	if numPipelinesFromScheduler == 0 {
 		if err := s.handleLoadedPipelines(ctx, grpcClient, namespace); err != nil {
 			return err
@@ -440,6 +507,12 @@ func (s *SchedulerClient) handlePipelines(
 		if err := s.handlePendingDeletePipelines(ctx, namespace); err != nil {
 			return err
 		}
+		if err := s.handleLoadedEnsembles(ctx, grpcClient, namespace); err != nil {
+			return err
+		}
+		if err := s.handlePendingDeleteEnsembles(ctx, namespace); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -304,6 +304,12 @@ func (s *mockSchedulerGrpcClient) StopExperiment(ctx context.Context, in *schedu
 	s.requests_experiments_unload = append(s.requests_experiments_unload, in)
 	return nil, nil
 }
+func (s *mockSchedulerGrpcClient) ExperimentFeedback(ctx context.Context, in *scheduler.ExperimentFeedbackRequest, opts ...grpc.CallOption) (*scheduler.ExperimentFeedbackResponse, error) {
+	return nil, nil
+}
+func (s *mockSchedulerGrpcClient) ExperimentRequests(ctx context.Context, in *scheduler.ExperimentRequestsRequest, opts ...grpc.CallOption) (*scheduler.ExperimentRequestsResponse, error) {
+	return nil, nil
+}
 func (s *mockSchedulerGrpcClient) ServerStatus(ctx context.Context, in *scheduler.ServerStatusRequest, opts ...grpc.CallOption) (scheduler.Scheduler_ServerStatusClient, error) {
 	// only used for seldon cli, not used in controller
 	return nil, nil
@@ -319,6 +325,9 @@ func (s *mockSchedulerGrpcClient) PipelineStatus(ctx context.Context, in *schedu
 func (s *mockSchedulerGrpcClient) SchedulerStatus(ctx context.Context, in *scheduler.SchedulerStatusRequest, opts ...grpc.CallOption) (*scheduler.SchedulerStatusResponse, error) {
 	return nil, nil
 }
+func (s *mockSchedulerGrpcClient) ExplainSchedule(ctx context.Context, in *scheduler.ExplainScheduleRequest, opts ...grpc.CallOption) (*scheduler.ExplainScheduleResponse, error) {
+	return nil, nil
+}
 func (s *mockSchedulerGrpcClient) SubscribeServerStatus(ctx context.Context, in *scheduler.ServerSubscriptionRequest, opts ...grpc.CallOption) (scheduler.Scheduler_SubscribeServerStatusClient, error) {
 	return newMockSchedulerServerSubscribeGrpcClient(s.responses_subscribe_servers), nil
 }
@@ -18,11 +18,14 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
 const (
 	envServerHttpPort                                  = "SELDON_SERVER_HTTP_PORT"
 	envServerGrpcPort                                  = "SELDON_SERVER_GRPC_PORT"
+	envServerManagementPort                            = "SELDON_SERVER_MANAGEMENT_PORT"
 	envReverseProxyHttpPort                            = "SELDON_REVERSE_PROXY_HTTP_PORT"
 	envReverseProxyGrpcPort                            = "SELDON_REVERSE_PROXY_GRPC_PORT"
 	envDebugGrpcPort                                   = "SELDON_DEBUG_GRPC_PORT"
@@ -38,6 +41,7 @@ const (
 	envMemoryRequest                                   = "MEMORY_REQUEST"
 	envCapabilities                                    = "SELDON_SERVER_CAPABILITIES"
 	envOverCommitPercentage                            = "SELDON_OVERCOMMIT_PERCENTAGE"
+	envCacheEvictionPolicy                             = "SELDON_CACHE_EVICTION_POLICY"
 	envEnvoyHost                                       = "SELDON_ENVOY_HOST"
 	envEnvoyPort                                       = "SELDON_ENVOY_PORT"
 	envDrainerServicePort                              = "SELDON_DRAINER_PORT"
@@ -54,6 +58,9 @@ const (
 	envMaxUnloadRetryCount                             = "SELDON_MAX_UNLOAD_RETRY_COUNT"
 	envUnloadGraceSeconds                              = "SELDON_UNLOAD_GRACE_PERIOD_SECONDS"
 	envuseDeploymentsForServers                        = "SELDON_USE_DEPLOYMENTS_FOR_SERVERS"
+	envTopologyLabels                                  = "SELDON_TOPOLOGY_LABELS"
+	envNodeName                                        = "NODE_NAME"
+	envTopologyZone                                    = "TOPOLOGY_ZONE"
 
 	flagVersion                                         = "version"
 	flagSchedulerHost                                   = "scheduler-host"
@@ -63,6 +70,7 @@ const (
 	flagServerIdx                                       = "server-idx"
 	flagInferenceHttpPort                               = "inference-http-port"
 	flagInferenceGrpcPort                               = "inference-grpc-port"
+	flagInferenceManagementPort                         = "inference-management-port"
 	flagReverseProxyHttpPort                            = "reverse-proxy-http-port"
 	flagReverseProxyGrpcPort                            = "reverse-proxy-grpc-port"
 	flagDebugGrpcPort                                   = "debug-grpc-port"
@@ -73,7 +81,9 @@ const (
 	flagMemoryBytes                                     = "memory-bytes"
 	flagCapabilities                                    = "capabilities"
 	flagOverCommitPercentage                            = "over-commit-percentage"
+	flagCacheEvictionPolicy                             = "cache-eviction-policy"
 	flagTracingConfigPath                               = "tracing-config-path"
+	flagKafkaConfigPath                                 = "kafka-config-path"
 	flagEnvoyHost                                       = "envoy-host"
 	flagEnvoyPort                                       = "envoy-port"
 	flagDrainerServicePort                              = "drainer-port"
@@ -90,11 +100,13 @@ const (
 	flagMaxUnloadRetryCount                             = "max-unload-retry-count"
 	flagUnloadGraceSeconds                              = "unload-grace-period-seconds"
 	flagUseDeploymentsForServers                        = "use-deployments-for-servers"
+	flagTopologyLabels                                  = "topology-labels"
 )
 
 const (
 	defaultInferenceHttpPort                               = 8080
 	defaultInferenceGrpcPort                               = 9500
+	defaultInferenceManagementPort                         = 8081
 	defaultRclonePort                                      = 5572
 	defaultSchedulerPort                                   = 9005
 	defaultSchedulerTlsPort                                = 9055
@@ -130,6 +142,7 @@ var (
 	InferenceHost                                   string
 	InferenceHttpPort                               int
 	InferenceGrpcPort                               int
+	InferenceManagementPort                         int
 	ReverseProxyHttpPort                            int
 	ReverseProxyGrpcPort                            int
 	DebugGrpcPort                                   int
@@ -146,8 +159,10 @@ var (
 	capabilitiesList                                string
 	Capabilities                                    []string
 	OverCommitPercentage                            int
-	serverTypes                                     = [...]string{"mlserver", "triton"}
+	CacheEvictionPolicy                             string
+	serverTypes                                     = [...]string{"mlserver", "triton", "torchserve", "openai"}
 	TracingConfigPath                               string
+	KafkaConfigPath                                 string
 	EnvoyHost                                       string
 	EnvoyPort                                       int
 	DrainerServicePort                              int
@@ -164,6 +179,8 @@ var (
 	MaxUnloadRetryCount                             int
 	UnloadGraceSeconds                              int
 	useDeploymentsForServers                        bool
+	topologyLabelsList                              string
+	TopologyLabels                                  map[string]string
 )
 
 func init() {
@@ -179,10 +196,12 @@ func UpdateArgs() {
 
 func updateFlagsFromEnv() {
 	maybeUpdateOverCommitPercentage()
+	maybeUpdateCacheEvictionPolicy()
 	maybeUpdateCapabilities()
 	maybeUpdateMemoryRequest()
 	maybeUpdateInferenceHttpPort()
 	maybeUpdateInferenceGrpcPort()
+	maybeUpdateInferenceManagementPort()
 	maybeUpdateReverseProxyHttpPort()
 	maybeUpdateReverseProxyGrpcPort()
 	maybeUpdateDebugGrpcPort()
@@ -210,6 +229,7 @@ func updateFlagsFromEnv() {
 	maybeMaxLoadRetryCount()
 	maybeMaxUnloadRetryCount()
 	maybeUpdateUnloadGraceSeconds()
+	maybeUpdateTopologyLabels()
 
 }
 
@@ -310,6 +330,20 @@ func maybeUpdateOverCommitPercentage() {
 	OverCommitPercentage = int(overCommitPercentageFromEnv)
 }
 
+func maybeUpdateCacheEvictionPolicy() {
+	if isFlagPassed(flagCacheEvictionPolicy) {
+		return
+	}
+
+	envPolicy, found := getEnvString(envCacheEvictionPolicy)
+	if !found {
+		return
+	}
+
+	log.Infof("Setting %s from %s to %s", flagCacheEvictionPolicy, envCacheEvictionPolicy, envPolicy)
+	CacheEvictionPolicy = envPolicy
+}
+
 func maybeUpdateCapabilities() {
 	if isFlagPassed(flagCapabilities) {
 		return
@@ -327,6 +361,37 @@ func maybeUpdateCapabilities() {
 	Capabilities = cs
 }
 
+// Topology labels can be set from the env, e.g. zone=europe-west1-b,node=node1. The node and zone
+// labels default to the node the agent runs on and its zone if they are exposed as NODE_NAME and
+// TOPOLOGY_ZONE, the latter from the topology.kubernetes.io/zone label of the pod
+func maybeUpdateTopologyLabels() {
+	if !isFlagPassed(flagTopologyLabels) {
+		labelsFromEnv, found := getEnvString(envTopologyLabels)
+		if found {
+			labels, err := parseTopologyLabels(labelsFromEnv)
+			if err != nil {
+				log.WithError(err).Fatalf("Failed to parse %s for topology labels", envTopologyLabels)
+			}
+			log.Infof("Setting topology labels from env %s with value %v", envTopologyLabels, labels)
+			TopologyLabels = labels
+		}
+	}
+
+	maybeSetTopologyLabel(util.TopologyNodeLabel, envNodeName)
+	maybeSetTopologyLabel(util.TopologyZoneLabel, envTopologyZone)
+}
+
+func maybeSetTopologyLabel(label string, envName string) {
+	if _, ok := TopologyLabels[label]; ok {
+		return
+	}
+	value, found := getEnvString(envName)
+	if found {
+		log.Infof("Setting topology label %s from env %s with value %s", label, envName, value)
+		TopologyLabels[label] = value
+	}
+}
+
 func maybeUpdateMemoryRequest() {
 	if isFlagPassed(flagMemoryBytes) {
 		return
@@ -396,6 +461,10 @@ func maybeUpdateInferenceGrpcPort() {
 	maybeUpdatePort(flagInferenceGrpcPort, envServerGrpcPort, &InferenceGrpcPort)
 }
 
+func maybeUpdateInferenceManagementPort() {
+	maybeUpdatePort(flagInferenceManagementPort, envServerManagementPort, &InferenceManagementPort)
+}
+
 func maybeUpdateReverseProxyHttpPort() {
 	maybeUpdatePort(flagReverseProxyHttpPort, envReverseProxyHttpPort, &ReverseProxyHttpPort)
 }
@@ -11,11 +11,13 @@ package cli
 
 import (
 	"flag"
+	"fmt"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/cache"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -32,6 +34,7 @@ func makeArgs() {
 	flag.StringVar(&InferenceHost, "inference-host", "0.0.0.0", "Inference server host")
 	flag.IntVar(&InferenceHttpPort, flagInferenceHttpPort, defaultInferenceHttpPort, "Inference server http port")
 	flag.IntVar(&InferenceGrpcPort, flagInferenceGrpcPort, defaultInferenceGrpcPort, "Inference server grpc port")
+	flag.IntVar(&InferenceManagementPort, flagInferenceManagementPort, defaultInferenceManagementPort, "Inference server management port, for servers with a separate management api (torchserve)")
 	flag.IntVar(&ReverseProxyHttpPort, flagReverseProxyHttpPort, util.DefaultReverseProxyHTTPPort, "Reverse proxy http port")
 	flag.IntVar(&ReverseProxyGrpcPort, flagReverseProxyGrpcPort, agent.ReverseGRPCProxyPort, "Reverse proxy grpc port")
 	flag.IntVar(&DebugGrpcPort, flagDebugGrpcPort, agent.GRPCDebugServicePort, "Debug grpc port")
@@ -40,12 +43,14 @@ func makeArgs() {
 	flag.StringVar(&ReplicaConfigStr, flagReplicaConfig, "", "Replica Json Config")
 	flag.StringVar(&Namespace, "namespace", "", "Namespace")
 	flag.StringVar(&ConfigPath, "config-path", "/mnt/config", "Path to folder with configuration files. Will assume agent.yaml or agent.json in this folder")
-	flag.StringVar(&ServerType, flagServerType, serverTypes[0], "Server type. Default mlserver")
+	flag.StringVar(&ServerType, flagServerType, serverTypes[0], fmt.Sprintf("Server type, one of %v. Default mlserver", serverTypes))
 	flag.IntVar(&memoryBytes, flagMemoryBytes, 1000000, "Memory available for server")
 	flag.StringVar(&capabilitiesList, flagCapabilities, "sklearn,xgboost", "Server capabilities")
 	flag.IntVar(&OverCommitPercentage, flagOverCommitPercentage, 0, "Overcommit memory percentage")
+	flag.StringVar(&CacheEvictionPolicy, flagCacheEvictionPolicy, cache.LRUEvictionPolicy, fmt.Sprintf("Policy to choose models to evict from memory when over committed, one of %v", cache.EvictionPolicies))
 	flag.StringVar(&LogLevel, flagLogLevel, "debug", "Log level - examples: debug, info, error")
 	flag.StringVar(&TracingConfigPath, flagTracingConfigPath, "", "Tracing config path")
+	flag.StringVar(&KafkaConfigPath, flagKafkaConfigPath, "", "Kafka config path, needed to capture experiment responses and log payloads")
 	flag.StringVar(&EnvoyHost, flagEnvoyHost, defaultEnvoyHost, "Envoy host")
 	flag.IntVar(&EnvoyPort, flagEnvoyPort, defaultEnvoyPort, "Envoy port")
 	flag.IntVar(&DrainerServicePort, flagDrainerServicePort, defaultDrainerServicePort, "Drainer port")
@@ -61,6 +66,7 @@ func makeArgs() {
 	flag.IntVar(&MaxUnloadRetryCount, flagMaxUnloadRetryCount, defaultMaxUnloadRetryCount, "Number of retries for unloading a model onto a server")
 	flag.IntVar(&UnloadGraceSeconds, flagUnloadGraceSeconds, defautUnloadGraceSeconds, "Grace period in seconds before unloading a model")
 	flag.BoolVar(&useDeploymentsForServers, flagUseDeploymentsForServers, defaultUseDeploymentsForServers, "Use server with deployment instead of statefulset.")
+	flag.StringVar(&topologyLabelsList, flagTopologyLabels, "", "Topology labels of the server replica, e.g. zone=europe-west1-b,node=node1")
 }
 
 func parseFlags() {
@@ -69,6 +75,11 @@ func parseFlags() {
 
 	parseMemoryBytes()
 	parseCapabilities()
+	labels, err := parseTopologyLabels(topologyLabelsList)
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to parse %s", flagTopologyLabels)
+	}
+	TopologyLabels = labels
 }
 
 func parseMemoryBytes() {
@@ -82,6 +93,27 @@ func parseCapabilities() {
 	log.Infof("Server Capabilities %v", Capabilities)
 }
 
+func parseTopologyLabels(labelsList string) (map[string]string, error) {
+	labels := map[string]string{}
+	for _, label := range trimStrings(strings.Split(labelsList, ",")) {
+		if label == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(label, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("topology label %q is not of the form key=value", label)
+		}
+		// labels without a value are skipped, e.g. a zone set from a pod label the pod does not have
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
 func isFlagPassed(name string) bool {
 	found := false
 	flag.Visit(func(f *flag.Flag) {
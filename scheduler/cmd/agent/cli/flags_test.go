@@ -37,6 +37,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 		expectedRclonePort                                      int
 		expectedInferenceHost                                   string
 		expectedInferenceHttpPort                               int
+		expectedInferenceManagementPort                         int
 		expectedInferenceGrpcPort                               int
 		expectedReverseProxyHttpPort                            int
 		expectedReverseProxyGrpcPort                            int
@@ -51,6 +52,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 		expectedMemoryRequest                                   uint64
 		expectedCapabilities                                    []string
 		expectedOverCommitPercentage                            int
+		expectedCacheEvictionPolicy                             string
 		expectedEnvoyHost                                       string
 		expectedEnvoyPort                                       int
 		expectedDrainerPort                                     int
@@ -72,6 +74,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			args:                                  []string{},
 			envs:                                  []string{},
 			expectedAgentHost:                     "0.0.0.0",
+			expectedCacheEvictionPolicy:           "lru",
 			expectedServerName:                    "mlserver",
 			expectedReplicaIdx:                    0,
 			expectedSchedulerHost:                 "0.0.0.0",
@@ -81,6 +84,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			expectedRclonePort:                    defaultRclonePort,
 			expectedInferenceHost:                 "0.0.0.0",
 			expectedInferenceHttpPort:             defaultInferenceHttpPort,
+			expectedInferenceManagementPort:       defaultInferenceManagementPort,
 			expectedInferenceGrpcPort:             defaultInferenceGrpcPort,
 			expectedReverseProxyHttpPort:          9999,
 			expectedReverseProxyGrpcPort:          9998,
@@ -123,6 +127,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 				"--rclone-port=11",
 				"--inference-host=12.12.12.12",
 				"--inference-http-port=12",
+				"--inference-management-port=13",
 				"--inference-grpc-port=122",
 				"--reverse-proxy-http-port=13",
 				"--reverse-proxy-grpc-port=133",
@@ -137,6 +142,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 				"--memory-bytes=300",
 				"--capabilities=a,b",
 				"--over-commit-percentage=10",
+				"--cache-eviction-policy=greedy-dual-size",
 				"--envoy-host=2.2.2.2",
 				"--envoy-port=2000",
 				"--drainer-port=2001",
@@ -163,6 +169,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			expectedRclonePort:                    11,
 			expectedInferenceHost:                 "12.12.12.12",
 			expectedInferenceHttpPort:             12,
+			expectedInferenceManagementPort:       13,
 			expectedInferenceGrpcPort:             122,
 			expectedReverseProxyHttpPort:          13,
 			expectedReverseProxyGrpcPort:          133,
@@ -177,6 +184,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			expectedMemoryRequest:                 300,
 			expectedCapabilities:                  []string{"a", "b"},
 			expectedOverCommitPercentage:          10,
+			expectedCacheEvictionPolicy:           "greedy-dual-size",
 			expectedEnvoyHost:                     "2.2.2.2",
 			expectedEnvoyPort:                     2000,
 			expectedDrainerPort:                   2001,
@@ -198,6 +206,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			envs: []string{
 				"SELDON_SERVER_TYPE=mlserver",
 				"SELDON_SERVER_HTTP_PORT=10",
+				"SELDON_SERVER_MANAGEMENT_PORT=11",
 				"SELDON_SERVER_GRPC_PORT=20",
 				"SELDON_REVERSE_PROXY_HTTP_PORT=11",
 				"SELDON_REVERSE_PROXY_GRPC_PORT=21",
@@ -211,6 +220,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 				"MEMORY_REQUEST=400",
 				"SELDON_SERVER_CAPABILITIES=c,d",
 				"SELDON_OVERCOMMIT_PERCENTAGE=30",
+				"SELDON_CACHE_EVICTION_POLICY=lfu",
 				"SELDON_ENVOY_HOST=3.3.3.3",
 				"SELDON_ENVOY_PORT=3000",
 				"SELDON_DRAINER_PORT=3001",
@@ -226,36 +236,38 @@ func TestAgentCliArgsDefault(t *testing.T) {
 				"SELDON_MAX_UNLOAD_RETRY_COUNT=1",
 				"SELDON_UNLOAD_GRACE_PERIOD_SECONDS=5",
 			},
-			expectedAgentHost:                     "0.0.0.0",
-			expectedServerName:                    "mlserver",
-			expectedReplicaIdx:                    0,
-			expectedSchedulerHost:                 "10.10.10.10",
-			expectedSchedulerPort:                 100,
-			expectedSchedulerTlsPort:              111,
-			expectedRcloneHost:                    "0.0.0.0",
-			expectedRclonePort:                    defaultRclonePort,
-			expectedInferenceHost:                 "0.0.0.0",
-			expectedInferenceHttpPort:             10,
-			expectedInferenceGrpcPort:             20,
-			expectedReverseProxyHttpPort:          11,
-			expectedReverseProxyGrpcPort:          21,
-			expectedDebugGrpcPort:                 30,
-			expectedMetricsPort:                   40,
-			expectedAgentFolder:                   "/mnt/agent",
-			expectedReplicaConfigStr:              "config",
-			expectedNamespace:                     "",
-			expectedConfigPath:                    "/mnt/config",
-			expectedLogLevel:                      "info",
-			expectedServerType:                    "mlserver",
-			expectedMemoryRequest:                 400,
-			expectedCapabilities:                  []string{"c", "d"},
-			expectedOverCommitPercentage:          30,
-			expectedEnvoyHost:                     "3.3.3.3",
-			expectedEnvoyPort:                     3000,
-			expectedDrainerPort:                   3001,
-			expectedModelInferenceLagThreshold:    50,
-			expectedModelInactiveSecondsThreshold: 60,
-			expectedScalingStatsPeriodSeconds:     70,
+			expectedAgentHost:                                       "0.0.0.0",
+			expectedServerName:                                      "mlserver",
+			expectedReplicaIdx:                                      0,
+			expectedSchedulerHost:                                   "10.10.10.10",
+			expectedSchedulerPort:                                   100,
+			expectedSchedulerTlsPort:                                111,
+			expectedRcloneHost:                                      "0.0.0.0",
+			expectedRclonePort:                                      defaultRclonePort,
+			expectedInferenceHost:                                   "0.0.0.0",
+			expectedInferenceHttpPort:                               10,
+			expectedInferenceManagementPort:                         11,
+			expectedInferenceGrpcPort:                               20,
+			expectedReverseProxyHttpPort:                            11,
+			expectedReverseProxyGrpcPort:                            21,
+			expectedDebugGrpcPort:                                   30,
+			expectedMetricsPort:                                     40,
+			expectedAgentFolder:                                     "/mnt/agent",
+			expectedReplicaConfigStr:                                "config",
+			expectedNamespace:                                       "",
+			expectedConfigPath:                                      "/mnt/config",
+			expectedLogLevel:                                        "info",
+			expectedServerType:                                      "mlserver",
+			expectedMemoryRequest:                                   400,
+			expectedCapabilities:                                    []string{"c", "d"},
+			expectedOverCommitPercentage:                            30,
+			expectedCacheEvictionPolicy:                             "lfu",
+			expectedEnvoyHost:                                       "3.3.3.3",
+			expectedEnvoyPort:                                       3000,
+			expectedDrainerPort:                                     3001,
+			expectedModelInferenceLagThreshold:                      50,
+			expectedModelInactiveSecondsThreshold:                   60,
+			expectedScalingStatsPeriodSeconds:                       70,
 			expectedMaxElapsedTimeReadySubServiceAfterStartSeconds:  30,
 			expectedMaxElapsedTimeReadySubServiceBeforeStartMinutes: 15,
 			expectedPeriodReadySubServiceSeconds:                    60,
@@ -292,6 +304,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			g.Expect(RclonePort).To(Equal(test.expectedRclonePort))
 			g.Expect(InferenceHost).To(Equal(test.expectedInferenceHost))
 			g.Expect(InferenceHttpPort).To(Equal(test.expectedInferenceHttpPort))
+			g.Expect(InferenceManagementPort).To(Equal(test.expectedInferenceManagementPort))
 			g.Expect(InferenceGrpcPort).To(Equal(test.expectedInferenceGrpcPort))
 			g.Expect(ReverseProxyHttpPort).To(Equal(test.expectedReverseProxyHttpPort))
 			g.Expect(ReverseProxyGrpcPort).To(Equal(test.expectedReverseProxyGrpcPort))
@@ -306,6 +319,7 @@ func TestAgentCliArgsDefault(t *testing.T) {
 			g.Expect(MemoryBytes64).To(Equal(test.expectedMemoryRequest))
 			g.Expect(Capabilities).To(Equal(test.expectedCapabilities))
 			g.Expect(OverCommitPercentage).To(Equal(test.expectedOverCommitPercentage))
+			g.Expect(CacheEvictionPolicy).To(Equal(test.expectedCacheEvictionPolicy))
 			g.Expect(EnvoyHost).To(Equal(test.expectedEnvoyHost))
 			g.Expect(EnvoyPort).To(Equal(test.expectedEnvoyPort))
 			g.Expect(DrainerServicePort).To(Equal(test.expectedDrainerPort))
@@ -331,3 +345,54 @@ func TestAgentCliArgsDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTopologyLabels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		labels   string
+		expected map[string]string
+		err      bool
+	}
+
+	tests := []test{
+		{
+			name:     "empty",
+			labels:   "",
+			expected: map[string]string{},
+		},
+		{
+			name:     "zone and node",
+			labels:   "zone=europe-west1-b, node=node1",
+			expected: map[string]string{"zone": "europe-west1-b", "node": "node1"},
+		},
+		{
+			name:     "empty value",
+			labels:   "zone=,node=node1",
+			expected: map[string]string{"node": "node1"},
+		},
+		{
+			name:   "missing value separator",
+			labels: "zone",
+			err:    true,
+		},
+		{
+			name:   "missing key",
+			labels: "=europe-west1-b",
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			labels, err := parseTopologyLabels(test.labels)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(labels).To(Equal(test.expected))
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ the Change License after the Change Date as each is defined in accordance with t
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -23,21 +24,29 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	agent2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
 	"github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/cmd/agent/cli"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/cache"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/capture"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/config"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/drainservice"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/k8s"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelscaling"
 	controlplane_factory "github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelserver_controlplane/factory"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/payloadlog"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/producer"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/rclone"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/readyservice"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository/mlserver"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository/openai"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository/torchserve"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository/triton"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/version"
@@ -71,6 +80,12 @@ func getRepositoryHandler(logger log.FieldLogger) repository.ModelRepositoryHand
 	case "triton":
 		logger.Infof("Creating Triton repository handler")
 		return triton.NewTritonRepositoryHandler(logger)
+	case "torchserve":
+		logger.Infof("Creating TorchServe repository handler")
+		return torchserve.NewTorchServeRepositoryHandler(logger)
+	case "openai":
+		logger.Infof("Creating OpenAI compatible server repository handler")
+		return openai.NewOpenAIRepositoryHandler(logger)
 	default:
 		logger.Infof("Using default as no server type requested - creating MLServer repository handler")
 		return mlserver.NewMLServerRepositoryHandler(logger)
@@ -94,9 +109,14 @@ func createReplicaConfig() *agent2.ReplicaConfig {
 			MemoryBytes:          cli.MemoryBytes64,
 			Capabilities:         cli.Capabilities,
 			OverCommitPercentage: uint32(cli.OverCommitPercentage),
+			TopologyLabels:       cli.TopologyLabels,
+			CacheEvictionPolicy:  cli.CacheEvictionPolicy,
 		}
 		log.Infof("Created replicaConfig from environment")
 	}
+	if _, err := cache.MakeCache(rc.CacheEvictionPolicy); err != nil {
+		log.WithError(err).Fatalf("Invalid cache eviction policy")
+	}
 	//Point to proxy always in replica config
 	rc.InferenceHttpPort = int32(cli.ReverseProxyHttpPort)
 	rc.InferenceGrpcPort = int32(cli.ReverseProxyGrpcPort)
@@ -104,6 +124,35 @@ func createReplicaConfig() *agent2.ReplicaConfig {
 	return rc
 }
 
+// Kafka is optional for the agent, it is used to capture experiment responses and log payloads
+func createKafkaDataPlaneLoggers(logger log.FieldLogger) (*producer.KafkaProducer, capture.ResponseCapturer, payloadlog.PayloadLogger) {
+	if cli.KafkaConfigPath == "" {
+		logger.Info("No kafka config provided, experiment responses and payloads will not be logged")
+		return nil, nil, nil
+	}
+	kafkaConfig, err := kafka_config.NewKafkaConfig(cli.KafkaConfigPath, cli.LogLevel)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to load kafka config from %s", cli.KafkaConfigPath)
+	}
+	if !kafkaConfig.HasKafkaBootstrapServer() {
+		logger.Warn("No kafka bootstrap server configured, experiment responses and payloads will not be logged")
+		return nil, nil, nil
+	}
+	kafkaProducer, err := producer.NewKafkaProducer(logger, kafkaConfig)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create kafka producer")
+	}
+	responseCapturer, err := capture.NewKafkaResponseCapturer(kafkaProducer, kafkaConfig.TopicPrefix, cli.Namespace)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create experiment response capturer")
+	}
+	payloadLogger, err := payloadlog.NewKafkaPayloadLogger(kafkaProducer, kafkaConfig.TopicPrefix, cli.Namespace, cli.ServerName)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create payload logger")
+	}
+	return kafkaProducer, responseCapturer, payloadLogger
+}
+
 func runningInsideK8s() bool {
 	return cli.Namespace != ""
 }
@@ -211,9 +260,12 @@ func main() {
 	modelServerControlPlaneClient, err := controlplane_factory.CreateModelServerControlPlane(
 		cli.ServerType,
 		interfaces.ModelServerConfig{
-			Host:   cli.InferenceHost,
-			Port:   cli.InferenceGrpcPort,
-			Logger: logger},
+			Host:                cli.InferenceHost,
+			Port:                cli.InferenceGrpcPort,
+			HttpPort:            cli.InferenceHttpPort,
+			ManagementPort:      cli.InferenceManagementPort,
+			ModelRepositoryPath: modelRepositoryDir,
+			Logger:              logger},
 	)
 	if err != nil {
 		logger.WithError(err).Fatal("Can't create model server control plane client")
@@ -254,16 +306,41 @@ func main() {
 			Reset:     false,
 			EventType: modelscaling.ScaleDownEvent,
 		}
+		// requests queued or rejected by the concurrency limits of models are scaling signals of their own
+		modelQueueDepthStatsWrapper := modelscaling.ModelScalingStatsWrapper{
+			Stats:     modelscaling.NewModelReplicaQueueDepthKeeper(),
+			Operator:  interfaces.Gte,
+			Threshold: uint(cli.ModelInferenceLagThreshold),
+			Reset:     true,
+			EventType: modelscaling.ScaleUpEvent,
+		}
+		modelRejectedStatsWrapper := modelscaling.ModelScalingStatsWrapper{
+			Stats:     modelscaling.NewModelReplicaRejectedKeeper(),
+			Operator:  interfaces.Gte,
+			Threshold: 1,
+			Reset:     true,
+			EventType: modelscaling.ScaleUpEvent,
+		}
 		modelScalingStatsCollector = modelscaling.NewDataPlaneStatsCollector(
 			modelLagStatsWrapper.Stats,
 			modelLastUsedStatsWrapper.Stats,
+			modelQueueDepthStatsWrapper.Stats,
+			modelRejectedStatsWrapper.Stats,
 		)
 
 		modelScalingService = modelscaling.NewStatsAnalyserService(
-			[]modelscaling.ModelScalingStatsWrapper{modelLagStatsWrapper, modelLastUsedStatsWrapper}, logger, uint(cli.ScalingStatsPeriodSeconds))
+			[]modelscaling.ModelScalingStatsWrapper{modelLagStatsWrapper, modelLastUsedStatsWrapper, modelQueueDepthStatsWrapper, modelRejectedStatsWrapper},
+			logger, uint(cli.ScalingStatsPeriodSeconds))
 		defer func() { _ = modelScalingService.Stop() }()
 	}
 
+	kafkaProducer, responseCapturer, payloadLogger := createKafkaDataPlaneLoggers(logger)
+	if kafkaProducer != nil {
+		defer kafkaProducer.Close()
+	}
+
+	banditRecorder := bandit.NewRequestRecorder(logger)
+
 	rpHTTP := agent.NewReverseHTTPProxy(
 		logger,
 		cli.InferenceHost,
@@ -271,6 +348,9 @@ func main() {
 		uint(cli.ReverseProxyHttpPort),
 		promMetrics,
 		modelScalingStatsCollector,
+		responseCapturer,
+		payloadLogger,
+		banditRecorder,
 	)
 	defer func() { _ = rpHTTP.Stop() }()
 
@@ -281,6 +361,9 @@ func main() {
 		uint(cli.InferenceGrpcPort),
 		uint(cli.ReverseProxyGrpcPort),
 		modelScalingStatsCollector,
+		responseCapturer,
+		payloadLogger,
+		banditRecorder,
 	)
 	defer func() { _ = rpGRPC.Stop() }()
 
@@ -346,6 +429,11 @@ func main() {
 	}()
 	defer func() { agentService.StopControlLoop() }()
 
+	// Send the requests served by the candidates of bandit experiments to the scheduler
+	banditCtx, cancelBandit := context.WithCancel(context.Background())
+	defer cancelBandit()
+	go banditRecorder.Start(banditCtx, agentService)
+
 	termSignalErrHandler(logger, errChan)
 	logger.Warning("Agent shutting down")
 }
@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/batch"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+	"github.com/seldonio/seldon-core/scheduler/v2/version"
+)
+
+const (
+	outputsFile         = "outputs.jsonl"
+	statusFile          = "status.json"
+	defaultEnvoyPort    = 80
+	defaultRclonePort   = 5572
+	defaultTerminateLog = "/dev/termination-log"
+)
+
+var (
+	displayVersion     bool
+	jobName            string
+	modelName          string
+	pipelineName       string
+	inputUri           string
+	inputFormat        string
+	inputName          string
+	kafkaTopic         string
+	kafkaConfigPath    string
+	outputUri          string
+	workDir            string
+	envoyHost          string
+	envoyPort          int
+	rcloneHost         string
+	rclonePort         int
+	rcloneConfigDir    string
+	parallelism        int
+	maxRetries         int
+	requestTimeout     time.Duration
+	statusInterval     time.Duration
+	terminationLogPath string
+	logLevel           string
+)
+
+func init() {
+	flag.BoolVar(&displayVersion, "version", false, "display version and exit")
+	flag.StringVar(&jobName, "job-name", "batch", "Name of the job, used as the Kafka consumer group id")
+	flag.StringVar(&modelName, "model", "", "Model to send the records to")
+	flag.StringVar(&pipelineName, "pipeline", "", "Pipeline to send the records to")
+	flag.StringVar(&inputUri, "input-uri", "", "Local path or rclone URI of the input file")
+	flag.StringVar(&inputFormat, "input-format", batch.FormatJsonl, "Format of the input file - csv, jsonl or parquet")
+	flag.StringVar(&inputName, "input-name", "", "Send the columns of each record as a single tensor with this name")
+	flag.StringVar(&kafkaTopic, "kafka-topic", "", "Kafka topic to read the records from instead of an input file")
+	flag.StringVar(&kafkaConfigPath, "kafka-config-path", "/mnt/config/kafka.json", "Path to kafka configuration file")
+	flag.StringVar(&outputUri, "output-uri", "", "Local path or rclone URI of the directory to write the outputs and status to")
+	flag.StringVar(&workDir, "work-dir", "/mnt/batch", "Local directory for files copied to and from storage")
+	flag.StringVar(&envoyHost, "envoy-host", "seldon-mesh", "Envoy host")
+	flag.IntVar(&envoyPort, "envoy-port", defaultEnvoyPort, "Envoy port")
+	flag.StringVar(&rcloneHost, "rclone-host", "0.0.0.0", "Rclone host")
+	flag.IntVar(&rclonePort, "rclone-port", defaultRclonePort, "Rclone server port")
+	flag.StringVar(&rcloneConfigDir, "rclone-config-dir", "", "Directory of rclone remote configurations to create, e.g. a mounted storage secret")
+	flag.IntVar(&parallelism, "parallelism", batch.DefaultParallelism, "Number of requests sent at the same time")
+	flag.IntVar(&maxRetries, "max-retries", batch.DefaultMaxRetries, "Number of retries of requests failing with a transient error")
+	flag.DurationVar(&requestTimeout, "request-timeout", batch.DefaultRequestTimeout, "Timeout of each request")
+	flag.DurationVar(&statusInterval, "status-interval", batch.DefaultStatusInterval, "Interval between progress reports")
+	flag.StringVar(&terminationLogPath, "termination-log-path", defaultTerminateLog, "File to write the final status to, empty to disable")
+	flag.StringVar(&logLevel, "log-level", "info", "Log level - examples: debug, info, error")
+}
+
+func main() {
+	logger := log.New()
+	flag.Parse()
+
+	if displayVersion {
+		logger.Infof("Version %s", version.Tag)
+		os.Exit(0)
+	}
+
+	logIntLevel, err := log.ParseLevel(logLevel)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to set log level %s", logLevel)
+	}
+	logger.Infof("Version %s", version.Tag)
+	logger.SetLevel(logIntLevel)
+
+	if (modelName == "") == (pipelineName == "") {
+		logger.Fatal("Exactly one of --model or --pipeline is required")
+	}
+	if (inputUri == "") == (kafkaTopic == "") {
+		logger.Fatal("Exactly one of --input-uri or --kafka-topic is required")
+	}
+	if outputUri == "" {
+		logger.Fatal("--output-uri is required")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	storage := batch.NewStorage(logger, rcloneHost, rclonePort)
+	if rcloneConfigDir != "" {
+		err = configureStorage(ctx, storage, rcloneConfigDir)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to configure storage")
+		}
+	}
+
+	reader, err := createReader(ctx, logger, storage)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to open input")
+	}
+	defer reader.Close()
+
+	tlsOptions, err := util.CreateTLSClientOptions()
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create TLS Options")
+	}
+	name, isPipeline := modelName, false
+	if pipelineName != "" {
+		name, isPipeline = pipelineName, true
+	}
+	inferer, err := batch.NewGrpcInferer(envoyHost, envoyPort, tlsOptions, name, isPipeline)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create inference client")
+	}
+
+	localDir := workDir
+	if batch.IsLocal(outputUri) {
+		localDir = outputUri
+	}
+	err = os.MkdirAll(localDir, os.ModePerm)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to create output directory %s", localDir)
+	}
+	outputs, err := os.Create(filepath.Join(localDir, outputsFile))
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create outputs file")
+	}
+	defer outputs.Close()
+
+	reportStatus := func(status *batch.Status) error {
+		return saveStatus(ctx, storage, localDir, status)
+	}
+	config := batch.Config{
+		Parallelism:    parallelism,
+		MaxRetries:     maxRetries,
+		RetryBackoff:   batch.DefaultRetryBackoff,
+		RequestTimeout: requestTimeout,
+		StatusInterval: statusInterval,
+	}
+	runner := batch.NewRunner(logger, config, inferer, reader, outputs, reportStatus)
+	status, err := runner.Run(ctx)
+	writeTerminationLog(logger, status)
+	if err != nil {
+		logger.WithError(err).Fatal("Batch job failed")
+	}
+}
+
+// configureStorage creates an rclone remote for each file of the directory
+func configureStorage(ctx context.Context, storage *batch.Storage, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		// mounted secrets and config maps link their keys to hidden directories
+		if entry.IsDir() || entry.Name()[0] == '.' {
+			continue
+		}
+		config, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		_, err = storage.Configure(ctx, config)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func createReader(ctx context.Context, logger log.FieldLogger, storage *batch.Storage) (batch.RecordReader, error) {
+	if kafkaTopic != "" {
+		kafkaConfig, err := kafka_config.NewKafkaConfig(kafkaConfigPath, logLevel)
+		if err != nil {
+			return nil, err
+		}
+		return batch.NewKafkaReader(logger, kafkaConfig.Consumer, kafkaTopic, jobName, inputName)
+	}
+	err := os.MkdirAll(workDir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	path, err := storage.Download(ctx, inputUri, workDir)
+	if err != nil {
+		return nil, err
+	}
+	return batch.NewFileReader(path, inputFormat, inputName)
+}
+
+// saveStatus writes the status next to the outputs and uploads both if the output directory is remote
+func saveStatus(ctx context.Context, storage *batch.Storage, localDir string, status *batch.Status) error {
+	b, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	statusPath := filepath.Join(localDir, statusFile)
+	err = os.WriteFile(statusPath, b, 0644)
+	if err != nil {
+		return err
+	}
+	// the final status is uploaded even if the job was interrupted
+	ctx = context.WithoutCancel(ctx)
+	return errors.Join(
+		storage.Upload(ctx, filepath.Join(localDir, outputsFile), outputUri),
+		storage.Upload(ctx, statusPath, outputUri),
+	)
+}
+
+// writeTerminationLog makes the final status available in the status of the pod
+func writeTerminationLog(logger log.FieldLogger, status *batch.Status) {
+	if terminationLogPath == "" || status == nil {
+		return
+	}
+	b, err := json.Marshal(status)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal status")
+		return
+	}
+	err = os.WriteFile(terminationLogPath, b, 0644)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to write termination log")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
 	"github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
 	health "github.com/seldonio/seldon-core/scheduler/v2/pkg/health-probe"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
@@ -53,6 +55,10 @@ const (
 	flagEnvoyHost             = "envoy-host"
 	flagEnvoyPort             = "envoy-port"
 	flagHealthPort            = "health-probe-port"
+	flagAsyncResultTTL        = "async-result-ttl"
+	flagAsyncCallbackHosts    = "async-callback-hosts"
+	flagAsyncRequestTimeout   = "async-request-timeout"
+	flagAsyncMaxPending       = "async-max-pending"
 )
 
 const (
@@ -63,6 +69,9 @@ const (
 	defaultSchedulerTLSPort      = 9044
 	defaultEnvoyPort             = 9000
 	defaultHealthProbePort       = 9999
+	defaultAsyncResultTTL        = 10 * time.Minute
+	defaultAsyncRequestTimeout   = time.Hour
+	defaultAsyncMaxPending       = 1000
 	serviceTag                   = "seldon-pipelinegateway"
 )
 
@@ -81,6 +90,10 @@ var (
 	envoyHost              string
 	envoyPort              int
 	healthProbeServicePort int
+	asyncResultTTL         time.Duration
+	asyncCallbackHosts     string
+	asyncRequestTimeout    time.Duration
+	asyncMaxPending        int
 	// TODO: add file watcher cfg using koanf and in the future read all file config in one file
 	k = koanf.New(".")
 )
@@ -105,6 +118,10 @@ func init() {
 	flag.StringVar(&envoyHost, flagEnvoyHost, "0.0.0.0", "Envoy host")
 	flag.IntVar(&envoyPort, flagEnvoyPort, defaultEnvoyPort, "Envoy port")
 	flag.IntVar(&healthProbeServicePort, flagHealthPort, defaultHealthProbePort, "Health probe port")
+	flag.DurationVar(&asyncResultTTL, flagAsyncResultTTL, defaultAsyncResultTTL, "How long results of asynchronous pipeline requests are kept after they complete, 0 disables asynchronous requests")
+	flag.StringVar(&asyncCallbackHosts, flagAsyncCallbackHosts, "", "Comma separated hosts, with an optional port, that results of asynchronous pipeline requests can be posted to, callbacks are rejected when empty")
+	flag.DurationVar(&asyncRequestTimeout, flagAsyncRequestTimeout, defaultAsyncRequestTimeout, "How long asynchronous pipeline requests can be pending before they fail")
+	flag.IntVar(&asyncMaxPending, flagAsyncMaxPending, defaultAsyncMaxPending, "Maximum asynchronous pipeline requests pending on each replica, further requests are rejected")
 }
 
 // TODO: move to a common util
@@ -178,9 +195,12 @@ func main() {
 		logger.Debugf("Schema registry not set")
 	}
 
+	// Handle pipeline status updates
+	statusManager := status.NewPipelineStatusManager()
+
 	maxNumConsumers := getEnVar(logger, pipeline.EnvMaxNumConsumers, pipeline.DefaultMaxNumConsumers)
 	km, err := pipeline.NewKafkaManager(
-		logger, namespace, kafkaConfigMap, tracer, maxNumConsumers, schemaRegistryClient)
+		logger, namespace, kafkaConfigMap, tracer, maxNumConsumers, schemaRegistryClient, statusManager)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to create kafka manager")
 	}
@@ -213,9 +233,23 @@ func main() {
 		logger.WithError(err).Fatal("Failed to create TLS Options")
 	}
 
-	// Handle pipeline status updates
-	statusManager := status.NewPipelineStatusManager()
-	schedulerClient := pipeline.NewPipelineSchedulerClient(logger, statusManager, km, tlsOptions)
+	// Ensembles and synchronous pipelines call their models through envoy instead of Kafka
+	tlsEnvoyClientOptions, err := util.CreateTLSClientOptions()
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create envoy client TLS Options")
+	}
+	envoyGrpcClient, err := util.GetInferenceGrpcClient(envoyHost, envoyPort, tlsEnvoyClientOptions)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create envoy gRPC client")
+	}
+	inferer := pipeline.NewSynchronousInferer(
+		logger,
+		pipeline.NewEnsembleInferer(logger, km, statusManager, envoyGrpcClient),
+		statusManager,
+		envoyGrpcClient,
+	)
+
+	schedulerClient := pipeline.NewPipelineSchedulerClient(logger, statusManager, inferer, tlsOptions)
 	go func() {
 		if err := schedulerClient.Start(schedulerHost, schedulerPlaintxtPort, schedulerTlsPort); err != nil {
 			logger.WithError(err).Error("Start client failed")
@@ -230,7 +264,13 @@ func main() {
 	}
 	pipelineReadyChecker := status.NewSimpleReadyChecker(statusManager, restModelChecker)
 
-	grpcServer := pipeline.NewGatewayGrpcServer(grpcPort, logger, km, promMetrics, &tlsEnvoyOptions, pipelineReadyChecker)
+	// Records the pipeline serving each request of a bandit experiment so feedback is rewarded to it
+	banditRecorder := bandit.NewRequestRecorder(logger)
+	banditCtx, cancelBandit := context.WithCancel(context.Background())
+	defer cancelBandit()
+	go banditRecorder.Start(banditCtx, schedulerClient)
+
+	grpcServer := pipeline.NewGatewayGrpcServer(grpcPort, logger, inferer, promMetrics, &tlsEnvoyOptions, pipelineReadyChecker, banditRecorder)
 	go func() {
 		if err := grpcServer.Start(); err != nil {
 			logger.WithError(err).Error("Failed to start grpc server")
@@ -238,7 +278,21 @@ func main() {
 		}
 	}()
 
-	httpServer := pipeline.NewGatewayHttpServer(httpPort, logger, km, promMetrics, &tlsEnvoyOptions, pipelineReadyChecker)
+	var asyncResults *pipeline.AsyncResults
+	if asyncResultTTL > 0 {
+		asyncResults = pipeline.NewAsyncResults(asyncResultTTL, asyncRequestTimeout, asyncMaxPending, strings.Split(asyncCallbackHosts, ","))
+		// results are shared with the other replicas, which are told apart by their pod name
+		replicaName, err := os.Hostname()
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to get replica name")
+		}
+		asyncReplicator, err := pipeline.NewAsyncResultsReplicator(logger, namespace, kafkaConfigMap, replicaName, asyncResults)
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to share asynchronous results")
+		}
+		defer asyncReplicator.Stop()
+	}
+	httpServer := pipeline.NewGatewayHttpServer(httpPort, logger, inferer, promMetrics, &tlsEnvoyOptions, pipelineReadyChecker, schedulerClient, asyncResults, banditRecorder)
 	go func() {
 		if err := httpServer.Start(); err != nil {
 			if !errors.Is(err, http.ErrServerClosed) {
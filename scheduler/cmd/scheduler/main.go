@@ -38,9 +38,12 @@ import (
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/xdscache"
 	health_probe "github.com/seldonio/seldon-core/scheduler/v2/pkg/health-probe"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/dataflow"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	scaling_config "github.com/seldonio/seldon-core/scheduler/v2/pkg/scaling/config"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/cleaner"
+	scheduling_config "github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/rollout"
 	schedulerServer "github.com/seldonio/seldon-core/scheduler/v2/pkg/server"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
@@ -73,6 +76,7 @@ var (
 	autoscalingServerEnabled         bool
 	kafkaConfigPath                  string
 	scalingConfigPath                string
+	schedulingConfigPath             string
 	schedulerReadyTimeoutSeconds     uint
 	deletedResourceTTLSeconds        uint
 	serverPackingEnabled             bool
@@ -80,6 +84,7 @@ var (
 	accessLogPath                    string
 	enableAccessLog                  bool
 	includeSuccessfulRequests        bool
+	envoyAuth                        xdscache.AuthConfig
 	enablePprof                      bool
 	pprofPort                        int
 	pprofMutexRate                   int
@@ -87,6 +92,8 @@ var (
 	retryFailedCreatingPipelinesTick time.Duration
 	retryFailedDeletePipelinesTick   time.Duration
 	maxRetryFailedPipelines          uint
+	rolloutPrometheusUrl             string
+	rolloutCheckInterval             time.Duration
 )
 
 const (
@@ -150,6 +157,13 @@ func init() {
 		"/mnt/config/scaling.json",
 		"Path to scaling configuration file",
 	)
+	// Scheduling config path, the built-in filters and sorters are used if not set
+	flag.StringVar(
+		&schedulingConfigPath,
+		"scheduling-config-path",
+		"",
+		"Path to scheduling configuration file selecting the filters and sorters used to place models",
+	)
 	flag.BoolVar(&autoscalingModelEnabled, "enable-model-autoscaling", false, "Enable native model autoscaling feature")
 	flag.BoolVar(&autoscalingServerEnabled, "enable-server-autoscaling", true, "Enable native server autoscaling feature")
 
@@ -165,7 +179,7 @@ func init() {
 	flag.UintVar(&schedulerReadyTimeoutSeconds, "scheduler-ready-timeout-seconds", 300, "Timeout for scheduler to be ready")
 
 	// This TTL is set in badger DB
-	flag.UintVar(&deletedResourceTTLSeconds, "deleted-resource-ttl-seconds", 86400, "TTL for deleted experiments and pipelines (in seconds)")
+	flag.UintVar(&deletedResourceTTLSeconds, "deleted-resource-ttl-seconds", 86400, "TTL for deleted models, experiments and pipelines (in seconds)")
 
 	// Server packing
 	flag.BoolVar(&serverPackingEnabled, "server-packing-enabled", false, "Enable server packing")
@@ -175,6 +189,13 @@ func init() {
 	flag.StringVar(&accessLogPath, "envoy-accesslog-path", "/tmp/envoy-accesslog.txt", "Envoy access log path")
 	flag.BoolVar(&enableAccessLog, "enable-envoy-accesslog", true, "Enable Envoy access log")
 	flag.BoolVar(&includeSuccessfulRequests, "include-successful-requests-envoy-accesslog", false, "Include successful requests in Envoy access log")
+
+	// Envoy inference auth config
+	flag.StringVar(&envoyAuth.JwtIssuer, "envoy-jwt-issuer", "", "Issuer of the JWTs validated by Envoy, JWTs are not validated if empty")
+	flag.StringVar(&envoyAuth.JwksUri, "envoy-jwt-jwks-uri", "", "Uri of the JSON web key set used by Envoy to validate the JWTs")
+	flag.StringVar(&envoyAuth.JwtGroupsClaim, "envoy-jwt-groups-claim", xdscache.DefaultJwtGroupsClaim, "JWT claim with the groups of the caller, nested claims are separated by dots")
+	flag.StringVar(&envoyAuth.JwtTenantClaim, "envoy-jwt-tenant-claim", "", "JWT claim identifying the tenant of the caller for rate limits, nested claims are separated by dots")
+	flag.StringVar(&envoyAuth.ExtAuthzAddress, "envoy-ext-authz-address", "", "Host and port of a gRPC ext_authz service called by Envoy for every inference request, not called if empty")
 	flag.BoolVar(&enablePprof, "enable-pprof", false, "Enables pprof on localhost - do not use in production, will affect performance")
 	flag.IntVar(&pprofPort, "pprof-port", 6060, "pprof HTTP server port")
 	flag.IntVar(&pprofBlockRate, "pprof-block-rate", 0, "pprof block rate")
@@ -184,6 +205,10 @@ func init() {
 	flag.DurationVar(&retryFailedCreatingPipelinesTick, "retry-creating-failed-pipelines-tick", time.Minute, "tick interval for re-attempting to create pipelines which failed to create")
 	flag.DurationVar(&retryFailedDeletePipelinesTick, "retry-deleting-failed-pipelines-tick", time.Minute, "tick interval for re-attempting to delete pipelines which failed to terminate")
 	flag.UintVar(&maxRetryFailedPipelines, "max-retry-failed-pipelines", 10, "max number of retry attempts to create/terminate pipelines which failed to create/terminate")
+
+	// canary rollouts of model versions
+	flag.StringVar(&rolloutPrometheusUrl, "rollout-prometheus-url", "", "Prometheus url to query agent metrics to roll back unhealthy canary model versions, if empty rollouts are not checked")
+	flag.DurationVar(&rolloutCheckInterval, "rollout-check-interval", 10*time.Second, "tick interval for progressing canary rollouts of model versions")
 }
 
 func getNamespace() string {
@@ -312,7 +337,7 @@ func main() {
 	// Create envoy incremental processor
 	incrementalProcessor, err := processor.NewIncrementalProcessor(
 		nodeID, logger, ss, es, ps, eventHub, &pipelineGatewayDetails, cleaner, &xdscache.EnvoyConfig{
-			AccessLogPath: accessLogPath, EnableAccessLog: enableAccessLog, IncludeSuccessfulRequests: includeSuccessfulRequests})
+			AccessLogPath: accessLogPath, EnableAccessLog: enableAccessLog, IncludeSuccessfulRequests: includeSuccessfulRequests, Auth: envoyAuth})
 	if err != nil {
 		log.WithError(err).Fatalf("Failed to create incremental processor")
 	}
@@ -351,11 +376,16 @@ func main() {
 		}
 	}()
 
-	// Load pipelines and experiments from DB
+	// Load models, pipelines and experiments from DB
 	// Do here after other services created so eventHub events will be handled on pipeline/experiment load
 	// If we start earlier events will be sent but not received by services that start listening "late" to eventHub
+	// Models are restored first so that pipelines and experiments see the restored model state
 	if dbPath != "" {
-		err := ps.InitialiseOrRestoreDB(dbPath, deletedResourceTTLSeconds)
+		err := ss.InitialiseOrRestoreDB(dbPath, deletedResourceTTLSeconds)
+		if err != nil {
+			log.WithError(err).Fatalf("Failed to initialise model db at %s", dbPath)
+		}
+		err = ps.InitialiseOrRestoreDB(dbPath, deletedResourceTTLSeconds)
 		if err != nil {
 			log.WithError(err).Fatalf("Failed to initialise pipeline db at %s", dbPath)
 		}
@@ -385,6 +415,29 @@ func main() {
 		sync,
 		eventHub,
 	)
+	schedulingConfigHdl, err := scheduling_config.NewSchedulingConfigHandler(schedulingConfigPath, namespace, logger)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to load Scheduling config from %s", schedulingConfigPath)
+	}
+	defer func() {
+		_ = schedulingConfigHdl.Close()
+		logger.Info("Closed scheduler scheduling config watcher")
+	}()
+	err = sched.WatchSchedulingConfig(schedulingConfigHdl)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to apply Scheduling config")
+	}
+
+	// canary rollouts of new model versions
+	var rolloutMetricsReader metrics.ModelVersionMetricsReader
+	if rolloutPrometheusUrl != "" {
+		rolloutMetricsReader = metrics.NewPrometheusQueryClient(rolloutPrometheusUrl, time.Minute)
+	}
+	rolloutController := rollout.NewRolloutController(ss, rolloutMetricsReader, rolloutCheckInterval, logger)
+	go rolloutController.Start(ctx)
+
+	// reweighting of bandit experiment candidates from submitted rewards
+	go es.StartBanditUpdates(ctx)
 
 	// scheduler <-> controller and {pipeline,model-gw} grpc
 	modelGwLoadBalancer := util.NewRingLoadBalancer(maxShardCountMultiplier)
@@ -413,7 +466,7 @@ func main() {
 	// scheduler <-> agent  grpc
 	// auto-scaling has been disabled for time being, until issue is fixed for scaling models back up
 	autoscalingModelEnabled = false
-	as := agent.NewAgentServer(logger, ss, sched, eventHub, autoscalingModelEnabled, *tlsOptions)
+	as := agent.NewAgentServer(logger, ss, sched, es, eventHub, autoscalingModelEnabled, *tlsOptions)
 	err = as.StartGrpcServer(allowPlaintxt, agentPort, agentMtlsPort)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to start agent gRPC server")
@@ -423,6 +476,12 @@ func main() {
 	sync.WaitReady()
 	logger.Info("Inference servers ready")
 
+	// any restored model replicas on servers that have not reconnected by now are rescheduled
+	ss.ReconcileRestoredState()
+	if _, err := sched.ScheduleFailedModels(); err != nil {
+		logger.WithError(err).Warn("Failed to reschedule models after reconciling restored state")
+	}
+
 	// extra wait to allow routes state to get created
 	time.Sleep(xDSWaitTimeout)
 
@@ -447,6 +506,9 @@ func main() {
 	stopPipelinePollers()
 	s.StopSendControlPlaneEvents()
 	as.StopAgentStreams()
+	if err := ss.StopDB(); err != nil {
+		log.WithError(err).Warn("Failed to write model and server state to db")
+	}
 
 	log.Info("All services have shut down cleanly")
 }
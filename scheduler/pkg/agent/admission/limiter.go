@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package admission
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+var ErrQueueFull = errors.New("too many concurrent requests")
+
+// QueueObserver is notified of the number of requests queued for a model each time a request is queued
+type QueueObserver func(modelName string, depth uint32)
+
+type modelLimiter struct {
+	inflight uint32
+	// channels of the queued requests, closed when a request is admitted
+	waiters *list.List
+}
+
+// Limiter admits the inference requests of models with a concurrency spec, requests over the max in
+// flight wait in a first in first out queue and are rejected once the queue is full
+type Limiter struct {
+	mu     sync.Mutex
+	models map[string]*modelLimiter
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		models: make(map[string]*modelLimiter),
+	}
+}
+
+// Acquire waits until the request can be sent to the model and returns the function to call once the
+// request completes, ErrQueueFull if the request is rejected or the context error if it is done first
+func (l *Limiter) Acquire(ctx context.Context, modelName string, spec *scheduler.ConcurrencySpec, observer QueueObserver) (func(), error) {
+	if spec.GetMaxInflight() == 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	model, ok := l.models[modelName]
+	if !ok {
+		model = &modelLimiter{waiters: list.New()}
+		l.models[modelName] = model
+	}
+	if model.inflight < spec.GetMaxInflight() && model.waiters.Len() == 0 {
+		model.inflight++
+		l.mu.Unlock()
+		return l.releaseFunc(modelName, model), nil
+	}
+	if uint32(model.waiters.Len()) >= spec.GetMaxQueueSize() {
+		l.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	admitted := make(chan struct{})
+	element := model.waiters.PushBack(admitted)
+	depth := uint32(model.waiters.Len())
+	l.mu.Unlock()
+
+	if observer != nil {
+		observer(modelName, depth)
+	}
+
+	select {
+	case <-admitted:
+		return l.releaseFunc(modelName, model), nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		select {
+		case <-admitted:
+			// admitted while giving up, so the slot is passed on
+			l.mu.Unlock()
+			l.releaseFunc(modelName, model)()
+		default:
+			model.waiters.Remove(element)
+			l.mu.Unlock()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// the slot of a completed request is handed over to the first queued request, if any
+func (l *Limiter) releaseFunc(modelName string, model *modelLimiter) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if front := model.waiters.Front(); front != nil {
+				model.waiters.Remove(front)
+				close(front.Value.(chan struct{}))
+				return
+			}
+			model.inflight--
+			if model.inflight == 0 {
+				delete(l.models, modelName)
+			}
+		})
+	}
+}
+
+// QueueDepth returns the number of requests waiting to be sent to the model
+func (l *Limiter) QueueDepth(modelName string) uint32 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if model, ok := l.models[modelName]; ok {
+		return uint32(model.waiters.Len())
+	}
+	return 0
+}
+
+// InFlight returns the number of requests admitted to the model which have not completed yet
+func (l *Limiter) InFlight(modelName string) uint32 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if model, ok := l.models[modelName]; ok {
+		return model.inflight
+	}
+	return 0
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package admission
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestLimiterNoSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	limiter := NewLimiter()
+	for range 10 {
+		release, err := limiter.Acquire(context.Background(), "iris_1", nil, nil)
+		g.Expect(err).To(BeNil())
+		defer release()
+	}
+	g.Expect(limiter.models).To(BeEmpty())
+}
+
+func TestLimiterQueue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	limiter := NewLimiter()
+	spec := &scheduler.ConcurrencySpec{MaxInflight: 1, MaxQueueSize: 2}
+	var mu sync.Mutex
+	var depths []uint32
+	observer := func(modelName string, depth uint32) {
+		mu.Lock()
+		defer mu.Unlock()
+		g.Expect(modelName).To(Equal("iris_1"))
+		depths = append(depths, depth)
+	}
+
+	release, err := limiter.Acquire(context.Background(), "iris_1", spec, observer)
+	g.Expect(err).To(BeNil())
+	g.Expect(limiter.InFlight("iris_1")).To(Equal(uint32(1)))
+
+	// other models are not limited by this one
+	releaseOther, err := limiter.Acquire(context.Background(), "other_1", spec, observer)
+	g.Expect(err).To(BeNil())
+	releaseOther()
+
+	var order []int
+	var wg sync.WaitGroup
+	for i := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.Acquire(context.Background(), "iris_1", spec, observer)
+			g.Expect(err).To(BeNil())
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			release()
+		}()
+		// queued in order
+		g.Eventually(func() uint32 { return limiter.QueueDepth("iris_1") }).Should(Equal(uint32(i + 1)))
+	}
+
+	// the queue is full
+	_, err = limiter.Acquire(context.Background(), "iris_1", spec, observer)
+	g.Expect(err).To(Equal(ErrQueueFull))
+
+	release()
+	// releasing twice has no effect
+	release()
+	wg.Wait()
+
+	g.Expect(order).To(Equal([]int{0, 1}))
+	g.Expect(depths).To(Equal([]uint32{1, 2}))
+	g.Expect(limiter.InFlight("iris_1")).To(Equal(uint32(0)))
+	g.Expect(limiter.models).To(BeEmpty())
+}
+
+func TestLimiterQueuedRequestCancelled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	limiter := NewLimiter()
+	spec := &scheduler.ConcurrencySpec{MaxInflight: 1, MaxQueueSize: 1}
+
+	release, err := limiter.Acquire(context.Background(), "iris_1", spec, nil)
+	g.Expect(err).To(BeNil())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(ctx, "iris_1", spec, nil)
+	g.Expect(err).To(Equal(context.DeadlineExceeded))
+	g.Expect(limiter.QueueDepth("iris_1")).To(Equal(uint32(0)))
+
+	release()
+	g.Expect(limiter.models).To(BeEmpty())
+}
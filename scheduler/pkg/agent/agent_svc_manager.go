@@ -179,7 +179,8 @@ func NewAgentServiceManager(
 	modelState := NewModelState()
 
 	stateManager := NewLocalStateManager(
-		modelState, logger, v2Client, replicaConfig.GetMemoryBytes(), replicaConfig.GetOverCommitPercentage(), metrics)
+		modelState, logger, v2Client, replicaConfig.GetMemoryBytes(), replicaConfig.GetOverCommitPercentage(), metrics,
+		replicaConfig.GetCacheEvictionPolicy())
 
 	agentDebugService.SetState(stateManager)
 	reverseProxyHTTP.SetState(stateManager)
@@ -919,6 +920,16 @@ func (am *AgentServiceManager) sendAgentDrainEvent() error {
 	return err
 }
 
+// SendExperimentRequests sends the requests served by the candidates of bandit experiments on this agent to the scheduler
+func (am *AgentServiceManager) SendExperimentRequests(ctx context.Context, requests []*sched_pb.ExperimentRequest) error {
+	if am.schedulerConn == nil {
+		return fmt.Errorf("not connected to scheduler")
+	}
+	grpcClient := agent_pb.NewAgentServiceClient(am.schedulerConn)
+	_, err := grpcClient.ExperimentRequests(ctx, &sched_pb.ExperimentRequestsRequest{Requests: requests})
+	return err
+}
+
 func (am *AgentServiceManager) sendModelScalingTriggerEvent(
 	modelName string,
 	modelVersion uint32,
@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	DefaultMaxWait = 5 * time.Millisecond
+	// the timeout of a batch with requests without a deadline
+	DefaultBatchTimeout = time.Minute
+)
+
+// Codec merges inference requests into a single batched request, concatenating their inputs along the
+// first dimension, and splits the batched response back into one response per request
+type Codec[Req any, Res any] interface {
+	// Signature returns a key shared by all requests that can be merged together and the number of rows
+	// of the request, requests sent with different routing headers have different keys. An error means
+	// the request can not be batched.
+	Signature(ctx context.Context, req Req) (string, int, error)
+	// Merge returns the merged request and the context to send it with, derived from ctx without the
+	// headers of single requests such as their request id
+	Merge(ctx context.Context, reqs []Req) (context.Context, Req, error)
+	Split(res Res, reqs []Req, rows []int) ([]Res, error)
+}
+
+type InferFunc[Req any, Res any] func(ctx context.Context, req Req) (Res, error)
+
+// Batcher coalesces concurrent requests for the same model into batches which are sent to the model
+// once they reach the max batch size of the model, or the max wait of the first request has passed
+type Batcher[Req any, Res any] struct {
+	mu      sync.Mutex
+	codec   Codec[Req, Res]
+	pending map[string]*batch[Req, Res]
+	logger  log.FieldLogger
+}
+
+type result[Res any] struct {
+	res Res
+	err error
+}
+
+type batchItem[Req any, Res any] struct {
+	ctx     context.Context
+	req     Req
+	rows    int
+	resChan chan result[Res]
+}
+
+type batch[Req any, Res any] struct {
+	modelName string
+	infer     InferFunc[Req, Res]
+	items     []*batchItem[Req, Res]
+	rows      int
+	timer     *time.Timer
+}
+
+func NewBatcher[Req any, Res any](codec Codec[Req, Res], logger log.FieldLogger) *Batcher[Req, Res] {
+	return &Batcher[Req, Res]{
+		codec:   codec,
+		pending: make(map[string]*batch[Req, Res]),
+		logger:  logger.WithField("source", "Batcher"),
+	}
+}
+
+// Infer sends the request to the model with infer, batched with other requests to the same model if the
+// model has batching enabled.
+// The batched request is sent with the context of the first request until all requests are done or the
+// latest of their deadlines has passed.
+func (b *Batcher[Req, Res]) Infer(ctx context.Context, modelName string, spec *scheduler.BatchingSpec, req Req, infer InferFunc[Req, Res]) (Res, error) {
+	maxBatchSize := int(spec.GetMaxBatchSize())
+	if maxBatchSize <= 1 {
+		return infer(ctx, req)
+	}
+	signature, rows, err := b.codec.Signature(ctx, req)
+	if err != nil {
+		b.logger.WithError(err).Debugf("Request for model %s can not be batched", modelName)
+		return infer(ctx, req)
+	}
+	if rows >= maxBatchSize {
+		return infer(ctx, req)
+	}
+
+	item := &batchItem[Req, Res]{
+		ctx:     ctx,
+		req:     req,
+		rows:    rows,
+		resChan: make(chan result[Res], 1),
+	}
+	b.add(modelName+"/"+signature, modelName, item, maxBatchSize, getMaxWait(spec), infer)
+
+	select {
+	case r := <-item.resChan:
+		return r.res, r.err
+	case <-ctx.Done():
+		var res Res
+		return res, ctx.Err()
+	}
+}
+
+func getMaxWait(spec *scheduler.BatchingSpec) time.Duration {
+	if spec.GetMaxWaitMs() == 0 {
+		return DefaultMaxWait
+	}
+	return time.Duration(spec.GetMaxWaitMs()) * time.Millisecond
+}
+
+func (b *Batcher[Req, Res]) add(key string, modelName string, item *batchItem[Req, Res], maxBatchSize int, maxWait time.Duration, infer InferFunc[Req, Res]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	current, ok := b.pending[key]
+	if ok && current.rows+item.rows > maxBatchSize {
+		// no room left, send what we have and start a new batch with this request
+		b.removeLocked(key, current)
+		go b.dispatch(current)
+		ok = false
+	}
+	if !ok {
+		current = &batch[Req, Res]{
+			modelName: modelName,
+			infer:     infer,
+		}
+		b.pending[key] = current
+		current.timer = time.AfterFunc(maxWait, func() {
+			b.flush(key, current)
+		})
+	}
+	current.items = append(current.items, item)
+	current.rows += item.rows
+	if current.rows >= maxBatchSize {
+		b.removeLocked(key, current)
+		go b.dispatch(current)
+	}
+}
+
+func (b *Batcher[Req, Res]) removeLocked(key string, current *batch[Req, Res]) {
+	current.timer.Stop()
+	delete(b.pending, key)
+}
+
+// called once the max wait of a batch has passed, unless it was already sent when it was full
+func (b *Batcher[Req, Res]) flush(key string, current *batch[Req, Res]) {
+	b.mu.Lock()
+	if b.pending[key] != current {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.mu.Unlock()
+	b.dispatch(current)
+}
+
+func (b *Batcher[Req, Res]) dispatch(current *batch[Req, Res]) {
+	logger := b.logger.WithField("func", "dispatch")
+	if len(current.items) == 1 {
+		item := current.items[0]
+		res, err := current.infer(item.ctx, item.req)
+		item.resChan <- result[Res]{res: res, err: err}
+		return
+	}
+
+	reqs := make([]Req, len(current.items))
+	rows := make([]int, len(current.items))
+	for i, item := range current.items {
+		reqs[i] = item.req
+		rows[i] = item.rows
+	}
+	ctx, cancel := batchContext(current.items)
+	defer cancel()
+	ctx, merged, err := b.codec.Merge(ctx, reqs)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to merge %d requests for model %s, sending them separately", len(reqs), current.modelName)
+		b.dispatchSeparately(current)
+		return
+	}
+	logger.Debugf("Sending batch of %d requests with %d rows to model %s", len(reqs), current.rows, current.modelName)
+	res, err := current.infer(ctx, merged)
+	if err != nil {
+		b.sendAll(current, result[Res]{err: err})
+		return
+	}
+	responses, err := b.codec.Split(res, reqs, rows)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to split batched response of model %s", current.modelName)
+		b.sendAll(current, result[Res]{err: fmt.Errorf("failed to split batched response of model %s: %w", current.modelName, err)})
+		return
+	}
+	for i, item := range current.items {
+		item.resChan <- result[Res]{res: responses[i]}
+	}
+}
+
+// batchContext is the context of the first request without its cancellation, as the batch is sent on
+// behalf of all requests. It is cancelled once all requests are done, and has the latest of their
+// deadlines or DefaultBatchTimeout if a request has no deadline.
+func batchContext[Req any, Res any](items []*batchItem[Req, Res]) (context.Context, context.CancelFunc) {
+	var deadline time.Time
+	for _, item := range items {
+		itemDeadline, ok := item.ctx.Deadline()
+		if !ok {
+			deadline = time.Now().Add(DefaultBatchTimeout)
+			break
+		}
+		if itemDeadline.After(deadline) {
+			deadline = itemDeadline
+		}
+	}
+	ctx, cancel := context.WithDeadline(context.WithoutCancel(items[0].ctx), deadline)
+
+	var remaining atomic.Int32
+	remaining.Store(int32(len(items)))
+	stops := make([]func() bool, len(items))
+	for i, item := range items {
+		stops[i] = context.AfterFunc(item.ctx, func() {
+			if remaining.Add(-1) == 0 {
+				cancel()
+			}
+		})
+	}
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
+
+func (b *Batcher[Req, Res]) dispatchSeparately(current *batch[Req, Res]) {
+	var wg sync.WaitGroup
+	wg.Add(len(current.items))
+	for _, item := range current.items {
+		go func(item *batchItem[Req, Res]) {
+			defer wg.Done()
+			res, err := current.infer(item.ctx, item.req)
+			item.resChan <- result[Res]{res: res, err: err}
+		}(item)
+	}
+	wg.Wait()
+}
+
+func (b *Batcher[Req, Res]) sendAll(current *batch[Req, Res], r result[Res]) {
+	for _, item := range current.items {
+		item.resChan <- r
+	}
+}
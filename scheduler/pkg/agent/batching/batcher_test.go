@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+// requests are lists of row values, responses double them
+type fakeCodec struct {
+	failMerge bool
+}
+
+func (f fakeCodec) Signature(_ context.Context, req []int) (string, int, error) {
+	if len(req) == 0 {
+		return "", 0, fmt.Errorf("empty request")
+	}
+	return "fake", len(req), nil
+}
+
+func (f fakeCodec) Merge(ctx context.Context, reqs [][]int) (context.Context, []int, error) {
+	if f.failMerge {
+		return nil, nil, fmt.Errorf("merge failed")
+	}
+	var merged []int
+	for _, req := range reqs {
+		merged = append(merged, req...)
+	}
+	return ctx, merged, nil
+}
+
+func (f fakeCodec) Split(res []int, _ [][]int, rows []int) ([][]int, error) {
+	var split [][]int
+	offset := 0
+	for _, r := range rows {
+		split = append(split, res[offset:offset+r])
+		offset += r
+	}
+	return split, nil
+}
+
+type fakeModel struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (f *fakeModel) infer(_ context.Context, req []int) ([]int, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, req)
+	f.mu.Unlock()
+	res := make([]int, len(req))
+	for i, v := range req {
+		res[i] = v * 2
+	}
+	return res, nil
+}
+
+func TestBatcherInfer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		spec            *scheduler.BatchingSpec
+		failMerge       bool
+		requests        [][]int
+		expectedBatches int
+	}
+
+	tests := []test{
+		{
+			name:            "batching disabled",
+			requests:        [][]int{{1}, {2}, {3}},
+			expectedBatches: 3,
+		},
+		{
+			name:            "full batch",
+			spec:            &scheduler.BatchingSpec{MaxBatchSize: 4, MaxWaitMs: 10000},
+			requests:        [][]int{{1}, {2, 3}, {4}},
+			expectedBatches: 1,
+		},
+		{
+			name:            "max wait",
+			spec:            &scheduler.BatchingSpec{MaxBatchSize: 100, MaxWaitMs: 50},
+			requests:        [][]int{{1}, {2}, {3}},
+			expectedBatches: 1,
+		},
+		{
+			name:            "batch overflow",
+			spec:            &scheduler.BatchingSpec{MaxBatchSize: 3, MaxWaitMs: 50},
+			requests:        [][]int{{1, 2}, {3, 4}},
+			expectedBatches: 2,
+		},
+		{
+			name:            "request larger than max batch size",
+			spec:            &scheduler.BatchingSpec{MaxBatchSize: 2, MaxWaitMs: 10000},
+			requests:        [][]int{{1, 2, 3}},
+			expectedBatches: 1,
+		},
+		{
+			name:            "not batchable",
+			spec:            &scheduler.BatchingSpec{MaxBatchSize: 4, MaxWaitMs: 10000},
+			requests:        [][]int{{}, {}},
+			expectedBatches: 2,
+		},
+		{
+			name:            "merge fails",
+			spec:            &scheduler.BatchingSpec{MaxBatchSize: 2, MaxWaitMs: 10000},
+			failMerge:       true,
+			requests:        [][]int{{1}, {2}},
+			expectedBatches: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			model := &fakeModel{}
+			batcher := NewBatcher[[]int, []int](fakeCodec{failMerge: test.failMerge}, log.New())
+			var wg sync.WaitGroup
+			wg.Add(len(test.requests))
+			for _, req := range test.requests {
+				go func(req []int) {
+					defer wg.Done()
+					res, err := batcher.Infer(context.Background(), "iris", test.spec, req, model.infer)
+					g.Expect(err).To(BeNil())
+					g.Expect(res).To(HaveLen(len(req)))
+					for i, v := range req {
+						g.Expect(res[i]).To(Equal(v * 2))
+					}
+				}(req)
+			}
+			wg.Wait()
+			g.Expect(model.batches).To(HaveLen(test.expectedBatches))
+			g.Expect(batcher.pending).To(BeEmpty())
+		})
+	}
+}
+
+func TestBatcherCallerCancelled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	model := &fakeModel{}
+	batcher := NewBatcher[[]int, []int](fakeCodec{}, log.New())
+	spec := &scheduler.BatchingSpec{MaxBatchSize: 10, MaxWaitMs: 100}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := batcher.Infer(ctx, "iris", spec, []int{1}, model.infer)
+	g.Expect(err).To(Equal(context.DeadlineExceeded))
+
+	// the batch is still sent
+	g.Eventually(func() int {
+		model.mu.Lock()
+		defer model.mu.Unlock()
+		return len(model.batches)
+	}).Should(Equal(1))
+}
+
+// hangingModel never responds, until the context of the request is done
+type hangingModel struct {
+	deadlines chan time.Time
+	errs      chan error
+}
+
+func (m *hangingModel) infer(ctx context.Context, _ []int) ([]int, error) {
+	deadline, _ := ctx.Deadline()
+	m.deadlines <- deadline
+	<-ctx.Done()
+	m.errs <- ctx.Err()
+	return nil, ctx.Err()
+}
+
+func TestBatcherBatchContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		timeouts         []time.Duration
+		cancel           bool
+		expectedDeadline time.Duration
+		expectedErr      error
+	}
+
+	tests := []test{
+		{
+			name:             "latest deadline of the requests",
+			timeouts:         []time.Duration{50 * time.Millisecond, 100 * time.Millisecond},
+			expectedDeadline: 100 * time.Millisecond,
+		},
+		{
+			name:             "default timeout for requests without a deadline",
+			timeouts:         []time.Duration{50 * time.Millisecond, 0},
+			cancel:           true,
+			expectedDeadline: DefaultBatchTimeout,
+			expectedErr:      context.Canceled,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			model := &hangingModel{deadlines: make(chan time.Time, 1), errs: make(chan error, 1)}
+			batcher := NewBatcher[[]int, []int](fakeCodec{}, log.New())
+			spec := &scheduler.BatchingSpec{MaxBatchSize: uint32(len(test.timeouts)), MaxWaitMs: 10000}
+
+			start := time.Now()
+			var wg sync.WaitGroup
+			for i, timeout := range test.timeouts {
+				ctx, cancel := context.WithCancel(context.Background())
+				if timeout > 0 {
+					ctx, cancel = context.WithTimeout(context.Background(), timeout)
+				}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer cancel()
+					if timeout == 0 && test.cancel {
+						// the batch is only cancelled once all its requests are done
+						time.AfterFunc(100*time.Millisecond, cancel)
+					}
+					_, err := batcher.Infer(ctx, "iris", spec, []int{i}, model.infer)
+					g.Expect(err).ToNot(BeNil())
+				}()
+			}
+
+			var deadline time.Time
+			g.Eventually(model.deadlines).Should(Receive(&deadline))
+			g.Expect(deadline.Sub(start)).To(BeNumerically("~", test.expectedDeadline, 20*time.Millisecond))
+			var err error
+			g.Eventually(model.errs).Should(Receive(&err))
+			// all requests are done at the latest deadline as well
+			if test.expectedErr != nil {
+				g.Expect(err).To(Equal(test.expectedErr))
+			}
+			g.Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+const (
+	bytesDatatype = "BYTES"
+	// raw BYTES elements are prefixed with their length as a 4 byte little endian integer
+	bytesLengthPrefix = 4
+)
+
+// GRPCCodec batches OIP gRPC inference requests
+type GRPCCodec struct{}
+
+func (c GRPCCodec) Signature(ctx context.Context, req *v2.ModelInferRequest) (string, int, error) {
+	if len(req.Inputs) == 0 {
+		return "", 0, fmt.Errorf("request has no inputs")
+	}
+	raw := len(req.RawInputContents) > 0
+	if raw && len(req.RawInputContents) != len(req.Inputs) {
+		return "", 0, fmt.Errorf("request has %d raw input contents for %d inputs", len(req.RawInputContents), len(req.Inputs))
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	getMetadata := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "raw=%t;%s;%s;", raw, headersSignature(getMetadata), parametersSignature(req.Parameters))
+	rows := -1
+	for _, input := range req.Inputs {
+		if len(input.Shape) == 0 {
+			return "", 0, fmt.Errorf("input %s has no batch dimension", input.Name)
+		}
+		if rows >= 0 && input.Shape[0] != int64(rows) {
+			return "", 0, fmt.Errorf("inputs have different batch sizes")
+		}
+		rows = int(input.Shape[0])
+		fmt.Fprintf(&sb, "in=%s:%s:%v:%s;", input.Name, input.Datatype, input.Shape[1:], parametersSignature(input.Parameters))
+	}
+	if rows <= 0 {
+		return "", 0, fmt.Errorf("request has no rows")
+	}
+	for _, output := range req.Outputs {
+		fmt.Fprintf(&sb, "out=%s:%s;", output.Name, parametersSignature(output.Parameters))
+	}
+	return sb.String(), rows, nil
+}
+
+func parametersSignature(parameters map[string]*v2.InferParameter) string {
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&sb, "%s=%v,", key, parameters[key].GetParameterChoice())
+	}
+	return sb.String()
+}
+
+// Merge concatenates the inputs of requests with the same signature
+func (c GRPCCodec) Merge(ctx context.Context, reqs []*v2.ModelInferRequest) (context.Context, *v2.ModelInferRequest, error) {
+	first := reqs[0]
+	merged := &v2.ModelInferRequest{
+		ModelName:    first.ModelName,
+		ModelVersion: first.ModelVersion,
+		Parameters:   first.Parameters,
+		Outputs:      first.Outputs,
+	}
+	raw := len(first.RawInputContents) > 0
+	for i, input := range first.Inputs {
+		var rows int64
+		contents := &v2.InferTensorContents{}
+		var rawContents []byte
+		for _, req := range reqs {
+			if len(req.Inputs) != len(first.Inputs) || req.Inputs[i].Name != input.Name {
+				return nil, nil, fmt.Errorf("requests have different inputs")
+			}
+			rows += req.Inputs[i].Shape[0]
+			if raw {
+				rawContents = append(rawContents, req.RawInputContents[i]...)
+			} else {
+				appendContents(contents, req.Inputs[i].Contents)
+			}
+		}
+		shape := append([]int64{rows}, input.Shape[1:]...)
+		mergedInput := &v2.ModelInferRequest_InferInputTensor{
+			Name:       input.Name,
+			Datatype:   input.Datatype,
+			Shape:      shape,
+			Parameters: input.Parameters,
+		}
+		if raw {
+			merged.RawInputContents = append(merged.RawInputContents, rawContents)
+		} else {
+			mergedInput.Contents = contents
+		}
+		merged.Inputs = append(merged.Inputs, mergedInput)
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		md = md.Copy()
+		for _, key := range perRequestHeaders {
+			md.Delete(key)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	return ctx, merged, nil
+}
+
+func appendContents(dst *v2.InferTensorContents, src *v2.InferTensorContents) {
+	if src == nil {
+		return
+	}
+	dst.BoolContents = append(dst.BoolContents, src.BoolContents...)
+	dst.IntContents = append(dst.IntContents, src.IntContents...)
+	dst.Int64Contents = append(dst.Int64Contents, src.Int64Contents...)
+	dst.UintContents = append(dst.UintContents, src.UintContents...)
+	dst.Uint64Contents = append(dst.Uint64Contents, src.Uint64Contents...)
+	dst.Fp32Contents = append(dst.Fp32Contents, src.Fp32Contents...)
+	dst.Fp64Contents = append(dst.Fp64Contents, src.Fp64Contents...)
+	dst.BytesContents = append(dst.BytesContents, src.BytesContents...)
+}
+
+// Split slices the outputs of the batched response along the first dimension
+func (c GRPCCodec) Split(res *v2.ModelInferResponse, reqs []*v2.ModelInferRequest, rows []int) ([]*v2.ModelInferResponse, error) {
+	totalRows := 0
+	for _, r := range rows {
+		totalRows += r
+	}
+	raw := len(res.RawOutputContents) > 0
+	if raw && len(res.RawOutputContents) != len(res.Outputs) {
+		return nil, fmt.Errorf("response has %d raw output contents for %d outputs", len(res.RawOutputContents), len(res.Outputs))
+	}
+
+	responses := make([]*v2.ModelInferResponse, len(reqs))
+	for i, req := range reqs {
+		responses[i] = &v2.ModelInferResponse{
+			ModelName:    res.ModelName,
+			ModelVersion: res.ModelVersion,
+			Id:           req.Id,
+			Parameters:   res.Parameters,
+		}
+	}
+	for j, output := range res.Outputs {
+		if len(output.Shape) == 0 || output.Shape[0] != int64(totalRows) {
+			return nil, fmt.Errorf("output %s has shape %v, expected a batch of %d rows", output.Name, output.Shape, totalRows)
+		}
+		elementsPerRow := 1
+		for _, dim := range output.Shape[1:] {
+			elementsPerRow *= int(dim)
+		}
+		var rawRows [][]byte
+		if raw {
+			var err error
+			rawRows, err = splitRawContents(res.RawOutputContents[j], output.Datatype, totalRows, elementsPerRow)
+			if err != nil {
+				return nil, fmt.Errorf("failed to split output %s: %w", output.Name, err)
+			}
+		}
+		offset := 0
+		for i, r := range rows {
+			splitOutput := &v2.ModelInferResponse_InferOutputTensor{
+				Name:       output.Name,
+				Datatype:   output.Datatype,
+				Shape:      append([]int64{int64(r)}, output.Shape[1:]...),
+				Parameters: output.Parameters,
+			}
+			if raw {
+				var b []byte
+				for _, rawRow := range rawRows[offset : offset+r] {
+					b = append(b, rawRow...)
+				}
+				responses[i].RawOutputContents = append(responses[i].RawOutputContents, b)
+			} else if output.Contents != nil {
+				contents, err := sliceContents(output.Contents, offset*elementsPerRow, (offset+r)*elementsPerRow)
+				if err != nil {
+					return nil, fmt.Errorf("failed to split output %s: %w", output.Name, err)
+				}
+				splitOutput.Contents = contents
+			}
+			responses[i].Outputs = append(responses[i].Outputs, splitOutput)
+			offset += r
+		}
+	}
+	return responses, nil
+}
+
+func sliceContents(contents *v2.InferTensorContents, start int, end int) (*v2.InferTensorContents, error) {
+	sliced := &v2.InferTensorContents{}
+	var err error
+	if sliced.BoolContents, err = sliceElements(contents.BoolContents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.IntContents, err = sliceElements(contents.IntContents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.Int64Contents, err = sliceElements(contents.Int64Contents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.UintContents, err = sliceElements(contents.UintContents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.Uint64Contents, err = sliceElements(contents.Uint64Contents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.Fp32Contents, err = sliceElements(contents.Fp32Contents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.Fp64Contents, err = sliceElements(contents.Fp64Contents, start, end); err != nil {
+		return nil, err
+	}
+	if sliced.BytesContents, err = sliceElements(contents.BytesContents, start, end); err != nil {
+		return nil, err
+	}
+	return sliced, nil
+}
+
+// only the field of the tensor datatype is set, the others are empty and left as such
+func sliceElements[T any](elements []T, start int, end int) ([]T, error) {
+	if len(elements) == 0 {
+		return nil, nil
+	}
+	if end > len(elements) {
+		return nil, fmt.Errorf("expected at least %d elements, found %d", end, len(elements))
+	}
+	return elements[start:end], nil
+}
+
+// splitRawContents splits raw tensor contents into rows
+func splitRawContents(raw []byte, datatype string, rows int, elementsPerRow int) ([][]byte, error) {
+	split := make([][]byte, rows)
+	if datatype != bytesDatatype {
+		if len(raw)%rows != 0 {
+			return nil, fmt.Errorf("%d bytes can not be split in %d rows", len(raw), rows)
+		}
+		rowSize := len(raw) / rows
+		for i := range rows {
+			split[i] = raw[i*rowSize : (i+1)*rowSize]
+		}
+		return split, nil
+	}
+
+	offset := 0
+	for i := range rows {
+		start := offset
+		for range elementsPerRow {
+			if offset+bytesLengthPrefix > len(raw) {
+				return nil, fmt.Errorf("invalid raw %s contents", bytesDatatype)
+			}
+			length := int(binary.LittleEndian.Uint32(raw[offset : offset+bytesLengthPrefix]))
+			offset += bytesLengthPrefix + length
+			if offset > len(raw) {
+				return nil, fmt.Errorf("invalid raw %s contents", bytesDatatype)
+			}
+		}
+		split[i] = raw[start:offset]
+	}
+	return split, nil
+}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func createGRPCRequest(id string, rows int64, values []float32) *v2.ModelInferRequest {
+	return &v2.ModelInferRequest{
+		ModelName: "iris_1",
+		Id:        id,
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{
+				Name:     "predict",
+				Datatype: "FP32",
+				Shape:    []int64{rows, 2},
+				Contents: &v2.InferTensorContents{Fp32Contents: values},
+			},
+		},
+	}
+}
+
+func TestGRPCSignature(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		req           *v2.ModelInferRequest
+		sameAs        *v2.ModelInferRequest
+		differentFrom *v2.ModelInferRequest
+		expectedRows  int
+		err           bool
+	}
+
+	tests := []test{
+		{
+			name:         "rows",
+			req:          createGRPCRequest("a", 2, []float32{1, 2, 3, 4}),
+			sameAs:       createGRPCRequest("b", 1, []float32{5, 6}),
+			expectedRows: 2,
+		},
+		{
+			name: "different shape",
+			req:  createGRPCRequest("a", 1, []float32{1, 2}),
+			differentFrom: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{
+					{Name: "predict", Datatype: "FP32", Shape: []int64{1, 3}},
+				},
+			},
+			expectedRows: 1,
+		},
+		{
+			name: "different parameters",
+			req:  createGRPCRequest("a", 1, []float32{1, 2}),
+			differentFrom: &v2.ModelInferRequest{
+				Parameters: map[string]*v2.InferParameter{
+					"content_type": {ParameterChoice: &v2.InferParameter_StringParam{StringParam: "np"}},
+				},
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{
+					{Name: "predict", Datatype: "FP32", Shape: []int64{1, 2}},
+				},
+			},
+			expectedRows: 1,
+		},
+		{
+			name: "scalar input",
+			req: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{
+					{Name: "predict", Datatype: "FP32"},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "inconsistent rows",
+			req: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{
+					{Name: "a", Datatype: "FP32", Shape: []int64{1}},
+					{Name: "b", Datatype: "FP32", Shape: []int64{2}},
+				},
+			},
+			err: true,
+		},
+	}
+
+	codec := GRPCCodec{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signature, rows, err := codec.Signature(t.Context(), test.req)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(rows).To(Equal(test.expectedRows))
+			if test.sameAs != nil {
+				other, _, err := codec.Signature(t.Context(), test.sameAs)
+				g.Expect(err).To(BeNil())
+				g.Expect(other).To(Equal(signature))
+			}
+			if test.differentFrom != nil {
+				other, _, err := codec.Signature(t.Context(), test.differentFrom)
+				g.Expect(err).To(BeNil())
+				g.Expect(other).ToNot(Equal(signature))
+			}
+		})
+	}
+}
+
+func TestGRPCMergeAndSplit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := GRPCCodec{}
+	reqs := []*v2.ModelInferRequest{
+		createGRPCRequest("a", 2, []float32{1, 2, 3, 4}),
+		createGRPCRequest("b", 1, []float32{5, 6}),
+	}
+	_, merged, err := codec.Merge(t.Context(), reqs)
+	g.Expect(err).To(BeNil())
+	g.Expect(merged.Inputs).To(HaveLen(1))
+	g.Expect(merged.Inputs[0].Shape).To(Equal([]int64{3, 2}))
+	g.Expect(merged.Inputs[0].Contents.Fp32Contents).To(Equal([]float32{1, 2, 3, 4, 5, 6}))
+	// the original requests are left untouched
+	g.Expect(reqs[0].Inputs[0].Shape).To(Equal([]int64{2, 2}))
+
+	res := &v2.ModelInferResponse{
+		ModelName: "iris_1",
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{Name: "predict", Datatype: "INT64", Shape: []int64{3, 1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{7, 8, 9}}},
+		},
+	}
+	split, err := codec.Split(res, reqs, []int{2, 1})
+	g.Expect(err).To(BeNil())
+	g.Expect(split).To(HaveLen(2))
+	g.Expect(split[0].Id).To(Equal("a"))
+	g.Expect(split[0].Outputs[0].Shape).To(Equal([]int64{2, 1}))
+	g.Expect(split[0].Outputs[0].Contents.Int64Contents).To(Equal([]int64{7, 8}))
+	g.Expect(split[1].Id).To(Equal("b"))
+	g.Expect(split[1].Outputs[0].Shape).To(Equal([]int64{1, 1}))
+	g.Expect(split[1].Outputs[0].Contents.Int64Contents).To(Equal([]int64{9}))
+
+	// outputs which are not batched can not be split
+	res.Outputs[0].Shape = []int64{1, 3}
+	_, err = codec.Split(res, reqs, []int{2, 1})
+	g.Expect(err).ToNot(BeNil())
+}
+
+func encodeRawBytes(elements ...string) []byte {
+	var raw []byte
+	for _, element := range elements {
+		raw = binary.LittleEndian.AppendUint32(raw, uint32(len(element)))
+		raw = append(raw, element...)
+	}
+	return raw
+}
+
+func TestGRPCMergeAndSplitRaw(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := GRPCCodec{}
+	createRawRequest := func(rows int64, raw []byte) *v2.ModelInferRequest {
+		return &v2.ModelInferRequest{
+			Inputs: []*v2.ModelInferRequest_InferInputTensor{
+				{Name: "text", Datatype: "BYTES", Shape: []int64{rows}},
+			},
+			RawInputContents: [][]byte{raw},
+		}
+	}
+	reqs := []*v2.ModelInferRequest{
+		createRawRequest(1, encodeRawBytes("hello")),
+		createRawRequest(2, encodeRawBytes("a", "bc")),
+	}
+	_, merged, err := codec.Merge(t.Context(), reqs)
+	g.Expect(err).To(BeNil())
+	g.Expect(merged.Inputs[0].Shape).To(Equal([]int64{3}))
+	g.Expect(merged.RawInputContents).To(Equal([][]byte{encodeRawBytes("hello", "a", "bc")}))
+
+	res := &v2.ModelInferResponse{
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{Name: "label", Datatype: "BYTES", Shape: []int64{3}},
+			{Name: "score", Datatype: "INT32", Shape: []int64{3}},
+		},
+		RawOutputContents: [][]byte{
+			encodeRawBytes("x", "yy", "zzz"),
+			{1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0},
+		},
+	}
+	split, err := codec.Split(res, reqs, []int{1, 2})
+	g.Expect(err).To(BeNil())
+	g.Expect(split[0].RawOutputContents).To(Equal([][]byte{encodeRawBytes("x"), {1, 0, 0, 0}}))
+	g.Expect(split[1].RawOutputContents).To(Equal([][]byte{encodeRawBytes("yy", "zzz"), {2, 0, 0, 0, 3, 0, 0, 0}}))
+}
+
+func TestGRPCMetadata(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := GRPCCodec{}
+	req := createGRPCRequest("a", 1, []float32{1, 2})
+	ctx := metadata.NewOutgoingContext(t.Context(), metadata.Pairs(
+		"authorization", "Bearer a",
+		util.SeldonModelHeader, "iris",
+		util.RequestIdHeader, "a",
+		util.SeldonShadowHeader, "true",
+	))
+	signature, _, err := codec.Signature(ctx, req)
+	g.Expect(err).To(BeNil())
+
+	// metadata of single requests does not stop requests from being merged
+	other, _, err := codec.Signature(metadata.NewOutgoingContext(t.Context(), metadata.Pairs(
+		"authorization", "Bearer a",
+		util.SeldonModelHeader, "iris",
+		util.RequestIdHeader, "b",
+	)), req)
+	g.Expect(err).To(BeNil())
+	g.Expect(other).To(Equal(signature))
+
+	// but requests authorized differently are not merged
+	other, _, err = codec.Signature(metadata.NewOutgoingContext(t.Context(), metadata.Pairs(
+		"authorization", "Bearer b",
+		util.SeldonModelHeader, "iris",
+	)), req)
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(Equal(signature))
+
+	mergedCtx, _, err := codec.Merge(ctx, []*v2.ModelInferRequest{req, createGRPCRequest("b", 1, []float32{3, 4})})
+	g.Expect(err).To(BeNil())
+	md, _ := metadata.FromOutgoingContext(mergedCtx)
+	g.Expect(md.Get("authorization")).To(Equal([]string{"Bearer a"}))
+	g.Expect(md.Get(util.RequestIdHeader)).To(BeEmpty())
+	g.Expect(md.Get(util.SeldonShadowHeader)).To(BeEmpty())
+	// the metadata of the first request is left untouched
+	md, _ = metadata.FromOutgoingContext(ctx)
+	g.Expect(md.Get(util.RequestIdHeader)).To(Equal([]string{"a"}))
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// headers (or gRPC metadata) of a single request, which are not sent with a merged request on behalf of
+// the other requests. The proxies read the experiment headers of each request from the request itself.
+var perRequestHeaders = []string{
+	util.RequestIdHeader,
+	"traceparent",
+	"tracestate",
+	"baggage",
+	util.SeldonRouteHeader,
+	util.SeldonCaptureHeader,
+	util.SeldonShadowHeader,
+	util.SeldonBanditHeader,
+}
+
+// headers (or gRPC metadata) which can change how a request is routed or handled by the model server,
+// so only requests with the same values are merged
+var routingHeaders = []string{
+	"authorization",
+	"content-type",
+	util.SeldonModelHeader,
+	util.SeldonInternalModelHeader,
+}
+
+func headersSignature(get func(key string) string) string {
+	var sb strings.Builder
+	for _, key := range routingHeaders {
+		fmt.Fprintf(&sb, "%s=%q,", key, get(key))
+	}
+	return sb.String()
+}
@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// RESTRequest is an OIP REST inference request whose body has already been read
+type RESTRequest struct {
+	Request *http.Request
+	Body    []byte
+}
+
+// HTTPRequest creates the request to send to the model server
+func (r *RESTRequest) HTTPRequest(ctx context.Context) *http.Request {
+	req := r.Request.Clone(ctx)
+	req.Body = io.NopCloser(bytes.NewReader(r.Body))
+	req.ContentLength = int64(len(r.Body))
+	return req
+}
+
+type restInferenceRequest struct {
+	Id         string            `json:"id,omitempty"`
+	Parameters json.RawMessage   `json:"parameters,omitempty"`
+	Inputs     []*restTensor     `json:"inputs"`
+	Outputs    []json.RawMessage `json:"outputs,omitempty"`
+}
+
+type restInferenceResponse struct {
+	ModelName    string          `json:"model_name"`
+	ModelVersion string          `json:"model_version,omitempty"`
+	Id           string          `json:"id,omitempty"`
+	Parameters   json.RawMessage `json:"parameters,omitempty"`
+	Outputs      []*restTensor   `json:"outputs"`
+}
+
+type restTensor struct {
+	Name       string          `json:"name"`
+	Shape      []int64         `json:"shape"`
+	Datatype   string          `json:"datatype"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// RESTCodec batches OIP REST inference requests
+type RESTCodec struct{}
+
+func parseRESTRequest(body []byte) (*restInferenceRequest, int, error) {
+	req := &restInferenceRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, 0, err
+	}
+	if len(req.Inputs) == 0 {
+		return nil, 0, fmt.Errorf("request has no inputs")
+	}
+	rows := -1
+	for _, input := range req.Inputs {
+		if len(input.Shape) == 0 {
+			return nil, 0, fmt.Errorf("input %s has no batch dimension", input.Name)
+		}
+		if rows >= 0 && input.Shape[0] != int64(rows) {
+			return nil, 0, fmt.Errorf("inputs have different batch sizes")
+		}
+		rows = int(input.Shape[0])
+	}
+	if rows <= 0 {
+		return nil, 0, fmt.Errorf("request has no rows")
+	}
+	return req, rows, nil
+}
+
+func (c RESTCodec) Signature(_ context.Context, req *RESTRequest) (string, int, error) {
+	parsed, rows, err := parseRESTRequest(req.Body)
+	if err != nil {
+		return "", 0, err
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s;%s;%s;", req.Request.URL.Path, headersSignature(req.Request.Header.Get), compactJSON(parsed.Parameters))
+	for _, input := range parsed.Inputs {
+		fmt.Fprintf(&sb, "in=%s:%s:%v:%s;", input.Name, input.Datatype, input.Shape[1:], compactJSON(input.Parameters))
+	}
+	for _, output := range parsed.Outputs {
+		fmt.Fprintf(&sb, "out=%s;", compactJSON(output))
+	}
+	return sb.String(), rows, nil
+}
+
+func compactJSON(raw json.RawMessage) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, raw); err != nil {
+		return string(raw)
+	}
+	return b.String()
+}
+
+// Merge concatenates the (flattened) data of the inputs of requests with the same signature
+func (c RESTCodec) Merge(ctx context.Context, reqs []*RESTRequest) (context.Context, *RESTRequest, error) {
+	parsed := make([]*restInferenceRequest, len(reqs))
+	for i, req := range reqs {
+		var err error
+		if parsed[i], _, err = parseRESTRequest(req.Body); err != nil {
+			return nil, nil, err
+		}
+	}
+	first := parsed[0]
+	merged := &restInferenceRequest{
+		Parameters: first.Parameters,
+		Outputs:    first.Outputs,
+	}
+	for i, input := range first.Inputs {
+		var rows int64
+		var data []json.RawMessage
+		for _, req := range parsed {
+			if len(req.Inputs) != len(first.Inputs) || req.Inputs[i].Name != input.Name {
+				return nil, nil, fmt.Errorf("requests have different inputs")
+			}
+			var err error
+			if data, err = flattenData(req.Inputs[i].Data, data); err != nil {
+				return nil, nil, fmt.Errorf("invalid data for input %s: %w", input.Name, err)
+			}
+			rows += req.Inputs[i].Shape[0]
+		}
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		merged.Inputs = append(merged.Inputs, &restTensor{
+			Name:       input.Name,
+			Shape:      append([]int64{rows}, input.Shape[1:]...),
+			Datatype:   input.Datatype,
+			Parameters: input.Parameters,
+			Data:       b,
+		})
+	}
+	body, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the response has to be parsed to be split, so leave it to the transport to ask for
+	// compression and decompress the response transparently
+	req := reqs[0].Request.Clone(ctx)
+	req.Header.Del("Accept-Encoding")
+	for _, header := range perRequestHeaders {
+		req.Header.Del(header)
+	}
+	return ctx, &RESTRequest{
+		Request: req,
+		Body:    body,
+	}, nil
+}
+
+// flattenData appends the elements of tensor data, which can be nested, to out in row major order
+func flattenData(data json.RawMessage, out []json.RawMessage) ([]json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return append(out, trimmed), nil
+	}
+	var elements []json.RawMessage
+	if err := json.Unmarshal(trimmed, &elements); err != nil {
+		return nil, err
+	}
+	for _, element := range elements {
+		var err error
+		if out, err = flattenData(element, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Split slices the outputs of a successful batched response along the first dimension, other
+// responses are returned as they are to all requests
+func (c RESTCodec) Split(res *http.Response, reqs []*RESTRequest, rows []int) ([]*http.Response, error) {
+	decompressed, _, err := translator.DecompressIfNeeded(res)
+	if err != nil {
+		_ = res.Body.Close()
+		return nil, err
+	}
+	body, err := io.ReadAll(decompressed.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	header := decompressed.Header
+	if res.StatusCode != http.StatusOK {
+		responses := make([]*http.Response, len(reqs))
+		for i, req := range reqs {
+			responses[i] = copyResponse(res, header, req.Request, body)
+		}
+		return responses, nil
+	}
+
+	batched := &restInferenceResponse{}
+	if err := json.Unmarshal(body, batched); err != nil {
+		return nil, err
+	}
+	totalRows := 0
+	for _, r := range rows {
+		totalRows += r
+	}
+	split := make([]*restInferenceResponse, len(reqs))
+	for i, req := range reqs {
+		// the request ids are only needed for the responses, so are parsed again here
+		parsed, _, err := parseRESTRequest(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		split[i] = &restInferenceResponse{
+			ModelName:    batched.ModelName,
+			ModelVersion: batched.ModelVersion,
+			Id:           parsed.Id,
+			Parameters:   batched.Parameters,
+		}
+	}
+	for _, output := range batched.Outputs {
+		if len(output.Shape) == 0 || output.Shape[0] != int64(totalRows) {
+			return nil, fmt.Errorf("output %s has shape %v, expected a batch of %d rows", output.Name, output.Shape, totalRows)
+		}
+		elementsPerRow := 1
+		for _, dim := range output.Shape[1:] {
+			elementsPerRow *= int(dim)
+		}
+		data, err := flattenData(output.Data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data for output %s: %w", output.Name, err)
+		}
+		if len(data) != totalRows*elementsPerRow {
+			return nil, fmt.Errorf("output %s has %d elements, expected %d", output.Name, len(data), totalRows*elementsPerRow)
+		}
+		offset := 0
+		for i, r := range rows {
+			b, err := json.Marshal(data[offset*elementsPerRow : (offset+r)*elementsPerRow])
+			if err != nil {
+				return nil, err
+			}
+			split[i].Outputs = append(split[i].Outputs, &restTensor{
+				Name:       output.Name,
+				Shape:      append([]int64{int64(r)}, output.Shape[1:]...),
+				Datatype:   output.Datatype,
+				Parameters: output.Parameters,
+				Data:       b,
+			})
+			offset += r
+		}
+	}
+
+	responses := make([]*http.Response, len(reqs))
+	for i, req := range reqs {
+		b, err := json.Marshal(split[i])
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = copyResponse(res, header, req.Request, b)
+	}
+	return responses, nil
+}
+
+func copyResponse(res *http.Response, resHeader http.Header, req *http.Request, body []byte) *http.Response {
+	header := resHeader.Clone()
+	// the request id of the batched request, set again from each request by the proxy
+	header.Del(util.RequestIdHeaderCanonical)
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	return &http.Response{
+		Status:        res.Status,
+		StatusCode:    res.StatusCode,
+		Proto:         res.Proto,
+		ProtoMajor:    res.ProtoMajor,
+		ProtoMinor:    res.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batching
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func createRESTRequest(t *testing.T, body string) *RESTRequest {
+	req, err := http.NewRequest(http.MethodPost, "http://localhost/v2/models/iris/infer", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &RESTRequest{Request: req, Body: []byte(body)}
+}
+
+func TestRESTSignature(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := RESTCodec{}
+	signature, rows, err := codec.Signature(t.Context(), createRESTRequest(t, `{"id":"a","inputs":[{"name":"predict","shape":[2,2],"datatype":"FP32","data":[[1,2],[3,4]]}]}`))
+	g.Expect(err).To(BeNil())
+	g.Expect(rows).To(Equal(2))
+
+	other, _, err := codec.Signature(t.Context(), createRESTRequest(t, `{"id":"b","inputs":[{"name":"predict","shape":[1,2],"datatype":"FP32","data":[5,6]}]}`))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).To(Equal(signature))
+
+	other, _, err = codec.Signature(t.Context(), createRESTRequest(t, `{"inputs":[{"name":"predict","shape":[1,2],"datatype":"FP64","data":[5,6]}]}`))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(Equal(signature))
+
+	_, _, err = codec.Signature(t.Context(), createRESTRequest(t, `not json`))
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestRESTMergeAndSplit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := RESTCodec{}
+	reqs := []*RESTRequest{
+		createRESTRequest(t, `{"id":"a","inputs":[{"name":"predict","shape":[2,2],"datatype":"FP32","data":[[1,2],[3,4.5]]}]}`),
+		createRESTRequest(t, `{"id":"b","inputs":[{"name":"predict","shape":[1,2],"datatype":"FP32","data":[5,6]}]}`),
+	}
+	_, merged, err := codec.Merge(t.Context(), reqs)
+	g.Expect(err).To(BeNil())
+	g.Expect(string(merged.Body)).To(Equal(`{"inputs":[{"name":"predict","shape":[3,2],"datatype":"FP32","data":[1,2,3,4.5,5,6]}]}`))
+
+	body, err := io.ReadAll(merged.HTTPRequest(t.Context()).Body)
+	g.Expect(err).To(BeNil())
+	g.Expect(body).To(Equal(merged.Body))
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{util.RequestIdHeaderCanonical: []string{"batch"}},
+		Body:       io.NopCloser(bytes.NewBufferString(`{"model_name":"iris_1","outputs":[{"name":"predict","shape":[3],"datatype":"INT64","data":[0,1,2]}]}`)),
+	}
+	split, err := codec.Split(res, reqs, []int{2, 1})
+	g.Expect(err).To(BeNil())
+	g.Expect(split).To(HaveLen(2))
+	expected := []string{
+		`{"model_name":"iris_1","id":"a","outputs":[{"name":"predict","shape":[2],"datatype":"INT64","data":[0,1]}]}`,
+		`{"model_name":"iris_1","id":"b","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[2]}]}`,
+	}
+	for i, r := range split {
+		g.Expect(r.StatusCode).To(Equal(http.StatusOK))
+		g.Expect(r.Header.Get(util.RequestIdHeaderCanonical)).To(BeEmpty())
+		b, err := io.ReadAll(r.Body)
+		g.Expect(err).To(BeNil())
+		g.Expect(string(b)).To(Equal(expected[i]))
+		g.Expect(r.ContentLength).To(Equal(int64(len(b))))
+	}
+}
+
+func TestRESTSplitError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := RESTCodec{}
+	reqs := []*RESTRequest{
+		createRESTRequest(t, `{"inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[1]}]}`),
+		createRESTRequest(t, `{"inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[2]}]}`),
+	}
+	res := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewBufferString(`{"error":"bad request"}`)),
+	}
+	split, err := codec.Split(res, reqs, []int{1, 1})
+	g.Expect(err).To(BeNil())
+	for _, r := range split {
+		g.Expect(r.StatusCode).To(Equal(http.StatusBadRequest))
+		b, err := io.ReadAll(r.Body)
+		g.Expect(err).To(BeNil())
+		g.Expect(string(b)).To(Equal(`{"error":"bad request"}`))
+	}
+}
+
+func gzipBody(g *WithT, body string) []byte {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write([]byte(body))
+	g.Expect(err).To(BeNil())
+	g.Expect(w.Close()).To(BeNil())
+	return b.Bytes()
+}
+
+func TestRESTSplitGzip(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := RESTCodec{}
+	reqs := []*RESTRequest{
+		createRESTRequest(t, `{"id":"a","inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[1]}]}`),
+		createRESTRequest(t, `{"id":"b","inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[2]}]}`),
+	}
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:       io.NopCloser(bytes.NewReader(gzipBody(g, `{"model_name":"iris_1","outputs":[{"name":"predict","shape":[2],"datatype":"INT64","data":[0,1]}]}`))),
+	}
+	split, err := codec.Split(res, reqs, []int{1, 1})
+	g.Expect(err).To(BeNil())
+	expected := []string{
+		`{"model_name":"iris_1","id":"a","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[0]}]}`,
+		`{"model_name":"iris_1","id":"b","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[1]}]}`,
+	}
+	for i, r := range split {
+		g.Expect(r.Header.Get("Content-Encoding")).To(BeEmpty())
+		b, err := io.ReadAll(r.Body)
+		g.Expect(err).To(BeNil())
+		g.Expect(string(b)).To(Equal(expected[i]))
+	}
+}
+
+func TestRESTBatchFromGzipServer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// like MLServer, compresses responses for clients which accept gzip
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := `{"model_name":"iris_1","outputs":[{"name":"predict","shape":[2],"datatype":"INT64","data":[0,1]}]}`
+		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.Header().Set("Content-Encoding", "gzip")
+			_, _ = w.Write(gzipBody(g, response))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	codec := RESTCodec{}
+	var reqs []*RESTRequest
+	for _, body := range []string{
+		`{"id":"a","inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[1]}]}`,
+		`{"id":"b","inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[2]}]}`,
+	} {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/models/iris/infer", bytes.NewBufferString(body))
+		g.Expect(err).To(BeNil())
+		req.Header.Set("Accept-Encoding", "gzip")
+		reqs = append(reqs, &RESTRequest{Request: req, Body: []byte(body)})
+	}
+	_, merged, err := codec.Merge(t.Context(), reqs)
+	g.Expect(err).To(BeNil())
+	g.Expect(merged.Request.Header.Get("Accept-Encoding")).To(BeEmpty())
+	g.Expect(reqs[0].Request.Header.Get("Accept-Encoding")).To(Equal("gzip"))
+
+	res, err := http.DefaultTransport.RoundTrip(merged.HTTPRequest(t.Context()))
+	g.Expect(err).To(BeNil())
+	split, err := codec.Split(res, reqs, []int{1, 1})
+	g.Expect(err).To(BeNil())
+	g.Expect(split).To(HaveLen(2))
+	b, err := io.ReadAll(split[1].Body)
+	g.Expect(err).To(BeNil())
+	g.Expect(string(b)).To(Equal(`{"model_name":"iris_1","id":"b","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[1]}]}`))
+}
+
+func TestRESTHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := RESTCodec{}
+	body := `{"inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[1]}]}`
+	createRequest := func(headers map[string]string) *RESTRequest {
+		req := createRESTRequest(t, body)
+		for key, value := range headers {
+			req.Request.Header.Set(key, value)
+		}
+		return req
+	}
+
+	first := createRequest(map[string]string{
+		"Authorization":                "Bearer a",
+		util.RequestIdHeader:           "a",
+		"traceparent":                  "00-a-01",
+		util.SeldonCaptureHeader:       "exp1",
+		util.SeldonModelHeader:         "iris",
+		util.SeldonInternalModelHeader: "iris_1",
+	})
+	signature, _, err := codec.Signature(t.Context(), first)
+	g.Expect(err).To(BeNil())
+
+	// headers of single requests do not stop requests from being merged
+	other, _, err := codec.Signature(t.Context(), createRequest(map[string]string{
+		"Authorization":                "Bearer a",
+		util.RequestIdHeader:           "b",
+		"traceparent":                  "00-b-01",
+		util.SeldonModelHeader:         "iris",
+		util.SeldonInternalModelHeader: "iris_1",
+	}))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).To(Equal(signature))
+
+	// but requests routed or authorized differently are not merged
+	for _, headers := range []map[string]string{
+		{"Authorization": "Bearer b", util.SeldonModelHeader: "iris", util.SeldonInternalModelHeader: "iris_1"},
+		{"Authorization": "Bearer a", util.SeldonModelHeader: "iris.experiment", util.SeldonInternalModelHeader: "iris_1"},
+	} {
+		other, _, err := codec.Signature(t.Context(), createRequest(headers))
+		g.Expect(err).To(BeNil())
+		g.Expect(other).ToNot(Equal(signature))
+	}
+
+	_, merged, err := codec.Merge(t.Context(), []*RESTRequest{first, createRequest(nil)})
+	g.Expect(err).To(BeNil())
+	g.Expect(merged.Request.Header.Get("Authorization")).To(Equal("Bearer a"))
+	g.Expect(merged.Request.Header.Get(util.SeldonModelHeader)).To(Equal("iris"))
+	for _, header := range []string{util.RequestIdHeader, "traceparent", util.SeldonCaptureHeader} {
+		g.Expect(merged.Request.Header.Get(header)).To(BeEmpty())
+	}
+	// the first request keeps its headers
+	g.Expect(first.Request.Header.Get(util.RequestIdHeader)).To(Equal("a"))
+}
@@ -10,13 +10,27 @@ the Change License after the Change Date as each is defined in accordance with t
 package cache
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 )
 
+const (
+	LRUEvictionPolicy            = "lru"
+	LFUEvictionPolicy            = "lfu"
+	GreedyDualSizeEvictionPolicy = "greedy-dual-size"
+)
+
+var EvictionPolicies = []string{
+	LRUEvictionPolicy,
+	LFUEvictionPolicy,
+	GreedyDualSizeEvictionPolicy,
+}
+
 type CacheTransactionManager struct {
 	cache     interfaces.CacheManager
 	itemLocks sync.Map
@@ -80,7 +94,14 @@ func (tx *CacheTransactionManager) StartEvict(id string) (func(), error) {
 	// TODO: this can be made efficient as top of queue could still be id?
 	return func() {
 		tx.itemUnLock(id)
-	}, tx.cache.Delete(id)
+	}, tx.deleteEvicted(id)
+}
+
+func (tx *CacheTransactionManager) deleteEvicted(id string) error {
+	if agingCache, ok := tx.cache.(interfaces.AgingCacheManager); ok {
+		return agingCache.DeleteEvicted(id)
+	}
+	return tx.cache.Delete(id)
 }
 
 func (tx *CacheTransactionManager) StartReloadIfNotExists(id string) (func(), bool) {
@@ -152,6 +173,20 @@ func (tx *CacheTransactionManager) Peek() (string, int64, error) {
 	return tx.cache.Peek()
 }
 
+// SetCost is a no-op unless the eviction policy takes the cost of reloading items into account
+func (tx *CacheTransactionManager) SetCost(id string, memoryBytes uint64, loadLatency time.Duration) {
+	if costAwareCache, ok := tx.cache.(interfaces.CostAwareCacheManager); ok {
+		costAwareCache.SetCost(id, memoryBytes, loadLatency)
+	}
+}
+
+// SetPinned keeps an item from being evicted, if the eviction policy supports pinning items
+func (tx *CacheTransactionManager) SetPinned(id string, pinned bool) {
+	if pinnableCache, ok := tx.cache.(interfaces.PinnableCacheManager); ok {
+		pinnableCache.SetPinned(id, pinned)
+	}
+}
+
 func newCacheTransactionManager(cache interfaces.CacheManager, logger log.FieldLogger) *CacheTransactionManager {
 	return &CacheTransactionManager{
 		cache:     cache,
@@ -164,3 +199,24 @@ func NewLRUCacheTransactionManager(logger log.FieldLogger) *CacheTransactionMana
 	lru := MakeLRU(map[string]int64{})
 	return newCacheTransactionManager(lru, logger)
 }
+
+func MakeCache(evictionPolicy string) (interfaces.CacheManager, error) {
+	switch evictionPolicy {
+	case LRUEvictionPolicy, "":
+		return MakeLRU(map[string]int64{}), nil
+	case LFUEvictionPolicy:
+		return MakeLFU(map[string]int64{}), nil
+	case GreedyDualSizeEvictionPolicy:
+		return MakeGreedyDualSize(map[string]int64{}), nil
+	default:
+		return nil, fmt.Errorf("unknown cache eviction policy %s, expected one of %v", evictionPolicy, EvictionPolicies)
+	}
+}
+
+func NewCacheTransactionManager(evictionPolicy string, logger log.FieldLogger) (*CacheTransactionManager, error) {
+	cache, err := MakeCache(evictionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return newCacheTransactionManager(cache, logger), nil
+}
@@ -112,3 +112,56 @@ func TestLRUCacheEvictTransactionEdgeCases(t *testing.T) {
 
 	g.Expect(txManager.Exists(id, true)).To(Equal(false))
 }
+
+func TestGreedyDualSizeCacheEvictTransaction(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := MakeGreedyDualSize(map[string]int64{})
+	txManager := newCacheTransactionManager(cache, log.New())
+	_ = txManager.AddDefault("model_1")
+	_ = txManager.AddDefault("model_2")
+
+	// deleting (unloading) a model does not age the cache
+	g.Expect(txManager.Delete("model_2")).To(BeNil())
+	g.Expect(cache.inflation).To(Equal(int64(0)))
+
+	// evicting it does
+	_, priority, err := txManager.Peek()
+	g.Expect(err).To(BeNil())
+	endEvictFn, err := txManager.StartEvict("model_1")
+	g.Expect(err).To(BeNil())
+	endEvictFn()
+	g.Expect(cache.inflation).To(Equal(-priority))
+}
+
+func TestMakeCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		policy   string
+		expected any
+		err      bool
+	}
+
+	tests := []test{
+		{name: "default", policy: "", expected: &LRUCacheManager{}},
+		{name: "lru", policy: LRUEvictionPolicy, expected: &LRUCacheManager{}},
+		{name: "lfu", policy: LFUEvictionPolicy, expected: &LFUCacheManager{}},
+		{name: "greedy dual size", policy: GreedyDualSizeEvictionPolicy, expected: &GreedyDualSizeCacheManager{}},
+		{name: "pinned is not a policy", policy: "pinned", err: true},
+		{name: "unknown", policy: "fifo", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache, err := MakeCache(test.policy)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(cache).To(BeAssignableToTypeOf(test.expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cache
+
+import (
+	"container/heap"
+	"fmt"
+	"time"
+)
+
+const (
+	// used until the agent has measured how long an item takes to load
+	defaultLoadLatency = time.Second
+	defaultMemoryBytes = uint64(1024 * 1024)
+	// costs are expressed in microseconds of load latency per MiB of memory
+	costMemoryUnitBytes = 1024 * 1024
+)
+
+type itemCost struct {
+	memoryBytes uint64
+	loadLatency time.Duration
+}
+
+// GreedyDualSizeCacheManager implements the GreedyDual-Size policy: each item is valued
+// H = L + cost / size, where cost is the time it takes to load the item and size its memory,
+// and the item with the lowest H is evicted first. L is inflated to the H of each evicted item
+// so that items which have not been used for a while eventually age out, even if expensive.
+// Large models that are quick to reload are therefore evicted before small models that are
+// slow to reload.
+// The priority kept in the queue is -H as the queue pops the highest priority first.
+type GreedyDualSizeCacheManager struct {
+	*LRUCacheManager
+	costs     map[string]itemCost
+	inflation int64
+}
+
+// caller must hold the lock
+func (cache *GreedyDualSizeCacheManager) priority(id string) int64 {
+	cost := itemCost{
+		memoryBytes: defaultMemoryBytes,
+		loadLatency: defaultLoadLatency,
+	}
+	if c, ok := cache.costs[id]; ok {
+		cost = c
+	}
+	memoryBytes := max(cost.memoryBytes, 1)
+	value := max(cost.loadLatency.Microseconds()*costMemoryUnitBytes/int64(memoryBytes), 1)
+	return -(cache.inflation + value)
+}
+
+func (cache *GreedyDualSizeCacheManager) SetCost(id string, memoryBytes uint64, loadLatency time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.costs[id] = itemCost{
+		memoryBytes: memoryBytes,
+		loadLatency: loadLatency,
+	}
+}
+
+func (cache *GreedyDualSizeCacheManager) AddDefault(id string) error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if id == "" {
+		return fmt.Errorf("cannot use empty string")
+	}
+	if cache.exists(id) {
+		return fmt.Errorf("item already exists in cache %s", id)
+	}
+	cache.add(id, cache.priority(id))
+	return nil
+}
+
+func (cache *GreedyDualSizeCacheManager) UpdateDefault(id string) error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	for _, item := range cache.pq {
+		if item.id == id {
+			cache.pq.update(item, item.id, cache.priority(id))
+			return nil
+		}
+	}
+	return fmt.Errorf("could not find item %s", id)
+}
+
+func (cache *GreedyDualSizeCacheManager) Evict() (string, int64, error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	id, priority, err := cache.evict()
+	if err == nil {
+		cache.inflation = -priority
+	}
+	return id, priority, err
+}
+
+// DeleteEvicted is how the agent evicts items (after a Peek), it inflates L in the same way as Evict
+func (cache *GreedyDualSizeCacheManager) DeleteEvicted(id string) error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	delete(cache.pinned, id)
+	for _, item := range cache.pq {
+		if item.id == id {
+			cache.inflation = -item.priority
+			heap.Remove(&(cache.pq), item.index)
+			return nil
+		}
+	}
+	return fmt.Errorf("could not find item %s", id)
+}
+
+func MakeGreedyDualSize(initItems map[string]int64) *GreedyDualSizeCacheManager {
+	return &GreedyDualSizeCacheManager{
+		LRUCacheManager: MakeLRU(initItems),
+		costs:           map[string]itemCost{},
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGreedyDualSizeCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type cost struct {
+		memoryBytes uint64
+		loadLatency time.Duration
+	}
+
+	type test struct {
+		name            string
+		costs           map[string]cost
+		added           []string
+		used            []string
+		expectedEvicted []string
+	}
+
+	tests := []test{
+		{
+			name: "large model quick to reload is evicted before small model slow to reload",
+			costs: map[string]cost{
+				"large": {memoryBytes: 1024 * 1024 * 1024, loadLatency: time.Second},
+				"small": {memoryBytes: 1024 * 1024, loadLatency: 10 * time.Second},
+			},
+			added:           []string{"small", "large"},
+			expectedEvicted: []string{"large", "small"},
+		},
+		{
+			name: "same size, cheaper to reload evicted first",
+			costs: map[string]cost{
+				"slow": {memoryBytes: 1024 * 1024, loadLatency: 2 * time.Second},
+				"fast": {memoryBytes: 1024 * 1024, loadLatency: time.Second},
+			},
+			added:           []string{"fast", "slow"},
+			expectedEvicted: []string{"fast", "slow"},
+		},
+		{
+			name: "default cost until measured",
+			costs: map[string]cost{
+				"measured": {memoryBytes: 1024 * 1024, loadLatency: 100 * time.Millisecond},
+			},
+			added:           []string{"unmeasured", "measured"},
+			expectedEvicted: []string{"measured", "unmeasured"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := MakeGreedyDualSize(map[string]int64{})
+			for id, c := range test.costs {
+				cache.SetCost(id, c.memoryBytes, c.loadLatency)
+			}
+			for _, id := range test.added {
+				g.Expect(cache.AddDefault(id)).To(BeNil())
+			}
+			for _, id := range test.used {
+				g.Expect(cache.UpdateDefault(id)).To(BeNil())
+			}
+			for _, expected := range test.expectedEvicted {
+				evicted, _, err := cache.Evict()
+				g.Expect(err).To(BeNil())
+				g.Expect(evicted).To(Equal(expected))
+			}
+		})
+	}
+}
+
+func TestGreedyDualSizeCacheAging(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := MakeGreedyDualSize(map[string]int64{})
+	// expensive to reload relative to its size
+	cache.SetCost("expensive", 1024*1024, 2500*time.Millisecond)
+	cache.SetCost("cheap_1", 1024*1024, time.Second)
+	cache.SetCost("cheap_2", 1024*1024, time.Second)
+	cache.SetCost("cheap_3", 1024*1024, time.Second)
+
+	g.Expect(cache.AddDefault("expensive")).To(BeNil())
+	g.Expect(cache.AddDefault("cheap_1")).To(BeNil())
+
+	// evicting through peek and delete, as the agent does, inflates the value of new items
+	peeked, _, err := cache.Peek()
+	g.Expect(err).To(BeNil())
+	g.Expect(peeked).To(Equal("cheap_1"))
+	g.Expect(cache.DeleteEvicted(peeked)).To(BeNil())
+
+	g.Expect(cache.AddDefault("cheap_2")).To(BeNil())
+	evicted, _, _ := cache.Evict()
+	g.Expect(evicted).To(Equal("cheap_2"))
+
+	// the expensive model has not been used since, so it eventually ages out
+	g.Expect(cache.AddDefault("cheap_3")).To(BeNil())
+	evicted, _, _ = cache.Evict()
+	g.Expect(evicted).To(Equal("expensive"))
+
+	// deleting an item (i.e. unloading a model) does not inflate the value of new items, even if
+	// it is at the head of the queue
+	before := cache.inflation
+	peeked, _, err = cache.Peek()
+	g.Expect(err).To(BeNil())
+	g.Expect(peeked).To(Equal("cheap_3"))
+	g.Expect(cache.Delete(peeked)).To(BeNil())
+	g.Expect(cache.inflation).To(Equal(before))
+}
+
+func TestGreedyDualSizeCachePinnedAging(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := MakeGreedyDualSize(map[string]int64{})
+	cache.SetCost("pinned", 1024*1024, time.Second)
+	cache.SetCost("model", 1024*1024, 2*time.Second)
+	g.Expect(cache.AddDefault("pinned")).To(BeNil())
+	g.Expect(cache.AddDefault("model")).To(BeNil())
+	cache.SetPinned("pinned", true)
+
+	// the pinned item is at the head of the queue but evicting the next one still inflates L
+	peeked, priority, err := cache.Peek()
+	g.Expect(err).To(BeNil())
+	g.Expect(peeked).To(Equal("model"))
+	g.Expect(cache.DeleteEvicted(peeked)).To(BeNil())
+	g.Expect(cache.inflation).To(Equal(-priority))
+	g.Expect(cache.Exists("pinned")).To(BeTrue())
+}
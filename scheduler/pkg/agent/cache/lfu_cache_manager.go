@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cache
+
+import (
+	"fmt"
+)
+
+// LFUCacheManager evicts the least frequently used item first.
+// It shares the priority queue of LRUCacheManager, the priority being the negated number of
+// uses since the item was (re)added.
+type LFUCacheManager struct {
+	*LRUCacheManager
+}
+
+func (cache *LFUCacheManager) AddDefault(id string) error {
+	return cache.Add(id, -1)
+}
+
+func (cache *LFUCacheManager) UpdateDefault(id string) error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	for _, item := range cache.pq {
+		if item.id == id {
+			cache.pq.update(item, item.id, item.priority-1)
+			return nil
+		}
+	}
+	return fmt.Errorf("could not find item %s", id)
+}
+
+func MakeLFU(initItems map[string]int64) *LFUCacheManager {
+	return &LFUCacheManager{
+		LRUCacheManager: MakeLRU(initItems),
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package cache
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLFUCacheSmoke(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	lfuCache := MakeLFU(map[string]int64{})
+
+	_ = lfuCache.AddDefault("model_1")
+	_ = lfuCache.AddDefault("model_2")
+	_ = lfuCache.AddDefault("model_3")
+	_ = lfuCache.UpdateDefault("model_1")
+	_ = lfuCache.UpdateDefault("model_1")
+	_ = lfuCache.UpdateDefault("model_2")
+	_ = lfuCache.UpdateDefault("model_3")
+	_ = lfuCache.UpdateDefault("model_3")
+	_ = lfuCache.UpdateDefault("model_3")
+
+	priority, err := lfuCache.Get("model_3")
+	g.Expect(err).To(BeNil())
+	g.Expect(priority).To(Equal(int64(-4)))
+
+	// peek does not change the use counts
+	peeked, _, err := lfuCache.Peek()
+	g.Expect(err).To(BeNil())
+	g.Expect(peeked).To(Equal("model_2"))
+
+	deleted, _, _ := lfuCache.Evict()
+	g.Expect(deleted).To(Equal("model_2"))
+	deleted, _, _ = lfuCache.Evict()
+	g.Expect(deleted).To(Equal("model_1"))
+
+	// a newly added item is the least frequently used
+	_ = lfuCache.AddDefault("model_4")
+	deleted, _, _ = lfuCache.Evict()
+	g.Expect(deleted).To(Equal("model_4"))
+	deleted, _, _ = lfuCache.Evict()
+	g.Expect(deleted).To(Equal("model_3"))
+
+	_, _, err = lfuCache.Evict()
+	g.Expect(err).ToNot(BeNil())
+	err = lfuCache.UpdateDefault("model_1")
+	g.Expect(err).ToNot(BeNil())
+}
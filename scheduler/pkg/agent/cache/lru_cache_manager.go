@@ -19,6 +19,8 @@ import (
 type LRUCacheManager struct {
 	pq PriorityQueue
 	mu sync.RWMutex
+	// items which are never offered for eviction, they can still be deleted
+	pinned map[string]bool
 }
 
 func (cache *LRUCacheManager) Peek() (string, int64, error) {
@@ -48,11 +50,35 @@ func (cache *LRUCacheManager) Evict() (string, int64, error) {
 }
 
 func (cache *LRUCacheManager) evict() (string, int64, error) {
-	if cache.pq.Len() > 0 {
+	if cache.pq.Len() == 0 {
+		return "", 0, fmt.Errorf("empty cache, cannot evict")
+	}
+	// pinned items are set aside until one that can be evicted is found
+	var skipped []*Item
+	defer func() {
+		for _, item := range skipped {
+			heap.Push(&(cache.pq), item)
+		}
+	}()
+	for cache.pq.Len() > 0 {
 		item := heap.Pop(&(cache.pq)).(*Item)
+		if cache.pinned[item.id] {
+			skipped = append(skipped, item)
+			continue
+		}
 		return item.id, item.priority, nil
 	}
-	return "", 0, fmt.Errorf("empty cache, cannot evict")
+	return "", 0, fmt.Errorf("all items are pinned, cannot evict")
+}
+
+func (cache *LRUCacheManager) SetPinned(id string, pinned bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if pinned {
+		cache.pinned[id] = true
+	} else {
+		delete(cache.pinned, id)
+	}
 }
 
 func (cache *LRUCacheManager) add(id string, value int64) {
@@ -131,6 +157,7 @@ func (cache *LRUCacheManager) Get(id string) (int64, error) {
 func (cache *LRUCacheManager) Delete(id string) error {
 	cache.mu.Lock()
 	defer cache.mu.Unlock()
+	delete(cache.pinned, id)
 	for _, item := range cache.pq {
 		if item.id == id {
 			heap.Remove(&(cache.pq), item.index)
@@ -168,8 +195,9 @@ func MakeLRU(initItems map[string]int64) *LRUCacheManager {
 	}
 	heap.Init(&pq)
 	return &LRUCacheManager{
-		pq: pq,
-		mu: sync.RWMutex{},
+		pq:     pq,
+		mu:     sync.RWMutex{},
+		pinned: map[string]bool{},
 	}
 }
 
@@ -321,3 +321,36 @@ func TestLRUCacheSmokeEdgeCases(t *testing.T) {
 
 	t.Logf("Done!")
 }
+
+func TestLRUCachePinned(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := MakeLRU(map[string]int64{})
+	g.Expect(cache.Add("model_1", -1)).To(BeNil())
+	g.Expect(cache.Add("model_2", -2)).To(BeNil())
+	g.Expect(cache.Add("model_3", -3)).To(BeNil())
+	cache.SetPinned("model_1", true)
+
+	// pinned items are skipped and stay in the cache
+	id, _, err := cache.Peek()
+	g.Expect(err).To(BeNil())
+	g.Expect(id).To(Equal("model_2"))
+	id, _, err = cache.Evict()
+	g.Expect(err).To(BeNil())
+	g.Expect(id).To(Equal("model_2"))
+	g.Expect(cache.Exists("model_1")).To(BeTrue())
+
+	cache.SetPinned("model_3", true)
+	_, _, err = cache.Evict()
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(cache.Exists("model_1")).To(BeTrue())
+	g.Expect(cache.Exists("model_3")).To(BeTrue())
+
+	// pinned items can still be deleted, and are evicted once unpinned
+	g.Expect(cache.Delete("model_1")).To(BeNil())
+	g.Expect(cache.Exists("model_1")).To(BeFalse())
+	cache.SetPinned("model_3", false)
+	id, _, err = cache.Evict()
+	g.Expect(err).To(BeNil())
+	g.Expect(id).To(Equal("model_3"))
+}
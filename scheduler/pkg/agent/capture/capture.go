@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package capture
+
+import (
+	"strconv"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/producer"
+	seldonkafka "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+)
+
+const (
+	ExperimentHeader = "seldon-experiment"
+	ModelHeader      = "seldon-internal-model"
+	ShadowHeader     = "seldon-shadow"
+	MethodHeader     = "seldon-method"
+	StatusHeader     = "seldon-status"
+)
+
+// CapturedResponse is the response of a model taking part in an experiment whose
+// responses are being captured. Requests to the default model and to each of its
+// mirrors share the same request id so they can be joined offline.
+type CapturedResponse struct {
+	Experiment string
+	ModelName  string
+	RequestId  string
+	Shadow     bool
+	Method     string
+	Status     string
+	Body       []byte
+}
+
+type ResponseCapturer interface {
+	Capture(response *CapturedResponse)
+}
+
+type KafkaResponseCapturer struct {
+	producer   *producer.KafkaProducer
+	topicNamer *seldonkafka.TopicNamer
+}
+
+func NewKafkaResponseCapturer(
+	kafkaProducer *producer.KafkaProducer,
+	topicPrefix string,
+	namespace string,
+) (*KafkaResponseCapturer, error) {
+	topicNamer, err := seldonkafka.NewTopicNamer(namespace, topicPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaResponseCapturer{
+		producer:   kafkaProducer,
+		topicNamer: topicNamer,
+	}, nil
+}
+
+// Capture is asynchronous and never fails the inference request; failures are only logged
+func (rc *KafkaResponseCapturer) Capture(response *CapturedResponse) {
+	rc.producer.Produce(createCaptureMessage(rc.topicNamer.GetExperimentTopicResponses(response.Experiment), response))
+}
+
+func createCaptureMessage(topic string, response *CapturedResponse) *kafka.Message {
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            []byte(response.RequestId),
+		Value:          response.Body,
+		Headers: []kafka.Header{
+			{Key: ExperimentHeader, Value: []byte(response.Experiment)},
+			{Key: ModelHeader, Value: []byte(response.ModelName)},
+			{Key: ShadowHeader, Value: []byte(strconv.FormatBool(response.Shadow))},
+			{Key: MethodHeader, Value: []byte(response.Method)},
+			{Key: StatusHeader, Value: []byte(response.Status)},
+		},
+	}
+}
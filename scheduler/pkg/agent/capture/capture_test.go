@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package capture
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	. "github.com/onsi/gomega"
+)
+
+func TestCreateCaptureMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		response        *CapturedResponse
+		expectedHeaders map[string]string
+	}
+
+	tests := []test{
+		{
+			name: "default model response",
+			response: &CapturedResponse{
+				Experiment: "exp1",
+				ModelName:  "foo_1",
+				RequestId:  "1234",
+				Method:     "rest",
+				Status:     "200",
+				Body:       []byte(`{"outputs":[]}`),
+			},
+			expectedHeaders: map[string]string{
+				ExperimentHeader: "exp1",
+				ModelHeader:      "foo_1",
+				ShadowHeader:     "false",
+				MethodHeader:     "rest",
+				StatusHeader:     "200",
+			},
+		},
+		{
+			name: "mirror response",
+			response: &CapturedResponse{
+				Experiment: "exp1",
+				ModelName:  "bar_2",
+				RequestId:  "1234",
+				Shadow:     true,
+				Method:     "grpc",
+				Status:     "OK",
+				Body:       []byte{1, 2, 3},
+			},
+			expectedHeaders: map[string]string{
+				ExperimentHeader: "exp1",
+				ModelHeader:      "bar_2",
+				ShadowHeader:     "true",
+				MethodHeader:     "grpc",
+				StatusHeader:     "OK",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := createCaptureMessage("seldon.default.experiment.exp1.responses", test.response)
+			g.Expect(*msg.TopicPartition.Topic).To(Equal("seldon.default.experiment.exp1.responses"))
+			g.Expect(msg.TopicPartition.Partition).To(Equal(kafka.PartitionAny))
+			g.Expect(string(msg.Key)).To(Equal(test.response.RequestId))
+			g.Expect(msg.Value).To(Equal(test.response.Body))
+			headers := make(map[string]string)
+			for _, header := range msg.Headers {
+				headers[header.Key] = string(header.Value)
+			}
+			g.Expect(headers).To(Equal(test.expectedHeaders))
+		})
+	}
+}
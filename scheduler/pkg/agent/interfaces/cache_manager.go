@@ -9,6 +9,8 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package interfaces
 
+import "time"
+
 type CacheManager interface {
 	// add a new node with specific id and priority/value
 	Add(string, int64) error
@@ -31,3 +33,25 @@ type CacheManager interface {
 	// evict
 	Evict() (string, int64, error)
 }
+
+// CostAwareCacheManager is implemented by caches whose eviction order takes into account
+// how expensive it is to bring an item back
+type CostAwareCacheManager interface {
+	CacheManager
+	// set the memory footprint and the measured (re)load latency of the given id
+	SetCost(id string, memoryBytes uint64, loadLatency time.Duration)
+}
+
+// PinnableCacheManager is implemented by caches which can keep items from being evicted
+type PinnableCacheManager interface {
+	CacheManager
+	// set whether the given id is never offered for eviction
+	SetPinned(id string, pinned bool)
+}
+
+// AgingCacheManager is implemented by caches whose eviction order depends on the items evicted so far
+type AgingCacheManager interface {
+	CacheManager
+	// delete item with id from cache as it is evicted (e.g. after a peek)
+	DeleteEvicted(id string) error
+}
@@ -71,9 +71,16 @@ type V2ServerError struct {
 }
 
 type ModelServerConfig struct {
-	Host   string
-	Port   int
-	Logger log.FieldLogger
+	Host string
+	// grpc port of the inference server
+	Port int
+	// http port of the inference server
+	HttpPort int
+	// port of the management api of servers which expose it separately from inference, e.g. TorchServe
+	ManagementPort int
+	// path of the model repository written by the agent, as shared with the server
+	ModelRepositoryPath string
+	Logger              log.FieldLogger
 }
 
 var ErrControlPlaneBadRequest = errors.New("ControlPlane Bad Request")
@@ -108,6 +108,46 @@ func (modelState *ModelState) versionExists(modelId string, versionId uint32) bo
 	return version.getVersion() == versionId
 }
 
+func (modelState *ModelState) getPayloadLoggingSpec(modelId string) *scheduler.PayloadLoggingSpec {
+	modelState.mu.RLock()
+	defer modelState.mu.RUnlock()
+	version, ok := modelState.loadedModels[modelId]
+	if !ok {
+		return nil
+	}
+	return version.versionInfo.GetModel().GetDeploymentSpec().GetPayloadLogging()
+}
+
+func (modelState *ModelState) getBatchingSpec(modelId string) *scheduler.BatchingSpec {
+	modelState.mu.RLock()
+	defer modelState.mu.RUnlock()
+	version, ok := modelState.loadedModels[modelId]
+	if !ok {
+		return nil
+	}
+	return version.versionInfo.GetModel().GetModelSpec().GetBatching()
+}
+
+func (modelState *ModelState) getResponseCacheSpec(modelId string) *scheduler.ResponseCacheSpec {
+	modelState.mu.RLock()
+	defer modelState.mu.RUnlock()
+	version, ok := modelState.loadedModels[modelId]
+	if !ok {
+		return nil
+	}
+	return version.versionInfo.GetModel().GetModelSpec().GetResponseCache()
+}
+
+func (modelState *ModelState) getConcurrencySpec(modelId string) *scheduler.ConcurrencySpec {
+	modelState.mu.RLock()
+	defer modelState.mu.RUnlock()
+	version, ok := modelState.loadedModels[modelId]
+	if !ok {
+		return nil
+	}
+	return version.versionInfo.GetModel().GetModelSpec().GetConcurrency()
+}
+
 // this includes the ones that are evicted as we do not differentiate at this level
 func (modelState *ModelState) getTotalMemoryBytesForAllModels() uint64 {
 	modelState.mu.RLock()
@@ -284,3 +284,112 @@ func TestRemoveModelVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPayloadLoggingSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	state := NewModelState()
+	spec := &pbs.PayloadLoggingSpec{SamplePercent: 10, RedactTensors: []string{"ssn"}}
+	_, err := state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:           &pbs.MetaData{Name: "iris"},
+			ModelSpec:      &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1)},
+			DeploymentSpec: &pbs.DeploymentSpec{PayloadLogging: spec},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+	_, err = state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "mnist"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1)},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(state.getPayloadLoggingSpec("iris")).To(Equal(spec))
+	g.Expect(state.getPayloadLoggingSpec("mnist")).To(BeNil())
+	g.Expect(state.getPayloadLoggingSpec("unknown")).To(BeNil())
+}
+
+func TestGetBatchingSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	state := NewModelState()
+	spec := &pbs.BatchingSpec{MaxBatchSize: 16, MaxWaitMs: 10}
+	_, err := state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "iris"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1), Batching: spec},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+	_, err = state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "mnist"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1)},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(state.getBatchingSpec("iris")).To(Equal(spec))
+	g.Expect(state.getBatchingSpec("mnist")).To(BeNil())
+	g.Expect(state.getBatchingSpec("unknown")).To(BeNil())
+}
+
+func TestGetResponseCacheSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	state := NewModelState()
+	spec := &pbs.ResponseCacheSpec{MaxEntries: 100, TtlSeconds: 60}
+	_, err := state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "iris"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1), ResponseCache: spec},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+	_, err = state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "mnist"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1)},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(state.getResponseCacheSpec("iris")).To(Equal(spec))
+	g.Expect(state.getResponseCacheSpec("mnist")).To(BeNil())
+	g.Expect(state.getResponseCacheSpec("unknown")).To(BeNil())
+}
+
+func TestGetConcurrencySpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	state := NewModelState()
+	spec := &pbs.ConcurrencySpec{MaxInflight: 4, MaxQueueSize: 16}
+	_, err := state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "iris"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1), Concurrency: spec},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+	_, err = state.addModelVersion(&pb.ModelVersion{
+		Model: &pbs.Model{
+			Meta:      &pbs.MetaData{Name: "mnist"},
+			ModelSpec: &pbs.ModelSpec{ModelRuntimeInfo: getModelRuntimeInfo(1)},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(state.getConcurrencySpec("iris")).To(Equal(spec))
+	g.Expect(state.getConcurrencySpec("mnist")).To(BeNil())
+	g.Expect(state.getConcurrencySpec("unknown")).To(BeNil())
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package modelscaling
+
+import (
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
+)
+
+const (
+	ModelQueueDepthKey = "queue_depth"
+	ModelRejectedKey   = "rejected"
+)
+
+// ModelReplicaCountsKeeper keeps a count per model replica apart from its lag, such as the requests
+// queued or rejected by the agent, until the count is reset by a scaling event
+type ModelReplicaCountsKeeper struct {
+	*ModelReplicaLagsKeeper
+	key  string
+	info string
+}
+
+func NewModelReplicaQueueDepthKeeper() *ModelReplicaCountsKeeper {
+	return &ModelReplicaCountsKeeper{
+		ModelReplicaLagsKeeper: NewModelReplicaLagsKeeper(),
+		key:                    ModelQueueDepthKey,
+		info:                   "Model Replica Queue Depth: max requests queued by the agent",
+	}
+}
+
+func NewModelReplicaRejectedKeeper() *ModelReplicaCountsKeeper {
+	return &ModelReplicaCountsKeeper{
+		ModelReplicaLagsKeeper: NewModelReplicaLagsKeeper(),
+		key:                    ModelRejectedKey,
+		info:                   "Model Replica Rejected: requests rejected by the agent",
+	}
+}
+
+func (countsKeeper *ModelReplicaCountsKeeper) Info() string {
+	return countsKeeper.info
+}
+
+// Add starts counting from zero, unlike the lag which counts the model load as a request
+func (countsKeeper *ModelReplicaCountsKeeper) Add(modelName string) error {
+	return countsKeeper.Set(modelName, 0)
+}
+
+func (countsKeeper *ModelReplicaCountsKeeper) GetAll(threshold uint32, op interfaces.LogicOperation, reset bool) ([]*interfaces.ModelStatsKV, error) {
+	rets, err := countsKeeper.ModelReplicaLagsKeeper.GetAll(threshold, op, reset)
+	if err != nil {
+		return nil, err
+	}
+	for _, ret := range rets {
+		ret.Key = countsKeeper.key
+	}
+	return rets, nil
+}
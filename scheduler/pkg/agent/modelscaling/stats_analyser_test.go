@@ -163,7 +163,7 @@ func TestStatsAnalyserSoak(t *testing.T) {
 
 	lags := NewModelReplicaLagsKeeper()
 	lastUsed := NewModelReplicaLastUsedKeeper()
-	modelScalingStatsCollector := NewDataPlaneStatsCollector(lags, lastUsed)
+	modelScalingStatsCollector := NewDataPlaneStatsCollector(lags, lastUsed, NewModelReplicaQueueDepthKeeper(), NewModelReplicaRejectedKeeper())
 	service := NewStatsAnalyserService(
 		[]ModelScalingStatsWrapper{
 			{
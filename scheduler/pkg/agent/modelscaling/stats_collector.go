@@ -17,14 +17,18 @@ import (
 
 // TODO: this has tight-coupling with specific metrics, how can we do it more generally?
 type DataPlaneStatsCollector struct {
-	ModelLagStats      interfaces.ModelScalingStats
-	ModelLastUsedStats interfaces.ModelScalingStats
+	ModelLagStats        interfaces.ModelScalingStats
+	ModelLastUsedStats   interfaces.ModelScalingStats
+	ModelQueueDepthStats interfaces.ModelScalingStats
+	ModelRejectedStats   interfaces.ModelScalingStats
 }
 
-func NewDataPlaneStatsCollector(modelLagStats, modelLastUsedStats interfaces.ModelScalingStats) *DataPlaneStatsCollector {
+func NewDataPlaneStatsCollector(modelLagStats, modelLastUsedStats, modelQueueDepthStats, modelRejectedStats interfaces.ModelScalingStats) *DataPlaneStatsCollector {
 	return &DataPlaneStatsCollector{
-		ModelLagStats:      modelLagStats,
-		ModelLastUsedStats: modelLastUsedStats,
+		ModelLagStats:        modelLagStats,
+		ModelLastUsedStats:   modelLastUsedStats,
+		ModelQueueDepthStats: modelQueueDepthStats,
+		ModelRejectedStats:   modelRejectedStats,
 	}
 }
 
@@ -42,3 +46,19 @@ func (c *DataPlaneStatsCollector) ScalingMetricsTearDown(wg *sync.WaitGroup, int
 	wg.Wait() // make sure that Inc is called first
 	return c.ModelLagStats.DecDefault(internalModelName)
 }
+
+// ScalingMetricsQueued keeps the max number of requests of the model queued by the agent, queued
+// requests are a sign that the model needs more replicas. The lag is not changed as queued requests
+// are already counted as incoming requests.
+func (c *DataPlaneStatsCollector) ScalingMetricsQueued(internalModelName string, depth uint32) error {
+	if queued, err := c.ModelQueueDepthStats.Get(internalModelName); err == nil && queued >= depth {
+		return nil
+	}
+	return c.ModelQueueDepthStats.Set(internalModelName, depth)
+}
+
+// ScalingMetricsRejected counts the requests of the model rejected by the agent, until the count is
+// reset by the next scaling event
+func (c *DataPlaneStatsCollector) ScalingMetricsRejected(internalModelName string) error {
+	return c.ModelRejectedStats.IncDefault(internalModelName)
+}
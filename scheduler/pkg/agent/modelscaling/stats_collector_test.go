@@ -15,6 +15,8 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 )
 
 func TestStatsCollectorSmoke(t *testing.T) {
@@ -24,7 +26,7 @@ func TestStatsCollectorSmoke(t *testing.T) {
 	lags := NewModelReplicaLagsKeeper()
 	lastUsed := NewModelReplicaLastUsedKeeper()
 
-	collector := NewDataPlaneStatsCollector(lags, lastUsed)
+	collector := NewDataPlaneStatsCollector(lags, lastUsed, NewModelReplicaQueueDepthKeeper(), NewModelReplicaRejectedKeeper())
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -45,3 +47,58 @@ func TestStatsCollectorSmoke(t *testing.T) {
 	g.Expect(lagCount).To(Equal(uint32(0)))
 
 }
+
+func TestStatsCollectorQueuedAndRejected(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "model_0"
+
+	collector := NewDataPlaneStatsCollector(
+		NewModelReplicaLagsKeeper(), NewModelReplicaLastUsedKeeper(), NewModelReplicaQueueDepthKeeper(), NewModelReplicaRejectedKeeper())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	err := collector.ScalingMetricsSetup(&wg, dummyModel)
+	g.Expect(err).To(BeNil())
+
+	err = collector.ScalingMetricsQueued(dummyModel, 3)
+	g.Expect(err).To(BeNil())
+	queued, _ := collector.ModelQueueDepthStats.Get(dummyModel)
+	g.Expect(queued).To(Equal(uint32(3)))
+
+	// the max queue depth is kept until the next scaling event
+	err = collector.ScalingMetricsQueued(dummyModel, 1)
+	g.Expect(err).To(BeNil())
+	queued, _ = collector.ModelQueueDepthStats.Get(dummyModel)
+	g.Expect(queued).To(Equal(uint32(3)))
+
+	err = collector.ScalingMetricsRejected(dummyModel)
+	g.Expect(err).To(BeNil())
+	rejected, _ := collector.ModelRejectedStats.Get(dummyModel)
+	g.Expect(rejected).To(Equal(uint32(1)))
+
+	// the lag only counts the request in flight and is back to 0 once the rejected request completes
+	lagCount, _ := collector.ModelLagStats.Get(dummyModel)
+	g.Expect(lagCount).To(Equal(uint32(1)))
+	err = collector.ScalingMetricsTearDown(&wg, dummyModel)
+	g.Expect(err).To(BeNil())
+	lagCount, _ = collector.ModelLagStats.Get(dummyModel)
+	g.Expect(lagCount).To(Equal(uint32(0)))
+}
+
+func TestModelReplicaCountsKeeper(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "model_0"
+
+	rejected := NewModelReplicaRejectedKeeper()
+	g.Expect(rejected.Add(dummyModel)).To(BeNil())
+	count, err := rejected.Get(dummyModel)
+	g.Expect(err).To(BeNil())
+	g.Expect(count).To(Equal(uint32(0)))
+
+	g.Expect(rejected.IncDefault(dummyModel)).To(BeNil())
+	stats, err := rejected.GetAll(1, interfaces.Gte, true)
+	g.Expect(err).To(BeNil())
+	g.Expect(stats).To(Equal([]*interfaces.ModelStatsKV{{ModelName: dummyModel, Value: 1, Key: ModelRejectedKey}}))
+	count, _ = rejected.Get(dummyModel)
+	g.Expect(count).To(Equal(uint32(0)))
+}
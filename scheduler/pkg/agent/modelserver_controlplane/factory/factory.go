@@ -12,13 +12,24 @@ package controlplane_factory
 import (
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelserver_controlplane/oip"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelserver_controlplane/openai"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelserver_controlplane/torchserve"
 )
 
 func CreateModelServerControlPlane(
 	modelServerType string,
 	config interfaces.ModelServerConfig,
 ) (interfaces.ModelServerControlPlaneClient, error) {
-	// we only support v2 for now
-	return oip.NewV2Client(
-		oip.GetV2ConfigWithDefaults(config.Host, config.Port), config.Logger), nil
+	switch modelServerType {
+	case "torchserve":
+		return torchserve.NewTorchServeClient(
+			torchserve.GetTorchServeConfigWithDefaults(config.Host, config.HttpPort, config.ManagementPort), config.Logger), nil
+	case "openai":
+		return openai.NewOpenAIClient(
+			openai.GetOpenAIConfigWithDefaults(config.Host, config.HttpPort, config.ModelRepositoryPath), config.Logger), nil
+	default:
+		// mlserver and triton implement the v2 (OIP) repository api
+		return oip.NewV2Client(
+			oip.GetV2ConfigWithDefaults(config.Host, config.Port), config.Logger), nil
+	}
 }
@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	loadAdapterPath   = "/v1/load_lora_adapter"
+	unloadAdapterPath = "/v1/unload_lora_adapter"
+	modelsPath        = "/v1/models"
+	healthPath        = "/health"
+)
+
+type OpenAIConfig struct {
+	Host string
+	Port int
+	// where the server finds the model repository written by the agent
+	ModelRepositoryPath string
+	ModelLoadTimeout    time.Duration
+	ModelUnloadTimeout  time.Duration
+	ControlPlaneTimeout time.Duration
+}
+
+// OpenAIClient manages the models of an OpenAI compatible server through its admin api to load and
+// unload (LoRA) adapters on top of the base model the server was started with, as exposed by vLLM with
+// VLLM_ALLOW_RUNTIME_LORA_UPDATING.
+// https://docs.vllm.ai/en/latest/features/lora.html#dynamically-serving-lora-adapters
+type OpenAIClient struct {
+	config     OpenAIConfig
+	httpClient *http.Client
+	logger     log.FieldLogger
+}
+
+type loadAdapterRequest struct {
+	Name string `json:"lora_name"`
+	Path string `json:"lora_path"`
+}
+
+type unloadAdapterRequest struct {
+	Name string `json:"lora_name"`
+}
+
+type modelsListResponse struct {
+	Data []modelEntry `json:"data"`
+}
+
+type modelEntry struct {
+	Id string `json:"id"`
+	// only set for adapters, to the base model they are loaded on
+	Parent *string `json:"parent"`
+}
+
+func GetOpenAIConfigWithDefaults(host string, port int, modelRepositoryPath string) OpenAIConfig {
+	return OpenAIConfig{
+		Host:                host,
+		Port:                port,
+		ModelRepositoryPath: modelRepositoryPath,
+		ModelLoadTimeout:    util.GRPCModelServerLoadTimeout,
+		ModelUnloadTimeout:  util.GRPCModelServerUnloadTimeout,
+		ControlPlaneTimeout: util.GRPCControlPlaneTimeout,
+	}
+}
+
+func NewOpenAIClient(config OpenAIConfig, logger log.FieldLogger) *OpenAIClient {
+	logger.Infof("OpenAI compatible Inference Server %s:%d", config.Host, config.Port)
+
+	return &OpenAIClient{
+		config:     config,
+		httpClient: http.DefaultClient,
+		logger:     logger.WithField("Source", "OpenAIClient"),
+	}
+}
+
+func (o *OpenAIClient) call(ctx context.Context, method string, path string, body any) ([]byte, *interfaces.ControlPlaneErr) {
+	endpoint := url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(o.config.Host, strconv.Itoa(o.config.Port)),
+		Path:   path,
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, &interfaces.ControlPlaneErr{
+				Err:     err,
+				ErrCode: interfaces.V2RequestErrCode,
+			}
+		}
+		reqBody = bytes.NewBuffer(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), reqBody)
+	if err != nil {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     err,
+			ErrCode: interfaces.V2RequestErrCode,
+		}
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	response, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     err,
+			ErrCode: interfaces.V2CommunicationErrCode,
+		}
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			o.logger.WithError(err).WithField("url", endpoint.String()).Error("Failed to close response body")
+		}
+	}()
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     err,
+			ErrCode: interfaces.V2CommunicationErrCode,
+		}
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     fmt.Errorf("%s %s failed with status %d: %s", method, endpoint.String(), response.StatusCode, string(b)),
+			ErrCode: response.StatusCode,
+		}
+	}
+	return b, nil
+}
+
+func (o *OpenAIClient) LoadModel(name string) *interfaces.ControlPlaneErr {
+	ctx, cancel := context.WithTimeout(context.Background(), o.config.ModelLoadTimeout)
+	defer cancel()
+
+	req := &loadAdapterRequest{
+		Name: name,
+		// the adapter folder has the same name as the model in the model repository
+		Path: filepath.Join(o.config.ModelRepositoryPath, name),
+	}
+	_, err := o.call(ctx, http.MethodPost, loadAdapterPath, req)
+	return err
+}
+
+func (o *OpenAIClient) UnloadModel(name string) *interfaces.ControlPlaneErr {
+	ctx, cancel := context.WithTimeout(context.Background(), o.config.ModelUnloadTimeout)
+	defer cancel()
+
+	_, err := o.call(ctx, http.MethodPost, unloadAdapterPath, &unloadAdapterRequest{Name: name})
+	return err
+}
+
+func (o *OpenAIClient) Live() error {
+	ctx, cancel := context.WithTimeout(context.Background(), o.config.ControlPlaneTimeout)
+	defer cancel()
+
+	if _, err := o.call(ctx, http.MethodGet, healthPath, nil); err != nil {
+		o.logger.WithError(err.Err).Debugf("Server live check failed on error")
+		if err.ErrCode == interfaces.V2CommunicationErrCode {
+			return err.Err
+		}
+		return interfaces.ErrServerNotReady
+	}
+	return nil
+}
+
+// GetModels only returns the adapters, the base model is not managed by the agent
+func (o *OpenAIClient) GetModels() ([]interfaces.ServerModelInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), o.config.ControlPlaneTimeout)
+	defer cancel()
+
+	b, err := o.call(ctx, http.MethodGet, modelsPath, nil)
+	if err != nil {
+		return nil, err.Err
+	}
+	res := modelsListResponse{}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+	var models []interfaces.ServerModelInfo
+	for _, model := range res.Data {
+		if model.Parent == nil || *model.Parent == "" {
+			continue
+		}
+		models = append(models, interfaces.ServerModelInfo{
+			Name:  model.Id,
+			State: interfaces.ServerModelState_READY,
+		})
+	}
+	return models, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package openai
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
+)
+
+const baseModel = "meta-llama/Llama-3.1-8B"
+
+type fakeOpenAIServer struct {
+	mu       sync.Mutex
+	adapters map[string]string
+}
+
+func (f *fakeOpenAIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.URL.Path {
+	case healthPath:
+		w.WriteHeader(http.StatusOK)
+	case loadAdapterPath:
+		req := loadAdapterRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Path == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.adapters[req.Name] = req.Path
+		_, _ = w.Write([]byte(`Success: LoRA adapter loaded`))
+	case unloadAdapterPath:
+		req := unloadAdapterRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := f.adapters[req.Name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.adapters, req.Name)
+		_, _ = w.Write([]byte(`Success: LoRA adapter unloaded`))
+	case modelsPath:
+		parent := baseModel
+		res := modelsListResponse{Data: []modelEntry{{Id: baseModel}}}
+		for name := range f.adapters {
+			res.Data = append(res.Data, modelEntry{Id: name, Parent: &parent})
+		}
+		b, _ := json.Marshal(res)
+		_, _ = w.Write(b)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func createTestClient(t *testing.T, handler http.Handler) *OpenAIClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	return NewOpenAIClient(GetOpenAIConfigWithDefaults(host, port, "/mnt/models"), log.New())
+}
+
+func TestOpenAILoadUnload(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fake := &fakeOpenAIServer{adapters: map[string]string{}}
+	client := createTestClient(t, fake)
+
+	g.Expect(client.Live()).To(BeNil())
+
+	g.Expect(client.LoadModel("sql-lora_1")).To(BeNil())
+	g.Expect(fake.adapters).To(Equal(map[string]string{"sql-lora_1": "/mnt/models/sql-lora_1"}))
+
+	models, err := client.GetModels()
+	g.Expect(err).To(BeNil())
+	// the base model is not returned
+	g.Expect(models).To(Equal([]interfaces.ServerModelInfo{
+		{Name: "sql-lora_1", State: interfaces.ServerModelState_READY},
+	}))
+
+	g.Expect(client.UnloadModel("sql-lora_1")).To(BeNil())
+	g.Expect(fake.adapters).To(BeEmpty())
+	cpErr := client.UnloadModel("sql-lora_1")
+	g.Expect(cpErr).ToNot(BeNil())
+	g.Expect(cpErr.IsNotFound()).To(BeTrue())
+}
+
+func TestOpenAINotLive(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := createTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	g.Expect(client.Live()).To(Equal(interfaces.ErrServerNotReady))
+}
@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package torchserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	DefaultManagementPort = 8081
+	defaultInitialWorkers = 1
+	// TorchServe pages the list of registered models
+	listModelsPageSize = 100
+)
+
+type TorchServeConfig struct {
+	Host string
+	// inference api port, used for health checks
+	InferencePort int
+	// management api port, used to register and unregister models
+	ManagementPort      int
+	InitialWorkers      int
+	ModelLoadTimeout    time.Duration
+	ModelUnloadTimeout  time.Duration
+	ControlPlaneTimeout time.Duration
+}
+
+// TorchServeClient manages models through the TorchServe management api.
+// Models are registered from folders (in the "no archive" format, i.e. with MAR-INF/MANIFEST.json)
+// placed directly in the TorchServe model store, which is the agent model repository.
+// https://pytorch.org/serve/management_api.html
+type TorchServeClient struct {
+	config     TorchServeConfig
+	httpClient *http.Client
+	logger     log.FieldLogger
+}
+
+type modelsListResponse struct {
+	NextPageToken string       `json:"nextPageToken"`
+	Models        []modelEntry `json:"models"`
+}
+
+type modelEntry struct {
+	ModelName string `json:"modelName"`
+}
+
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+func GetTorchServeConfigWithDefaults(host string, inferencePort int, managementPort int) TorchServeConfig {
+	return TorchServeConfig{
+		Host:                host,
+		InferencePort:       inferencePort,
+		ManagementPort:      managementPort,
+		InitialWorkers:      defaultInitialWorkers,
+		ModelLoadTimeout:    util.GRPCModelServerLoadTimeout,
+		ModelUnloadTimeout:  util.GRPCModelServerUnloadTimeout,
+		ControlPlaneTimeout: util.GRPCControlPlaneTimeout,
+	}
+}
+
+func NewTorchServeClient(config TorchServeConfig, logger log.FieldLogger) *TorchServeClient {
+	logger.Infof("TorchServe Inference Server %s:%d (management port %d)", config.Host, config.InferencePort, config.ManagementPort)
+
+	return &TorchServeClient{
+		config:     config,
+		httpClient: http.DefaultClient,
+		logger:     logger.WithField("Source", "TorchServeClient"),
+	}
+}
+
+func (t *TorchServeClient) endpoint(port int, path string, query url.Values) string {
+	u := url.URL{
+		Scheme:   "http",
+		Host:     net.JoinHostPort(t.config.Host, strconv.Itoa(port)),
+		Path:     path,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+func (t *TorchServeClient) call(ctx context.Context, method string, endpoint string) ([]byte, *interfaces.ControlPlaneErr) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     err,
+			ErrCode: interfaces.V2RequestErrCode,
+		}
+	}
+	response, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     err,
+			ErrCode: interfaces.V2CommunicationErrCode,
+		}
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			t.logger.WithError(err).WithField("url", endpoint).Error("Failed to close TorchServe response body")
+		}
+	}()
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     err,
+			ErrCode: interfaces.V2CommunicationErrCode,
+		}
+	}
+	if response.StatusCode != http.StatusOK {
+		message := string(b)
+		errResponse := errorResponse{}
+		if err := json.Unmarshal(b, &errResponse); err == nil && errResponse.Message != "" {
+			message = errResponse.Message
+		}
+		return nil, &interfaces.ControlPlaneErr{
+			Err:     fmt.Errorf("TorchServe %s %s failed with status %d: %s", method, endpoint, response.StatusCode, message),
+			ErrCode: response.StatusCode,
+		}
+	}
+	return b, nil
+}
+
+func (t *TorchServeClient) LoadModel(name string) *interfaces.ControlPlaneErr {
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.ModelLoadTimeout)
+	defer cancel()
+
+	query := url.Values{}
+	// the model folder has the same name as the model in the model store
+	query.Set("url", name)
+	query.Set("model_name", name)
+	query.Set("initial_workers", strconv.Itoa(t.config.InitialWorkers))
+	query.Set("synchronous", "true")
+	_, err := t.call(ctx, http.MethodPost, t.endpoint(t.config.ManagementPort, "/models", query))
+	if err != nil && err.ErrCode == http.StatusConflict {
+		// already registered, e.g. the agent is retrying a load
+		t.logger.Debugf("Model %s is already registered", name)
+		return nil
+	}
+	return err
+}
+
+func (t *TorchServeClient) UnloadModel(name string) *interfaces.ControlPlaneErr {
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.ModelUnloadTimeout)
+	defer cancel()
+
+	_, err := t.call(ctx, http.MethodDelete, t.endpoint(t.config.ManagementPort, "/models/"+url.PathEscape(name), nil))
+	return err
+}
+
+func (t *TorchServeClient) Live() error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.ControlPlaneTimeout)
+	defer cancel()
+
+	if _, err := t.call(ctx, http.MethodGet, t.endpoint(t.config.InferencePort, "/ping", nil)); err != nil {
+		t.logger.WithError(err.Err).Debugf("Server live check failed on error")
+		if err.ErrCode == interfaces.V2CommunicationErrCode {
+			return err.Err
+		}
+		return interfaces.ErrServerNotReady
+	}
+	return nil
+}
+
+func (t *TorchServeClient) GetModels() ([]interfaces.ServerModelInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.ControlPlaneTimeout)
+	defer cancel()
+
+	var models []interfaces.ServerModelInfo
+	nextPageToken := ""
+	for {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(listModelsPageSize))
+		if nextPageToken != "" {
+			query.Set("next_page_token", nextPageToken)
+		}
+		b, err := t.call(ctx, http.MethodGet, t.endpoint(t.config.ManagementPort, "/models", query))
+		if err != nil {
+			return nil, err.Err
+		}
+		res := modelsListResponse{}
+		if err := json.Unmarshal(b, &res); err != nil {
+			return nil, err
+		}
+		for _, model := range res.Models {
+			// registered models have workers, so they are considered ready
+			models = append(models, interfaces.ServerModelInfo{
+				Name:  model.ModelName,
+				State: interfaces.ServerModelState_READY,
+			})
+		}
+		if res.NextPageToken == "" {
+			return models, nil
+		}
+		nextPageToken = res.NextPageToken
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package torchserve
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
+)
+
+type fakeTorchServe struct {
+	mu     sync.Mutex
+	models map[string]bool
+}
+
+func (f *fakeTorchServe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/ping":
+		_, _ = w.Write([]byte(`{"status":"Healthy"}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/models":
+		name := r.URL.Query().Get("model_name")
+		if name == "fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"code":500,"type":"InternalServerException","message":"Failed to load model"}`))
+			return
+		}
+		if f.models[name] {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code":409,"type":"ConflictStatusException","message":"Model version 1 is already registered"}`))
+			return
+		}
+		f.models[name] = true
+		_, _ = w.Write([]byte(`{"status":"Model registered"}`))
+	case r.Method == http.MethodDelete:
+		name := r.URL.Path[len("/models/"):]
+		if !f.models[name] {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"type":"ModelNotFoundException","message":"Model not found"}`))
+			return
+		}
+		delete(f.models, name)
+		_, _ = w.Write([]byte(`{"status":"Model unregistered"}`))
+	case r.Method == http.MethodGet && r.URL.Path == "/models":
+		// one model per page to check pagination
+		res := modelsListResponse{}
+		token := r.URL.Query().Get("next_page_token")
+		names := []string{"a", "b"}
+		idx := 0
+		if token != "" {
+			idx, _ = strconv.Atoi(token)
+		}
+		res.Models = []modelEntry{{ModelName: names[idx]}}
+		if idx+1 < len(names) {
+			res.NextPageToken = strconv.Itoa(idx + 1)
+		}
+		b, _ := json.Marshal(res)
+		_, _ = w.Write(b)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func createTestClient(t *testing.T, handler http.Handler) *TorchServeClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, _ := strconv.Atoi(portStr)
+	// inference and management api are served by the same test server
+	return NewTorchServeClient(GetTorchServeConfigWithDefaults(host, port, port), log.New())
+}
+
+func TestTorchServeLoadUnload(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fake := &fakeTorchServe{models: map[string]bool{}}
+	client := createTestClient(t, fake)
+
+	g.Expect(client.Live()).To(BeNil())
+
+	g.Expect(client.LoadModel("iris")).To(BeNil())
+	g.Expect(fake.models["iris"]).To(BeTrue())
+	// already registered
+	g.Expect(client.LoadModel("iris")).To(BeNil())
+
+	err := client.LoadModel("fail")
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(err.ErrCode).To(Equal(http.StatusInternalServerError))
+	g.Expect(err.Err.Error()).To(ContainSubstring("Failed to load model"))
+
+	g.Expect(client.UnloadModel("iris")).To(BeNil())
+	g.Expect(fake.models).ToNot(HaveKey("iris"))
+	err = client.UnloadModel("iris")
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(err.IsNotFound()).To(BeTrue())
+}
+
+func TestTorchServeGetModels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := createTestClient(t, &fakeTorchServe{models: map[string]bool{}})
+	models, err := client.GetModels()
+	g.Expect(err).To(BeNil())
+	g.Expect(models).To(Equal([]interfaces.ServerModelInfo{
+		{Name: "a", State: interfaces.ServerModelState_READY},
+		{Name: "b", State: interfaces.ServerModelState_READY},
+	}))
+}
+
+func TestTorchServeNotLive(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := createTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	g.Expect(client.Live()).To(Equal(interfaces.ErrServerNotReady))
+
+	client = NewTorchServeClient(GetTorchServeConfigWithDefaults("127.0.0.1", 1, 1), log.New())
+	err := client.Live()
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(err).ToNot(Equal(interfaces.ErrServerNotReady))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package payloadlog
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/producer"
+	seldonkafka "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// Payloads are written as CloudEvents in kafka binary content mode, i.e. the event attributes
+// are kafka headers and the message value is the (json) payload itself.
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/bindings/kafka-protocol-binding.md
+const (
+	RequestEventType  = "io.seldon.serving.inference.request"
+	ResponseEventType = "io.seldon.serving.inference.response"
+
+	cloudEventsSpecVersion = "1.0"
+	contentTypeJSON        = "application/json"
+
+	CeSpecVersionHeader = "ce_specversion"
+	CeIdHeader          = "ce_id"
+	CeSourceHeader      = "ce_source"
+	CeTypeHeader        = "ce_type"
+	CeTimeHeader        = "ce_time"
+	CeModelIdHeader     = "ce_modelid"
+	CeRequestIdHeader   = "ce_requestid"
+	CeMethodHeader      = "ce_method"
+	ContentTypeHeader   = "content-type"
+)
+
+// Payload is an inference request or response in the open inference protocol json format
+type Payload struct {
+	ModelName string
+	RequestId string
+	EventType string
+	Method    string
+	Body      []byte
+}
+
+type PayloadLogger interface {
+	Log(payload *Payload)
+}
+
+type KafkaPayloadLogger struct {
+	producer   *producer.KafkaProducer
+	topicNamer *seldonkafka.TopicNamer
+	source     string
+}
+
+func NewKafkaPayloadLogger(
+	kafkaProducer *producer.KafkaProducer,
+	topicPrefix string,
+	namespace string,
+	serverName string,
+) (*KafkaPayloadLogger, error) {
+	topicNamer, err := seldonkafka.NewTopicNamer(namespace, topicPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaPayloadLogger{
+		producer:   kafkaProducer,
+		topicNamer: topicNamer,
+		source:     fmt.Sprintf("/seldon/%s/servers/%s", namespace, serverName),
+	}, nil
+}
+
+// Log is asynchronous and never fails the inference request; failures are only logged
+func (pl *KafkaPayloadLogger) Log(payload *Payload) {
+	// all versions of a model log to the same topic
+	modelName, _, err := util.GetOrignalModelNameAndVersion(payload.ModelName)
+	if err != nil {
+		modelName = payload.ModelName
+	}
+	topic := pl.topicNamer.GetModelTopicPayloads(modelName)
+	pl.producer.Produce(createPayloadMessage(topic, pl.source, payload, time.Now()))
+}
+
+func createPayloadMessage(topic string, source string, payload *Payload, eventTime time.Time) *kafka.Message {
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            []byte(payload.RequestId),
+		Value:          payload.Body,
+		Headers: []kafka.Header{
+			{Key: CeSpecVersionHeader, Value: []byte(cloudEventsSpecVersion)},
+			{Key: CeIdHeader, Value: []byte(util.CreateRequestId())},
+			{Key: CeSourceHeader, Value: []byte(source)},
+			{Key: CeTypeHeader, Value: []byte(payload.EventType)},
+			{Key: CeTimeHeader, Value: []byte(eventTime.UTC().Format(time.RFC3339Nano))},
+			{Key: CeModelIdHeader, Value: []byte(payload.ModelName)},
+			{Key: CeRequestIdHeader, Value: []byte(payload.RequestId)},
+			{Key: CeMethodHeader, Value: []byte(payload.Method)},
+			{Key: ContentTypeHeader, Value: []byte(contentTypeJSON)},
+		},
+	}
+}
+
+// Sample decides whether the payloads of a request are logged
+func Sample(spec *scheduler.PayloadLoggingSpec) bool {
+	if spec.GetSamplePercent() >= 100 {
+		return true
+	}
+	return uint32(rand.Intn(100)) < spec.GetSamplePercent()
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package payloadlog
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestCreatePayloadMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	eventTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	payload := &Payload{
+		ModelName: "iris_1",
+		RequestId: "1234",
+		EventType: RequestEventType,
+		Method:    "rest",
+		Body:      []byte(`{"inputs":[]}`),
+	}
+	msg := createPayloadMessage("seldon.default.model.iris.payloads", "/seldon/default/servers/mlserver", payload, eventTime)
+
+	g.Expect(*msg.TopicPartition.Topic).To(Equal("seldon.default.model.iris.payloads"))
+	g.Expect(string(msg.Key)).To(Equal("1234"))
+	g.Expect(msg.Value).To(Equal(payload.Body))
+	headers := make(map[string]string)
+	for _, header := range msg.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	g.Expect(headers[CeIdHeader]).ToNot(BeEmpty())
+	delete(headers, CeIdHeader)
+	g.Expect(headers).To(Equal(map[string]string{
+		CeSpecVersionHeader: "1.0",
+		CeSourceHeader:      "/seldon/default/servers/mlserver",
+		CeTypeHeader:        RequestEventType,
+		CeTimeHeader:        "2024-01-02T03:04:05Z",
+		CeModelIdHeader:     "iris_1",
+		CeRequestIdHeader:   "1234",
+		CeMethodHeader:      "rest",
+		ContentTypeHeader:   "application/json",
+	}))
+}
+
+func TestSample(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		spec          *scheduler.PayloadLoggingSpec
+		expectedMin   int
+		expectedMax   int
+		numberOfCalls int
+	}
+
+	tests := []test{
+		{
+			name:          "all",
+			spec:          &scheduler.PayloadLoggingSpec{SamplePercent: 100},
+			expectedMin:   1000,
+			expectedMax:   1000,
+			numberOfCalls: 1000,
+		},
+		{
+			name:          "none",
+			spec:          &scheduler.PayloadLoggingSpec{SamplePercent: 0},
+			expectedMin:   0,
+			expectedMax:   0,
+			numberOfCalls: 1000,
+		},
+		{
+			name:          "half",
+			spec:          &scheduler.PayloadLoggingSpec{SamplePercent: 50},
+			expectedMin:   350,
+			expectedMax:   650,
+			numberOfCalls: 1000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sampled := 0
+			for range test.numberOfCalls {
+				if Sample(test.spec) {
+					sampled++
+				}
+			}
+			g.Expect(sampled).To(BeNumerically(">=", test.expectedMin))
+			g.Expect(sampled).To(BeNumerically("<=", test.expectedMax))
+		})
+	}
+}
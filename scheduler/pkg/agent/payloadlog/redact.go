@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package payloadlog
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+const (
+	inputsField  = "inputs"
+	outputsField = "outputs"
+	nameField    = "name"
+	dataField    = "data"
+)
+
+func toSet(tensorNames []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(tensorNames))
+	for _, name := range tensorNames {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// RedactJSON removes the data of the named tensors from an open inference protocol json payload,
+// keeping their name, shape and datatype. An error is returned if the payload can't be parsed
+// so it is not logged unredacted.
+func RedactJSON(body []byte, redactTensors []string) ([]byte, error) {
+	if len(redactTensors) == 0 {
+		return body, nil
+	}
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	redact := toSet(redactTensors)
+	for _, field := range []string{inputsField, outputsField} {
+		raw, ok := payload[field]
+		if !ok {
+			continue
+		}
+		var tensors []map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &tensors); err != nil {
+			return nil, err
+		}
+		for _, tensor := range tensors {
+			var name string
+			if err := json.Unmarshal(tensor[nameField], &name); err != nil {
+				return nil, err
+			}
+			if _, ok := redact[name]; ok {
+				delete(tensor, dataField)
+			}
+		}
+		redacted, err := json.Marshal(tensors)
+		if err != nil {
+			return nil, err
+		}
+		payload[field] = redacted
+	}
+	return json.Marshal(payload)
+}
+
+// MarshalInferRequest converts a grpc request to json so all logged payloads share the same format
+func MarshalInferRequest(r *v2.ModelInferRequest, redactTensors []string) ([]byte, error) {
+	if len(redactTensors) > 0 {
+		redact := toSet(redactTensors)
+		r = proto.Clone(r).(*v2.ModelInferRequest)
+		for idx, input := range r.Inputs {
+			if _, ok := redact[input.Name]; ok {
+				input.Contents = nil
+				if idx < len(r.RawInputContents) {
+					r.RawInputContents[idx] = nil
+				}
+			}
+		}
+	}
+	return protojson.Marshal(r)
+}
+
+func MarshalInferResponse(r *v2.ModelInferResponse, redactTensors []string) ([]byte, error) {
+	if len(redactTensors) > 0 {
+		redact := toSet(redactTensors)
+		r = proto.Clone(r).(*v2.ModelInferResponse)
+		for idx, output := range r.Outputs {
+			if _, ok := redact[output.Name]; ok {
+				output.Contents = nil
+				if idx < len(r.RawOutputContents) {
+					r.RawOutputContents[idx] = nil
+				}
+			}
+		}
+	}
+	return protojson.Marshal(r)
+}
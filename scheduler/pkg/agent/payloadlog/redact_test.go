@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package payloadlog
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+func TestRedactJSON(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		body          string
+		redactTensors []string
+		expected      string
+		err           bool
+	}
+
+	tests := []test{
+		{
+			name:     "no redaction",
+			body:     `not json`,
+			expected: `not json`,
+		},
+		{
+			name:          "redact input",
+			body:          `{"id":"1","inputs":[{"name":"ssn","shape":[1],"datatype":"BYTES","data":["123"]},{"name":"age","shape":[1],"datatype":"INT32","data":[42]}]}`,
+			redactTensors: []string{"ssn"},
+			expected:      `{"id":"1","inputs":[{"datatype":"BYTES","name":"ssn","shape":[1]},{"data":[42],"datatype":"INT32","name":"age","shape":[1]}]}`,
+		},
+		{
+			name:          "redact output",
+			body:          `{"model_name":"iris","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[2]}]}`,
+			redactTensors: []string{"predict"},
+			expected:      `{"model_name":"iris","outputs":[{"datatype":"INT64","name":"predict","shape":[1]}]}`,
+		},
+		{
+			name:          "not json",
+			body:          `not json`,
+			redactTensors: []string{"ssn"},
+			err:           true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			redacted, err := RedactJSON([]byte(test.body), test.redactTensors)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(string(redacted)).To(Equal(test.expected))
+			}
+		})
+	}
+}
+
+func TestMarshalInferRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	createRequest := func() *v2.ModelInferRequest {
+		return &v2.ModelInferRequest{
+			ModelName: "iris",
+			Inputs: []*v2.ModelInferRequest_InferInputTensor{
+				{Name: "ssn", Datatype: "INT32", Shape: []int64{1}, Contents: &v2.InferTensorContents{IntContents: []int32{123}}},
+				{Name: "age", Datatype: "INT32", Shape: []int64{1}, Contents: &v2.InferTensorContents{IntContents: []int32{42}}},
+			},
+			RawInputContents: [][]byte{{1}, {2}},
+		}
+	}
+
+	r := createRequest()
+	body, err := MarshalInferRequest(r, []string{"ssn"})
+	g.Expect(err).To(BeNil())
+	logged := &v2.ModelInferRequest{}
+	g.Expect(protojson.Unmarshal(body, logged)).To(BeNil())
+	g.Expect(logged.Inputs[0].Contents).To(BeNil())
+	g.Expect(logged.Inputs[1].Contents.IntContents).To(Equal([]int32{42}))
+	g.Expect(logged.RawInputContents[0]).To(BeEmpty())
+	g.Expect(logged.RawInputContents[1]).To(Equal([]byte{2}))
+	// the request sent to the model is left untouched
+	g.Expect(proto.Equal(r, createRequest())).To(BeTrue())
+}
+
+func TestMarshalInferResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := &v2.ModelInferResponse{
+		ModelName: "iris",
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{Name: "predict", Datatype: "INT64", Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{2}}},
+		},
+	}
+	body, err := MarshalInferResponse(r, []string{"predict"})
+	g.Expect(err).To(BeNil())
+	logged := &v2.ModelInferResponse{}
+	g.Expect(protojson.Unmarshal(body, logged)).To(BeNil())
+	g.Expect(logged.Outputs[0].Name).To(Equal("predict"))
+	g.Expect(logged.Outputs[0].Contents).To(BeNil())
+	g.Expect(r.Outputs[0].Contents.Int64Contents).To(Equal([]int64{2}))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package producer
+
+import (
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	log "github.com/sirupsen/logrus"
+
+	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
+)
+
+const (
+	flushTimeoutMs = 5000
+)
+
+// KafkaProducer is shared by the agent components writing to kafka from the data plane.
+// Messages are produced asynchronously and delivery failures are only logged so they
+// never fail inference requests.
+type KafkaProducer struct {
+	logger   log.FieldLogger
+	producer *kafka.Producer
+	mu       sync.RWMutex
+	closed   bool
+}
+
+func NewKafkaProducer(logger log.FieldLogger, kafkaConfig *kafka_config.KafkaConfig) (*KafkaProducer, error) {
+	logger = logger.WithField("source", "KafkaProducer")
+	producerConfig := kafka_config.CloneKafkaConfigMap(kafkaConfig.Producer)
+	logger.Infof("Creating producer with config %v", kafka_config.WithoutSecrets(producerConfig))
+	producer, err := kafka.NewProducer(&producerConfig)
+	if err != nil {
+		return nil, err
+	}
+	kp := &KafkaProducer{
+		logger:   logger,
+		producer: producer,
+	}
+	go kp.handleEvents()
+	return kp, nil
+}
+
+func (kp *KafkaProducer) handleEvents() {
+	for e := range kp.producer.Events() {
+		switch ev := e.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				kp.logger.WithError(ev.TopicPartition.Error).Errorf("Failed to deliver message to %s", *ev.TopicPartition.Topic)
+			}
+		case kafka.Error:
+			kp.logger.WithError(ev).Warn("Kafka producer error")
+		}
+	}
+}
+
+func (kp *KafkaProducer) Produce(msg *kafka.Message) {
+	kp.mu.RLock()
+	defer kp.mu.RUnlock()
+	if kp.closed {
+		return
+	}
+	if err := kp.producer.Produce(msg, nil); err != nil {
+		kp.logger.WithError(err).Errorf("Failed to produce message to %s", *msg.TopicPartition.Topic)
+	}
+}
+
+func (kp *KafkaProducer) Close() {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	if kp.closed {
+		return
+	}
+	kp.closed = true
+	remaining := kp.producer.Flush(flushTimeoutMs)
+	if remaining > 0 {
+		kp.logger.Warnf("Closing producer with %d messages not delivered", remaining)
+	}
+	kp.producer.Close()
+}
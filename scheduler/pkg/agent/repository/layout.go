@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package repository
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// FindNumberedVersionFolder finds the folder of the requested version, or of the highest version if none
+// is requested, in artifacts with numbered version folders. If none is requested and there are no version
+// folders, the artifact itself is the model.
+func FindNumberedVersionFolder(modelPath string, version *uint32) (string, bool, error) {
+	entries, err := os.ReadDir(modelPath)
+	if err != nil {
+		return "", false, err
+	}
+	highestVersion := -1
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		v, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		if version != nil && v == int(*version) {
+			return filepath.Join(modelPath, entry.Name()), true, nil
+		}
+		if v > highestVersion {
+			highestVersion = v
+		}
+	}
+	if version != nil {
+		return "", false, fmt.Errorf("Failed to find requested version %d in path %s", *version, modelPath)
+	}
+	if highestVersion >= 0 {
+		return filepath.Join(modelPath, strconv.Itoa(highestVersion)), true, nil
+	}
+	return modelPath, false, nil
+}
+
+// PromoteVersionFolder replaces the model folder with the version folder written by the agent inside it,
+// for servers which can only load models from folders named after the model (e.g. TorchServe)
+func PromoteVersionFolder(modelRepoPath string) error {
+	versionPath := filepath.Join(modelRepoPath, strconv.Itoa(int(util.GetPinnedModelVersion())))
+	if _, err := os.Stat(versionPath); err != nil {
+		return err
+	}
+	tmpPath := modelRepoPath + ".tmp"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return err
+	}
+	if err := os.Rename(modelRepoPath, tmpPath); err != nil {
+		return err
+	}
+	if err := os.Rename(filepath.Join(tmpPath, filepath.Base(versionPath)), modelRepoPath); err != nil {
+		return err
+	}
+	return os.RemoveAll(tmpPath)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFindNumberedVersionFolder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name           string
+		folders        []string
+		version        *uint32
+		found          bool
+		expectedFolder string
+		error          bool
+	}
+
+	getVersion := func(version uint32) *uint32 {
+		return &version
+	}
+	tests := []test{
+		{
+			name:           "requested version",
+			folders:        []string{"1", "2"},
+			version:        getVersion(1),
+			found:          true,
+			expectedFolder: "1",
+		},
+		{
+			name:           "highest version",
+			folders:        []string{"1", "3", "2", "other"},
+			found:          true,
+			expectedFolder: "3",
+		},
+		{
+			name:           "no version folders",
+			folders:        []string{"other"},
+			found:          false,
+			expectedFolder: "",
+		},
+		{
+			name:    "missing requested version",
+			folders: []string{"1"},
+			version: getVersion(2),
+			error:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rclonePath := t.TempDir()
+			for _, folder := range test.folders {
+				g.Expect(os.MkdirAll(filepath.Join(rclonePath, folder), os.ModePerm)).To(BeNil())
+			}
+			folder, found, err := FindNumberedVersionFolder(rclonePath, test.version)
+			if test.error {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(found).To(Equal(test.found))
+				g.Expect(folder).To(Equal(filepath.Join(rclonePath, test.expectedFolder)))
+			}
+		})
+	}
+}
+
+func TestPromoteVersionFolder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	modelPath := filepath.Join(t.TempDir(), "iris")
+	g.Expect(os.MkdirAll(filepath.Join(modelPath, "1", "MAR-INF"), os.ModePerm)).To(BeNil())
+	g.Expect(os.WriteFile(filepath.Join(modelPath, "1", "MAR-INF", "MANIFEST.json"), []byte("{}"), os.ModePerm)).To(BeNil())
+
+	g.Expect(PromoteVersionFolder(modelPath)).To(BeNil())
+	_, err := os.Stat(filepath.Join(modelPath, "MAR-INF", "MANIFEST.json"))
+	g.Expect(err).To(BeNil())
+	_, err = os.Stat(filepath.Join(modelPath, "1"))
+	g.Expect(os.IsNotExist(err)).To(BeTrue())
+	_, err = os.Stat(modelPath + ".tmp")
+	g.Expect(os.IsNotExist(err)).To(BeTrue())
+
+	// no version folder to promote
+	g.Expect(PromoteVersionFolder(modelPath)).ToNot(BeNil())
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package openai
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository"
+)
+
+const (
+	AdapterConfigFile = "adapter_config.json"
+)
+
+// OpenAIRepositoryHandler lays out (LoRA) adapter artifacts, i.e. folders with an adapter_config.json
+// in the PEFT format, in a folder named after the model so the server can load them from there.
+type OpenAIRepositoryHandler struct {
+	logger log.FieldLogger
+}
+
+func NewOpenAIRepositoryHandler(logger log.FieldLogger) *OpenAIRepositoryHandler {
+	return &OpenAIRepositoryHandler{logger: logger.WithField("name", "OpenAIRepositoryHandler")}
+}
+
+func (o *OpenAIRepositoryHandler) FindModelVersionFolder(_ string, version *uint32, path string) (string, bool, error) {
+	return repository.FindNumberedVersionFolder(path, version)
+}
+
+// Adapters are loaded as they are, but we check early that the artifact is one
+func (o *OpenAIRepositoryHandler) UpdateModelVersion(modelName string, _ uint32, path string, _ *scheduler.ModelSpec) error {
+	if _, err := os.Stat(filepath.Join(path, AdapterConfigFile)); err != nil {
+		return fmt.Errorf("model %s is not an adapter, %s not found: %w", modelName, AdapterConfigFile, err)
+	}
+	return nil
+}
+
+// The control plane loads adapters from the model folder
+func (o *OpenAIRepositoryHandler) UpdateModelRepository(_ string, _ string, _ bool, modelRepoPath string) error {
+	return repository.PromoteVersionFolder(modelRepoPath)
+}
+
+func (o *OpenAIRepositoryHandler) SetExplainer(modelRepoPath string, explainerSpec *scheduler.ExplainerSpec, envoyHost string, envoyPort int) error {
+	return nil
+}
+
+func (o *OpenAIRepositoryHandler) SetLlm(modelRepoPath string, llmSpec *scheduler.LlmSpec, envoyHost string, envoyPort int) error {
+	return nil
+}
+
+func (o *OpenAIRepositoryHandler) SetExtraParameters(modelRepoPath string, parameters []*scheduler.ParameterSpec) error {
+	return nil
+}
+
+// Adapters share the base model of the server
+func (o *OpenAIRepositoryHandler) GetModelRuntimeInfo(_ string) (*scheduler.ModelRuntimeInfo, error) {
+	return &scheduler.ModelRuntimeInfo{}, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package openai
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestUpdateModelVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name  string
+		files []string
+		error bool
+	}
+
+	tests := []test{
+		{
+			name:  "adapter",
+			files: []string{AdapterConfigFile, "adapter_model.safetensors"},
+		},
+		{
+			name:  "not an adapter",
+			files: []string{"model.safetensors"},
+			error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := t.TempDir()
+			for _, file := range test.files {
+				g.Expect(os.WriteFile(filepath.Join(path, file), []byte("{}"), os.ModePerm)).To(BeNil())
+			}
+			handler := NewOpenAIRepositoryHandler(log.New())
+			err := handler.UpdateModelVersion("sql-lora_1", 1, path, &scheduler.ModelSpec{})
+			if test.error {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package torchserve
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/repository"
+)
+
+const (
+	ManifestFolder    = "MAR-INF"
+	ManifestFile      = "MANIFEST.json"
+	ModelArchiveExt   = ".mar"
+	manifestModelKey  = "model"
+	modelNameKey      = "modelName"
+	modelVersionKey   = "modelVersion"
+	manifestRuntime   = "python"
+	handlerParameter  = "handler"
+	serializedFileKey = "serializedFile"
+)
+
+// TorchServeRepositoryHandler lays out artifacts in the TorchServe "no archive" format, i.e. a model
+// folder with MAR-INF/MANIFEST.json, named after the model in the model store.
+// Artifacts can be a model archive (.mar) or an already extracted one.
+type TorchServeRepositoryHandler struct {
+	logger log.FieldLogger
+}
+
+func NewTorchServeRepositoryHandler(logger log.FieldLogger) *TorchServeRepositoryHandler {
+	return &TorchServeRepositoryHandler{logger: logger.WithField("name", "TorchServeRepositoryHandler")}
+}
+
+func (t *TorchServeRepositoryHandler) FindModelVersionFolder(_ string, version *uint32, path string) (string, bool, error) {
+	return repository.FindNumberedVersionFolder(path, version)
+}
+
+// Extract the model archive if needed and set the model name and version in the manifest
+func (t *TorchServeRepositoryHandler) UpdateModelVersion(modelName string, version uint32, path string, modelSpec *scheduler.ModelSpec) error {
+	manifestPath := filepath.Join(path, ManifestFolder, ManifestFile)
+	if _, err := os.Stat(manifestPath); err != nil {
+		archivePath, err := findModelArchive(path)
+		if err != nil {
+			return err
+		}
+		if archivePath != "" {
+			t.logger.Infof("Extracting model archive %s", archivePath)
+			if err := extractModelArchive(archivePath, path); err != nil {
+				return err
+			}
+			if err := os.Remove(archivePath); err != nil {
+				return err
+			}
+		}
+	}
+
+	manifest, err := loadManifest(manifestPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		manifest, err = createManifest(modelName, modelSpec.GetParameters())
+		if err != nil {
+			return err
+		}
+	}
+	model, ok := manifest[manifestModelKey].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid TorchServe manifest %s for model %s", manifestPath, modelName)
+	}
+	model[modelNameKey] = modelName
+	model[modelVersionKey] = fmt.Sprintf("%d", version)
+	return saveManifest(manifestPath, manifest)
+}
+
+// TorchServe only registers folders placed directly in its model store
+func (t *TorchServeRepositoryHandler) UpdateModelRepository(_ string, _ string, _ bool, modelRepoPath string) error {
+	return repository.PromoteVersionFolder(modelRepoPath)
+}
+
+func (t *TorchServeRepositoryHandler) SetExplainer(modelRepoPath string, explainerSpec *scheduler.ExplainerSpec, envoyHost string, envoyPort int) error {
+	return nil
+}
+
+func (t *TorchServeRepositoryHandler) SetLlm(modelRepoPath string, llmSpec *scheduler.LlmSpec, envoyHost string, envoyPort int) error {
+	return nil
+}
+
+func (t *TorchServeRepositoryHandler) SetExtraParameters(modelRepoPath string, parameters []*scheduler.ParameterSpec) error {
+	return nil
+}
+
+// Models are registered with a single worker
+func (t *TorchServeRepositoryHandler) GetModelRuntimeInfo(_ string) (*scheduler.ModelRuntimeInfo, error) {
+	return &scheduler.ModelRuntimeInfo{}, nil
+}
+
+func findModelArchive(path string) (string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return "", err
+	}
+	var archives []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ModelArchiveExt) {
+			archives = append(archives, filepath.Join(path, entry.Name()))
+		}
+	}
+	switch len(archives) {
+	case 0:
+		return "", nil
+	case 1:
+		return archives[0], nil
+	default:
+		return "", fmt.Errorf("found multiple model archives in %s: %v", path, archives)
+	}
+}
+
+// model archives are zip files
+func extractModelArchive(archivePath string, dst string) error {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		target := filepath.Join(dst, file.Name)
+		if !strings.HasPrefix(target, filepath.Clean(dst)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file path %s in model archive %s", file.Name, archivePath)
+		}
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := extractFile(file, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractFile(file *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return err
+	}
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	_, err = io.Copy(dst, src)
+	return err
+}
+
+func loadManifest(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest := map[string]interface{}{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Artifacts without a manifest need the handler to be passed as a model parameter
+func createManifest(modelName string, parameters []*scheduler.ParameterSpec) (map[string]interface{}, error) {
+	model := map[string]interface{}{
+		modelNameKey: modelName,
+	}
+	for _, parameter := range parameters {
+		switch parameter.Name {
+		case handlerParameter:
+			model[handlerParameter] = parameter.Value
+		case serializedFileKey:
+			model[serializedFileKey] = parameter.Value
+		}
+	}
+	if _, ok := model[handlerParameter]; !ok {
+		return nil, fmt.Errorf("model %s has no %s/%s or %s archive and no %s parameter", modelName, ManifestFolder, ManifestFile, ModelArchiveExt, handlerParameter)
+	}
+	return map[string]interface{}{
+		"runtime":        manifestRuntime,
+		manifestModelKey: model,
+	}, nil
+}
+
+func saveManifest(path string, manifest map[string]interface{}) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, fs.ModePerm)
+}
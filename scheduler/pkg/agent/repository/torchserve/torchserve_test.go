@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package torchserve
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func writeModelArchive(t *testing.T, path string, files map[string]string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateModelVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		archive          map[string]string
+		files            map[string]string
+		parameters       []*scheduler.ParameterSpec
+		expectedManifest map[string]interface{}
+		expectedFiles    []string
+		error            bool
+	}
+
+	tests := []test{
+		{
+			name: "model archive",
+			archive: map[string]string{
+				"MAR-INF/MANIFEST.json": `{"runtime":"python","model":{"modelName":"mnist","handler":"mnist_handler.py","modelVersion":"1.0"}}`,
+				"mnist_handler.py":      "",
+			},
+			expectedManifest: map[string]interface{}{
+				"runtime": "python",
+				"model": map[string]interface{}{
+					"modelName":    "iris_1",
+					"handler":      "mnist_handler.py",
+					"modelVersion": "2",
+				},
+			},
+			expectedFiles: []string{"mnist_handler.py"},
+		},
+		{
+			name: "extracted archive",
+			files: map[string]string{
+				"MAR-INF/MANIFEST.json": `{"runtime":"python","model":{"modelName":"mnist","handler":"image_classifier"}}`,
+			},
+			expectedManifest: map[string]interface{}{
+				"runtime": "python",
+				"model": map[string]interface{}{
+					"modelName":    "iris_1",
+					"handler":      "image_classifier",
+					"modelVersion": "2",
+				},
+			},
+		},
+		{
+			name: "manifest from parameters",
+			files: map[string]string{
+				"model.pt": "",
+			},
+			parameters: []*scheduler.ParameterSpec{
+				{Name: "handler", Value: "image_classifier"},
+				{Name: "serializedFile", Value: "model.pt"},
+			},
+			expectedManifest: map[string]interface{}{
+				"runtime": "python",
+				"model": map[string]interface{}{
+					"modelName":      "iris_1",
+					"handler":        "image_classifier",
+					"serializedFile": "model.pt",
+					"modelVersion":   "2",
+				},
+			},
+		},
+		{
+			name: "no manifest and no handler",
+			files: map[string]string{
+				"model.pt": "",
+			},
+			error: true,
+		},
+		{
+			name: "archive with invalid path",
+			archive: map[string]string{
+				"../escape.py": "",
+			},
+			error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := t.TempDir()
+			if test.archive != nil {
+				writeModelArchive(t, filepath.Join(path, "model.mar"), test.archive)
+			}
+			for name, content := range test.files {
+				g.Expect(os.MkdirAll(filepath.Dir(filepath.Join(path, name)), os.ModePerm)).To(BeNil())
+				g.Expect(os.WriteFile(filepath.Join(path, name), []byte(content), os.ModePerm)).To(BeNil())
+			}
+			handler := NewTorchServeRepositoryHandler(log.New())
+			err := handler.UpdateModelVersion("iris_1", 2, path, &scheduler.ModelSpec{Parameters: test.parameters})
+			if test.error {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			manifest, err := loadManifest(filepath.Join(path, ManifestFolder, ManifestFile))
+			g.Expect(err).To(BeNil())
+			g.Expect(manifest).To(Equal(test.expectedManifest))
+			for _, file := range test.expectedFiles {
+				_, err := os.Stat(filepath.Join(path, file))
+				g.Expect(err).To(BeNil())
+			}
+			// the archive is removed once extracted
+			_, err = os.Stat(filepath.Join(path, "model.mar"))
+			g.Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package responsecache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+type entry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// modelCache is a least recently used cache of the responses of one model
+type modelCache struct {
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+func newModelCache() *modelCache {
+	return &modelCache{
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (m *modelCache) remove(element *list.Element) {
+	m.lru.Remove(element)
+	delete(m.entries, element.Value.(*entry).key)
+}
+
+// Cache holds the responses of models with a response cache spec, keyed by internal model name
+// and a hash of the request
+type Cache struct {
+	mu     sync.Mutex
+	models map[string]*modelCache
+	logger log.FieldLogger
+	now    func() time.Time
+}
+
+func NewCache(logger log.FieldLogger) *Cache {
+	return &Cache{
+		models: make(map[string]*modelCache),
+		logger: logger.WithField("source", "ResponseCache"),
+		now:    time.Now,
+	}
+}
+
+// Get returns the cached response for the key, if any and not expired
+func (c *Cache) Get(modelName string, key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	model, ok := c.models[modelName]
+	if !ok {
+		return nil, false
+	}
+	element, ok := model.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if !e.expires.IsZero() && !c.now().Before(e.expires) {
+		model.remove(element)
+		return nil, false
+	}
+	model.lru.MoveToFront(element)
+	return e.value, true
+}
+
+// Put caches the response for the key, evicting the least recently used responses of the model
+// above the max entries of the spec
+func (c *Cache) Put(modelName string, spec *scheduler.ResponseCacheSpec, key string, value []byte) {
+	if spec.GetMaxEntries() == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	model, ok := c.models[modelName]
+	if !ok {
+		model = newModelCache()
+		c.models[modelName] = model
+	}
+	var expires time.Time
+	if spec.GetTtlSeconds() > 0 {
+		expires = c.now().Add(time.Duration(spec.GetTtlSeconds()) * time.Second)
+	}
+	if element, ok := model.entries[key]; ok {
+		e := element.Value.(*entry)
+		e.value = value
+		e.expires = expires
+		model.lru.MoveToFront(element)
+		return
+	}
+	model.entries[key] = model.lru.PushFront(&entry{key: key, value: value, expires: expires})
+	for uint32(model.lru.Len()) > spec.GetMaxEntries() {
+		model.remove(model.lru.Back())
+	}
+}
+
+// Invalidate drops all the cached responses of the model, called when a model version is loaded
+// or unloaded so stale responses are never returned
+func (c *Cache) Invalidate(modelName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if model, ok := c.models[modelName]; ok {
+		c.logger.Debugf("Invalidating %d cached responses for model %s", model.lru.Len(), modelName)
+		delete(c.models, modelName)
+	}
+}
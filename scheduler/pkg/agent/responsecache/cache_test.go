@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package responsecache
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+func TestCacheEviction(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := NewCache(log.New())
+	spec := &scheduler.ResponseCacheSpec{MaxEntries: 2}
+
+	cache.Put("iris_1", spec, "a", []byte("1"))
+	cache.Put("iris_1", spec, "b", []byte("2"))
+	// a becomes the most recently used so b is evicted
+	_, ok := cache.Get("iris_1", "a")
+	g.Expect(ok).To(BeTrue())
+	cache.Put("iris_1", spec, "c", []byte("3"))
+
+	_, ok = cache.Get("iris_1", "b")
+	g.Expect(ok).To(BeFalse())
+	value, ok := cache.Get("iris_1", "a")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(value).To(Equal([]byte("1")))
+	value, ok = cache.Get("iris_1", "c")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(value).To(Equal([]byte("3")))
+
+	// models are cached separately
+	_, ok = cache.Get("iris_2", "a")
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestCacheTTL(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := NewCache(log.New())
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	cache.Put("iris_1", &scheduler.ResponseCacheSpec{MaxEntries: 10, TtlSeconds: 5}, "a", []byte("1"))
+	cache.Put("iris_1", &scheduler.ResponseCacheSpec{MaxEntries: 10}, "b", []byte("2"))
+
+	now = now.Add(4 * time.Second)
+	_, ok := cache.Get("iris_1", "a")
+	g.Expect(ok).To(BeTrue())
+
+	now = now.Add(time.Second)
+	_, ok = cache.Get("iris_1", "a")
+	g.Expect(ok).To(BeFalse())
+	_, ok = cache.Get("iris_1", "b")
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := NewCache(log.New())
+	spec := &scheduler.ResponseCacheSpec{MaxEntries: 10}
+	cache.Put("iris_1", spec, "a", []byte("1"))
+	cache.Put("other_1", spec, "a", []byte("1"))
+
+	cache.Invalidate("iris_1")
+	_, ok := cache.Get("iris_1", "a")
+	g.Expect(ok).To(BeFalse())
+	_, ok = cache.Get("other_1", "a")
+	g.Expect(ok).To(BeTrue())
+
+	// a zero size cache stores nothing
+	cache.Put("iris_1", &scheduler.ResponseCacheSpec{}, "a", []byte("1"))
+	_, ok = cache.Get("iris_1", "a")
+	g.Expect(ok).To(BeFalse())
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package responsecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+// GRPCRequestKey hashes the parameters and tensors of an OIP gRPC inference request, the id and
+// model name are left out as they do not change the response
+func GRPCRequestKey(req *v2.ModelInferRequest) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(&v2.ModelInferRequest{
+		Parameters:       req.Parameters,
+		Inputs:           req.Inputs,
+		Outputs:          req.Outputs,
+		RawInputContents: req.RawInputContents,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func MarshalGRPCResponse(res *v2.ModelInferResponse) ([]byte, error) {
+	return proto.Marshal(res)
+}
+
+// UnmarshalGRPCResponse restores a cached response for the request with the given id
+func UnmarshalGRPCResponse(b []byte, id string) (*v2.ModelInferResponse, error) {
+	res := &v2.ModelInferResponse{}
+	if err := proto.Unmarshal(b, res); err != nil {
+		return nil, err
+	}
+	res.Id = id
+	return res, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package responsecache
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+func createGRPCRequest(id string, values []float32) *v2.ModelInferRequest {
+	return &v2.ModelInferRequest{
+		ModelName: "iris",
+		Id:        id,
+		Parameters: map[string]*v2.InferParameter{
+			"a": {ParameterChoice: &v2.InferParameter_StringParam{StringParam: "x"}},
+			"b": {ParameterChoice: &v2.InferParameter_Int64Param{Int64Param: 1}},
+		},
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{
+				Name:     "predict",
+				Datatype: "FP32",
+				Shape:    []int64{1, int64(len(values))},
+				Contents: &v2.InferTensorContents{Fp32Contents: values},
+			},
+		},
+	}
+}
+
+func TestGRPCRequestKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	key, err := GRPCRequestKey(createGRPCRequest("a", []float32{1, 2}))
+	g.Expect(err).To(BeNil())
+
+	// the id does not change the key
+	other, err := GRPCRequestKey(createGRPCRequest("b", []float32{1, 2}))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).To(Equal(key))
+
+	other, err = GRPCRequestKey(createGRPCRequest("a", []float32{1, 3}))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(Equal(key))
+
+	req := createGRPCRequest("a", []float32{1, 2})
+	req.Parameters["b"] = &v2.InferParameter{ParameterChoice: &v2.InferParameter_Int64Param{Int64Param: 2}}
+	other, err = GRPCRequestKey(req)
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(Equal(key))
+}
+
+func TestGRPCResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	res := &v2.ModelInferResponse{
+		ModelName: "iris_1",
+		Id:        "a",
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{Name: "predict", Datatype: "INT64", Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{2}}},
+		},
+	}
+	b, err := MarshalGRPCResponse(res)
+	g.Expect(err).To(BeNil())
+
+	cached, err := UnmarshalGRPCResponse(b, "b")
+	g.Expect(err).To(BeNil())
+	g.Expect(cached.Id).To(Equal("b"))
+	g.Expect(cached.ModelName).To(Equal("iris_1"))
+	g.Expect(cached.Outputs[0].Contents.Int64Contents).To(Equal([]int64{2}))
+}
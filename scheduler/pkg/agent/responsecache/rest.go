@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package responsecache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const idField = "id"
+
+// RESTRequestKey hashes the path and body of an OIP REST inference request without its id, the
+// top level fields are sorted and whitespace removed so equivalent requests share a key
+func RESTRequestKey(path string, body []byte) (string, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return "", err
+	}
+	delete(fields, idField)
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// RESTResponse restores a cached response body for the request, with the given OIP request id
+func RESTResponse(req *http.Request, body []byte, id string) (*http.Response, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	if id == "" {
+		delete(fields, idField)
+	} else {
+		b, err := json.Marshal(id)
+		if err != nil {
+			return nil, err
+		}
+		fields[idField] = b
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:     strconv.Itoa(http.StatusOK) + " " + http.StatusText(http.StatusOK),
+		StatusCode: http.StatusOK,
+		Proto:      req.Proto,
+		ProtoMajor: req.ProtoMajor,
+		ProtoMinor: req.ProtoMinor,
+		Header: http.Header{
+			"Content-Type":   []string{"application/json"},
+			"Content-Length": []string{strconv.Itoa(len(b))},
+		},
+		Body:          io.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
+// RESTRequestId returns the OIP id of a REST inference request body
+func RESTRequestId(body []byte) string {
+	req := struct {
+		Id string `json:"id"`
+	}{}
+	_ = json.Unmarshal(body, &req)
+	return req.Id
+}
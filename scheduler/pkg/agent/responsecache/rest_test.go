@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package responsecache
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRESTRequestKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := "/v2/models/iris/infer"
+	key, err := RESTRequestKey(path, []byte(`{"id":"a","inputs":[{"name":"predict","shape":[1,2],"datatype":"FP32","data":[1,2]}]}`))
+	g.Expect(err).To(BeNil())
+
+	// the id, field order and whitespace do not change the key
+	other, err := RESTRequestKey(path, []byte(`{ "inputs": [{"name":"predict","shape":[1,2],"datatype":"FP32","data":[1, 2]}], "id": "b" }`))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).To(Equal(key))
+
+	other, err = RESTRequestKey(path, []byte(`{"inputs":[{"name":"predict","shape":[1,2],"datatype":"FP32","data":[1,3]}]}`))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(Equal(key))
+
+	other, err = RESTRequestKey("/v2/models/other/infer", []byte(`{"inputs":[{"name":"predict","shape":[1,2],"datatype":"FP32","data":[1,2]}]}`))
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(Equal(key))
+
+	_, err = RESTRequestKey(path, []byte(`not json`))
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestRESTResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	req, err := http.NewRequest(http.MethodPost, "http://localhost/v2/models/iris/infer", nil)
+	g.Expect(err).To(BeNil())
+	body := []byte(`{"model_name":"iris_1","id":"a","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[2]}]}`)
+
+	type test struct {
+		name     string
+		id       string
+		expected string
+	}
+	tests := []test{
+		{
+			name:     "new id",
+			id:       "b",
+			expected: `{"id":"b","model_name":"iris_1","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[2]}]}`,
+		},
+		{
+			name:     "no id",
+			expected: `{"model_name":"iris_1","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[2]}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := RESTResponse(req, body, test.id)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.StatusCode).To(Equal(http.StatusOK))
+			b, err := io.ReadAll(res.Body)
+			g.Expect(err).To(BeNil())
+			g.Expect(string(b)).To(Equal(test.expected))
+			g.Expect(res.ContentLength).To(Equal(int64(len(b))))
+		})
+	}
+
+	g.Expect(RESTRequestId([]byte(`{"id":"a","inputs":[]}`))).To(Equal("a"))
+	g.Expect(RESTRequestId([]byte(`{"inputs":[]}`))).To(BeEmpty())
+}
@@ -13,6 +13,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -21,16 +22,26 @@ import (
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/admission"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/batching"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/capture"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelscaling"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/payloadlog"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/responsecache"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator/anthropic"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator/openai"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
@@ -39,6 +50,8 @@ const (
 	chatCompletionsPath   = "/chat/completions"
 	embeddingsPath        = "/embeddings"
 	imagesGenerationsPath = "/images/generations"
+	responsesPath         = "/responses"
+	messagesPath          = "/v1/messages" // the Anthropic client adds the API version to the path
 )
 
 type reverseHTTPProxy struct {
@@ -53,6 +66,9 @@ type reverseHTTPProxy struct {
 	metrics                    metrics.AgentMetricsHandler
 	tlsOptions                 util.TLSOptions
 	modelScalingStatsCollector *modelscaling.DataPlaneStatsCollector
+	responseCapturer           capture.ResponseCapturer
+	payloadLogger              payloadlog.PayloadLogger
+	banditRecorder             *bandit.RequestRecorder
 }
 
 // in the case the model is not loaded on server (return 404), we attempt to load it and then retry request
@@ -63,6 +79,16 @@ type lazyModelLoadTransport struct {
 	modelScalingStatsCollector *modelscaling.DataPlaneStatsCollector
 	logger                     log.FieldLogger
 	apiTranslators             map[string]translator.Translator
+	responseCapturer           capture.ResponseCapturer
+	payloadLogger              payloadlog.PayloadLogger
+	banditRecorder             *bandit.RequestRecorder
+	payloadLoggingSpec         func(string) *scheduler.PayloadLoggingSpec
+	batcher                    *batching.Batcher[*batching.RESTRequest, *http.Response]
+	batchingSpec               func(string) *scheduler.BatchingSpec
+	responseCache              *responsecache.Cache
+	responseCacheSpec          func(string) *scheduler.ResponseCacheSpec
+	limiter                    *admission.Limiter
+	concurrencySpec            func(string) *scheduler.ConcurrencySpec
 }
 
 func addRequestIdToResponse(req *http.Request, res *http.Response) {
@@ -161,7 +187,74 @@ func (t *lazyModelLoadTransport) RoundTrip(req *http.Request) (*http.Response, e
 
 	// reset main request body
 	req.Body = io.NopCloser(bytes.NewBuffer(reqOriginalBody))
-	res, err := t.RoundTripper.RoundTrip(req)
+	oipInfer := termination == "" && strings.HasSuffix(req.URL.Path, "/infer")
+	cacheSpec := t.getResponseCacheSpec(internalModelName, oipInfer)
+	res, cacheKey := t.getCachedResponse(req, reqOriginalBody, internalModelName, externalModelName, cacheSpec)
+	if res == nil {
+		var release func()
+		release, err = t.admit(req, internalModelName)
+		if errors.Is(err, admission.ErrQueueFull) {
+			elapsedTime := time.Since(startTime).Seconds()
+			go t.metrics.AddModelInferMetrics(externalModelName, internalModelName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusTooManyRequests))
+			message := fmt.Sprintf("Model %s has too many concurrent requests", internalModelName)
+			return t.createErrorResponse(req, http.StatusTooManyRequests, message), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res, err = t.roundTripWithReload(req, reqOriginalBody, internalModelName, oipInfer)
+		if err != nil {
+			release()
+			return res, err
+		}
+		// the request is in flight until its response has been read
+		res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+		if cacheKey != "" && res.StatusCode == http.StatusOK {
+			t.cacheResponse(res, internalModelName, cacheKey, cacheSpec)
+		}
+	}
+
+	// only OIP inference requests are logged as inference events, not streamed or translated requests
+	if t.payloadLogger != nil && oipInfer {
+		if spec := t.payloadLoggingSpec(internalModelName); spec != nil && payloadlog.Sample(spec) {
+			t.logPayloads(req, res, reqOriginalBody, internalModelName, spec)
+		}
+	}
+
+	// Translate the response from OIP format if needed
+	translated, err := t.TranslateFromOIPIfNeeded(res, termination)
+	if err != nil {
+		// translators can fail before reading the whole response, closing it releases its admission
+		_ = res.Body.Close()
+		message := fmt.Sprintf("Failed to translate response from OIP format: %v", err)
+		return t.createErrorResponse(req, http.StatusInternalServerError, message), nil
+	}
+	res = translated
+
+	addRequestIdToResponse(req, res)
+
+	// the candidate which served a request of a bandit experiment is recorded for the feedback on the request
+	if experimentName := req.Header.Get(util.SeldonBanditHeader); experimentName != "" && t.banditRecorder != nil {
+		t.banditRecorder.Record(experimentName, res.Header.Get(util.RequestIdHeader), externalModelName)
+	}
+
+	if experimentName := req.Header.Get(util.SeldonCaptureHeader); experimentName != "" && t.responseCapturer != nil {
+		t.captureResponse(req, res, experimentName, internalModelName)
+	}
+
+	elapsedTime := time.Since(startTime).Seconds()
+	go t.metrics.AddModelInferMetrics(externalModelName, internalModelName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(res.StatusCode))
+	return res, err
+}
+
+func (t *lazyModelLoadTransport) roundTripWithReload(req *http.Request, reqOriginalBody []byte, internalModelName string, oipInfer bool) (*http.Response, error) {
+	var res *http.Response
+	var err error
+	if spec := t.getBatchingSpec(internalModelName); spec != nil && oipInfer {
+		res, err = t.batcher.Infer(req.Context(), internalModelName, spec, &batching.RESTRequest{Request: req, Body: reqOriginalBody}, t.roundTripBatch)
+	} else {
+		res, err = t.RoundTripper.RoundTrip(req)
+	}
 	if err != nil {
 		t.logger.WithError(err).Error("Failed proxying request")
 		return res, err
@@ -174,7 +267,6 @@ func (t *lazyModelLoadTransport) RoundTrip(req *http.Request) (*http.Response, e
 	// in the case of triton, a request to a model that is not found is considered a bad request
 	// this is likely to increase latency for genuine bad requests as we will retry twice
 	if res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusBadRequest {
-		internalModelName := req.Header.Get(util.SeldonInternalModelHeader)
 		if v2Err := t.loader(internalModelName); v2Err != nil {
 			t.logger.WithError(v2Err).Warnf("cannot load model %s", internalModelName)
 		}
@@ -191,19 +283,176 @@ func (t *lazyModelLoadTransport) RoundTrip(req *http.Request) (*http.Response, e
 			t.logger.Errorf("Got %d response on second attempt", res.StatusCode)
 		}
 	}
+	return res, nil
+}
 
-	// Translate the response from OIP format if needed
-	res, err = t.TranslateFromOIPIfNeeded(res, termination)
+// admit waits for the request to be admitted by the concurrency limits of the model, if any
+func (t *lazyModelLoadTransport) admit(req *http.Request, internalModelName string) (func(), error) {
+	if t.limiter == nil || t.concurrencySpec == nil {
+		return func() {}, nil
+	}
+	release, err := t.limiter.Acquire(req.Context(), internalModelName, t.concurrencySpec(internalModelName), queueObserver(t.modelScalingStatsCollector, t.logger))
+	if errors.Is(err, admission.ErrQueueFull) {
+		rejectedRequestScalingMetrics(t.modelScalingStatsCollector, internalModelName, t.logger)
+	}
+	return release, err
+}
+
+// releaseOnClose releases the admission of a request once its response body is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.release()
+	return err
+}
+
+// queueObserver exports the depth of the queues of models as a scaling signal, nil if models are not autoscaled
+func queueObserver(collector *modelscaling.DataPlaneStatsCollector, logger log.FieldLogger) admission.QueueObserver {
+	if collector == nil {
+		return nil
+	}
+	return func(modelName string, depth uint32) {
+		if err := collector.ScalingMetricsQueued(modelName, depth); err != nil {
+			logger.WithError(err).Warnf("cannot collect scaling stats for model %s", modelName)
+		}
+	}
+}
+
+func rejectedRequestScalingMetrics(collector *modelscaling.DataPlaneStatsCollector, modelName string, logger log.FieldLogger) {
+	if collector == nil {
+		return
+	}
+	if err := collector.ScalingMetricsRejected(modelName); err != nil {
+		logger.WithError(err).Warnf("cannot collect scaling stats for model %s", modelName)
+	}
+}
+
+// only OIP inference responses are cached, translated requests are typically not deterministic
+func (t *lazyModelLoadTransport) getResponseCacheSpec(internalModelName string, oipInfer bool) *scheduler.ResponseCacheSpec {
+	if !oipInfer || t.responseCache == nil || t.responseCacheSpec == nil {
+		return nil
+	}
+	return t.responseCacheSpec(internalModelName)
+}
+
+// getCachedResponse returns the cached response for the request, if any, and the cache key to
+// store the response with, empty if the responses of the model are not cached
+func (t *lazyModelLoadTransport) getCachedResponse(req *http.Request, reqBody []byte, internalModelName string, externalModelName string, spec *scheduler.ResponseCacheSpec) (*http.Response, string) {
+	if spec == nil {
+		return nil, ""
+	}
+	key, err := responsecache.RESTRequestKey(req.URL.Path, reqBody)
 	if err != nil {
-		message := fmt.Sprintf("Failed to translate response from OIP format: %v", err)
-		return t.createErrorResponse(req, http.StatusInternalServerError, message), nil
+		t.logger.WithError(err).Warnf("Failed to hash request of model %s, response not cached", internalModelName)
+		return nil, ""
+	}
+	if body, ok := t.responseCache.Get(internalModelName, key); ok {
+		res, err := responsecache.RESTResponse(req, body, responsecache.RESTRequestId(reqBody))
+		if err == nil {
+			go t.metrics.AddModelResponseCacheMetrics(externalModelName, internalModelName, metrics.MethodTypeRest, true)
+			return res, key
+		}
+		t.logger.WithError(err).Warnf("Failed to restore cached response of model %s", internalModelName)
 	}
+	go t.metrics.AddModelResponseCacheMetrics(externalModelName, internalModelName, metrics.MethodTypeRest, false)
+	return nil, key
+}
 
-	addRequestIdToResponse(req, res)
+// the response is cached decompressed as it is restored with the id of each request, whether it
+// accepts gzip or not
+func (t *lazyModelLoadTransport) cacheResponse(res *http.Response, internalModelName string, key string, spec *scheduler.ResponseCacheSpec) {
+	body, err := readDecompressedBody(res)
+	if err != nil {
+		t.logger.WithError(err).Warnf("Failed to read response of model %s, response not cached", internalModelName)
+		return
+	}
+	t.responseCache.Put(internalModelName, spec, key, body)
+}
 
-	elapsedTime := time.Since(startTime).Seconds()
-	go t.metrics.AddModelInferMetrics(externalModelName, internalModelName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(res.StatusCode))
-	return res, err
+func (t *lazyModelLoadTransport) getBatchingSpec(internalModelName string) *scheduler.BatchingSpec {
+	if t.batcher == nil || t.batchingSpec == nil {
+		return nil
+	}
+	return t.batchingSpec(internalModelName)
+}
+
+func (t *lazyModelLoadTransport) roundTripBatch(ctx context.Context, req *batching.RESTRequest) (*http.Response, error) {
+	return t.RoundTripper.RoundTrip(req.HTTPRequest(ctx))
+}
+
+// the response body can only be read once so it is replaced with a copy
+func readAndResetBody(res *http.Response) ([]byte, error) {
+	if res.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewBuffer(body))
+	return body, err
+}
+
+// readDecompressedBody reads the response body like readAndResetBody, decompressed if the model server gzipped it
+func readDecompressedBody(res *http.Response) ([]byte, error) {
+	body, err := readAndResetBody(res)
+	if err != nil || len(body) == 0 {
+		return body, err
+	}
+	decompressed, _, err := translator.DecompressIfNeeded(&http.Response{Header: res.Header, Body: io.NopCloser(bytes.NewReader(body))})
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(decompressed.Body)
+}
+
+func (t *lazyModelLoadTransport) logPayloads(req *http.Request, res *http.Response, reqBody []byte, internalModelName string, spec *scheduler.PayloadLoggingSpec) {
+	resBody, err := readDecompressedBody(res)
+	if err != nil {
+		t.logger.WithError(err).Warnf("Failed to read response of model %s for payload logging", internalModelName)
+	}
+	requestId := req.Header.Get(util.RequestIdHeaderCanonical)
+	if requestId == "" {
+		requestId = res.Header.Get(util.RequestIdHeaderCanonical)
+	}
+	go func() {
+		for eventType, body := range map[string][]byte{payloadlog.RequestEventType: reqBody, payloadlog.ResponseEventType: resBody} {
+			if len(body) == 0 {
+				continue
+			}
+			redacted, err := payloadlog.RedactJSON(body, spec.GetRedactTensors())
+			if err != nil {
+				t.logger.WithError(err).Warnf("Failed to redact %s of model %s, payload not logged", eventType, internalModelName)
+				continue
+			}
+			t.payloadLogger.Log(&payloadlog.Payload{
+				ModelName: internalModelName,
+				RequestId: requestId,
+				EventType: eventType,
+				Method:    metrics.MethodTypeRest,
+				Body:      redacted,
+			})
+		}
+	}()
+}
+
+func (t *lazyModelLoadTransport) captureResponse(req *http.Request, res *http.Response, experimentName string, internalModelName string) {
+	body, err := readDecompressedBody(res)
+	if err != nil {
+		t.logger.WithError(err).Warnf("Failed to read response of model %s for capture", internalModelName)
+	}
+	captured := &capture.CapturedResponse{
+		Experiment: experimentName,
+		ModelName:  internalModelName,
+		RequestId:  res.Header.Get(util.RequestIdHeaderCanonical),
+		Shadow:     req.Header.Get(util.SeldonShadowHeader) == "true",
+		Method:     metrics.MethodTypeRest,
+		Status:     strconv.Itoa(res.StatusCode),
+		Body:       body,
+	}
+	go t.responseCapturer.Capture(captured)
 }
 
 func (rp *reverseHTTPProxy) addHandlers(proxy http.Handler) http.Handler {
@@ -268,6 +517,8 @@ func (rp *reverseHTTPProxy) Start() error {
 		chatCompletionsPath:   &openai.OpenAIChatCompletionsTranslator{},
 		embeddingsPath:        &openai.OpenAIEmbeddingsTranslator{},
 		imagesGenerationsPath: &openai.OpenAIImagesGenerationsTranslator{},
+		responsesPath:         &openai.OpenAIResponsesTranslator{},
+		messagesPath:          &anthropic.AnthropicMessagesTranslator{},
 	}
 	proxy.Transport = &lazyModelLoadTransport{
 		rp.stateManager.v2Client.LoadModel,
@@ -276,6 +527,16 @@ func (rp *reverseHTTPProxy) Start() error {
 		rp.modelScalingStatsCollector,
 		rp.logger,
 		apiTranslators,
+		rp.responseCapturer,
+		rp.payloadLogger,
+		rp.banditRecorder,
+		rp.stateManager.GetPayloadLoggingSpec,
+		batching.NewBatcher(batching.RESTCodec{}, rp.logger),
+		rp.stateManager.GetBatchingSpec,
+		rp.stateManager.responseCache,
+		rp.stateManager.GetResponseCacheSpec,
+		rp.stateManager.limiter,
+		rp.stateManager.GetConcurrencySpec,
 	}
 	rp.logger.Infof("Start reverse proxy on port %d for %s", rp.servicePort, backend)
 	var tlsConfig *tls.Config
@@ -361,6 +622,9 @@ func NewReverseHTTPProxy(
 	servicePort uint,
 	metrics metrics.AgentMetricsHandler,
 	modelScalingStatsCollector *modelscaling.DataPlaneStatsCollector,
+	responseCapturer capture.ResponseCapturer,
+	payloadLogger payloadlog.PayloadLogger,
+	banditRecorder *bandit.RequestRecorder,
 ) *reverseHTTPProxy {
 	rp := reverseHTTPProxy{
 		logger:                     logger.WithField("Source", "HTTPProxy"),
@@ -369,6 +633,9 @@ func NewReverseHTTPProxy(
 		servicePort:                servicePort,
 		metrics:                    metrics,
 		modelScalingStatsCollector: modelScalingStatsCollector,
+		responseCapturer:           responseCapturer,
+		payloadLogger:              payloadLogger,
+		banditRecorder:             banditRecorder,
 	}
 
 	return &rp
@@ -13,6 +13,7 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -28,12 +29,20 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/admission"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/batching"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/capture"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelscaling"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelserver_controlplane/oip"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/payloadlog"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/responsecache"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
@@ -59,6 +68,10 @@ type reverseGRPCProxy struct {
 	callOptions                []grpc.CallOption
 	tlsOptions                 util.TLSOptions
 	modelScalingStatsCollector *modelscaling.DataPlaneStatsCollector
+	responseCapturer           capture.ResponseCapturer
+	payloadLogger              payloadlog.PayloadLogger
+	banditRecorder             *bandit.RequestRecorder
+	batcher                    *batching.Batcher[*v2.ModelInferRequest, *v2.ModelInferResponse]
 }
 
 func NewReverseGRPCProxy(
@@ -67,6 +80,9 @@ func NewReverseGRPCProxy(
 	backendGRPCServerPort uint,
 	servicePort uint,
 	modelScalingStatsCollector *modelscaling.DataPlaneStatsCollector,
+	responseCapturer capture.ResponseCapturer,
+	payloadLogger payloadlog.PayloadLogger,
+	banditRecorder *bandit.RequestRecorder,
 ) *reverseGRPCProxy {
 	opts := []grpc.CallOption{
 		grpc.MaxCallSendMsgSize(math.MaxInt32),
@@ -80,6 +96,10 @@ func NewReverseGRPCProxy(
 		metrics:                    metricsHandler,
 		callOptions:                opts,
 		modelScalingStatsCollector: modelScalingStatsCollector,
+		responseCapturer:           responseCapturer,
+		payloadLogger:              payloadLogger,
+		banditRecorder:             banditRecorder,
+		batcher:                    batching.NewBatcher(batching.GRPCCodec{}, logger),
 	}
 }
 
@@ -233,7 +253,6 @@ func (rp *reverseGRPCProxy) syncScalingMetrics(internalModelName string) {
 }
 
 func (rp *reverseGRPCProxy) ModelInfer(ctx context.Context, r *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
-	logger := rp.logger.WithField("func", "ModelInfer")
 	internalModelName, externalModelName, err := rp.extractModelNamesFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -258,27 +277,185 @@ func (rp *reverseGRPCProxy) ModelInfer(ctx context.Context, r *v2.ModelInferRequ
 	outgoingCtx, requestId := rp.createOutgoingCtxWithRequestId(ctx)
 
 	var trailer metadata.MD
-	opts := append(rp.callOptions, grpc.Trailer(&trailer))
-	resp, err := rp.getV2GRPCClient().ModelInfer(outgoingCtx, r, opts...)
+	cacheSpec := rp.stateManager.GetResponseCacheSpec(internalModelName)
+	resp, cacheKey := rp.getCachedResponse(r, internalModelName, externalModelName, cacheSpec)
+	if resp == nil {
+		var release func()
+		release, err = rp.admit(ctx, internalModelName)
+		if err == nil {
+			opts := append(rp.callOptions, grpc.Trailer(&trailer))
+			resp, err = rp.modelInfer(outgoingCtx, r, internalModelName, opts)
+			release()
+			if err == nil && cacheKey != "" {
+				rp.cacheResponse(resp, internalModelName, cacheKey, cacheSpec)
+			}
+		}
+	}
+
+	rp.setTrailer(ctx, trailer, requestId)
+	if rp.banditRecorder != nil {
+		rp.recordBanditRequest(ctx, requestId, externalModelName)
+	}
+
+	grpcStatus, _ := status.FromError(err)
+	if rp.responseCapturer != nil {
+		rp.captureResponse(ctx, resp, grpcStatus, internalModelName, requestId)
+	}
+	if rp.payloadLogger != nil {
+		if spec := rp.stateManager.GetPayloadLoggingSpec(internalModelName); spec != nil && payloadlog.Sample(spec) {
+			go rp.logPayloads(r, resp, internalModelName, requestId, spec)
+		}
+	}
+	elapsedTime := time.Since(startTime).Seconds()
+	go rp.metrics.AddModelInferMetrics(externalModelName, internalModelName, metrics.MethodTypeGrpc, elapsedTime, grpcStatus.Code().String())
+	return resp, err
+}
+
+func (rp *reverseGRPCProxy) modelInfer(ctx context.Context, r *v2.ModelInferRequest, internalModelName string, opts []grpc.CallOption) (*v2.ModelInferResponse, error) {
+	logger := rp.logger.WithField("func", "modelInfer")
+	var resp *v2.ModelInferResponse
+	var err error
+	if spec := rp.stateManager.GetBatchingSpec(internalModelName); spec != nil {
+		resp, err = rp.batcher.Infer(ctx, internalModelName, spec, r, rp.modelInferBatch)
+	} else {
+		resp, err = rp.getV2GRPCClient().ModelInfer(ctx, r, opts...)
+	}
 	if err != nil {
-		rp.logger.WithError(err).Error("Failed to run infer request")
+		logger.WithError(err).Error("Failed to run infer request")
 	}
 	if retryForLazyReload(err) {
 		if v2Err := rp.stateManager.v2Client.LoadModel(internalModelName); v2Err != nil {
 			logger.WithError(v2Err).Warnf("error loading model %s", internalModelName)
 		}
-		resp, err = rp.getV2GRPCClient().ModelInfer(outgoingCtx, r, opts...)
+		resp, err = rp.getV2GRPCClient().ModelInfer(ctx, r, opts...)
 		if err != nil {
 			logger.WithError(err).Error("Failed to run infer request on second attempt")
 		}
 	}
+	return resp, err
+}
 
-	rp.setTrailer(ctx, trailer, requestId)
+// admit waits for the request to be admitted by the concurrency limits of the model, if any
+func (rp *reverseGRPCProxy) admit(ctx context.Context, internalModelName string) (func(), error) {
+	spec := rp.stateManager.GetConcurrencySpec(internalModelName)
+	release, err := rp.stateManager.limiter.Acquire(ctx, internalModelName, spec, queueObserver(rp.modelScalingStatsCollector, rp.logger))
+	if err == nil {
+		return release, nil
+	}
+	if errors.Is(err, admission.ErrQueueFull) {
+		rejectedRequestScalingMetrics(rp.modelScalingStatsCollector, internalModelName, rp.logger)
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("Model %s has too many concurrent requests", internalModelName))
+	}
+	return nil, status.FromContextError(err).Err()
+}
 
-	grpcStatus, _ := status.FromError(err)
-	elapsedTime := time.Since(startTime).Seconds()
-	go rp.metrics.AddModelInferMetrics(externalModelName, internalModelName, metrics.MethodTypeGrpc, elapsedTime, grpcStatus.Code().String())
-	return resp, err
+// getCachedResponse returns the cached response for the request, if any, and the cache key to
+// store the response with, empty if the responses of the model are not cached
+func (rp *reverseGRPCProxy) getCachedResponse(r *v2.ModelInferRequest, internalModelName string, externalModelName string, spec *scheduler.ResponseCacheSpec) (*v2.ModelInferResponse, string) {
+	if spec == nil {
+		return nil, ""
+	}
+	logger := rp.logger.WithField("func", "getCachedResponse")
+	key, err := responsecache.GRPCRequestKey(r)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to hash request of model %s, response not cached", internalModelName)
+		return nil, ""
+	}
+	if b, ok := rp.stateManager.responseCache.Get(internalModelName, key); ok {
+		resp, err := responsecache.UnmarshalGRPCResponse(b, r.Id)
+		if err == nil {
+			go rp.metrics.AddModelResponseCacheMetrics(externalModelName, internalModelName, metrics.MethodTypeGrpc, true)
+			return resp, key
+		}
+		logger.WithError(err).Warnf("Failed to restore cached response of model %s", internalModelName)
+	}
+	go rp.metrics.AddModelResponseCacheMetrics(externalModelName, internalModelName, metrics.MethodTypeGrpc, false)
+	return nil, key
+}
+
+func (rp *reverseGRPCProxy) cacheResponse(resp *v2.ModelInferResponse, internalModelName string, key string, spec *scheduler.ResponseCacheSpec) {
+	b, err := responsecache.MarshalGRPCResponse(resp)
+	if err != nil {
+		rp.logger.WithError(err).Warnf("Failed to marshal response of model %s, response not cached", internalModelName)
+		return
+	}
+	rp.stateManager.responseCache.Put(internalModelName, spec, key, b)
+}
+
+// batched requests are sent on behalf of several callers, so the trailers of the model server are not passed on
+func (rp *reverseGRPCProxy) modelInferBatch(ctx context.Context, r *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+	return rp.getV2GRPCClient().ModelInfer(ctx, r, rp.callOptions...)
+}
+
+func (rp *reverseGRPCProxy) logPayloads(r *v2.ModelInferRequest, resp *v2.ModelInferResponse, internalModelName string, requestId string, spec *scheduler.PayloadLoggingSpec) {
+	logger := rp.logger.WithField("func", "logPayloads")
+	reqBody, err := payloadlog.MarshalInferRequest(r, spec.GetRedactTensors())
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to marshal request of model %s, payload not logged", internalModelName)
+	} else {
+		rp.payloadLogger.Log(&payloadlog.Payload{
+			ModelName: internalModelName,
+			RequestId: requestId,
+			EventType: payloadlog.RequestEventType,
+			Method:    metrics.MethodTypeGrpc,
+			Body:      reqBody,
+		})
+	}
+	if resp == nil {
+		return
+	}
+	resBody, err := payloadlog.MarshalInferResponse(resp, spec.GetRedactTensors())
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to marshal response of model %s, payload not logged", internalModelName)
+	} else {
+		rp.payloadLogger.Log(&payloadlog.Payload{
+			ModelName: internalModelName,
+			RequestId: requestId,
+			EventType: payloadlog.ResponseEventType,
+			Method:    metrics.MethodTypeGrpc,
+			Body:      resBody,
+		})
+	}
+}
+
+// recordBanditRequest records the candidate which served a request of a bandit experiment for the feedback on the request
+func (rp *reverseGRPCProxy) recordBanditRequest(ctx context.Context, requestId string, externalModelName string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	if experimentName := extractHeader(util.SeldonBanditHeader, md); experimentName != "" {
+		rp.banditRecorder.Record(experimentName, requestId, externalModelName)
+	}
+}
+
+func (rp *reverseGRPCProxy) captureResponse(ctx context.Context, resp *v2.ModelInferResponse, grpcStatus *status.Status, internalModelName string, requestId string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	experimentName := extractHeader(util.SeldonCaptureHeader, md)
+	if experimentName == "" {
+		return
+	}
+	var body []byte
+	if resp != nil {
+		var err error
+		body, err = proto.Marshal(resp)
+		if err != nil {
+			rp.logger.WithError(err).Warnf("Failed to marshal response of model %s for capture", internalModelName)
+		}
+	}
+	captured := &capture.CapturedResponse{
+		Experiment: experimentName,
+		ModelName:  internalModelName,
+		RequestId:  requestId,
+		Shadow:     extractHeader(util.SeldonShadowHeader, md) == "true",
+		Method:     metrics.MethodTypeGrpc,
+		Status:     grpcStatus.Code().String(),
+		Body:       body,
+	}
+	go rp.responseCapturer.Capture(captured)
 }
 
 type InferMessage interface {
@@ -367,6 +544,15 @@ func (rp *reverseGRPCProxy) ModelStreamInfer(stream v2.GRPCInferenceService_Mode
 		return status.Error(codes.NotFound, fmt.Sprintf("Model %s not found (err: %s)", internalModelName, err))
 	}
 
+	// the whole stream counts as one request in flight
+	release, err := rp.admit(ctx, internalModelName)
+	if err != nil {
+		elapsedTime := time.Since(startTime).Seconds()
+		go rp.metrics.AddModelInferMetrics(externalModelName, internalModelName, metrics.MethodTypeGrpc, elapsedTime, status.Code(err).String())
+		return err
+	}
+	defer release()
+
 	// Create an outgoing context for the proxy call to service from incoming context
 	outgoingCtx, requestId := rp.createOutgoingCtxWithRequestId(ctx)
 
@@ -19,9 +19,13 @@ import (
 	. "github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	pba "github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/internal/testing_utils"
@@ -39,6 +43,7 @@ func setupReverseGRPCService(numModels int, modelPrefix string, backEndGRPCPort,
 	localCacheManager := setupLocalTestManager(numModels, modelPrefix, v2Client, numModels-2, 1)
 	modelScalingStatsCollector := modelscaling.NewDataPlaneStatsCollector(
 		modelscaling.NewModelReplicaLagsKeeper(), modelscaling.NewModelReplicaLastUsedKeeper(),
+		modelscaling.NewModelReplicaQueueDepthKeeper(), modelscaling.NewModelReplicaRejectedKeeper(),
 	)
 	rp := NewReverseGRPCProxy(
 		metricsHandler,
@@ -47,6 +52,9 @@ func setupReverseGRPCService(numModels int, modelPrefix string, backEndGRPCPort,
 		uint(backEndGRPCPort),
 		uint(rpPort),
 		modelScalingStatsCollector,
+		nil,
+		nil,
+		nil,
 	)
 	rp.SetState(localCacheManager)
 	return rp
@@ -287,3 +295,74 @@ func TestCreateOutgoingCtxWithRequestId(t *testing.T) {
 		})
 	}
 }
+
+func TestReverseGRPCResponseCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fakeMetricsHandler := newFakeMetricsHandler()
+	rp := setupReverseGRPCService(0, "dummy_model", 1, 1, 1, fakeMetricsHandler)
+	spec := &scheduler.ResponseCacheSpec{MaxEntries: 10}
+	createRequest := func(id string) *v2.ModelInferRequest {
+		return &v2.ModelInferRequest{
+			Id: id,
+			Inputs: []*v2.ModelInferRequest_InferInputTensor{
+				{Name: "predict", Datatype: "FP32", Shape: []int64{1}, Contents: &v2.InferTensorContents{Fp32Contents: []float32{1}}},
+			},
+		}
+	}
+
+	// responses are not cached without a spec
+	resp, key := rp.getCachedResponse(createRequest("a"), "foo_1", "foo", nil)
+	g.Expect(resp).To(BeNil())
+	g.Expect(key).To(BeEmpty())
+
+	resp, key = rp.getCachedResponse(createRequest("a"), "foo_1", "foo", spec)
+	g.Expect(resp).To(BeNil())
+	g.Expect(key).ToNot(BeEmpty())
+	rp.cacheResponse(&v2.ModelInferResponse{ModelName: "foo_1", Id: "a"}, "foo_1", key, spec)
+
+	resp, _ = rp.getCachedResponse(createRequest("b"), "foo_1", "foo", spec)
+	g.Expect(resp).ToNot(BeNil())
+	g.Expect(resp.ModelName).To(Equal("foo_1"))
+	g.Expect(resp.Id).To(Equal("b"))
+
+	g.Eventually(func() responseCacheStateValue {
+		fakeMetricsHandler.mu.Lock()
+		defer fakeMetricsHandler.mu.Unlock()
+		return fakeMetricsHandler.modelResponseCacheState["foo"]
+	}).Should(Equal(responseCacheStateValue{hits: 1, misses: 1}))
+}
+
+func TestReverseGRPCAdmit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rp := setupReverseGRPCService(0, "dummy_model", 1, 1, 1, newFakeMetricsHandler())
+	_, err := rp.stateManager.modelVersions.addModelVersion(&pba.ModelVersion{
+		Model: &scheduler.Model{
+			Meta: &scheduler.MetaData{Name: "foo_1"},
+			ModelSpec: &scheduler.ModelSpec{
+				ModelRuntimeInfo: getModelRuntimeInfo(1),
+				Concurrency:      &scheduler.ConcurrencySpec{MaxInflight: 1},
+			},
+		},
+		Version: 1,
+	})
+	g.Expect(err).To(BeNil())
+
+	release, err := rp.admit(context.Background(), "foo_1")
+	g.Expect(err).To(BeNil())
+
+	// no queue, so requests over the max in flight are rejected
+	_, err = rp.admit(context.Background(), "foo_1")
+	g.Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+	// models without a concurrency spec are not limited
+	releaseOther, err := rp.admit(context.Background(), "other_1")
+	g.Expect(err).To(BeNil())
+	releaseOther()
+
+	release()
+	release, err = rp.admit(context.Background(), "foo_1")
+	g.Expect(err).To(BeNil())
+	release()
+}
@@ -12,10 +12,12 @@ package agent
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,12 +29,19 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/admission"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/capture"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/internal/testing_utils"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/modelscaling"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/payloadlog"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/responsecache"
 	testing_utils2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/internal/testing_utils"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator/openai"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -133,10 +142,16 @@ type inferModelSateValue struct {
 	code              string
 }
 
+type responseCacheStateValue struct {
+	hits   int
+	misses int
+}
+
 type fakeMetricsHandler struct {
-	modelLoadState  map[string]loadModelSateValue
-	modelInferState map[string]inferModelSateValue
-	mu              *sync.Mutex
+	modelLoadState          map[string]loadModelSateValue
+	modelInferState         map[string]inferModelSateValue
+	modelResponseCacheState map[string]responseCacheStateValue
+	mu                      *sync.Mutex
 }
 
 func (f *fakeMetricsHandler) AddModelHistogramMetricsHandler(baseHandler http.HandlerFunc) http.HandlerFunc {
@@ -172,11 +187,25 @@ func (f *fakeMetricsHandler) AddLoadedModelMetrics(internalModelName string, mem
 func (f fakeMetricsHandler) AddServerReplicaMetrics(memory uint64, memoryWithOvercommit float32) {
 }
 
+func (f *fakeMetricsHandler) AddModelResponseCacheMetrics(externalModelName string, internalModelName string, method string, hit bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state := f.modelResponseCacheState[externalModelName]
+	if hit {
+		state.hits++
+	} else {
+		state.misses++
+	}
+	f.modelResponseCacheState[externalModelName] = state
+}
+
 func newFakeMetricsHandler() *fakeMetricsHandler {
 	return &fakeMetricsHandler{
-		modelLoadState:  map[string]loadModelSateValue{},
-		modelInferState: map[string]inferModelSateValue{},
-		mu:              &sync.Mutex{},
+		modelLoadState:          map[string]loadModelSateValue{},
+		modelInferState:         map[string]inferModelSateValue{},
+		modelResponseCacheState: map[string]responseCacheStateValue{},
+		mu:                      &sync.Mutex{},
 	}
 }
 
@@ -192,6 +221,8 @@ func setupReverseProxy(logger log.FieldLogger, numModels int, modelPrefix string
 	modelScalingStatsCollector := modelscaling.NewDataPlaneStatsCollector(
 		modelscaling.NewModelReplicaLagsKeeper(),
 		modelscaling.NewModelReplicaLastUsedKeeper(),
+		modelscaling.NewModelReplicaQueueDepthKeeper(),
+		modelscaling.NewModelReplicaRejectedKeeper(),
 	)
 	rp := NewReverseHTTPProxy(
 		logger,
@@ -200,6 +231,9 @@ func setupReverseProxy(logger log.FieldLogger, numModels int, modelPrefix string
 		uint(rpPort),
 		metricsHandler,
 		modelScalingStatsCollector,
+		nil,
+		nil,
+		nil,
 	)
 	rp.SetState(localCacheManager)
 	return rp
@@ -454,13 +488,23 @@ func TestRewritePath(t *testing.T) {
 	}
 }
 
+type fakeResponseCapturer struct {
+	captured chan *capture.CapturedResponse
+}
+
+func (f *fakeResponseCapturer) Capture(response *capture.CapturedResponse) {
+	f.captured <- response
+}
+
 func TestLazyLoadRoundTripper(t *testing.T) {
 	g := NewGomegaWithT(t)
 	dummyModel := "foo"
 
 	type test struct {
-		name      string
-		dummyBody []byte
+		name              string
+		dummyBody         []byte
+		captureExperiment string
+		shadow            bool
 	}
 	tests := []test{
 		{
@@ -471,6 +515,17 @@ func TestLazyLoadRoundTripper(t *testing.T) {
 			name:      "empty body",
 			dummyBody: nil,
 		},
+		{
+			name:              "capture response",
+			dummyBody:         []byte{97, 98, 99, 100, 101, 102},
+			captureExperiment: "exp1",
+		},
+		{
+			name:              "capture shadow response",
+			dummyBody:         []byte{97, 98, 99, 100, 101, 102},
+			captureExperiment: "exp1",
+			shadow:            true,
+		},
 	}
 
 	for _, test := range tests {
@@ -514,19 +569,541 @@ func TestLazyLoadRoundTripper(t *testing.T) {
 			httpClient := http.DefaultClient
 			metricsHandler := newFakeMetricsHandler()
 			modelScalingStatsCollector := modelscaling.NewDataPlaneStatsCollector(
-				modelscaling.NewModelReplicaLagsKeeper(), modelscaling.NewModelReplicaLastUsedKeeper())
+				modelscaling.NewModelReplicaLagsKeeper(), modelscaling.NewModelReplicaLastUsedKeeper(),
+				modelscaling.NewModelReplicaQueueDepthKeeper(), modelscaling.NewModelReplicaRejectedKeeper())
+			responseCapturer := &fakeResponseCapturer{captured: make(chan *capture.CapturedResponse, 1)}
 			httpClient.Transport = &lazyModelLoadTransport{
-				loader, http.DefaultTransport, metricsHandler, modelScalingStatsCollector, log.New(), map[string]translator.Translator{},
+				loader, http.DefaultTransport, metricsHandler, modelScalingStatsCollector, log.New(), map[string]translator.Translator{}, responseCapturer, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			}
 			mockMLServerState.setModelServerUnloaded(dummyModel)
 			req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+			if test.captureExperiment != "" {
+				req.Header.Set(util.SeldonCaptureHeader, test.captureExperiment)
+			}
+			if test.shadow {
+				req.Header.Set(util.SeldonShadowHeader, "true")
+			}
+			resp, err := httpClient.Do(req)
+			g.Expect(err).To(BeNil())
+			g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			if test.captureExperiment != "" {
+				body, err := io.ReadAll(resp.Body)
+				g.Expect(err).To(BeNil())
+				var captured *capture.CapturedResponse
+				g.Eventually(responseCapturer.captured).Should(Receive(&captured))
+				g.Expect(captured.Experiment).To(Equal(test.captureExperiment))
+				g.Expect(captured.ModelName).To(Equal(dummyModel))
+				g.Expect(captured.Shadow).To(Equal(test.shadow))
+				g.Expect(captured.Method).To(Equal(metrics.MethodTypeRest))
+				g.Expect(captured.Status).To(Equal(strconv.Itoa(http.StatusOK)))
+				g.Expect(captured.RequestId).To(Equal(resp.Header.Get(util.RequestIdHeaderCanonical)))
+				g.Expect(captured.Body).To(Equal(body))
+			} else {
+				g.Consistently(responseCapturer.captured, 100*time.Millisecond).ShouldNot(Receive())
+			}
+		})
+	}
+}
+
+type fakePayloadLogger struct {
+	logged chan *payloadlog.Payload
+}
+
+func (f *fakePayloadLogger) Log(payload *payloadlog.Payload) {
+	f.logged <- payload
+}
+
+func TestLazyLoadRoundTripperPayloadLogging(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+	requestBody := `{"inputs":[{"data":[1,2],"datatype":"INT32","name":"ssn","shape":[2]}]}`
+
+	type test struct {
+		name             string
+		spec             *scheduler.PayloadLoggingSpec
+		streamRequest    bool
+		expectedPayloads map[string]string
+	}
+	tests := []test{
+		{
+			name:             "payload logging not configured",
+			spec:             nil,
+			expectedPayloads: map[string]string{},
+		},
+		{
+			name: "log all payloads",
+			spec: &scheduler.PayloadLoggingSpec{SamplePercent: 100},
+			expectedPayloads: map[string]string{
+				payloadlog.RequestEventType:  requestBody,
+				payloadlog.ResponseEventType: "Model inference: " + dummyModel,
+			},
+		},
+		{
+			name:             "no payloads sampled",
+			spec:             &scheduler.PayloadLoggingSpec{SamplePercent: 0},
+			expectedPayloads: map[string]string{},
+		},
+		{
+			// the mock server response is not json so can't be redacted and is not logged
+			name: "redact tensors",
+			spec: &scheduler.PayloadLoggingSpec{SamplePercent: 100, RedactTensors: []string{"ssn"}},
+			expectedPayloads: map[string]string{
+				payloadlog.RequestEventType: `{"inputs":[{"datatype":"INT32","name":"ssn","shape":[2]}]}`,
+			},
+		},
+		{
+			name:             "stream requests not logged",
+			spec:             &scheduler.PayloadLoggingSpec{SamplePercent: 100},
+			streamRequest:    true,
+			expectedPayloads: map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockMLServerState := &mockMLServerState{
+				models:         make(map[string]bool),
+				modelsNotFound: make(map[string]bool),
+				mu:             &sync.Mutex{},
+			}
+			serverPort, err := testing_utils2.GetFreePortForTest()
+			if err != nil {
+				t.Fatal(err)
+			}
+			mlserver := setupMockMLServer(mockMLServerState, serverPort)
+			go func() {
+				_ = mlserver.ListenAndServe()
+			}()
+
+			time.Sleep(util.GRPCRetryBackoff)
+
+			defer func() {
+				_ = mlserver.Shutdown(context.Background())
+			}()
+
+			loader := func(model string) *interfaces.ControlPlaneErr {
+				return nil
+			}
+			payloadLogger := &fakePayloadLogger{logged: make(chan *payloadlog.Payload, 2)}
+			payloadLoggingSpec := func(model string) *scheduler.PayloadLoggingSpec {
+				return test.spec
+			}
+			httpClient := http.Client{
+				Transport: &lazyModelLoadTransport{
+					loader, http.DefaultTransport, newFakeMetricsHandler(), nil, log.New(), map[string]translator.Translator{}, nil, payloadLogger, nil, payloadLoggingSpec, nil, nil, nil, nil, nil, nil,
+				},
+			}
+
+			inferUrl := "http://localhost:" + strconv.Itoa(serverPort) + "/v2/models/" + dummyModel + "/infer"
+			if test.streamRequest {
+				inferUrl += "_stream"
+			}
+			req, err := http.NewRequest(http.MethodPost, inferUrl, bytes.NewBufferString(requestBody))
+			g.Expect(err).To(BeNil())
+			req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+			req.Header.Set(util.RequestIdHeader, "1234")
 			resp, err := httpClient.Do(req)
 			g.Expect(err).To(BeNil())
 			g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			body, err := io.ReadAll(resp.Body)
+			g.Expect(err).To(BeNil())
+			if !test.streamRequest {
+				g.Expect(string(body)).To(Equal("Model inference: " + dummyModel))
+			}
+
+			payloads := map[string]string{}
+			for range test.expectedPayloads {
+				var payload *payloadlog.Payload
+				g.Eventually(payloadLogger.logged).Should(Receive(&payload))
+				g.Expect(payload.ModelName).To(Equal(dummyModel))
+				g.Expect(payload.RequestId).To(Equal("1234"))
+				g.Expect(payload.Method).To(Equal(metrics.MethodTypeRest))
+				payloads[payload.EventType] = string(payload.Body)
+			}
+			g.Consistently(payloadLogger.logged, 100*time.Millisecond).ShouldNot(Receive())
+			g.Expect(payloads).To(Equal(test.expectedPayloads))
+		})
+	}
+}
+
+// gzipBody compresses a response body as MLServer does for clients accepting gzip
+func gzipBody(g *WithT, body string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(body))
+	g.Expect(err).To(BeNil())
+	g.Expect(gz.Close()).To(BeNil())
+	return buf.Bytes()
+}
+
+func TestLazyLoadRoundTripperPayloadLoggingGzip(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+	responseBody := `{"model_name":"foo_1","outputs":[{"name":"ssn","shape":[1],"datatype":"INT64","data":[1]}]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(gzipBody(g, responseBody))
+	}))
+	defer server.Close()
+
+	loader := func(model string) *interfaces.ControlPlaneErr {
+		return nil
+	}
+	payloadLogger := &fakePayloadLogger{logged: make(chan *payloadlog.Payload, 2)}
+	payloadLoggingSpec := func(model string) *scheduler.PayloadLoggingSpec {
+		return &scheduler.PayloadLoggingSpec{SamplePercent: 100, RedactTensors: []string{"ssn"}}
+	}
+	httpClient := http.Client{
+		Transport: &lazyModelLoadTransport{
+			loader, http.DefaultTransport, newFakeMetricsHandler(), nil, log.New(), map[string]translator.Translator{}, nil, payloadLogger, nil, payloadLoggingSpec, nil, nil, nil, nil, nil, nil,
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/models/foo_1/infer", bytes.NewBufferString(`{"inputs":[]}`))
+	g.Expect(err).To(BeNil())
+	req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := httpClient.Do(req)
+	g.Expect(err).To(BeNil())
+	// the client still gets the compressed response
+	g.Expect(resp.Header.Get("Content-Encoding")).To(Equal("gzip"))
+	body, err := io.ReadAll(resp.Body)
+	g.Expect(err).To(BeNil())
+	g.Expect(body).To(Equal(gzipBody(g, responseBody)))
+
+	payloads := map[string]string{}
+	for range 2 {
+		var payload *payloadlog.Payload
+		g.Eventually(payloadLogger.logged).Should(Receive(&payload))
+		payloads[payload.EventType] = string(payload.Body)
+	}
+	g.Expect(payloads[payloadlog.ResponseEventType]).To(Equal(`{"model_name":"foo_1","outputs":[{"datatype":"INT64","name":"ssn","shape":[1]}]}`))
+}
+
+func TestLazyLoadRoundTripperCaptureGzip(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+	responseBody := `{"model_name":"foo_1","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[1]}]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(gzipBody(g, responseBody))
+	}))
+	defer server.Close()
+
+	loader := func(model string) *interfaces.ControlPlaneErr {
+		return nil
+	}
+	responseCapturer := &fakeResponseCapturer{captured: make(chan *capture.CapturedResponse, 1)}
+	httpClient := http.Client{
+		Transport: &lazyModelLoadTransport{
+			loader, http.DefaultTransport, newFakeMetricsHandler(), nil, log.New(), map[string]translator.Translator{}, responseCapturer, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/models/foo_1/infer", bytes.NewBufferString(`{"inputs":[]}`))
+	g.Expect(err).To(BeNil())
+	req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+	req.Header.Set(util.SeldonCaptureHeader, "exp1")
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := httpClient.Do(req)
+	g.Expect(err).To(BeNil())
+	// the client still gets the compressed response
+	g.Expect(resp.Header.Get("Content-Encoding")).To(Equal("gzip"))
+	body, err := io.ReadAll(resp.Body)
+	g.Expect(err).To(BeNil())
+	g.Expect(body).To(Equal(gzipBody(g, responseBody)))
+
+	var captured *capture.CapturedResponse
+	g.Eventually(responseCapturer.captured).Should(Receive(&captured))
+	g.Expect(string(captured.Body)).To(Equal(responseBody))
+}
+
+func TestLazyLoadRoundTripperResponseCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+
+	type test struct {
+		name           string
+		spec           *scheduler.ResponseCacheSpec
+		path           string
+		expectedCalls  int
+		expectedHits   int
+		expectedMisses int
+	}
+	tests := []test{
+		{
+			name:          "response cache not configured",
+			path:          "/v2/models/foo_1/infer",
+			expectedCalls: 3,
+		},
+		{
+			name:           "cached responses",
+			spec:           &scheduler.ResponseCacheSpec{MaxEntries: 10},
+			path:           "/v2/models/foo_1/infer",
+			expectedCalls:  1,
+			expectedHits:   2,
+			expectedMisses: 1,
+		},
+		{
+			name:          "only inference responses are cached",
+			spec:          &scheduler.ResponseCacheSpec{MaxEntries: 10},
+			path:          "/v2/models/foo_1/infer_stream",
+			expectedCalls: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				_, _ = w.Write([]byte(`{"model_name":"foo_1","id":"a","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[1]}]}`))
+			}))
+			defer server.Close()
+
+			loader := func(model string) *interfaces.ControlPlaneErr {
+				return nil
+			}
+			metricsHandler := newFakeMetricsHandler()
+			responseCacheSpec := func(model string) *scheduler.ResponseCacheSpec {
+				return test.spec
+			}
+			httpClient := http.Client{
+				Transport: &lazyModelLoadTransport{
+					loader, http.DefaultTransport, metricsHandler, nil, log.New(), map[string]translator.Translator{}, nil, nil, nil, nil, nil, nil, responsecache.NewCache(log.New()), responseCacheSpec, nil, nil,
+				},
+			}
+
+			for _, id := range []string{"a", "b", "c"} {
+				req, err := http.NewRequest(http.MethodPost, server.URL+test.path, bytes.NewBufferString(`{"id":"`+id+`","inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[1]}]}`))
+				g.Expect(err).To(BeNil())
+				req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+				resp, err := httpClient.Do(req)
+				g.Expect(err).To(BeNil())
+				g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				g.Expect(resp.Header.Get(util.RequestIdHeaderCanonical)).ToNot(BeEmpty())
+				body, err := io.ReadAll(resp.Body)
+				g.Expect(err).To(BeNil())
+				// cached responses are returned with the id of the request
+				if test.expectedHits > 0 {
+					g.Expect(string(body)).To(ContainSubstring(`"id":"` + id + `"`))
+				}
+			}
+
+			mu.Lock()
+			g.Expect(calls).To(Equal(test.expectedCalls))
+			mu.Unlock()
+			g.Eventually(func() responseCacheStateValue {
+				metricsHandler.mu.Lock()
+				defer metricsHandler.mu.Unlock()
+				return metricsHandler.modelResponseCacheState["foo"]
+			}).Should(Equal(responseCacheStateValue{hits: test.expectedHits, misses: test.expectedMisses}))
+		})
+	}
+}
+
+func TestLazyLoadRoundTripperResponseCacheGzip(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+
+	type test struct {
+		name       string
+		acceptGzip []bool
+	}
+	tests := []test{
+		{
+			name:       "cached from a gzip response",
+			acceptGzip: []bool{true, false, true},
+		},
+		{
+			name:       "cached from a plain response",
+			acceptGzip: []bool{false, true, false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			calls := 0
+			// like MLServer, compresses responses for clients which accept gzip
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				response := `{"model_name":"foo_1","id":"a","outputs":[{"name":"predict","shape":[1],"datatype":"INT64","data":[1]}]}`
+				if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+					w.Header().Set("Content-Encoding", "gzip")
+					_, _ = w.Write(gzipBody(g, response))
+					return
+				}
+				_, _ = w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			loader := func(model string) *interfaces.ControlPlaneErr {
+				return nil
+			}
+			responseCacheSpec := func(model string) *scheduler.ResponseCacheSpec {
+				return &scheduler.ResponseCacheSpec{MaxEntries: 10}
+			}
+			httpClient := http.Client{
+				Transport: &lazyModelLoadTransport{
+					loader, http.DefaultTransport, newFakeMetricsHandler(), nil, log.New(), map[string]translator.Translator{}, nil, nil, nil, nil, nil, nil, responsecache.NewCache(log.New()), responseCacheSpec, nil, nil,
+				},
+			}
+
+			for i, id := range []string{"a", "b", "c"} {
+				req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/models/foo_1/infer", bytes.NewBufferString(`{"id":"`+id+`","inputs":[{"name":"predict","shape":[1],"datatype":"FP32","data":[1]}]}`))
+				g.Expect(err).To(BeNil())
+				req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+				if test.acceptGzip[i] {
+					req.Header.Set("Accept-Encoding", "gzip")
+				}
+				resp, err := httpClient.Do(req)
+				g.Expect(err).To(BeNil())
+				g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				decompressed, _, err := translator.DecompressIfNeeded(resp)
+				g.Expect(err).To(BeNil())
+				body, err := io.ReadAll(decompressed.Body)
+				g.Expect(err).To(BeNil())
+				// only the first response comes from the server, the others are restored from the cache
+				if i > 0 {
+					g.Expect(resp.Header.Get("Content-Encoding")).To(BeEmpty())
+					g.Expect(string(body)).To(ContainSubstring(`"id":"` + id + `"`))
+				}
+				g.Expect(string(body)).To(ContainSubstring(`"model_name":"foo_1"`))
+			}
+
+			mu.Lock()
+			g.Expect(calls).To(Equal(1))
+			mu.Unlock()
 		})
 	}
 }
 
+func TestLazyLoadRoundTripperConcurrency(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+
+	blocked := make(chan struct{})
+	received := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		<-blocked
+		_, _ = w.Write([]byte(`{"model_name":"foo_1","outputs":[]}`))
+	}))
+	defer server.Close()
+
+	loader := func(model string) *interfaces.ControlPlaneErr {
+		return nil
+	}
+	concurrencySpec := func(model string) *scheduler.ConcurrencySpec {
+		return &scheduler.ConcurrencySpec{MaxInflight: 1, MaxQueueSize: 1}
+	}
+	limiter := admission.NewLimiter()
+	metricsHandler := newFakeMetricsHandler()
+	modelScalingStatsCollector := modelscaling.NewDataPlaneStatsCollector(
+		modelscaling.NewModelReplicaLagsKeeper(), modelscaling.NewModelReplicaLastUsedKeeper(),
+		modelscaling.NewModelReplicaQueueDepthKeeper(), modelscaling.NewModelReplicaRejectedKeeper())
+	httpClient := http.Client{
+		Transport: &lazyModelLoadTransport{
+			loader, http.DefaultTransport, metricsHandler, modelScalingStatsCollector, log.New(), map[string]translator.Translator{}, nil, nil, nil, nil, nil, nil, nil, nil, limiter, concurrencySpec,
+		},
+	}
+	send := func() *http.Response {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/models/foo_1/infer", bytes.NewBufferString(`{"inputs":[]}`))
+		g.Expect(err).To(BeNil())
+		req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+		resp, err := httpClient.Do(req)
+		g.Expect(err).To(BeNil())
+		_, _ = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		return resp
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for range 2 {
+		go func() {
+			defer wg.Done()
+			g.Expect(send().StatusCode).To(Equal(http.StatusOK))
+		}()
+	}
+	// one request is in flight and the other queued
+	g.Eventually(received).Should(Receive())
+	g.Eventually(func() uint32 { return limiter.QueueDepth(dummyModel) }).Should(Equal(uint32(1)))
+	g.Consistently(received, 50*time.Millisecond).ShouldNot(Receive())
+
+	// the queue is full so the request is rejected
+	g.Expect(send().StatusCode).To(Equal(http.StatusTooManyRequests))
+	g.Eventually(func() string {
+		metricsHandler.mu.Lock()
+		defer metricsHandler.mu.Unlock()
+		return metricsHandler.modelInferState["foo"].code
+	}).Should(Equal("429"))
+
+	close(blocked)
+	wg.Wait()
+	g.Expect(limiter.QueueDepth(dummyModel)).To(Equal(uint32(0)))
+
+	// queued and rejected requests are scaling signals of their own, the lag is back to 0 once all
+	// requests have completed
+	g.Eventually(func() uint32 {
+		lag, _ := modelScalingStatsCollector.ModelLagStats.Get(dummyModel)
+		return lag
+	}).Should(Equal(uint32(0)))
+	queued, _ := modelScalingStatsCollector.ModelQueueDepthStats.Get(dummyModel)
+	g.Expect(queued).To(Equal(uint32(1)))
+	rejected, _ := modelScalingStatsCollector.ModelRejectedStats.Get(dummyModel)
+	g.Expect(rejected).To(Equal(uint32(1)))
+}
+
+func TestLazyLoadRoundTripperConcurrencyTranslationError(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModel := "foo_1"
+
+	// the first line of the stream is not an OIP response, so it cannot be translated
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: not json\n\n"))
+	}))
+	defer server.Close()
+
+	loader := func(model string) *interfaces.ControlPlaneErr {
+		return nil
+	}
+	concurrencySpec := func(model string) *scheduler.ConcurrencySpec {
+		return &scheduler.ConcurrencySpec{MaxInflight: 1}
+	}
+	limiter := admission.NewLimiter()
+	apiTranslators := map[string]translator.Translator{
+		chatCompletionsPath: &openai.OpenAIChatCompletionsTranslator{},
+	}
+	httpClient := http.Client{
+		Transport: &lazyModelLoadTransport{
+			loader, http.DefaultTransport, newFakeMetricsHandler(), nil, log.New(), apiTranslators, nil, nil, nil, nil, nil, nil, nil, nil, limiter, concurrencySpec,
+		},
+	}
+
+	// the slot is released, otherwise the next request would be rejected
+	for range 2 {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/models/foo_1/infer/chat/completions", bytes.NewBufferString(`{"model":"foo","messages":[{"role":"user","content":"hi"}],"stream":true}`))
+		g.Expect(err).To(BeNil())
+		req.Header.Set(util.SeldonInternalModelHeader, dummyModel)
+		resp, err := httpClient.Do(req)
+		g.Expect(err).To(BeNil())
+		g.Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		_ = resp.Body.Close()
+		g.Expect(limiter.InFlight(dummyModel)).To(Equal(uint32(0)))
+	}
+}
+
 func TestAddRequestIdToResponse(t *testing.T) {
 	g := NewGomegaWithT(t)
 
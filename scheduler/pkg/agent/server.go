@@ -32,6 +32,7 @@ import (
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/experiment"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -113,6 +114,7 @@ type Server struct {
 	agents                  map[ServerKey]*AgentSubscriber
 	store                   store.ModelStore
 	scheduler               scheduler.Scheduler
+	experimentServer        experiment.ExperimentServer
 	waiter                  *modelRelocatedWaiter // waiter for when we want to drain a particular server replica
 	autoscalingModelEnabled bool
 	agentMutex              sync.Map // to force a serial order per agent (serverName, replicaIdx)
@@ -153,6 +155,7 @@ func NewAgentServer(
 	logger log.FieldLogger,
 	store store.ModelStore,
 	scheduler scheduler.Scheduler,
+	experimentServer experiment.ExperimentServer,
 	hub *coordinator.EventHub,
 	autoscalingModelEnabled bool,
 	tlsOptions seldontls.TLSOptions,
@@ -162,6 +165,7 @@ func NewAgentServer(
 		agents:                  make(map[ServerKey]*AgentSubscriber),
 		store:                   store,
 		scheduler:               scheduler,
+		experimentServer:        experimentServer,
 		waiter:                  newModelRelocatedWaiter(),
 		autoscalingModelEnabled: autoscalingModelEnabled,
 		agentMutex:              sync.Map{},
@@ -354,6 +358,18 @@ func (s *Server) AgentDrain(ctx context.Context, message *pb.AgentDrainRequest)
 	return &pb.AgentDrainResponse{Success: true}, nil
 }
 
+// ExperimentRequests records the candidates of bandit experiments which served requests on the agents. Requests
+// which can not be recorded are only logged, as the experiment may have changed since they were served.
+func (s *Server) ExperimentRequests(ctx context.Context, message *pbs.ExperimentRequestsRequest) (*pbs.ExperimentRequestsResponse, error) {
+	logger := s.logger.WithField("func", "ExperimentRequests")
+	for _, request := range message.GetRequests() {
+		if err := s.experimentServer.AddRequest(request.GetExperimentName(), request.GetRequestId(), request.GetCandidate()); err != nil {
+			logger.WithError(err).Debugf("Failed to record request %s", request.GetRequestId())
+		}
+	}
+	return &pbs.ExperimentRequestsResponse{}, nil
+}
+
 func (s *Server) AgentEvent(ctx context.Context, message *pb.ModelEventMessage) (*pb.ModelEventResponse, error) {
 	logger := s.logger.WithField("func", "AgentEvent")
 	var desiredState store.ModelReplicaState
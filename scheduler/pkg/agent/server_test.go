@@ -44,6 +44,10 @@ func (s mockScheduler) ScheduleFailedModels() ([]string, error) {
 	return nil, nil
 }
 
+func (s mockScheduler) ExplainSchedule(_ *pbs.Model) (*pbs.ExplainScheduleResponse, error) {
+	return nil, nil
+}
+
 type mockStore struct {
 	models map[string]*store.ModelSnapshot
 }
@@ -96,6 +100,10 @@ func (m *mockStore) UpdateLoadedModels(modelKey string, version uint32, serverKe
 	panic("implement me")
 }
 
+func (m *mockStore) UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	panic("implement me")
+}
+
 func (m *mockStore) UnloadVersionModels(modelKey string, version uint32) (bool, error) {
 	panic("implement me")
 }
@@ -142,6 +150,14 @@ func (m *mockStore) SetModelGwModelState(name string, versionNumber uint32, stat
 	panic("implement me")
 }
 
+func (m *mockStore) SetSchedulingWarning(modelID string, version uint32, warning string) error {
+	panic("implement me")
+}
+
+func (m *mockStore) SetModelRollout(modelID string, version uint32, rollout *store.RolloutStatus) error {
+	panic("implement me")
+}
+
 type mockGrpcStream struct {
 	err error
 	grpc.ServerStream
@@ -359,7 +375,7 @@ func TestSync(t *testing.T) {
 			logger := log.New()
 			eventHub, err := coordinator.NewEventHub(logger)
 			g.Expect(err).To(BeNil())
-			server := NewAgentServer(logger, test.store, nil, eventHub, false, tls.TLSOptions{})
+			server := NewAgentServer(logger, test.store, nil, nil, eventHub, false, tls.TLSOptions{})
 			server.agents = test.agents
 			server.Sync(test.modelName)
 			model, err := test.store.GetModel(test.modelName)
@@ -1072,7 +1088,7 @@ func TestSubscribe(t *testing.T) {
 			logger := log.New()
 			eventHub, err := coordinator.NewEventHub(logger)
 			g.Expect(err).To(BeNil())
-			server := NewAgentServer(logger, &mockStore{}, mockScheduler{}, eventHub, false, tls.TLSOptions{})
+			server := NewAgentServer(logger, &mockStore{}, mockScheduler{}, nil, eventHub, false, tls.TLSOptions{})
 			port, err := testing_utils.GetFreePortForTest()
 			if err != nil {
 				t.Fatal(err)
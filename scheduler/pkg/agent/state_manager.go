@@ -12,13 +12,17 @@ package agent
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/admission"
 	cache "github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/cache"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/responsecache"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 )
 
@@ -39,6 +43,10 @@ type LocalStateManager struct {
 	// lock for `availableMainMemoryBytes`
 	mu      sync.RWMutex
 	metrics metrics.AgentMetricsHandler
+	// responses of models with a response cache spec, shared by the reverse proxies
+	responseCache *responsecache.Cache
+	// admission of inference requests to models with a concurrency spec, shared by the reverse proxies
+	limiter *admission.Limiter
 }
 
 // this should be called from control plane (if directly)
@@ -90,6 +98,7 @@ func (manager *LocalStateManager) LoadModelVersion(modelVersionDetails *agent.Mo
 		return err
 	}
 
+	loadStart := time.Now()
 	if err := manager.v2Client.LoadModel(modelId); err != nil {
 		if _, err := manager.modelVersions.removeModelVersion(modelVersionDetails); err != nil {
 			manager.logger.WithError(err).Warnf("Model removing failed %s", modelId)
@@ -99,6 +108,8 @@ func (manager *LocalStateManager) LoadModelVersion(modelVersionDetails *agent.Mo
 		}
 		return err.Err
 	}
+	manager.cache.SetCost(modelId, memBytesToLoad, time.Since(loadStart))
+	manager.cache.SetPinned(modelId, modelVersionDetails.GetModel().GetModelSpec().GetPinned())
 
 	if err := manager.cache.AddDefault(modelId); err != nil {
 		manager.logger.WithError(err).Infof("Cannot load model %s, aborting", modelId)
@@ -115,6 +126,8 @@ func (manager *LocalStateManager) LoadModelVersion(modelVersionDetails *agent.Mo
 	}
 
 	go manager.metrics.AddLoadedModelMetrics(modelId, memBytesToLoad, true, false)
+	// responses of a previous model version with the same name must not be served
+	manager.responseCache.Invalidate(modelId)
 
 	manager.logger.Debugf("Load model %s success, available memory is %d",
 		modelId, manager.GetAvailableMemoryBytes())
@@ -171,6 +184,7 @@ func (manager *LocalStateManager) UnloadModelVersion(modelVersionDetails *agent.
 		manager.logger.WithError(err).Errorf("Model removing failed for %s", modelId)
 		return err
 	}
+	manager.responseCache.Invalidate(modelId)
 
 	manager.logger.Debugf("Unload model %s success, available memory is %d",
 		modelId, manager.GetAvailableMemoryBytes())
@@ -204,6 +218,7 @@ func (manager *LocalStateManager) EnsureLoadModel(modelId string) error {
 			return err
 		}
 
+		reloadStart := time.Now()
 		if err := manager.v2Client.LoadModel(modelId); err != nil {
 			manager.logger.WithError(err.Err).Errorf("Cannot reload %s", modelId)
 			if err := manager.updateAvailableMemory(modelMemoryBytes, false); err != nil {
@@ -211,6 +226,9 @@ func (manager *LocalStateManager) EnsureLoadModel(modelId string) error {
 			}
 			return err.Err
 		}
+		// the reload latency feeds the eviction policy, if it takes it into account
+		reloadLatency := time.Since(reloadStart)
+		manager.cache.SetCost(modelId, modelMemoryBytes, reloadLatency)
 
 		if err := manager.cache.AddDefault(modelId); err != nil {
 			// we were not too quick and the model has been added by a concurrent request
@@ -222,8 +240,8 @@ func (manager *LocalStateManager) EnsureLoadModel(modelId string) error {
 		}
 
 		go manager.metrics.AddLoadedModelMetrics(modelId, modelMemoryBytes, true, true)
-		manager.logger.Infof("Reload model %s success, available memory is %d",
-			modelId, manager.GetAvailableMemoryBytes())
+		manager.logger.Infof("Reload model %s success in %s, available memory is %d",
+			modelId, reloadLatency, manager.GetAvailableMemoryBytes())
 
 		return nil
 	} else {
@@ -237,6 +255,26 @@ func (manager *LocalStateManager) EnsureLoadModel(modelId string) error {
 	}
 }
 
+// this should be called from data plane, nil if payloads of the model are not logged
+func (manager *LocalStateManager) GetPayloadLoggingSpec(modelId string) *scheduler.PayloadLoggingSpec {
+	return manager.modelVersions.getPayloadLoggingSpec(modelId)
+}
+
+// this should be called from data plane, nil if requests to the model are not batched
+func (manager *LocalStateManager) GetBatchingSpec(modelId string) *scheduler.BatchingSpec {
+	return manager.modelVersions.getBatchingSpec(modelId)
+}
+
+// this should be called from data plane, nil if responses of the model are not cached
+func (manager *LocalStateManager) GetResponseCacheSpec(modelId string) *scheduler.ResponseCacheSpec {
+	return manager.modelVersions.getResponseCacheSpec(modelId)
+}
+
+// this should be called from data plane, nil if requests to the model are not limited
+func (manager *LocalStateManager) GetConcurrencySpec(modelId string) *scheduler.ConcurrencySpec {
+	return manager.modelVersions.getConcurrencySpec(modelId)
+}
+
 func (manager *LocalStateManager) GetAvailableMemoryBytes() uint64 {
 	manager.mu.RLock()
 	defer manager.mu.RUnlock()
@@ -373,10 +411,15 @@ func NewLocalStateManager(
 	totalMainMemoryBytes uint64,
 	overCommitPercentage uint32,
 	metrics metrics.AgentMetricsHandler,
+	cacheEvictionPolicy string,
 ) *LocalStateManager {
 	// if we are here it means that it is a fresh instance with no state yet
 	// i.e. should not have any models loaded / cache is empty etc.
-	cacheWithTransaction := cache.NewLRUCacheTransactionManager(logger)
+	cacheWithTransaction, err := cache.NewCacheTransactionManager(cacheEvictionPolicy, logger)
+	if err != nil {
+		logger.WithError(err).Warnf("Falling back to %s cache eviction policy", cache.LRUEvictionPolicy)
+		cacheWithTransaction = cache.NewLRUCacheTransactionManager(logger)
+	}
 
 	return &LocalStateManager{
 		v2Client:                 v2Client,
@@ -388,5 +431,7 @@ func NewLocalStateManager(
 		totalMainMemoryBytes:     totalMainMemoryBytes,
 		overCommitPercentage:     overCommitPercentage,
 		metrics:                  metrics,
+		responseCache:            responsecache.NewCache(logger),
+		limiter:                  admission.NewLimiter(),
 	}
 }
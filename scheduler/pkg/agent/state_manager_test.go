@@ -26,6 +26,7 @@ import (
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 	pbs "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/cache"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/interfaces"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/internal/testing_utils"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
@@ -127,6 +128,7 @@ func setupLocalTestManagerWithState(
 		uint64(capacity),
 		overCommitPercentage,
 		newFakeMetricsHandler(),
+		cache.LRUEvictionPolicy,
 	)
 	return manager, v2ClientState
 }
@@ -1057,6 +1059,68 @@ func TestServerConnectionIssues(t *testing.T) {
 	g.Expect(manager.cache.Exists(otherModelName+"_1", false)).To(BeTrue())
 }
 
+func TestPinnedModelsAreNotEvicted(t *testing.T) {
+	numModels := 3
+	dummyModelPrefix := "dummy_model"
+
+	for _, policy := range []string{cache.LRUEvictionPolicy, cache.LFUEvictionPolicy, cache.GreedyDualSizeEvictionPolicy} {
+		t.Run(policy, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			manager := setupLocalTestManager(numModels, dummyModelPrefix, nil, numModels-1, 1)
+			cacheManager, err := cache.NewCacheTransactionManager(policy, log.New())
+			g.Expect(err).To(BeNil())
+			manager.cache = cacheManager
+
+			httpmock.ActivateNonDefault(manager.v2Client.(*testing_utils.V2RestClientForTest).HttpClient)
+			defer httpmock.DeactivateAndReset()
+
+			pinnedModelName := getModelId(dummyModelPrefix, 0)
+			modelName := getModelId(dummyModelPrefix, 1)
+			otherModelName := getModelId(dummyModelPrefix, 2)
+			memBytes := uint64(1)
+			pinned := getDummyModelDetails(pinnedModelName, memBytes, uint32(1))
+			pinned.Model.ModelSpec.Pinned = true
+			err = manager.loadModelFn(pinned, getModelRuntimeInfo(1))
+			g.Expect(err).To(BeNil())
+			err = manager.loadModelFn(getDummyModelDetails(modelName, memBytes, uint32(1)), getModelRuntimeInfo(1))
+			g.Expect(err).To(BeNil())
+
+			// the pinned model is the least recently and frequently used but the other model is evicted
+			err = manager.loadModelFn(getDummyModelDetails(otherModelName, memBytes, uint32(1)), getModelRuntimeInfo(1))
+			g.Expect(err).To(BeNil())
+			g.Expect(manager.cache.Exists(pinnedModelName+"_1", false)).To(BeTrue())
+			g.Expect(manager.cache.Exists(modelName+"_1", false)).To(BeFalse())
+			g.Expect(manager.cache.Exists(otherModelName+"_1", false)).To(BeTrue())
+		})
+	}
+}
+
+func TestResponseCacheInvalidatedOnLoadAndUnload(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dummyModelPrefix := "dummy_model"
+
+	manager := setupLocalTestManager(1, dummyModelPrefix, nil, 10, 1)
+	httpmock.ActivateNonDefault(manager.v2Client.(*testing_utils.V2RestClientForTest).HttpClient)
+	defer httpmock.DeactivateAndReset()
+
+	modelName := getModelId(dummyModelPrefix, 0)
+	internalModelName := modelName + "_1"
+	spec := &scheduler.ResponseCacheSpec{MaxEntries: 10}
+
+	// responses cached for an earlier model version with the same name are dropped on load
+	manager.responseCache.Put(internalModelName, spec, "key", []byte("response"))
+	err := manager.loadModelFn(getDummyModelDetails(modelName, 1, uint32(1)), getModelRuntimeInfo(1))
+	g.Expect(err).To(BeNil())
+	_, ok := manager.responseCache.Get(internalModelName, "key")
+	g.Expect(ok).To(BeFalse())
+
+	manager.responseCache.Put(internalModelName, spec, "key", []byte("response"))
+	err = manager.unloadModelFn(getDummyModelDetailsUnload(modelName, uint32(1)), nil)
+	g.Expect(err).To(BeNil())
+	_, ok = manager.responseCache.Get(internalModelName, "key")
+	g.Expect(ok).To(BeFalse())
+}
+
 func TestModelMetricsStats(t *testing.T) {
 	dummyModelPrefix := "dummy_model"
 	memBytes := uint64(1)
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package bandit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+const (
+	flushInterval       = time.Second
+	sendTimeout         = 5 * time.Second
+	maxBufferedRequests = 10000
+)
+
+// RequestSender sends the requests served by the candidates of bandit experiments to the scheduler
+type RequestSender interface {
+	SendExperimentRequests(ctx context.Context, requests []*scheduler.ExperimentRequest) error
+}
+
+// RequestRecorder records which candidate of a bandit experiment served each request, so the scheduler adds the
+// feedback for a request to the rewards of that candidate rather than trusting the client to name it.
+// Requests are sent to the scheduler in batches every second.
+type RequestRecorder struct {
+	logger   log.FieldLogger
+	mu       sync.Mutex
+	requests []*scheduler.ExperimentRequest
+}
+
+func NewRequestRecorder(logger log.FieldLogger) *RequestRecorder {
+	return &RequestRecorder{
+		logger: logger.WithField("source", "BanditRequestRecorder"),
+	}
+}
+
+// Record never blocks the inference request, requests are dropped if the scheduler can not keep up
+func (r *RequestRecorder) Record(experimentName string, requestId string, candidate string) {
+	if requestId == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.requests) >= maxBufferedRequests {
+		r.logger.Warnf("Dropping request %s of experiment %s as too many requests are buffered", requestId, experimentName)
+		return
+	}
+	r.requests = append(r.requests, &scheduler.ExperimentRequest{
+		ExperimentName: experimentName,
+		RequestId:      requestId,
+		Candidate:      candidate,
+	})
+}
+
+// Start sends the recorded requests with the sender until the context is done
+func (r *RequestRecorder) Start(ctx context.Context, sender RequestSender) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.flush(ctx, sender)
+		}
+	}
+}
+
+func (r *RequestRecorder) flush(ctx context.Context, sender RequestSender) {
+	r.mu.Lock()
+	requests := r.requests
+	r.requests = nil
+	r.mu.Unlock()
+	if len(requests) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	if err := sender.SendExperimentRequests(ctx, requests); err != nil {
+		r.logger.WithError(err).Warnf("Failed to send %d experiment requests, feedback for them will be ignored", len(requests))
+	}
+}
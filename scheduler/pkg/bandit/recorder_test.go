@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package bandit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+type fakeRequestSender struct {
+	requests [][]*scheduler.ExperimentRequest
+	err      error
+}
+
+func (f *fakeRequestSender) SendExperimentRequests(_ context.Context, requests []*scheduler.ExperimentRequest) error {
+	f.requests = append(f.requests, requests)
+	return f.err
+}
+
+func TestRecordAndFlush(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		requestIds    []string
+		sendErr       error
+		expectedSends int
+		expectedIds   []string
+	}
+
+	tests := []test{
+		{
+			name:          "requests sent in one batch",
+			requestIds:    []string{"req1", "req2"},
+			expectedSends: 1,
+			expectedIds:   []string{"req1", "req2"},
+		},
+		{
+			name:          "requests without id ignored",
+			requestIds:    []string{"", "req2"},
+			expectedSends: 1,
+			expectedIds:   []string{"req2"},
+		},
+		{
+			name:          "nothing sent without requests",
+			expectedSends: 0,
+		},
+		{
+			name:          "requests dropped when send fails",
+			requestIds:    []string{"req1"},
+			sendErr:       fmt.Errorf("not connected"),
+			expectedSends: 1,
+			expectedIds:   []string{"req1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := NewRequestRecorder(log.New())
+			sender := &fakeRequestSender{err: test.sendErr}
+			for _, requestId := range test.requestIds {
+				recorder.Record("exp", requestId, "model1")
+			}
+
+			recorder.flush(context.Background(), sender)
+			g.Expect(sender.requests).To(HaveLen(test.expectedSends))
+			if test.expectedSends > 0 {
+				var requestIds []string
+				for _, request := range sender.requests[0] {
+					g.Expect(request.ExperimentName).To(Equal("exp"))
+					g.Expect(request.Candidate).To(Equal("model1"))
+					requestIds = append(requestIds, request.RequestId)
+				}
+				g.Expect(requestIds).To(Equal(test.expectedIds))
+			}
+
+			// the buffer is emptied by each flush
+			recorder.flush(context.Background(), sender)
+			g.Expect(sender.requests).To(HaveLen(test.expectedSends))
+		})
+	}
+}
+
+func TestRecordDropsWhenBufferFull(t *testing.T) {
+	g := NewGomegaWithT(t)
+	recorder := NewRequestRecorder(log.New())
+	for i := 0; i < maxBufferedRequests+10; i++ {
+		recorder.Record("exp", fmt.Sprintf("req%d", i), "model1")
+	}
+	g.Expect(recorder.requests).To(HaveLen(maxBufferedRequests))
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	pipelineSuffix = "." + util.SeldonPipelineHeaderSuffix
+)
+
+// GrpcInferer sends requests to a model or pipeline through the Seldon mesh, with the inference client
+// used by the gateways to call envoy
+type GrpcInferer struct {
+	client   v2.GRPCInferenceServiceClient
+	resource string
+}
+
+// NewGrpcInferer creates a client for the model, or for the pipeline if isPipeline is set, served behind the
+// Envoy listener at host and port
+func NewGrpcInferer(host string, port int, tlsOptions *util.TLSOptions, name string, isPipeline bool) (*GrpcInferer, error) {
+	client, err := util.GetInferenceGrpcClient(host, port, tlsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := name
+	if isPipeline && !strings.HasSuffix(name, pipelineSuffix) {
+		resource = name + pipelineSuffix
+	}
+	return &GrpcInferer{
+		client:   client,
+		resource: resource,
+	}, nil
+}
+
+func (g *GrpcInferer) Infer(ctx context.Context, request *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+	request.ModelName = strings.TrimSuffix(g.resource, pipelineSuffix)
+	ctx = metadata.AppendToOutgoingContext(ctx, util.SeldonModelHeader, g.resource, util.RequestIdHeader, request.Id)
+	return g.client.ModelInfer(ctx, request)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
+	config_tls "github.com/seldonio/seldon-core/components/tls/v2/pkg/config"
+)
+
+const (
+	kafkaTimeoutMs     = 10000
+	kafkaPollTimeoutMs = 1000
+)
+
+// KafkaReader reads the messages of a topic that were present when the job started, from the earliest
+// retained offset of each partition. Message values are Open Inference Protocol requests, either as json or as
+// protobuf as found on pipeline topics, or json objects with a value per input.
+type KafkaReader struct {
+	logger    log.FieldLogger
+	consumer  *kafka.Consumer
+	topic     string
+	inputName string
+	// the offset of the last message to read for each partition still being read
+	lastOffsets map[int32]kafka.Offset
+	index       int64
+}
+
+func NewKafkaReader(logger log.FieldLogger, consumerConfig kafka.ConfigMap, topic string, groupId string, inputName string) (*KafkaReader, error) {
+	logger = logger.WithField("source", "KafkaReader")
+	consumerConfig = kafka_config.CloneKafkaConfigMap(consumerConfig)
+	consumerConfig["group.id"] = groupId
+	consumerConfig["enable.auto.commit"] = false
+	err := config_tls.AddKafkaSSLOptions(consumerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infof("Creating consumer with config %v", kafka_config.WithoutSecrets(consumerConfig))
+	consumer, err := kafka.NewConsumer(&consumerConfig)
+	if err != nil {
+		return nil, err
+	}
+	reader := &KafkaReader{
+		logger:      logger,
+		consumer:    consumer,
+		topic:       topic,
+		inputName:   inputName,
+		lastOffsets: make(map[int32]kafka.Offset),
+	}
+	err = reader.assignPartitions()
+	if err != nil {
+		_ = consumer.Close()
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (k *KafkaReader) assignPartitions() error {
+	metadata, err := k.consumer.GetMetadata(&k.topic, false, kafkaTimeoutMs)
+	if err != nil {
+		return err
+	}
+	topicMetadata, ok := metadata.Topics[k.topic]
+	if !ok || topicMetadata.Error.Code() != kafka.ErrNoError {
+		return fmt.Errorf("failed to get partitions of topic %s: %v", k.topic, topicMetadata.Error)
+	}
+
+	var partitions []kafka.TopicPartition
+	for _, partition := range topicMetadata.Partitions {
+		low, high, err := k.consumer.QueryWatermarkOffsets(k.topic, partition.ID, kafkaTimeoutMs)
+		if err != nil {
+			return err
+		}
+		if high <= low {
+			continue
+		}
+		k.lastOffsets[partition.ID] = kafka.Offset(high - 1)
+		partitions = append(partitions, kafka.TopicPartition{
+			Topic:     &k.topic,
+			Partition: partition.ID,
+			Offset:    kafka.Offset(low),
+		})
+	}
+	k.logger.Infof("Reading %d non-empty partitions of topic %s", len(partitions), k.topic)
+	return k.consumer.Assign(partitions)
+}
+
+func (k *KafkaReader) Next() (*Record, error) {
+	for len(k.lastOffsets) > 0 {
+		msg, err := k.consumer.ReadMessage(kafkaPollTimeoutMs * time.Millisecond)
+		if err != nil {
+			if kafkaErr, ok := err.(kafka.Error); ok && kafkaErr.Code() == kafka.ErrTimedOut {
+				continue
+			}
+			return nil, err
+		}
+		partition := msg.TopicPartition.Partition
+		lastOffset, ok := k.lastOffsets[partition]
+		if !ok || msg.TopicPartition.Offset > lastOffset {
+			continue
+		}
+		if msg.TopicPartition.Offset == lastOffset {
+			delete(k.lastOffsets, partition)
+		}
+		record := newRecordFromMessage(k.index, msg.Key, msg.Value, k.inputName)
+		k.index++
+		return record, nil
+	}
+	return nil, io.EOF
+}
+
+func (k *KafkaReader) Close() error {
+	return k.consumer.Close()
+}
+
+func newRecordFromMessage(index int64, key []byte, value []byte, inputName string) *Record {
+	var record *Record
+	if json.Valid(value) {
+		record = newRecordFromJson(index, value, inputName)
+	} else {
+		request := &v2.ModelInferRequest{}
+		if err := proto.Unmarshal(value, request); err != nil {
+			return &Record{Index: index, Err: fmt.Errorf("message is neither json nor an inference request: %w", err)}
+		}
+		record = &Record{Index: index, Id: request.Id, Request: request}
+	}
+	if record.Id == "" {
+		record.Id = string(key)
+	}
+	return record
+}
@@ -0,0 +1,476 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline"
+)
+
+const (
+	FormatCsv     = "csv"
+	FormatJsonl   = "jsonl"
+	FormatParquet = "parquet"
+
+	tyBool   = "BOOL"
+	tyInt64  = "INT64"
+	tyFp64   = "FP64"
+	tyBytes  = "BYTES"
+	maxLine  = 64 * 1024 * 1024
+	rowsRead = 64
+)
+
+// Record is one request to send to the model or pipeline. Records that could not be converted to a request
+// carry the error instead, so they are reported as failed without stopping the job.
+type Record struct {
+	Index   int64
+	Id      string
+	Request *v2.ModelInferRequest
+	Err     error
+}
+
+// RecordReader returns the records of the input in order and io.EOF once they have all been read
+type RecordReader interface {
+	Next() (*Record, error)
+	Close() error
+}
+
+// column is a named value of a record, in the order of the input columns
+type column struct {
+	name  string
+	value any
+}
+
+// NewFileReader reads records in the given format from a local file. If inputName is set the columns of each
+// record are sent as a single FP64 tensor with that name, otherwise each column is sent as its own tensor.
+func NewFileReader(path string, format string, inputName string) (RecordReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatCsv:
+		return newCsvReader(file, inputName)
+	case FormatJsonl:
+		return newJsonlReader(file, inputName), nil
+	case FormatParquet:
+		return newParquetReader(file, inputName)
+	default:
+		_ = file.Close()
+		return nil, fmt.Errorf("unknown input format %s, expected one of %s, %s or %s", format, FormatCsv, FormatJsonl, FormatParquet)
+	}
+}
+
+type csvReader struct {
+	file      *os.File
+	reader    *csv.Reader
+	header    []string
+	inputName string
+	index     int64
+}
+
+func newCsvReader(file *os.File, inputName string) (*csvReader, error) {
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	return &csvReader{
+		file:      file,
+		reader:    reader,
+		header:    header,
+		inputName: inputName,
+	}, nil
+}
+
+func (c *csvReader) Next() (*Record, error) {
+	values, err := c.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			record := &Record{Index: c.index, Err: err}
+			c.index++
+			return record, nil
+		}
+		return nil, err
+	}
+	columns := make([]column, len(values))
+	for i, value := range values {
+		columns[i] = column{name: c.header[i], value: parseCsvValue(value)}
+	}
+	record := newRecordFromColumns(c.index, columns, c.inputName)
+	c.index++
+	return record, nil
+}
+
+func (c *csvReader) Close() error {
+	return c.file.Close()
+}
+
+func parseCsvValue(value string) any {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	return value
+}
+
+type jsonlReader struct {
+	file      *os.File
+	scanner   *bufio.Scanner
+	inputName string
+	index     int64
+}
+
+func newJsonlReader(file *os.File, inputName string) *jsonlReader {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLine)
+	return &jsonlReader{
+		file:      file,
+		scanner:   scanner,
+		inputName: inputName,
+	}
+}
+
+func (j *jsonlReader) Next() (*Record, error) {
+	for j.scanner.Scan() {
+		line := bytes.TrimSpace(j.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		record := newRecordFromJson(j.index, line, j.inputName)
+		j.index++
+		return record, nil
+	}
+	if err := j.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (j *jsonlReader) Close() error {
+	return j.file.Close()
+}
+
+type parquetReader struct {
+	file      *os.File
+	reader    *parquet.Reader
+	names     []string
+	rows      []parquet.Row
+	inputName string
+	index     int64
+}
+
+func newParquetReader(file *os.File, inputName string) (*parquetReader, error) {
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	parquetFile, err := parquet.OpenFile(file, info.Size())
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to open parquet file: %w", err)
+	}
+	var names []string
+	for _, field := range parquetFile.Schema().Fields() {
+		if !field.Leaf() || field.Repeated() {
+			_ = file.Close()
+			return nil, fmt.Errorf("parquet column %s is not supported, only flat schemas can be read", field.Name())
+		}
+		names = append(names, field.Name())
+	}
+	return &parquetReader{
+		file:      file,
+		reader:    parquet.NewReader(parquetFile),
+		names:     names,
+		inputName: inputName,
+	}, nil
+}
+
+func (p *parquetReader) Next() (*Record, error) {
+	if len(p.rows) == 0 {
+		rows := make([]parquet.Row, rowsRead)
+		n, err := p.reader.ReadRows(rows)
+		if n == 0 {
+			if err == nil {
+				err = io.EOF
+			}
+			return nil, err
+		}
+		p.rows = rows[:n]
+	}
+	row := p.rows[0]
+	p.rows = p.rows[1:]
+
+	record := &Record{Index: p.index}
+	p.index++
+	columns := make([]column, len(p.names))
+	for _, value := range row {
+		name := p.names[value.Column()]
+		if value.IsNull() {
+			record.Err = fmt.Errorf("column %s is null", name)
+			return record, nil
+		}
+		columns[value.Column()] = column{name: name, value: parquetValue(value)}
+	}
+	return newRecordFromColumns(record.Index, columns, p.inputName), nil
+}
+
+func (p *parquetReader) Close() error {
+	return errors.Join(p.reader.Close(), p.file.Close())
+}
+
+func parquetValue(value parquet.Value) any {
+	switch value.Kind() {
+	case parquet.Boolean:
+		return value.Boolean()
+	case parquet.Int32:
+		return int64(value.Int32())
+	case parquet.Int64:
+		return value.Int64()
+	case parquet.Float:
+		return float64(value.Float())
+	case parquet.Double:
+		return value.Double()
+	default:
+		return string(value.ByteArray())
+	}
+}
+
+// newRecordFromJson converts a json object, either an Open Inference Protocol request or an object with a
+// value per input, to a record
+func newRecordFromJson(index int64, data []byte, inputName string) *Record {
+	request := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &request); err != nil {
+		return &Record{Index: index, Err: fmt.Errorf("invalid json: %w", err)}
+	}
+	if _, ok := request["inputs"]; ok {
+		requestProto, err := pipeline.ConvertRequestToV2Bytes(data, "", "")
+		if err != nil {
+			return &Record{Index: index, Err: err}
+		}
+		inferRequest := &v2.ModelInferRequest{}
+		if err := proto.Unmarshal(requestProto, inferRequest); err != nil {
+			return &Record{Index: index, Err: err}
+		}
+		// the request id is not part of the converted request
+		if id, ok := request["id"]; ok {
+			if err := json.Unmarshal(id, &inferRequest.Id); err != nil {
+				return &Record{Index: index, Err: fmt.Errorf("invalid request id: %w", err)}
+			}
+		}
+		return &Record{Index: index, Id: inferRequest.Id, Request: inferRequest}
+	}
+	columns, err := decodeColumns(data)
+	if err != nil {
+		return &Record{Index: index, Err: err}
+	}
+	return newRecordFromColumns(index, columns, inputName)
+}
+
+// decodeColumns decodes the fields of a json object keeping their order
+func decodeColumns(data []byte) ([]column, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	var columns []column
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		value, err = normaliseJsonValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", token, err)
+		}
+		columns = append(columns, column{name: token.(string), value: value})
+	}
+	return columns, nil
+}
+
+func normaliseJsonValue(value any) (any, error) {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	case bool, string:
+		return v, nil
+	case []any:
+		values := make([]any, len(v))
+		for i, element := range v {
+			normalised, err := normaliseJsonValue(element)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := normalised.([]any); ok {
+				return nil, errors.New("nested arrays are not supported")
+			}
+			values[i] = normalised
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+}
+
+func newRecordFromColumns(index int64, columns []column, inputName string) *Record {
+	record := &Record{Index: index}
+	var err error
+	if inputName != "" {
+		record.Request, err = newRequestWithSingleInput(columns, inputName)
+	} else {
+		record.Request, err = newRequestWithInputPerColumn(columns)
+	}
+	if err != nil {
+		return &Record{Index: index, Err: err}
+	}
+	return record
+}
+
+func newRequestWithSingleInput(columns []column, inputName string) (*v2.ModelInferRequest, error) {
+	var values []float64
+	for _, column := range columns {
+		elements, ok := column.value.([]any)
+		if !ok {
+			elements = []any{column.value}
+		}
+		for _, element := range elements {
+			switch v := element.(type) {
+			case int64:
+				values = append(values, float64(v))
+			case float64:
+				values = append(values, v)
+			default:
+				return nil, fmt.Errorf("column %s is not numeric", column.name)
+			}
+		}
+	}
+	return &v2.ModelInferRequest{
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{
+				Name:     inputName,
+				Datatype: tyFp64,
+				Shape:    []int64{1, int64(len(values))},
+				Contents: &v2.InferTensorContents{Fp64Contents: values},
+			},
+		},
+	}, nil
+}
+
+func newRequestWithInputPerColumn(columns []column) (*v2.ModelInferRequest, error) {
+	request := &v2.ModelInferRequest{}
+	for _, column := range columns {
+		input, err := newTensor(column)
+		if err != nil {
+			return nil, err
+		}
+		request.Inputs = append(request.Inputs, input)
+	}
+	return request, nil
+}
+
+// newTensor converts a scalar value to a tensor of shape [1] and an array to a tensor of shape [1, n]
+func newTensor(column column) (*v2.ModelInferRequest_InferInputTensor, error) {
+	elements, isArray := column.value.([]any)
+	if !isArray {
+		elements = []any{column.value}
+	}
+	tensor := &v2.ModelInferRequest_InferInputTensor{
+		Name:     column.name,
+		Shape:    []int64{1},
+		Contents: &v2.InferTensorContents{},
+	}
+	if isArray {
+		tensor.Shape = []int64{1, int64(len(elements))}
+	}
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("column %s is empty", column.name)
+	}
+	// the tensor is of the type of its first element, with integers widened to floats if needed
+	for _, element := range elements {
+		if _, ok := element.(float64); ok {
+			tensor.Datatype = tyFp64
+			break
+		}
+	}
+	if tensor.Datatype == "" {
+		switch elements[0].(type) {
+		case bool:
+			tensor.Datatype = tyBool
+		case int64:
+			tensor.Datatype = tyInt64
+		default:
+			tensor.Datatype = tyBytes
+		}
+	}
+	for _, element := range elements {
+		if err := appendElement(tensor, element); err != nil {
+			return nil, fmt.Errorf("column %s: %w", column.name, err)
+		}
+	}
+	return tensor, nil
+}
+
+func appendElement(tensor *v2.ModelInferRequest_InferInputTensor, element any) error {
+	contents := tensor.Contents
+	switch v := element.(type) {
+	case bool:
+		if tensor.Datatype == tyBool {
+			contents.BoolContents = append(contents.BoolContents, v)
+			return nil
+		}
+	case int64:
+		switch tensor.Datatype {
+		case tyInt64:
+			contents.Int64Contents = append(contents.Int64Contents, v)
+			return nil
+		case tyFp64:
+			contents.Fp64Contents = append(contents.Fp64Contents, float64(v))
+			return nil
+		}
+	case float64:
+		if tensor.Datatype == tyFp64 {
+			contents.Fp64Contents = append(contents.Fp64Contents, v)
+			return nil
+		}
+	case string:
+		if tensor.Datatype == tyBytes {
+			contents.BytesContents = append(contents.BytesContents, []byte(v))
+			return nil
+		}
+	}
+	return fmt.Errorf("value %v does not match datatype %s", element, tensor.Datatype)
+}
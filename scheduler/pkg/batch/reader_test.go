@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+func readAll(g *WithT, reader RecordReader) []*Record {
+	var records []*Record
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		g.Expect(err).To(BeNil())
+		records = append(records, record)
+	}
+	g.Expect(reader.Close()).To(BeNil())
+	return records
+}
+
+func TestFileReader(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name      string
+		format    string
+		data      string
+		inputName string
+		expected  []*Record
+	}
+
+	tests := []test{
+		{
+			name:   "csv",
+			format: FormatCsv,
+			data:   "a,b,c\n1,2.5,x\n3,4,y\n",
+			expected: []*Record{
+				{
+					Index: 0,
+					Request: &v2.ModelInferRequest{
+						Inputs: []*v2.ModelInferRequest_InferInputTensor{
+							{Name: "a", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{1}}},
+							{Name: "b", Datatype: tyFp64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Fp64Contents: []float64{2.5}}},
+							{Name: "c", Datatype: tyBytes, Shape: []int64{1}, Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte("x")}}},
+						},
+					},
+				},
+				{
+					Index: 1,
+					Request: &v2.ModelInferRequest{
+						Inputs: []*v2.ModelInferRequest_InferInputTensor{
+							{Name: "a", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{3}}},
+							{Name: "b", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{4}}},
+							{Name: "c", Datatype: tyBytes, Shape: []int64{1}, Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte("y")}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "csv with single input",
+			format:    FormatCsv,
+			data:      "a,b\n1,2.5\n3,x\n",
+			inputName: "predict",
+			expected: []*Record{
+				{
+					Index: 0,
+					Request: &v2.ModelInferRequest{
+						Inputs: []*v2.ModelInferRequest_InferInputTensor{
+							{Name: "predict", Datatype: tyFp64, Shape: []int64{1, 2}, Contents: &v2.InferTensorContents{Fp64Contents: []float64{1, 2.5}}},
+						},
+					},
+				},
+				{Index: 1, Err: errors.New("column b is not numeric")},
+			},
+		},
+		{
+			name:   "jsonl",
+			format: FormatJsonl,
+			data: `{"id":"r1","inputs":[{"name":"t","datatype":"INT64","shape":[1],"data":[7]}]}
+
+{"b":[1,2.5],"a":true}
+not json
+`,
+			expected: []*Record{
+				{
+					Index: 0,
+					Id:    "r1",
+					Request: &v2.ModelInferRequest{
+						Id: "r1",
+						Inputs: []*v2.ModelInferRequest_InferInputTensor{
+							{Name: "t", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{7}}},
+						},
+					},
+				},
+				{
+					Index: 1,
+					Request: &v2.ModelInferRequest{
+						Inputs: []*v2.ModelInferRequest_InferInputTensor{
+							{Name: "b", Datatype: tyFp64, Shape: []int64{1, 2}, Contents: &v2.InferTensorContents{Fp64Contents: []float64{1, 2.5}}},
+							{Name: "a", Datatype: tyBool, Shape: []int64{1}, Contents: &v2.InferTensorContents{BoolContents: []bool{true}}},
+						},
+					},
+				},
+				{Index: 2, Err: errors.New("invalid json")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "input")
+			g.Expect(os.WriteFile(path, []byte(test.data), 0600)).To(BeNil())
+			reader, err := NewFileReader(path, test.format, test.inputName)
+			g.Expect(err).To(BeNil())
+			records := readAll(g, reader)
+			g.Expect(records).To(HaveLen(len(test.expected)))
+			for i, record := range records {
+				expected := test.expected[i]
+				g.Expect(record.Index).To(Equal(expected.Index))
+				g.Expect(record.Id).To(Equal(expected.Id))
+				if expected.Err != nil {
+					g.Expect(record.Err).To(MatchError(ContainSubstring(expected.Err.Error())))
+					continue
+				}
+				g.Expect(record.Err).To(BeNil())
+				g.Expect(proto.Equal(record.Request, expected.Request)).To(BeTrue(), "%v", record.Request)
+			}
+		})
+	}
+}
+
+func TestParquetReader(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type row struct {
+		Sepal float64 `parquet:"sepal"`
+		Count int32   `parquet:"count"`
+		Label string  `parquet:"label"`
+	}
+	path := filepath.Join(t.TempDir(), "input.parquet")
+	file, err := os.Create(path)
+	g.Expect(err).To(BeNil())
+	g.Expect(parquet.Write(file, []row{{Sepal: 1.5, Count: 2, Label: "a"}, {Sepal: 3, Count: 4, Label: "b"}})).To(BeNil())
+	g.Expect(file.Close()).To(BeNil())
+
+	reader, err := NewFileReader(path, FormatParquet, "")
+	g.Expect(err).To(BeNil())
+	records := readAll(g, reader)
+	g.Expect(records).To(HaveLen(2))
+	g.Expect(records[1].Index).To(Equal(int64(1)))
+	g.Expect(proto.Equal(records[1].Request, &v2.ModelInferRequest{
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{Name: "sepal", Datatype: tyFp64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Fp64Contents: []float64{3}}},
+			{Name: "count", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{4}}},
+			{Name: "label", Datatype: tyBytes, Shape: []int64{1}, Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte("b")}}},
+		},
+	})).To(BeTrue(), "%v", records[1].Request)
+
+	reader, err = NewFileReader(path, FormatParquet, "predict")
+	g.Expect(err).To(BeNil())
+	records = readAll(g, reader)
+	g.Expect(records[0].Err).To(MatchError("column label is not numeric"))
+}
+
+func TestNewRecordFromMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	request := &v2.ModelInferRequest{
+		Id: "r1",
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{Name: "t", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{1}}},
+		},
+	}
+	b, err := proto.Marshal(request)
+	g.Expect(err).To(BeNil())
+	record := newRecordFromMessage(3, []byte("key"), b, "")
+	g.Expect(record.Err).To(BeNil())
+	g.Expect(record.Index).To(Equal(int64(3)))
+	g.Expect(record.Id).To(Equal("r1"))
+	g.Expect(proto.Equal(record.Request, request)).To(BeTrue())
+
+	// json records without an id take the key of the message
+	record = newRecordFromMessage(4, []byte("key"), []byte(`{"t":1}`), "")
+	g.Expect(record.Err).To(BeNil())
+	g.Expect(record.Id).To(Equal("key"))
+	g.Expect(record.Request.Inputs).To(HaveLen(1))
+}
+
+func TestUnknownFormat(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "input")
+	g.Expect(os.WriteFile(path, []byte{}, 0600)).To(BeNil())
+	_, err := NewFileReader(path, "xml", "")
+	g.Expect(err).ToNot(BeNil())
+}
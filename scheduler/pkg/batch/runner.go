@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline"
+)
+
+const (
+	StateRunning   = "Running"
+	StateSucceeded = "Succeeded"
+	StateFailed    = "Failed"
+
+	DefaultParallelism    = 4
+	DefaultMaxRetries     = 3
+	DefaultRetryBackoff   = 500 * time.Millisecond
+	DefaultRequestTimeout = 60 * time.Second
+	DefaultStatusInterval = 10 * time.Second
+)
+
+type Config struct {
+	Parallelism int
+	// MaxRetries is the number of times a request is retried after a transient error
+	MaxRetries     int
+	RetryBackoff   time.Duration
+	RequestTimeout time.Duration
+	StatusInterval time.Duration
+}
+
+// Status is the progress of a job, reported periodically while it runs and once it has finished
+type Status struct {
+	State          string     `json:"state"`
+	Total          int64      `json:"total"`
+	Succeeded      int64      `json:"succeeded"`
+	Failed         int64      `json:"failed"`
+	StartTime      time.Time  `json:"startTime"`
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+	Error          string     `json:"error,omitempty"`
+}
+
+// Output is written as a json line for each record, in the order the records complete
+type Output struct {
+	Index    int64           `json:"index"`
+	Id       string          `json:"id,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+type Inferer interface {
+	Infer(ctx context.Context, request *v2.ModelInferRequest) (*v2.ModelInferResponse, error)
+}
+
+// Runner sends the records of a reader to a model or pipeline with bounded parallelism and writes their outputs
+type Runner struct {
+	logger       log.FieldLogger
+	config       Config
+	inferer      Inferer
+	reader       RecordReader
+	output       *bufio.Writer
+	reportStatus func(*Status) error
+	mu           sync.Mutex
+	status       Status
+}
+
+func NewRunner(
+	logger log.FieldLogger,
+	config Config,
+	inferer Inferer,
+	reader RecordReader,
+	output io.Writer,
+	reportStatus func(*Status) error,
+) *Runner {
+	if config.Parallelism <= 0 {
+		config.Parallelism = DefaultParallelism
+	}
+	if config.StatusInterval <= 0 {
+		config.StatusInterval = DefaultStatusInterval
+	}
+	return &Runner{
+		logger:       logger.WithField("source", "BatchRunner"),
+		config:       config,
+		inferer:      inferer,
+		reader:       reader,
+		output:       bufio.NewWriter(output),
+		reportStatus: reportStatus,
+	}
+}
+
+// Run processes all the records and returns the final status, which has also been reported. An error is
+// returned if the job could not complete, records that failed are only counted.
+func (r *Runner) Run(ctx context.Context) (*Status, error) {
+	logger := r.logger.WithField("func", "Run")
+	r.status = Status{State: StateRunning, StartTime: time.Now()}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	records := make(chan *Record, r.config.Parallelism)
+	outputs := make(chan *Output, r.config.Parallelism)
+
+	var readErr error
+	go func() {
+		defer close(records)
+		readErr = r.read(ctx, records)
+		if readErr != nil {
+			cancel()
+		}
+	}()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < r.config.Parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for record := range records {
+				outputs <- r.process(ctx, record)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(outputs)
+	}()
+
+	var writeErr error
+	ticker := time.NewTicker(r.config.StatusInterval)
+	defer ticker.Stop()
+	for done := false; !done; {
+		select {
+		case output, ok := <-outputs:
+			if !ok {
+				done = true
+				break
+			}
+			if writeErr == nil {
+				writeErr = r.write(output)
+				if writeErr != nil {
+					cancel()
+				}
+			}
+		case <-ticker.C:
+			if err := r.report(); err != nil {
+				logger.WithError(err).Warn("Failed to report status")
+			}
+		}
+	}
+
+	err := errors.Join(readErr, writeErr, r.output.Flush())
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	r.mu.Lock()
+	completionTime := time.Now()
+	r.status.CompletionTime = &completionTime
+	if err != nil {
+		r.status.State = StateFailed
+		r.status.Error = err.Error()
+	} else {
+		r.status.State = StateSucceeded
+	}
+	status := r.status
+	r.mu.Unlock()
+	logger.Infof("Job %s with %d records, %d succeeded and %d failed", status.State, status.Total, status.Succeeded, status.Failed)
+	return &status, errors.Join(err, r.reportStatus(&status))
+}
+
+func (r *Runner) read(ctx context.Context, records chan<- *Record) error {
+	for {
+		record, err := r.reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		r.mu.Lock()
+		r.status.Total++
+		r.mu.Unlock()
+		select {
+		case records <- record:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (r *Runner) process(ctx context.Context, record *Record) *Output {
+	output := &Output{Index: record.Index, Id: record.Id}
+	if output.Id == "" {
+		output.Id = strconv.FormatInt(record.Index, 10)
+	}
+	if record.Err != nil {
+		output.Error = record.Err.Error()
+		return output
+	}
+	record.Request.Id = output.Id
+
+	response, err := r.infer(ctx, record.Request)
+	if err == nil {
+		var b []byte
+		b, err = proto.Marshal(response)
+		if err == nil {
+			output.Response, err = pipeline.ConvertV2ResponseBytesToJson(b)
+		}
+	}
+	if err != nil {
+		output.Error = err.Error()
+		output.Response = nil
+	}
+	return output
+}
+
+// infer retries requests failing with transient errors, with exponential backoff. The inference client
+// already retries each attempt while envoy is unavailable, these retries cover the longer outages of a model.
+func (r *Runner) infer(ctx context.Context, request *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+	backoff := r.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		response, err := r.inferOnce(ctx, request)
+		if err == nil || attempt >= r.config.MaxRetries || !isRetryable(err) {
+			return response, err
+		}
+		r.logger.WithError(err).Debugf("Retrying request %s", request.Id)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+func (r *Runner) inferOnce(ctx context.Context, request *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+	if r.config.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.config.RequestTimeout)
+		defer cancel()
+	}
+	return r.inferer.Infer(ctx, request)
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Runner) write(output *Output) error {
+	r.mu.Lock()
+	if output.Error == "" {
+		r.status.Succeeded++
+	} else {
+		r.status.Failed++
+	}
+	r.mu.Unlock()
+
+	b, err := json.Marshal(output)
+	if err != nil {
+		return err
+	}
+	_, err = r.output.Write(append(b, '\n'))
+	return err
+}
+
+// report flushes the outputs written so far and reports the progress
+func (r *Runner) report() error {
+	if err := r.output.Flush(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	status := r.status
+	r.mu.Unlock()
+	return r.reportStatus(&status)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+)
+
+type fakeRecordReader struct {
+	records []*Record
+	err     error
+}
+
+func (f *fakeRecordReader) Next() (*Record, error) {
+	if len(f.records) == 0 {
+		if f.err != nil {
+			return nil, f.err
+		}
+		return nil, io.EOF
+	}
+	record := f.records[0]
+	f.records = f.records[1:]
+	return record, nil
+}
+
+func (f *fakeRecordReader) Close() error {
+	return nil
+}
+
+// fakeInferer fails the first calls for each request with the given errors
+type fakeInferer struct {
+	mu       sync.Mutex
+	errors   map[string][]error
+	calls    map[string]int
+	inflight int
+	max      int
+}
+
+func (f *fakeInferer) Infer(ctx context.Context, request *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+	f.mu.Lock()
+	f.calls[request.Id]++
+	f.inflight++
+	f.max = max(f.max, f.inflight)
+	var err error
+	if errs := f.errors[request.Id]; len(errs) > 0 {
+		err = errs[0]
+		f.errors[request.Id] = errs[1:]
+	}
+	f.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+	f.mu.Lock()
+	f.inflight--
+	f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return &v2.ModelInferResponse{
+		ModelName: "model",
+		Id:        request.Id,
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{Name: "out", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{1}}},
+		},
+	}, nil
+}
+
+func newTestRecords(n int) []*Record {
+	var records []*Record
+	for i := 0; i < n; i++ {
+		records = append(records, &Record{
+			Index: int64(i),
+			Request: &v2.ModelInferRequest{
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{
+					{Name: "in", Datatype: tyInt64, Shape: []int64{1}, Contents: &v2.InferTensorContents{Int64Contents: []int64{int64(i)}}},
+				},
+			},
+		})
+	}
+	return records
+}
+
+func TestRunner(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name           string
+		records        []*Record
+		readErr        error
+		inferErrors    map[string][]error
+		maxRetries     int
+		expectedState  string
+		expectedOk     int64
+		expectedFailed []int64
+		expectedCalls  map[string]int
+	}
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	tests := []test{
+		{
+			name:          "all succeed",
+			records:       newTestRecords(20),
+			expectedState: StateSucceeded,
+			expectedOk:    20,
+		},
+		{
+			name:           "transient errors are retried",
+			records:        newTestRecords(3),
+			inferErrors:    map[string][]error{"1": {unavailable, unavailable}, "2": {unavailable, unavailable, unavailable}},
+			maxRetries:     2,
+			expectedState:  StateSucceeded,
+			expectedOk:     2,
+			expectedFailed: []int64{2},
+			expectedCalls:  map[string]int{"0": 1, "1": 3, "2": 3},
+		},
+		{
+			name:           "other errors are not retried",
+			records:        newTestRecords(2),
+			inferErrors:    map[string][]error{"0": {status.Error(codes.InvalidArgument, "bad request")}},
+			maxRetries:     2,
+			expectedState:  StateSucceeded,
+			expectedOk:     1,
+			expectedFailed: []int64{0},
+			expectedCalls:  map[string]int{"0": 1, "1": 1},
+		},
+		{
+			name:           "invalid records fail",
+			records:        append(newTestRecords(1), &Record{Index: 1, Err: errors.New("invalid")}),
+			expectedState:  StateSucceeded,
+			expectedOk:     1,
+			expectedFailed: []int64{1},
+		},
+		{
+			name:          "read error fails the job",
+			records:       newTestRecords(2),
+			readErr:       errors.New("read error"),
+			expectedState: StateFailed,
+			expectedOk:    2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inferer := &fakeInferer{errors: test.inferErrors, calls: map[string]int{}}
+			if inferer.errors == nil {
+				inferer.errors = map[string][]error{}
+			}
+			output := &bytes.Buffer{}
+			var reports []*Status
+			runner := NewRunner(
+				log.New(),
+				Config{Parallelism: 4, MaxRetries: test.maxRetries, RetryBackoff: time.Millisecond},
+				inferer,
+				&fakeRecordReader{records: test.records, err: test.readErr},
+				output,
+				func(status *Status) error {
+					reports = append(reports, status)
+					return nil
+				},
+			)
+			status, err := runner.Run(context.Background())
+			if test.expectedState == StateFailed {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(status.State).To(Equal(test.expectedState))
+			g.Expect(status.Succeeded).To(Equal(test.expectedOk))
+			g.Expect(status.Failed).To(Equal(int64(len(test.expectedFailed))))
+			g.Expect(status.Total).To(Equal(int64(len(test.records))))
+			g.Expect(status.CompletionTime).ToNot(BeNil())
+			g.Expect(reports[len(reports)-1]).To(Equal(status))
+			g.Expect(inferer.max).To(BeNumerically("<=", 4))
+			if test.expectedCalls != nil {
+				g.Expect(inferer.calls).To(Equal(test.expectedCalls))
+			}
+
+			var failed []int64
+			scanner := bufio.NewScanner(output)
+			lines := 0
+			for scanner.Scan() {
+				result := &Output{}
+				g.Expect(json.Unmarshal(scanner.Bytes(), result)).To(BeNil())
+				if result.Error != "" {
+					failed = append(failed, result.Index)
+					g.Expect(result.Response).To(BeNil())
+				} else {
+					g.Expect(result.Response).ToNot(BeNil())
+				}
+				lines++
+			}
+			sort.Slice(failed, func(i, j int) bool { return failed[i] < failed[j] })
+			g.Expect(failed).To(Equal(test.expectedFailed))
+			g.Expect(lines).To(Equal(len(test.records)))
+		})
+	}
+}
+
+func TestRunnerReportsProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	inferer := &fakeInferer{errors: map[string][]error{}, calls: map[string]int{}}
+	mu := sync.Mutex{}
+	var reports []*Status
+	runner := NewRunner(
+		log.New(),
+		Config{Parallelism: 1, StatusInterval: 10 * time.Millisecond},
+		inferer,
+		&fakeRecordReader{records: newTestRecords(20)},
+		io.Discard,
+		func(status *Status) error {
+			mu.Lock()
+			defer mu.Unlock()
+			reports = append(reports, status)
+			return nil
+		},
+	)
+	status, err := runner.Run(context.Background())
+	g.Expect(err).To(BeNil())
+	g.Expect(status.Succeeded).To(Equal(int64(20)))
+	g.Expect(len(reports)).To(BeNumerically(">", 1))
+	g.Expect(reports[0].State).To(Equal(StateRunning))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/rclone"
+)
+
+const (
+	rcloneCopyFilePath = "/operations/copyfile"
+)
+
+type rcloneCopyFile struct {
+	SrcFs     string `json:"srcFs"`
+	SrcRemote string `json:"srcRemote"`
+	DstFs     string `json:"dstFs"`
+	DstRemote string `json:"dstRemote"`
+}
+
+// Storage copies files between local paths and the remote storage of an rclone server, e.g. a sidecar of the
+// job. Local paths are used as they are and need no rclone server.
+type Storage struct {
+	logger     log.FieldLogger
+	host       string
+	port       int
+	httpClient *http.Client
+}
+
+func NewStorage(logger log.FieldLogger, host string, port int) *Storage {
+	return &Storage{
+		logger:     logger.WithField("source", "Storage"),
+		host:       host,
+		port:       port,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// IsLocal returns whether the uri is a local path rather than an rclone remote
+func IsLocal(uri string) bool {
+	if strings.HasPrefix(uri, "/") || strings.HasPrefix(uri, ".") {
+		return true
+	}
+	idx := strings.Index(uri, ":")
+	// remotes are named before the first colon, e.g. s3://bucket/file or :s3,provider=AWS:bucket/file
+	return idx == -1 || strings.Contains(uri[:idx], "/")
+}
+
+// Configure creates an rclone remote from a configuration in the json or yaml format of model storage secrets
+func (s *Storage) Configure(ctx context.Context, rawConfig []byte) (string, error) {
+	config := rclone.RcloneConfigCreate{}
+	err := yaml.Unmarshal(rawConfig, &config)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal rclone config: %w", err)
+	}
+	if config.Name == "" || config.Type == "" {
+		return "", errors.New("rclone config requires a name and a type")
+	}
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return config.Name, s.call(ctx, b, rclone.RcloneConfigCreatePath)
+}
+
+// Download copies the file at uri to the local directory and returns its local path
+func (s *Storage) Download(ctx context.Context, uri string, localDir string) (string, error) {
+	if IsLocal(uri) {
+		return uri, nil
+	}
+	dir, file := path.Split(uri)
+	if file == "" {
+		return "", fmt.Errorf("%s is not a file", uri)
+	}
+	s.logger.Infof("Downloading %s to %s", uri, localDir)
+	err := s.copyFile(ctx, dir, file, localDir, file)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", uri, err)
+	}
+	return filepath.Join(localDir, file), nil
+}
+
+// Upload copies the local file to the directory at uri, which for a local directory is where the file already is
+func (s *Storage) Upload(ctx context.Context, localPath string, uri string) error {
+	if IsLocal(uri) {
+		return nil
+	}
+	dir, file := filepath.Split(localPath)
+	s.logger.Debugf("Uploading %s to %s", localPath, uri)
+	err := s.copyFile(ctx, dir, file, uri, file)
+	if err != nil {
+		return fmt.Errorf("failed to upload %s to %s: %w", localPath, uri, err)
+	}
+	return nil
+}
+
+func (s *Storage) copyFile(ctx context.Context, srcFs string, srcRemote string, dstFs string, dstRemote string) error {
+	b, err := json.Marshal(rcloneCopyFile{
+		SrcFs:     srcFs,
+		SrcRemote: srcRemote,
+		DstFs:     dstFs,
+		DstRemote: dstRemote,
+	})
+	if err != nil {
+		return err
+	}
+	return s.call(ctx, b, rcloneCopyFilePath)
+}
+
+func (s *Storage) call(ctx context.Context, op []byte, rcPath string) error {
+	rcloneUrl := url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(s.host, strconv.Itoa(s.port)),
+		Path:   rcPath,
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rcloneUrl.String(), bytes.NewBuffer(op))
+	if err != nil {
+		return err
+	}
+	req.Header.Add(rclone.ContentType, rclone.ContentTypeJSON)
+	response, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed making http post to %s: %w", rcloneUrl.String(), err)
+	}
+	defer response.Body.Close()
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("rclone request to %s failed with status code %d: %s", rcPath, response.StatusCode, string(b))
+	}
+	return nil
+}
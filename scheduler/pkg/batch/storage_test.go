@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/agent/rclone"
+)
+
+func TestIsLocal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		uri      string
+		expected bool
+	}
+
+	tests := []test{
+		{uri: "/mnt/data/input.csv", expected: true},
+		{uri: "./input.csv", expected: true},
+		{uri: "input.csv", expected: true},
+		{uri: "data/a:b.csv", expected: true},
+		{uri: "s3://bucket/input.csv", expected: false},
+		{uri: "gs://bucket/input.csv", expected: false},
+		{uri: ":s3,provider=AWS:bucket/input.csv", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.uri, func(t *testing.T) {
+			g.Expect(IsLocal(test.uri)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestStorage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type call struct {
+		path string
+		body map[string]any
+	}
+	var calls []call
+	failCopies := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body := map[string]any{}
+		g.Expect(json.NewDecoder(req.Body).Decode(&body)).To(BeNil())
+		calls = append(calls, call{path: req.URL.Path, body: body})
+		if failCopies && req.URL.Path == rcloneCopyFilePath {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	g.Expect(err).To(BeNil())
+	port, err := strconv.Atoi(portStr)
+	g.Expect(err).To(BeNil())
+	storage := NewStorage(log.New(), host, port)
+	ctx := context.Background()
+
+	name, err := storage.Configure(ctx, []byte("name: s3\ntype: s3\nparameters:\n  provider: minio\n"))
+	g.Expect(err).To(BeNil())
+	g.Expect(name).To(Equal("s3"))
+	_, err = storage.Configure(ctx, []byte(`{"type":"s3"}`))
+	g.Expect(err).ToNot(BeNil())
+
+	path, err := storage.Download(ctx, "s3://bucket/data/input.csv", "/mnt/batch")
+	g.Expect(err).To(BeNil())
+	g.Expect(path).To(Equal("/mnt/batch/input.csv"))
+	path, err = storage.Download(ctx, "/mnt/data/input.csv", "/mnt/batch")
+	g.Expect(err).To(BeNil())
+	g.Expect(path).To(Equal("/mnt/data/input.csv"))
+
+	g.Expect(storage.Upload(ctx, "/mnt/batch/outputs.jsonl", "s3://bucket/results")).To(BeNil())
+	g.Expect(storage.Upload(ctx, "/mnt/batch/outputs.jsonl", "/mnt/batch")).To(BeNil())
+
+	g.Expect(calls).To(Equal([]call{
+		{
+			path: rclone.RcloneConfigCreatePath,
+			body: map[string]any{"name": "s3", "type": "s3", "parameters": map[string]any{"provider": "minio"}, "opt": nil},
+		},
+		{
+			path: rcloneCopyFilePath,
+			body: map[string]any{"srcFs": "s3://bucket/data/", "srcRemote": "input.csv", "dstFs": "/mnt/batch", "dstRemote": "input.csv"},
+		},
+		{
+			path: rcloneCopyFilePath,
+			body: map[string]any{"srcFs": "/mnt/batch/", "srcRemote": "outputs.jsonl", "dstFs": "s3://bucket/results", "dstRemote": "outputs.jsonl"},
+		},
+	}))
+
+	failCopies = true
+	_, err = storage.Download(ctx, "s3://bucket/data/input.csv", "/mnt/batch")
+	g.Expect(err).ToNot(BeNil())
+}
@@ -17,6 +17,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/envoy/xdscache"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/cleaner"
@@ -118,7 +120,7 @@ func (p *IncrementalProcessor) handlePipelineStreamsEvents(event coordinator.Pip
 	// If models are ready or not has no bearing on whether we need to update pipeline
 	if !event.ModelStatusChange {
 		go func() {
-			err := p.xdsCache.AddPipelineClusters(&event, p.pipelineHandler, logger)
+			err := p.xdsCache.AddPipelineClusters(&event, p.pipelineHandler, p.getPipelineStreamsRouting(event.PipelineName), logger)
 			if err != nil {
 				logger.WithError(err).Errorf("Failed to add clusters for pipeline %s", event.PipelineName)
 				return
@@ -205,7 +207,7 @@ func (p *IncrementalProcessor) removeRouteForServerInEnvoyCache(routeName string
 	return nil
 }
 
-func (p *IncrementalProcessor) updateEnvoyForModelVersion(routeName string, modelVersion *store.ModelVersion, server *store.ServerSnapshot, trafficPercent uint32, isMirror bool) {
+func (p *IncrementalProcessor) updateEnvoyForModelVersion(routeName string, modelVersion *store.ModelVersion, server *store.ServerSnapshot, trafficPercent uint32, matches []xdscache.MatchRule, isMirror bool) {
 	logger := p.logger.WithField("func", "updateEnvoyForModelVersion")
 	assignment := modelVersion.GetAssignment()
 	if len(assignment) == 0 {
@@ -215,15 +217,21 @@ func (p *IncrementalProcessor) updateEnvoyForModelVersion(routeName string, mode
 	modelName := modelVersion.GetMeta().GetName()
 	modelVersionNumber := modelVersion.GetVersion()
 	httpClusterName, grpcClusterName := getClusterNames(modelName, modelVersionNumber)
-	p.xdsCache.AddClustersForRoute(routeName, modelName, httpClusterName, grpcClusterName, modelVersionNumber, assignment, server)
 
 	logPayloads := false
+	var auth *xdscache.AuthRule
+	var rateLimit *xdscache.RateLimit
+	var routing *xdscache.Routing
 	if modelVersion.GetDeploymentSpec() != nil {
 		logPayloads = modelVersion.GetDeploymentSpec().LogPayloads
+		auth = getAuthRule(modelVersion.GetDeploymentSpec().GetAuth())
+		rateLimit = getRateLimit(modelVersion.GetDeploymentSpec().GetRateLimit())
+		routing = getRouting(modelVersion.GetDeploymentSpec().GetRouting())
 	} else {
 		logger.Warnf("model %s has not deployment spec", modelName)
 	}
-	p.xdsCache.AddRouteClusterTraffic(routeName, modelName, httpClusterName, grpcClusterName, modelVersionNumber, trafficPercent, logPayloads, isMirror)
+	p.xdsCache.AddClustersForRoute(routeName, modelName, httpClusterName, grpcClusterName, modelVersionNumber, assignment, server, routing)
+	p.xdsCache.AddRouteClusterTraffic(routeName, modelName, httpClusterName, grpcClusterName, modelVersionNumber, trafficPercent, matches, logPayloads, auth, rateLimit, routing, isMirror)
 }
 
 func getClusterNames(modelVersion string, modelVersionNumber uint32) (string, string) {
@@ -242,7 +250,236 @@ func getTrafficShare(latestModel *store.ModelVersion, lastAvailableModelVersion
 	return trafficLatestModel, trafficLastAvailableModel
 }
 
-func (p *IncrementalProcessor) addModelTraffic(routeName string, model *store.ModelSnapshot, weight uint32, isMirror bool) error {
+// getCanaryTrafficShare splits the traffic by the progress of the rollout of the latest version,
+// which receives no traffic until the rollout starts or after it is rolled back
+func getCanaryTrafficShare(latestModel *store.ModelVersion, weight uint32) (uint32, uint32) {
+	var trafficPercent uint32
+	if rollout := latestModel.ModelState().Rollout; rollout != nil && rollout.State == store.RolloutProgressing {
+		trafficPercent = min(rollout.TrafficPercent, 100)
+	}
+	trafficLatestModel := (weight * trafficPercent) / 100
+	return trafficLatestModel, weight - trafficLatestModel
+}
+
+func getMatchRules(matches []*experiment.Match) []xdscache.MatchRule {
+	if len(matches) == 0 {
+		return nil
+	}
+	rules := make([]xdscache.MatchRule, len(matches))
+	for idx, match := range matches {
+		rules[idx] = xdscache.MatchRule{
+			Header:         match.Header,
+			QueryParameter: match.QueryParameter,
+			Exact:          match.Exact,
+			Regex:          match.Regex,
+		}
+	}
+	return rules
+}
+
+func getAuthRule(auth *scheduler.AuthSpec) *xdscache.AuthRule {
+	if auth == nil {
+		return nil
+	}
+	return &xdscache.AuthRule{
+		Audiences: auth.Audiences,
+		Groups:    auth.Groups,
+	}
+}
+
+// getPipelineAuthRules returns the auth rules of a pipeline version, none when it has no rules
+func getPipelineAuthRules(pv *pipeline.PipelineVersion) []*xdscache.AuthRule {
+	if pv == nil || pv.Auth == nil {
+		return nil
+	}
+	return []*xdscache.AuthRule{{
+		Audiences: pv.Auth.Audiences,
+		Groups:    pv.Auth.Groups,
+	}}
+}
+
+// getExperimentPipelineAuthRules returns the auth rules of every candidate pipeline, all of which must be
+// matched as requests to the experiment can be routed to any of them
+func (p *IncrementalProcessor) getExperimentPipelineAuthRules(exp *experiment.Experiment) []*xdscache.AuthRule {
+	var rules []*xdscache.AuthRule
+	for _, candidate := range exp.Candidates {
+		pip, err := p.pipelineHandler.GetPipeline(candidate.Name)
+		if err != nil {
+			continue
+		}
+		rules = append(rules, getPipelineAuthRules(pip.GetLatestPipelineVersion())...)
+	}
+	return rules
+}
+
+func getRateLimit(rateLimit *scheduler.RateLimitSpec) *xdscache.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	var unit time.Duration
+	switch rateLimit.Unit {
+	case scheduler.RateLimitSpec_MINUTE:
+		unit = time.Minute
+	case scheduler.RateLimitSpec_HOUR:
+		unit = time.Hour
+	default:
+		unit = time.Second
+	}
+	xdsRateLimit := &xdscache.RateLimit{
+		TokenBucket: getTokenBucket(rateLimit.RequestsPerUnit, rateLimit.Burst, unit),
+	}
+	for _, tenant := range rateLimit.Tenants {
+		xdsRateLimit.Tenants = append(xdsRateLimit.Tenants, xdscache.TenantRateLimit{
+			Value:       tenant.Value,
+			TokenBucket: getTokenBucket(tenant.RequestsPerUnit, tenant.Burst, unit),
+		})
+	}
+	return xdsRateLimit
+}
+
+func getPipelineRateLimit(pv *pipeline.PipelineVersion) *xdscache.RateLimit {
+	if pv == nil || pv.RateLimit == nil {
+		return nil
+	}
+	xdsRateLimit := &xdscache.RateLimit{
+		TokenBucket: getTokenBucket(pv.RateLimit.RequestsPerUnit, pv.RateLimit.Burst, pv.RateLimit.Unit),
+	}
+	for _, tenant := range pv.RateLimit.Tenants {
+		xdsRateLimit.Tenants = append(xdsRateLimit.Tenants, xdscache.TenantRateLimit{
+			Value:       tenant.Value,
+			TokenBucket: getTokenBucket(tenant.RequestsPerUnit, tenant.Burst, pv.RateLimit.Unit),
+		})
+	}
+	return xdsRateLimit
+}
+
+// getTokenBucket refills the requests per unit once per unit, a full bucket holds at least one unit of requests
+func getTokenBucket(requestsPerUnit uint32, burst uint32, unit time.Duration) xdscache.TokenBucket {
+	return xdscache.TokenBucket{
+		TokensPerFill: requestsPerUnit,
+		MaxTokens:     max(requestsPerUnit, burst),
+		FillInterval:  unit,
+	}
+}
+
+// getExperimentPipelineRateLimit returns the rate limit of the first candidate pipeline with a limit,
+// as requests to the experiment can be routed to any of them
+func (p *IncrementalProcessor) getExperimentPipelineRateLimit(exp *experiment.Experiment) *xdscache.RateLimit {
+	for _, candidate := range exp.Candidates {
+		pip, err := p.pipelineHandler.GetPipeline(candidate.Name)
+		if err != nil {
+			continue
+		}
+		if rateLimit := getPipelineRateLimit(pip.GetLatestPipelineVersion()); rateLimit != nil {
+			return rateLimit
+		}
+	}
+	return nil
+}
+
+func getRouting(routing *scheduler.RoutingSpec) *xdscache.Routing {
+	if routing == nil {
+		return nil
+	}
+	xdsRouting := &xdscache.Routing{
+		Timeout:        time.Duration(routing.TimeoutMs) * time.Millisecond,
+		ConnectTimeout: time.Duration(routing.ConnectTimeoutMs) * time.Millisecond,
+		HashHeader:     routing.HashHeader,
+	}
+	switch routing.LoadBalancer {
+	case scheduler.RoutingSpec_ROUND_ROBIN:
+		xdsRouting.LoadBalancer = xdscache.RoundRobinLoadBalancer
+	case scheduler.RoutingSpec_RING_HASH:
+		xdsRouting.LoadBalancer = xdscache.RingHashLoadBalancer
+	case scheduler.RoutingSpec_RANDOM:
+		xdsRouting.LoadBalancer = xdscache.RandomLoadBalancer
+	default:
+		xdsRouting.LoadBalancer = xdscache.LeastRequestLoadBalancer
+	}
+	if retry := routing.Retry; retry != nil {
+		xdsRouting.Retry = &xdscache.RetryPolicy{
+			RetryOn:             retry.RetryOn,
+			MaxRetries:          retry.MaxRetries,
+			PerTryTimeout:       time.Duration(retry.PerTryTimeoutMs) * time.Millisecond,
+			BackoffBaseInterval: time.Duration(retry.BackoffBaseIntervalMs) * time.Millisecond,
+			BudgetPercent:       retry.BudgetPercent,
+		}
+	}
+	if outlierDetection := routing.OutlierDetection; outlierDetection != nil {
+		xdsRouting.OutlierDetection = &xdscache.OutlierDetection{
+			Consecutive5xx:     outlierDetection.Consecutive5Xx,
+			Interval:           time.Duration(outlierDetection.IntervalSeconds) * time.Second,
+			BaseEjectionTime:   time.Duration(outlierDetection.BaseEjectionTimeSeconds) * time.Second,
+			MaxEjectionPercent: outlierDetection.MaxEjectionPercent,
+		}
+	}
+	return xdsRouting
+}
+
+func getPipelineRouting(pv *pipeline.PipelineVersion) *xdscache.Routing {
+	if pv == nil || pv.Routing == nil {
+		return nil
+	}
+	xdsRouting := &xdscache.Routing{
+		Timeout:        pv.Routing.Timeout,
+		ConnectTimeout: pv.Routing.ConnectTimeout,
+		HashHeader:     pv.Routing.HashHeader,
+	}
+	switch pv.Routing.LoadBalancer {
+	case pipeline.RoundRobinLoadBalancer:
+		xdsRouting.LoadBalancer = xdscache.RoundRobinLoadBalancer
+	case pipeline.RingHashLoadBalancer:
+		xdsRouting.LoadBalancer = xdscache.RingHashLoadBalancer
+	case pipeline.RandomLoadBalancer:
+		xdsRouting.LoadBalancer = xdscache.RandomLoadBalancer
+	default:
+		xdsRouting.LoadBalancer = xdscache.LeastRequestLoadBalancer
+	}
+	if retry := pv.Routing.Retry; retry != nil {
+		xdsRouting.Retry = &xdscache.RetryPolicy{
+			RetryOn:             retry.RetryOn,
+			MaxRetries:          retry.MaxRetries,
+			PerTryTimeout:       retry.PerTryTimeout,
+			BackoffBaseInterval: retry.BackoffBaseInterval,
+			BudgetPercent:       retry.BudgetPercent,
+		}
+	}
+	if outlierDetection := pv.Routing.OutlierDetection; outlierDetection != nil {
+		xdsRouting.OutlierDetection = &xdscache.OutlierDetection{
+			Consecutive5xx:     outlierDetection.Consecutive5xx,
+			Interval:           outlierDetection.Interval,
+			BaseEjectionTime:   outlierDetection.BaseEjectionTime,
+			MaxEjectionPercent: outlierDetection.MaxEjectionPercent,
+		}
+	}
+	return xdsRouting
+}
+
+// getPipelineStreamsRouting returns the routing of the clusters of the pipeline gateways serving a pipeline
+func (p *IncrementalProcessor) getPipelineStreamsRouting(pipelineName string) *xdscache.Routing {
+	pip, err := p.pipelineHandler.GetPipeline(pipelineName)
+	if err != nil {
+		return nil
+	}
+	return getPipelineRouting(pip.GetLatestPipelineVersion())
+}
+
+// getExperimentPipelineRouting returns the routing of the first candidate pipeline with routing,
+// as requests to the experiment can be routed to any of them
+func (p *IncrementalProcessor) getExperimentPipelineRouting(exp *experiment.Experiment) *xdscache.Routing {
+	for _, candidate := range exp.Candidates {
+		pip, err := p.pipelineHandler.GetPipeline(candidate.Name)
+		if err != nil {
+			continue
+		}
+		if routing := getPipelineRouting(pip.GetLatestPipelineVersion()); routing != nil {
+			return routing
+		}
+	}
+	return nil
+}
+
+func (p *IncrementalProcessor) addModelTraffic(routeName string, model *store.ModelSnapshot, weight uint32, matches []xdscache.MatchRule, isMirror bool) error {
 	logger := p.logger.WithField("func", "addModelTraffic")
 
 	modelName := model.Name
@@ -259,6 +496,32 @@ func (p *IncrementalProcessor) addModelTraffic(routeName string, model *store.Mo
 		return err
 	}
 
+	stableModelVersion := model.GetCanaryStableModel()
+	if stableModelVersion != nil && len(stableModelVersion.GetAssignment()) > 0 {
+		trafficLatestModel, trafficStableModel := getCanaryTrafficShare(latestModel, weight)
+		stableServer, err := p.modelStore.GetServer(stableModelVersion.Server(), false, false)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to find server %s for stable model %s", stableModelVersion.Server(), modelName)
+			return err
+		}
+
+		logger.Debugf("Canary rollout splitting traffic between latest %s:%d %d percent and stable %s:%d %d percent",
+			modelName,
+			latestModel.GetVersion(),
+			trafficLatestModel,
+			modelName,
+			stableModelVersion.GetVersion(),
+			trafficStableModel)
+
+		if trafficStableModel > 0 {
+			p.updateEnvoyForModelVersion(routeName, stableModelVersion, stableServer, trafficStableModel, matches, isMirror)
+		}
+		if trafficLatestModel > 0 {
+			p.updateEnvoyForModelVersion(routeName, latestModel, server, trafficLatestModel, matches, isMirror)
+		}
+		return nil
+	}
+
 	lastAvailableModelVersion := model.GetLastAvailableModel()
 	if lastAvailableModelVersion != nil && latestModel.GetVersion() != lastAvailableModelVersion.GetVersion() {
 		trafficLatestModel, trafficLastAvailableModel := getTrafficShare(latestModel, lastAvailableModelVersion, weight)
@@ -276,10 +539,10 @@ func (p *IncrementalProcessor) addModelTraffic(routeName string, model *store.Mo
 			lastAvailableModelVersion.GetVersion(),
 			trafficLastAvailableModel)
 
-		p.updateEnvoyForModelVersion(routeName, lastAvailableModelVersion, lastAvailableServer, trafficLastAvailableModel, isMirror)
-		p.updateEnvoyForModelVersion(routeName, latestModel, server, trafficLatestModel, isMirror)
+		p.updateEnvoyForModelVersion(routeName, lastAvailableModelVersion, lastAvailableServer, trafficLastAvailableModel, matches, isMirror)
+		p.updateEnvoyForModelVersion(routeName, latestModel, server, trafficLatestModel, matches, isMirror)
 	} else {
-		p.updateEnvoyForModelVersion(routeName, latestModel, server, weight, isMirror)
+		p.updateEnvoyForModelVersion(routeName, latestModel, server, weight, matches, isMirror)
 	}
 	return nil
 }
@@ -301,22 +564,28 @@ func (p *IncrementalProcessor) addExperimentModelBaselineTraffic(model *store.Mo
 		if err != nil {
 			return err
 		}
-		err = p.addModelTraffic(model.Name, candidateModel, candidate.Weight, false)
+		err = p.addModelTraffic(model.Name, candidateModel, candidate.Weight, getMatchRules(candidate.Matches), false)
 		if err != nil {
 			return err
 		}
 	}
-	if exp.Mirror != nil {
-		mirrorModel, err := p.modelStore.GetModel(exp.Mirror.Name)
+	for _, mirror := range exp.Mirrors {
+		mirrorModel, err := p.modelStore.GetModel(mirror.Name)
 		if err != nil {
 			return err
 		}
 		logger.Infof("Getting mirror model %s to add to model %s", mirrorModel.Name, model.Name)
-		err = p.addModelTraffic(model.Name, mirrorModel, exp.Mirror.Percent, true)
+		err = p.addModelTraffic(model.Name, mirrorModel, mirror.Percent, nil, true)
 		if err != nil {
 			return err
 		}
 	}
+	if exp.CaptureResponses {
+		p.xdsCache.SetRouteCaptureExperiment(model.Name, exp.Name)
+	}
+	if exp.Bandit != nil {
+		p.xdsCache.SetRouteBanditExperiment(model.Name, exp.Name)
+	}
 	return nil
 }
 
@@ -331,11 +600,11 @@ func (p *IncrementalProcessor) addModel(model *store.ModelSnapshot) error {
 			if err != nil {
 				return err
 			}
-			return p.addModelTraffic(model.Name, model, 100, false)
+			return p.addModelTraffic(model.Name, model, 100, nil, false)
 		}
 	} else {
 		logger.Infof("Handle vanilla no experiment traffic for %s", model.Name)
-		return p.addModelTraffic(model.Name, model, 100, false)
+		return p.addModelTraffic(model.Name, model, 100, nil, false)
 	}
 	return nil
 }
@@ -344,17 +613,20 @@ func (p *IncrementalProcessor) addTrafficForExperiment(routeName string, exp *ex
 	switch exp.ResourceType {
 	case experiment.PipelineResourceType:
 
-		var mirrorSplit *xdscache.PipelineTrafficSplit
+		var mirrorSplits []xdscache.PipelineTrafficSplit
 		trafficSplits := make([]xdscache.PipelineTrafficSplit, len(exp.Candidates))
 
 		for _, candidate := range exp.Candidates {
-			trafficSplits = append(trafficSplits, xdscache.PipelineTrafficSplit{PipelineName: candidate.Name, TrafficWeight: candidate.Weight})
+			trafficSplits = append(trafficSplits, xdscache.PipelineTrafficSplit{PipelineName: candidate.Name, TrafficWeight: candidate.Weight, Matches: getMatchRules(candidate.Matches)})
 		}
-		if exp.Mirror != nil {
-			mirrorSplit = &xdscache.PipelineTrafficSplit{PipelineName: exp.Mirror.Name, TrafficWeight: exp.Mirror.Percent}
+		for _, mirror := range exp.Mirrors {
+			mirrorSplits = append(mirrorSplits, xdscache.PipelineTrafficSplit{PipelineName: mirror.Name, TrafficWeight: mirror.Percent})
 		}
 
-		p.xdsCache.AddPipelineRoute(routeName, trafficSplits, mirrorSplit)
+		p.xdsCache.AddPipelineRoute(routeName, trafficSplits, mirrorSplits, p.getExperimentPipelineAuthRules(exp), p.getExperimentPipelineRateLimit(exp), p.getExperimentPipelineRouting(exp))
+		if exp.Bandit != nil {
+			p.xdsCache.SetPipelineRouteBanditExperiment(routeName, exp.Name)
+		}
 
 	case experiment.ModelResourceType:
 		for _, candidate := range exp.Candidates {
@@ -362,21 +634,27 @@ func (p *IncrementalProcessor) addTrafficForExperiment(routeName string, exp *ex
 			if err != nil {
 				return err
 			}
-			err = p.addModelTraffic(routeName, candidateModel, candidate.Weight, false)
+			err = p.addModelTraffic(routeName, candidateModel, candidate.Weight, getMatchRules(candidate.Matches), false)
 			if err != nil {
 				return err
 			}
 		}
-		if exp.Mirror != nil {
-			mirrorModel, err := p.modelStore.GetModel(exp.Mirror.Name)
+		for _, mirror := range exp.Mirrors {
+			mirrorModel, err := p.modelStore.GetModel(mirror.Name)
 			if err != nil {
 				return err
 			}
-			err = p.addModelTraffic(routeName, mirrorModel, exp.Mirror.Percent, true)
+			err = p.addModelTraffic(routeName, mirrorModel, mirror.Percent, nil, true)
 			if err != nil {
 				return err
 			}
 		}
+		if exp.CaptureResponses {
+			p.xdsCache.SetRouteCaptureExperiment(routeName, exp.Name)
+		}
+		if exp.Bandit != nil {
+			p.xdsCache.SetRouteBanditExperiment(routeName, exp.Name)
+		}
 	default:
 		return fmt.Errorf("Unknown resource type %v", exp.ResourceType)
 	}
@@ -438,6 +716,10 @@ func (p *IncrementalProcessor) addPipeline(pipelineName string) error {
 	}
 
 	routeName := getPipelineRouteName(pip.Name)
+	// Ensembles are called like models so are routed on their name without the pipeline suffix
+	if pv := pip.GetLatestPipelineVersion(); pv != nil && pv.Ensemble != nil {
+		routeName = pip.Name
+	}
 	p.xdsCache.RemovePipelineRoute(routeName)
 	exp := p.experimentServer.GetExperimentForBaselinePipeline(pip.Name)
 	logger.Debugf("getting experiment for baseline %s returned %v", pip.Name, exp)
@@ -453,20 +735,23 @@ func (p *IncrementalProcessor) addPipeline(pipelineName string) error {
 		if exp.Deleted {
 			return fmt.Errorf("Experiment on pipeline %s, but %s is deleted", pip.Name, *exp.Default)
 		}
-		var mirrorSplit *xdscache.PipelineTrafficSplit
+		var mirrorSplits []xdscache.PipelineTrafficSplit
 		trafficSplits := make([]xdscache.PipelineTrafficSplit, len(exp.Candidates))
 
 		for _, candidate := range exp.Candidates {
-			trafficSplits = append(trafficSplits, xdscache.PipelineTrafficSplit{PipelineName: candidate.Name, TrafficWeight: candidate.Weight})
+			trafficSplits = append(trafficSplits, xdscache.PipelineTrafficSplit{PipelineName: candidate.Name, TrafficWeight: candidate.Weight, Matches: getMatchRules(candidate.Matches)})
 		}
-		if exp.Mirror != nil {
-			mirrorSplit = &xdscache.PipelineTrafficSplit{PipelineName: exp.Mirror.Name, TrafficWeight: exp.Mirror.Percent}
+		for _, mirror := range exp.Mirrors {
+			mirrorSplits = append(mirrorSplits, xdscache.PipelineTrafficSplit{PipelineName: mirror.Name, TrafficWeight: mirror.Percent})
 		}
 
-		p.xdsCache.AddPipelineRoute(routeName, trafficSplits, mirrorSplit)
+		p.xdsCache.AddPipelineRoute(routeName, trafficSplits, mirrorSplits, p.getExperimentPipelineAuthRules(exp), p.getExperimentPipelineRateLimit(exp), p.getExperimentPipelineRouting(exp))
+		if exp.Bandit != nil {
+			p.xdsCache.SetPipelineRouteBanditExperiment(routeName, exp.Name)
+		}
 	} else {
 		logger.Infof("Adding normal pipeline route %s", pip.Name)
-		p.xdsCache.AddPipelineRoute(routeName, []xdscache.PipelineTrafficSplit{{PipelineName: pip.Name, TrafficWeight: 100}}, nil)
+		p.xdsCache.AddPipelineRoute(routeName, []xdscache.PipelineTrafficSplit{{PipelineName: pip.Name, TrafficWeight: 100}}, nil, getPipelineAuthRules(pip.GetLatestPipelineVersion()), getPipelineRateLimit(pip.GetLatestPipelineVersion()), getPipelineRouting(pip.GetLatestPipelineVersion()))
 	}
 
 	return p.updateEnvoy()
@@ -138,6 +138,162 @@ func TestGetTrafficShare(t *testing.T) {
 	}
 }
 
+func TestGetCanaryTrafficShare(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name                      string
+		rollout                   *store.RolloutStatus
+		weight                    uint32
+		expectedLatestModelWeight uint32
+		expectedStableModelWeight uint32
+	}
+
+	tests := []test{
+		{
+			name:                      "rollout not started",
+			weight:                    100,
+			expectedLatestModelWeight: 0,
+			expectedStableModelWeight: 100,
+		},
+		{
+			name:                      "rollout progressing",
+			rollout:                   &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1, TrafficPercent: 20},
+			weight:                    100,
+			expectedLatestModelWeight: 20,
+			expectedStableModelWeight: 80,
+		},
+		{
+			name:                      "rollout progressing with experiment weight",
+			rollout:                   &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1, TrafficPercent: 20},
+			weight:                    50,
+			expectedLatestModelWeight: 10,
+			expectedStableModelWeight: 40,
+		},
+		{
+			name:                      "rolled back",
+			rollout:                   &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1},
+			weight:                    100,
+			expectedLatestModelWeight: 0,
+			expectedStableModelWeight: 100,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latestModel := store.NewModelVersion(nil, 2, "server", map[int]store.ReplicaStatus{1: {State: store.Available}}, false, store.ModelAvailable)
+			latestModel.SetModelState(store.ModelStatus{State: store.ModelAvailable, Rollout: test.rollout})
+			latestModelTraffic, stableModelTraffic := getCanaryTrafficShare(latestModel, test.weight)
+			g.Expect(latestModelTraffic).To(Equal(test.expectedLatestModelWeight))
+			g.Expect(stableModelTraffic).To(Equal(test.expectedStableModelWeight))
+		})
+	}
+}
+
+func TestGetRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name              string
+		rateLimit         *scheduler.RateLimitSpec
+		expectedRateLimit *xdscache.RateLimit
+	}
+
+	tests := []test{
+		{
+			name: "no rate limit",
+		},
+		{
+			name:      "burst defaults to requests per unit",
+			rateLimit: &scheduler.RateLimitSpec{RequestsPerUnit: 10},
+			expectedRateLimit: &xdscache.RateLimit{
+				TokenBucket: xdscache.TokenBucket{TokensPerFill: 10, MaxTokens: 10, FillInterval: time.Second},
+			},
+		},
+		{
+			name: "tenants",
+			rateLimit: &scheduler.RateLimitSpec{
+				RequestsPerUnit: 100,
+				Unit:            scheduler.RateLimitSpec_HOUR,
+				Burst:           200,
+				Header:          "x-api-key",
+				Tenants: []*scheduler.TenantRateLimit{
+					{Value: "batch", RequestsPerUnit: 10, Burst: 5},
+				},
+			},
+			expectedRateLimit: &xdscache.RateLimit{
+				TokenBucket: xdscache.TokenBucket{TokensPerFill: 100, MaxTokens: 200, FillInterval: time.Hour},
+				Tenants: []xdscache.TenantRateLimit{
+					{Value: "batch", TokenBucket: xdscache.TokenBucket{TokensPerFill: 10, MaxTokens: 10, FillInterval: time.Hour}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(getRateLimit(test.rateLimit)).To(Equal(test.expectedRateLimit))
+		})
+	}
+}
+
+func TestGetRouting(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name            string
+		routing         *scheduler.RoutingSpec
+		expectedRouting *xdscache.Routing
+	}
+
+	maxRetries := uint32(2)
+	tests := []test{
+		{
+			name: "no routing",
+		},
+		{
+			name:    "defaults",
+			routing: &scheduler.RoutingSpec{},
+			expectedRouting: &xdscache.Routing{
+				LoadBalancer: xdscache.LeastRequestLoadBalancer,
+			},
+		},
+		{
+			name: "routing",
+			routing: &scheduler.RoutingSpec{
+				TimeoutMs:        1500,
+				ConnectTimeoutMs: 250,
+				Retry: &scheduler.RetrySpec{
+					RetryOn:               []string{"reset"},
+					MaxRetries:            &maxRetries,
+					BackoffBaseIntervalMs: 100,
+				},
+				OutlierDetection: &scheduler.OutlierDetectionSpec{
+					Consecutive5Xx:          3,
+					BaseEjectionTimeSeconds: 60,
+				},
+				LoadBalancer: scheduler.RoutingSpec_RING_HASH,
+				HashHeader:   "x-session-id",
+			},
+			expectedRouting: &xdscache.Routing{
+				Timeout:        1500 * time.Millisecond,
+				ConnectTimeout: 250 * time.Millisecond,
+				Retry: &xdscache.RetryPolicy{
+					RetryOn:             []string{"reset"},
+					MaxRetries:          &maxRetries,
+					BackoffBaseInterval: 100 * time.Millisecond,
+				},
+				OutlierDetection: &xdscache.OutlierDetection{
+					Consecutive5xx:   3,
+					BaseEjectionTime: time.Minute,
+				},
+				LoadBalancer: xdscache.RingHashLoadBalancer,
+				HashHeader:   "x-session-id",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g.Expect(getRouting(test.routing)).To(Equal(test.expectedRouting))
+		})
+	}
+}
+
 func TestUpdateEnvoyForModelVersion(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type test struct {
@@ -297,7 +453,7 @@ func TestUpdateEnvoyForModelVersion(t *testing.T) {
 				xdsCache: xdsCache,
 			}
 			for _, mv := range test.modelVersions {
-				inc.updateEnvoyForModelVersion(mv.GetMeta().GetName(), mv, test.server, test.traffic, false)
+				inc.updateEnvoyForModelVersion(mv.GetMeta().GetName(), mv, test.server, test.traffic, nil, false)
 			}
 
 			g.Expect(inc.xdsCache.Routes.Length()).To(Equal(test.expectedRoutes))
@@ -1015,9 +1171,8 @@ func getTrafficSplits(virtualHost *routev3.VirtualHost) []xdscache.Route {
 
 		}
 
-		if len(route.GetRoute().RequestMirrorPolicies) > 0 {
-			mirror := route.GetRoute().RequestMirrorPolicies[0]
-			trafficSplit.Mirror = &xdscache.TrafficSplit{ModelName: mirror.Cluster, TrafficWeight: mirror.RuntimeFraction.DefaultValue.Numerator}
+		for _, mirror := range route.GetRoute().RequestMirrorPolicies {
+			trafficSplit.Mirrors = append(trafficSplit.Mirrors, xdscache.TrafficSplit{ModelName: mirror.Cluster, TrafficWeight: mirror.RuntimeFraction.DefaultValue.Numerator})
 		}
 
 		trafficSplits = append(trafficSplits, trafficSplit)
@@ -1128,21 +1283,21 @@ func removeTestModel(
 func createTestExperiment(experimentName string, modelNames []string, defaultModel *string, mirrorName *string) func(inc *IncrementalProcessor, g *WithT) {
 	f := func(inc *IncrementalProcessor, g *WithT) {
 		var candidates []*experiment.Candidate
-		var mirror *experiment.Mirror
+		var mirrors []*experiment.Mirror
 		for _, modelName := range modelNames {
 			candidates = append(candidates, &experiment.Candidate{Name: modelName, Weight: 1})
 		}
 		if mirrorName != nil {
-			mirror = &experiment.Mirror{
+			mirrors = append(mirrors, &experiment.Mirror{
 				Name:    *mirrorName,
 				Percent: 100,
-			}
+			})
 		}
 		exp := &experiment.Experiment{
 			Name:       experimentName,
 			Default:    defaultModel,
 			Candidates: candidates,
-			Mirror:     mirror,
+			Mirrors:    mirrors,
 		}
 		err := inc.experimentServer.StartExperiment(exp)
 		g.Expect(err).To(BeNil())
@@ -1164,6 +1319,64 @@ func deleteTestExperiment(experimentName string) func(inc *IncrementalProcessor,
 	return f
 }
 
+func TestAddPipelineRouteName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name              string
+		pipeline          *scheduler.Pipeline
+		expectedRouteName string
+	}
+
+	tests := []test{
+		{
+			name: "pipeline",
+			pipeline: &scheduler.Pipeline{
+				Name:  "pipe",
+				Steps: []*scheduler.PipelineStep{{Name: "model1"}},
+			},
+			expectedRouteName: "pipe.pipeline",
+		},
+		{
+			name: "ensemble",
+			pipeline: &scheduler.Pipeline{
+				Name:     "ens",
+				Steps:    []*scheduler.PipelineStep{{Name: "model1"}, {Name: "model2"}},
+				Ensemble: &scheduler.EnsembleSpec{Aggregation: scheduler.EnsembleSpec_MEAN},
+			},
+			expectedRouteName: "ens",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger := log.New()
+			eventHub, _ := coordinator.NewEventHub(logger)
+			memoryStore := store.NewMemoryStore(logger, store.NewLocalSchedulerStore(), eventHub)
+			xdsCache, err := xdscache.NewSeldonXDSCache(logger, &xdscache.PipelineGatewayDetails{Host: "pipeline", GrpcPort: 1, HttpPort: 2}, nil)
+			g.Expect(err).To(BeNil())
+			inc := &IncrementalProcessor{
+				logger:           logger.WithField("source", "IncrementalProcessor"),
+				xdsCache:         xdsCache,
+				modelStore:       memoryStore,
+				experimentServer: experiment.NewExperimentServer(logger, eventHub, memoryStore, nil),
+				pipelineHandler:  pipeline.NewPipelineStore(logger, eventHub, memoryStore),
+			}
+
+			err = inc.pipelineHandler.AddPipeline(test.pipeline)
+			g.Expect(err).To(BeNil())
+			err = inc.addPipeline(test.pipeline.Name)
+			g.Expect(err).To(BeNil())
+
+			g.Expect(inc.xdsCache.Pipelines.Length()).To(Equal(1))
+			route, ok := inc.xdsCache.Pipelines.Load(test.expectedRouteName)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(route.RouteName).To(Equal(test.expectedRouteName))
+			g.Expect(route.Clusters[0].PipelineName).To(Equal(test.pipeline.Name))
+		})
+	}
+}
+
 func createTestPipeline(pipelineName string, modelNames []string, version uint32) func(inc *IncrementalProcessor, g *WithT) {
 	f := func(inc *IncrementalProcessor, g *WithT) {
 		steps := []*scheduler.PipelineStep{}
@@ -1190,7 +1403,7 @@ func createTestPipeline(pipelineName string, modelNames []string, version uint32
 			StreamNames: []string{"dummy-stream"},
 			StreamIps:   []string{"dummy-ip"},
 		}
-		err = inc.xdsCache.AddPipelineClusters(&event, inc.pipelineHandler, inc.logger.WithField("source", "IncrementalProcessor"))
+		err = inc.xdsCache.AddPipelineClusters(&event, inc.pipelineHandler, nil, inc.logger.WithField("source", "IncrementalProcessor"))
 		g.Expect(err).To(BeNil())
 
 		err = inc.addPipeline(pipelineName)
@@ -0,0 +1,362 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package xdscache
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	rbacconfig "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	extauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	jwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	duration "google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	DefaultJwtGroupsClaim = "groups"
+
+	jwtAuthnFilterName    = "envoy.filters.http.jwt_authn"
+	rbacFilterName        = "envoy.filters.http.rbac"
+	extAuthzFilterName    = "envoy.filters.http.ext_authz"
+	jwtProviderName       = "seldon"
+	jwtPayloadMetadataKey = "jwt_payload"
+	jwtAudienceClaim      = "aud"
+	authPolicyName        = "seldon-auth"
+	jwksClusterName       = "seldon_jwks"
+	extAuthzClusterName   = "seldon_ext_authz"
+	jwksFetchTimeout      = 5 * time.Second
+	jwksCacheDuration     = 5 * time.Minute
+	extAuthzTimeout       = time.Second
+	// CA bundle of the envoy image used to verify a JSON web key set served over https
+	systemCaBundlePath = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+// AuthConfig configures the authentication of inference requests by envoy
+type AuthConfig struct {
+	// Issuer of the JWTs, JWTs are not validated when empty
+	JwtIssuer string
+	// Uri of the JSON web key set used to validate the JWTs
+	JwksUri string
+	// Claim with the groups of the caller, nested claims are separated by dots
+	JwtGroupsClaim string
+	// Claim identifying the tenant of the caller for rate limits, nested claims are separated by dots.
+	// Tenants are only set by the ext_authz service when empty.
+	JwtTenantClaim string
+	// Host and port of a gRPC ext_authz service called for every inference request, not called when empty
+	ExtAuthzAddress string
+}
+
+func (a *AuthConfig) jwtEnabled() bool {
+	return a != nil && a.JwtIssuer != ""
+}
+
+func (a *AuthConfig) extAuthzEnabled() bool {
+	return a != nil && a.ExtAuthzAddress != ""
+}
+
+func (a *AuthConfig) groupsClaim() string {
+	if a == nil || a.JwtGroupsClaim == "" {
+		return DefaultJwtGroupsClaim
+	}
+	return a.JwtGroupsClaim
+}
+
+// createAuthFilters creates the http filters authenticating requests ahead of the router. The JWT of a request
+// is validated when it has one, and the RBAC rules of the route then require it when the model or pipeline
+// has auth rules.
+func createAuthFilters(auth *AuthConfig) []*hcm.HttpFilter {
+	var filters []*hcm.HttpFilter
+	if auth.jwtEnabled() {
+		filters = append(filters, &hcm.HttpFilter{
+			Name:       jwtAuthnFilterName,
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: createJwtAuthnConfig(auth)},
+		})
+	}
+	// without policies the filter allows all requests, the routes with auth rules override it
+	rbacConfig, err := anypb.New(&rbac.RBAC{})
+	if err != nil {
+		panic(err)
+	}
+	filters = append(filters, &hcm.HttpFilter{
+		Name:       rbacFilterName,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: rbacConfig},
+	})
+	if auth.extAuthzEnabled() {
+		filters = append(filters, &hcm.HttpFilter{
+			Name:       extAuthzFilterName,
+			ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: createExtAuthzConfig()},
+		})
+	}
+	return filters
+}
+
+func createJwtAuthnConfig(auth *AuthConfig) *anypb.Any {
+	jwtAuthn := &jwtauthn.JwtAuthentication{
+		Providers: map[string]*jwtauthn.JwtProvider{
+			jwtProviderName: {
+				Issuer: auth.JwtIssuer,
+				JwksSourceSpecifier: &jwtauthn.JwtProvider_RemoteJwks{
+					RemoteJwks: &jwtauthn.RemoteJwks{
+						HttpUri: &core.HttpUri{
+							Uri:              auth.JwksUri,
+							HttpUpstreamType: &core.HttpUri_Cluster{Cluster: jwksClusterName},
+							Timeout:          duration.New(jwksFetchTimeout),
+						},
+						CacheDuration: duration.New(jwksCacheDuration),
+						AsyncFetch:    &jwtauthn.JwksAsyncFetch{},
+					},
+				},
+				PayloadInMetadata: jwtPayloadMetadataKey,
+				ClaimToHeaders:    createClaimToHeaders(auth),
+			},
+		},
+		// requests without a JWT are let through for the routes to decide, requests with an invalid JWT are rejected
+		Rules: []*jwtauthn.RequirementRule{
+			{
+				Match: &route.RouteMatch{PathSpecifier: &route.RouteMatch_Prefix{Prefix: "/"}},
+				RequirementType: &jwtauthn.RequirementRule_Requires{
+					Requires: &jwtauthn.JwtRequirement{
+						RequiresType: &jwtauthn.JwtRequirement_RequiresAny{
+							RequiresAny: &jwtauthn.JwtRequirementOrList{
+								Requirements: []*jwtauthn.JwtRequirement{
+									{RequiresType: &jwtauthn.JwtRequirement_ProviderName{ProviderName: jwtProviderName}},
+									{RequiresType: &jwtauthn.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	jwtAny, err := anypb.New(jwtAuthn)
+	if err != nil {
+		panic(err)
+	}
+	return jwtAny
+}
+
+// createClaimToHeaders sets the tenant header of requests with a valid JWT from the tenant claim
+func createClaimToHeaders(auth *AuthConfig) []*jwtauthn.JwtClaimToHeader {
+	if auth.JwtTenantClaim == "" {
+		return nil
+	}
+	return []*jwtauthn.JwtClaimToHeader{
+		{HeaderName: util.SeldonTenantHeader, ClaimName: auth.JwtTenantClaim},
+	}
+}
+
+// createExtAuthzConfig calls the ext_authz service with the request headers and the validated JWT claims
+func createExtAuthzConfig() *anypb.Any {
+	extAuthz := &extauthz.ExtAuthz{
+		Services: &extauthz.ExtAuthz_GrpcService{
+			GrpcService: &core.GrpcService{
+				TargetSpecifier: &core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &core.GrpcService_EnvoyGrpc{ClusterName: extAuthzClusterName},
+				},
+				Timeout: duration.New(extAuthzTimeout),
+			},
+		},
+		TransportApiVersion:       core.ApiVersion_V3,
+		MetadataContextNamespaces: []string{jwtAuthnFilterName},
+	}
+	extAuthzAny, err := anypb.New(extAuthz)
+	if err != nil {
+		panic(err)
+	}
+	return extAuthzAny
+}
+
+// makeAuthClusters creates the clusters of the JSON web key set and the ext_authz service
+func makeAuthClusters(auth *AuthConfig) ([]*cluster.Cluster, error) {
+	var clusters []*cluster.Cluster
+	if auth.jwtEnabled() {
+		jwksCluster, err := makeJwksCluster(auth.JwksUri)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, jwksCluster)
+	}
+	if auth.extAuthzEnabled() {
+		host, port, err := splitHostPort(auth.ExtAuthzAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ext_authz address %s: %w", auth.ExtAuthzAddress, err)
+		}
+		clusters = append(clusters, makeCluster(extAuthzClusterName, map[string]Endpoint{
+			auth.ExtAuthzAddress: {UpstreamHost: host, UpstreamPort: port},
+		}, true, nil))
+	}
+	return clusters, nil
+}
+
+func makeJwksCluster(jwksUri string) (*cluster.Cluster, error) {
+	u, err := url.Parse(jwksUri)
+	if err != nil || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid JSON web key set uri %q", jwksUri)
+	}
+	isHttps := u.Scheme == "https"
+	port := uint32(80)
+	if isHttps {
+		port = 443
+	}
+	if u.Port() != "" {
+		_, port, err = splitHostPort(u.Host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON web key set uri %q: %w", jwksUri, err)
+		}
+	}
+	jwksCluster := makeCluster(jwksClusterName, map[string]Endpoint{
+		u.Host: {UpstreamHost: u.Hostname(), UpstreamPort: port},
+	}, false, nil)
+	if isHttps {
+		tlsCtx, err := anypb.New(&tlsv3.UpstreamTlsContext{
+			Sni: u.Hostname(),
+			CommonTlsContext: &tlsv3.CommonTlsContext{
+				ValidationContextType: &tlsv3.CommonTlsContext_ValidationContext{
+					ValidationContext: &tlsv3.CertificateValidationContext{
+						TrustedCa: &core.DataSource{
+							Specifier: &core.DataSource_Filename{Filename: systemCaBundlePath},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		jwksCluster.TransportSocket = &core.TransportSocket{
+			Name:       "envoy.transport_sockets.tls",
+			ConfigType: &core.TransportSocket_TypedConfig{TypedConfig: tlsCtx},
+		}
+	}
+	return jwksCluster, nil
+}
+
+func splitHostPort(address string) (string, uint32, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := net.LookupPort("tcp", portStr)
+	if err != nil {
+		return "", 0, err
+	}
+	return host, uint32(port), nil
+}
+
+// addAuthRule adds a rule to the rules of a route unless an equal rule is already there
+func addAuthRule(rules []*AuthRule, rule *AuthRule) []*AuthRule {
+	for _, existing := range rules {
+		if slices.Equal(existing.Audiences, rule.Audiences) && slices.Equal(existing.Groups, rule.Groups) {
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
+
+// createRouteRbacConfig allows the requests with a validated JWT that matches all the rules that are set,
+// of every rule of the route. Requests without a JWT are rejected, including when JWT validation is not configured.
+func createRouteRbacConfig(rules []*AuthRule, groupsClaim string) *anypb.Any {
+	principals := []*rbacconfig.Principal{
+		metadataPrincipal([]string{jwtPayloadMetadataKey}, &matcherv3.ValueMatcher{
+			MatchPattern: &matcherv3.ValueMatcher_PresentMatch{PresentMatch: true},
+		}),
+	}
+	for _, rule := range rules {
+		if len(rule.Audiences) > 0 {
+			principals = append(principals, claimPrincipal([]string{jwtAudienceClaim}, rule.Audiences))
+		}
+		if len(rule.Groups) > 0 {
+			principals = append(principals, claimPrincipal(strings.Split(groupsClaim, "."), rule.Groups))
+		}
+	}
+
+	rbacPerRoute := &rbac.RBACPerRoute{
+		Rbac: &rbac.RBAC{
+			Rules: &rbacconfig.RBAC{
+				Action: rbacconfig.RBAC_ALLOW,
+				Policies: map[string]*rbacconfig.Policy{
+					authPolicyName: {
+						Permissions: []*rbacconfig.Permission{
+							{Rule: &rbacconfig.Permission_Any{Any: true}},
+						},
+						Principals: []*rbacconfig.Principal{
+							{Identifier: &rbacconfig.Principal_AndIds{AndIds: &rbacconfig.Principal_Set{Ids: principals}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	rbacAny, err := anypb.New(rbacPerRoute)
+	if err != nil {
+		panic(err)
+	}
+	return rbacAny
+}
+
+// claimPrincipal matches a JWT whose claim is, or is a list containing, one of the values
+func claimPrincipal(claim []string, values []string) *rbacconfig.Principal {
+	var ids []*rbacconfig.Principal
+	path := append([]string{jwtPayloadMetadataKey}, claim...)
+	for _, value := range values {
+		valueMatcher := &matcherv3.ValueMatcher{
+			MatchPattern: &matcherv3.ValueMatcher_StringMatch{
+				StringMatch: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{Exact: value},
+				},
+			},
+		}
+		ids = append(ids,
+			metadataPrincipal(path, valueMatcher),
+			metadataPrincipal(path, &matcherv3.ValueMatcher{
+				MatchPattern: &matcherv3.ValueMatcher_ListMatch{
+					ListMatch: &matcherv3.ListMatcher{
+						MatchPattern: &matcherv3.ListMatcher_OneOf{OneOf: valueMatcher},
+					},
+				},
+			}),
+		)
+	}
+	return &rbacconfig.Principal{Identifier: &rbacconfig.Principal_OrIds{OrIds: &rbacconfig.Principal_Set{Ids: ids}}}
+}
+
+func metadataPrincipal(path []string, value *matcherv3.ValueMatcher) *rbacconfig.Principal {
+	segments := make([]*matcherv3.MetadataMatcher_PathSegment, len(path))
+	for idx, key := range path {
+		segments[idx] = &matcherv3.MetadataMatcher_PathSegment{
+			Segment: &matcherv3.MetadataMatcher_PathSegment_Key{Key: key},
+		}
+	}
+	return &rbacconfig.Principal{
+		Identifier: &rbacconfig.Principal_Metadata{
+			Metadata: &matcherv3.MetadataMatcher{
+				Filter: jwtAuthnFilterName,
+				Path:   segments,
+				Value:  value,
+			},
+		},
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package xdscache
+
+import (
+	"testing"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbacconfig "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	jwtauthn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func getHttpFilterNames(g Gomega, l *listener.Listener) []string {
+	manager := new(hcm.HttpConnectionManager)
+	err := l.FilterChains[0].Filters[0].GetTypedConfig().UnmarshalTo(manager)
+	g.Expect(err).To(BeNil())
+	var names []string
+	for _, filter := range manager.HttpFilters {
+		names = append(names, filter.Name)
+	}
+	return names
+}
+
+func TestAuthListeners(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                   string
+		config                 *EnvoyConfig
+		expectedFilters        []string
+		expectedMirrorFilters  []string
+		expectedClusters       []string
+		expectedJwksTlsCluster bool
+		err                    bool
+	}
+
+	noAuthFilters := []string{"envoy.filters.http.tap", "envoy.filters.http.lua", "envoy.filters.http.router"}
+	tests := []test{
+		{
+			name:                  "nil config",
+			config:                nil,
+			expectedFilters:       []string{"envoy.filters.http.tap", "envoy.filters.http.lua", rbacFilterName, localRateLimitFilterName, "envoy.filters.http.router"},
+			expectedMirrorFilters: noAuthFilters,
+		},
+		{
+			name: "jwt",
+			config: &EnvoyConfig{Auth: AuthConfig{
+				JwtIssuer: "https://issuer.example.com",
+				JwksUri:   "https://issuer.example.com/.well-known/jwks.json",
+			}},
+			expectedFilters:        []string{"envoy.filters.http.tap", "envoy.filters.http.lua", jwtAuthnFilterName, rbacFilterName, localRateLimitFilterName, "envoy.filters.http.router"},
+			expectedMirrorFilters:  noAuthFilters,
+			expectedClusters:       []string{jwksClusterName},
+			expectedJwksTlsCluster: true,
+		},
+		{
+			name: "jwt and ext_authz",
+			config: &EnvoyConfig{Auth: AuthConfig{
+				JwtIssuer:       "issuer",
+				JwksUri:         "http://keycloak.auth:8080/realms/seldon/protocol/openid-connect/certs",
+				ExtAuthzAddress: "authz.auth:9000",
+			}},
+			expectedFilters:       []string{"envoy.filters.http.tap", "envoy.filters.http.lua", jwtAuthnFilterName, rbacFilterName, extAuthzFilterName, localRateLimitFilterName, "envoy.filters.http.router"},
+			expectedMirrorFilters: noAuthFilters,
+			expectedClusters:      []string{jwksClusterName, extAuthzClusterName},
+		},
+		{
+			name: "jwt without jwks uri",
+			config: &EnvoyConfig{Auth: AuthConfig{
+				JwtIssuer: "issuer",
+			}},
+			err: true,
+		},
+		{
+			name: "invalid ext_authz address",
+			config: &EnvoyConfig{Auth: AuthConfig{
+				ExtAuthzAddress: "authz",
+			}},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			xds, err := NewSeldonXDSCache(log.New(), &PipelineGatewayDetails{}, test.config)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+
+			listeners := xds.lds.GetResources()
+			g.Expect(getHttpFilterNames(g, listeners[defaultListenerName].(*listener.Listener))).To(Equal(test.expectedFilters))
+			g.Expect(getHttpFilterNames(g, listeners[mirrorListenerName].(*listener.Listener))).To(Equal(test.expectedMirrorFilters))
+
+			clusters := xds.cds.GetResources()
+			for _, clusterName := range test.expectedClusters {
+				g.Expect(clusters).To(HaveKey(clusterName))
+			}
+			if jwksCluster, ok := clusters[jwksClusterName]; ok {
+				g.Expect(jwksCluster.(*cluster.Cluster).TransportSocket != nil).To(Equal(test.expectedJwksTlsCluster))
+			}
+		})
+	}
+}
+
+func TestJwtAuthnConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	jwtAuthn := new(jwtauthn.JwtAuthentication)
+	err := createJwtAuthnConfig(&AuthConfig{JwtIssuer: "issuer", JwksUri: "http://jwks:8080/certs"}).UnmarshalTo(jwtAuthn)
+	g.Expect(err).To(BeNil())
+	provider := jwtAuthn.Providers[jwtProviderName]
+	g.Expect(provider.Issuer).To(Equal("issuer"))
+	g.Expect(provider.GetRemoteJwks().GetHttpUri().GetUri()).To(Equal("http://jwks:8080/certs"))
+	g.Expect(provider.GetRemoteJwks().GetHttpUri().GetCluster()).To(Equal(jwksClusterName))
+	g.Expect(provider.PayloadInMetadata).To(Equal(jwtPayloadMetadataKey))
+	// requests without a JWT are left to the route rules
+	requirements := jwtAuthn.Rules[0].GetRequires().GetRequiresAny().GetRequirements()
+	g.Expect(requirements).To(HaveLen(2))
+	g.Expect(requirements[0].GetProviderName()).To(Equal(jwtProviderName))
+	g.Expect(requirements[1].GetAllowMissing()).ToNot(BeNil())
+	g.Expect(provider.ClaimToHeaders).To(BeEmpty())
+
+	// the tenant of a caller with a valid JWT is set by envoy
+	jwtAuthn = new(jwtauthn.JwtAuthentication)
+	err = createJwtAuthnConfig(&AuthConfig{JwtIssuer: "issuer", JwksUri: "http://jwks:8080/certs", JwtTenantClaim: "org.team"}).UnmarshalTo(jwtAuthn)
+	g.Expect(err).To(BeNil())
+	g.Expect(jwtAuthn.Providers[jwtProviderName].ClaimToHeaders).To(HaveLen(1))
+	g.Expect(jwtAuthn.Providers[jwtProviderName].ClaimToHeaders[0].HeaderName).To(Equal(util.SeldonTenantHeader))
+	g.Expect(jwtAuthn.Providers[jwtProviderName].ClaimToHeaders[0].ClaimName).To(Equal("org.team"))
+}
+
+func TestMakeRoutesAuth(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name              string
+		auth              []*AuthRule
+		groupsClaim       string
+		expectedPrincipal int
+		expectedGroupPath []string
+	}
+
+	tests := []test{
+		{
+			name: "no auth",
+		},
+		{
+			name:              "jwt required",
+			auth:              []*AuthRule{{}},
+			groupsClaim:       DefaultJwtGroupsClaim,
+			expectedPrincipal: 1,
+		},
+		{
+			name:              "audiences and groups",
+			auth:              []*AuthRule{{Audiences: []string{"iris"}, Groups: []string{"a", "b"}}},
+			groupsClaim:       DefaultJwtGroupsClaim,
+			expectedPrincipal: 3,
+			expectedGroupPath: []string{jwtPayloadMetadataKey, "groups"},
+		},
+		{
+			name:              "nested groups claim",
+			auth:              []*AuthRule{{Groups: []string{"a"}}},
+			groupsClaim:       "realm_access.roles",
+			expectedPrincipal: 2,
+			expectedGroupPath: []string{jwtPayloadMetadataKey, "realm_access", "roles"},
+		},
+		{
+			name:              "candidates with different audiences",
+			auth:              []*AuthRule{{Audiences: []string{"iris"}}, {Audiences: []string{"wine"}}},
+			groupsClaim:       DefaultJwtGroupsClaim,
+			expectedPrincipal: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			routes := util.NewCountedSyncMap[Route]()
+			routes.Store("r1", Route{
+				RouteName: "r1",
+				Clusters: []TrafficSplit{
+					{ModelName: "m1", ModelVersion: 1, TrafficWeight: 50, HttpCluster: "h1", GrpcCluster: "g1"},
+					{ModelName: "m2", ModelVersion: 1, TrafficWeight: 50, HttpCluster: "h2", GrpcCluster: "g2"},
+				},
+				Mirrors: []TrafficSplit{
+					{ModelName: "m3", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h3", GrpcCluster: "g3"},
+				},
+				Auth: test.auth,
+			})
+			pipelines := util.NewCountedSyncMap[PipelineRoute]()
+			pipelines.Store("p1", PipelineRoute{
+				RouteName: "p1",
+				Clusters:  []PipelineTrafficSplit{{PipelineName: "p1", TrafficWeight: 100}},
+				Auth:      test.auth,
+			})
+
+			rcDef, rcMirrors := makeRoutes(routes, pipelines, test.groupsClaim)
+			// sticky session, weighted and pipeline routes
+			g.Expect(rcDef.VirtualHosts[0].Routes).To(HaveLen(8))
+			for _, envoyRoute := range rcDef.VirtualHosts[0].Routes {
+				if test.auth == nil {
+					g.Expect(envoyRoute.TypedPerFilterConfig).To(BeEmpty())
+					continue
+				}
+				rbacPerRoute := new(rbac.RBACPerRoute)
+				err := envoyRoute.TypedPerFilterConfig[rbacFilterName].UnmarshalTo(rbacPerRoute)
+				g.Expect(err).To(BeNil())
+				policy := rbacPerRoute.Rbac.Rules.Policies[authPolicyName]
+				g.Expect(rbacPerRoute.Rbac.Rules.Action).To(Equal(rbacconfig.RBAC_ALLOW))
+				principals := policy.Principals[0].GetAndIds().GetIds()
+				g.Expect(principals).To(HaveLen(test.expectedPrincipal))
+				if test.expectedGroupPath != nil {
+					groupIds := principals[len(principals)-1].GetOrIds().GetIds()
+					// a string and a list match for each group
+					g.Expect(groupIds).To(HaveLen(2 * len(test.auth[0].Groups)))
+					var path []string
+					for _, segment := range groupIds[0].GetMetadata().GetPath() {
+						path = append(path, segment.GetKey())
+					}
+					g.Expect(path).To(Equal(test.expectedGroupPath))
+				}
+			}
+			// mirrored requests are authorised on the default listener
+			for _, envoyRoute := range rcMirrors[0].VirtualHosts[0].Routes {
+				g.Expect(envoyRoute.TypedPerFilterConfig).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAddRouteClusterTrafficAuth(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c, err := NewSeldonXDSCache(log.New(), &PipelineGatewayDetails{}, nil)
+	g.Expect(err).To(BeNil())
+
+	iris := &AuthRule{Audiences: []string{"iris"}}
+	wine := &AuthRule{Audiences: []string{"wine"}}
+	// two candidates of an experiment with different audiences, a second version of the first and a mirror
+	c.AddRouteClusterTraffic("r1", "m1", "m1_1_http", "m1_1_grpc", 1, 50, nil, false, iris, nil, nil, false)
+	c.AddRouteClusterTraffic("r1", "m2", "m2_1_http", "m2_1_grpc", 1, 50, nil, false, wine, nil, nil, false)
+	c.AddRouteClusterTraffic("r1", "m1", "m1_2_http", "m1_2_grpc", 2, 0, nil, false, &AuthRule{Audiences: []string{"iris"}}, nil, nil, false)
+	c.AddRouteClusterTraffic("r1", "m3", "m3_1_http", "m3_1_grpc", 1, 100, nil, false, &AuthRule{}, nil, nil, true)
+
+	route, ok := c.Routes.Load("r1")
+	g.Expect(ok).To(BeTrue())
+	// requests must have the audiences of both candidates
+	g.Expect(route.Auth).To(Equal([]*AuthRule{iris, wine}))
+
+	rcDef, _ := makeRoutes(c.Routes, c.Pipelines, DefaultJwtGroupsClaim)
+	for _, envoyRoute := range rcDef.VirtualHosts[0].Routes {
+		rbacPerRoute := new(rbac.RBACPerRoute)
+		err := envoyRoute.TypedPerFilterConfig[rbacFilterName].UnmarshalTo(rbacPerRoute)
+		g.Expect(err).To(BeNil())
+		principals := rbacPerRoute.Rbac.Rules.Policies[authPolicyName].Principals[0].GetAndIds().GetIds()
+		g.Expect(principals).To(HaveLen(3))
+		var audiences []string
+		for _, principal := range principals[1:] {
+			audiences = append(audiences, principal.GetOrIds().GetIds()[0].GetMetadata().GetValue().GetStringMatch().GetExact())
+		}
+		g.Expect(audiences).To(Equal([]string{"iris", "wine"}))
+	}
+}
@@ -9,7 +9,11 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package xdscache
 
-import "github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
+import (
+	"time"
+
+	"github.com/seldonio/seldon-core/components/tls/v2/pkg/tls"
+)
 
 const (
 	MirrorHttpClusterName = "mirror_http"
@@ -27,7 +31,17 @@ type Route struct {
 	RouteName   string
 	LogPayloads bool
 	Clusters    []TrafficSplit
-	Mirror      *TrafficSplit
+	Mirrors     []TrafficSplit
+	// the experiment whose primary and mirror responses are captured by the agents
+	CaptureExperiment string
+	// the bandit experiment whose candidates record the requests they serve
+	BanditExperiment string
+	// callers allowed to use the route must match every rule, any caller when empty
+	Auth []*AuthRule
+	// requests allowed on the route, unlimited when nil
+	RateLimit *RateLimit
+	// timeouts and retries of the requests on the route, the defaults when nil
+	Routing *Routing
 }
 
 type TrafficSplit struct {
@@ -36,8 +50,84 @@ type TrafficSplit struct {
 	TrafficWeight uint32
 	HttpCluster   string
 	GrpcCluster   string
+	Matches       []MatchRule
+}
+
+// MatchRule matches a request header or query parameter, requests matching all the rules
+// of an experiment candidate are routed to it ahead of the weighted split
+type MatchRule struct {
+	Header         string
+	QueryParameter string
+	Exact          string
+	Regex          string
+}
+
+// AuthRule allows requests with a validated JWT matching all the rules that are set
+type AuthRule struct {
+	// the aud claim must be or contain one of the audiences
+	Audiences []string
+	// the groups claim must be or contain one of the groups
+	Groups []string
+}
+
+// RateLimit limits the requests of a route with a token bucket on each envoy replica, with separate
+// token buckets for the tenants identified by the tenant header set by envoy
+type RateLimit struct {
+	TokenBucket
+	Tenants []TenantRateLimit
+}
+
+type TenantRateLimit struct {
+	TokenBucket
+	Value string
+}
+
+type TokenBucket struct {
+	// tokens added at each fill interval
+	TokensPerFill uint32
+	// tokens held by a full bucket, the requests allowed in a burst
+	MaxTokens    uint32
+	FillInterval time.Duration
+}
+
+// Routing sets the timeout and retries of the requests of a route and the connect timeout, outlier
+// detection and load balancing of the clusters the requests are sent to. Zero values keep the defaults.
+type Routing struct {
+	Timeout          time.Duration
+	ConnectTimeout   time.Duration
+	Retry            *RetryPolicy
+	OutlierDetection *OutlierDetection
+	LoadBalancer     LoadBalancer
+	// header hashed to pick the endpoint of a request with RingHashLoadBalancer
+	HashHeader string
 }
 
+type RetryPolicy struct {
+	RetryOn             []string
+	MaxRetries          *uint32
+	PerTryTimeout       time.Duration
+	BackoffBaseInterval time.Duration
+	// percentage of the active requests to a cluster that can be retries, replacing the max parallel retries
+	BudgetPercent *uint32
+}
+
+// OutlierDetection ejects the endpoints of a cluster returning consecutive 5xx responses
+type OutlierDetection struct {
+	Consecutive5xx     uint32
+	Interval           time.Duration
+	BaseEjectionTime   time.Duration
+	MaxEjectionPercent uint32
+}
+
+type LoadBalancer uint32
+
+const (
+	LeastRequestLoadBalancer LoadBalancer = iota
+	RoundRobinLoadBalancer
+	RingHashLoadBalancer
+	RandomLoadBalancer
+)
+
 type RouteVersionKey struct {
 	RouteName string
 	ModelName string
@@ -49,6 +139,7 @@ type Cluster struct {
 	Grpc      bool
 	Endpoints map[string]Endpoint
 	Routes    map[RouteVersionKey]bool
+	Routing   *Routing
 }
 
 type Endpoint struct {
@@ -59,12 +150,19 @@ type Endpoint struct {
 type PipelineRoute struct {
 	RouteName string
 	Clusters  []PipelineTrafficSplit
-	Mirror    *PipelineTrafficSplit
+	Mirrors   []PipelineTrafficSplit
+	// callers allowed to use the route must match every rule, any caller when empty
+	Auth      []*AuthRule
+	RateLimit *RateLimit
+	Routing   *Routing
+	// the bandit experiment whose candidates record the requests they serve
+	BanditExperiment string
 }
 
 type PipelineTrafficSplit struct {
 	PipelineName  string
 	TrafficWeight uint32
+	Matches       []MatchRule
 }
 
 type Secret struct {
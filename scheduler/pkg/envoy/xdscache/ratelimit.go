@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package xdscache
+
+import (
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	duration "google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	localRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+	localRateLimitStatPrefix = "seldon_rate_limit"
+	tenantDescriptorKey      = "seldon_tenant"
+	// runtime keys allowing the rate limits to be turned off or only counted without a new configuration
+	rateLimitEnabledRuntimeKey  = "seldon_rate_limit.enabled"
+	rateLimitEnforcedRuntimeKey = "seldon_rate_limit.enforced"
+)
+
+// createRateLimitFilter creates the local rate limit filter, which does nothing without the
+// configuration of the routes with rate limits
+func createRateLimitFilter() *hcm.HttpFilter {
+	rateLimitConfig, err := anypb.New(&localratelimit.LocalRateLimit{
+		StatPrefix: localRateLimitStatPrefix,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &hcm.HttpFilter{
+		Name:       localRateLimitFilterName,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: rateLimitConfig},
+	}
+}
+
+// createRouteRateLimitConfig creates the rate limit of the envoy routes of a model or pipeline. Each envoy
+// route has its own token buckets but they share the stats of the model or pipeline. The buckets are local to
+// each envoy replica. Tenants are identified by the tenant header, which envoy removes from incoming requests
+// and sets from the JWT tenant claim, or which the ext_authz service sets, so callers can not choose a tenant.
+func createRouteRateLimitConfig(routeName string, rateLimit *RateLimit) *anypb.Any {
+	rateLimitConfig := &localratelimit.LocalRateLimit{
+		StatPrefix:  localRateLimitStatPrefix + "_" + routeName,
+		TokenBucket: createTokenBucket(&rateLimit.TokenBucket),
+		FilterEnabled: &core.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{Numerator: 100, Denominator: typev3.FractionalPercent_HUNDRED},
+			RuntimeKey:   rateLimitEnabledRuntimeKey,
+		},
+		FilterEnforced: &core.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{Numerator: 100, Denominator: typev3.FractionalPercent_HUNDRED},
+			RuntimeKey:   rateLimitEnforcedRuntimeKey,
+		},
+		EnableXRatelimitHeaders:        ratelimit.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03,
+		RateLimitedAsResourceExhausted: true,
+	}
+	if len(rateLimit.Tenants) > 0 {
+		// requests of a tenant only consume the tokens of its own bucket
+		rateLimitConfig.AlwaysConsumeDefaultTokenBucket = wrapperspb.Bool(false)
+		rateLimitConfig.RateLimits = []*route.RateLimit{
+			{
+				Actions: []*route.RateLimit_Action{
+					{
+						ActionSpecifier: &route.RateLimit_Action_RequestHeaders_{
+							RequestHeaders: &route.RateLimit_Action_RequestHeaders{
+								HeaderName:    util.SeldonTenantHeader,
+								DescriptorKey: tenantDescriptorKey,
+							},
+						},
+					},
+				},
+			},
+		}
+		for _, tenant := range rateLimit.Tenants {
+			rateLimitConfig.Descriptors = append(rateLimitConfig.Descriptors, &ratelimit.LocalRateLimitDescriptor{
+				Entries:     []*ratelimit.RateLimitDescriptor_Entry{{Key: tenantDescriptorKey, Value: tenant.Value}},
+				TokenBucket: createTokenBucket(&tenant.TokenBucket),
+			})
+		}
+	}
+	rateLimitAny, err := anypb.New(rateLimitConfig)
+	if err != nil {
+		panic(err)
+	}
+	return rateLimitAny
+}
+
+func createTokenBucket(tokenBucket *TokenBucket) *typev3.TokenBucket {
+	return &typev3.TokenBucket{
+		MaxTokens:     tokenBucket.MaxTokens,
+		TokensPerFill: wrapperspb.UInt32(tokenBucket.TokensPerFill),
+		FillInterval:  duration.New(tokenBucket.FillInterval),
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package xdscache
+
+import (
+	"testing"
+	"time"
+
+	localratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func TestMakeRoutesRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                string
+		rateLimit           *RateLimit
+		auth                []*AuthRule
+		expectedDescriptors map[string]uint32
+	}
+
+	tests := []test{
+		{
+			name: "no rate limit",
+		},
+		{
+			name: "rate limit",
+			rateLimit: &RateLimit{
+				TokenBucket: TokenBucket{TokensPerFill: 10, MaxTokens: 20, FillInterval: time.Second},
+			},
+		},
+		{
+			name: "rate limit with tenants",
+			rateLimit: &RateLimit{
+				TokenBucket: TokenBucket{TokensPerFill: 10, MaxTokens: 10, FillInterval: time.Minute},
+				Tenants: []TenantRateLimit{
+					{Value: "batch", TokenBucket: TokenBucket{TokensPerFill: 1, MaxTokens: 5, FillInterval: time.Minute}},
+					{Value: "online", TokenBucket: TokenBucket{TokensPerFill: 100, MaxTokens: 100, FillInterval: time.Minute}},
+				},
+			},
+			expectedDescriptors: map[string]uint32{"batch": 5, "online": 100},
+		},
+		{
+			name: "rate limit with auth",
+			rateLimit: &RateLimit{
+				TokenBucket: TokenBucket{TokensPerFill: 10, MaxTokens: 10, FillInterval: time.Second},
+			},
+			auth: []*AuthRule{{Groups: []string{"a"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			routes := util.NewCountedSyncMap[Route]()
+			routes.Store("r1", Route{
+				RouteName: "r1",
+				Clusters: []TrafficSplit{
+					{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"},
+				},
+				Mirrors: []TrafficSplit{
+					{ModelName: "m2", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h2", GrpcCluster: "g2"},
+				},
+				Auth:      test.auth,
+				RateLimit: test.rateLimit,
+			})
+			pipelines := util.NewCountedSyncMap[PipelineRoute]()
+			pipelines.Store("p1", PipelineRoute{
+				RouteName: "p1",
+				Clusters:  []PipelineTrafficSplit{{PipelineName: "p1", TrafficWeight: 100}},
+				Auth:      test.auth,
+				RateLimit: test.rateLimit,
+			})
+
+			rcDef, rcMirrors := makeRoutes(routes, pipelines, DefaultJwtGroupsClaim)
+			g.Expect(rcDef.VirtualHosts[0].Routes).To(HaveLen(4))
+			for idx, envoyRoute := range rcDef.VirtualHosts[0].Routes {
+				if test.rateLimit == nil {
+					g.Expect(envoyRoute.TypedPerFilterConfig).To(BeEmpty())
+					continue
+				}
+				g.Expect(envoyRoute.TypedPerFilterConfig).To(HaveKey(localRateLimitFilterName))
+				if test.auth != nil {
+					g.Expect(envoyRoute.TypedPerFilterConfig).To(HaveKey(rbacFilterName))
+				}
+				rateLimit := new(localratelimit.LocalRateLimit)
+				err := envoyRoute.TypedPerFilterConfig[localRateLimitFilterName].UnmarshalTo(rateLimit)
+				g.Expect(err).To(BeNil())
+				// the model routes are made ahead of the pipeline routes
+				if idx < 2 {
+					g.Expect(rateLimit.StatPrefix).To(Equal("seldon_rate_limit_r1"))
+				} else {
+					g.Expect(rateLimit.StatPrefix).To(Equal("seldon_rate_limit_p1"))
+				}
+				g.Expect(rateLimit.TokenBucket.MaxTokens).To(Equal(test.rateLimit.MaxTokens))
+				g.Expect(rateLimit.TokenBucket.TokensPerFill.GetValue()).To(Equal(test.rateLimit.TokensPerFill))
+				g.Expect(rateLimit.TokenBucket.FillInterval.AsDuration()).To(Equal(test.rateLimit.FillInterval))
+				g.Expect(rateLimit.FilterEnabled.DefaultValue.Numerator).To(Equal(uint32(100)))
+				g.Expect(rateLimit.FilterEnforced.DefaultValue.Numerator).To(Equal(uint32(100)))
+				g.Expect(rateLimit.RateLimitedAsResourceExhausted).To(BeTrue())
+
+				descriptors := map[string]uint32{}
+				for _, descriptor := range rateLimit.Descriptors {
+					g.Expect(descriptor.Entries).To(HaveLen(1))
+					g.Expect(descriptor.Entries[0].Key).To(Equal(tenantDescriptorKey))
+					descriptors[descriptor.Entries[0].Value] = descriptor.TokenBucket.MaxTokens
+				}
+				if test.expectedDescriptors == nil {
+					g.Expect(descriptors).To(BeEmpty())
+					g.Expect(rateLimit.RateLimits).To(BeEmpty())
+				} else {
+					g.Expect(descriptors).To(Equal(test.expectedDescriptors))
+					g.Expect(rateLimit.AlwaysConsumeDefaultTokenBucket.GetValue()).To(BeFalse())
+					g.Expect(rateLimit.RateLimits[0].Actions[0].GetRequestHeaders().HeaderName).To(Equal(util.SeldonTenantHeader))
+				}
+			}
+			// mirrored requests are limited on the default listener
+			for _, envoyRoute := range rcMirrors[0].VirtualHosts[0].Routes {
+				g.Expect(envoyRoute.TypedPerFilterConfig).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestTenantHeaderRemoved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the tenant header of callers is removed ahead of the auth filters, which are the only ones to set it
+	lua := new(luav3.Lua)
+	err := createHeaderFilter().UnmarshalTo(lua)
+	g.Expect(err).To(BeNil())
+	g.Expect(lua.DefaultSourceCode.GetInlineString()).To(ContainSubstring(`request_handle:headers():remove("` + util.SeldonTenantHeader + `")`))
+}
@@ -16,6 +16,7 @@ package xdscache
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
@@ -70,8 +71,34 @@ func makeHTTPListener(listenerName, address string,
 	routeConfigurationName string,
 	serverSecret *Secret,
 	config *EnvoyConfig,
+	isMirror bool,
 ) *listener.Listener {
 	routerConfig, _ := anypb.New(&router.Router{})
+	httpFilters := []*hcm.HttpFilter{
+		{
+			Name: "envoy.filters.http.tap",
+			ConfigType: &hcm.HttpFilter_TypedConfig{
+				TypedConfig: createTapConfig(),
+			},
+		},
+		{
+			Name: "envoy.filters.http.lua",
+			ConfigType: &hcm.HttpFilter_TypedConfig{
+				TypedConfig: createHeaderFilter(),
+			},
+		},
+	}
+	// the auth filters follow the lua filter so the ext_authz service is sent the model header, and only
+	// they can set the tenant header removed by the lua filter. The rate limit follows them so requests that
+	// are not allowed do not use the tokens of a tenant.
+	if !isMirror {
+		httpFilters = append(httpFilters, createAuthFilters(config.authConfig())...)
+		httpFilters = append(httpFilters, createRateLimitFilter())
+	}
+	httpFilters = append(httpFilters, &hcm.HttpFilter{
+		Name:       wellknown.Router,
+		ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: routerConfig},
+	})
 	// HTTP filter configuration
 	manager := &hcm.HttpConnectionManager{
 		CodecType:                    hcm.HttpConnectionManager_AUTO,
@@ -84,24 +111,7 @@ func makeHTTPListener(listenerName, address string,
 				RouteConfigName: routeConfigurationName,
 			},
 		},
-		HttpFilters: []*hcm.HttpFilter{
-			{
-				Name: "envoy.filters.http.tap",
-				ConfigType: &hcm.HttpFilter_TypedConfig{
-					TypedConfig: createTapConfig(),
-				},
-			},
-			{
-				Name: "envoy.filters.http.lua",
-				ConfigType: &hcm.HttpFilter_TypedConfig{
-					TypedConfig: createHeaderFilter(),
-				},
-			},
-			{
-				Name:       wellknown.Router,
-				ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: routerConfig},
-			},
-		},
+		HttpFilters: httpFilters,
 	}
 	if config != nil && config.EnableAccessLog {
 		var filter *accesslog.AccessLogFilter = nil
@@ -238,10 +248,12 @@ func makeEndpoint(clusterName string, eps map[string]Endpoint) *endpoint.Cluster
 	}
 }
 
-func makeRoutes(routes *util.CountedSyncMap[Route], pipelines *util.CountedSyncMap[PipelineRoute]) (*route.RouteConfiguration, *route.RouteConfiguration) {
+func makeRoutes(routes *util.CountedSyncMap[Route], pipelines *util.CountedSyncMap[PipelineRoute], groupsClaim string) (*route.RouteConfiguration, []*route.RouteConfiguration) {
 	rts := make([]*route.Route, 2*(routes.Length()+pipelines.Length())+
 		countModelStickySessions(routes)+
-		countPipelineStickySessions(pipelines))
+		countPipelineStickySessions(pipelines)+
+		countModelMatchRoutes(routes)+
+		countPipelineMatchRoutes(pipelines))
 
 	for i := range rts {
 		rts[i] = &route.Route{
@@ -251,20 +263,24 @@ func makeRoutes(routes *util.CountedSyncMap[Route], pipelines *util.CountedSyncM
 		}
 	}
 
-	rtsMirrors := make([]*route.Route, countModelMirrors(routes)+countPipelineMirrors(pipelines))
-
-	for i := range rtsMirrors {
-		rtsMirrors[i] = &route.Route{
-			Match: &route.RouteMatch{
-				Headers: make([]*route.HeaderMatcher, 2), // We always do 2 header matches
-			},
+	// each mirror of an experiment is sent to its own mirror listener, the first of which always exists
+	rtsMirrors := make([][]*route.Route, countMirrorListeners(routes, pipelines))
+	for mirrorIdx := range rtsMirrors {
+		rtsMirrors[mirrorIdx] = make([]*route.Route, countModelMirrors(routes, mirrorIdx)+countPipelineMirrors(pipelines, mirrorIdx))
+		for i := range rtsMirrors[mirrorIdx] {
+			rtsMirrors[mirrorIdx][i] = &route.Route{
+				Match: &route.RouteMatch{
+					Headers: make([]*route.HeaderMatcher, 2), // We always do 2 header matches
+				},
+			}
 		}
 	}
 
 	rtsIndex := 0
-	mirrorsIndex := 0
+	mirrorsIndex := make([]int, util.MaxExperimentMirrors)
 
 	routes.Range(func(_ string, r Route) bool {
+		routeStartIdx := rtsIndex
 		for _, clusterTraffic := range r.Clusters { // it's an experiment, so create some sticky session Routes
 			if isExperiment(r.Clusters) {
 				makeModelStickySessionEnvoyRoute(r.RouteName, rts[rtsIndex], r.LogPayloads, &clusterTraffic, false)
@@ -273,22 +289,34 @@ func makeRoutes(routes *util.CountedSyncMap[Route], pipelines *util.CountedSyncM
 				rtsIndex++
 			}
 		}
-		makeModelEnvoyRoute(&r, rts[rtsIndex], false, false)
+		// candidates with match rules are routed ahead of the weighted split
+		for _, clusterTraffics := range getModelMatchTraffic(r.Clusters) {
+			makeModelMatchEnvoyRoute(&r, rts[rtsIndex], clusterTraffics, false)
+			rtsIndex++
+			makeModelMatchEnvoyRoute(&r, rts[rtsIndex], clusterTraffics, true)
+			rtsIndex++
+		}
+		makeModelEnvoyRoute(&r, rts[rtsIndex], false, nil)
 		rtsIndex++
-		makeModelEnvoyRoute(&r, rts[rtsIndex], true, false)
+		makeModelEnvoyRoute(&r, rts[rtsIndex], true, nil)
 		rtsIndex++
-
-		if r.Mirror != nil {
-			makeModelEnvoyRoute(&r, rtsMirrors[mirrorsIndex], false, true)
-			mirrorsIndex++
-			makeModelEnvoyRoute(&r, rtsMirrors[mirrorsIndex], true, true)
-			mirrorsIndex++
+		addRouteAuth(rts[routeStartIdx:rtsIndex], r.Auth, groupsClaim)
+		addRouteRateLimit(rts[routeStartIdx:rtsIndex], r.RouteName, r.RateLimit)
+		addRouteRouting(rts[routeStartIdx:rtsIndex], r.Routing)
+
+		for mirrorIdx := range min(len(r.Mirrors), util.MaxExperimentMirrors) {
+			mirrorRoutes := rtsMirrors[mirrorIdx]
+			makeModelEnvoyRoute(&r, mirrorRoutes[mirrorsIndex[mirrorIdx]], false, &r.Mirrors[mirrorIdx])
+			mirrorsIndex[mirrorIdx]++
+			makeModelEnvoyRoute(&r, mirrorRoutes[mirrorsIndex[mirrorIdx]], true, &r.Mirrors[mirrorIdx])
+			mirrorsIndex[mirrorIdx]++
 		}
 
 		return true
 	})
 
 	pipelines.Range(func(key string, r PipelineRoute) bool {
+		routeStartIdx := rtsIndex
 		if isExperiment(r.Clusters) { // it's an experiment, so create some sticky session Routes
 			for _, clusterTraffic := range r.Clusters {
 				makePipelineStickySessionEnvoyRoute(r.RouteName, rts[rtsIndex], &clusterTraffic, false)
@@ -298,64 +326,168 @@ func makeRoutes(routes *util.CountedSyncMap[Route], pipelines *util.CountedSyncM
 			}
 		}
 
-		makePipelineEnvoyRoute(&r, rts[rtsIndex], false, false)
+		// candidates with match rules are routed ahead of the weighted split
+		for _, clusterTraffic := range r.Clusters {
+			if len(clusterTraffic.Matches) > 0 {
+				makePipelineMatchEnvoyRoute(&r, rts[rtsIndex], clusterTraffic, false)
+				rtsIndex++
+				makePipelineMatchEnvoyRoute(&r, rts[rtsIndex], clusterTraffic, true)
+				rtsIndex++
+			}
+		}
+		makePipelineEnvoyRoute(&r, rts[rtsIndex], false, nil)
 		rtsIndex++
-		makePipelineEnvoyRoute(&r, rts[rtsIndex], true, false)
+		makePipelineEnvoyRoute(&r, rts[rtsIndex], true, nil)
 		rtsIndex++
-
-		if r.Mirror != nil {
-			makePipelineEnvoyRoute(&r, rtsMirrors[mirrorsIndex], false, true)
-			mirrorsIndex++
-			makePipelineEnvoyRoute(&r, rtsMirrors[mirrorsIndex], true, true)
-			mirrorsIndex++
+		addRouteAuth(rts[routeStartIdx:rtsIndex], r.Auth, groupsClaim)
+		addRouteRateLimit(rts[routeStartIdx:rtsIndex], r.RouteName, r.RateLimit)
+		addRouteRouting(rts[routeStartIdx:rtsIndex], r.Routing)
+
+		for mirrorIdx := range min(len(r.Mirrors), util.MaxExperimentMirrors) {
+			mirrorRoutes := rtsMirrors[mirrorIdx]
+			makePipelineEnvoyRoute(&r, mirrorRoutes[mirrorsIndex[mirrorIdx]], false, &r.Mirrors[mirrorIdx])
+			mirrorsIndex[mirrorIdx]++
+			makePipelineEnvoyRoute(&r, mirrorRoutes[mirrorsIndex[mirrorIdx]], true, &r.Mirrors[mirrorIdx])
+			mirrorsIndex[mirrorIdx]++
 		}
 
 		return true
 	})
 
-	return &route.RouteConfiguration{
-			Name: DefaultRouteConfigurationName,
-			VirtualHosts: []*route.VirtualHost{{
-				Name:    "seldon_service",
-				Domains: []string{"*"},
-				Routes:  rts,
-			}},
-		},
-		&route.RouteConfiguration{
-			Name: MirrorRouteConfigurationName,
+	mirrorRouteConfigs := make([]*route.RouteConfiguration, len(rtsMirrors))
+	for mirrorIdx := range mirrorRouteConfigs {
+		mirrorRouteConfigs[mirrorIdx] = &route.RouteConfiguration{
+			Name: getMirrorRouteConfigurationName(mirrorIdx),
 			VirtualHosts: []*route.VirtualHost{{
 				Name:    "seldon_mirror",
 				Domains: []string{"*"},
-				Routes:  rtsMirrors,
+				Routes:  rtsMirrors[mirrorIdx],
 			}},
 		}
+	}
+
+	return &route.RouteConfiguration{
+		Name: DefaultRouteConfigurationName,
+		VirtualHosts: []*route.VirtualHost{{
+			Name:    "seldon_service",
+			Domains: []string{"*"},
+			Routes:  rts,
+		}},
+	}, mirrorRouteConfigs
+}
+
+// addRouteAuth restricts the envoy routes of a model or pipeline to the callers allowed by all its auth rules
+func addRouteAuth(envoyRoutes []*route.Route, auth []*AuthRule, groupsClaim string) {
+	if len(auth) == 0 {
+		return
+	}
+	rbacConfig := createRouteRbacConfig(auth, groupsClaim)
+	for _, envoyRoute := range envoyRoutes {
+		addTypedPerFilterConfig(envoyRoute, rbacFilterName, rbacConfig)
+	}
+}
+
+// addRouteRateLimit limits the requests on the envoy routes of a model or pipeline
+func addRouteRateLimit(envoyRoutes []*route.Route, routeName string, rateLimit *RateLimit) {
+	if rateLimit == nil {
+		return
+	}
+	rateLimitConfig := createRouteRateLimitConfig(routeName, rateLimit)
+	for _, envoyRoute := range envoyRoutes {
+		addTypedPerFilterConfig(envoyRoute, localRateLimitFilterName, rateLimitConfig)
+	}
+}
+
+func addTypedPerFilterConfig(envoyRoute *route.Route, filterName string, config *anypb.Any) {
+	if envoyRoute.TypedPerFilterConfig == nil {
+		envoyRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
+	}
+	envoyRoute.TypedPerFilterConfig[filterName] = config
 }
 
 func wrapRouteHeader(key string) string {
 	return fmt.Sprintf("%s%s%s", SeldonRouteSeparator, key, SeldonRouteSeparator)
 }
 
-func createMirrorRouteAction(trafficWeight uint32, isGrpc bool) []*route.RouteAction_RequestMirrorPolicy {
+func createMirrorRouteAction(trafficWeights []uint32, isGrpc bool) []*route.RouteAction_RequestMirrorPolicy {
 	var mirrors []*route.RouteAction_RequestMirrorPolicy
+	for mirrorIdx, trafficWeight := range trafficWeights {
+		mirrors = append(mirrors, &route.RouteAction_RequestMirrorPolicy{
+			Cluster: getMirrorClusterName(mirrorIdx, isGrpc),
+			RuntimeFraction: &core.RuntimeFractionalPercent{
+				DefaultValue: &typev3.FractionalPercent{
+					Numerator:   trafficWeight,
+					Denominator: typev3.FractionalPercent_HUNDRED,
+				},
+			},
+		})
+	}
+	return mirrors
+}
+
+func getMirrorListenerName(mirrorIdx int) string {
+	if mirrorIdx == 0 {
+		return mirrorListenerName
+	}
+	return fmt.Sprintf("%s_%d", mirrorListenerName, mirrorIdx)
+}
+
+func getMirrorListenerPort(mirrorIdx int) uint32 {
+	if mirrorIdx == 0 {
+		return mirrorListenerPort
+	}
+	return extraMirrorListenerBasePort + uint32(mirrorIdx)
+}
+
+func getMirrorRouteConfigurationName(mirrorIdx int) string {
+	if mirrorIdx == 0 {
+		return MirrorRouteConfigurationName
+	}
+	return fmt.Sprintf("%s_%d", MirrorRouteConfigurationName, mirrorIdx)
+}
+
+func getMirrorClusterName(mirrorIdx int, isGrpc bool) string {
 	clusterName := MirrorHttpClusterName
 	if isGrpc {
 		clusterName = MirrorGrpcClusterName
 	}
-	mirrors = append(mirrors, &route.RouteAction_RequestMirrorPolicy{
-		Cluster: clusterName,
-		RuntimeFraction: &core.RuntimeFractionalPercent{
-			DefaultValue: &typev3.FractionalPercent{
-				Numerator:   trafficWeight, // Just take the first one - at present all will be same
-				Denominator: typev3.FractionalPercent_HUNDRED,
-			},
-		},
-	})
-	return mirrors
+	if mirrorIdx == 0 {
+		return clusterName
+	}
+	return fmt.Sprintf("%s_%d", clusterName, mirrorIdx)
+}
+
+// getCaptureRequestHeaders replaces any capture headers sent by the client with those for the route,
+// mirrored requests are marked as shadow requests so the agents can tell their responses apart
+func getCaptureRequestHeaders(captureExperiment string, isShadow bool) ([]*core.HeaderValueOption, []string) {
+	var headers []*core.HeaderValueOption
+	if captureExperiment != "" {
+		headers = append(headers, &core.HeaderValueOption{
+			Header: &core.HeaderValue{Key: util.SeldonCaptureHeader, Value: captureExperiment},
+		})
+		if isShadow {
+			headers = append(headers, &core.HeaderValueOption{
+				Header: &core.HeaderValue{Key: util.SeldonShadowHeader, Value: "true"},
+			})
+		}
+	}
+	return headers, []string{util.SeldonCaptureHeader, util.SeldonShadowHeader}
+}
+
+// addBanditRequestHeaders replaces any bandit header sent by the client with the bandit experiment of the route.
+// Mirrored requests are not served by a candidate so are never recorded.
+func addBanditRequestHeaders(envoyRoute *route.Route, banditExperiment string, isMirror bool) {
+	if banditExperiment != "" && !isMirror {
+		envoyRoute.RequestHeadersToAdd = append(envoyRoute.RequestHeadersToAdd, &core.HeaderValueOption{
+			Header: &core.HeaderValue{Key: util.SeldonBanditHeader, Value: banditExperiment},
+		})
+	}
+	envoyRoute.RequestHeadersToRemove = append(envoyRoute.RequestHeadersToRemove, util.SeldonBanditHeader)
 }
 
 // weighted clusters do not play well with session affinity see https://github.com/envoyproxy/envoy/issues/8167
 // Traffic shifting may need to be reinvesigated https://github.com/envoyproxy/envoy/pull/18207
-func createWeightedModelClusterAction(clusterTraffics []TrafficSplit, mirrorTraffic *TrafficSplit, isGrpc bool) *route.Route_Route {
+func createWeightedModelClusterAction(clusterTraffics []TrafficSplit, mirrorTraffics []TrafficSplit, isGrpc bool) *route.Route_Route {
 	// Add Weighted Clusters with given traffic percentages to each internal model
 	var splits []*route.WeightedCluster_ClusterWeight
 	var mirrors []*route.RouteAction_RequestMirrorPolicy
@@ -399,8 +531,12 @@ func createWeightedModelClusterAction(clusterTraffics []TrafficSplit, mirrorTraf
 			})
 
 	}
-	if mirrorTraffic != nil {
-		mirrors = createMirrorRouteAction(mirrorTraffic.TrafficWeight, isGrpc)
+	if len(mirrorTraffics) > 0 {
+		mirrorWeights := make([]uint32, len(mirrorTraffics))
+		for idx, mirrorTraffic := range mirrorTraffics {
+			mirrorWeights[idx] = mirrorTraffic.TrafficWeight
+		}
+		mirrors = createMirrorRouteAction(mirrorWeights, isGrpc)
 	}
 
 	action := &route.Route_Route{
@@ -532,8 +668,8 @@ func makeModelStickySessionEnvoyRoute(routeName string, envoyRoute *route.Route,
 	}
 }
 
-func makeModelEnvoyRoute(r *Route, envoyRoute *route.Route, isGrpc, isMirror bool) {
-	envoyRoute.Name = getRouteName(r.RouteName, isGrpc, isMirror)
+func makeModelEnvoyRoute(r *Route, envoyRoute *route.Route, isGrpc bool, mirror *TrafficSplit) {
+	envoyRoute.Name = getRouteName(r.RouteName, isGrpc, mirror != nil)
 	if isGrpc {
 		envoyRoute.Match.PathSpecifier = modelRouteMatchPathGrpc
 	} else {
@@ -556,19 +692,120 @@ func makeModelEnvoyRoute(r *Route, envoyRoute *route.Route, isGrpc, isMirror boo
 		},
 	}
 
-	if isMirror {
-		envoyRoute.Action = createWeightedModelClusterAction([]TrafficSplit{*r.Mirror}, nil, isGrpc)
+	if mirror != nil {
+		envoyRoute.Action = createWeightedModelClusterAction([]TrafficSplit{*mirror}, nil, isGrpc)
 	} else {
-		envoyRoute.Action = createWeightedModelClusterAction(r.Clusters, r.Mirror, isGrpc)
+		envoyRoute.Action = createWeightedModelClusterAction(r.Clusters, r.Mirrors, isGrpc)
 	}
+	envoyRoute.RequestHeadersToAdd, envoyRoute.RequestHeadersToRemove = getCaptureRequestHeaders(r.CaptureExperiment, mirror != nil)
+	addBanditRequestHeaders(envoyRoute, r.BanditExperiment, mirror != nil)
 
 	if r.LogPayloads {
 		envoyRoute.ResponseHeadersToAdd = modelRouteHeaders
 	}
 }
 
-func makePipelineEnvoyRoute(r *PipelineRoute, envoyRoute *route.Route, isGrpc, isMirror bool) {
-	envoyRoute.Name = getRouteName(r.RouteName, isGrpc, isMirror)
+// getModelMatchTraffic groups the traffic of the candidates with match rules by model, keeping the candidate order.
+// A candidate can have traffic to more than one version of its model while it is being updated.
+func getModelMatchTraffic(clusterTraffics []TrafficSplit) [][]TrafficSplit {
+	var matchTraffic [][]TrafficSplit
+	modelIdx := make(map[string]int)
+	for _, clusterTraffic := range clusterTraffics {
+		if len(clusterTraffic.Matches) == 0 {
+			continue
+		}
+		if idx, ok := modelIdx[clusterTraffic.ModelName]; ok {
+			matchTraffic[idx] = append(matchTraffic[idx], clusterTraffic)
+			continue
+		}
+		modelIdx[clusterTraffic.ModelName] = len(matchTraffic)
+		matchTraffic = append(matchTraffic, []TrafficSplit{clusterTraffic})
+	}
+	return matchTraffic
+}
+
+func makeModelMatchEnvoyRoute(r *Route, envoyRoute *route.Route, clusterTraffics []TrafficSplit, isGrpc bool) {
+	envoyRoute.Name = fmt.Sprintf("%s_%s_match", getRouteName(r.RouteName, isGrpc, false), clusterTraffics[0].ModelName)
+	if isGrpc {
+		envoyRoute.Match.PathSpecifier = modelRouteMatchPathGrpc
+	} else {
+		envoyRoute.Match.PathSpecifier = modelRouteMatchPathHttp
+	}
+	envoyRoute.Match.Headers[0] = &route.HeaderMatcher{
+		Name: util.SeldonModelHeader, // Header name we will match on
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+			StringMatch: &matcherv3.StringMatcher{
+				MatchPattern: &matcherv3.StringMatcher_Exact{
+					Exact: r.RouteName,
+				},
+			},
+		},
+	}
+	envoyRoute.Match.Headers[1] = &route.HeaderMatcher{
+		Name: util.SeldonRouteHeader,
+		HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{
+			PresentMatch: false,
+		},
+	}
+	addMatchRules(envoyRoute.Match, clusterTraffics[0].Matches)
+
+	// a candidate only routed to by its match rules has no weight in the experiment split,
+	// so its versions share the matched traffic equally
+	var totWeight uint32
+	for _, clusterTraffic := range clusterTraffics {
+		totWeight += clusterTraffic.TrafficWeight
+	}
+	if totWeight == 0 {
+		weighted := make([]TrafficSplit, len(clusterTraffics))
+		for idx, clusterTraffic := range clusterTraffics {
+			weighted[idx] = clusterTraffic
+			weighted[idx].TrafficWeight = 1
+		}
+		clusterTraffics = weighted
+	}
+	envoyRoute.Action = createWeightedModelClusterAction(clusterTraffics, r.Mirrors, isGrpc)
+	envoyRoute.RequestHeadersToAdd, envoyRoute.RequestHeadersToRemove = getCaptureRequestHeaders(r.CaptureExperiment, false)
+	addBanditRequestHeaders(envoyRoute, r.BanditExperiment, false)
+
+	if r.LogPayloads {
+		envoyRoute.ResponseHeadersToAdd = modelRouteHeaders
+	}
+}
+
+func addMatchRules(routeMatch *route.RouteMatch, rules []MatchRule) {
+	for _, rule := range rules {
+		stringMatcher := &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_Exact{
+				Exact: rule.Exact,
+			},
+		}
+		if rule.Regex != "" {
+			stringMatcher = &matcherv3.StringMatcher{
+				MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+					SafeRegex: &matcherv3.RegexMatcher{Regex: rule.Regex},
+				},
+			}
+		}
+		if rule.Header != "" {
+			routeMatch.Headers = append(routeMatch.Headers, &route.HeaderMatcher{
+				Name: strings.ToLower(rule.Header), // envoy matches on lower case header names
+				HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+					StringMatch: stringMatcher,
+				},
+			})
+		} else {
+			routeMatch.QueryParameters = append(routeMatch.QueryParameters, &route.QueryParameterMatcher{
+				Name: rule.QueryParameter,
+				QueryParameterMatchSpecifier: &route.QueryParameterMatcher_StringMatch{
+					StringMatch: stringMatcher,
+				},
+			})
+		}
+	}
+}
+
+func makePipelineEnvoyRoute(r *PipelineRoute, envoyRoute *route.Route, isGrpc bool, mirror *PipelineTrafficSplit) {
+	envoyRoute.Name = getRouteName(r.RouteName, isGrpc, mirror != nil)
 	envoyRoute.Match.PathSpecifier = pipelineRoutePathHttp
 	if isGrpc {
 		envoyRoute.Match.PathSpecifier = pipelineRoutePathGrpc
@@ -590,18 +827,49 @@ func makePipelineEnvoyRoute(r *PipelineRoute, envoyRoute *route.Route, isGrpc, i
 		},
 	}
 
-	if isMirror {
-		envoyRoute.Action = createWeightedPipelineClusterAction([]PipelineTrafficSplit{*r.Mirror}, nil, isGrpc)
+	if mirror != nil {
+		envoyRoute.Action = createWeightedPipelineClusterAction([]PipelineTrafficSplit{*mirror}, nil, isGrpc)
 	} else {
-		envoyRoute.Action = createWeightedPipelineClusterAction(r.Clusters, r.Mirror, isGrpc)
+		envoyRoute.Action = createWeightedPipelineClusterAction(r.Clusters, r.Mirrors, isGrpc)
+	}
+	addBanditRequestHeaders(envoyRoute, r.BanditExperiment, mirror != nil)
+}
+
+func makePipelineMatchEnvoyRoute(r *PipelineRoute, envoyRoute *route.Route, clusterTraffic PipelineTrafficSplit, isGrpc bool) {
+	envoyRoute.Name = fmt.Sprintf("%s_%s_match", getRouteName(r.RouteName, isGrpc, false), clusterTraffic.PipelineName)
+	envoyRoute.Match.PathSpecifier = pipelineRoutePathHttp
+	if isGrpc {
+		envoyRoute.Match.PathSpecifier = pipelineRoutePathGrpc
+	}
+	envoyRoute.Match.Headers[0] = &route.HeaderMatcher{
+		Name: util.SeldonModelHeader, // Header name we will match on
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+			StringMatch: &matcherv3.StringMatcher{
+				MatchPattern: &matcherv3.StringMatcher_Exact{
+					Exact: r.RouteName,
+				},
+			},
+		},
+	}
+	envoyRoute.Match.Headers[1] = &route.HeaderMatcher{
+		Name: util.SeldonRouteHeader,
+		HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{
+			PresentMatch: false,
+		},
 	}
+	addMatchRules(envoyRoute.Match, clusterTraffic.Matches)
+
+	// all matched traffic goes to the candidate whatever its weight in the experiment split
+	clusterTraffic.TrafficWeight = 100
+	envoyRoute.Action = createWeightedPipelineClusterAction([]PipelineTrafficSplit{clusterTraffic}, r.Mirrors, isGrpc)
+	addBanditRequestHeaders(envoyRoute, r.BanditExperiment, false)
 }
 
 func getPipelineModelName(pipelineName string) string {
 	return fmt.Sprintf("%s.%s", pipelineName, util.SeldonPipelineHeaderSuffix)
 }
 
-func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplit, mirrorTraffic *PipelineTrafficSplit, isGrpc bool) *route.Route_Route {
+func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplit, mirrorTraffics []PipelineTrafficSplit, isGrpc bool) *route.Route_Route {
 	// Add Weighted Clusters with given traffic percentages to each internal model
 	var splits []*route.WeightedCluster_ClusterWeight
 	var mirrors []*route.RouteAction_RequestMirrorPolicy
@@ -634,8 +902,12 @@ func createWeightedPipelineClusterAction(clusterTraffics []PipelineTrafficSplit,
 			})
 
 	}
-	if mirrorTraffic != nil {
-		mirrors = createMirrorRouteAction(mirrorTraffic.TrafficWeight, isGrpc)
+	if len(mirrorTraffics) > 0 {
+		mirrorWeights := make([]uint32, len(mirrorTraffics))
+		for idx, mirrorTraffic := range mirrorTraffics {
+			mirrorWeights[idx] = mirrorTraffic.TrafficWeight
+		}
+		mirrors = createMirrorRouteAction(mirrorWeights, isGrpc)
 	}
 	action := &route.Route_Route{
 		Route: &route.RouteAction{
@@ -746,15 +1018,58 @@ func countPipelineStickySessions(pipelineRoutes *util.CountedSyncMap[PipelineRou
 	return count
 }
 
+func countModelMatchRoutes(routes *util.CountedSyncMap[Route]) int {
+	count := 0
+
+	routes.Range(func(key string, r Route) bool {
+		count += len(getModelMatchTraffic(r.Clusters)) * 2 // REST and gRPC
+		return true
+	})
+
+	return count
+}
+
+func countPipelineMatchRoutes(pipelineRoutes *util.CountedSyncMap[PipelineRoute]) int {
+	count := 0
+
+	pipelineRoutes.Range(func(key string, r PipelineRoute) bool {
+		for _, clusterTraffic := range r.Clusters {
+			if len(clusterTraffic.Matches) > 0 {
+				count += 2 // REST and gRPC
+			}
+		}
+		return true
+	})
+
+	return count
+}
+
 func isExperiment[T any](clusters []T) bool {
 	return len(clusters) > 1
 }
 
-func countModelMirrors(routes *util.CountedSyncMap[Route]) int {
+// countMirrorListeners returns the number of mirror listeners needed for the experiment with the most mirrors,
+// there is always at least one
+func countMirrorListeners(routes *util.CountedSyncMap[Route], pipelines *util.CountedSyncMap[PipelineRoute]) int {
+	count := 1
+
+	routes.Range(func(_ string, r Route) bool {
+		count = max(count, len(r.Mirrors))
+		return true
+	})
+	pipelines.Range(func(_ string, r PipelineRoute) bool {
+		count = max(count, len(r.Mirrors))
+		return true
+	})
+
+	return min(count, util.MaxExperimentMirrors)
+}
+
+func countModelMirrors(routes *util.CountedSyncMap[Route], mirrorIdx int) int {
 	count := 0
 
 	routes.Range(func(key string, r Route) bool {
-		if r.Mirror != nil {
+		if len(r.Mirrors) > mirrorIdx {
 			count += +2 // REST and gRPC
 		}
 		return true
@@ -763,11 +1078,11 @@ func countModelMirrors(routes *util.CountedSyncMap[Route]) int {
 	return count
 }
 
-func countPipelineMirrors(pipelines *util.CountedSyncMap[PipelineRoute]) int {
+func countPipelineMirrors(pipelines *util.CountedSyncMap[PipelineRoute], mirrorIdx int) int {
 	count := 0
 
 	pipelines.Range(func(key string, value PipelineRoute) bool {
-		if value.Mirror != nil {
+		if len(value.Mirrors) > mirrorIdx {
 			count += 2 // REST and gRPC
 		}
 		return true
@@ -844,7 +1159,7 @@ func createAccessLogConfig(path string) *anypb.Any {
 				Format: &core.SubstitutionFormatString_TextFormatSource{
 					TextFormatSource: &core.DataSource{
 						Specifier: &core.DataSource_InlineString{
-							InlineString: "[%START_TIME%] \"%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%\" %RESPONSE_CODE% %GRPC_STATUS_NUMBER% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% \"%REQ(X-FORWARDED-FOR)%\" \"%REQ(USER-AGENT)%\" \"%REQ(X-REQUEST-ID)%\" \"%REQ(:AUTHORITY)%\" \"%UPSTREAM_HOST%\"\n",
+							InlineString: "[%START_TIME%] \"%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%\" %RESPONSE_CODE% %GRPC_STATUS_NUMBER% %RESPONSE_FLAGS% %RESPONSE_CODE_DETAILS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% \"%REQ(X-FORWARDED-FOR)%\" \"%REQ(USER-AGENT)%\" \"%REQ(X-REQUEST-ID)%\" \"%REQ(:AUTHORITY)%\" \"%UPSTREAM_HOST%\"\n",
 						},
 					},
 				},
@@ -943,6 +1258,7 @@ func createHeaderFilter() *anypb.Any {
 		DefaultSourceCode: &core.DataSource{
 			Specifier: &core.DataSource_InlineString{
 				InlineString: `function envoy_on_request(request_handle)
+  request_handle:headers():remove("` + util.SeldonTenantHeader + `")
   local modelHeader = request_handle:headers():get("` + util.SeldonModelHeader + `")
   local routeHeader = request_handle:headers():get("` + util.SeldonRouteHeader + `")
   if (modelHeader == nil or modelHeader == '') and (routeHeader == nil or routeHeader == '') then
@@ -12,6 +12,7 @@ package xdscache
 import (
 	"testing"
 
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	. "github.com/onsi/gomega"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
@@ -24,7 +25,10 @@ func TestMakeRoute(t *testing.T) {
 		modelRoutes           func() *util.CountedSyncMap[Route]
 		pipelineRoutes        func() *util.CountedSyncMap[PipelineRoute]
 		expectedDefaultRoutes int
-		expectedMirrorRoutes  int
+		// the total across the route configurations of all mirror listeners
+		expectedMirrorRoutes int
+		// there is always one mirror listener, further ones only for the mirrors in use
+		expectedMirrorListeners int
 	}
 
 	tests := []test{
@@ -46,8 +50,9 @@ func TestMakeRoute(t *testing.T) {
 				})
 				return c
 			},
-			expectedDefaultRoutes: 2,
-			expectedMirrorRoutes:  0,
+			expectedDefaultRoutes:   2,
+			expectedMirrorRoutes:    0,
+			expectedMirrorListeners: 1,
 		},
 		{
 			name: "one pipeline",
@@ -64,8 +69,9 @@ func TestMakeRoute(t *testing.T) {
 				})
 				return c
 			},
-			expectedDefaultRoutes: 2,
-			expectedMirrorRoutes:  0,
+			expectedDefaultRoutes:   2,
+			expectedMirrorRoutes:    0,
+			expectedMirrorListeners: 1,
 		},
 		{
 			name: "pipeline experiment",
@@ -86,8 +92,9 @@ func TestMakeRoute(t *testing.T) {
 				})
 				return c
 			},
-			expectedDefaultRoutes: 6,
-			expectedMirrorRoutes:  0,
+			expectedDefaultRoutes:   6,
+			expectedMirrorRoutes:    0,
+			expectedMirrorListeners: 1,
 		},
 		{
 			name: "pipeline experiment with mirror",
@@ -106,15 +113,18 @@ func TestMakeRoute(t *testing.T) {
 							TrafficWeight: 50,
 						},
 					},
-					Mirror: &PipelineTrafficSplit{
-						PipelineName:  "p3",
-						TrafficWeight: 100,
+					Mirrors: []PipelineTrafficSplit{
+						{
+							PipelineName:  "p3",
+							TrafficWeight: 100,
+						},
 					},
 				})
 				return c
 			},
-			expectedDefaultRoutes: 6,
-			expectedMirrorRoutes:  2,
+			expectedDefaultRoutes:   6,
+			expectedMirrorRoutes:    2,
+			expectedMirrorListeners: 1,
 		},
 		{
 			name: "model experiment",
@@ -141,8 +151,9 @@ func TestMakeRoute(t *testing.T) {
 				})
 				return c
 			},
-			expectedDefaultRoutes: 6,
-			expectedMirrorRoutes:  0,
+			expectedDefaultRoutes:   6,
+			expectedMirrorRoutes:    0,
+			expectedMirrorListeners: 1,
 		},
 		{
 			name: "experiment with model mirror",
@@ -166,18 +177,122 @@ func TestMakeRoute(t *testing.T) {
 							GrpcCluster:   "g1",
 						},
 					},
-					Mirror: &TrafficSplit{
-						ModelName:     "m3",
-						ModelVersion:  1,
-						TrafficWeight: 100,
-						HttpCluster:   "h1",
-						GrpcCluster:   "g1",
+					Mirrors: []TrafficSplit{
+						{
+							ModelName:     "m3",
+							ModelVersion:  1,
+							TrafficWeight: 100,
+							HttpCluster:   "h1",
+							GrpcCluster:   "g1",
+						},
 					},
 				})
 				return c
 			},
-			expectedDefaultRoutes: 6,
-			expectedMirrorRoutes:  2,
+			expectedDefaultRoutes:   6,
+			expectedMirrorRoutes:    2,
+			expectedMirrorListeners: 1,
+		},
+		{
+			name: "experiment with multiple model mirrors",
+			modelRoutes: func() *util.CountedSyncMap[Route] {
+				c := util.NewCountedSyncMap[Route]()
+				c.Store("r1", Route{
+					RouteName: "r1",
+					Clusters: []TrafficSplit{
+						{
+							ModelName:     "m1",
+							ModelVersion:  1,
+							TrafficWeight: 100,
+							HttpCluster:   "h1",
+							GrpcCluster:   "g1",
+						},
+					},
+					Mirrors: []TrafficSplit{
+						{
+							ModelName:     "m2",
+							ModelVersion:  1,
+							TrafficWeight: 100,
+							HttpCluster:   "h2",
+							GrpcCluster:   "g2",
+						},
+						{
+							ModelName:     "m3",
+							ModelVersion:  1,
+							TrafficWeight: 50,
+							HttpCluster:   "h3",
+							GrpcCluster:   "g3",
+						},
+					},
+					CaptureExperiment: "exp",
+				})
+				return c
+			},
+			expectedDefaultRoutes:   2,
+			expectedMirrorRoutes:    4,
+			expectedMirrorListeners: 2,
+		},
+		{
+			name: "model experiment with matches",
+			modelRoutes: func() *util.CountedSyncMap[Route] {
+				c := util.NewCountedSyncMap[Route]()
+				c.Store("r1", Route{
+					RouteName: "r1",
+					Clusters: []TrafficSplit{
+						{
+							ModelName:     "m1",
+							ModelVersion:  1,
+							TrafficWeight: 100,
+							HttpCluster:   "h1",
+							GrpcCluster:   "g1",
+						},
+						{
+							ModelName:     "m2",
+							ModelVersion:  1,
+							TrafficWeight: 0,
+							HttpCluster:   "h2",
+							GrpcCluster:   "g2",
+							Matches:       []MatchRule{{Header: "X-Tenant", Exact: "foo"}},
+						},
+						{
+							ModelName:     "m2",
+							ModelVersion:  2,
+							TrafficWeight: 0,
+							HttpCluster:   "h3",
+							GrpcCluster:   "g3",
+							Matches:       []MatchRule{{Header: "X-Tenant", Exact: "foo"}},
+						},
+					},
+				})
+				return c
+			},
+			expectedDefaultRoutes:   10,
+			expectedMirrorRoutes:    0,
+			expectedMirrorListeners: 1,
+		},
+		{
+			name: "pipeline experiment with matches",
+			pipelineRoutes: func() *util.CountedSyncMap[PipelineRoute] {
+				c := util.NewCountedSyncMap[PipelineRoute]()
+				c.Store("r1", PipelineRoute{
+					RouteName: "r1",
+					Clusters: []PipelineTrafficSplit{
+						{
+							PipelineName:  "p1",
+							TrafficWeight: 50,
+						},
+						{
+							PipelineName:  "p2",
+							TrafficWeight: 50,
+							Matches:       []MatchRule{{QueryParameter: "tenant", Regex: "foo.*"}},
+						},
+					},
+				})
+				return c
+			},
+			expectedDefaultRoutes:   8,
+			expectedMirrorRoutes:    0,
+			expectedMirrorListeners: 1,
 		},
 	}
 
@@ -194,13 +309,58 @@ func TestMakeRoute(t *testing.T) {
 				routes = test.modelRoutes()
 			}
 
-			rcDef, rcMirror := makeRoutes(routes, pipelines)
+			rcDef, rcMirrors := makeRoutes(routes, pipelines, DefaultJwtGroupsClaim)
 			g.Expect(len(rcDef.VirtualHosts[0].Routes)).To(Equal(test.expectedDefaultRoutes))
-			g.Expect(len(rcMirror.VirtualHosts[0].Routes)).To(Equal(test.expectedMirrorRoutes))
+			g.Expect(rcMirrors).To(HaveLen(test.expectedMirrorListeners))
+			mirrorRoutes := 0
+			for _, rcMirror := range rcMirrors {
+				mirrorRoutes += len(rcMirror.VirtualHosts[0].Routes)
+			}
+			g.Expect(mirrorRoutes).To(Equal(test.expectedMirrorRoutes))
 		})
 	}
 }
 
+func TestMakeModelMatchEnvoyRoute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	r := Route{
+		RouteName: "r1",
+		Clusters: []TrafficSplit{
+			{
+				ModelName:     "m2",
+				ModelVersion:  1,
+				TrafficWeight: 0,
+				HttpCluster:   "h1",
+				GrpcCluster:   "g1",
+				Matches: []MatchRule{
+					{Header: "X-Tenant", Exact: "foo"},
+					{QueryParameter: "region", Regex: "eu-.*"},
+				},
+			},
+		},
+	}
+	envoyRoute := &route.Route{
+		Match: &route.RouteMatch{
+			Headers: make([]*route.HeaderMatcher, 2),
+		},
+	}
+	makeModelMatchEnvoyRoute(&r, envoyRoute, r.Clusters, false)
+
+	g.Expect(envoyRoute.Name).To(Equal("r1_http_m2_match"))
+	g.Expect(envoyRoute.Match.Headers).To(HaveLen(3))
+	g.Expect(envoyRoute.Match.Headers[0].GetStringMatch().GetExact()).To(Equal("r1"))
+	g.Expect(envoyRoute.Match.Headers[2].Name).To(Equal("x-tenant"))
+	g.Expect(envoyRoute.Match.Headers[2].GetStringMatch().GetExact()).To(Equal("foo"))
+	g.Expect(envoyRoute.Match.QueryParameters).To(HaveLen(1))
+	g.Expect(envoyRoute.Match.QueryParameters[0].Name).To(Equal("region"))
+	g.Expect(envoyRoute.Match.QueryParameters[0].GetStringMatch().GetSafeRegex().GetRegex()).To(Equal("eu-.*"))
+	clusters := envoyRoute.GetRoute().GetWeightedClusters().GetClusters()
+	g.Expect(clusters).To(HaveLen(1))
+	g.Expect(clusters[0].Name).To(Equal("h1"))
+	g.Expect(clusters[0].Weight.GetValue()).To(Equal(uint32(1)))
+}
+
 func TestGetRouteName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type test struct {
@@ -285,3 +445,114 @@ func TestGetRouteName(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeRoutesWithMirrorsAndCapture(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	routes := util.NewCountedSyncMap[Route]()
+	routes.Store("r1", Route{
+		RouteName: "r1",
+		Clusters: []TrafficSplit{
+			{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"},
+		},
+		Mirrors: []TrafficSplit{
+			{ModelName: "m2", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h2", GrpcCluster: "g2"},
+			{ModelName: "m3", ModelVersion: 1, TrafficWeight: 50, HttpCluster: "h3", GrpcCluster: "g3"},
+		},
+		CaptureExperiment: "exp",
+	})
+
+	rcDef, rcMirrors := makeRoutes(routes, util.NewCountedSyncMap[PipelineRoute](), DefaultJwtGroupsClaim)
+
+	httpRoute := rcDef.VirtualHosts[0].Routes[0]
+	g.Expect(httpRoute.Name).To(Equal("r1_http"))
+	mirrorPolicies := httpRoute.GetRoute().RequestMirrorPolicies
+	g.Expect(mirrorPolicies).To(HaveLen(2))
+	g.Expect(mirrorPolicies[0].Cluster).To(Equal(MirrorHttpClusterName))
+	g.Expect(mirrorPolicies[0].RuntimeFraction.DefaultValue.Numerator).To(Equal(uint32(100)))
+	g.Expect(mirrorPolicies[1].Cluster).To(Equal(MirrorHttpClusterName + "_1"))
+	g.Expect(mirrorPolicies[1].RuntimeFraction.DefaultValue.Numerator).To(Equal(uint32(50)))
+	g.Expect(httpRoute.RequestHeadersToAdd).To(HaveLen(1))
+	g.Expect(httpRoute.RequestHeadersToAdd[0].Header.Key).To(Equal(util.SeldonCaptureHeader))
+	g.Expect(httpRoute.RequestHeadersToAdd[0].Header.Value).To(Equal("exp"))
+
+	g.Expect(rcMirrors[1].Name).To(Equal(MirrorRouteConfigurationName + "_1"))
+	mirrorRoute := rcMirrors[1].VirtualHosts[0].Routes[0]
+	g.Expect(mirrorRoute.Name).To(Equal("r1_http_mirror"))
+	clusters := mirrorRoute.GetRoute().GetWeightedClusters().GetClusters()
+	g.Expect(clusters).To(HaveLen(1))
+	g.Expect(clusters[0].Name).To(Equal("h3"))
+	g.Expect(mirrorRoute.RequestHeadersToAdd).To(HaveLen(2))
+	g.Expect(mirrorRoute.RequestHeadersToAdd[1].Header.Key).To(Equal(util.SeldonShadowHeader))
+	g.Expect(mirrorRoute.RequestHeadersToRemove).To(ContainElement(util.SeldonShadowHeader))
+}
+
+func TestMakeRoutesWithBandit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	routes := util.NewCountedSyncMap[Route]()
+	routes.Store("r1", Route{
+		RouteName: "r1",
+		Clusters: []TrafficSplit{
+			{ModelName: "m1", ModelVersion: 1, TrafficWeight: 50, HttpCluster: "h1", GrpcCluster: "g1"},
+			{ModelName: "m2", ModelVersion: 1, TrafficWeight: 50, HttpCluster: "h2", GrpcCluster: "g2"},
+		},
+		Mirrors: []TrafficSplit{
+			{ModelName: "m3", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h3", GrpcCluster: "g3"},
+		},
+		BanditExperiment: "exp",
+	})
+	routes.Store("r2", Route{
+		RouteName: "r2",
+		Clusters: []TrafficSplit{
+			{ModelName: "m4", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h4", GrpcCluster: "g4"},
+		},
+	})
+	pipelines := util.NewCountedSyncMap[PipelineRoute]()
+	pipelines.Store("p1", PipelineRoute{
+		RouteName: "p1",
+		Clusters: []PipelineTrafficSplit{
+			{PipelineName: "p1", TrafficWeight: 50},
+			{PipelineName: "p2", TrafficWeight: 50},
+		},
+		BanditExperiment: "exp2",
+	})
+
+	rcDef, rcMirrors := makeRoutes(routes, pipelines, DefaultJwtGroupsClaim)
+
+	expectedBanditHeaders := map[string]string{
+		"r1_http": "exp",
+		"r1_grpc": "exp",
+		"r2_http": "",
+		"r2_grpc": "",
+		"p1_http": "exp2",
+		"p1_grpc": "exp2",
+	}
+	found := 0
+	for _, envoyRoute := range rcDef.VirtualHosts[0].Routes {
+		expected, ok := expectedBanditHeaders[envoyRoute.Name]
+		if !ok {
+			continue
+		}
+		found++
+		// a bandit header sent by the client is always removed
+		g.Expect(envoyRoute.RequestHeadersToRemove).To(ContainElement(util.SeldonBanditHeader))
+		var value string
+		for _, header := range envoyRoute.RequestHeadersToAdd {
+			if header.Header.Key == util.SeldonBanditHeader {
+				value = header.Header.Value
+			}
+		}
+		g.Expect(value).To(Equal(expected), envoyRoute.Name)
+	}
+	g.Expect(found).To(Equal(len(expectedBanditHeaders)))
+
+	// mirrored requests are not served by a candidate
+	for _, rcMirror := range rcMirrors {
+		for _, envoyRoute := range rcMirror.VirtualHosts[0].Routes {
+			for _, header := range envoyRoute.RequestHeadersToAdd {
+				g.Expect(header.Header.Key).ToNot(Equal(util.SeldonBanditHeader))
+			}
+		}
+	}
+}
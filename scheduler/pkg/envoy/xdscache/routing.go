@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package xdscache
+
+import (
+	"strings"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	duration "google.golang.org/protobuf/types/known/durationpb"
+	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	defaultRetryOn          = "5xx,connect-failure"
+	defaultMaxRetries       = 5
+	defaultRetryBackoffBase = 500 * time.Millisecond
+	// retries allowed by a retry budget whatever the active requests
+	retryBudgetMinConcurrency = 3
+)
+
+// addRouteRouting sets the timeout and retries of the requests on the envoy routes of a model or pipeline
+func addRouteRouting(envoyRoutes []*route.Route, routing *Routing) {
+	if routing == nil {
+		return
+	}
+	for _, envoyRoute := range envoyRoutes {
+		routeAction := envoyRoute.GetRoute()
+		if routeAction == nil {
+			continue
+		}
+		if routing.Timeout > 0 {
+			routeAction.Timeout = duration.New(routing.Timeout)
+		}
+		if routing.Retry != nil {
+			routeAction.RetryPolicy = createRetryPolicy(routing.Retry)
+		}
+		// requests with the same header value are sent to the same endpoint while it is available
+		if routing.LoadBalancer == RingHashLoadBalancer && routing.HashHeader != "" {
+			routeAction.HashPolicy = []*route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &route.RouteAction_HashPolicy_Header_{
+						Header: &route.RouteAction_HashPolicy_Header{
+							HeaderName: strings.ToLower(routing.HashHeader),
+						},
+					},
+				},
+			}
+		}
+	}
+}
+
+func createRetryPolicy(retry *RetryPolicy) *route.RetryPolicy {
+	retryOn := defaultRetryOn
+	if len(retry.RetryOn) > 0 {
+		retryOn = strings.Join(retry.RetryOn, ",")
+	}
+	maxRetries := uint32(defaultMaxRetries)
+	if retry.MaxRetries != nil {
+		maxRetries = *retry.MaxRetries
+	}
+	backoffBase := defaultRetryBackoffBase
+	if retry.BackoffBaseInterval > 0 {
+		backoffBase = retry.BackoffBaseInterval
+	}
+	retryPolicy := &route.RetryPolicy{
+		RetryOn:    retryOn,
+		NumRetries: &wrappers.UInt32Value{Value: maxRetries},
+		RetryBackOff: &route.RetryPolicy_RetryBackOff{
+			BaseInterval: duration.New(backoffBase),
+		},
+	}
+	if retry.PerTryTimeout > 0 {
+		retryPolicy.PerTryTimeout = duration.New(retry.PerTryTimeout)
+	}
+	return retryPolicy
+}
+
+// addClusterRouting sets the connect timeout, load balancing, outlier detection and retry budget of a cluster
+func addClusterRouting(envoyCluster *cluster.Cluster, routing *Routing) {
+	if routing == nil {
+		return
+	}
+	if routing.ConnectTimeout > 0 {
+		envoyCluster.ConnectTimeout = duration.New(routing.ConnectTimeout)
+	}
+	switch routing.LoadBalancer {
+	case RoundRobinLoadBalancer:
+		envoyCluster.LbPolicy = cluster.Cluster_ROUND_ROBIN
+	case RingHashLoadBalancer:
+		envoyCluster.LbPolicy = cluster.Cluster_RING_HASH
+	case RandomLoadBalancer:
+		envoyCluster.LbPolicy = cluster.Cluster_RANDOM
+	default:
+		envoyCluster.LbPolicy = cluster.Cluster_LEAST_REQUEST
+	}
+	if routing.OutlierDetection != nil {
+		envoyCluster.OutlierDetection = createOutlierDetection(routing.OutlierDetection)
+	}
+	// a retry budget replaces the max parallel retries of the circuit breaker
+	if routing.Retry != nil && routing.Retry.BudgetPercent != nil {
+		envoyCluster.CircuitBreakers = &cluster.CircuitBreakers{
+			Thresholds: []*cluster.CircuitBreakers_Thresholds{
+				{
+					RetryBudget: &cluster.CircuitBreakers_Thresholds_RetryBudget{
+						BudgetPercent:       &typev3.Percent{Value: float64(*routing.Retry.BudgetPercent)},
+						MinRetryConcurrency: &wrappers.UInt32Value{Value: retryBudgetMinConcurrency},
+					},
+				},
+			},
+		}
+	}
+}
+
+// createOutlierDetection ejects endpoints on consecutive 5xx responses, envoy defaults are kept for the unset fields
+func createOutlierDetection(outlierDetection *OutlierDetection) *cluster.OutlierDetection {
+	envoyOutlierDetection := &cluster.OutlierDetection{}
+	if outlierDetection.Consecutive5xx > 0 {
+		envoyOutlierDetection.Consecutive_5Xx = &wrappers.UInt32Value{Value: outlierDetection.Consecutive5xx}
+	}
+	if outlierDetection.Interval > 0 {
+		envoyOutlierDetection.Interval = duration.New(outlierDetection.Interval)
+	}
+	if outlierDetection.BaseEjectionTime > 0 {
+		envoyOutlierDetection.BaseEjectionTime = duration.New(outlierDetection.BaseEjectionTime)
+	}
+	if outlierDetection.MaxEjectionPercent > 0 {
+		envoyOutlierDetection.MaxEjectionPercent = &wrappers.UInt32Value{Value: outlierDetection.MaxEjectionPercent}
+	}
+	return envoyOutlierDetection
+}
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package xdscache
+
+import (
+	"testing"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func TestMakeRoutesRouting(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                string
+		routing             *Routing
+		expectedTimeout     time.Duration
+		expectedRetryOn     string
+		expectedNumRetries  uint32
+		expectedPerTry      time.Duration
+		expectedHashHeader  string
+		expectedModelRetry  bool
+		expectedPipelineTry bool
+	}
+
+	maxRetries := uint32(2)
+	tests := []test{
+		{
+			name:               "no routing",
+			expectedRetryOn:    defaultRetryOn,
+			expectedNumRetries: defaultMaxRetries,
+		},
+		{
+			name:               "timeout keeps the default retries",
+			routing:            &Routing{Timeout: 3 * time.Second},
+			expectedTimeout:    3 * time.Second,
+			expectedRetryOn:    defaultRetryOn,
+			expectedNumRetries: defaultMaxRetries,
+		},
+		{
+			name: "retries",
+			routing: &Routing{
+				Timeout: time.Second,
+				Retry: &RetryPolicy{
+					RetryOn:       []string{"reset", "retriable-4xx"},
+					MaxRetries:    &maxRetries,
+					PerTryTimeout: 200 * time.Millisecond,
+				},
+			},
+			expectedTimeout:    time.Second,
+			expectedRetryOn:    "reset,retriable-4xx",
+			expectedNumRetries: 2,
+			expectedPerTry:     200 * time.Millisecond,
+		},
+		{
+			name: "ring hash",
+			routing: &Routing{
+				LoadBalancer: RingHashLoadBalancer,
+				HashHeader:   "X-Session-Id",
+			},
+			expectedRetryOn:    defaultRetryOn,
+			expectedNumRetries: defaultMaxRetries,
+			expectedHashHeader: "x-session-id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			routes := util.NewCountedSyncMap[Route]()
+			routes.Store("r1", Route{
+				RouteName: "r1",
+				Clusters: []TrafficSplit{
+					{ModelName: "m1", ModelVersion: 1, TrafficWeight: 100, HttpCluster: "h1", GrpcCluster: "g1"},
+				},
+				Routing: test.routing,
+			})
+			pipelines := util.NewCountedSyncMap[PipelineRoute]()
+			pipelines.Store("p1", PipelineRoute{
+				RouteName: "p1",
+				Clusters:  []PipelineTrafficSplit{{PipelineName: "p1", TrafficWeight: 100}},
+				Routing:   test.routing,
+			})
+
+			rcDef, _ := makeRoutes(routes, pipelines, DefaultJwtGroupsClaim)
+			g.Expect(rcDef.VirtualHosts[0].Routes).To(HaveLen(4))
+			for _, envoyRoute := range rcDef.VirtualHosts[0].Routes {
+				routeAction := envoyRoute.GetRoute()
+				g.Expect(routeAction.Timeout.AsDuration()).To(Equal(test.expectedTimeout))
+				g.Expect(routeAction.RetryPolicy.RetryOn).To(Equal(test.expectedRetryOn))
+				g.Expect(routeAction.RetryPolicy.NumRetries.GetValue()).To(Equal(test.expectedNumRetries))
+				if test.expectedPerTry > 0 {
+					g.Expect(routeAction.RetryPolicy.PerTryTimeout.AsDuration()).To(Equal(test.expectedPerTry))
+				} else {
+					g.Expect(routeAction.RetryPolicy.PerTryTimeout).To(BeNil())
+				}
+				if test.expectedHashHeader != "" {
+					g.Expect(routeAction.HashPolicy).To(HaveLen(1))
+					g.Expect(routeAction.HashPolicy[0].GetHeader().HeaderName).To(Equal(test.expectedHashHeader))
+				} else {
+					g.Expect(routeAction.HashPolicy).To(BeEmpty())
+				}
+			}
+		})
+	}
+}
+
+func TestAddClusterRouting(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                   string
+		routing                *Routing
+		expectedConnectTimeout time.Duration
+		expectedLbPolicy       cluster.Cluster_LbPolicy
+		expectedConsecutive5xx uint32
+		expectedBudgetPercent  float64
+	}
+
+	budgetPercent := uint32(20)
+	tests := []test{
+		{
+			name:                   "no routing",
+			expectedConnectTimeout: 5 * time.Second,
+			expectedLbPolicy:       cluster.Cluster_LEAST_REQUEST,
+		},
+		{
+			name: "routing",
+			routing: &Routing{
+				ConnectTimeout:   time.Second,
+				LoadBalancer:     RandomLoadBalancer,
+				OutlierDetection: &OutlierDetection{Consecutive5xx: 3, Interval: 5 * time.Second},
+				Retry:            &RetryPolicy{BudgetPercent: &budgetPercent},
+			},
+			expectedConnectTimeout: time.Second,
+			expectedLbPolicy:       cluster.Cluster_RANDOM,
+			expectedConsecutive5xx: 3,
+			expectedBudgetPercent:  20,
+		},
+		{
+			name:                   "round robin",
+			routing:                &Routing{LoadBalancer: RoundRobinLoadBalancer},
+			expectedConnectTimeout: 5 * time.Second,
+			expectedLbPolicy:       cluster.Cluster_ROUND_ROBIN,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, isGrpc := range []bool{false, true} {
+				envoyCluster := makeCluster("c1", map[string]Endpoint{"e1": {UpstreamHost: "0.0.0.0", UpstreamPort: 9000}}, isGrpc, nil)
+				addClusterRouting(envoyCluster, test.routing)
+				g.Expect(envoyCluster.ConnectTimeout.AsDuration()).To(Equal(test.expectedConnectTimeout))
+				g.Expect(envoyCluster.LbPolicy).To(Equal(test.expectedLbPolicy))
+				thresholds := envoyCluster.CircuitBreakers.Thresholds[0]
+				if test.expectedBudgetPercent > 0 {
+					g.Expect(thresholds.RetryBudget.BudgetPercent.Value).To(Equal(test.expectedBudgetPercent))
+					g.Expect(thresholds.MaxRetries).To(BeNil())
+				} else {
+					g.Expect(thresholds.RetryBudget).To(BeNil())
+					g.Expect(thresholds.MaxRetries.GetValue()).To(Equal(uint32(circuitBreakerMaxRetry)))
+				}
+				if test.expectedConsecutive5xx > 0 {
+					g.Expect(envoyCluster.OutlierDetection.Consecutive_5Xx.GetValue()).To(Equal(test.expectedConsecutive5xx))
+					g.Expect(envoyCluster.OutlierDetection.Interval.AsDuration()).To(Equal(5 * time.Second))
+					g.Expect(envoyCluster.OutlierDetection.BaseEjectionTime).To(BeNil())
+				} else {
+					g.Expect(envoyCluster.OutlierDetection).To(BeNil())
+				}
+			}
+		})
+	}
+}
@@ -39,6 +39,8 @@ const (
 	mirrorListenerName           = "seldon_mirrors"
 	mirrorListenerAddress        = "0.0.0.0"
 	mirrorListenerPort    uint32 = 9001
+	// the listeners for any further mirrors of an experiment are on consecutive ports from this one
+	extraMirrorListenerBasePort uint32 = 9010
 
 	envoyDownstreamServerCertName = "downstream_server"
 	envoyDownstreamClientCertName = "downstream_client"
@@ -70,6 +72,8 @@ type SeldonXDSCache struct {
 	tlsActive              bool
 	snapshotVersion        int64
 	config                 *EnvoyConfig
+	// number of mirror listeners and their clusters sent to envoy, further ones are only added while used
+	mirrorListeners int
 }
 
 type PipelineGatewayDetails struct {
@@ -82,6 +86,14 @@ type EnvoyConfig struct {
 	AccessLogPath             string
 	IncludeSuccessfulRequests bool
 	EnableAccessLog           bool
+	Auth                      AuthConfig
+}
+
+func (c *EnvoyConfig) authConfig() *AuthConfig {
+	if c == nil {
+		return nil
+	}
+	return &c.Auth
 }
 
 func NewSeldonXDSCache(logger logrus.FieldLogger, pipelineGatewayDetails *PipelineGatewayDetails, config *EnvoyConfig) (*SeldonXDSCache, error) {
@@ -253,32 +265,57 @@ func (xds *SeldonXDSCache) addPermanentListeners() error {
 		}
 	}
 	resources := make(map[string]types.Resource)
-	resources[defaultListenerName] = makeHTTPListener(defaultListenerName, defaultListenerAddress, defaultListenerPort, DefaultRouteConfigurationName, serverSecret, xds.config)
-	resources[mirrorListenerName] = makeHTTPListener(mirrorListenerName, mirrorListenerAddress, mirrorListenerPort, MirrorRouteConfigurationName, serverSecret, xds.config)
+	resources[defaultListenerName] = makeHTTPListener(defaultListenerName, defaultListenerAddress, defaultListenerPort, DefaultRouteConfigurationName, serverSecret, xds.config, false)
+	resources[mirrorListenerName] = xds.makeMirrorListener(0)
+	xds.mirrorListeners = 1
 	return xds.lds.UpdateResources(resources, nil)
 }
 
-func (xds *SeldonXDSCache) addPermanentClusters() error {
-	resources := make(map[string]types.Resource)
+// mirrored requests come from envoy once authenticated and rate limited on the default listener
+func (xds *SeldonXDSCache) makeMirrorListener(mirrorIdx int) types.Resource {
+	var serverSecret *Secret
+	if xds.tlsActive {
+		if secret, ok := xds.secrets[envoyDownstreamServerCertName]; ok {
+			serverSecret = &secret
+		}
+	}
+	listenerName := getMirrorListenerName(mirrorIdx)
+	return makeHTTPListener(listenerName, mirrorListenerAddress, getMirrorListenerPort(mirrorIdx), getMirrorRouteConfigurationName(mirrorIdx), serverSecret, xds.config, true)
+}
 
-	// Add Mirror clusters
-	mirrorHttpEndpointName := fmt.Sprintf("%s:%d", mirrorListenerAddress, mirrorListenerPort)
-	mirrorHttpCluster := makeCluster(MirrorHttpClusterName, map[string]Endpoint{
-		mirrorHttpEndpointName: {
+func addMirrorClusters(resources map[string]types.Resource, mirrorIdx int) {
+	mirrorPort := getMirrorListenerPort(mirrorIdx)
+	mirrorEndpointName := fmt.Sprintf("%s:%d", mirrorListenerAddress, mirrorPort)
+	mirrorHttpCluster := makeCluster(getMirrorClusterName(mirrorIdx, false), map[string]Endpoint{
+		mirrorEndpointName: {
 			UpstreamHost: mirrorListenerAddress,
-			UpstreamPort: mirrorListenerPort,
+			UpstreamPort: mirrorPort,
 		},
 	}, false, nil)
-	resources[mirrorHttpCluster.Name] = mirrorHttpCluster
 
-	mirrorGrpcEndpointName := fmt.Sprintf("%s:%d", mirrorListenerAddress, mirrorListenerPort)
-	mirrorGprcCluster := makeCluster(MirrorGrpcClusterName, map[string]Endpoint{
-		mirrorGrpcEndpointName: {
+	mirrorGprcCluster := makeCluster(getMirrorClusterName(mirrorIdx, true), map[string]Endpoint{
+		mirrorEndpointName: {
 			UpstreamHost: mirrorListenerAddress,
-			UpstreamPort: mirrorListenerPort,
+			UpstreamPort: mirrorPort,
 		},
 	}, true, nil)
+	resources[mirrorHttpCluster.Name] = mirrorHttpCluster
 	resources[mirrorGprcCluster.Name] = mirrorGprcCluster
+}
+
+func (xds *SeldonXDSCache) addPermanentClusters() error {
+	resources := make(map[string]types.Resource)
+
+	// Add Mirror clusters
+	addMirrorClusters(resources, 0)
+
+	authClusters, err := makeAuthClusters(xds.config.authConfig())
+	if err != nil {
+		return err
+	}
+	for _, authCluster := range authClusters {
+		resources[authCluster.Name] = authCluster
+	}
 
 	return xds.cds.UpdateResources(resources, nil)
 }
@@ -295,8 +332,12 @@ func (xds *SeldonXDSCache) RouteResources() []types.Resource {
 	xds.mu.RLock()
 	defer xds.mu.RUnlock()
 
-	defaultRoutes, mirrorRoutes := makeRoutes(xds.Routes, xds.Pipelines)
-	return []types.Resource{defaultRoutes, mirrorRoutes}
+	defaultRoutes, mirrorRoutes := makeRoutes(xds.Routes, xds.Pipelines, xds.config.authConfig().groupsClaim())
+	resources := []types.Resource{defaultRoutes}
+	for _, mirrorRoute := range mirrorRoutes {
+		resources = append(resources, mirrorRoute)
+	}
+	return resources
 }
 
 func (xds *SeldonXDSCache) UpdateRoutes(nodeId string) error {
@@ -332,11 +373,16 @@ func (xds *SeldonXDSCache) AddClusters() error {
 		}
 	}
 
+	if err := xds.addMirrorListeners(); err != nil {
+		return err
+	}
+
 	resources := make(map[string]types.Resource)
 	for clusterName := range xds.clustersToAdd {
 		cluster, ok := xds.Clusters.Load(clusterName)
 		if ok {
 			resource := makeCluster(cluster.Name, cluster.Endpoints, cluster.Grpc, clientSecret)
+			addClusterRouting(resource, cluster.Routing)
 			resources[cluster.Name] = resource
 		}
 		delete(xds.clustersToAdd, clusterName)
@@ -357,7 +403,56 @@ func (xds *SeldonXDSCache) RemoveClusters() error {
 		delete(xds.clustersToRemove, clusterName)
 	}
 
-	return xds.cds.UpdateResources(nil, clustersToRemove)
+	if err := xds.cds.UpdateResources(nil, clustersToRemove); err != nil {
+		return err
+	}
+	return xds.removeMirrorListeners()
+}
+
+// addMirrorListeners adds the listeners and clusters of further mirrors of experiments before the routes
+// send mirrored traffic to them, caller must hold the lock
+func (xds *SeldonXDSCache) addMirrorListeners() error {
+	mirrorListeners := countMirrorListeners(xds.Routes, xds.Pipelines)
+	if mirrorListeners <= xds.mirrorListeners {
+		return nil
+	}
+	listeners := make(map[string]types.Resource)
+	clusters := make(map[string]types.Resource)
+	for mirrorIdx := xds.mirrorListeners; mirrorIdx < mirrorListeners; mirrorIdx++ {
+		listeners[getMirrorListenerName(mirrorIdx)] = xds.makeMirrorListener(mirrorIdx)
+		addMirrorClusters(clusters, mirrorIdx)
+	}
+	if err := xds.cds.UpdateResources(clusters, nil); err != nil {
+		return err
+	}
+	if err := xds.lds.UpdateResources(listeners, nil); err != nil {
+		return err
+	}
+	xds.mirrorListeners = mirrorListeners
+	return nil
+}
+
+// removeMirrorListeners removes the listeners and clusters of mirrors no longer used by any experiment once
+// the routes no longer send traffic to them, caller must hold the lock
+func (xds *SeldonXDSCache) removeMirrorListeners() error {
+	mirrorListeners := countMirrorListeners(xds.Routes, xds.Pipelines)
+	if mirrorListeners >= xds.mirrorListeners {
+		return nil
+	}
+	var listeners []string
+	var clusters []string
+	for mirrorIdx := mirrorListeners; mirrorIdx < xds.mirrorListeners; mirrorIdx++ {
+		listeners = append(listeners, getMirrorListenerName(mirrorIdx))
+		clusters = append(clusters, getMirrorClusterName(mirrorIdx, false), getMirrorClusterName(mirrorIdx, true))
+	}
+	if err := xds.lds.UpdateResources(nil, listeners); err != nil {
+		return err
+	}
+	if err := xds.cds.UpdateResources(nil, clusters); err != nil {
+		return err
+	}
+	xds.mirrorListeners = mirrorListeners
+	return nil
 }
 
 // updates are batched - always check if the state has changed
@@ -369,6 +464,7 @@ func (xds *SeldonXDSCache) shouldRemoveCluster(name string) bool {
 func (xds *SeldonXDSCache) AddPipelineClusters(
 	event *coordinator.PipelineStreamsEventMsg,
 	p pipeline.PipelineHandler,
+	routing *Routing,
 	logger *logrus.Entry,
 ) error {
 	pip, err := p.GetPipeline(event.PipelineName)
@@ -390,6 +486,7 @@ func (xds *SeldonXDSCache) AddPipelineClusters(
 		event.StreamIps,
 		uint32(xds.pipelineGatewayDetails.HttpPort),
 		false,
+		routing,
 		logger,
 	)
 	// add grpc cluster
@@ -399,13 +496,14 @@ func (xds *SeldonXDSCache) AddPipelineClusters(
 		event.StreamIps,
 		uint32(xds.pipelineGatewayDetails.GrpcPort),
 		true,
+		routing,
 		logger,
 	)
 
 	return nil
 }
 
-func (xds *SeldonXDSCache) AddPipelineRoute(routeName string, trafficSplits []PipelineTrafficSplit, mirror *PipelineTrafficSplit) {
+func (xds *SeldonXDSCache) AddPipelineRoute(routeName string, trafficSplits []PipelineTrafficSplit, mirrors []PipelineTrafficSplit, auth []*AuthRule, rateLimit *RateLimit, routing *Routing) {
 	xds.mu.Lock()
 	defer xds.mu.Unlock()
 
@@ -414,14 +512,20 @@ func (xds *SeldonXDSCache) AddPipelineRoute(routeName string, trafficSplits []Pi
 	if !ok {
 		xds.Pipelines.Store(routeName, PipelineRoute{
 			RouteName: routeName,
-			Mirror:    mirror,
+			Mirrors:   mirrors,
 			Clusters:  trafficSplits,
+			Auth:      auth,
+			RateLimit: rateLimit,
+			Routing:   routing,
 		})
 		return
 	}
 
-	pipelineRoute.Mirror = mirror
+	pipelineRoute.Mirrors = mirrors
 	pipelineRoute.Clusters = trafficSplits
+	pipelineRoute.Auth = auth
+	pipelineRoute.RateLimit = rateLimit
+	pipelineRoute.Routing = routing
 	xds.Pipelines.Store(routeName, *pipelineRoute)
 }
 
@@ -433,7 +537,11 @@ func (xds *SeldonXDSCache) AddRouteClusterTraffic(
 	routeName, modelName, httpClusterName, grpcClusterName string,
 	modelVersion uint32,
 	trafficPercent uint32,
+	matches []MatchRule,
 	logPayloads bool,
+	auth *AuthRule,
+	rateLimit *RateLimit,
+	routing *Routing,
 	isMirror bool,
 ) {
 	xds.mu.Lock()
@@ -452,16 +560,31 @@ func (xds *SeldonXDSCache) AddRouteClusterTraffic(
 		route.LogPayloads = true
 	}
 
+	// Requests must match the auth rules of every model and version on the route, as they can be sent to any
+	// of them. Keep the rate limit and routing of earlier versions unless replaced, so during a rolling update
+	// requests are limited if any version wants it. The traffic mirrored to a model is authorised and limited
+	// on the route, and retried with the defaults.
+	if auth != nil && !isMirror {
+		route.Auth = addAuthRule(route.Auth, auth)
+	}
+	if rateLimit != nil && !isMirror {
+		route.RateLimit = rateLimit
+	}
+	if routing != nil && !isMirror {
+		route.Routing = routing
+	}
+
 	clusterTraffic := TrafficSplit{
 		ModelName:     modelName,
 		ModelVersion:  modelVersion,
 		TrafficWeight: trafficPercent,
 		HttpCluster:   httpClusterName,
 		GrpcCluster:   grpcClusterName,
+		Matches:       matches,
 	}
 
 	if isMirror {
-		route.Mirror = &clusterTraffic
+		route.Mirrors = addMirrorTraffic(route.Mirrors, clusterTraffic)
 	} else {
 		route.Clusters = append(route.Clusters, clusterTraffic)
 	}
@@ -469,11 +592,66 @@ func (xds *SeldonXDSCache) AddRouteClusterTraffic(
 	xds.Routes.Store(routeName, *route)
 }
 
+// addMirrorTraffic adds the traffic for a mirror model, replacing any earlier version of the model
+// as only one version of each mirror receives mirrored requests
+func addMirrorTraffic(mirrors []TrafficSplit, mirrorTraffic TrafficSplit) []TrafficSplit {
+	for idx, mirror := range mirrors {
+		if mirror.ModelName == mirrorTraffic.ModelName {
+			mirrors[idx] = mirrorTraffic
+			return mirrors
+		}
+	}
+	return append(mirrors, mirrorTraffic)
+}
+
+// SetRouteCaptureExperiment has the agents capture the responses to requests on the route, and to its mirrors,
+// for the experiment so they can be compared offline
+func (xds *SeldonXDSCache) SetRouteCaptureExperiment(routeName string, experimentName string) {
+	xds.mu.Lock()
+	defer xds.mu.Unlock()
+
+	route, ok := xds.Routes.Load(routeName)
+	if !ok {
+		return
+	}
+	route.CaptureExperiment = experimentName
+	xds.Routes.Store(routeName, *route)
+}
+
+// SetRouteBanditExperiment has the agents record which candidate of the bandit experiment served each request
+// on the route, so feedback for the request is rewarded to that candidate
+func (xds *SeldonXDSCache) SetRouteBanditExperiment(routeName string, experimentName string) {
+	xds.mu.Lock()
+	defer xds.mu.Unlock()
+
+	route, ok := xds.Routes.Load(routeName)
+	if !ok {
+		return
+	}
+	route.BanditExperiment = experimentName
+	xds.Routes.Store(routeName, *route)
+}
+
+// SetPipelineRouteBanditExperiment has the pipeline gateway record which candidate of the bandit experiment
+// served each request on the pipeline route
+func (xds *SeldonXDSCache) SetPipelineRouteBanditExperiment(routeName string, experimentName string) {
+	xds.mu.Lock()
+	defer xds.mu.Unlock()
+
+	pipelineRoute, ok := xds.Pipelines.Load(routeName)
+	if !ok {
+		return
+	}
+	pipelineRoute.BanditExperiment = experimentName
+	xds.Pipelines.Store(routeName, *pipelineRoute)
+}
+
 func (xds *SeldonXDSCache) AddClustersForRoute(
 	routeName, modelName, httpClusterName, grpcClusterName string,
 	modelVersion uint32,
 	assignment []int,
 	server *store.ServerSnapshot,
+	routing *Routing,
 ) {
 	xds.mu.Lock()
 	defer xds.mu.Unlock()
@@ -523,11 +701,13 @@ func (xds *SeldonXDSCache) AddClustersForRoute(
 	}
 
 	httpCluster.Routes[routeVersionKey] = true
+	httpCluster.Routing = routing
 	xds.Clusters.Store(httpClusterName, *httpCluster)
 
 	xds.clustersToAdd[httpClusterName] = struct{}{}
 
 	grpcCluster.Routes[routeVersionKey] = true
+	grpcCluster.Routing = routing
 	xds.Clusters.Store(grpcClusterName, *grpcCluster)
 
 	xds.clustersToAdd[grpcClusterName] = struct{}{}
@@ -552,8 +732,8 @@ func (xds *SeldonXDSCache) RemoveRoute(routeName string) error {
 			return err
 		}
 	}
-	if route.Mirror != nil {
-		err := xds.removeRouteFromCluster(*route, *route.Mirror)
+	for _, mirror := range route.Mirrors {
+		err := xds.removeRouteFromCluster(*route, mirror)
 		if err != nil {
 			return err
 		}
@@ -598,7 +778,7 @@ func (xds *SeldonXDSCache) createEndpointsForStreams(streamNames []string, strea
 	return endpoints
 }
 
-func (xds *SeldonXDSCache) CreateClusterForStreams(clusterPrefix string, streamNames []string, streamIps []string, port uint32, isGrpc bool, logger *logrus.Entry) *Cluster {
+func (xds *SeldonXDSCache) CreateClusterForStreams(clusterPrefix string, streamNames []string, streamIps []string, port uint32, isGrpc bool, routing *Routing, logger *logrus.Entry) *Cluster {
 	xds.mu.Lock()
 	defer xds.mu.Unlock()
 
@@ -610,6 +790,7 @@ func (xds *SeldonXDSCache) CreateClusterForStreams(clusterPrefix string, streamN
 		Endpoints: xds.createEndpointsForStreams(streamNames, streamIps, port, logger),
 		Routes:    make(map[RouteVersionKey]bool),
 		Grpc:      isGrpc,
+		Routing:   routing,
 	}
 
 	xds.Clusters.Store(clusterName, cluster)
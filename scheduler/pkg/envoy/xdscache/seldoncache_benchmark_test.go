@@ -25,7 +25,7 @@ func benchmarkRouteContents(b *testing.B, numResources uint) {
 	x, _ := NewSeldonXDSCache(logrus.New(), nil, nil)
 
 	for n := 0; n < int(numResources); n++ {
-		x.AddPipelineRoute(strconv.Itoa(n), []PipelineTrafficSplit{{PipelineName: strconv.Itoa(n), TrafficWeight: 100}}, nil)
+		x.AddPipelineRoute(strconv.Itoa(n), []PipelineTrafficSplit{{PipelineName: strconv.Itoa(n), TrafficWeight: 100}}, nil, nil, nil, nil)
 
 		x.AddRouteClusterTraffic(
 			fmt.Sprintf("model-%d", n),
@@ -34,7 +34,11 @@ func benchmarkRouteContents(b *testing.B, numResources uint) {
 			"grpc",
 			1,
 			100,
+			nil,
 			false,
+			nil,
+			nil,
+			nil,
 			false,
 		)
 	}
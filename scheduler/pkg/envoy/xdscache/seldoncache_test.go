@@ -350,6 +350,43 @@ func TestAddRemoveHttpAndGrpcRouteVersionsForDifferentRoutesSameModel(t *testing
 	g.Expect(ok).To(BeTrue()) // grpc Cluster to be removed from cds
 }
 
+// Checks the listeners of further mirrors are only sent to envoy while an experiment uses them
+func TestMirrorListenersOnlyForMirrorsInUse(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger := log.New()
+
+	c, err := NewSeldonXDSCache(logger, &PipelineGatewayDetails{}, nil)
+	g.Expect(err).To(BeNil())
+	extraMirrorListenerName := getMirrorListenerName(1)
+	extraMirrorClusterName := getMirrorClusterName(1, false)
+
+	addVersionedRoute(c, "r1", "m1", "m1_1_http", "m1_1_grpc", 100, 1)
+	g.Expect(c.AddClusters()).To(BeNil())
+	g.Expect(c.lds.GetResources()).To(HaveKey(mirrorListenerName))
+	g.Expect(c.lds.GetResources()).ToNot(HaveKey(extraMirrorListenerName))
+	g.Expect(c.cds.GetResources()).ToNot(HaveKey(extraMirrorClusterName))
+	g.Expect(c.RouteResources()).To(HaveLen(2))
+
+	for _, model := range []string{"m2", "m3"} {
+		httpCluster, grpcCluster := model+"_1_http", model+"_1_grpc"
+		addVersionedRoute(c, model, model, httpCluster, grpcCluster, 100, 1)
+		c.AddRouteClusterTraffic("r1", model, httpCluster, grpcCluster, 1, 100, nil, false, nil, nil, nil, true)
+	}
+	g.Expect(c.AddClusters()).To(BeNil())
+	g.Expect(c.lds.GetResources()).To(HaveKey(extraMirrorListenerName))
+	g.Expect(c.lds.GetResources()).ToNot(HaveKey(getMirrorListenerName(2)))
+	g.Expect(c.cds.GetResources()).To(HaveKey(extraMirrorClusterName))
+	g.Expect(c.RouteResources()).To(HaveLen(3))
+
+	g.Expect(c.RemoveRoute("r1")).To(BeNil())
+	g.Expect(c.RemoveClusters()).To(BeNil())
+	g.Expect(c.lds.GetResources()).To(HaveKey(mirrorListenerName))
+	g.Expect(c.lds.GetResources()).ToNot(HaveKey(extraMirrorListenerName))
+	g.Expect(c.cds.GetResources()).To(HaveKey(MirrorHttpClusterName))
+	g.Expect(c.cds.GetResources()).ToNot(HaveKey(extraMirrorClusterName))
+	g.Expect(c.RouteResources()).To(HaveLen(2))
+}
+
 func TestSetupTLS(t *testing.T) {
 	g := NewGomegaWithT(t)
 	logger := log.New()
@@ -590,6 +627,6 @@ func addVersionedRoute(c *SeldonXDSCache, modelRouteName string, modelName strin
 			1: store.NewServerReplica("0.0.0.0", 9000, 9001, 1, store.NewServer("server", false), nil, 100, 100, 0, nil, 100),
 		},
 	}
-	c.AddClustersForRoute(modelRouteName, modelName, httpCluster, grpcCluster, modelVersion.GetVersion(), []int{1}, server)
-	c.AddRouteClusterTraffic(modelRouteName, modelName, httpCluster, grpcCluster, modelVersion.GetVersion(), traffic, false, false)
+	c.AddClustersForRoute(modelRouteName, modelName, httpCluster, grpcCluster, modelVersion.GetVersion(), []int{1}, server, nil)
+	c.AddRouteClusterTraffic(modelRouteName, modelName, httpCluster, grpcCluster, modelVersion.GetVersion(), traffic, nil, false, nil, nil, nil, false)
 }
@@ -675,6 +675,11 @@ func (c *ChainerServer) rebalancePipeline(pipelineName string, pipelineVersion u
 		return err
 	}
 
+	// ensembles and synchronous pipelines are not run by the dataflow engines
+	if pv.InferredByGateway() {
+		return nil
+	}
+
 	c.logger.Debugf("Rebalancing pipeline %s:%d with state %s", pipelineName, pipelineVersion, pv.State.Status.String())
 	if len(c.streams) == 0 {
 		pipelineState := pipeline.PipelineCreate
@@ -815,6 +820,11 @@ func (c *ChainerServer) handlePipelineEvent(event coordinator.PipelineEventMsg)
 		}
 		logger.Debugf("Received event %s with state %s", event.String(), pv.State.Status.String())
 
+		if pv.InferredByGateway() {
+			c.handleGatewayPipelineEvent(pv)
+			return
+		}
+
 		// Handle case where we have no subscribers
 		if len(c.streams) == 0 {
 			if pv.State.Status == pipeline.PipelineTerminated {
@@ -856,6 +866,24 @@ func (c *ChainerServer) handlePipelineEvent(event coordinator.PipelineEventMsg)
 	}()
 }
 
+// handleGatewayPipelineEvent sets the state of an ensemble or synchronous pipeline without the dataflow
+// engines, as the pipeline gateway infers its steps without Kafka
+func (c *ChainerServer) handleGatewayPipelineEvent(pv *pipeline.PipelineVersion) {
+	var status pipeline.PipelineStatus
+	switch pv.State.Status {
+	case pipeline.PipelineCreate:
+		status = pipeline.PipelineReady
+	case pipeline.PipelineTerminate:
+		status = pipeline.PipelineTerminated
+	default:
+		return
+	}
+	err := c.pipelineHandler.SetPipelineState(pv.Name, pv.Version, pv.UID, status, "inferred by the pipeline gateway", util.SourceChainerServer)
+	if err != nil {
+		c.logger.WithError(err).Errorf("Failed to set pipeline state to %s for %s", status.String(), pv.String())
+	}
+}
+
 func (c *ChainerServer) terminatePipeline(pv *pipeline.PipelineVersion, keepTopics bool) {
 	err := c.pipelineHandler.SetPipelineState(pv.Name, pv.Version, pv.UID, pipeline.PipelineTerminating, "", util.SourceChainerServer)
 	if err != nil {
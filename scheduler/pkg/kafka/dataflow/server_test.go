@@ -1333,6 +1333,88 @@ func TestPipelineEvents(t *testing.T) {
 	}
 }
 
+func TestGatewayPipelineEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name    string
+		loadReq *scheduler.Pipeline
+	}
+
+	tests := []test{
+		{
+			name: "ensemble",
+			loadReq: &scheduler.Pipeline{
+				Name:    "foo",
+				Version: 1,
+				Uid:     "x",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+					{
+						Name: "b",
+					},
+				},
+				Ensemble: &scheduler.EnsembleSpec{Aggregation: scheduler.EnsembleSpec_VOTE},
+			},
+		},
+		{
+			name: "synchronous pipeline",
+			loadReq: &scheduler.Pipeline{
+				Name:    "foo",
+				Version: 1,
+				Uid:     "x",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "a",
+					},
+					{
+						Name:   "b",
+						Inputs: []string{"a"},
+					},
+				},
+				Output: &scheduler.PipelineOutput{
+					Steps: []string{"b"},
+				},
+				DataflowSpec: &scheduler.DataflowSpec{Mode: scheduler.DataflowSpec_SYNCHRONOUS},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serverName := "dummy"
+			s, _ := createTestScheduler(t, serverName)
+			stream := newStubServerStatusServer(10, context.Background())
+			s.mu.Lock()
+			s.streams[serverName] = &ChainerSubscription{
+				name:   serverName,
+				stream: stream,
+				fin:    make(chan bool),
+			}
+			s.mu.Unlock()
+
+			err := s.pipelineHandler.AddPipeline(test.loadReq)
+			g.Expect(err).To(BeNil())
+
+			getStatus := func() pipeline.PipelineStatus {
+				p, err := s.pipelineHandler.GetPipeline(test.loadReq.Name)
+				g.Expect(err).To(BeNil())
+				return p.GetLatestPipelineVersion().State.Status
+			}
+			g.Eventually(getStatus).Should(Equal(pipeline.PipelineReady))
+
+			err = s.pipelineHandler.RemovePipeline(test.loadReq.Name)
+			g.Expect(err).To(BeNil())
+			g.Eventually(getStatus).Should(Equal(pipeline.PipelineTerminated))
+
+			// the dataflow engine is not sent pipelines inferred by the pipeline gateway
+			g.Consistently(stream.msgs).ShouldNot(Receive())
+		})
+	}
+}
+
 func TestPipelineRebalance(t *testing.T) {
 	g := NewGomegaWithT(t)
 
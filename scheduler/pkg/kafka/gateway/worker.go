@@ -18,6 +18,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -26,16 +27,12 @@ import (
 	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
 	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
 	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde/protobuf"
-	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/signalfx/splunk-otel-go/instrumentation/github.com/confluentinc/confluent-kafka-go/v2/kafka/splunkkafka"
 	log "github.com/sirupsen/logrus"
-	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
@@ -91,9 +88,10 @@ func NewInferWorker(
 		schemaRegistryClient: schemaRegistryClient,
 	}
 	// Create gRPC clients
-	grpcClient, err := iw.getGrpcClient(
+	grpcClient, err := util.GetInferenceGrpcClient(
 		consumer.consumerConfig.InferenceServerConfig.Host,
 		consumer.consumerConfig.InferenceServerConfig.GrpcPort,
+		consumer.tlsClientOptions,
 	)
 	if err != nil {
 		return nil, err
@@ -115,45 +113,6 @@ func getRestUrl(tls bool, host string, port int, modelName string) *url.URL {
 	}
 }
 
-func (iw *InferWorker) getGrpcClient(host string, port int) (v2.GRPCInferenceServiceClient, error) {
-	logger := iw.logger.WithField("func", "getGrpcClient")
-	retryOpts := []grpc_retry.CallOption{
-		grpc_retry.WithBackoff(grpc_retry.BackoffExponential(util.GRPCRetryBackoff)),
-		grpc_retry.WithMax(util.GRPCRetryMaxCount), // retry envoy connection
-	}
-
-	var creds credentials.TransportCredentials
-	if iw.consumer.tlsClientOptions.TLS {
-		logger.Info("Creating TLS credentials")
-		creds = iw.consumer.tlsClientOptions.Cert.CreateClientTransportCredentials()
-	} else {
-		logger.Info("Creating insecure credentials")
-		creds = insecure.NewCredentials()
-	}
-
-	opts := []grpc.DialOption{
-		grpc.WithKeepaliveParams(util.GetClientKeepAliveParameters()),
-		grpc.WithTransportCredentials(creds),
-		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(util.GRPCMaxMsgSizeBytes),
-			grpc.MaxCallSendMsgSize(util.GRPCMaxMsgSizeBytes),
-		),
-		grpc.WithStatsHandler(
-			otelgrpc.NewClientHandler(),
-		),
-		grpc.WithUnaryInterceptor(
-			grpc_retry.UnaryClientInterceptor(retryOpts...),
-		),
-	}
-
-	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", host, port), opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	return v2.NewGRPCInferenceServiceClient(conn), nil
-}
-
 func getProtoInferRequest(job *InferWork) (*v2.ModelInferRequest, error) {
 	ireq := v2.ModelInferRequest{}
 	err := proto.Unmarshal(job.msg.Value, &ireq)
@@ -243,7 +202,8 @@ func (iw *InferWorker) produce(
 ) error {
 	logger := iw.logger.WithField("func", "produce")
 
-	kafkaHeaders := job.msg.Headers
+	// the headers are cloned as a stream produces several responses for the same request
+	kafkaHeaders := slices.Clone(job.msg.Headers)
 	if errorTopic {
 		kafkaHeaders = append(kafkaHeaders, kafka.Header{Key: kafka2.TopicErrorHeader, Value: []byte(job.modelName)})
 	}
@@ -395,6 +355,10 @@ func (iw *InferWorker) grpcRequest(ctx context.Context, job *InferWork, req *v2.
 	req.ModelName = job.modelName
 	req.ModelVersion = fmt.Sprintf("%d", util.GetPinnedModelVersion())
 
+	if job.headers[kafka2.TopicStreamHeader] == job.modelName {
+		return iw.grpcStreamRequest(ctx, job, req)
+	}
+
 	ctx = addMetadataToOutgoingContext(ctx, job, logger)
 
 	var header, trailer metadata.MD
@@ -428,6 +392,93 @@ func (iw *InferWorker) grpcRequest(ctx context.Context, job *InferWork, req *v2.
 	return nil
 }
 
+// grpcStreamRequest sends the request with ModelStreamInfer and produces the responses of the stream as they
+// arrive, numbered with the sequence header. Each response is held back until the next one arrives, so the
+// last one can be produced with the end header when the stream ends.
+func (iw *InferWorker) grpcStreamRequest(ctx context.Context, job *InferWork, req *v2.ModelInferRequest) error {
+	logger := iw.logger.WithField("func", "grpcStreamRequest")
+	logger.Debugf("gRPC stream request for %s", job.modelName)
+
+	ctx = addMetadataToOutgoingContext(ctx, job, logger)
+
+	stream, err := iw.grpcClient.ModelStreamInfer(ctx, iw.callOptions...)
+	if err != nil {
+		logger.WithError(err).Warnf("Failed stream infer request")
+		return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+	}
+	err = stream.Send(req)
+	if err == nil {
+		err = stream.CloseSend()
+	}
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to send stream infer request")
+		return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+	}
+
+	outputTopic := iw.topicNamer.GetModelTopicOutputs(job.modelName)
+	var previous []byte
+	sequence := 0
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.WithError(err).Warnf("Failed stream infer request")
+			return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+		}
+		b, err := proto.Marshal(resp)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to proto.Marshal")
+			return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+		}
+		if previous != nil {
+			err = iw.produce(ctx, job, outputTopic, previous, false, streamHeaders(nil, sequence, false))
+			if err != nil {
+				logger.WithError(err).Errorf("Failed infer request iw.produce")
+				return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+			}
+			sequence++
+		}
+		previous = b
+	}
+	if previous == nil {
+		err = fmt.Errorf("stream of model %s ended without a response", job.modelName)
+		return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+	}
+
+	header, err := stream.Header()
+	if err != nil {
+		logger.WithError(err).Warnf("Failed to get stream headers")
+	}
+	err = iw.produce(
+		ctx,
+		job,
+		outputTopic,
+		previous,
+		false,
+		streamHeaders(extractHeadersGrpc(header, stream.Trailer()), sequence, true),
+	)
+	if err != nil {
+		logger.WithError(err).Errorf("Failed infer request iw.produce")
+		return iw.produce(ctx, job, iw.topicNamer.GetModelErrorTopic(), []byte(err.Error()), true, nil)
+	}
+	return nil
+}
+
+// streamHeaders adds the sequence header, and the end header for the last response, to the headers of a
+// response of a stream
+func streamHeaders(headers map[string][]string, sequence int, end bool) map[string][]string {
+	if headers == nil {
+		headers = make(map[string][]string)
+	}
+	headers[kafka2.TopicStreamSequenceHeader] = []string{strconv.Itoa(sequence)}
+	if end {
+		headers[kafka2.TopicStreamEndHeader] = []string{"true"}
+	}
+	return headers
+}
+
 func (iw *InferWorker) serializeModelInferRespWithSchemaRegistry(topic string, payload []byte) ([]byte, error) {
 	logger := iw.logger.WithField("func", "serializeModelInferRespWithSchemaRegistry")
 
@@ -184,6 +184,28 @@ func (m *mockGRPCMLServer) ModelInfer(ctx context.Context, r *v2.ModelInferReque
 	return &v2.ModelInferResponse{ModelName: r.ModelName, ModelVersion: r.ModelVersion}, nil
 }
 
+// ModelStreamInfer returns a response for each token of the request
+func (m *mockGRPCMLServer) ModelStreamInfer(stream v2.GRPCInferenceService_ModelStreamInferServer) error {
+	r, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	m.recv = m.recv + 1
+	for _, token := range []string{"Hel", "lo", "!"} {
+		err := stream.Send(&v2.ModelInferResponse{
+			ModelName:    r.ModelName,
+			ModelVersion: r.ModelVersion,
+			Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+				{Name: "content", Datatype: "BYTES", Shape: []int64{1}, Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte(token)}}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func createMLMockGrpcServer(g *GomegaWithT) *mockGRPCMLServer {
 	mockMLServer := &mockGRPCMLServer{}
 	err := mockMLServer.setup()
@@ -287,6 +309,76 @@ func TestProcessRequestGrpc(t *testing.T) {
 	}
 }
 
+func TestProcessRequestGrpcStream(t *testing.T) {
+	type test struct {
+		name             string
+		streamModel      string
+		expectedProduced int
+	}
+	tests := []test{
+		{
+			name:             "streamed model",
+			streamModel:      "foo",
+			expectedProduced: 3,
+		},
+		{
+			// the steps of a pipeline before its streamed output step respond once
+			name:             "step before the streamed step of a pipeline",
+			streamModel:      "llm",
+			expectedProduced: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			logger := log.New()
+
+			kafkaServerConfig := InferenceServerConfig{
+				Host: "0.0.0.0",
+			}
+			kafkaModelConfig := KafkaModelConfig{
+				ModelName:   "foo",
+				InputTopic:  "input",
+				OutputTopic: "output",
+			}
+			mockMLGrpcServer := createMLMockGrpcServer(g)
+			defer mockMLGrpcServer.stop()
+			ic, iw := createInferWorkerWithMockConn(mockMLGrpcServer, logger, &kafkaServerConfig, &kafkaModelConfig, g)
+			defer ic.Stop(false)
+			check := creatMockServerHealthFunc(mockMLGrpcServer)
+			g.Eventually(check).Should(BeTrue())
+
+			b, err := proto.Marshal(&v2.ModelInferRequest{})
+			g.Expect(err).To(BeNil())
+			job := &InferWork{
+				modelName: "foo",
+				headers:   map[string]string{kafka2.TopicStreamHeader: test.streamModel},
+				msg:       &kafka.Message{Value: b},
+			}
+			err = iw.processRequest(context.Background(), job, DefaultWorkerTimeoutMs*time.Millisecond)
+			g.Expect(err).To(BeNil())
+			g.Expect(mockMLGrpcServer.recv).To(Equal(1))
+			g.Eventually(ic.producer.Len).Should(Equal(test.expectedProduced))
+			g.Consistently(ic.producer.Len, 100*time.Millisecond).Should(Equal(test.expectedProduced))
+		})
+	}
+}
+
+func TestStreamHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	headers := streamHeaders(nil, 0, false)
+	g.Expect(headers).To(Equal(map[string][]string{kafka2.TopicStreamSequenceHeader: {"0"}}))
+
+	headers = streamHeaders(map[string][]string{"x-foo": {"bar"}}, 2, true)
+	g.Expect(headers).To(Equal(map[string][]string{
+		"x-foo":                          {"bar"},
+		kafka2.TopicStreamSequenceHeader: {"2"},
+		kafka2.TopicStreamEndHeader:      {"true"},
+	}))
+}
+
 func TestProcessRequest(t *testing.T) {
 	g := NewGomegaWithT(t)
 
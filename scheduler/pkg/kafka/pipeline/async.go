@@ -0,0 +1,424 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/gorilla/mux"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	RequestIdVariable    = "request"
+	preferHeader         = "Prefer"
+	preferRespondAsync   = "respond-async"
+	callbackUrlHeader    = "Seldon-Callback-Url"
+	asyncStatusPending   = "pending"
+	asyncStatusCompleted = "completed"
+	asyncStatusFailed    = "failed"
+	callbackTimeout      = 30 * time.Second
+)
+
+var (
+	errAsyncRequestExists   = errors.New("request already exists")
+	errTooManyAsyncRequests = errors.New("too many pending asynchronous requests")
+)
+
+// AsyncResults retains the results of asynchronous pipeline requests, from their submission until the
+// retention time has passed after they completed. Results are shared with the other replicas of the gateway
+// when a publisher is set, so they can be fetched from any replica.
+type AsyncResults struct {
+	mu      sync.Mutex
+	results map[string]*asyncEntry
+	// entries ordered by when they expire
+	expiries asyncExpiryQueue
+	ttl      time.Duration
+	// how long a request can be pending before it fails
+	timeout time.Duration
+	// pending requests submitted to this replica, and how many are allowed
+	pending    int
+	maxPending int
+	// hosts, with an optional port, that results can be posted to, no callbacks are allowed when empty
+	callbackHosts  map[string]struct{}
+	callbackClient *http.Client
+	publish        func(pipelineName string, result *asyncResult, expires time.Time)
+}
+
+// asyncResult is returned for an asynchronous request, with the pipeline output once it has completed
+type asyncResult struct {
+	Id       string          `json:"id"`
+	Status   string          `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+type asyncEntry struct {
+	key     string
+	result  *asyncResult
+	expires time.Time
+	// whether the request was submitted to this replica and counts towards its pending requests
+	local bool
+	index int
+}
+
+// asyncExpiryQueue is a heap of entries with the entry that expires first at the top
+type asyncExpiryQueue []*asyncEntry
+
+func (q asyncExpiryQueue) Len() int { return len(q) }
+
+func (q asyncExpiryQueue) Less(i, j int) bool { return q[i].expires.Before(q[j].expires) }
+
+func (q asyncExpiryQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *asyncExpiryQueue) Push(x any) {
+	entry := x.(*asyncEntry)
+	entry.index = len(*q)
+	*q = append(*q, entry)
+}
+
+func (q *asyncExpiryQueue) Pop() any {
+	old := *q
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return entry
+}
+
+func NewAsyncResults(ttl time.Duration, timeout time.Duration, maxPending int, callbackHosts []string) *AsyncResults {
+	hosts := make(map[string]struct{}, len(callbackHosts))
+	for _, host := range callbackHosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			hosts[host] = struct{}{}
+		}
+	}
+	return &AsyncResults{
+		results:       make(map[string]*asyncEntry),
+		ttl:           ttl,
+		timeout:       timeout,
+		maxPending:    maxPending,
+		callbackHosts: hosts,
+		callbackClient: &http.Client{
+			Timeout: callbackTimeout,
+			// redirects could send the result to a host that is not allowed
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// isCallbackAllowed returns whether results can be posted to the URL, whose host must be allowed with or
+// without its port
+func (a *AsyncResults) isCallbackAllowed(u *url.URL) bool {
+	if _, ok := a.callbackHosts[strings.ToLower(u.Host)]; ok {
+		return true
+	}
+	_, ok := a.callbackHosts[strings.ToLower(u.Hostname())]
+	return ok
+}
+
+// setPublisher sets the function sharing the results of this replica with the others
+func (a *AsyncResults) setPublisher(publish func(pipelineName string, result *asyncResult, expires time.Time)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.publish = publish
+}
+
+func getAsyncResultKey(pipelineName string, requestId string) string {
+	return pipelineName + "/" + requestId
+}
+
+// add registers a pending request submitted to this replica, unless its request id is already in use for the
+// pipeline or too many requests are pending
+func (a *AsyncResults) add(pipelineName string, requestId string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	a.removeExpired(now)
+	key := getAsyncResultKey(pipelineName, requestId)
+	if _, ok := a.results[key]; ok {
+		return errAsyncRequestExists
+	}
+	if a.pending >= a.maxPending {
+		return errTooManyAsyncRequests
+	}
+	result := &asyncResult{Id: requestId, Status: asyncStatusPending}
+	// the request fails once the timeout has passed, and its result is then retained
+	expires := now.Add(a.timeout + a.ttl)
+	a.set(key, result, expires, true)
+	a.pending++
+	if a.publish != nil {
+		a.publish(pipelineName, result, expires)
+	}
+	return nil
+}
+
+func (a *AsyncResults) complete(pipelineName string, result *asyncResult) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	a.removeExpired(now)
+	expires := now.Add(a.ttl)
+	a.set(getAsyncResultKey(pipelineName, result.Id), result, expires, false)
+	if a.publish != nil {
+		a.publish(pipelineName, result, expires)
+	}
+}
+
+// apply stores a result published by another replica, which does not replace a completed result
+func (a *AsyncResults) apply(pipelineName string, result *asyncResult, expires time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	a.removeExpired(now)
+	if !expires.After(now) {
+		return
+	}
+	key := getAsyncResultKey(pipelineName, result.Id)
+	if entry, ok := a.results[key]; ok && (entry.local || entry.result.Status != asyncStatusPending) {
+		return
+	}
+	a.set(key, result, expires, false)
+}
+
+func (a *AsyncResults) get(pipelineName string, requestId string) (*asyncResult, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.removeExpired(time.Now())
+	entry, ok := a.results[getAsyncResultKey(pipelineName, requestId)]
+	if !ok {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// set stores the result of a request, which is no longer pending on this replica unless local is set. The lock must be held.
+func (a *AsyncResults) set(key string, result *asyncResult, expires time.Time, local bool) {
+	entry, ok := a.results[key]
+	if !ok {
+		entry = &asyncEntry{key: key, result: result, expires: expires, local: local}
+		a.results[key] = entry
+		heap.Push(&a.expiries, entry)
+		return
+	}
+	if entry.local && !local {
+		a.pending--
+	}
+	entry.result = result
+	entry.expires = expires
+	entry.local = local
+	heap.Fix(&a.expiries, entry.index)
+}
+
+// removeExpired removes the entries that have expired, which are at the top of the queue. The lock must be held.
+func (a *AsyncResults) removeExpired(now time.Time) {
+	for len(a.expiries) > 0 && now.After(a.expiries[0].expires) {
+		entry := heap.Pop(&a.expiries).(*asyncEntry)
+		delete(a.results, entry.key)
+		if entry.local {
+			a.pending--
+		}
+	}
+}
+
+// isAsyncRequest returns whether the client prefers the request to be processed asynchronously
+func isAsyncRequest(req *http.Request) bool {
+	for _, value := range req.Header.Values(preferHeader) {
+		for _, preference := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(preference), preferRespondAsync) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inferAsync sends the request to the pipeline in the background and returns its id straight away. The result
+// can then be fetched with the id and, if the client gave a callback URL, is posted to it once completed.
+func (g *GatewayHttpServer) inferAsync(w http.ResponseWriter, req *http.Request, pipelineName string, dataProto []byte, startTime time.Time) {
+	logger := g.logger.WithField("func", "inferAsync")
+
+	callbackUrl := req.Header.Get(callbackUrlHeader)
+	if callbackUrl != "" {
+		u, err := url.Parse(callbackUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			http.Error(w, fmt.Sprintf("invalid %s header %s", callbackUrlHeader, callbackUrl), http.StatusBadRequest)
+			return
+		}
+		if !g.asyncResults.isCallbackAllowed(u) {
+			http.Error(w, fmt.Sprintf("callbacks to host %s are not allowed", u.Host), http.StatusBadRequest)
+			return
+		}
+	}
+	requestId := g.getRequestId(req)
+	err := g.asyncResults.add(pipelineName, requestId)
+	switch {
+	case errors.Is(err, errAsyncRequestExists):
+		http.Error(w, fmt.Sprintf("request %s already exists", requestId), http.StatusConflict)
+		return
+	case errors.Is(err, errTooManyAsyncRequests):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	// the request outlives the client connection but keeps its trace
+	ctx := context.WithoutCancel(req.Context())
+	headers := convertHttpHeadersToKafkaHeaders(req.Header)
+	go func() {
+		inferCtx, cancel := context.WithTimeout(ctx, g.asyncResults.timeout)
+		defer cancel()
+		result := g.getAsyncResult(inferCtx, pipelineName, requestId, dataProto, headers, startTime)
+		g.asyncResults.complete(pipelineName, result)
+		if callbackUrl != "" {
+			err := g.postAsyncResult(ctx, callbackUrl, result)
+			if err != nil {
+				logger.WithError(err).Warnf("Failed to post result of request %s for pipeline %s", requestId, pipelineName)
+			}
+		}
+	}()
+
+	w.Header().Set("Location", v2PipelinePathPrefix+pipelineName+"/requests/"+requestId)
+	w.Header().Set(util.RequestIdHeader, requestId)
+	recordBanditRequest(g.banditRecorder, req.Header.Get(util.SeldonBanditHeader), requestId, pipelineName, false)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	err = json.NewEncoder(w).Encode(&asyncResult{Id: requestId, Status: asyncStatusPending})
+	if err != nil {
+		logger.WithError(err).Error("Failed to write response")
+	}
+}
+
+func (g *GatewayHttpServer) getAsyncResult(
+	ctx context.Context,
+	pipelineName string,
+	requestId string,
+	dataProto []byte,
+	headers []kafka.Header,
+	startTime time.Time,
+) *asyncResult {
+	logger := g.logger.WithField("func", "getAsyncResult")
+	result := &asyncResult{Id: requestId, Status: asyncStatusFailed}
+
+	kafkaRequest, err := g.inferWithTimeout(ctx, pipelineName, dataProto, headers, requestId)
+	elapsedTime := time.Since(startTime).Seconds()
+	if errors.Is(err, context.DeadlineExceeded) {
+		go g.metrics.AddPipelineInferMetrics(pipelineName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusGatewayTimeout))
+		result.Error = "request timed out"
+		return result
+	}
+	if err != nil {
+		logger.WithError(err).Error("Failed to call infer")
+		go g.metrics.AddPipelineInferMetrics(pipelineName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusInternalServerError))
+		result.Error = err.Error()
+		return result
+	}
+	if kafkaRequest.err != nil {
+		go g.metrics.AddPipelineInferMetrics(pipelineName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusBadRequest))
+		result.Error = string(createResponseErrorPayload(kafkaRequest.err, kafkaRequest.response))
+		return result
+	}
+	resJson, err := ConvertV2ResponseBytesToJson(kafkaRequest.response)
+	if err != nil {
+		logger.WithError(err).Errorf("Failed to convert v2 response to json for pipeline %s", pipelineName)
+		go g.metrics.AddPipelineInferMetrics(pipelineName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusInternalServerError))
+		result.Error = err.Error()
+		return result
+	}
+	go g.metrics.AddPipelineInferMetrics(pipelineName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusOK))
+	result.Status = asyncStatusCompleted
+	result.Response = resJson
+	return result
+}
+
+// inferWithTimeout stops waiting for the response of the pipeline once the context is done
+func (g *GatewayHttpServer) inferWithTimeout(ctx context.Context, pipelineName string, dataProto []byte, headers []kafka.Header, requestId string) (*Request, error) {
+	type inferResult struct {
+		request *Request
+		err     error
+	}
+	done := make(chan inferResult, 1)
+	go func() {
+		request, err := g.gateway.Infer(ctx, pipelineName, false, dataProto, headers, requestId)
+		done <- inferResult{request: request, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.request, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (g *GatewayHttpServer) postAsyncResult(ctx context.Context, callbackUrl string, result *asyncResult) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(util.RequestIdHeader, result.Id)
+	res, err := g.asyncResults.callbackClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("callback returned status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// asyncResult returns the result of an asynchronous request, with the accepted status code while it is pending
+func (g *GatewayHttpServer) asyncResult(w http.ResponseWriter, req *http.Request) {
+	logger := g.logger.WithField("func", "asyncResult")
+	vars := mux.Vars(req)
+	pipelineName := vars[ResourceNameVariable]
+	requestId := vars[RequestIdVariable]
+
+	result, ok := g.asyncResults.get(pipelineName, requestId)
+	if !ok {
+		http.Error(w, fmt.Sprintf("request %s not found for pipeline %s", requestId, pipelineName), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if result.Status == asyncStatusPending {
+		w.WriteHeader(http.StatusAccepted)
+	}
+	err := json.NewEncoder(w).Encode(result)
+	if err != nil {
+		logger.WithError(err).Error("Failed to write response")
+	}
+}
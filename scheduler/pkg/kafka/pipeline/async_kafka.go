@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+
+	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
+	config_tls "github.com/seldonio/seldon-core/components/tls/v2/pkg/config"
+
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	envDefaultReplicationFactor = "KAFKA_DEFAULT_REPLICATION_FACTOR"
+	envDefaultNumPartitions     = "KAFKA_DEFAULT_NUM_PARTITIONS"
+	defaultReplicationFactor    = 1
+	defaultNumPartitions        = 1
+	asyncTopicCreateTimeout     = 10 * time.Second
+)
+
+// asyncResultMessage is the value of the message sharing the result of an asynchronous request
+type asyncResultMessage struct {
+	Pipeline string       `json:"pipeline"`
+	Result   *asyncResult `json:"result"`
+	Expires  time.Time    `json:"expires"`
+}
+
+// AsyncResultsReplicator shares the results of asynchronous requests between the replicas of the pipeline gateway
+// through a compacted Kafka topic keyed by pipeline and request id. Every replica reads the whole topic with a
+// consumer group of its own, so the result of a request can be fetched from any replica.
+type AsyncResultsReplicator struct {
+	logger   logrus.FieldLogger
+	results  *AsyncResults
+	topic    string
+	producer *kafka.Producer
+	consumer *kafka.Consumer
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func NewAsyncResultsReplicator(
+	logger logrus.FieldLogger,
+	namespace string,
+	kafkaConfig *kafka_config.KafkaConfig,
+	replicaName string,
+	results *AsyncResults,
+) (*AsyncResultsReplicator, error) {
+	topicNamer, err := kafka2.NewTopicNamer(namespace, kafkaConfig.TopicPrefix)
+	if err != nil {
+		return nil, err
+	}
+	r := &AsyncResultsReplicator{
+		logger:  logger.WithField("source", "AsyncResultsReplicator"),
+		results: results,
+		topic:   topicNamer.GetPipelineGatewayAsyncResultsTopic(),
+		done:    make(chan struct{}),
+	}
+
+	producerConfigMap := kafka_config.CloneKafkaConfigMap(kafkaConfig.Producer)
+	producerConfigMap["go.delivery.reports"] = false
+	if err := config_tls.AddKafkaSSLOptions(producerConfigMap); err != nil {
+		return nil, err
+	}
+	r.producer, err = kafka.NewProducer(&producerConfigMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.createTopic(); err != nil {
+		r.producer.Close()
+		return nil, err
+	}
+
+	// offsets are not committed so the topic is read from the start, up to its retention, on every start
+	consumerConfigMap := kafka_config.CloneKafkaConfigMap(kafkaConfig.Consumer)
+	consumerConfigMap["group.id"] = r.topic + kafka2.TopicSeparator + replicaName
+	consumerConfigMap["auto.offset.reset"] = "earliest"
+	consumerConfigMap["enable.auto.commit"] = false
+	if err := config_tls.AddKafkaSSLOptions(consumerConfigMap); err != nil {
+		r.producer.Close()
+		return nil, err
+	}
+	r.consumer, err = kafka.NewConsumer(&consumerConfigMap)
+	if err != nil {
+		r.producer.Close()
+		return nil, err
+	}
+	if err := r.consumer.Subscribe(r.topic, nil); err != nil {
+		r.producer.Close()
+		_ = r.consumer.Close()
+		return nil, err
+	}
+
+	results.setPublisher(r.publish)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.consume()
+	}()
+	return r, nil
+}
+
+// createTopic creates the compacted topic, whose results are deleted once they can no longer be fetched
+func (r *AsyncResultsReplicator) createTopic() error {
+	replicationFactor, err := util.GetIntEnvar(envDefaultReplicationFactor, defaultReplicationFactor)
+	if err != nil {
+		return fmt.Errorf("invalid Kafka topic configuration: %w", err)
+	}
+	numPartitions, err := util.GetIntEnvar(envDefaultNumPartitions, defaultNumPartitions)
+	if err != nil {
+		return fmt.Errorf("invalid Kafka topic configuration: %w", err)
+	}
+	adminClient, err := kafka.NewAdminClientFromProducer(r.producer)
+	if err != nil {
+		return err
+	}
+	defer adminClient.Close()
+
+	retention := r.results.timeout + 2*r.results.ttl
+	results, err := adminClient.CreateTopics(
+		context.Background(),
+		[]kafka.TopicSpecification{{
+			Topic:             r.topic,
+			NumPartitions:     numPartitions,
+			ReplicationFactor: replicationFactor,
+			Config: map[string]string{
+				"cleanup.policy": "compact,delete",
+				"retention.ms":   strconv.FormatInt(retention.Milliseconds(), 10),
+			},
+		}},
+		kafka.SetAdminOperationTimeout(asyncTopicCreateTimeout),
+	)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if code := result.Error.Code(); code != kafka.ErrNoError && code != kafka.ErrTopicAlreadyExists {
+			return fmt.Errorf("failed to create topic %s: %w", result.Topic, result.Error)
+		}
+	}
+	return nil
+}
+
+// publish shares a result of this replica, without waiting for it to be delivered
+func (r *AsyncResultsReplicator) publish(pipelineName string, result *asyncResult, expires time.Time) {
+	value, err := json.Marshal(&asyncResultMessage{Pipeline: pipelineName, Result: result, Expires: expires})
+	if err != nil {
+		r.logger.WithError(err).Errorf("Failed to encode result of request %s for pipeline %s", result.Id, pipelineName)
+		return
+	}
+	err = r.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &r.topic, Partition: kafka.PartitionAny},
+		Key:            []byte(getAsyncResultKey(pipelineName, result.Id)),
+		Value:          value,
+	}, nil)
+	if err != nil {
+		r.logger.WithError(err).Warnf("Failed to share result of request %s for pipeline %s", result.Id, pipelineName)
+	}
+}
+
+func (r *AsyncResultsReplicator) consume() {
+	for {
+		select {
+		case <-r.done:
+			return
+		default:
+		}
+		ev := r.consumer.Poll(pollTimeoutMillisecs)
+		switch e := ev.(type) {
+		case *kafka.Message:
+			r.handleMessage(e)
+		case kafka.Error:
+			r.logger.Errorf("Kafka error, code: [%s] msg: [%s]", e.Code().String(), e.Error())
+		}
+	}
+}
+
+// handleMessage stores the result shared by a replica, including this one before a restart
+func (r *AsyncResultsReplicator) handleMessage(msg *kafka.Message) {
+	message := &asyncResultMessage{}
+	if err := json.Unmarshal(msg.Value, message); err != nil || message.Result == nil {
+		r.logger.WithError(err).Warnf("Ignoring invalid result with key %s", string(msg.Key))
+		return
+	}
+	r.results.apply(message.Pipeline, message.Result, message.Expires)
+}
+
+func (r *AsyncResultsReplicator) Stop() {
+	r.results.setPublisher(nil)
+	close(r.done)
+	r.wg.Wait()
+	if err := r.consumer.Close(); err != nil {
+		r.logger.WithError(err).Warn("Failed to close consumer")
+	}
+	r.producer.Flush(int(asyncTopicCreateTimeout.Milliseconds()))
+	r.producer.Close()
+}
@@ -0,0 +1,383 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/internal/testing_utils"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+func TestAsyncResults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	results := NewAsyncResults(50*time.Millisecond, time.Minute, 2, nil)
+	g.Expect(results.add("p1", "1")).To(BeNil())
+	g.Expect(results.add("p1", "1")).To(MatchError(errAsyncRequestExists))
+	// request ids are per pipeline
+	g.Expect(results.add("p2", "1")).To(BeNil())
+	// the pending requests are capped
+	g.Expect(results.add("p1", "2")).To(MatchError(errTooManyAsyncRequests))
+
+	result, ok := results.get("p1", "1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(result.Status).To(Equal(asyncStatusPending))
+	_, ok = results.get("p1", "2")
+	g.Expect(ok).To(BeFalse())
+
+	results.complete("p1", &asyncResult{Id: "1", Status: asyncStatusCompleted})
+	result, ok = results.get("p1", "1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(result.Status).To(Equal(asyncStatusCompleted))
+	// a completed request is no longer pending
+	g.Expect(results.add("p1", "2")).To(BeNil())
+
+	// completed results expire while pending ones are kept
+	time.Sleep(100 * time.Millisecond)
+	_, ok = results.get("p1", "1")
+	g.Expect(ok).To(BeFalse())
+	_, ok = results.get("p2", "1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(results.add("p1", "1")).To(MatchError(errTooManyAsyncRequests))
+	g.Expect(results.results).To(HaveLen(2))
+	g.Expect(results.expiries).To(HaveLen(2))
+}
+
+func TestAsyncResultsExpiryOrder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	results := NewAsyncResults(time.Minute, time.Minute, 10, nil)
+	now := time.Now()
+	for idx, expires := range []time.Duration{5, 1, 3, -1, 4, -2} {
+		results.apply("p1", &asyncResult{Id: strconv.Itoa(idx), Status: asyncStatusCompleted}, now.Add(expires*time.Second))
+	}
+	// results that have already expired are not stored
+	g.Expect(results.results).To(HaveLen(4))
+	g.Expect(results.expiries[0].result.Id).To(Equal("1"))
+
+	results.removeExpired(now.Add(3500 * time.Millisecond))
+	g.Expect(results.results).To(HaveLen(2))
+	g.Expect(results.results).To(HaveKey("p1/0"))
+	g.Expect(results.results).To(HaveKey("p1/4"))
+	g.Expect(results.expiries[0].result.Id).To(Equal("4"))
+}
+
+func TestAsyncResultsApply(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	results := NewAsyncResults(time.Minute, time.Minute, 10, nil)
+	var published []*asyncResult
+	results.setPublisher(func(pipelineName string, result *asyncResult, expires time.Time) {
+		published = append(published, result)
+	})
+	expires := time.Now().Add(time.Minute)
+
+	// results of other replicas can be fetched, pending ones do not count towards the pending requests
+	results.apply("p1", &asyncResult{Id: "1", Status: asyncStatusPending}, expires)
+	result, ok := results.get("p1", "1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(result.Status).To(Equal(asyncStatusPending))
+	g.Expect(results.pending).To(Equal(0))
+	results.apply("p1", &asyncResult{Id: "1", Status: asyncStatusCompleted}, expires)
+	result, _ = results.get("p1", "1")
+	g.Expect(result.Status).To(Equal(asyncStatusCompleted))
+	// a completed result is not replaced
+	results.apply("p1", &asyncResult{Id: "1", Status: asyncStatusPending}, expires)
+	result, _ = results.get("p1", "1")
+	g.Expect(result.Status).To(Equal(asyncStatusCompleted))
+	g.Expect(results.add("p1", "1")).To(MatchError(errAsyncRequestExists))
+
+	// the results of this replica are published and not replaced by the results it published before
+	g.Expect(results.add("p1", "2")).To(BeNil())
+	results.apply("p1", &asyncResult{Id: "2", Status: asyncStatusFailed}, expires)
+	result, _ = results.get("p1", "2")
+	g.Expect(result.Status).To(Equal(asyncStatusPending))
+	results.complete("p1", &asyncResult{Id: "2", Status: asyncStatusCompleted})
+	g.Expect(results.pending).To(Equal(0))
+	g.Expect(published).To(HaveLen(2))
+	g.Expect(published[0].Status).To(Equal(asyncStatusPending))
+	g.Expect(published[1].Status).To(Equal(asyncStatusCompleted))
+}
+
+func TestAsyncResultsReplicatorHandleMessage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	results := NewAsyncResults(time.Minute, time.Minute, 10, nil)
+	replicator := &AsyncResultsReplicator{logger: logrus.New(), results: results}
+
+	value, err := json.Marshal(&asyncResultMessage{
+		Pipeline: "p1",
+		Result:   &asyncResult{Id: "1", Status: asyncStatusCompleted, Response: json.RawMessage(`{"outputs":[]}`)},
+		Expires:  time.Now().Add(time.Minute),
+	})
+	g.Expect(err).To(BeNil())
+	replicator.handleMessage(&kafka.Message{Key: []byte("p1/1"), Value: value})
+	replicator.handleMessage(&kafka.Message{Key: []byte("p1/2"), Value: []byte("invalid")})
+	replicator.handleMessage(&kafka.Message{Key: []byte("p1/3")})
+
+	result, ok := results.get("p1", "1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(result.Status).To(Equal(asyncStatusCompleted))
+	g.Expect(result.Response).To(MatchJSON(`{"outputs":[]}`))
+	g.Expect(results.results).To(HaveLen(1))
+}
+
+func TestIsCallbackAllowed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		callbackHosts []string
+		callbackUrl   string
+		expected      bool
+	}
+
+	tests := []test{
+		{name: "no hosts allowed", callbackUrl: "http://example.com/results", expected: false},
+		{name: "host allowed", callbackHosts: []string{"example.com"}, callbackUrl: "https://example.com/results", expected: true},
+		{name: "host allowed on any port", callbackHosts: []string{"Example.com"}, callbackUrl: "http://EXAMPLE.com:8080/results", expected: true},
+		{name: "host and port allowed", callbackHosts: []string{"example.com:8080"}, callbackUrl: "http://example.com:8080/results", expected: true},
+		{name: "other port", callbackHosts: []string{"example.com:8080"}, callbackUrl: "http://example.com:9090/results", expected: false},
+		{name: "outside the allowlist", callbackHosts: []string{"example.com"}, callbackUrl: "http://169.254.169.254/latest/meta-data", expected: false},
+		{name: "subdomain", callbackHosts: []string{"example.com"}, callbackUrl: "http://internal.example.com/results", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, err := url.Parse(test.callbackUrl)
+			g.Expect(err).To(BeNil())
+			g.Expect(NewAsyncResults(time.Minute, time.Minute, 10, test.callbackHosts).isCallbackAllowed(u)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestIsAsyncRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		prefer   []string
+		expected bool
+	}
+
+	tests := []test{
+		{name: "no preference", expected: false},
+		{name: "respond async", prefer: []string{"respond-async"}, expected: true},
+		{name: "several preferences", prefer: []string{"return=minimal, Respond-Async"}, expected: true},
+		{name: "several headers", prefer: []string{"return=minimal", "respond-async"}, expected: true},
+		{name: "other preference", prefer: []string{"wait=10"}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v2/pipelines/p/infer", nil)
+			for _, prefer := range test.prefer {
+				req.Header.Add(preferHeader, prefer)
+			}
+			g.Expect(isAsyncRequest(req)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestHttpServerAsync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	res := &v2.ModelInferResponse{
+		ModelName: "model",
+		Id:        "1234",
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{
+				Name:     "t1",
+				Datatype: tyInt64,
+				Shape:    []int64{1},
+				Contents: &v2.InferTensorContents{Int64Contents: []int64{1}},
+			},
+		},
+	}
+	resProto, err := proto.Marshal(res)
+	g.Expect(err).To(BeNil())
+	resJson, err := ConvertV2ResponseBytesToJson(resProto)
+	g.Expect(err).To(BeNil())
+
+	callbacks := make(chan *asyncResult, 10)
+	callbackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		result := &asyncResult{}
+		g.Expect(json.NewDecoder(req.Body).Decode(result)).To(BeNil())
+		g.Expect(req.Header.Get(util.RequestIdHeader)).To(Equal(result.Id))
+		callbacks <- result
+	}))
+	defer callbackServer.Close()
+
+	type test struct {
+		name               string
+		requestId          string
+		callbackUrl        string
+		errorModel         error
+		submitStatusCode   int
+		expectedStatus     string
+		expectedResponse   json.RawMessage
+		expectedError      string
+		expectCallbackCall bool
+	}
+
+	tests := []test{
+		{
+			name:             "completed",
+			requestId:        "req-1",
+			submitStatusCode: http.StatusAccepted,
+			expectedStatus:   asyncStatusCompleted,
+			expectedResponse: resJson,
+		},
+		{
+			name:               "completed with callback",
+			requestId:          "req-2",
+			callbackUrl:        callbackServer.URL,
+			submitStatusCode:   http.StatusAccepted,
+			expectedStatus:     asyncStatusCompleted,
+			expectedResponse:   resJson,
+			expectCallbackCall: true,
+		},
+		{
+			name:               "failed",
+			requestId:          "req-3",
+			callbackUrl:        callbackServer.URL,
+			errorModel:         errors.New("model error"),
+			submitStatusCode:   http.StatusAccepted,
+			expectedStatus:     asyncStatusFailed,
+			expectedError:      "model error : ",
+			expectCallbackCall: true,
+		},
+		{
+			name:             "existing request id",
+			requestId:        "req-1",
+			submitStatusCode: http.StatusConflict,
+		},
+		{
+			name:             "invalid callback url",
+			requestId:        "req-4",
+			callbackUrl:      "ftp://localhost/results",
+			submitStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:             "callback host not allowed",
+			requestId:        "req-5",
+			callbackUrl:      "http://169.254.169.254/latest/meta-data",
+			submitStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:             "callback port not allowed",
+			requestId:        "req-6",
+			callbackUrl:      "http://localhost:1/results",
+			submitStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	callbackServerUrl, err := url.Parse(callbackServer.URL)
+	g.Expect(err).To(BeNil())
+	// the callback server listens on 127.0.0.1
+	g.Expect(callbackServerUrl.Hostname()).ToNot(Equal("localhost"))
+	port, err := testing_utils.GetFreePortForTest()
+	g.Expect(err).To(BeNil())
+	mockInferer := &fakePipelineInferer{}
+	asyncResults := NewAsyncResults(time.Minute, time.Minute, 10, []string{callbackServerUrl.Host, "Example.com"})
+	httpServer := NewGatewayHttpServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, nil, asyncResults, nil)
+	go func() {
+		err := httpServer.Start()
+		g.Expect(err).To(Equal(http.ErrServerClosed))
+	}()
+	waitForServer(port)
+	defer func() {
+		err = httpServer.Stop()
+		g.Expect(err).To(BeNil())
+	}()
+	baseUrl := "http://localhost:" + strconv.Itoa(port)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockInferer.data = resProto
+			mockInferer.errorModel = test.errorModel
+			if test.errorModel != nil {
+				mockInferer.data = nil
+			}
+
+			req, err := http.NewRequest(http.MethodPost, baseUrl+"/v2/pipelines/p/infer", strings.NewReader(`{"inputs":[]}`))
+			g.Expect(err).To(BeNil())
+			req.Header.Set(util.SeldonModelHeader, "p.pipeline")
+			req.Header.Set(util.RequestIdHeader, test.requestId)
+			req.Header.Set(preferHeader, preferRespondAsync)
+			if test.callbackUrl != "" {
+				req.Header.Set(callbackUrlHeader, test.callbackUrl)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			g.Expect(err).To(BeNil())
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(Equal(test.submitStatusCode))
+			if test.submitStatusCode != http.StatusAccepted {
+				return
+			}
+			location := "/v2/pipelines/p/requests/" + test.requestId
+			g.Expect(resp.Header.Get("Location")).To(Equal(location))
+			submitted := &asyncResult{}
+			g.Expect(json.NewDecoder(resp.Body).Decode(submitted)).To(BeNil())
+			g.Expect(submitted).To(Equal(&asyncResult{Id: test.requestId, Status: asyncStatusPending}))
+
+			result := &asyncResult{}
+			g.Eventually(func() string {
+				req, err := http.NewRequest(http.MethodGet, baseUrl+location, nil)
+				g.Expect(err).To(BeNil())
+				req.Header.Set(util.SeldonModelHeader, "p.pipeline")
+				resp, err := http.DefaultClient.Do(req)
+				g.Expect(err).To(BeNil())
+				defer resp.Body.Close()
+				g.Expect(json.NewDecoder(resp.Body).Decode(result)).To(BeNil())
+				return result.Status
+			}).Should(Equal(test.expectedStatus))
+			g.Expect(result.Id).To(Equal(test.requestId))
+			g.Expect(result.Error).To(Equal(test.expectedError))
+			if test.expectedResponse != nil {
+				g.Expect(result.Response).To(MatchJSON(test.expectedResponse))
+			}
+
+			if test.expectCallbackCall {
+				var callback *asyncResult
+				g.Eventually(callbacks).Should(Receive(&callback))
+				g.Expect(callback.Id).To(Equal(test.requestId))
+				g.Expect(callback.Status).To(Equal(test.expectedStatus))
+			}
+		})
+	}
+
+	// unknown requests are not found
+	req, err := http.NewRequest(http.MethodGet, baseUrl+"/v2/pipelines/p/requests/unknown", nil)
+	g.Expect(err).To(BeNil())
+	resp, err := http.DefaultClient.Do(req)
+	g.Expect(err).To(BeNil())
+	defer resp.Body.Close()
+	_, err = io.ReadAll(resp.Body)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+}
@@ -107,6 +107,24 @@ func (pc *PipelineSchedulerClient) connectToScheduler(host string, plainTxtPort
 	return nil
 }
 
+func (pc *PipelineSchedulerClient) SendExperimentFeedback(ctx context.Context, feedback *scheduler.ExperimentFeedbackRequest) error {
+	if !pc.IsConnected() {
+		return fmt.Errorf("not connected to scheduler")
+	}
+	grpcClient := scheduler.NewSchedulerClient(pc.conn)
+	_, err := grpcClient.ExperimentFeedback(ctx, feedback, pc.callOptions...)
+	return err
+}
+
+func (pc *PipelineSchedulerClient) SendExperimentRequests(ctx context.Context, requests []*scheduler.ExperimentRequest) error {
+	if !pc.IsConnected() {
+		return fmt.Errorf("not connected to scheduler")
+	}
+	grpcClient := scheduler.NewSchedulerClient(pc.conn)
+	_, err := grpcClient.ExperimentRequests(ctx, &scheduler.ExperimentRequestsRequest{Requests: requests}, pc.callOptions...)
+	return err
+}
+
 func (pc *PipelineSchedulerClient) Stop() {
 	pc.stop.Store(true)
 	if pc.conn != nil {
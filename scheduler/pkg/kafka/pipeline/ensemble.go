@@ -0,0 +1,482 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	status2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// EnsembleInferer infers with the ensembles of the pipeline gateway by sending the request to all their steps
+// in parallel over OIP, through envoy, and aggregating the responses, so no Kafka or dataflow engine is
+// involved. Models and the other pipelines are inferred with the wrapped inferer.
+type EnsembleInferer struct {
+	PipelineInferer
+	pipelines   status2.PipelineStatusProvider
+	grpcClient  v2.GRPCInferenceServiceClient
+	callOptions []grpc.CallOption
+	logger      logrus.FieldLogger
+}
+
+type stepResult struct {
+	idx      int
+	response *v2.ModelInferResponse
+	err      error
+}
+
+func NewEnsembleInferer(
+	logger logrus.FieldLogger,
+	inferer PipelineInferer,
+	pipelines status2.PipelineStatusProvider,
+	grpcClient v2.GRPCInferenceServiceClient,
+) *EnsembleInferer {
+	return &EnsembleInferer{
+		PipelineInferer: inferer,
+		pipelines:       pipelines,
+		grpcClient:      grpcClient,
+		callOptions: []grpc.CallOption{
+			grpc.MaxCallSendMsgSize(math.MaxInt32),
+			grpc.MaxCallRecvMsgSize(math.MaxInt32),
+		},
+		logger: logger.WithField("source", "EnsembleInferer"),
+	}
+}
+
+func (e *EnsembleInferer) getEnsemble(resourceName string, isModel bool) *pipeline.PipelineVersion {
+	if isModel {
+		return nil
+	}
+	pv := e.pipelines.Get(resourceName)
+	if pv == nil || pv.Ensemble == nil {
+		return nil
+	}
+	return pv
+}
+
+// LoadOrStorePipeline does not create Kafka consumers for ensembles as their responses are not read from Kafka
+func (e *EnsembleInferer) LoadOrStorePipeline(resourceName string, isModel bool, loadOnly bool) (*Pipeline, error) {
+	if e.getEnsemble(resourceName, isModel) != nil {
+		return nil, nil
+	}
+	return e.PipelineInferer.LoadOrStorePipeline(resourceName, isModel, loadOnly)
+}
+
+func (e *EnsembleInferer) Infer(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+) (*Request, error) {
+	pv := e.getEnsemble(resourceName, isModel)
+	if pv == nil {
+		return e.PipelineInferer.Infer(ctx, resourceName, isModel, data, headers, requestId)
+	}
+	return e.inferEnsemble(ctx, pv, data, headers, requestId)
+}
+
+// InferStream returns the aggregated response of an ensemble as the only response of the stream
+func (e *EnsembleInferer) InferStream(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+	onChunk func(response []byte) error,
+) (*Request, error) {
+	pv := e.getEnsemble(resourceName, isModel)
+	if pv == nil {
+		return e.PipelineInferer.InferStream(ctx, resourceName, isModel, data, headers, requestId, onChunk)
+	}
+	return e.inferEnsemble(ctx, pv, data, headers, requestId)
+}
+
+// inferEnsemble sends the request to the steps of the ensemble in parallel. Failed steps fail the request
+// except for first-successful, which responds with the first step to succeed and cancels the others.
+func (e *EnsembleInferer) inferEnsemble(
+	ctx context.Context,
+	pv *pipeline.PipelineVersion,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+) (*Request, error) {
+	logger := e.logger.WithField("func", "inferEnsemble")
+	req := &v2.ModelInferRequest{}
+	if err := proto.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+
+	if pv.Ensemble.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, pv.Ensemble.Timeout)
+		defer cancelTimeout()
+	}
+	// the steps still running are cancelled once the ensemble has its response
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	steps := make([]string, 0, len(pv.Steps))
+	for stepName := range pv.Steps {
+		steps = append(steps, stepName)
+	}
+	slices.Sort(steps)
+
+	md := createModelMetadata(headers, requestId)
+	results := make(chan stepResult, len(steps))
+	for idx, step := range steps {
+		go func() {
+			stepReq := proto.Clone(req).(*v2.ModelInferRequest)
+			stepReq.ModelName = step
+			response, err := inferModel(ctx, e.grpcClient, e.callOptions, stepReq, md)
+			results <- stepResult{idx: idx, response: response, err: err}
+		}()
+	}
+
+	responses := make([]*v2.ModelInferResponse, len(steps))
+	var stepErr error
+	for range steps {
+		result := <-results
+		if result.err != nil {
+			logger.WithError(result.err).Debugf("Failed infer request to step %s of ensemble %s", steps[result.idx], pv.Name)
+			stepErr = &upstreamErr{err: fmt.Errorf("step %s of ensemble %s failed: %w", steps[result.idx], pv.Name, result.err)}
+			if pv.Ensemble.Aggregation == pipeline.EnsembleFirstSuccessful {
+				continue
+			}
+			return &Request{key: requestId, err: stepErr}, nil
+		}
+		if pv.Ensemble.Aggregation == pipeline.EnsembleFirstSuccessful {
+			return createEnsembleRequest(pv.Name, requestId, req.Id, result.response.Outputs)
+		}
+		responses[result.idx] = result.response
+	}
+	if stepErr != nil {
+		return &Request{key: requestId, err: stepErr}, nil
+	}
+
+	outputs, err := aggregateOutputs(pv.Ensemble.Aggregation, steps, responses)
+	if err != nil {
+		return &Request{key: requestId, err: fmt.Errorf("failed to aggregate the responses of ensemble %s: %w", pv.Name, err)}, nil
+	}
+	return createEnsembleRequest(pv.Name, requestId, req.Id, outputs)
+}
+
+// createModelMetadata returns the metadata of the requests to the models of a pipeline from the headers of
+// the pipeline request
+func createModelMetadata(headers []kafka.Header, requestId string) metadata.MD {
+	md := convertKafkaHeadersToGrpcMetadata(addRequestIdToKafkaHeadersIfMissing(headers, requestId))
+	delete(md, util.SeldonRouteHeader) // x-seldon-route would confuse envoy
+	return md
+}
+
+// inferModel sends the request to the pinned version of its model through envoy
+func inferModel(
+	ctx context.Context,
+	grpcClient v2.GRPCInferenceServiceClient,
+	callOptions []grpc.CallOption,
+	req *v2.ModelInferRequest,
+	md metadata.MD,
+) (*v2.ModelInferResponse, error) {
+	req.ModelVersion = fmt.Sprintf("%d", util.GetPinnedModelVersion())
+
+	ctx = metadata.NewOutgoingContext(ctx, md.Copy())
+	ctx = metadata.AppendToOutgoingContext(ctx, util.SeldonModelHeader, req.ModelName)
+	response, err := grpcClient.ModelInfer(ctx, req, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+	// the responses are used tensor by tensor so raw contents are decoded first
+	if err := updateResponseFromRawContents(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// upstreamErr is the failure of a request of the pipeline gateway to a model, which is not caused by the
+// request of the caller
+type upstreamErr struct {
+	err error
+}
+
+func (ue *upstreamErr) Error() string {
+	return ue.err.Error()
+}
+
+func (ue *upstreamErr) Unwrap() error {
+	return ue.err
+}
+
+// getErrorCode returns the gRPC code of the error of a request: deadline exceeded when a model timed out,
+// unavailable when a model failed otherwise and unknown for the other errors, such as those of the
+// dataflow engine
+func getErrorCode(err error) codes.Code {
+	var ue *upstreamErr
+	switch {
+	case !errors.As(err, &ue):
+		return codes.Unknown
+	case errors.Is(ue.err, context.DeadlineExceeded) || status.Code(ue.err) == codes.DeadlineExceeded:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Unavailable
+	}
+}
+
+func createEnsembleRequest(ensembleName string, requestId string, id string, outputs []*v2.ModelInferResponse_InferOutputTensor) (*Request, error) {
+	response, err := proto.Marshal(&v2.ModelInferResponse{
+		ModelName: ensembleName,
+		Id:        id,
+		Outputs:   outputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Request{key: requestId, response: response}, nil
+}
+
+func aggregateOutputs(
+	aggregation pipeline.EnsembleAggregation,
+	steps []string,
+	responses []*v2.ModelInferResponse,
+) ([]*v2.ModelInferResponse_InferOutputTensor, error) {
+	switch aggregation {
+	case pipeline.EnsembleVote:
+		return voteOutputs(steps, responses)
+	case pipeline.EnsembleConcat:
+		return concatOutputs(steps, responses), nil
+	default:
+		return meanOutputs(steps, responses)
+	}
+}
+
+// concatOutputs returns the outputs of all the steps, named <step>.<output>
+func concatOutputs(steps []string, responses []*v2.ModelInferResponse) []*v2.ModelInferResponse_InferOutputTensor {
+	var outputs []*v2.ModelInferResponse_InferOutputTensor
+	for idx, response := range responses {
+		for _, output := range response.Outputs {
+			output.Name = steps[idx] + "." + output.Name
+			outputs = append(outputs, output)
+		}
+	}
+	return outputs
+}
+
+// meanOutputs returns the element-wise mean of the outputs of the steps with the same name. FP64 outputs
+// stay FP64 and the other numeric outputs become FP32.
+func meanOutputs(steps []string, responses []*v2.ModelInferResponse) ([]*v2.ModelInferResponse_InferOutputTensor, error) {
+	var outputs []*v2.ModelInferResponse_InferOutputTensor
+	for _, output := range responses[0].Outputs {
+		var sums []float64
+		for idx, response := range responses {
+			stepOutput, err := getMatchingOutput(steps[idx], response, output)
+			if err != nil {
+				return nil, err
+			}
+			values, ok := numericValues(stepOutput.Contents)
+			if !ok {
+				return nil, fmt.Errorf("output %s of step %s of type %s is not numeric", output.Name, steps[idx], output.Datatype)
+			}
+			if sums == nil {
+				sums = make([]float64, len(values))
+			}
+			if len(values) != len(sums) {
+				return nil, fmt.Errorf("output %s of step %s has %d elements, expected %d", output.Name, steps[idx], len(values), len(sums))
+			}
+			for i, value := range values {
+				sums[i] += value
+			}
+		}
+
+		mean := &v2.ModelInferResponse_InferOutputTensor{
+			Name:       output.Name,
+			Shape:      output.Shape,
+			Parameters: output.Parameters,
+			Contents:   &v2.InferTensorContents{},
+		}
+		if output.Datatype == tyFp64 {
+			mean.Datatype = tyFp64
+			for _, sum := range sums {
+				mean.Contents.Fp64Contents = append(mean.Contents.Fp64Contents, sum/float64(len(responses)))
+			}
+		} else {
+			mean.Datatype = tyFp32
+			for _, sum := range sums {
+				mean.Contents.Fp32Contents = append(mean.Contents.Fp32Contents, float32(sum/float64(len(responses))))
+			}
+		}
+		outputs = append(outputs, mean)
+	}
+	return outputs, nil
+}
+
+// voteOutputs returns the element-wise most common value of the outputs of the steps with the same name,
+// ties are won by the first step in alphabetical order
+func voteOutputs(steps []string, responses []*v2.ModelInferResponse) ([]*v2.ModelInferResponse_InferOutputTensor, error) {
+	var outputs []*v2.ModelInferResponse_InferOutputTensor
+	for _, output := range responses[0].Outputs {
+		stepOutputs := make([]*v2.ModelInferResponse_InferOutputTensor, len(responses))
+		stepValues := make([][]string, len(responses))
+		for idx, response := range responses {
+			stepOutput, err := getMatchingOutput(steps[idx], response, output)
+			if err != nil {
+				return nil, err
+			}
+			stepOutputs[idx] = stepOutput
+			stepValues[idx] = formatValues(stepOutput.Contents)
+			if len(stepValues[idx]) != len(stepValues[0]) {
+				return nil, fmt.Errorf("output %s of step %s has %d elements, expected %d", output.Name, steps[idx], len(stepValues[idx]), len(stepValues[0]))
+			}
+		}
+
+		vote := &v2.ModelInferResponse_InferOutputTensor{
+			Name:       output.Name,
+			Datatype:   output.Datatype,
+			Shape:      output.Shape,
+			Parameters: output.Parameters,
+			Contents:   &v2.InferTensorContents{},
+		}
+		for i := range stepValues[0] {
+			votes := make(map[string]int)
+			for idx := range stepValues {
+				votes[stepValues[idx][i]]++
+			}
+			winner := 0
+			for idx := range stepValues {
+				if votes[stepValues[idx][i]] > votes[stepValues[winner][i]] {
+					winner = idx
+				}
+			}
+			appendValue(vote.Contents, stepOutputs[winner].Contents, i)
+		}
+		outputs = append(outputs, vote)
+	}
+	return outputs, nil
+}
+
+// getMatchingOutput returns the output of a step with the name, type and shape of the output of another step
+func getMatchingOutput(
+	step string,
+	response *v2.ModelInferResponse,
+	output *v2.ModelInferResponse_InferOutputTensor,
+) (*v2.ModelInferResponse_InferOutputTensor, error) {
+	for _, stepOutput := range response.Outputs {
+		if stepOutput.Name != output.Name {
+			continue
+		}
+		if stepOutput.Datatype != output.Datatype || !slices.Equal(stepOutput.Shape, output.Shape) {
+			return nil, fmt.Errorf(
+				"output %s of step %s is %s %v, expected %s %v",
+				output.Name, step, stepOutput.Datatype, stepOutput.Shape, output.Datatype, output.Shape,
+			)
+		}
+		return stepOutput, nil
+	}
+	return nil, fmt.Errorf("step %s has no output %s", step, output.Name)
+}
+
+func numericValues(contents *v2.InferTensorContents) ([]float64, bool) {
+	switch {
+	case contents == nil:
+		return nil, false
+	case len(contents.Fp32Contents) > 0:
+		return toFloat64(contents.Fp32Contents), true
+	case len(contents.Fp64Contents) > 0:
+		return contents.Fp64Contents, true
+	case len(contents.IntContents) > 0:
+		return toFloat64(contents.IntContents), true
+	case len(contents.Int64Contents) > 0:
+		return toFloat64(contents.Int64Contents), true
+	case len(contents.UintContents) > 0:
+		return toFloat64(contents.UintContents), true
+	case len(contents.Uint64Contents) > 0:
+		return toFloat64(contents.Uint64Contents), true
+	}
+	return nil, false
+}
+
+func toFloat64[T float32 | int32 | int64 | uint32 | uint64](values []T) []float64 {
+	floats := make([]float64, len(values))
+	for i, value := range values {
+		floats[i] = float64(value)
+	}
+	return floats
+}
+
+// formatValues returns the elements of the contents as strings, so elements of any type can be counted
+func formatValues(contents *v2.InferTensorContents) []string {
+	switch {
+	case contents == nil:
+		return nil
+	case len(contents.BoolContents) > 0:
+		return toStrings(contents.BoolContents)
+	case len(contents.Fp32Contents) > 0:
+		return toStrings(contents.Fp32Contents)
+	case len(contents.Fp64Contents) > 0:
+		return toStrings(contents.Fp64Contents)
+	case len(contents.IntContents) > 0:
+		return toStrings(contents.IntContents)
+	case len(contents.Int64Contents) > 0:
+		return toStrings(contents.Int64Contents)
+	case len(contents.UintContents) > 0:
+		return toStrings(contents.UintContents)
+	case len(contents.Uint64Contents) > 0:
+		return toStrings(contents.Uint64Contents)
+	case len(contents.BytesContents) > 0:
+		return convertByteSlicesToString(contents.BytesContents)
+	}
+	return nil
+}
+
+func toStrings[T any](values []T) []string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = fmt.Sprint(value)
+	}
+	return strs
+}
+
+// appendValue appends the element i of the contents from to the contents to
+func appendValue(to *v2.InferTensorContents, from *v2.InferTensorContents, i int) {
+	switch {
+	case len(from.BoolContents) > 0:
+		to.BoolContents = append(to.BoolContents, from.BoolContents[i])
+	case len(from.Fp32Contents) > 0:
+		to.Fp32Contents = append(to.Fp32Contents, from.Fp32Contents[i])
+	case len(from.Fp64Contents) > 0:
+		to.Fp64Contents = append(to.Fp64Contents, from.Fp64Contents[i])
+	case len(from.IntContents) > 0:
+		to.IntContents = append(to.IntContents, from.IntContents[i])
+	case len(from.Int64Contents) > 0:
+		to.Int64Contents = append(to.Int64Contents, from.Int64Contents[i])
+	case len(from.UintContents) > 0:
+		to.UintContents = append(to.UintContents, from.UintContents[i])
+	case len(from.Uint64Contents) > 0:
+		to.Uint64Contents = append(to.Uint64Contents, from.Uint64Contents[i])
+	case len(from.BytesContents) > 0:
+		to.BytesContents = append(to.BytesContents, from.BytesContents[i])
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	status2 "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+type fakeModelInferClient struct {
+	v2.GRPCInferenceServiceClient
+	mu        sync.Mutex
+	responses map[string]*v2.ModelInferResponse
+	errs      map[string]error
+	delays    map[string]time.Duration
+	models    []string
+	requests  map[string]*v2.ModelInferRequest
+}
+
+func (f *fakeModelInferClient) ModelInfer(ctx context.Context, in *v2.ModelInferRequest, opts ...grpc.CallOption) (*v2.ModelInferResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.mu.Lock()
+	f.models = append(f.models, md.Get(util.SeldonModelHeader)[0])
+	if f.requests == nil {
+		f.requests = make(map[string]*v2.ModelInferRequest)
+	}
+	f.requests[in.ModelName] = in
+	f.mu.Unlock()
+	select {
+	case <-time.After(f.delays[in.ModelName]):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if err := f.errs[in.ModelName]; err != nil {
+		return nil, err
+	}
+	return proto.Clone(f.responses[in.ModelName]).(*v2.ModelInferResponse), nil
+}
+
+func createEnsembleTestResponse(fp32 []float32, ints []int64) *v2.ModelInferResponse {
+	return &v2.ModelInferResponse{
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{
+				Name:     "proba",
+				Datatype: tyFp32,
+				Shape:    []int64{1, int64(len(fp32))},
+				Contents: &v2.InferTensorContents{Fp32Contents: fp32},
+			},
+			{
+				Name:     "label",
+				Datatype: tyInt64,
+				Shape:    []int64{int64(len(ints))},
+				Contents: &v2.InferTensorContents{Int64Contents: ints},
+			},
+		},
+	}
+}
+
+func TestEnsembleInferer(t *testing.T) {
+	type test struct {
+		name             string
+		resourceName     string
+		ensemble         *pipeline.EnsembleSpec
+		responses        map[string]*v2.ModelInferResponse
+		errs             map[string]error
+		delays           map[string]time.Duration
+		expectedOutputs  []*v2.ModelInferResponse_InferOutputTensor
+		expectedErrCode  codes.Code
+		expectedFallback bool
+	}
+
+	responses := map[string]*v2.ModelInferResponse{
+		"a": createEnsembleTestResponse([]float32{0.2, 0.8}, []int64{1, 0}),
+		"b": createEnsembleTestResponse([]float32{0.4, 0.6}, []int64{1, 1}),
+		"c": createEnsembleTestResponse([]float32{0.6, 0.4}, []int64{0, 1}),
+	}
+	tests := []test{
+		{
+			name:         "mean",
+			resourceName: "ens",
+			ensemble:     &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleMean},
+			responses:    responses,
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				{
+					Name:     "proba",
+					Datatype: tyFp32,
+					Shape:    []int64{1, 2},
+					Contents: &v2.InferTensorContents{Fp32Contents: []float32{0.4, 0.6}},
+				},
+				{
+					Name:     "label",
+					Datatype: tyFp32,
+					Shape:    []int64{2},
+					Contents: &v2.InferTensorContents{Fp32Contents: []float32{float32(2.0 / 3.0), float32(2.0 / 3.0)}},
+				},
+			},
+		},
+		{
+			name:         "vote",
+			resourceName: "ens",
+			ensemble:     &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleVote},
+			responses:    responses,
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				{
+					Name:     "proba",
+					Datatype: tyFp32,
+					Shape:    []int64{1, 2},
+					Contents: &v2.InferTensorContents{Fp32Contents: []float32{0.2, 0.8}},
+				},
+				{
+					Name:     "label",
+					Datatype: tyInt64,
+					Shape:    []int64{2},
+					Contents: &v2.InferTensorContents{Int64Contents: []int64{1, 1}},
+				},
+			},
+		},
+		{
+			name:         "concat",
+			resourceName: "ens",
+			ensemble:     &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleConcat},
+			responses: map[string]*v2.ModelInferResponse{
+				"a": {Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+					{Name: "out", Datatype: tyBool, Shape: []int64{1}, Contents: &v2.InferTensorContents{BoolContents: []bool{true}}},
+				}},
+				"b": {Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+					{Name: "out", Datatype: tyInt32, Shape: []int64{1}, Contents: &v2.InferTensorContents{IntContents: []int32{3}}},
+				}},
+				"c": {Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+					{Name: "out", Datatype: tyBytes, Shape: []int64{1}, Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte("x")}}},
+				}},
+			},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				{Name: "a.out", Datatype: tyBool, Shape: []int64{1}, Contents: &v2.InferTensorContents{BoolContents: []bool{true}}},
+				{Name: "b.out", Datatype: tyInt32, Shape: []int64{1}, Contents: &v2.InferTensorContents{IntContents: []int32{3}}},
+				{Name: "c.out", Datatype: tyBytes, Shape: []int64{1}, Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte("x")}}},
+			},
+		},
+		{
+			name:         "first successful",
+			resourceName: "ens",
+			ensemble:     &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleFirstSuccessful},
+			responses:    responses,
+			errs:         map[string]error{"a": errors.New("failed")},
+			delays:       map[string]time.Duration{"c": time.Minute},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				responses["b"].Outputs[0],
+				responses["b"].Outputs[1],
+			},
+		},
+		{
+			name:            "first successful all failed",
+			resourceName:    "ens",
+			ensemble:        &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleFirstSuccessful},
+			errs:            map[string]error{"a": errors.New("failed"), "b": errors.New("failed"), "c": errors.New("failed")},
+			expectedErrCode: codes.Unavailable,
+		},
+		{
+			name:            "failed step",
+			resourceName:    "ens",
+			ensemble:        &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleMean},
+			responses:       responses,
+			errs:            map[string]error{"b": errors.New("failed")},
+			expectedErrCode: codes.Unavailable,
+		},
+		{
+			name:            "step deadline exceeded",
+			resourceName:    "ens",
+			ensemble:        &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleMean},
+			responses:       responses,
+			errs:            map[string]error{"b": status2.Error(codes.DeadlineExceeded, "deadline exceeded")},
+			expectedErrCode: codes.DeadlineExceeded,
+		},
+		{
+			name:            "timeout",
+			resourceName:    "ens",
+			ensemble:        &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleMean, Timeout: 10 * time.Millisecond},
+			responses:       responses,
+			delays:          map[string]time.Duration{"c": time.Minute},
+			expectedErrCode: codes.DeadlineExceeded,
+		},
+		{
+			name:         "mismatched outputs",
+			resourceName: "ens",
+			ensemble:     &pipeline.EnsembleSpec{Aggregation: pipeline.EnsembleVote},
+			responses: map[string]*v2.ModelInferResponse{
+				"a": responses["a"],
+				"b": responses["b"],
+				"c": createEnsembleTestResponse([]float32{0.6, 0.3, 0.1}, []int64{0, 1}),
+			},
+			expectedErrCode: codes.Unknown,
+		},
+		{
+			name:             "not an ensemble",
+			resourceName:     "ens",
+			expectedFallback: true,
+		},
+		{
+			name:             "unknown pipeline",
+			resourceName:     "other",
+			ensemble:         &pipeline.EnsembleSpec{},
+			expectedFallback: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			statusManager := status.NewPipelineStatusManager()
+			statusManager.Update(&pipeline.PipelineVersion{
+				Name:    "ens",
+				Version: 1,
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b"},
+					"c": {Name: "c"},
+				},
+				State:    &pipeline.PipelineState{Status: pipeline.PipelineReady},
+				Ensemble: test.ensemble,
+			})
+			grpcClient := &fakeModelInferClient{responses: test.responses, errs: test.errs, delays: test.delays}
+			fallback := &fakePipelineInferer{key: "fallback"}
+			inferer := NewEnsembleInferer(logrus.New(), fallback, statusManager, grpcClient)
+
+			req, err := proto.Marshal(&v2.ModelInferRequest{Id: "id"})
+			g.Expect(err).To(BeNil())
+			kafkaRequest, err := inferer.Infer(context.Background(), test.resourceName, false, req, []kafka.Header{}, "req")
+			g.Expect(err).To(BeNil())
+
+			if test.expectedFallback {
+				g.Expect(kafkaRequest.key).To(Equal("fallback"))
+				g.Expect(fallback.resourceName).To(Equal(test.resourceName))
+				g.Expect(grpcClient.models).To(BeEmpty())
+				return
+			}
+			g.Expect(fallback.resourceName).To(BeEmpty())
+			g.Expect(kafkaRequest.key).To(Equal("req"))
+			if test.expectedErrCode != codes.OK {
+				g.Expect(kafkaRequest.err).ToNot(BeNil())
+				g.Expect(getErrorCode(kafkaRequest.err)).To(Equal(test.expectedErrCode))
+				return
+			}
+			g.Expect(kafkaRequest.err).To(BeNil())
+			grpcClient.mu.Lock()
+			g.Expect(grpcClient.models).To(ConsistOf("a", "b", "c"))
+			grpcClient.mu.Unlock()
+
+			response := &v2.ModelInferResponse{}
+			g.Expect(proto.Unmarshal(kafkaRequest.response, response)).To(Succeed())
+			g.Expect(response.ModelName).To(Equal("ens"))
+			g.Expect(response.Id).To(Equal("id"))
+			g.Expect(response.Outputs).To(HaveLen(len(test.expectedOutputs)))
+			for i, output := range test.expectedOutputs {
+				g.Expect(proto.Equal(response.Outputs[i], output)).To(BeTrue(), "output %s", output.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	ExperimentNameVariable = "experiment"
+	v2ExperimentPathPrefix = "/v2/experiments/"
+)
+
+// ExperimentFeedbackSender forwards the rewards for requests served by experiment candidates to the scheduler
+type ExperimentFeedbackSender interface {
+	SendExperimentFeedback(ctx context.Context, feedback *scheduler.ExperimentFeedbackRequest) error
+}
+
+type experimentFeedback struct {
+	Reward *float64 `json:"reward"`
+}
+
+// experimentFeedback takes the reward for an earlier inference request, identified by its x-request-id header.
+// The candidate which served the request was recorded by the scheduler when the request was served.
+func (g *GatewayHttpServer) experimentFeedback(w http.ResponseWriter, req *http.Request) {
+	logger := g.logger.WithField("func", "experimentFeedback")
+	experimentName := mux.Vars(req)[ExperimentNameVariable]
+
+	requestId := req.Header.Get(util.RequestIdHeader)
+	if requestId == "" {
+		http.Error(w, fmt.Sprintf("missing %s header", util.RequestIdHeader), http.StatusBadRequest)
+		return
+	}
+	feedback := experimentFeedback{}
+	if err := json.NewDecoder(req.Body).Decode(&feedback); err != nil || feedback.Reward == nil {
+		http.Error(w, "expected a json body with a reward", http.StatusBadRequest)
+		return
+	}
+
+	err := g.feedbackSender.SendExperimentFeedback(req.Context(), &scheduler.ExperimentFeedbackRequest{
+		ExperimentName: experimentName,
+		RequestId:      requestId,
+		Reward:         *feedback.Reward,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		default:
+			logger.WithError(err).Errorf("Failed to send feedback for experiment %s", experimentName)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// recordBanditRequest records the pipeline which served a request when envoy routed it for a bandit experiment.
+// Models record the requests they serve in the agent.
+func recordBanditRequest(recorder *bandit.RequestRecorder, experimentName string, requestId string, resourceName string, isModel bool) {
+	if recorder == nil || experimentName == "" || isModel {
+		return
+	}
+	recorder.Record(experimentName, requestId, resourceName)
+}
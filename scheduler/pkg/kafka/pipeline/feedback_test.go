@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+type fakeFeedbackSender struct {
+	feedback *scheduler.ExperimentFeedbackRequest
+	err      error
+}
+
+func (f *fakeFeedbackSender) SendExperimentFeedback(_ context.Context, feedback *scheduler.ExperimentFeedbackRequest) error {
+	f.feedback = feedback
+	return f.err
+}
+
+func TestExperimentFeedback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		requestId        string
+		body             string
+		sendErr          error
+		expectedStatus   int
+		expectedFeedback *scheduler.ExperimentFeedbackRequest
+	}
+
+	tests := []test{
+		{
+			name:           "feedback sent",
+			requestId:      "req1",
+			body:           `{"reward": 0.5}`,
+			expectedStatus: http.StatusOK,
+			expectedFeedback: &scheduler.ExperimentFeedbackRequest{
+				ExperimentName: "exp",
+				RequestId:      "req1",
+				Reward:         0.5,
+			},
+		},
+		{
+			name:           "missing request id",
+			body:           `{"reward": 0.5}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing reward",
+			requestId:      "req1",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "experiment not found",
+			requestId:      "req1",
+			body:           `{"reward": 0.5}`,
+			sendErr:        status.Error(codes.NotFound, "experiment not found"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid feedback",
+			requestId:      "req1",
+			body:           `{"reward": 2}`,
+			sendErr:        status.Error(codes.InvalidArgument, "reward out of range"),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sender := &fakeFeedbackSender{err: test.sendErr}
+			httpServer := NewGatewayHttpServer(0, logrus.New(), &fakePipelineInferer{}, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, sender, nil, nil)
+			httpServer.setupRoutes()
+
+			req := httptest.NewRequest(http.MethodPost, "/v2/experiments/exp/feedback", strings.NewReader(test.body))
+			if test.requestId != "" {
+				req.Header.Set(util.RequestIdHeader, test.requestId)
+			}
+			rec := httptest.NewRecorder()
+			httpServer.router.ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(test.expectedStatus))
+			if test.expectedFeedback != nil {
+				g.Expect(sender.feedback).To(Equal(test.expectedFeedback))
+			}
+		})
+	}
+}
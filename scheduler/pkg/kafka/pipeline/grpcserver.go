@@ -13,6 +13,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 
 	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
 	status2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
@@ -44,6 +46,7 @@ type GatewayGrpcServer struct {
 	metrics              metrics.PipelineMetricsHandler
 	tlsOptions           *util.TLSOptions
 	pipelineReadyChecker status2.PipelineReadyChecker
+	banditRecorder       *bandit.RequestRecorder
 }
 
 func NewGatewayGrpcServer(port int,
@@ -51,7 +54,8 @@ func NewGatewayGrpcServer(port int,
 	gateway PipelineInferer,
 	metricsHandler metrics.PipelineMetricsHandler,
 	tlsOptions *util.TLSOptions,
-	piplineReadyChecker status2.PipelineReadyChecker) *GatewayGrpcServer {
+	piplineReadyChecker status2.PipelineReadyChecker,
+	banditRecorder *bandit.RequestRecorder) *GatewayGrpcServer {
 	return &GatewayGrpcServer{
 		port:                 port,
 		gateway:              gateway,
@@ -59,6 +63,7 @@ func NewGatewayGrpcServer(port int,
 		metrics:              metricsHandler,
 		tlsOptions:           tlsOptions,
 		pipelineReadyChecker: piplineReadyChecker,
+		banditRecorder:       banditRecorder,
 	}
 }
 
@@ -122,10 +127,11 @@ func (g *GatewayGrpcServer) getRequestId(md metadata.MD) string {
 	return requestId
 }
 
-func (g *GatewayGrpcServer) ModelInfer(ctx context.Context, r *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+// getResource returns the metadata of the request and the resource it is for
+func (g *GatewayGrpcServer) getResource(ctx context.Context) (metadata.MD, string, bool, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, status.Errorf(codes.FailedPrecondition, "failed to find any metadata - required %s or %s", util.SeldonModelHeader, util.SeldonInternalModelHeader)
+		return nil, "", false, status.Errorf(codes.FailedPrecondition, "failed to find any metadata - required %s or %s", util.SeldonModelHeader, util.SeldonInternalModelHeader)
 	}
 	g.logger.Debugf("Seldon model header %v and seldon internal model header %v", md[util.SeldonModelHeader], md[util.SeldonInternalModelHeader])
 	header := extractHeader(util.SeldonInternalModelHeader, md) // Internal model header has precedence
@@ -134,7 +140,15 @@ func (g *GatewayGrpcServer) ModelInfer(ctx context.Context, r *v2.ModelInferRequ
 	}
 	resourceName, isModel, err := createResourceNameFromHeader(header)
 	if err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "failed to find valid header %s, found %s", util.SeldonModelHeader, resourceName)
+		return nil, "", false, status.Errorf(codes.FailedPrecondition, "failed to find valid header %s, found %s", util.SeldonModelHeader, resourceName)
+	}
+	return md, resourceName, isModel, nil
+}
+
+func (g *GatewayGrpcServer) ModelInfer(ctx context.Context, r *v2.ModelInferRequest) (*v2.ModelInferResponse, error) {
+	md, resourceName, isModel, err := g.getResource(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	startTime := time.Now()
@@ -151,12 +165,14 @@ func (g *GatewayGrpcServer) ModelInfer(ctx context.Context, r *v2.ModelInferRequ
 	}
 
 	if kafkaRequest.err != nil {
-		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, codes.Unknown.String())
-		return nil, status.Errorf(codes.Unknown, "%s", string(createResponseErrorPayload(kafkaRequest.err, kafkaRequest.response)))
+		code := getErrorCode(kafkaRequest.err)
+		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, code.String())
+		return nil, status.Errorf(code, "%s", string(createResponseErrorPayload(kafkaRequest.err, kafkaRequest.response)))
 	}
 
 	meta := convertKafkaHeadersToGrpcMetadata(kafkaRequest.headers)
 	meta[util.RequestIdHeader] = []string{kafkaRequest.key}
+	recordBanditRequest(g.banditRecorder, extractHeader(util.SeldonBanditHeader, md), kafkaRequest.key, resourceName, isModel)
 	err = grpc.SendHeader(ctx, meta)
 	if err != nil {
 		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, codes.Internal.String())
@@ -173,6 +189,60 @@ func (g *GatewayGrpcServer) ModelInfer(ctx context.Context, r *v2.ModelInferRequ
 	return resProto, nil
 }
 
+// ModelStreamInfer requests a stream from the model or pipeline for each request received and sends its
+// responses as they arrive
+func (g *GatewayGrpcServer) ModelStreamInfer(stream v2.GRPCInferenceService_ModelStreamInferServer) error {
+	ctx := stream.Context()
+	md, resourceName, isModel, err := g.getResource(ctx)
+	if err != nil {
+		return err
+	}
+
+	send := func(response []byte) error {
+		resProto := &v2.ModelInferResponse{}
+		err := proto.Unmarshal(response, resProto)
+		if err != nil {
+			return err
+		}
+		return stream.Send(resProto)
+	}
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		startTime := time.Now()
+		b, err := proto.Marshal(r)
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+		}
+
+		kafkaRequest, err := g.gateway.InferStream(ctx, resourceName, isModel, b, convertGrpcMetadataToKafkaHeaders(md), g.getRequestId(md), send)
+		elapsedTime := time.Since(startTime).Seconds()
+		if err != nil {
+			go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, codes.FailedPrecondition.String())
+			return status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+		}
+
+		if kafkaRequest.err != nil {
+			code := getErrorCode(kafkaRequest.err)
+			go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, code.String())
+			return status.Errorf(code, "%s", string(createResponseErrorPayload(kafkaRequest.err, kafkaRequest.response)))
+		}
+
+		err = send(kafkaRequest.response)
+		if err != nil {
+			go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, codes.Internal.String())
+			return status.Errorf(codes.Internal, "%s", err.Error())
+		}
+		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeGrpc, elapsedTime, codes.OK.String())
+	}
+}
+
 func (g *GatewayGrpcServer) ServerReady(context.Context, *v2.ServerReadyRequest) (*v2.ServerReadyResponse, error) {
 	return &v2.ServerReadyResponse{Ready: true}, nil
 }
@@ -11,15 +11,19 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
@@ -109,7 +113,7 @@ func TestGrpcServer(t *testing.T) {
 		data: []byte("result"),
 		key:  testRequestId,
 	}
-	grpcServer := NewGatewayGrpcServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil)
+	grpcServer := NewGatewayGrpcServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, nil)
 	go func() {
 		err := grpcServer.Start()
 		g.Expect(err).To(BeNil())
@@ -146,3 +150,71 @@ func TestGrpcServer(t *testing.T) {
 	}
 	grpcServer.Stop()
 }
+
+func TestGrpcServerStream(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	response := func(content string) *v2.ModelInferResponse {
+		return &v2.ModelInferResponse{
+			ModelName: "llm",
+			Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+				{
+					Name:     "content",
+					Datatype: tyBytes,
+					Shape:    []int64{1},
+					Contents: &v2.InferTensorContents{BytesContents: [][]byte{[]byte(content)}},
+				},
+			},
+		}
+	}
+	marshal := func(res *v2.ModelInferResponse) []byte {
+		b, err := proto.Marshal(res)
+		g.Expect(err).To(BeNil())
+		return b
+	}
+
+	port, err := testing_utils.GetFreePortForTest()
+	g.Expect(err).To(BeNil())
+	mockInferer := &fakePipelineInferer{
+		chunks: [][]byte{marshal(response("Hel")), marshal(response("lo"))},
+		data:   marshal(response("!")),
+		key:    "test-id",
+	}
+	grpcServer := NewGatewayGrpcServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, nil)
+	go func() {
+		err := grpcServer.Start()
+		g.Expect(err).To(BeNil())
+	}()
+	waitForServer(port)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(fmt.Sprintf("0.0.0.0:%d", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	g.Expect(err).To(BeNil())
+	client := v2.NewGRPCInferenceServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.TODO(), util.SeldonModelHeader, "chat.pipeline")
+	stream, err := client.ModelStreamInfer(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(stream.Send(&v2.ModelInferRequest{})).To(BeNil())
+	g.Expect(stream.CloseSend()).To(BeNil())
+
+	var contents []string
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		g.Expect(err).To(BeNil())
+		contents = append(contents, string(res.Outputs[0].Contents.BytesContents[0]))
+	}
+	g.Expect(contents).To(Equal([]string{"Hel", "lo", "!"}))
+	g.Expect(mockInferer.resourceName).To(Equal("chat"))
+
+	// an error of the pipeline ends the stream
+	mockInferer.chunks = nil
+	mockInferer.errorModel = errors.New("model error")
+	stream, err = client.ModelStreamInfer(ctx)
+	g.Expect(err).To(BeNil())
+	g.Expect(stream.Send(&v2.ModelInferRequest{})).To(BeNil())
+	_, err = stream.Recv()
+	g.Expect(status.Code(err)).To(Equal(codes.Unknown))
+}
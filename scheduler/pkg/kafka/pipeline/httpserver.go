@@ -10,13 +10,16 @@ the Change License after the Change Date as each is defined in accordance with t
 package pipeline
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -24,9 +27,13 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
 
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/bandit"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator/openai"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
 
@@ -35,8 +42,19 @@ const (
 	v2ModelPathPrefix    = "/v2/models/"
 	v2PipelinePathPrefix = "/v2/pipelines/"
 	healthCheckPath      = "/ready"
+	chatCompletionsPath  = "/v1/chat/completions"
+	embeddingsPath       = "/v1/embeddings"
+	streamKey            = "stream"
+	streamOptionsKey     = "stream_options"
 )
 
+// apiTranslators translate the OpenAI API requests of a pipeline, keyed by the path after /infer, to
+// the OIP inputs of the pipeline and the pipeline outputs back
+var apiTranslators = map[string]translator.Translator{
+	chatCompletionsPath: &openai.OpenAIChatCompletionsTranslator{},
+	embeddingsPath:      &openai.OpenAIEmbeddingsTranslator{},
+}
+
 type GatewayHttpServer struct {
 	port                 int
 	router               *mux.Router
@@ -46,6 +64,9 @@ type GatewayHttpServer struct {
 	metrics              metrics.PipelineMetricsHandler
 	tlsOptions           *util.TLSOptions
 	pipelineReadyChecker status.PipelineReadyChecker
+	feedbackSender       ExperimentFeedbackSender
+	asyncResults         *AsyncResults
+	banditRecorder       *bandit.RequestRecorder
 }
 
 type TLSDetails struct {
@@ -58,7 +79,10 @@ func NewGatewayHttpServer(port int, logger log.FieldLogger,
 	gateway PipelineInferer,
 	metrics metrics.PipelineMetricsHandler,
 	tlsOptions *util.TLSOptions,
-	pipelineReadyChecker status.PipelineReadyChecker) *GatewayHttpServer {
+	pipelineReadyChecker status.PipelineReadyChecker,
+	feedbackSender ExperimentFeedbackSender,
+	asyncResults *AsyncResults,
+	banditRecorder *bandit.RequestRecorder) *GatewayHttpServer {
 	return &GatewayHttpServer{
 		port:                 port,
 		router:               mux.NewRouter(),
@@ -67,6 +91,9 @@ func NewGatewayHttpServer(port int, logger log.FieldLogger,
 		metrics:              metrics,
 		tlsOptions:           tlsOptions,
 		pipelineReadyChecker: pipelineReadyChecker,
+		feedbackSender:       feedbackSender,
+		asyncResults:         asyncResults,
+		banditRecorder:       banditRecorder,
 	}
 }
 
@@ -117,10 +144,28 @@ func (g *GatewayHttpServer) setupRoutes() {
 		v2ModelPathPrefix + "{" + ResourceNameVariable + "}/infer").HandlerFunc(g.inferModel)
 	g.router.NewRoute().Path(
 		v2PipelinePathPrefix + "{" + ResourceNameVariable + "}/infer").HandlerFunc(g.inferPipeline)
+	g.router.NewRoute().Path(
+		v2ModelPathPrefix + "{" + ResourceNameVariable + "}/infer_stream").HandlerFunc(g.inferStream)
+	g.router.NewRoute().Path(
+		v2PipelinePathPrefix + "{" + ResourceNameVariable + "}/infer_stream").HandlerFunc(g.inferStream)
+	for termination := range apiTranslators {
+		g.router.NewRoute().Path(
+			v2ModelPathPrefix + "{" + ResourceNameVariable + "}/infer" + termination).HandlerFunc(g.inferTranslated(termination))
+		g.router.NewRoute().Path(
+			v2PipelinePathPrefix + "{" + ResourceNameVariable + "}/infer" + termination).HandlerFunc(g.inferTranslated(termination))
+	}
 	g.router.NewRoute().Path(
 		v2ModelPathPrefix + "{" + ResourceNameVariable + "}/ready").HandlerFunc(g.pipelineReadyFromModelPath)
 	g.router.NewRoute().Path(
 		v2PipelinePathPrefix + "{" + ResourceNameVariable + "}/ready").HandlerFunc(g.pipelineReadyFromPipelinePath)
+	if g.feedbackSender != nil {
+		g.router.NewRoute().Path(
+			v2ExperimentPathPrefix + "{" + ExperimentNameVariable + "}/feedback").Methods(http.MethodPost).HandlerFunc(g.experimentFeedback)
+	}
+	if g.asyncResults != nil {
+		g.router.NewRoute().Path(
+			v2PipelinePathPrefix + "{" + ResourceNameVariable + "}/requests/{" + RequestIdVariable + "}").Methods(http.MethodGet).HandlerFunc(g.asyncResult)
+	}
 	g.setupHealthRoute()
 }
 
@@ -145,9 +190,23 @@ func (g *GatewayHttpServer) getRequestId(req *http.Request) string {
 	return requestId
 }
 
-func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, resourceName string, isModel bool) {
+// infer sends the OIP request to the pipeline or, for a termination of the path with an API translator,
+// the translated request and translates the response back. Streams are requested by the infer_stream path
+// or by the API request, and OIP requests to pipelines preferring to respond async are processed in the
+// background.
+func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, resourceName string, isModel bool, termination string, stream bool) {
 	logger := g.logger.WithField("func", "infer")
 	startTime := time.Now()
+	apiTranslator := apiTranslators[termination]
+	if apiTranslator != nil {
+		var err error
+		req, stream, err = translateRequestToOIP(req, resourceName, termination, apiTranslator)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to translate request to OIP for resource %s", resourceName)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
 	data, err := io.ReadAll(req.Body)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -159,6 +218,14 @@ func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, reso
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	if stream {
+		g.relayStream(w, req, resourceName, isModel, dataProto, apiTranslator, startTime)
+		return
+	}
+	if g.asyncResults != nil && !isModel && apiTranslator == nil && isAsyncRequest(req) {
+		g.inferAsync(w, req, resourceName, dataProto, startTime)
+		return
+	}
 
 	kafkaRequest, err := g.gateway.Infer(req.Context(), resourceName, isModel, dataProto, convertHttpHeadersToKafkaHeaders(req.Header), g.getRequestId(req))
 	elapsedTime := time.Since(startTime).Seconds()
@@ -170,6 +237,7 @@ func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, reso
 	}
 
 	w.Header().Set(util.RequestIdHeader, kafkaRequest.key)
+	recordBanditRequest(g.banditRecorder, req.Header.Get(util.SeldonBanditHeader), kafkaRequest.key, resourceName, isModel)
 	for k, vals := range convertKafkaHeadersToHttpHeaders(kafkaRequest.headers) {
 		for _, val := range vals {
 			w.Header().Add(k, val)
@@ -178,7 +246,7 @@ func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, reso
 
 	if kafkaRequest.err != nil {
 		logger.WithField("resp_body", kafkaRequest.response).Error("Got upstream error after publishing req")
-		w.WriteHeader(http.StatusBadRequest)
+		w.WriteHeader(getErrorHttpStatus(kafkaRequest.err))
 		_, err = w.Write(createResponseErrorPayload(kafkaRequest.err, kafkaRequest.response))
 		if err != nil {
 			logger.WithError(err).Error("Failed to write error payload")
@@ -193,6 +261,15 @@ func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, reso
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	if apiTranslator != nil {
+		resJson, err = translateResponseFromOIP(w.Header(), resJson, false, apiTranslator)
+		if err != nil {
+			logger.WithError(err).Errorf("Failed to translate response from OIP for resource %s", resourceName)
+			go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusInternalServerError))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
 	_, err = w.Write(resJson)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -201,6 +278,95 @@ func (g *GatewayHttpServer) infer(w http.ResponseWriter, req *http.Request, reso
 	}
 }
 
+// relayStream requests a stream from the pipeline and relays its responses as server sent events as they
+// arrive, translated by the API translator if any. Errors are returned with their status code until the
+// first event is sent, and as the last event after.
+func (g *GatewayHttpServer) relayStream(
+	w http.ResponseWriter,
+	req *http.Request,
+	resourceName string,
+	isModel bool,
+	dataProto []byte,
+	apiTranslator translator.Translator,
+	startTime time.Time,
+) {
+	logger := g.logger.WithField("func", "relayStream")
+	requestId := g.getRequestId(req)
+	recordBanditRequest(g.banditRecorder, req.Header.Get(util.SeldonBanditHeader), requestId, resourceName, isModel)
+	started := false
+	sendEvent := func(response []byte) error {
+		resJson, err := ConvertV2ResponseBytesToJson(response)
+		if err != nil {
+			return err
+		}
+		event := []byte(translator.SSEPrefix + string(resJson) + translator.SSESuffix)
+		if apiTranslator != nil {
+			event, err = translateResponseFromOIP(http.Header{}, resJson, true, apiTranslator)
+			if err != nil {
+				return err
+			}
+		}
+		if !started {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set(util.RequestIdHeader, requestId)
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		_, err = w.Write(event)
+		if err != nil {
+			return err
+		}
+		return http.NewResponseController(w).Flush()
+	}
+
+	kafkaRequest, err := g.gateway.InferStream(req.Context(), resourceName, isModel, dataProto, convertHttpHeadersToKafkaHeaders(req.Header), requestId, sendEvent)
+	errStatus := http.StatusBadRequest
+	if err == nil && kafkaRequest.err != nil {
+		errStatus = getErrorHttpStatus(kafkaRequest.err)
+		err = errors.New(string(createResponseErrorPayload(kafkaRequest.err, kafkaRequest.response)))
+	}
+	if err == nil {
+		err = sendEvent(kafkaRequest.response)
+	}
+	elapsedTime := time.Since(startTime).Seconds()
+
+	switch {
+	case err == nil:
+		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusOK))
+	case !started:
+		logger.WithError(err).Errorf("Failed stream for resource %s", resourceName)
+		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(errStatus))
+		w.WriteHeader(errStatus)
+		_, err = w.Write([]byte(err.Error()))
+		if err != nil {
+			logger.WithError(err).Error("Failed to write error payload")
+		}
+	default:
+		logger.WithError(err).Errorf("Failed stream for resource %s after relaying responses", resourceName)
+		go g.metrics.AddPipelineInferMetrics(resourceName, metrics.MethodTypeRest, elapsedTime, metrics.HttpCodeToString(http.StatusInternalServerError))
+		event, jsonErr := json.Marshal(map[string]string{"error": err.Error()})
+		if jsonErr == nil {
+			_, err = w.Write([]byte(translator.SSEPrefix + string(event) + translator.SSESuffix))
+		}
+		if jsonErr != nil || err != nil {
+			logger.Error("Failed to write error event")
+		}
+	}
+}
+
+// getErrorHttpStatus returns the status of the error of a request: gateway timeout when a model timed out,
+// service unavailable when a model failed otherwise and bad request for the other errors
+func getErrorHttpStatus(err error) int {
+	switch getErrorCode(err) {
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadRequest
+	}
+}
+
 func getResourceFromHeaders(req *http.Request, logger log.FieldLogger) (string, bool, error) {
 	modelHeader := req.Header.Get(util.SeldonModelHeader)
 	// may have multiple header values due to shadow/mirror processing
@@ -223,7 +389,7 @@ func (g *GatewayHttpServer) inferModel(w http.ResponseWriter, req *http.Request)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	g.infer(w, req, resourceName, isModel)
+	g.infer(w, req, resourceName, isModel, "", false)
 }
 
 func (g *GatewayHttpServer) traceReqID(req *http.Request) {
@@ -245,7 +411,79 @@ func (g *GatewayHttpServer) inferPipeline(w http.ResponseWriter, req *http.Reque
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	g.infer(w, req, resourceName, isModel)
+	g.infer(w, req, resourceName, isModel, "", false)
+}
+
+func (g *GatewayHttpServer) inferStream(w http.ResponseWriter, req *http.Request) {
+	g.traceReqID(req)
+
+	logger := g.logger.WithField("func", "inferStream")
+	resourceName, isModel, err := getResourceFromHeaders(req, logger)
+	if err != nil {
+		logger.Error("No header found for pipeline identification")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	g.infer(w, req, resourceName, isModel, "", true)
+}
+
+func (g *GatewayHttpServer) inferTranslated(termination string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		g.traceReqID(req)
+
+		logger := g.logger.WithField("func", "inferTranslated")
+		resourceName, isModel, err := getResourceFromHeaders(req, logger)
+		if err != nil {
+			logger.Error("No header found for pipeline identification")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		g.infer(w, req, resourceName, isModel, termination, false)
+	}
+}
+
+// translateRequestToOIP translates the API request with the path of the resource, as the translators
+// check the model in the request matches the path, and returns whether a stream was requested. The stream
+// fields are removed as streams are requested from the pipeline with a Kafka header instead.
+func translateRequestToOIP(req *http.Request, resourceName string, termination string, apiTranslator translator.Translator) (*http.Request, bool, error) {
+	jsonBody, err := translator.ConvertRequestToJsonBody(req)
+	if err != nil {
+		return nil, false, err
+	}
+	stream, _ := jsonBody[streamKey].(bool)
+	delete(jsonBody, streamKey)
+	delete(jsonBody, streamOptionsKey)
+
+	apiReq, err := translator.NewJsonRequest(req, jsonBody)
+	if err != nil {
+		return nil, false, err
+	}
+	apiReq.URL.Path = v2ModelPathPrefix + resourceName + "/infer" + termination
+	oipReq, err := apiTranslator.TranslateToOIP(apiReq)
+	if err != nil {
+		return nil, false, err
+	}
+	return oipReq, stream, nil
+}
+
+func translateResponseFromOIP(header http.Header, resJson []byte, stream bool, apiTranslator translator.Translator) ([]byte, error) {
+	oipRes := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(resJson)),
+	}
+	if stream {
+		oipRes.Header.Set("Content-Type", "text/event-stream")
+		oipRes.Body = io.NopCloser(strings.NewReader(translator.SSEPrefix + string(resJson) + translator.SSESuffix))
+	}
+
+	res, err := apiTranslator.TranslateFromOIP(oipRes)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	header.Set("Content-Type", res.Header.Get("Content-Type"))
+	return io.ReadAll(res.Body)
 }
 
 func (g *GatewayHttpServer) pipelineReady(w http.ResponseWriter, req *http.Request, resourceName string) {
@@ -36,13 +36,18 @@ import (
 )
 
 type fakePipelineInferer struct {
-	err        error
-	data       []byte
-	key        string
-	errorModel error
+	err          error
+	data         []byte
+	key          string
+	errorModel   error
+	resourceName string
+	request      []byte
+	chunks       [][]byte
 }
 
 func (f *fakePipelineInferer) Infer(ctx context.Context, resourceName string, isModel bool, data []byte, headers []kafka.Header, requestId string) (*Request, error) {
+	f.resourceName = resourceName
+	f.request = data
 	if f.err != nil {
 		return nil, f.err
 	} else {
@@ -50,6 +55,20 @@ func (f *fakePipelineInferer) Infer(ctx context.Context, resourceName string, is
 	}
 }
 
+func (f *fakePipelineInferer) InferStream(ctx context.Context, resourceName string, isModel bool, data []byte, headers []kafka.Header, requestId string, onChunk func([]byte) error) (*Request, error) {
+	f.resourceName = resourceName
+	f.request = data
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, chunk := range f.chunks {
+		if err := onChunk(chunk); err != nil {
+			return nil, err
+		}
+	}
+	return &Request{key: f.key, response: f.data, err: f.errorModel}, nil
+}
+
 func (f *fakePipelineInferer) DeletePipeline(resourceName string, isModel bool) error {
 	return nil
 }
@@ -165,7 +184,7 @@ func TestHttpServer(t *testing.T) {
 				test.setupHealthChecker(mockHealthCheck)
 			}
 
-			httpServer := NewGatewayHttpServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil)
+			httpServer := NewGatewayHttpServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, nil, nil, nil)
 			go func() {
 				err := httpServer.Start()
 				g.Expect(err).To(Equal(http.ErrServerClosed))
@@ -221,3 +240,274 @@ func TestHttpServer(t *testing.T) {
 		})
 	}
 }
+
+func TestHttpServerAPITranslation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	strParameters := map[string]*v2.InferParameter{
+		"content_type": {ParameterChoice: &v2.InferParameter_StringParam{StringParam: "str"}},
+	}
+	chatResponse := &v2.ModelInferResponse{
+		ModelName: "llm",
+		Id:        "1234",
+		Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+			{
+				Name:       "role",
+				Datatype:   tyBytes,
+				Shape:      []int64{1},
+				Parameters: strParameters,
+				Contents:   &v2.InferTensorContents{BytesContents: [][]byte{[]byte("assistant")}},
+			},
+			{
+				Name:       "content",
+				Datatype:   tyBytes,
+				Shape:      []int64{1},
+				Parameters: strParameters,
+				Contents:   &v2.InferTensorContents{BytesContents: [][]byte{[]byte("Hello!")}},
+			},
+		},
+	}
+
+	type test struct {
+		name            string
+		path            string
+		header          string
+		req             string
+		res             *v2.ModelInferResponse
+		statusCode      int
+		contentType     string
+		expectedInputs  []string
+		expectedContent string
+	}
+	tests := []test{
+		{
+			name:            "chat completions",
+			path:            "/v2/pipelines/chat/infer/v1/chat/completions",
+			header:          "chat.pipeline",
+			req:             `{"model":"chat","messages":[{"role":"user","content":"Hello!"}],"temperature":0.5}`,
+			res:             chatResponse,
+			statusCode:      http.StatusOK,
+			contentType:     "application/json",
+			expectedInputs:  []string{"role", "content", "type"},
+			expectedContent: `{"choices":[{"index":0,"message":{"content":"Hello!","role":"assistant"}}],"created":0,"id":"1234","model":"llm","object":"chat.completion"}`,
+		},
+		{
+			name:            "chat completions stream",
+			path:            "/v2/models/chat/infer/v1/chat/completions",
+			header:          "chat.pipeline",
+			req:             `{"model":"chat","messages":[{"role":"user","content":"Hello!"}],"stream":true}`,
+			res:             chatResponse,
+			statusCode:      http.StatusOK,
+			contentType:     "text/event-stream",
+			expectedInputs:  []string{"role", "content", "type"},
+			expectedContent: `data: {"choices":[{"delta":{"content":"Hello!","role":"assistant"},"index":0}],"created":0,"id":"1234","model":"llm","object":"chat.completion.chunk"}` + "\n\n",
+		},
+		{
+			name:   "embeddings",
+			path:   "/v2/pipelines/embed/infer/v1/embeddings",
+			header: "embed.pipeline",
+			req:    `{"model":"embed","input":["Hello!"]}`,
+			res: &v2.ModelInferResponse{
+				ModelName: "embedder",
+				Id:        "1234",
+				Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+					{
+						Name:     "embedding",
+						Datatype: tyFp32,
+						Shape:    []int64{1, 2},
+						Contents: &v2.InferTensorContents{Fp32Contents: []float32{0.5, 0.25}},
+					},
+				},
+			},
+			statusCode:      http.StatusOK,
+			contentType:     "application/json",
+			expectedInputs:  []string{"input"},
+			expectedContent: `{"data":[{"embedding":[0.5,0.25],"index":0,"object":"embedding"}],"model":"embedder","object":"list"}`,
+		},
+		{
+			name:       "model mismatch",
+			path:       "/v2/pipelines/chat/infer/v1/chat/completions",
+			header:     "chat.pipeline",
+			req:        `{"model":"other","messages":[{"role":"user","content":"Hello!"}]}`,
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	port, err := testing_utils.GetFreePortForTest()
+	g.Expect(err).To(BeNil())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b []byte
+			if test.res != nil {
+				b, err = proto.Marshal(test.res)
+				g.Expect(err).To(BeNil())
+			}
+			mockInferer := &fakePipelineInferer{data: b, key: "test-id"}
+			httpServer := NewGatewayHttpServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, nil, nil, nil)
+			go func() {
+				err := httpServer.Start()
+				g.Expect(err).To(Equal(http.ErrServerClosed))
+			}()
+			waitForServer(port)
+			defer func() {
+				err = httpServer.Stop()
+				g.Expect(err).To(BeNil())
+			}()
+
+			url := "http://localhost:" + strconv.Itoa(port) + test.path
+			req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(test.req))
+			g.Expect(err).To(BeNil())
+			req.Header.Set(util.SeldonModelHeader, test.header)
+			resp, err := http.DefaultClient.Do(req)
+			g.Expect(err).To(BeNil())
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(Equal(test.statusCode))
+			if test.statusCode != http.StatusOK {
+				return
+			}
+			g.Expect(resp.Header.Get("Content-Type")).To(Equal(test.contentType))
+
+			inferRequest := &v2.ModelInferRequest{}
+			g.Expect(proto.Unmarshal(mockInferer.request, inferRequest)).To(BeNil())
+			var inputs []string
+			for _, input := range inferRequest.Inputs {
+				inputs = append(inputs, input.Name)
+			}
+			g.Expect(inputs).To(Equal(test.expectedInputs))
+			// streams are not requested from the pipeline
+			for _, param := range inferRequest.Parameters {
+				g.Expect(param.GetStringParam()).ToNot(ContainSubstring("stream"))
+			}
+
+			bResp, err := io.ReadAll(resp.Body)
+			g.Expect(err).To(BeNil())
+			g.Expect(string(bResp)).To(Equal(test.expectedContent))
+		})
+	}
+}
+
+func TestHttpServerStream(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	strParameters := map[string]*v2.InferParameter{
+		"content_type": {ParameterChoice: &v2.InferParameter_StringParam{StringParam: "str"}},
+	}
+	chunk := func(content string) []byte {
+		b, err := proto.Marshal(&v2.ModelInferResponse{
+			ModelName: "llm",
+			Id:        "1234",
+			Outputs: []*v2.ModelInferResponse_InferOutputTensor{
+				{
+					Name:       "role",
+					Datatype:   tyBytes,
+					Shape:      []int64{1},
+					Parameters: strParameters,
+					Contents:   &v2.InferTensorContents{BytesContents: [][]byte{[]byte("assistant")}},
+				},
+				{
+					Name:       "content",
+					Datatype:   tyBytes,
+					Shape:      []int64{1},
+					Parameters: strParameters,
+					Contents:   &v2.InferTensorContents{BytesContents: [][]byte{[]byte(content)}},
+				},
+			},
+		})
+		g.Expect(err).To(BeNil())
+		return b
+	}
+	oipEvent := func(content string) string {
+		resJson, err := ConvertV2ResponseBytesToJson(chunk(content))
+		g.Expect(err).To(BeNil())
+		return "data: " + string(resJson) + "\n\n"
+	}
+	chatEvent := func(content string) string {
+		return `data: {"choices":[{"delta":{"content":"` + content + `","role":"assistant"},"index":0}],"created":0,"id":"1234","model":"llm",` +
+			`"object":"chat.completion.chunk"}` + "\n\n"
+	}
+
+	type test struct {
+		name            string
+		path            string
+		req             string
+		chunks          [][]byte
+		res             []byte
+		errorModel      error
+		statusCode      int
+		expectedContent string
+	}
+	tests := []test{
+		{
+			name:            "oip stream",
+			path:            "/v2/pipelines/chat/infer_stream",
+			req:             `{"inputs":[{"name":"content","datatype":"BYTES","shape":[1],"data":["Hello!"]}]}`,
+			chunks:          [][]byte{chunk("Hel"), chunk("lo")},
+			res:             chunk("!"),
+			statusCode:      http.StatusOK,
+			expectedContent: oipEvent("Hel") + oipEvent("lo") + oipEvent("!"),
+		},
+		{
+			name:            "chat completions stream",
+			path:            "/v2/pipelines/chat/infer/v1/chat/completions",
+			req:             `{"model":"chat","messages":[{"role":"user","content":"Hello!"}],"stream":true}`,
+			chunks:          [][]byte{chunk("Hel"), chunk("lo")},
+			res:             chunk("!"),
+			statusCode:      http.StatusOK,
+			expectedContent: chatEvent("Hel") + chatEvent("lo") + chatEvent("!"),
+		},
+		{
+			name:            "error before the first response",
+			path:            "/v2/pipelines/chat/infer_stream",
+			req:             `{"inputs":[{"name":"content","datatype":"BYTES","shape":[1],"data":["Hello!"]}]}`,
+			res:             []byte("failed"),
+			errorModel:      errors.New("model error"),
+			statusCode:      http.StatusBadRequest,
+			expectedContent: "model error : failed",
+		},
+		{
+			name:            "error after the first response",
+			path:            "/v2/pipelines/chat/infer_stream",
+			req:             `{"inputs":[{"name":"content","datatype":"BYTES","shape":[1],"data":["Hello!"]}]}`,
+			chunks:          [][]byte{chunk("Hel")},
+			res:             []byte("failed"),
+			errorModel:      errors.New("model error"),
+			statusCode:      http.StatusOK,
+			expectedContent: oipEvent("Hel") + `data: {"error":"model error : failed"}` + "\n\n",
+		},
+	}
+
+	port, err := testing_utils.GetFreePortForTest()
+	g.Expect(err).To(BeNil())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockInferer := &fakePipelineInferer{chunks: test.chunks, data: test.res, errorModel: test.errorModel, key: "test-id"}
+			httpServer := NewGatewayHttpServer(port, logrus.New(), mockInferer, fakePipelineMetricsHandler{}, &util.TLSOptions{}, nil, nil, nil, nil)
+			go func() {
+				err := httpServer.Start()
+				g.Expect(err).To(Equal(http.ErrServerClosed))
+			}()
+			waitForServer(port)
+			defer func() {
+				err = httpServer.Stop()
+				g.Expect(err).To(BeNil())
+			}()
+
+			url := "http://localhost:" + strconv.Itoa(port) + test.path
+			req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(test.req))
+			g.Expect(err).To(BeNil())
+			req.Header.Set(util.SeldonModelHeader, "chat.pipeline")
+			resp, err := http.DefaultClient.Do(req)
+			g.Expect(err).To(BeNil())
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(Equal(test.statusCode))
+			if test.statusCode == http.StatusOK {
+				g.Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+			}
+			g.Expect(mockInferer.resourceName).To(Equal("chat"))
+
+			bResp, err := io.ReadAll(resp.Body)
+			g.Expect(err).To(BeNil())
+			g.Expect(string(bResp)).To(Equal(test.expectedContent))
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -34,6 +35,8 @@ import (
 	config_tls "github.com/seldonio/seldon-core/components/tls/v2/pkg/config"
 
 	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
 	seldontracer "github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
 )
@@ -42,10 +45,12 @@ const (
 	pollTimeoutMillisecs           = 1000
 	timeoutWaitForPartitions       = 10 * time.Second
 	maxRequeueAfterPartitionRevoke = 1
+	streamBufferSize               = 1024
 )
 
 var (
 	errPartitionRevoked = errors.New("partition(s) revoked")
+	errStreamTooSlow    = errors.New("stream responses not relayed fast enough")
 )
 
 //go:generate go tool mockgen -source=kafkamanager.go -destination=./mocks/mock_kafkamanager.go -package=mocks PipelineInferer
@@ -60,6 +65,17 @@ type PipelineInferer interface {
 		headers []kafka.Header,
 		requestId string,
 	) (*Request, error)
+	// InferStream requests a stream and calls onChunk with each partial response as it arrives, the last
+	// response of the stream is returned in the request
+	InferStream(
+		ctx context.Context,
+		resourceName string,
+		isModel bool,
+		data []byte,
+		headers []kafka.Header,
+		requestId string,
+		onChunk func(response []byte) error,
+	) (*Request, error)
 }
 
 type KafkaManager struct {
@@ -72,6 +88,7 @@ type KafkaManager struct {
 	tracer               trace.Tracer
 	consumerManager      *ConsumerManager
 	schemaRegistryClient schemaregistry.Client
+	pipelineStatus       status.PipelineStatusProvider
 }
 
 type Pipeline struct {
@@ -90,6 +107,18 @@ type Request struct {
 	response  []byte
 	headers   []kafka.Header
 	err       error
+	// chunks receives the partial responses of a stream and sequence is the sequence number of the next one
+	chunks   chan []byte
+	sequence int
+}
+
+// finish marks the request as done, releasing the goroutine waiting for it. The request lock must be held.
+func (r *Request) finish() {
+	r.active = false
+	if r.chunks != nil {
+		close(r.chunks)
+	}
+	r.wg.Done()
 }
 
 func NewKafkaManager(
@@ -99,6 +128,7 @@ func NewKafkaManager(
 	traceProvider *seldontracer.TracerProvider,
 	maxNumConsumers int,
 	schemaRegistryClient schemaregistry.Client,
+	pipelineStatus status.PipelineStatusProvider,
 ) (*KafkaManager, error) {
 	topicNamer, err := kafka2.NewTopicNamer(namespace, kafkaConfig.TopicPrefix)
 	if err != nil {
@@ -114,6 +144,7 @@ func NewKafkaManager(
 		consumerManager:      NewConsumerManager(namespace, logger, kafkaConfig, maxNumConsumers, tracer, schemaRegistryClient),
 		mu:                   sync.RWMutex{},
 		schemaRegistryClient: schemaRegistryClient,
+		pipelineStatus:       pipelineStatus,
 	}
 
 	err = km.createProducer()
@@ -292,6 +323,50 @@ func (km *KafkaManager) Infer(
 	data []byte,
 	headers []kafka.Header,
 	requestId string,
+) (*Request, error) {
+	return km.inferWithRequeue(ctx, resourceName, isModel, data, headers, requestId, nil)
+}
+
+func (km *KafkaManager) InferStream(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+	onChunk func(response []byte) error,
+) (*Request, error) {
+	streamModel, err := km.getStreamModel(resourceName, isModel)
+	if err != nil {
+		return nil, err
+	}
+	// only the model named by the stream header streams, the other steps of a pipeline respond once
+	headers = append(slices.Clone(headers), kafka.Header{Key: kafka2.TopicStreamHeader, Value: []byte(streamModel)})
+	return km.inferWithRequeue(ctx, resourceName, isModel, data, headers, requestId, onChunk)
+}
+
+// getStreamModel returns the model that streams its partial responses for a stream request, the model itself
+// or the only output step of a pipeline, as the partial responses would be seen as separate outputs by any
+// step reading them
+func (km *KafkaManager) getStreamModel(resourceName string, isModel bool) (string, error) {
+	if isModel {
+		return resourceName, nil
+	}
+	pv := km.pipelineStatus.Get(resourceName)
+	if pv == nil {
+		return "", fmt.Errorf("pipeline %s not found", resourceName)
+	}
+	return pipeline.GetStreamingStep(pv)
+}
+
+func (km *KafkaManager) inferWithRequeue(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+	onChunk func(response []byte) error,
 ) (*Request, error) {
 	reQueueCount := 0
 	for {
@@ -299,9 +374,10 @@ func (km *KafkaManager) Infer(
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
-			resp, err := km.infer(ctx, resourceName, isModel, data, headers, requestId)
+			resp, err := km.infer(ctx, resourceName, isModel, data, headers, requestId, onChunk)
 			if err == nil {
-				if errors.Is(resp.err, errPartitionRevoked) {
+				// a stream can only be re-published if none of its responses have been relayed
+				if errors.Is(resp.err, errPartitionRevoked) && resp.sequence == 0 {
 					// partition has been revoked, so we can not consume the response for the req, we attempt to
 					// re-publish, once a partition is available. If none available we return error 500, envoy will
 					// handle backoff retrying the request.
@@ -328,6 +404,7 @@ func (km *KafkaManager) infer(
 	data []byte,
 	headers []kafka.Header,
 	requestId string,
+	onChunk func(response []byte) error,
 ) (*Request, error) {
 	logger := km.logger.WithField("func", "Infer")
 
@@ -381,6 +458,9 @@ func (km *KafkaManager) infer(
 		key:       compositeKey,
 		partition: partition,
 	}
+	if onChunk != nil {
+		request.chunks = make(chan []byte, streamBufferSize)
+	}
 	pipeline.consumer.requests.Set(compositeKey, request)
 	defer pipeline.consumer.requests.Remove(compositeKey)
 	request.wg.Add(1)
@@ -438,11 +518,54 @@ func (km *KafkaManager) infer(
 		span.End()
 	}()
 	logger.Debugf("Waiting for response for request id %s for resource %s on parititon %d", requestId, resourceName, partition)
+	if onChunk != nil {
+		err = relayChunks(ctx, request, onChunk)
+		if err != nil {
+			return nil, err
+		}
+	}
 	request.wg.Wait()
 	logger.Debugf("Got response for request id %s for resource %s on parition %d", requestId, resourceName, partition)
 	return request, nil
 }
 
+// relayChunks calls onChunk with the partial responses of the stream until its last response arrives
+func relayChunks(ctx context.Context, request *Request, onChunk func(response []byte) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case chunk, ok := <-request.chunks:
+			if !ok {
+				return nil
+			}
+			err := onChunk(chunk)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// extractStreamSequence returns the sequence number of a partial response of a stream, i.e. a response with
+// the sequence header but not the end header
+func extractStreamSequence(headers []kafka.Header) (int, bool) {
+	sequence := -1
+	for _, header := range headers {
+		switch header.Key {
+		case kafka2.TopicStreamEndHeader:
+			return 0, false
+		case kafka2.TopicStreamSequenceHeader:
+			value, err := strconv.Atoi(string(header.Value))
+			if err != nil {
+				return 0, false
+			}
+			sequence = value
+		}
+	}
+	return sequence, sequence >= 0
+}
+
 func extractErrorHeader(headers []kafka.Header) (string, bool) {
 	for _, header := range headers {
 		if header.Key == kafka2.TopicErrorHeader {
@@ -515,12 +638,11 @@ func createRebalanceCb(km *KafkaManager, mtConsumer *MultiTopicsKafkaConsumer) k
 			for _, request := range mtConsumer.requests.Items() {
 				req := request.(*Request)
 				req.mu.Lock()
-				if revokedPartitionSet[req.partition] {
+				if req.active && revokedPartitionSet[req.partition] {
 					logger.Warnf("Revoking request %s for partition %d", req.key, req.partition)
 					req.response = []byte("Request revoked due to partition reassignment")
 					req.err = errPartitionRevoked
-					req.active = false
-					req.wg.Done()
+					req.finish()
 					mtConsumer.requests.Remove(req.key)
 				}
 				req.mu.Unlock()
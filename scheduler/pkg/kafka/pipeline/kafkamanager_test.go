@@ -13,11 +13,15 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 
 	kafka_config "github.com/seldonio/seldon-core/components/kafka/v2/pkg/config"
 
+	kafka2 "github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/tracing"
 )
 
@@ -98,7 +102,7 @@ func TestStoreAndLoadPipeline(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			km, err := NewKafkaManager(logrus.New(), "default", &kafka_config.KafkaConfig{}, tracer, 10, nil)
+			km, err := NewKafkaManager(logrus.New(), "default", &kafka_config.KafkaConfig{}, tracer, 10, nil, status.NewPipelineStatusManager())
 			g.Expect(err).To(BeNil())
 			if test.pipeline != nil {
 				km.pipelines.Store(getPipelineKey(test.resourceName, test.isModel), test.pipeline)
@@ -118,3 +122,144 @@ func TestStoreAndLoadPipeline(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchStreamResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	message := func(value string, headers ...string) *kafka.Message {
+		msg := &kafka.Message{Value: []byte(value)}
+		for i := 0; i < len(headers); i += 2 {
+			msg.Headers = append(msg.Headers, kafka.Header{Key: headers[i], Value: []byte(headers[i+1])})
+		}
+		return msg
+	}
+	newRequest := func(bufferSize int) *Request {
+		request := &Request{active: true, wg: new(sync.WaitGroup), chunks: make(chan []byte, bufferSize)}
+		request.wg.Add(1)
+		return request
+	}
+	received := func(request *Request) []string {
+		var chunks []string
+		for chunk := range request.chunks {
+			chunks = append(chunks, string(chunk))
+		}
+		return chunks
+	}
+	consumer := &MultiTopicsKafkaConsumer{logger: logrus.New()}
+
+	// partial responses are passed on in order and duplicates are dropped
+	request := newRequest(10)
+	consumer.matchResponse(request, message("a", kafka2.TopicStreamSequenceHeader, "0"))
+	consumer.matchResponse(request, message("a", kafka2.TopicStreamSequenceHeader, "0"))
+	consumer.matchResponse(request, message("b", kafka2.TopicStreamSequenceHeader, "1"))
+	g.Expect(request.active).To(BeTrue())
+	consumer.matchResponse(request, message("c", kafka2.TopicStreamSequenceHeader, "2", kafka2.TopicStreamEndHeader, "true"))
+	request.wg.Wait()
+	g.Expect(request.active).To(BeFalse())
+	g.Expect(received(request)).To(Equal([]string{"a", "b"}))
+	g.Expect(string(request.response)).To(Equal("c"))
+	g.Expect(request.sequence).To(Equal(2))
+	g.Expect(request.err).To(BeNil())
+
+	// a response without a sequence number is the complete response
+	request = newRequest(10)
+	consumer.matchResponse(request, message("all"))
+	g.Expect(request.active).To(BeFalse())
+	g.Expect(received(request)).To(BeEmpty())
+	g.Expect(string(request.response)).To(Equal("all"))
+
+	// an error ends the stream
+	request = newRequest(10)
+	consumer.matchResponse(request, message("a", kafka2.TopicStreamSequenceHeader, "0"))
+	consumer.matchResponse(request, message("failed", kafka2.TopicErrorHeader, "model"))
+	g.Expect(request.active).To(BeFalse())
+	g.Expect(received(request)).To(Equal([]string{"a"}))
+	g.Expect(request.err).To(MatchError("model"))
+
+	// a stream which is not relayed fast enough is ended
+	request = newRequest(1)
+	consumer.matchResponse(request, message("a", kafka2.TopicStreamSequenceHeader, "0"))
+	consumer.matchResponse(request, message("b", kafka2.TopicStreamSequenceHeader, "1"))
+	g.Expect(request.active).To(BeFalse())
+	g.Expect(received(request)).To(Equal([]string{"a"}))
+	g.Expect(request.err).To(Equal(errStreamTooSlow))
+}
+
+func TestGetStreamModel(t *testing.T) {
+	type test struct {
+		name          string
+		resourceName  string
+		isModel       bool
+		expectedModel string
+		expectedErr   bool
+	}
+
+	tests := []test{
+		{
+			name:          "model",
+			resourceName:  "llm",
+			isModel:       true,
+			expectedModel: "llm",
+		},
+		{
+			name:          "output step of multi-step pipeline",
+			resourceName:  "rag",
+			expectedModel: "llm",
+		},
+		{
+			name:         "multi-step pipeline joining its outputs",
+			resourceName: "join",
+			expectedErr:  true,
+		},
+		{
+			name:         "multi-step pipeline with output step used by another step",
+			resourceName: "chain",
+			expectedErr:  true,
+		},
+		{
+			name:         "unknown pipeline",
+			resourceName: "other",
+			expectedErr:  true,
+		},
+	}
+
+	statusManager := status.NewPipelineStatusManager()
+	statusManager.Update(&pipeline.PipelineVersion{
+		Name: "rag",
+		Steps: map[string]*pipeline.PipelineStep{
+			"retriever": {Name: "retriever"},
+			"llm":       {Name: "llm", Inputs: []string{"retriever.outputs"}},
+		},
+		Output: &pipeline.PipelineOutput{Steps: []string{"llm.outputs"}},
+	})
+	statusManager.Update(&pipeline.PipelineVersion{
+		Name: "join",
+		Steps: map[string]*pipeline.PipelineStep{
+			"llm":   {Name: "llm"},
+			"guard": {Name: "guard"},
+		},
+		Output: &pipeline.PipelineOutput{Steps: []string{"llm.outputs", "guard.outputs"}},
+	})
+	statusManager.Update(&pipeline.PipelineVersion{
+		Name: "chain",
+		Steps: map[string]*pipeline.PipelineStep{
+			"llm":   {Name: "llm"},
+			"guard": {Name: "guard", Inputs: []string{"llm.outputs"}},
+		},
+		Output: &pipeline.PipelineOutput{Steps: []string{"llm.outputs"}},
+	})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			km := &KafkaManager{pipelineStatus: statusManager}
+			model, err := km.getStreamModel(test.resourceName, test.isModel)
+			if test.expectedErr {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(model).To(Equal(test.expectedModel))
+			}
+		})
+	}
+}
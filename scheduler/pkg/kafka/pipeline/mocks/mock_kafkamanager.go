@@ -79,6 +79,21 @@ func (mr *MockPipelineInfererMockRecorder) Infer(ctx, resourceName, isModel, dat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Infer", reflect.TypeOf((*MockPipelineInferer)(nil).Infer), ctx, resourceName, isModel, data, headers, requestId)
 }
 
+// InferStream mocks base method.
+func (m *MockPipelineInferer) InferStream(ctx context.Context, resourceName string, isModel bool, data []byte, headers []kafka.Header, requestId string, onChunk func([]byte) error) (*pipeline.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InferStream", ctx, resourceName, isModel, data, headers, requestId, onChunk)
+	ret0, _ := ret[0].(*pipeline.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InferStream indicates an expected call of InferStream.
+func (mr *MockPipelineInfererMockRecorder) InferStream(ctx, resourceName, isModel, data, headers, requestId, onChunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InferStream", reflect.TypeOf((*MockPipelineInferer)(nil).InferStream), ctx, resourceName, isModel, data, headers, requestId, onChunk)
+}
+
 // LoadOrStorePipeline mocks base method.
 func (m *MockPipelineInferer) LoadOrStorePipeline(resourceName string, isModel, loadOnly bool) (*pipeline.Pipeline, error) {
 	m.ctrl.T.Helper()
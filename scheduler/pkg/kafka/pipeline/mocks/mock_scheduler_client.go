@@ -51,6 +51,46 @@ func (m *MockSchedulerClient) EXPECT() *MockSchedulerClientMockRecorder {
 	return m.recorder
 }
 
+// ExperimentFeedback mocks base method.
+func (m *MockSchedulerClient) ExperimentFeedback(arg0 context.Context, arg1 *scheduler.ExperimentFeedbackRequest, arg2 ...grpc.CallOption) (*scheduler.ExperimentFeedbackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExperimentFeedback", varargs...)
+	ret0, _ := ret[0].(*scheduler.ExperimentFeedbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExperimentFeedback indicates an expected call of ExperimentFeedback.
+func (mr *MockSchedulerClientMockRecorder) ExperimentFeedback(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExperimentFeedback", reflect.TypeOf((*MockSchedulerClient)(nil).ExperimentFeedback), varargs...)
+}
+
+// ExperimentRequests mocks base method.
+func (m *MockSchedulerClient) ExperimentRequests(arg0 context.Context, arg1 *scheduler.ExperimentRequestsRequest, arg2 ...grpc.CallOption) (*scheduler.ExperimentRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExperimentRequests", varargs...)
+	ret0, _ := ret[0].(*scheduler.ExperimentRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExperimentRequests indicates an expected call of ExperimentRequests.
+func (mr *MockSchedulerClientMockRecorder) ExperimentRequests(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExperimentRequests", reflect.TypeOf((*MockSchedulerClient)(nil).ExperimentRequests), varargs...)
+}
+
 // ExperimentStatus mocks base method.
 func (m *MockSchedulerClient) ExperimentStatus(arg0 context.Context, arg1 *scheduler.ExperimentStatusRequest, arg2 ...grpc.CallOption) (scheduler.Scheduler_ExperimentStatusClient, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +111,26 @@ func (mr *MockSchedulerClientMockRecorder) ExperimentStatus(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExperimentStatus", reflect.TypeOf((*MockSchedulerClient)(nil).ExperimentStatus), varargs...)
 }
 
+// ExplainSchedule mocks base method.
+func (m *MockSchedulerClient) ExplainSchedule(arg0 context.Context, arg1 *scheduler.ExplainScheduleRequest, arg2 ...grpc.CallOption) (*scheduler.ExplainScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainSchedule", varargs...)
+	ret0, _ := ret[0].(*scheduler.ExplainScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainSchedule indicates an expected call of ExplainSchedule.
+func (mr *MockSchedulerClientMockRecorder) ExplainSchedule(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainSchedule", reflect.TypeOf((*MockSchedulerClient)(nil).ExplainSchedule), varargs...)
+}
+
 // LoadModel mocks base method.
 func (m *MockSchedulerClient) LoadModel(arg0 context.Context, arg1 *scheduler.LoadModelRequest, arg2 ...grpc.CallOption) (*scheduler.LoadModelResponse, error) {
 	m.ctrl.T.Helper()
@@ -207,13 +207,7 @@ func (c *MultiTopicsKafkaConsumer) pollAndMatch() error {
 				request.mu.Lock()
 				if request.active {
 					logger.Debugf("Process response for key %s", key)
-					if errMsg, ok := extractErrorHeader(e.Headers); ok {
-						request.err = fmt.Errorf("%s", errMsg)
-					}
-					request.response = e.Value
-					request.headers = e.Headers
-					request.wg.Done()
-					request.active = false
+					c.matchResponse(request, e)
 				} else {
 					logger.Warnf("Got duplicate request with key %s", key)
 				}
@@ -231,6 +225,34 @@ func (c *MultiTopicsKafkaConsumer) pollAndMatch() error {
 	return nil // assumption here is that the connection has already terminated
 }
 
+// matchResponse sets the response of the request and finishes it, unless the response is a partial response
+// of a stream which is passed on to the stream. The request lock must be held.
+func (c *MultiTopicsKafkaConsumer) matchResponse(request *Request, msg *kafka.Message) {
+	errMsg, isError := extractErrorHeader(msg.Headers)
+	if sequence, ok := extractStreamSequence(msg.Headers); ok && !isError && request.chunks != nil {
+		if sequence < request.sequence {
+			c.logger.Warnf("Got duplicate stream response %d for key %s", sequence, request.key)
+			return
+		}
+		select {
+		case request.chunks <- msg.Value:
+			request.sequence = sequence + 1
+			return
+		default:
+			// the poll loop is not blocked by a slow client, whose stream is ended instead
+			request.err = errStreamTooSlow
+			request.finish()
+			return
+		}
+	}
+	if isError {
+		request.err = fmt.Errorf("%s", errMsg)
+	}
+	request.response = msg.Value
+	request.headers = msg.Headers
+	request.finish()
+}
+
 func createBaseContextFromKafkaMsg(msg *kafka.Message) context.Context {
 	// these are just a base context for a new span
 	// callers should add timeout, etc for this context as they see fit.
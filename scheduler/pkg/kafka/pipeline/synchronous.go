@@ -0,0 +1,376 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+// SynchronousInferer runs the synchronous pipelines of the pipeline gateway by walking their steps and
+// calling the models directly over OIP, through envoy, so no Kafka or dataflow engine is involved. Models and
+// the other pipelines are inferred with the wrapped inferer.
+type SynchronousInferer struct {
+	PipelineInferer
+	pipelines   status.PipelineStatusProvider
+	grpcClient  v2.GRPCInferenceServiceClient
+	callOptions []grpc.CallOption
+	logger      logrus.FieldLogger
+}
+
+// tensorSource is a step input or output referenced by a pipeline, <step>.(inputs|outputs), with the
+// tensors referenced or all its tensors when none are
+type tensorSource struct {
+	name    string
+	tensors []string
+}
+
+type synchronousStepResult struct {
+	step     string
+	inputs   []*v2.ModelInferResponse_InferOutputTensor
+	response *v2.ModelInferResponse
+	err      error
+}
+
+func NewSynchronousInferer(
+	logger logrus.FieldLogger,
+	inferer PipelineInferer,
+	pipelines status.PipelineStatusProvider,
+	grpcClient v2.GRPCInferenceServiceClient,
+) *SynchronousInferer {
+	return &SynchronousInferer{
+		PipelineInferer: inferer,
+		pipelines:       pipelines,
+		grpcClient:      grpcClient,
+		callOptions: []grpc.CallOption{
+			grpc.MaxCallSendMsgSize(math.MaxInt32),
+			grpc.MaxCallRecvMsgSize(math.MaxInt32),
+		},
+		logger: logger.WithField("source", "SynchronousInferer"),
+	}
+}
+
+func (s *SynchronousInferer) getSynchronousPipeline(resourceName string, isModel bool) *pipeline.PipelineVersion {
+	if isModel {
+		return nil
+	}
+	pv := s.pipelines.Get(resourceName)
+	// ensembles are always inferred by the ensemble inferer
+	if pv == nil || !pv.IsSynchronous() || pv.Ensemble != nil {
+		return nil
+	}
+	return pv
+}
+
+// LoadOrStorePipeline does not create Kafka consumers for synchronous pipelines as their responses are not
+// read from Kafka
+func (s *SynchronousInferer) LoadOrStorePipeline(resourceName string, isModel bool, loadOnly bool) (*Pipeline, error) {
+	if s.getSynchronousPipeline(resourceName, isModel) != nil {
+		return nil, nil
+	}
+	return s.PipelineInferer.LoadOrStorePipeline(resourceName, isModel, loadOnly)
+}
+
+func (s *SynchronousInferer) Infer(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+) (*Request, error) {
+	pv := s.getSynchronousPipeline(resourceName, isModel)
+	if pv == nil {
+		return s.PipelineInferer.Infer(ctx, resourceName, isModel, data, headers, requestId)
+	}
+	return s.inferSynchronous(ctx, pv, data, headers, requestId)
+}
+
+// InferStream returns the response of a synchronous pipeline as the only response of the stream
+func (s *SynchronousInferer) InferStream(
+	ctx context.Context,
+	resourceName string,
+	isModel bool,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+	onChunk func(response []byte) error,
+) (*Request, error) {
+	pv := s.getSynchronousPipeline(resourceName, isModel)
+	if pv == nil {
+		return s.PipelineInferer.InferStream(ctx, resourceName, isModel, data, headers, requestId, onChunk)
+	}
+	return s.inferSynchronous(ctx, pv, data, headers, requestId)
+}
+
+// inferSynchronous runs the steps of the pipeline in waves: each wave infers in parallel the steps whose
+// inputs and triggers only reference the pipeline inputs and the steps of the previous waves. Steps whose
+// inputs or triggers are not available are skipped, as the dataflow engine would never send them a request.
+func (s *SynchronousInferer) inferSynchronous(
+	ctx context.Context,
+	pv *pipeline.PipelineVersion,
+	data []byte,
+	headers []kafka.Header,
+	requestId string,
+) (*Request, error) {
+	logger := s.logger.WithField("func", "inferSynchronous")
+	req := &v2.ModelInferRequest{}
+	if err := proto.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+	inputs, err := getRequestTensors(req)
+	if err != nil {
+		return &Request{key: requestId, err: err}, nil
+	}
+
+	// the steps still running are cancelled once a step fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tensors := map[string][]*v2.ModelInferResponse_InferOutputTensor{
+		pv.Name + pipeline.StepNameSeperator + pipeline.StepInputSpecifier: inputs,
+	}
+	md := createModelMetadata(headers, requestId)
+	remaining := make(map[string]*pipeline.PipelineStep, len(pv.Steps))
+	for stepName, step := range pv.Steps {
+		remaining[stepName] = step
+	}
+	for len(remaining) > 0 {
+		wave := getNextWave(remaining)
+		if len(wave) == 0 {
+			return &Request{key: requestId, err: fmt.Errorf("pipeline %s has a cycle", pv.Name)}, nil
+		}
+
+		results := make([]synchronousStepResult, 0, len(wave))
+		for _, stepName := range wave {
+			delete(remaining, stepName)
+			stepInputs, ok := getStepInputs(pv.Name, pv.Steps[stepName], tensors)
+			if !ok {
+				logger.Debugf("Skipping step %s of pipeline %s as its inputs or triggers are not available", stepName, pv.Name)
+				continue
+			}
+			results = append(results, synchronousStepResult{step: stepName, inputs: stepInputs})
+		}
+
+		wg := sync.WaitGroup{}
+		failed := sync.Once{}
+		var stepErr error
+		for idx := range results {
+			result := &results[idx]
+			stepReq := &v2.ModelInferRequest{
+				ModelName:  result.step,
+				Id:         req.Id,
+				Parameters: req.Parameters,
+				Inputs:     createInputTensors(result.inputs),
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result.response, result.err = inferModel(ctx, s.grpcClient, s.callOptions, stepReq, md)
+				if result.err != nil {
+					// only the first failure is returned as the others can be caused by the cancellation
+					failed.Do(func() {
+						logger.WithError(result.err).Debugf("Failed infer request to step %s of pipeline %s", result.step, pv.Name)
+						stepErr = &upstreamErr{err: fmt.Errorf("step %s of pipeline %s failed: %w", result.step, pv.Name, result.err)}
+						cancel()
+					})
+				}
+			}()
+		}
+		wg.Wait()
+		if stepErr != nil {
+			return &Request{key: requestId, err: stepErr}, nil
+		}
+
+		for _, result := range results {
+			tensors[result.step+pipeline.StepNameSeperator+pipeline.StepInputSpecifier] = result.inputs
+			tensors[result.step+pipeline.StepNameSeperator+pipeline.StepOutputSpecifier] = result.response.Outputs
+		}
+	}
+
+	if pv.Output == nil {
+		return &Request{key: requestId, err: fmt.Errorf("pipeline %s has no output", pv.Name)}, nil
+	}
+	outputs, ok := joinTensorSources(pv.Output.Steps, pv.Output.TensorMap, pv.Output.StepsJoinType, tensors)
+	if !ok {
+		return &Request{key: requestId, err: fmt.Errorf("pipeline %s has no output as the steps of its output did not run", pv.Name)}, nil
+	}
+	response, err := proto.Marshal(&v2.ModelInferResponse{
+		ModelName: pv.Name,
+		Id:        req.Id,
+		Outputs:   outputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Request{key: requestId, response: response}, nil
+}
+
+// getNextWave returns the remaining steps that do not depend on another remaining step, in alphabetical order
+func getNextWave(remaining map[string]*pipeline.PipelineStep) []string {
+	var wave []string
+	for stepName, step := range remaining {
+		ready := true
+		for _, ref := range slices.Concat(step.Inputs, step.Triggers) {
+			if _, ok := remaining[getStepFromReference(ref)]; ok {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			wave = append(wave, stepName)
+		}
+	}
+	slices.Sort(wave)
+	return wave
+}
+
+// getStepInputs returns the tensors of the request to a step, or false when the step is not triggered or its
+// inputs are not available. Steps without inputs receive the pipeline inputs.
+func getStepInputs(
+	pipelineName string,
+	step *pipeline.PipelineStep,
+	tensors map[string][]*v2.ModelInferResponse_InferOutputTensor,
+) ([]*v2.ModelInferResponse_InferOutputTensor, bool) {
+	if len(step.Triggers) > 0 {
+		if _, ok := joinTensorSources(step.Triggers, nil, step.TriggersJoinType, tensors); !ok {
+			return nil, false
+		}
+	}
+	inputs := step.Inputs
+	if len(inputs) == 0 {
+		inputs = []string{pipelineName + pipeline.StepNameSeperator + pipeline.StepInputSpecifier}
+	}
+	return joinTensorSources(inputs, step.TensorMap, step.InputsJoinType, tensors)
+}
+
+// joinTensorSources returns the tensors referenced from the available sources, renamed with the tensor map,
+// as the dataflow engine joins them: inner needs all the sources, outer takes all the available sources and
+// any takes the first available source. Sources are not available when their step did not run or has none
+// of the tensors referenced.
+func joinTensorSources(
+	refs []string,
+	tensorMap map[string]string,
+	joinType pipeline.JoinType,
+	tensors map[string][]*v2.ModelInferResponse_InferOutputTensor,
+) ([]*v2.ModelInferResponse_InferOutputTensor, bool) {
+	var joined []*v2.ModelInferResponse_InferOutputTensor
+	for _, source := range getTensorSources(refs) {
+		sourceTensors := getSourceTensors(source, tensorMap, tensors)
+		if len(sourceTensors) == 0 {
+			if joinType == pipeline.JoinInner {
+				return nil, false
+			}
+			continue
+		}
+		if joinType == pipeline.JoinAny {
+			return sourceTensors, true
+		}
+		joined = append(joined, sourceTensors...)
+	}
+	return joined, len(joined) > 0
+}
+
+// getTensorSources groups the references, <step>.(inputs|outputs)(.<tensor>)?, by source in the order they
+// are referenced
+func getTensorSources(refs []string) []*tensorSource {
+	var sources []*tensorSource
+	for _, ref := range refs {
+		parts := strings.SplitN(ref, pipeline.StepNameSeperator, 3)
+		if len(parts) < 2 {
+			continue
+		}
+		name := parts[0] + pipeline.StepNameSeperator + parts[1]
+		idx := slices.IndexFunc(sources, func(source *tensorSource) bool { return source.name == name })
+		if idx == -1 {
+			sources = append(sources, &tensorSource{name: name})
+			idx = len(sources) - 1
+		}
+		if len(parts) == 3 {
+			sources[idx].tensors = append(sources[idx].tensors, parts[2])
+		}
+	}
+	return sources
+}
+
+func getSourceTensors(
+	source *tensorSource,
+	tensorMap map[string]string,
+	tensors map[string][]*v2.ModelInferResponse_InferOutputTensor,
+) []*v2.ModelInferResponse_InferOutputTensor {
+	var sourceTensors []*v2.ModelInferResponse_InferOutputTensor
+	for _, tensor := range tensors[source.name] {
+		if len(source.tensors) > 0 && !slices.Contains(source.tensors, tensor.Name) {
+			continue
+		}
+		if name, ok := tensorMap[source.name+pipeline.StepNameSeperator+tensor.Name]; ok {
+			tensor = &v2.ModelInferResponse_InferOutputTensor{
+				Name:       name,
+				Datatype:   tensor.Datatype,
+				Shape:      tensor.Shape,
+				Parameters: tensor.Parameters,
+				Contents:   tensor.Contents,
+			}
+		}
+		sourceTensors = append(sourceTensors, tensor)
+	}
+	return sourceTensors
+}
+
+func getStepFromReference(ref string) string {
+	return strings.Split(ref, pipeline.StepNameSeperator)[0]
+}
+
+// getRequestTensors returns the inputs of the request as output tensors with their raw contents decoded, so
+// the pipeline inputs are handled like the outputs of the steps
+func getRequestTensors(req *v2.ModelInferRequest) ([]*v2.ModelInferResponse_InferOutputTensor, error) {
+	res := &v2.ModelInferResponse{RawOutputContents: req.RawInputContents}
+	for _, input := range req.Inputs {
+		res.Outputs = append(res.Outputs, &v2.ModelInferResponse_InferOutputTensor{
+			Name:       input.Name,
+			Datatype:   input.Datatype,
+			Shape:      input.Shape,
+			Parameters: input.Parameters,
+			Contents:   input.Contents,
+		})
+	}
+	if err := updateResponseFromRawContents(res); err != nil {
+		return nil, err
+	}
+	return res.Outputs, nil
+}
+
+func createInputTensors(tensors []*v2.ModelInferResponse_InferOutputTensor) []*v2.ModelInferRequest_InferInputTensor {
+	inputs := make([]*v2.ModelInferRequest_InferInputTensor, 0, len(tensors))
+	for _, tensor := range tensors {
+		inputs = append(inputs, &v2.ModelInferRequest_InferInputTensor{
+			Name:       tensor.Name,
+			Datatype:   tensor.Datatype,
+			Shape:      tensor.Shape,
+			Parameters: tensor.Parameters,
+			Contents:   tensor.Contents,
+		})
+	}
+	return inputs
+}
@@ -0,0 +1,340 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/kafka/pipeline/status"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
+)
+
+func createSynchronousTestTensor(name string, values ...int32) *v2.ModelInferResponse_InferOutputTensor {
+	return &v2.ModelInferResponse_InferOutputTensor{
+		Name:     name,
+		Datatype: tyInt32,
+		Shape:    []int64{int64(len(values))},
+		Contents: &v2.InferTensorContents{IntContents: values},
+	}
+}
+
+func createSynchronousTestResponse(outputs ...*v2.ModelInferResponse_InferOutputTensor) *v2.ModelInferResponse {
+	return &v2.ModelInferResponse{Outputs: outputs}
+}
+
+func TestSynchronousInferer(t *testing.T) {
+	type test struct {
+		name             string
+		pipeline         *pipeline.PipelineVersion
+		request          *v2.ModelInferRequest
+		responses        map[string]*v2.ModelInferResponse
+		errs             map[string]error
+		expectedRequests map[string][]*v2.ModelInferResponse_InferOutputTensor
+		expectedOutputs  []*v2.ModelInferResponse_InferOutputTensor
+		expectedErrCode  codes.Code
+		expectedFallback bool
+	}
+
+	synchronous := &pipeline.DataflowSpec{Mode: pipeline.DataflowSynchronous}
+	request := &v2.ModelInferRequest{
+		Id: "id",
+		Inputs: []*v2.ModelInferRequest_InferInputTensor{
+			{
+				Name:     "x",
+				Datatype: tyInt32,
+				Shape:    []int64{1},
+				Contents: &v2.InferTensorContents{IntContents: []int32{1}},
+			},
+			{
+				Name:     "y",
+				Datatype: tyInt32,
+				Shape:    []int64{1},
+				Contents: &v2.InferTensorContents{IntContents: []int32{2}},
+			},
+		},
+	}
+	tests := []test{
+		{
+			name: "chain with tensor map",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {
+						Name:      "b",
+						Inputs:    []string{"a.outputs.out"},
+						TensorMap: map[string]string{"a.outputs.out": "in"},
+					},
+				},
+				Output:        &pipeline.PipelineOutput{Steps: []string{"b.outputs"}},
+				DataflowSepec: synchronous,
+			},
+			request: request,
+			responses: map[string]*v2.ModelInferResponse{
+				"a": createSynchronousTestResponse(createSynchronousTestTensor("out", 3), createSynchronousTestTensor("other", 4)),
+				"b": createSynchronousTestResponse(createSynchronousTestTensor("out", 5)),
+			},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"a": {createSynchronousTestTensor("x", 1), createSynchronousTestTensor("y", 2)},
+				"b": {createSynchronousTestTensor("in", 3)},
+			},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				createSynchronousTestTensor("out", 5),
+			},
+		},
+		{
+			name: "raw input contents",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a", Inputs: []string{"p.inputs.x"}},
+				},
+				Output:        &pipeline.PipelineOutput{Steps: []string{"a.inputs"}},
+				DataflowSepec: synchronous,
+			},
+			request: &v2.ModelInferRequest{
+				Id: "id",
+				Inputs: []*v2.ModelInferRequest_InferInputTensor{
+					{Name: "x", Datatype: tyInt32, Shape: []int64{1}},
+				},
+				RawInputContents: [][]byte{{7, 0, 0, 0}},
+			},
+			responses: map[string]*v2.ModelInferResponse{
+				"a": createSynchronousTestResponse(createSynchronousTestTensor("out", 3)),
+			},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"a": {createSynchronousTestTensor("x", 7)},
+			},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				createSynchronousTestTensor("x", 7),
+			},
+		},
+		{
+			name: "inner join",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a", Inputs: []string{"p.inputs.x"}},
+					"b": {Name: "b", Inputs: []string{"p.inputs.y"}},
+					"c": {Name: "c", Inputs: []string{"b.outputs", "a.outputs"}},
+				},
+				Output:        &pipeline.PipelineOutput{Steps: []string{"c.outputs"}},
+				DataflowSepec: synchronous,
+			},
+			request: request,
+			responses: map[string]*v2.ModelInferResponse{
+				"a": createSynchronousTestResponse(createSynchronousTestTensor("outa", 3)),
+				"b": createSynchronousTestResponse(createSynchronousTestTensor("outb", 4)),
+				"c": createSynchronousTestResponse(createSynchronousTestTensor("out", 5)),
+			},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"a": {createSynchronousTestTensor("x", 1)},
+				"b": {createSynchronousTestTensor("y", 2)},
+				"c": {createSynchronousTestTensor("outb", 4), createSynchronousTestTensor("outa", 3)},
+			},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				createSynchronousTestTensor("out", 5),
+			},
+		},
+		{
+			name: "triggers",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"choose": {Name: "choose"},
+					"b": {
+						Name:     "b",
+						Inputs:   []string{"p.inputs"},
+						Triggers: []string{"choose.outputs.choice1"},
+					},
+					"c": {
+						Name:     "c",
+						Inputs:   []string{"p.inputs"},
+						Triggers: []string{"choose.outputs.choice2"},
+					},
+				},
+				Output: &pipeline.PipelineOutput{
+					Steps:         []string{"c.outputs", "b.outputs"},
+					StepsJoinType: pipeline.JoinAny,
+				},
+				DataflowSepec: synchronous,
+			},
+			request: request,
+			responses: map[string]*v2.ModelInferResponse{
+				"choose": createSynchronousTestResponse(createSynchronousTestTensor("choice1", 1)),
+				"b":      createSynchronousTestResponse(createSynchronousTestTensor("out", 5)),
+			},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"choose": {createSynchronousTestTensor("x", 1), createSynchronousTestTensor("y", 2)},
+				"b":      {createSynchronousTestTensor("x", 1), createSynchronousTestTensor("y", 2)},
+			},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				createSynchronousTestTensor("out", 5),
+			},
+		},
+		{
+			name: "outer join of skipped step",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {
+						Name:             "b",
+						Triggers:         []string{"a.outputs.missing", "p.inputs.missing"},
+						TriggersJoinType: pipeline.JoinOuter,
+					},
+				},
+				Output: &pipeline.PipelineOutput{
+					Steps:         []string{"a.outputs", "b.outputs"},
+					StepsJoinType: pipeline.JoinOuter,
+					TensorMap:     map[string]string{"a.outputs.out": "result"},
+				},
+				DataflowSepec: synchronous,
+			},
+			request: request,
+			responses: map[string]*v2.ModelInferResponse{
+				"a": createSynchronousTestResponse(createSynchronousTestTensor("out", 3)),
+			},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"a": {createSynchronousTestTensor("x", 1), createSynchronousTestTensor("y", 2)},
+			},
+			expectedOutputs: []*v2.ModelInferResponse_InferOutputTensor{
+				createSynchronousTestTensor("result", 3),
+			},
+		},
+		{
+			name: "inner join of skipped step",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b", Inputs: []string{"a.outputs.missing"}},
+				},
+				Output:        &pipeline.PipelineOutput{Steps: []string{"a.outputs", "b.outputs"}},
+				DataflowSepec: synchronous,
+			},
+			request: request,
+			responses: map[string]*v2.ModelInferResponse{
+				"a": createSynchronousTestResponse(createSynchronousTestTensor("out", 3)),
+			},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"a": {createSynchronousTestTensor("x", 1), createSynchronousTestTensor("y", 2)},
+			},
+			expectedErrCode: codes.Unknown,
+		},
+		{
+			name: "failed step",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b", Inputs: []string{"a"}},
+				},
+				Output:        &pipeline.PipelineOutput{Steps: []string{"b.outputs"}},
+				DataflowSepec: synchronous,
+			},
+			request: request,
+			errs:    map[string]error{"a": errors.New("failed")},
+			expectedRequests: map[string][]*v2.ModelInferResponse_InferOutputTensor{
+				"a": {createSynchronousTestTensor("x", 1), createSynchronousTestTensor("y", 2)},
+			},
+			expectedErrCode: codes.Unavailable,
+		},
+		{
+			name: "kafka pipeline",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+				},
+				Output: &pipeline.PipelineOutput{Steps: []string{"a.outputs"}},
+			},
+			request:          request,
+			expectedFallback: true,
+		},
+		{
+			name: "synchronous ensemble",
+			pipeline: &pipeline.PipelineVersion{
+				Name: "p",
+				Steps: map[string]*pipeline.PipelineStep{
+					"a": {Name: "a"},
+				},
+				Ensemble:      &pipeline.EnsembleSpec{},
+				DataflowSepec: synchronous,
+			},
+			request:          request,
+			expectedFallback: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			test.pipeline.Version = 1
+			test.pipeline.State = &pipeline.PipelineState{Status: pipeline.PipelineReady}
+			statusManager := status.NewPipelineStatusManager()
+			statusManager.Update(test.pipeline)
+			grpcClient := &fakeModelInferClient{responses: test.responses, errs: test.errs}
+			fallback := &fakePipelineInferer{key: "fallback"}
+			inferer := NewSynchronousInferer(logrus.New(), fallback, statusManager, grpcClient)
+
+			req, err := proto.Marshal(test.request)
+			g.Expect(err).To(BeNil())
+			kafkaRequest, err := inferer.Infer(context.Background(), test.pipeline.Name, false, req, []kafka.Header{}, "req")
+			g.Expect(err).To(BeNil())
+
+			if test.expectedFallback {
+				g.Expect(kafkaRequest.key).To(Equal("fallback"))
+				g.Expect(fallback.resourceName).To(Equal(test.pipeline.Name))
+				g.Expect(grpcClient.models).To(BeEmpty())
+				return
+			}
+			g.Expect(fallback.resourceName).To(BeEmpty())
+			g.Expect(kafkaRequest.key).To(Equal("req"))
+
+			g.Expect(grpcClient.requests).To(HaveLen(len(test.expectedRequests)))
+			for model, inputs := range test.expectedRequests {
+				stepReq := grpcClient.requests[model]
+				g.Expect(stepReq).ToNot(BeNil(), "request to %s", model)
+				g.Expect(stepReq.Id).To(Equal("id"))
+				g.Expect(stepReq.Inputs).To(HaveLen(len(inputs)))
+				for i, input := range createInputTensors(inputs) {
+					g.Expect(proto.Equal(stepReq.Inputs[i], input)).To(BeTrue(), "input %s of %s", input.Name, model)
+				}
+			}
+
+			if test.expectedErrCode != codes.OK {
+				g.Expect(kafkaRequest.err).ToNot(BeNil())
+				g.Expect(getErrorCode(kafkaRequest.err)).To(Equal(test.expectedErrCode))
+				return
+			}
+			g.Expect(kafkaRequest.err).To(BeNil())
+			response := &v2.ModelInferResponse{}
+			g.Expect(proto.Unmarshal(kafkaRequest.response, response)).To(Succeed())
+			g.Expect(response.ModelName).To(Equal(test.pipeline.Name))
+			g.Expect(response.Id).To(Equal("id"))
+			g.Expect(response.Outputs).To(HaveLen(len(test.expectedOutputs)))
+			for i, output := range test.expectedOutputs {
+				g.Expect(proto.Equal(response.Outputs[i], output)).To(BeTrue(), "output %s", output.Name)
+			}
+		})
+	}
+}
@@ -468,6 +468,16 @@ func requestInputParametersToV2(val interface{}) (*v2_dataplane.InferParameter,
 		return &v2_dataplane.InferParameter{
 			ParameterChoice: &v2_dataplane.InferParameter_BoolParam{BoolParam: val.(bool)},
 		}, nil
+	case map[string]interface{}, []interface{}:
+		// objects such as the llm parameters of translated OpenAI requests have no v2 parameter type
+		// so are sent as JSON strings
+		data, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		return &v2_dataplane.InferParameter{
+			ParameterChoice: &v2_dataplane.InferParameter_StringParam{StringParam: string(data)},
+		}, nil
 	default:
 		return nil, fmt.Errorf("Unknown type for parameter %v", ty)
 	}
@@ -58,8 +58,22 @@ func TestRequestInputParametersToV2(t *testing.T) {
 			},
 		},
 		{
-			name:  "invalid",
+			name:  "object",
 			input: `{"parameters":{"foo":{"bar":2}}}`,
+			expected: &v2_dataplane.InferParameter{
+				ParameterChoice: &v2_dataplane.InferParameter_StringParam{StringParam: `{"bar":2}`},
+			},
+		},
+		{
+			name:  "list",
+			input: `{"parameters":{"foo":["bar",2]}}`,
+			expected: &v2_dataplane.InferParameter{
+				ParameterChoice: &v2_dataplane.InferParameter_StringParam{StringParam: `["bar",2]`},
+			},
+		},
+		{
+			name:  "invalid",
+			input: `{"parameters":{"foo":null}}`,
 			error: true,
 		},
 	}
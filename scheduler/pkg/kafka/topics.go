@@ -23,14 +23,27 @@ const (
 	defaultSeldonTopicPrefix = "seldon"
 	modelTopic               = "model"
 	pipelineTopic            = "pipeline"
+	experimentTopic          = "experiment"
 	errorsTopic              = "errors"
 	inputsSuffix             = "inputs"
 	outputsSuffix            = "outputs"
 	errorsSuffix             = "errors"
+	responsesSuffix          = "responses"
+	payloadsSuffix           = "payloads"
+	pipelineGatewayTopic     = "pipelinegateway"
+	asyncResultsSuffix       = "async-results"
 	TopicErrorHeader         = "seldon-pipeline-errors"
 	TopicSeparator           = "."
 )
 
+// Headers of the messages of a stream, requested with the stream header, whose partial responses are
+// numbered with the sequence header and whose last response has the end header
+const (
+	TopicStreamHeader         = "seldon-stream"
+	TopicStreamSequenceHeader = "seldon-stream-sequence"
+	TopicStreamEndHeader      = "seldon-stream-end"
+)
+
 type TopicNamer struct {
 	namespace   string
 	topicPrefix string
@@ -87,6 +100,11 @@ func (tn *TopicNamer) GetModelTopicOutputs(modelName string) string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, modelTopic, modelName, outputsSuffix}, TopicSeparator)
 }
 
+// Topic the agents log the sampled request and response payloads of a model to
+func (tn *TopicNamer) GetModelTopicPayloads(modelName string) string {
+	return strings.Join([]string{tn.topicPrefix, tn.namespace, modelTopic, modelName, payloadsSuffix}, TopicSeparator)
+}
+
 func (tn *TopicNamer) GetPipelineTopicInputs(pipelineName string) string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, pipelineTopic, pipelineName, inputsSuffix}, TopicSeparator)
 }
@@ -95,6 +113,16 @@ func (tn *TopicNamer) GetPipelineTopicOutputs(pipelineName string) string {
 	return strings.Join([]string{tn.topicPrefix, tn.namespace, pipelineTopic, pipelineName, outputsSuffix}, TopicSeparator)
 }
 
+// Topic the pipeline gateway replicas share the results of asynchronous requests on
+func (tn *TopicNamer) GetPipelineGatewayAsyncResultsTopic() string {
+	return strings.Join([]string{tn.topicPrefix, tn.namespace, pipelineGatewayTopic, asyncResultsSuffix}, TopicSeparator)
+}
+
+// Topic the agents write the captured default and mirror responses of an experiment to
+func (tn *TopicNamer) GetExperimentTopicResponses(experimentName string) string {
+	return strings.Join([]string{tn.topicPrefix, tn.namespace, experimentTopic, experimentName, responsesSuffix}, TopicSeparator)
+}
+
 func (tn *TopicNamer) GetModelOrPipelineTopicAndTensor(pipelineName string, stepReference string) (string, *string) {
 	stepName := strings.Split(stepReference, pipeline.StepNameSeperator)[0]
 	stepReference, tensor := tn.getTopicReferenceAndTensor(stepReference)
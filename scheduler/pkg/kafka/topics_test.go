@@ -468,3 +468,105 @@ func TestNewTopicNamer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExperimentTopicResponses(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name           string
+		namespace      string
+		topicPrefix    string
+		experimentName string
+		expectedTopic  string
+	}
+
+	tests := []test{
+		{
+			name:           "default prefix",
+			namespace:      "default",
+			experimentName: "exp1",
+			expectedTopic:  "seldon.default.experiment.exp1.responses",
+		},
+		{
+			name:           "custom prefix",
+			namespace:      "seldon-mesh",
+			topicPrefix:    "myprefix",
+			experimentName: "exp1",
+			expectedTopic:  "myprefix.seldon-mesh.experiment.exp1.responses",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tn, err := NewTopicNamer(test.namespace, test.topicPrefix)
+			g.Expect(err).To(BeNil())
+			g.Expect(tn.GetExperimentTopicResponses(test.experimentName)).To(Equal(test.expectedTopic))
+		})
+	}
+}
+
+func TestGetModelTopicPayloads(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		namespace     string
+		topicPrefix   string
+		modelName     string
+		expectedTopic string
+	}
+
+	tests := []test{
+		{
+			name:          "default prefix",
+			namespace:     "default",
+			modelName:     "iris",
+			expectedTopic: "seldon.default.model.iris.payloads",
+		},
+		{
+			name:          "custom prefix",
+			namespace:     "seldon-mesh",
+			topicPrefix:   "myprefix",
+			modelName:     "iris",
+			expectedTopic: "myprefix.seldon-mesh.model.iris.payloads",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tn, err := NewTopicNamer(test.namespace, test.topicPrefix)
+			g.Expect(err).To(BeNil())
+			g.Expect(tn.GetModelTopicPayloads(test.modelName)).To(Equal(test.expectedTopic))
+		})
+	}
+}
+
+func TestGetPipelineGatewayAsyncResultsTopic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name          string
+		namespace     string
+		topicPrefix   string
+		expectedTopic string
+	}
+
+	tests := []test{
+		{
+			name:          "default prefix",
+			namespace:     "default",
+			expectedTopic: "seldon.default.pipelinegateway.async-results",
+		},
+		{
+			name:          "custom prefix",
+			namespace:     "seldon-mesh",
+			topicPrefix:   "myprefix",
+			expectedTopic: "myprefix.seldon-mesh.pipelinegateway.async-results",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tn, err := NewTopicNamer(test.namespace, test.topicPrefix)
+			g.Expect(err).To(BeNil())
+			g.Expect(tn.GetPipelineGatewayAsyncResultsTopic()).To(Equal(test.expectedTopic))
+		})
+	}
+}
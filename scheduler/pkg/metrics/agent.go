@@ -36,6 +36,8 @@ const (
 	modelInferLatencyCounterName          = "seldon_model_infer_seconds_total"
 	modelAggregateInferCounterName        = "seldon_model_aggregate_infer_total"
 	modelAggregateInferLatencyCounterName = "seldon_model_aggregate_infer_seconds_total"
+	modelResponseCacheHitCounterName      = "seldon_model_response_cache_hit_total"
+	modelResponseCacheMissCounterName     = "seldon_model_response_cache_miss_total"
 )
 
 // Agent metrics
@@ -74,6 +76,7 @@ type AgentMetricsHandler interface {
 	AddModelInferMetrics(externalModelName string, internalModelName string, method string, elapsedTime float64, code string)
 	AddLoadedModelMetrics(internalModelName string, memory uint64, isLoad, isSoft bool)
 	AddServerReplicaMetrics(memory uint64, memoryWithOvercommit float32)
+	AddModelResponseCacheMetrics(externalModelName string, internalModelName string, method string, hit bool)
 }
 
 var (
@@ -90,6 +93,8 @@ type PrometheusMetrics struct {
 	modelInferLatencyCounter                       *prometheus.CounterVec
 	modelAggregateInferCounter                     *prometheus.CounterVec
 	modelAggregateInferLatencyCounter              *prometheus.CounterVec
+	modelResponseCacheHitCounter                   *prometheus.CounterVec
+	modelResponseCacheMissCounter                  *prometheus.CounterVec
 	cacheEvictCounter                              *prometheus.CounterVec
 	cacheMissCounter                               *prometheus.CounterVec
 	loadModelCounter                               *prometheus.CounterVec
@@ -129,6 +134,16 @@ func NewPrometheusModelMetrics(serverName string, serverReplicaIdx uint, logger
 		return nil, err
 	}
 
+	responseCacheHitCounter, err := createModelResponseCacheHitCounter()
+	if err != nil {
+		return nil, err
+	}
+
+	responseCacheMissCounter, err := createModelResponseCacheMissCounter()
+	if err != nil {
+		return nil, err
+	}
+
 	cacheEvictCounter, err := createCacheEvictCounter()
 	if err != nil {
 		return nil, err
@@ -175,22 +190,24 @@ func NewPrometheusModelMetrics(serverName string, serverReplicaIdx uint, logger
 	}
 
 	return &PrometheusMetrics{
-		serverName:                        serverName,
-		serverReplicaIdx:                  fmt.Sprintf("%d", serverReplicaIdx),
-		logger:                            logger.WithField("source", "PrometheusMetrics"),
-		modelHistogram:                    histogram,
-		modelInferCounter:                 inferCounter,
-		modelInferLatencyCounter:          inferLatencyCounter,
-		modelAggregateInferCounter:        aggregateInferCounter,
-		modelAggregateInferLatencyCounter: aggregateInferLatencyCounter,
-		cacheEvictCounter:                 cacheEvictCounter,
-		cacheMissCounter:                  cacheMissCounter,
-		loadModelCounter:                  loadModelCounter,
-		unloadModelCounter:                unloadModelCounter,
-		loadedModelGauge:                  loadedModelGauge,
-		loadedModelMemoryGauge:            loadedModelMemoryGauge,
-		evictedModelMemoryGauge:           evictedModelMemoryGauge,
-		serverReplicaMemoryCapacityGauge:  serverReplicaMemoryCapacityGauge,
+		serverName:                                     serverName,
+		serverReplicaIdx:                               fmt.Sprintf("%d", serverReplicaIdx),
+		logger:                                         logger.WithField("source", "PrometheusMetrics"),
+		modelHistogram:                                 histogram,
+		modelInferCounter:                              inferCounter,
+		modelInferLatencyCounter:                       inferLatencyCounter,
+		modelAggregateInferCounter:                     aggregateInferCounter,
+		modelAggregateInferLatencyCounter:              aggregateInferLatencyCounter,
+		modelResponseCacheHitCounter:                   responseCacheHitCounter,
+		modelResponseCacheMissCounter:                  responseCacheMissCounter,
+		cacheEvictCounter:                              cacheEvictCounter,
+		cacheMissCounter:                               cacheMissCounter,
+		loadModelCounter:                               loadModelCounter,
+		unloadModelCounter:                             unloadModelCounter,
+		loadedModelGauge:                               loadedModelGauge,
+		loadedModelMemoryGauge:                         loadedModelMemoryGauge,
+		evictedModelMemoryGauge:                        evictedModelMemoryGauge,
+		serverReplicaMemoryCapacityGauge:               serverReplicaMemoryCapacityGauge,
 		serverReplicaMemoryCapacityWithOvercommitGauge: serverReplicaMemoryCapacityWithOvercommitGauge,
 	}, nil
 }
@@ -254,6 +271,24 @@ func createModelAggregateInferLatencyCounter() (*prometheus.CounterVec, error) {
 	)
 }
 
+func createModelResponseCacheHitCounter() (*prometheus.CounterVec, error) {
+	labelNames := []string{SeldonServerMetric, SeldonServerReplicaMetric, SeldonModelMetric, SeldonInternalModelMetric, MethodTypeMetric}
+	return createCounterVec(
+		modelResponseCacheHitCounterName,
+		"A count of inference calls served from the response cache",
+		labelNames,
+	)
+}
+
+func createModelResponseCacheMissCounter() (*prometheus.CounterVec, error) {
+	labelNames := []string{SeldonServerMetric, SeldonServerReplicaMetric, SeldonModelMetric, SeldonInternalModelMetric, MethodTypeMetric}
+	return createCounterVec(
+		modelResponseCacheMissCounterName,
+		"A count of inference calls not found in the response cache",
+		labelNames,
+	)
+}
+
 func createCacheEvictCounter() (*prometheus.CounterVec, error) {
 	labelNames := []string{SeldonServerMetric, SeldonServerReplicaMetric}
 	return createCounterVec(
@@ -362,6 +397,20 @@ func (pm *PrometheusMetrics) AddModelInferMetrics(externalModelName string, inte
 	pm.addInferCount(externalModelName, internalModelName, method, code)
 }
 
+func (pm *PrometheusMetrics) AddModelResponseCacheMetrics(externalModelName string, internalModelName string, method string, hit bool) {
+	counter := pm.modelResponseCacheMissCounter
+	if hit {
+		counter = pm.modelResponseCacheHitCounter
+	}
+	counter.With(prometheus.Labels{
+		SeldonModelMetric:         externalModelName,
+		SeldonInternalModelMetric: internalModelName,
+		SeldonServerMetric:        pm.serverName,
+		SeldonServerReplicaMetric: pm.serverReplicaIdx,
+		MethodTypeMetric:          method,
+	}).Inc()
+}
+
 func (pm *PrometheusMetrics) AddLoadedModelMetrics(internalModelName string, memBytes uint64, isLoad, isSoft bool) {
 	if isLoad {
 		if isSoft {
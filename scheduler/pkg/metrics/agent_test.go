@@ -218,6 +218,32 @@ func TestInferModelMetrics(t *testing.T) {
 	promMetrics.modelAggregateInferCounter.Reset()
 }
 
+func TestModelResponseCacheMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	promMetrics, err := createTestPrometheusMetrics()
+	g.Expect(err).To(BeNil())
+
+	modelName := modelNamePrefix + "0"
+	method := "grpc"
+	promMetrics.AddModelResponseCacheMetrics(modelName, modelName, method, false)
+	promMetrics.AddModelResponseCacheMetrics(modelName, modelName, method, true)
+	promMetrics.AddModelResponseCacheMetrics(modelName, modelName, method, true)
+
+	labels := prometheus.Labels{
+		SeldonModelMetric:         modelName,
+		SeldonInternalModelMetric: modelName,
+		MethodTypeMetric:          method,
+		SeldonServerMetric:        serverName,
+		SeldonServerReplicaMetric: strconv.Itoa(serverIdx),
+	}
+	g.Expect(testutil.ToFloat64(promMetrics.modelResponseCacheHitCounter.With(labels))).To(Equal(float64(2)))
+	g.Expect(testutil.ToFloat64(promMetrics.modelResponseCacheMissCounter.With(labels))).To(Equal(float64(1)))
+
+	promMetrics.modelResponseCacheHitCounter.Reset()
+	promMetrics.modelResponseCacheMissCounter.Reset()
+}
+
 func TestServerEarlyStop(t *testing.T) {
 	g := NewGomegaWithT(t)
 
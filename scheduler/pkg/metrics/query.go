@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	prometheusQueryPath = "/api/v1/query"
+	// successful requests are recorded with the grpc code OK or a 2xx http status code
+	successCodesRegex = "OK|2.."
+)
+
+type ModelVersionMetrics struct {
+	Requests    float64
+	ErrorRate   float64
+	MeanLatency time.Duration
+}
+
+// ModelVersionMetricsReader reads back the inference metrics recorded by the agents for a model version
+type ModelVersionMetricsReader interface {
+	GetModelVersionMetrics(ctx context.Context, internalModelName string, window time.Duration) (*ModelVersionMetrics, error)
+}
+
+// PrometheusQueryClient queries the agent model inference counters from the Prometheus server scraping the agents
+type PrometheusQueryClient struct {
+	queryUrl   string
+	httpClient *http.Client
+}
+
+type prometheusQueryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func NewPrometheusQueryClient(prometheusUrl string, timeout time.Duration) *PrometheusQueryClient {
+	return &PrometheusQueryClient{
+		queryUrl:   strings.TrimSuffix(prometheusUrl, "/") + prometheusQueryPath,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (pq *PrometheusQueryClient) GetModelVersionMetrics(ctx context.Context, internalModelName string, window time.Duration) (*ModelVersionMetrics, error) {
+	selector := fmt.Sprintf("%s=%q", SeldonInternalModelMetric, internalModelName)
+	rangeSelector := fmt.Sprintf("[%ds]", int64(window.Seconds()))
+
+	requests, err := pq.query(ctx, fmt.Sprintf("sum(increase(%s{%s}%s))", modelInferCounterName, selector, rangeSelector))
+	if err != nil {
+		return nil, err
+	}
+	metrics := &ModelVersionMetrics{Requests: requests}
+	if requests == 0 {
+		return metrics, nil
+	}
+
+	failed, err := pq.query(ctx, fmt.Sprintf("sum(increase(%s{%s,%s!~%q}%s))", modelInferCounterName, selector, CodeMetric, successCodesRegex, rangeSelector))
+	if err != nil {
+		return nil, err
+	}
+	latency, err := pq.query(ctx, fmt.Sprintf("sum(increase(%s{%s}%s))", modelInferLatencyCounterName, selector, rangeSelector))
+	if err != nil {
+		return nil, err
+	}
+	metrics.ErrorRate = failed / requests
+	metrics.MeanLatency = time.Duration(latency / requests * float64(time.Second))
+	return metrics, nil
+}
+
+// query runs an instant query returning a single value, which is 0 if there are no matching series
+func (pq *PrometheusQueryClient) query(ctx context.Context, query string) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pq.queryUrl+"?"+url.Values{"query": {query}}.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+	res, err := pq.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	queryResponse := prometheusQueryResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&queryResponse); err != nil {
+		return 0, fmt.Errorf("failed to decode response for query %s with status %d: %w", query, res.StatusCode, err)
+	}
+	if queryResponse.Status != "success" {
+		return 0, fmt.Errorf("query %s failed with %s: %s", query, queryResponse.ErrorType, queryResponse.Error)
+	}
+	if len(queryResponse.Data.Result) == 0 {
+		return 0, nil
+	}
+	value := queryResponse.Data.Result[0].Value
+	if len(value) != 2 {
+		return 0, fmt.Errorf("unexpected value %v for query %s", value, query)
+	}
+	valueStr, ok := value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v for query %s", value, query)
+	}
+	return strconv.ParseFloat(valueStr, 64)
+}
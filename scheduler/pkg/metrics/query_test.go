@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetModelVersionMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		values          map[string]string
+		status          string
+		expectedMetrics *ModelVersionMetrics
+		expectedErr     bool
+	}
+
+	tests := []test{
+		{
+			name: "requests with errors",
+			values: map[string]string{
+				modelInferCounterName + "{model_internal=\"model_2\"}":                  "100",
+				modelInferCounterName + "{model_internal=\"model_2\",code!~\"OK|2..\"}": "5",
+				modelInferLatencyCounterName + "{model_internal=\"model_2\"}":           "2",
+			},
+			status:          "success",
+			expectedMetrics: &ModelVersionMetrics{Requests: 100, ErrorRate: 0.05, MeanLatency: 20 * time.Millisecond},
+		},
+		{
+			name:            "no requests",
+			values:          map[string]string{},
+			status:          "success",
+			expectedMetrics: &ModelVersionMetrics{},
+		},
+		{
+			name:        "query error",
+			status:      "error",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.URL.Path).To(Equal(prometheusQueryPath))
+				query := r.URL.Query().Get("query")
+				g.Expect(query).To(HaveSuffix("[300s]))"))
+				result := "[]"
+				for series, value := range test.values {
+					if strings.Contains(query, series+"[") {
+						result = fmt.Sprintf(`[{"metric":{},"value":[1700000000,"%s"]}]`, value)
+					}
+				}
+				_, _ = fmt.Fprintf(w, `{"status":"%s","errorType":"bad_data","error":"failed","data":{"resultType":"vector","result":%s}}`, test.status, result)
+			}))
+			defer server.Close()
+
+			client := NewPrometheusQueryClient(server.URL+"/", time.Second)
+			versionMetrics, err := client.GetModelVersionMetrics(context.Background(), "model_2", 5*time.Minute)
+			if test.expectedErr {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(versionMetrics.Requests).To(Equal(test.expectedMetrics.Requests))
+			g.Expect(versionMetrics.ErrorRate).To(BeNumerically("~", test.expectedMetrics.ErrorRate, 0.0001))
+			g.Expect(versionMetrics.MeanLatency).To(Equal(test.expectedMetrics.MeanLatency))
+		})
+	}
+}
@@ -65,8 +65,13 @@ func (v *VersionCleaner) cleanupOldVersions(modelName string) error {
 	if latest == nil {
 		return fmt.Errorf("Failed to find latest model for %s", modelName)
 	}
+	// the stable version keeps serving traffic until a canary rollout of the latest version has succeeded
+	stableModelVersion := model.GetCanaryStableModel()
 	if latest.ModelState().State == store.ModelAvailable {
 		for _, mv := range model.GetVersionsBeforeLastAvailable() {
+			if stableModelVersion != nil && mv.GetVersion() == stableModelVersion.GetVersion() {
+				continue
+			}
 			_, err := v.store.UnloadVersionModels(modelName, mv.GetVersion())
 			if err != nil {
 				return err
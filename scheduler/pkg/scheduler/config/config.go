@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package config
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/filters"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/sorters"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	SchedulingConfigYamlFilename = "scheduling.yaml"
+	ConfigMapName                = "seldon-scheduling"
+	topologyKeyParam             = "topologyKey"
+)
+
+var (
+	DefaultServerFilters = []PolicyConfig{
+		{Name: filters.ServerReplicaFilter{}.Name()},
+		{Name: filters.SharingServerFilter{}.Name()},
+		{Name: filters.DeletedServerFilter{}.Name()},
+		{Name: filters.ServerRequirementFilter{}.Name()},
+	}
+	DefaultServerSorters = []PolicyConfig{
+		{Name: sorters.ModelAlreadyLoadedOnServerSorter{}.Name()},
+	}
+	DefaultReplicaFilters = []PolicyConfig{
+		{Name: filters.AvailableMemoryReplicaFilter{}.Name()},
+		{Name: filters.ExplainerFilter{}.Name()},
+		{Name: filters.ReplicaDrainingFilter{}.Name()},
+	}
+	DefaultReplicaSorters = []PolicyConfig{
+		{Name: sorters.ReplicaIndexSorter{}.Name()},
+		{Name: sorters.AvailableMemorySorter{}.Name()},
+		{Name: sorters.TopologySpreadSorter{}.Name()},
+		{Name: sorters.ModelAlreadyLoadedSorter{}.Name()},
+	}
+	DefaultReplicaSetFilters = []PolicyConfig{
+		{Name: filters.TopologySpreadFilter{}.Name()},
+	}
+	DefaultSchedulingConfig = SchedulingConfig{
+		ServerFilters:     DefaultServerFilters,
+		ServerSorters:     DefaultServerSorters,
+		ReplicaFilters:    DefaultReplicaFilters,
+		ReplicaSorters:    DefaultReplicaSorters,
+		ReplicaSetFilters: DefaultReplicaSetFilters,
+		TopologyKey:       DefaultTopologyKey,
+	}
+	DefaultTopologyKey = util.TopologyZoneLabel
+)
+
+// SchedulingConfig selects, orders and parameterises the filters and sorters used by the scheduler.
+// Filters and sorters are referred to by the name they are registered with, which for the
+// built-in ones is the value returned by Name().
+type SchedulingConfig struct {
+	ServerFilters  []PolicyConfig `json:"serverFilters,omitempty" yaml:"serverFilters,omitempty"`
+	ServerSorters  []PolicyConfig `json:"serverSorters,omitempty" yaml:"serverSorters,omitempty"`
+	ReplicaFilters []PolicyConfig `json:"replicaFilters,omitempty" yaml:"replicaFilters,omitempty"`
+	ReplicaSorters []PolicyConfig `json:"replicaSorters,omitempty" yaml:"replicaSorters,omitempty"`
+	// Soft constraints on the replicas chosen for a model, e.g. TopologySpreadFilter, which only add a
+	// scheduling warning to the model status when they are not met
+	ReplicaSetFilters []PolicyConfig `json:"replicaSetFilters,omitempty" yaml:"replicaSetFilters,omitempty"`
+	// Topology label the replicas of a model are spread by, given as the topologyKey parameter to the
+	// filters and sorters that do not set it, e.g. TopologySpreadFilter and TopologySpreadSorter
+	TopologyKey string `json:"topologyKey,omitempty" yaml:"topologyKey,omitempty"`
+}
+
+type PolicyConfig struct {
+	Name       string            `json:"name" yaml:"name"`
+	Parameters map[string]string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+}
+
+// SchedulingPolicies are the filters and sorters created from a SchedulingConfig
+type SchedulingPolicies struct {
+	ServerFilters     []filters.ServerFilter
+	ServerSorters     []sorters.ServerSorter
+	ReplicaFilters    []filters.ReplicaFilter
+	ReplicaSorters    []sorters.ReplicaSorter
+	ReplicaSetFilters []filters.ReplicaSetFilter
+}
+
+func (sc *SchedulingConfig) DeepCopy() SchedulingConfig {
+	return SchedulingConfig{
+		ServerFilters:     copyPolicies(sc.ServerFilters),
+		ServerSorters:     copyPolicies(sc.ServerSorters),
+		ReplicaFilters:    copyPolicies(sc.ReplicaFilters),
+		ReplicaSorters:    copyPolicies(sc.ReplicaSorters),
+		ReplicaSetFilters: copyPolicies(sc.ReplicaSetFilters),
+		TopologyKey:       sc.TopologyKey,
+	}
+}
+
+func copyPolicies(policies []PolicyConfig) []PolicyConfig {
+	if policies == nil {
+		return nil
+	}
+	res := make([]PolicyConfig, len(policies))
+	for idx, policy := range policies {
+		res[idx] = PolicyConfig{Name: policy.Name}
+		if policy.Parameters != nil {
+			res[idx].Parameters = make(map[string]string, len(policy.Parameters))
+			for k, v := range policy.Parameters {
+				res[idx].Parameters[k] = v
+			}
+		}
+	}
+	return res
+}
+
+func (sc *SchedulingConfig) Default() SchedulingConfig {
+	return DefaultSchedulingConfig.DeepCopy()
+}
+
+// Policies creates the configured filters and sorters, failing on the first unknown name or invalid parameter
+func (sc *SchedulingConfig) Policies() (*SchedulingPolicies, error) {
+	policies := &SchedulingPolicies{}
+	for _, p := range sc.ServerFilters {
+		filter, err := filters.NewServerFilter(p.Name, sc.parameters(p))
+		if err != nil {
+			return nil, err
+		}
+		policies.ServerFilters = append(policies.ServerFilters, filter)
+	}
+	for _, p := range sc.ServerSorters {
+		sorter, err := sorters.NewServerSorter(p.Name, sc.parameters(p))
+		if err != nil {
+			return nil, err
+		}
+		policies.ServerSorters = append(policies.ServerSorters, sorter)
+	}
+	for _, p := range sc.ReplicaFilters {
+		filter, err := filters.NewReplicaFilter(p.Name, sc.parameters(p))
+		if err != nil {
+			return nil, err
+		}
+		policies.ReplicaFilters = append(policies.ReplicaFilters, filter)
+	}
+	for _, p := range sc.ReplicaSorters {
+		sorter, err := sorters.NewReplicaSorter(p.Name, sc.parameters(p))
+		if err != nil {
+			return nil, err
+		}
+		policies.ReplicaSorters = append(policies.ReplicaSorters, sorter)
+	}
+	for _, p := range sc.ReplicaSetFilters {
+		filter, err := filters.NewReplicaSetFilter(p.Name, sc.parameters(p))
+		if err != nil {
+			return nil, err
+		}
+		policies.ReplicaSetFilters = append(policies.ReplicaSetFilters, filter)
+	}
+	return policies, nil
+}
+
+// parameters of a policy, with the topology key of the config unless the policy sets its own
+func (sc *SchedulingConfig) parameters(p PolicyConfig) map[string]string {
+	if sc.TopologyKey == "" {
+		return p.Parameters
+	}
+	if _, ok := p.Parameters[topologyKeyParam]; ok {
+		return p.Parameters
+	}
+	params := make(map[string]string, len(p.Parameters)+1)
+	for k, v := range p.Parameters {
+		params[k] = v
+	}
+	params[topologyKeyParam] = sc.TopologyKey
+	return params
+}
+
+type SchedulingConfigHandler = config.ConfigWatcher[SchedulingConfig, *SchedulingConfig]
+
+func NewSchedulingConfigHandler(configPath string, namespace string, logger log.FieldLogger) (*SchedulingConfigHandler, error) {
+	return config.NewConfigWatcher(
+		configPath,
+		SchedulingConfigYamlFilename,
+		namespace,
+		false, // watch mounted config file rather than using k8s informer on the config map
+		ConfigMapName,
+		nil,
+		onConfigUpdate,
+		logger.WithField("source", "SchedulingConfigHandler"),
+	)
+}
+
+func onConfigUpdate(config *SchedulingConfig, logger log.FieldLogger) error {
+	// Missing sections are set to their defaults. An empty section on the other hand is
+	// treated as explicitly configuring no filters or sorters of that kind.
+	if config.ServerFilters == nil {
+		config.ServerFilters = copyPolicies(DefaultServerFilters)
+	}
+	if config.ServerSorters == nil {
+		config.ServerSorters = copyPolicies(DefaultServerSorters)
+	}
+	if config.ReplicaFilters == nil {
+		config.ReplicaFilters = copyPolicies(DefaultReplicaFilters)
+	}
+	if config.ReplicaSorters == nil {
+		config.ReplicaSorters = copyPolicies(DefaultReplicaSorters)
+	}
+	if config.ReplicaSetFilters == nil {
+		config.ReplicaSetFilters = copyPolicies(DefaultReplicaSetFilters)
+	}
+	if config.TopologyKey == "" {
+		config.TopologyKey = DefaultTopologyKey
+	}
+	// Reject the update if any policy can not be created, so that the current config is kept
+	_, err := config.Policies()
+	if err != nil {
+		logger.WithError(err).Error("Invalid scheduling config")
+		return err
+	}
+	return nil
+}
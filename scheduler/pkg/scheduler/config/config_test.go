@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/filters"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/sorters"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+func TestSchedulingConfigUpdate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name           string
+		config         string
+		expectedConfig SchedulingConfig
+		err            bool
+	}
+
+	tests := []test{
+		{
+			name:           "empty config uses defaults",
+			config:         "",
+			expectedConfig: DefaultSchedulingConfig,
+		},
+		{
+			name: "missing sections use defaults",
+			config: `
+replicaSorters:
+  - name: AvailableMemorySorter
+    parameters:
+      binPacking: "true"
+`,
+			expectedConfig: SchedulingConfig{
+				ServerFilters:  DefaultServerFilters,
+				ServerSorters:  DefaultServerSorters,
+				ReplicaFilters: DefaultReplicaFilters,
+				ReplicaSorters: []PolicyConfig{
+					{Name: "AvailableMemorySorter", Parameters: map[string]string{"binPacking": "true"}},
+				},
+				ReplicaSetFilters: DefaultReplicaSetFilters,
+				TopologyKey:       DefaultTopologyKey,
+			},
+		},
+		{
+			name: "empty section disables policies",
+			config: `
+serverSorters: []
+`,
+			expectedConfig: SchedulingConfig{
+				ServerFilters:     DefaultServerFilters,
+				ServerSorters:     []PolicyConfig{},
+				ReplicaFilters:    DefaultReplicaFilters,
+				ReplicaSorters:    DefaultReplicaSorters,
+				ReplicaSetFilters: DefaultReplicaSetFilters,
+				TopologyKey:       DefaultTopologyKey,
+			},
+		},
+		{
+			name: "max skew of topology spread",
+			config: `
+replicaSetFilters:
+  - name: TopologySpreadFilter
+    parameters:
+      maxSkew: "2"
+`,
+			expectedConfig: SchedulingConfig{
+				ServerFilters:  DefaultServerFilters,
+				ServerSorters:  DefaultServerSorters,
+				ReplicaFilters: DefaultReplicaFilters,
+				ReplicaSorters: DefaultReplicaSorters,
+				ReplicaSetFilters: []PolicyConfig{
+					{Name: "TopologySpreadFilter", Parameters: map[string]string{"maxSkew": "2"}},
+				},
+				TopologyKey: DefaultTopologyKey,
+			},
+		},
+		{
+			name: "topology key",
+			config: `
+topologyKey: node
+`,
+			expectedConfig: SchedulingConfig{
+				ServerFilters:     DefaultServerFilters,
+				ServerSorters:     DefaultServerSorters,
+				ReplicaFilters:    DefaultReplicaFilters,
+				ReplicaSorters:    DefaultReplicaSorters,
+				ReplicaSetFilters: DefaultReplicaSetFilters,
+				TopologyKey:       "node",
+			},
+		},
+		{
+			name: "unknown filter",
+			config: `
+serverFilters:
+  - name: NotAFilter
+`,
+			err: true,
+		},
+		{
+			name: "invalid parameter",
+			config: `
+replicaSorters:
+  - name: AvailableMemorySorter
+    parameters:
+      binPacking: "maybe"
+`,
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configDir := t.TempDir()
+			configPath := filepath.Join(configDir, SchedulingConfigYamlFilename)
+			err := os.WriteFile(configPath, []byte(test.config), 0644)
+			g.Expect(err).To(BeNil())
+
+			hdl, err := NewSchedulingConfigHandler(configPath, "", log.New())
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			defer func() { _ = hdl.Close() }()
+			g.Expect(hdl.GetConfiguration()).To(Equal(test.expectedConfig))
+		})
+	}
+}
+
+func TestSchedulingConfigPolicies(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policies, err := DefaultSchedulingConfig.Policies()
+	g.Expect(err).To(BeNil())
+	g.Expect(policies.ServerFilters).To(Equal([]filters.ServerFilter{filters.ServerReplicaFilter{}, filters.SharingServerFilter{}, filters.DeletedServerFilter{}, filters.ServerRequirementFilter{}}))
+	g.Expect(policies.ReplicaFilters).To(Equal([]filters.ReplicaFilter{filters.AvailableMemoryReplicaFilter{}, filters.ExplainerFilter{}, filters.ReplicaDrainingFilter{}}))
+	g.Expect(policies.ServerSorters).To(Equal([]sorters.ServerSorter{sorters.ModelAlreadyLoadedOnServerSorter{}}))
+	g.Expect(policies.ReplicaSorters).To(Equal([]sorters.ReplicaSorter{sorters.ReplicaIndexSorter{}, sorters.AvailableMemorySorter{}, sorters.NewTopologySpreadSorter(), sorters.ModelAlreadyLoadedSorter{}}))
+	g.Expect(policies.ReplicaSetFilters).To(Equal([]filters.ReplicaSetFilter{filters.NewTopologySpreadFilter()}))
+
+	binPacking := SchedulingConfig{
+		ReplicaSorters: []PolicyConfig{{Name: "AvailableMemorySorter", Parameters: map[string]string{"binPacking": "true"}}},
+	}
+	policies, err = binPacking.Policies()
+	g.Expect(err).To(BeNil())
+	g.Expect(policies.ServerFilters).To(BeEmpty())
+	g.Expect(policies.ReplicaSorters).To(Equal([]sorters.ReplicaSorter{sorters.AvailableMemorySorter{BinPacking: true}}))
+
+	topologyKey := SchedulingConfig{
+		ReplicaSorters: []PolicyConfig{{Name: "TopologySpreadSorter"}},
+		ReplicaSetFilters: []PolicyConfig{
+			{Name: "TopologySpreadFilter", Parameters: map[string]string{"topologyKey": "rack"}},
+		},
+		TopologyKey: "node",
+	}
+	policies, err = topologyKey.Policies()
+	g.Expect(err).To(BeNil())
+	g.Expect(policies.ReplicaSorters).To(Equal([]sorters.ReplicaSorter{sorters.TopologySpreadSorter{TopologyKey: "node"}}))
+	g.Expect(policies.ReplicaSetFilters).To(Equal([]filters.ReplicaSetFilter{filters.TopologySpreadFilter{TopologyKey: "rack", MaxSkew: filters.DefaultTopologySpreadMaxSkew}}))
+}
+
+type testServerFilter struct{}
+
+func (f testServerFilter) Name() string {
+	return "TestServerFilter"
+}
+
+func (f testServerFilter) Filter(_ *store.ModelVersion, _ *store.ServerSnapshot) bool {
+	return true
+}
+
+func (f testServerFilter) Description(_ *store.ModelVersion, _ *store.ServerSnapshot) string {
+	return ""
+}
+
+func TestSchedulingConfigCustomFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	filters.RegisterServerFilter(testServerFilter{}.Name(), func(_ map[string]string) (filters.ServerFilter, error) {
+		return testServerFilter{}, nil
+	})
+	custom := SchedulingConfig{
+		ServerFilters: []PolicyConfig{{Name: "TestServerFilter"}},
+	}
+	policies, err := custom.Policies()
+	g.Expect(err).To(BeNil())
+	g.Expect(policies.ServerFilters).To(Equal([]filters.ServerFilter{testServerFilter{}}))
+}
@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/sorters"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+var (
+	ErrInvalidExplainRequest = errors.New("invalid explain schedule request")
+	ErrExplainModelNotFound  = errors.New("model not found")
+)
+
+// ExplainSchedule runs the configured filters and sorters for a proposed model against the current
+// servers without changing any state. Unlike scheduling, all filters are evaluated so that every
+// reason a server or replica is rejected is reported.
+func (s *SimpleScheduler) ExplainSchedule(model *pb.Model) (*pb.ExplainScheduleResponse, error) {
+	logger := s.logger.WithField("func", "ExplainSchedule").WithField("model", model.GetMeta().GetName())
+	logger.Debug("Explain schedule for model")
+
+	modelVersion, err := s.proposedModelVersion(model)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := s.store.GetServers(false, true)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulerConfig := s.getSchedulerConfig()
+	res := &pb.ExplainScheduleResponse{
+		ModelName: modelVersion.Key(),
+		Version:   modelVersion.GetVersion(),
+	}
+
+	var acceptedServers []*store.ServerSnapshot
+	var rejectedServers []*pb.ServerScheduleDecision
+	serverDecisions := make(map[string]*pb.ServerScheduleDecision)
+	for _, server := range servers {
+		decision := &pb.ServerScheduleDecision{ServerName: server.Name, Accepted: true}
+		for _, serverFilter := range schedulerConfig.serverFilters {
+			ok := serverFilter.Filter(modelVersion, server)
+			decision.Filters = append(decision.Filters, &pb.FilterDecision{
+				Name:        serverFilter.Name(),
+				Accepted:    ok,
+				Description: serverFilter.Description(modelVersion, server),
+			})
+			decision.Accepted = decision.Accepted && ok
+		}
+		if decision.Accepted {
+			acceptedServers = append(acceptedServers, server)
+			serverDecisions[server.Name] = decision
+		} else {
+			rejectedServers = append(rejectedServers, decision)
+		}
+	}
+	if len(acceptedServers) == 0 {
+		res.Reason = "Failed to schedule model as no matching servers are available"
+		res.Servers = rejectedServers
+		return res, nil
+	}
+
+	s.sortServers(schedulerConfig, modelVersion, acceptedServers)
+	candidates := make([]*sorters.CandidateServer, len(acceptedServers))
+	for idx, server := range acceptedServers {
+		decision := serverDecisions[server.Name]
+		candidates[idx] = s.explainReplicas(schedulerConfig, modelVersion, server, decision)
+		res.Servers = append(res.Servers, decision)
+	}
+	res.Servers = append(res.Servers, rejectedServers...)
+
+	// Choose replicas in the same way as scheduleToServer, first trying for the desired replicas and then for min replicas
+	desiredReplicas := modelVersion.DesiredReplicas()
+	minReplicas := int(modelVersion.GetDeploymentSpec().GetMinReplicas())
+	chosen, numReplicas := findCandidate(candidates, desiredReplicas, desiredReplicas)
+	if chosen == nil && minReplicas > 0 {
+		chosen, numReplicas = findCandidate(candidates, desiredReplicas, minReplicas)
+		if chosen != nil {
+			res.Reason = "No matching server had enough suitable replicas, would schedule with min replicas"
+		}
+	}
+	if chosen == nil {
+		res.Reason = "Failed to schedule model as no matching server had enough suitable replicas"
+		return res, nil
+	}
+
+	replicas := chosen.ChosenReplicas[0:numReplicas]
+	res.ServerName = chosen.Server.Name
+	for _, replica := range replicas {
+		res.ReplicaIdxs = append(res.ReplicaIdxs, int32(replica.GetReplicaIdx()))
+	}
+	for _, replicaSetFilter := range schedulerConfig.replicaSetFilters {
+		res.ReplicaSetFilters = append(res.ReplicaSetFilters, &pb.FilterDecision{
+			Name:        replicaSetFilter.Name(),
+			Accepted:    replicaSetFilter.Filter(modelVersion, chosen.Server, replicas),
+			Description: replicaSetFilter.Description(modelVersion, chosen.Server, replicas),
+		})
+	}
+	return res, nil
+}
+
+// proposedModelVersion returns the model version that would be scheduled if the model was loaded.
+// This mirrors the store, so a change to only the deployment spec keeps the current placement of the latest version.
+// A model without a model spec explains the latest version of the existing model.
+func (s *SimpleScheduler) proposedModelVersion(model *pb.Model) (*store.ModelVersion, error) {
+	modelName := model.GetMeta().GetName()
+	if modelName == "" {
+		return nil, fmt.Errorf("%w: model name is required", ErrInvalidExplainRequest)
+	}
+	existing, err := s.store.GetModel(modelName)
+	if err != nil {
+		return nil, err
+	}
+	var latest *store.ModelVersion
+	if existing != nil {
+		latest = existing.GetLatest()
+	}
+	if model.GetModelSpec() == nil {
+		if latest == nil || existing.Deleted {
+			return nil, fmt.Errorf("%w: %s", ErrExplainModelNotFound, modelName)
+		}
+		proposed := latest.DeepCopy()
+		if model.GetDeploymentSpec() != nil {
+			proposed.SetDeploymentSpec(model.GetDeploymentSpec())
+		}
+		return proposed, nil
+	}
+	if latest == nil || (existing.Deleted && latest.Inactive()) {
+		return store.NewDefaultModelVersion(model, 1), nil
+	}
+	if existing.Deleted {
+		return nil, fmt.Errorf("Model %s is in process of deletion - new model can not be created", modelName)
+	}
+	if store.ModelEqualityCheck(latest.GetModel(), model).ModelSpecDiffers {
+		return store.NewDefaultModelVersion(model, latest.GetVersion()+1), nil
+	}
+	proposed := latest.DeepCopy()
+	proposed.SetDeploymentSpec(model.GetDeploymentSpec())
+	return proposed, nil
+}
+
+// explainReplicas records the replica filter decisions for a server, returning the accepted replicas in sorted order
+func (s *SimpleScheduler) explainReplicas(
+	schedulerConfig SchedulerConfig,
+	model *store.ModelVersion,
+	server *store.ServerSnapshot,
+	decision *pb.ServerScheduleDecision,
+) *sorters.CandidateServer {
+	candidateServer := &sorters.CandidateServer{Model: model, Server: server}
+	replicaDecisions := make(map[int]*pb.ReplicaScheduleDecision)
+	var rejectedReplicas []*pb.ReplicaScheduleDecision
+	for _, replica := range sortedReplicas(server) {
+		replicaDecision := &pb.ReplicaScheduleDecision{ReplicaIdx: int32(replica.GetReplicaIdx()), Accepted: true}
+		for _, replicaFilter := range schedulerConfig.replicaFilters {
+			ok := replicaFilter.Filter(model, replica)
+			replicaDecision.Filters = append(replicaDecision.Filters, &pb.FilterDecision{
+				Name:        replicaFilter.Name(),
+				Accepted:    ok,
+				Description: replicaFilter.Description(model, replica),
+			})
+			replicaDecision.Accepted = replicaDecision.Accepted && ok
+		}
+		if replicaDecision.Accepted {
+			candidateServer.ChosenReplicas = append(candidateServer.ChosenReplicas, replica)
+			replicaDecisions[replica.GetReplicaIdx()] = replicaDecision
+		} else {
+			rejectedReplicas = append(rejectedReplicas, replicaDecision)
+		}
+	}
+
+	s.sortReplicas(schedulerConfig, candidateServer)
+	for _, replica := range candidateServer.ChosenReplicas {
+		decision.Replicas = append(decision.Replicas, replicaDecisions[replica.GetReplicaIdx()])
+	}
+	decision.Replicas = append(decision.Replicas, rejectedReplicas...)
+	return candidateServer
+}
+
+// server replicas are held in a map so are ordered by index to give a stable explanation
+func sortedReplicas(server *store.ServerSnapshot) []*store.ServerReplica {
+	replicas := make([]*store.ServerReplica, 0, len(server.Replicas))
+	for _, replica := range server.Replicas {
+		replicas = append(replicas, replica)
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].GetReplicaIdx() < replicas[j].GetReplicaIdx()
+	})
+	return replicas
+}
+
+func findCandidate(candidates []*sorters.CandidateServer, desiredReplicas, desiredMinReplicas int) (*sorters.CandidateServer, int) {
+	for _, candidate := range candidates {
+		numServerReplicas := len(candidate.ChosenReplicas)
+		if numServerReplicas < desiredMinReplicas {
+			continue
+		}
+		numReplicas := desiredMinReplicas
+		if desiredMinReplicas != desiredReplicas {
+			numReplicas = min(numServerReplicas, desiredReplicas)
+		}
+		return candidate, numReplicas
+	}
+	return nil, 0
+}
@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+func TestExplainSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newTestModel := func(replicas, minReplicas uint32) *pb.Model {
+		memory := uint64(100)
+		return &pb.Model{
+			Meta:           &pb.MetaData{Name: "model1"},
+			ModelSpec:      &pb.ModelSpec{MemoryBytes: &memory, Requirements: []string{"sklearn"}},
+			DeploymentSpec: &pb.DeploymentSpec{Replicas: replicas, MinReplicas: minReplicas, MaxReplicas: replicas},
+		}
+	}
+
+	gsr := func(replicaIdx int, availableMemory uint64, capabilities []string, serverName string) *store.ServerReplica {
+		return store.NewServerReplica("svc", 8080, 5001, replicaIdx, store.NewServer(serverName, true), capabilities, availableMemory, availableMemory, 0, nil, 100)
+	}
+
+	servers := []*store.ServerSnapshot{
+		{
+			Name:             "server1",
+			Replicas:         map[int]*store.ServerReplica{0: gsr(0, 200, []string{"tensorflow"}, "server1")},
+			Shared:           true,
+			ExpectedReplicas: -1,
+		},
+		{
+			Name: "server2",
+			Replicas: map[int]*store.ServerReplica{
+				0: gsr(0, 50, []string{"sklearn"}, "server2"),
+				1: gsr(1, 200, []string{"sklearn"}, "server2"),
+			},
+			Shared:           true,
+			ExpectedReplicas: -1,
+		},
+	}
+
+	type test struct {
+		name              string
+		model             *pb.Model
+		servers           []*store.ServerSnapshot
+		expectedServer    string
+		expectedReplicas  []int32
+		expectedReason    string
+		expectedAccepted  map[string]bool
+		expectedReplicaOk map[int32]bool
+	}
+
+	tests := []test{
+		{
+			name:              "schedules on suitable replica",
+			model:             newTestModel(1, 0),
+			servers:           servers,
+			expectedServer:    "server2",
+			expectedReplicas:  []int32{1},
+			expectedAccepted:  map[string]bool{"server1": false, "server2": true},
+			expectedReplicaOk: map[int32]bool{0: false, 1: true},
+		},
+		{
+			name:              "not enough replicas",
+			model:             newTestModel(2, 0),
+			servers:           servers,
+			expectedReason:    "Failed to schedule model as no matching server had enough suitable replicas",
+			expectedAccepted:  map[string]bool{"server1": false, "server2": true},
+			expectedReplicaOk: map[int32]bool{0: false, 1: true},
+		},
+		{
+			name:              "schedules with min replicas",
+			model:             newTestModel(2, 1),
+			servers:           servers,
+			expectedServer:    "server2",
+			expectedReplicas:  []int32{1},
+			expectedReason:    "No matching server had enough suitable replicas, would schedule with min replicas",
+			expectedAccepted:  map[string]bool{"server1": false, "server2": true},
+			expectedReplicaOk: map[int32]bool{0: false, 1: true},
+		},
+		{
+			name:             "no matching servers",
+			model:            newTestModel(1, 0),
+			servers:          servers[0:1],
+			expectedReason:   "Failed to schedule model as no matching servers are available",
+			expectedAccepted: map[string]bool{"server1": false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockStore := &mockStore{
+				models:  map[string]*store.ModelSnapshot{},
+				servers: test.servers,
+			}
+			scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+			res, err := scheduler.ExplainSchedule(test.model)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.ModelName).To(Equal("model1"))
+			g.Expect(res.Version).To(Equal(uint32(1)))
+			g.Expect(res.ServerName).To(Equal(test.expectedServer))
+			g.Expect(res.ReplicaIdxs).To(Equal(test.expectedReplicas))
+			g.Expect(res.Reason).To(Equal(test.expectedReason))
+
+			g.Expect(res.Servers).To(HaveLen(len(test.expectedAccepted)))
+			for _, serverDecision := range res.Servers {
+				g.Expect(serverDecision.Accepted).To(Equal(test.expectedAccepted[serverDecision.ServerName]))
+				// all filters are evaluated, not just up to the first rejection
+				g.Expect(serverDecision.Filters).To(HaveLen(4))
+				if !serverDecision.Accepted {
+					g.Expect(serverDecision.Replicas).To(BeEmpty())
+					continue
+				}
+				g.Expect(serverDecision.Replicas).To(HaveLen(len(test.expectedReplicaOk)))
+				for _, replicaDecision := range serverDecision.Replicas {
+					g.Expect(replicaDecision.Accepted).To(Equal(test.expectedReplicaOk[replicaDecision.ReplicaIdx]))
+					g.Expect(replicaDecision.Filters).To(HaveLen(3))
+				}
+			}
+
+			// explaining must not change the store
+			g.Expect(mockStore.scheduledServer).To(Equal(""))
+			g.Expect(mockStore.scheduledReplicas).To(BeNil())
+		})
+	}
+}
+
+func TestExplainScheduleExistingModel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memory := uint64(100)
+	existing := &pb.Model{
+		Meta:           &pb.MetaData{Name: "model1"},
+		ModelSpec:      &pb.ModelSpec{MemoryBytes: &memory, Requirements: []string{"sklearn"}},
+		DeploymentSpec: &pb.DeploymentSpec{Replicas: 1},
+	}
+	mockStore := &mockStore{
+		models: map[string]*store.ModelSnapshot{
+			"model1": {
+				Name: "model1",
+				Versions: []*store.ModelVersion{
+					store.NewModelVersion(existing, 3, "server1", map[int]store.ReplicaStatus{0: {State: store.Available}}, false, store.ModelAvailable),
+				},
+			},
+		},
+	}
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+
+	// a deployment spec change keeps the version being scheduled
+	scaled := &pb.Model{
+		Meta:           existing.Meta,
+		ModelSpec:      existing.ModelSpec,
+		DeploymentSpec: &pb.DeploymentSpec{Replicas: 2},
+	}
+	res, err := scheduler.ExplainSchedule(scaled)
+	g.Expect(err).To(BeNil())
+	g.Expect(res.Version).To(Equal(uint32(3)))
+	// the store copy is not changed
+	g.Expect(mockStore.models["model1"].GetLatest().DesiredReplicas()).To(Equal(1))
+
+	// a model spec change creates a new version
+	newMemory := uint64(200)
+	updated := &pb.Model{
+		Meta:           existing.Meta,
+		ModelSpec:      &pb.ModelSpec{MemoryBytes: &newMemory, Requirements: []string{"sklearn"}},
+		DeploymentSpec: existing.DeploymentSpec,
+	}
+	res, err = scheduler.ExplainSchedule(updated)
+	g.Expect(err).To(BeNil())
+	g.Expect(res.Version).To(Equal(uint32(4)))
+
+	// a model without a model spec explains the existing model
+	res, err = scheduler.ExplainSchedule(&pb.Model{Meta: existing.Meta})
+	g.Expect(err).To(BeNil())
+	g.Expect(res.Version).To(Equal(uint32(3)))
+
+	_, err = scheduler.ExplainSchedule(&pb.Model{Meta: &pb.MetaData{Name: "model2"}})
+	g.Expect(errors.Is(err, ErrExplainModelNotFound)).To(BeTrue())
+
+	_, err = scheduler.ExplainSchedule(&pb.Model{})
+	g.Expect(errors.Is(err, ErrInvalidExplainRequest)).To(BeTrue())
+}
@@ -22,3 +22,11 @@ type ServerFilter interface {
 	Filter(model *store.ModelVersion, server *store.ServerSnapshot) bool
 	Description(model *store.ModelVersion, server *store.ServerSnapshot) string
 }
+
+// ReplicaSetFilter checks the replicas chosen for a model on a server as a whole, e.g. how they are spread.
+// It is a soft constraint: the model is still scheduled and the description is surfaced as a scheduling warning.
+type ReplicaSetFilter interface {
+	Name() string
+	Filter(model *store.ModelVersion, server *store.ServerSnapshot, replicas []*store.ServerReplica) bool
+	Description(model *store.ModelVersion, server *store.ServerSnapshot, replicas []*store.ServerReplica) string
+}
@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package filters
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	topologyKeyParam = "topologyKey"
+	maxSkewParam     = "maxSkew"
+)
+
+// Factories create a filter from the (optional) parameters given for it in the scheduling config
+type ServerFilterFactory func(params map[string]string) (ServerFilter, error)
+
+type ReplicaFilterFactory func(params map[string]string) (ReplicaFilter, error)
+
+type ReplicaSetFilterFactory func(params map[string]string) (ReplicaSetFilter, error)
+
+var (
+	registryMu                sync.RWMutex
+	serverFilterFactories     = map[string]ServerFilterFactory{}
+	replicaFilterFactories    = map[string]ReplicaFilterFactory{}
+	replicaSetFilterFactories = map[string]ReplicaSetFilterFactory{}
+)
+
+func init() {
+	registerBuiltinServerFilter(ServerReplicaFilter{})
+	registerBuiltinServerFilter(SharingServerFilter{})
+	registerBuiltinServerFilter(DeletedServerFilter{})
+	registerBuiltinServerFilter(ServerRequirementFilter{})
+
+	registerBuiltinReplicaFilter(AvailableMemoryReplicaFilter{})
+	registerBuiltinReplicaFilter(ExplainerFilter{})
+	registerBuiltinReplicaFilter(ReplicaDrainingFilter{})
+
+	RegisterReplicaSetFilter(TopologySpreadFilter{}.Name(), newTopologySpreadFilter)
+}
+
+func registerBuiltinServerFilter(filter ServerFilter) {
+	RegisterServerFilter(filter.Name(), func(_ map[string]string) (ServerFilter, error) {
+		return filter, nil
+	})
+}
+
+func registerBuiltinReplicaFilter(filter ReplicaFilter) {
+	RegisterReplicaFilter(filter.Name(), func(_ map[string]string) (ReplicaFilter, error) {
+		return filter, nil
+	})
+}
+
+func newTopologySpreadFilter(params map[string]string) (ReplicaSetFilter, error) {
+	filter := NewTopologySpreadFilter()
+	if v, ok := params[topologyKeyParam]; ok {
+		filter.TopologyKey = v
+	}
+	if v, ok := params[maxSkewParam]; ok {
+		maxSkew, err := strconv.Atoi(v)
+		if err != nil || maxSkew < 1 {
+			return nil, fmt.Errorf("invalid %s parameter %q for %s, must be a positive integer", maxSkewParam, v, filter.Name())
+		}
+		filter.MaxSkew = maxSkew
+	}
+	return filter, nil
+}
+
+// RegisterServerFilter makes a server filter available to the scheduling config under the given name.
+// Registering a name that already exists replaces the previous factory.
+func RegisterServerFilter(name string, factory ServerFilterFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	serverFilterFactories[name] = factory
+}
+
+// RegisterReplicaFilter makes a replica filter available to the scheduling config under the given name.
+// Registering a name that already exists replaces the previous factory.
+func RegisterReplicaFilter(name string, factory ReplicaFilterFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	replicaFilterFactories[name] = factory
+}
+
+// RegisterReplicaSetFilter makes a replica set filter available to the scheduling config under the given name.
+// Registering a name that already exists replaces the previous factory.
+func RegisterReplicaSetFilter(name string, factory ReplicaSetFilterFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	replicaSetFilterFactories[name] = factory
+}
+
+func NewServerFilter(name string, params map[string]string) (ServerFilter, error) {
+	registryMu.RLock()
+	factory, ok := serverFilterFactories[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown server filter %s, registered server filters are %v", name, ServerFilterNames())
+	}
+	return factory(params)
+}
+
+func NewReplicaFilter(name string, params map[string]string) (ReplicaFilter, error) {
+	registryMu.RLock()
+	factory, ok := replicaFilterFactories[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown replica filter %s, registered replica filters are %v", name, ReplicaFilterNames())
+	}
+	return factory(params)
+}
+
+func ServerFilterNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(serverFilterFactories))
+	for name := range serverFilterFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ReplicaFilterNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(replicaFilterFactories))
+	for name := range replicaFilterFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func NewReplicaSetFilter(name string, params map[string]string) (ReplicaSetFilter, error) {
+	registryMu.RLock()
+	factory, ok := replicaSetFilterFactories[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown replica set filter %s, registered replica set filters are %v", name, ReplicaSetFilterNames())
+	}
+	return factory(params)
+}
+
+func ReplicaSetFilterNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(replicaSetFilterFactories))
+	for name := range replicaSetFilterFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
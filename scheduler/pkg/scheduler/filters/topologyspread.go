@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package filters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	DefaultTopologySpreadMaxSkew = 1
+)
+
+// TopologySpreadFilter checks that the replicas chosen for a model are spread across the topology domains
+// (zones by default) of the server, i.e. the difference between the number of replicas in the most and least used
+// domain is at most MaxSkew. Servers whose replicas do not report the topology label are spread across nodes
+// instead. Server replicas without the label and draining replicas are ignored.
+type TopologySpreadFilter struct {
+	TopologyKey string
+	MaxSkew     int
+}
+
+func NewTopologySpreadFilter() TopologySpreadFilter {
+	return TopologySpreadFilter{
+		TopologyKey: util.TopologyZoneLabel,
+		MaxSkew:     DefaultTopologySpreadMaxSkew,
+	}
+}
+
+func (t TopologySpreadFilter) Name() string {
+	return "TopologySpreadFilter"
+}
+
+func (t TopologySpreadFilter) Filter(model *store.ModelVersion, server *store.ServerSnapshot, replicas []*store.ServerReplica) bool {
+	return t.skew(server, replicas) <= t.MaxSkew
+}
+
+func (t TopologySpreadFilter) Description(model *store.ModelVersion, server *store.ServerSnapshot, replicas []*store.ServerReplica) string {
+	return fmt.Sprintf(
+		"model replicas per %s [%s] have skew %d, max skew is %d",
+		t.topologyKey(server), showDomainCounts(t.domainCounts(server, replicas)), t.skew(server, replicas), t.MaxSkew)
+}
+
+// topologyKey is the configured key, or the node label if no replica of the server reports it
+func (t TopologySpreadFilter) topologyKey(server *store.ServerSnapshot) string {
+	for _, replica := range server.Replicas {
+		if _, ok := replica.GetTopologyLabels()[t.TopologyKey]; ok {
+			return t.TopologyKey
+		}
+	}
+	return util.TopologyNodeLabel
+}
+
+func (t TopologySpreadFilter) domainCounts(server *store.ServerSnapshot, replicas []*store.ServerReplica) map[string]int {
+	key := t.topologyKey(server)
+	counts := make(map[string]int)
+	for _, replica := range server.Replicas {
+		if domain, ok := replica.GetTopologyLabels()[key]; ok && !replica.GetIsDraining() {
+			counts[domain] = 0
+		}
+	}
+	for _, replica := range replicas {
+		if domain, ok := replica.GetTopologyLabels()[key]; ok {
+			counts[domain]++
+		}
+	}
+	return counts
+}
+
+func (t TopologySpreadFilter) skew(server *store.ServerSnapshot, replicas []*store.ServerReplica) int {
+	counts := t.domainCounts(server, replicas)
+	if len(counts) < 2 {
+		return 0
+	}
+	first := true
+	var minCount, maxCount int
+	for _, count := range counts {
+		if first || count < minCount {
+			minCount = count
+		}
+		if first || count > maxCount {
+			maxCount = count
+		}
+		first = false
+	}
+	return maxCount - minCount
+}
+
+func showDomainCounts(counts map[string]int) string {
+	domains := make([]string, 0, len(counts))
+	for domain := range counts {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	var sb strings.Builder
+	for idx, domain := range domains {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(fmt.Sprintf("%s:%d", domain, counts[domain]))
+	}
+	return sb.String()
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package filters
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+func TestTopologySpreadFilter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name     string
+		zones    []string // zone of each server replica, "" for no topology label
+		nodes    []string // node of each server replica if set, "" for no node label
+		draining []int
+		chosen   []int
+		maxSkew  int
+		expected bool
+	}
+
+	tests := []test{
+		{name: "SpreadAcrossZones", zones: []string{"a", "b", "a", "b"}, chosen: []int{0, 1}, maxSkew: 1, expected: true},
+		{name: "SameZone", zones: []string{"a", "b", "a", "b"}, chosen: []int{0, 2}, maxSkew: 1, expected: false},
+		{name: "SameZoneWithinMaxSkew", zones: []string{"a", "b", "a", "b"}, chosen: []int{0, 2}, maxSkew: 2, expected: true},
+		{name: "UnevenNumberOfReplicas", zones: []string{"a", "b", "c", "a"}, chosen: []int{0, 1, 3}, maxSkew: 1, expected: false},
+		{name: "OneZone", zones: []string{"a", "a"}, chosen: []int{0, 1}, maxSkew: 1, expected: true},
+		{name: "NoTopologyLabels", zones: []string{"", "", ""}, chosen: []int{0, 1}, maxSkew: 1, expected: true},
+		{name: "NodesWithoutZones", zones: []string{"", "", ""}, nodes: []string{"n1", "n2", "n1"}, chosen: []int{0, 2}, maxSkew: 1, expected: false},
+		{name: "NodesWithoutZonesSpread", zones: []string{"", "", ""}, nodes: []string{"n1", "n2", "n1"}, chosen: []int{0, 1}, maxSkew: 1, expected: true},
+		{name: "ZonesPreferredOverNodes", zones: []string{"a", "b", "a"}, nodes: []string{"n1", "n2", "n3"}, chosen: []int{0, 2}, maxSkew: 1, expected: false},
+		{name: "DrainingZoneIgnored", zones: []string{"a", "b", "a"}, draining: []int{1}, chosen: []int{0, 2}, maxSkew: 1, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &store.ServerSnapshot{Name: "server", Replicas: map[int]*store.ServerReplica{}}
+			for idx, zone := range test.zones {
+				config := &agent.ReplicaConfig{TopologyLabels: map[string]string{}}
+				if zone != "" {
+					config.TopologyLabels["zone"] = zone
+				}
+				if test.nodes != nil && test.nodes[idx] != "" {
+					config.TopologyLabels["node"] = test.nodes[idx]
+				}
+				server.Replicas[idx] = store.NewServerReplicaFromConfig(store.NewServer("server", true), idx, nil, config, 0)
+			}
+			for _, idx := range test.draining {
+				server.Replicas[idx].SetIsDraining()
+			}
+			var chosen []*store.ServerReplica
+			for _, idx := range test.chosen {
+				chosen = append(chosen, server.Replicas[idx])
+			}
+			filter := TopologySpreadFilter{TopologyKey: "zone", MaxSkew: test.maxSkew}
+			ok := filter.Filter(nil, server, chosen)
+			g.Expect(ok).To(Equal(test.expected))
+		})
+	}
+}
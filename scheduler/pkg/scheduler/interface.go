@@ -9,7 +9,12 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package scheduler
 
+import (
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
 type Scheduler interface {
 	Schedule(modelKey string) error
 	ScheduleFailedModels() ([]string, error)
+	ExplainSchedule(model *pb.Model) (*pb.ExplainScheduleResponse, error)
 }
@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/filters"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+const (
+	pendingEventsQueueSize     = 1000
+	preemptionEventHandlerName = "scheduler.preemption.models"
+	// preemptionTimeout is how long a model waits for its preempted models to be unloaded before it can preempt again
+	preemptionTimeout = 5 * time.Minute
+)
+
+// preemption is the set of lower priority model versions evicted to make room for a model.
+// Memory is only released once the evicted models have been unloaded by the agents,
+// after which the failed models are rescheduled.
+type preemption struct {
+	server    string
+	victims   map[string]uint32
+	startTime time.Time
+}
+
+type preemptionCandidate struct {
+	replica *store.ServerReplica
+	victims []*store.ModelVersion
+}
+
+func getPriority(model *store.ModelVersion) int32 {
+	return model.GetDeploymentSpec().GetPriority()
+}
+
+// isPreempting returns true if the model is waiting for preempted models to be unloaded.
+// Preemptions which have not completed within the timeout are dropped, so the model can be scheduled again.
+func (s *SimpleScheduler) isPreempting(modelName string) bool {
+	s.muPreemptions.Lock()
+	defer s.muPreemptions.Unlock()
+	p, ok := s.preemptions[modelName]
+	if !ok {
+		return false
+	}
+	if time.Since(p.startTime) > preemptionTimeout {
+		s.logger.WithField("model", modelName).WithField("server", p.server).Warn("Timed out waiting for preempted models to be unloaded")
+		delete(s.preemptions, modelName)
+		return false
+	}
+	return true
+}
+
+func (s *SimpleScheduler) clearPreemption(modelName string) {
+	s.muPreemptions.Lock()
+	defer s.muPreemptions.Unlock()
+	delete(s.preemptions, modelName)
+}
+
+// preempt evicts lower priority models from the replicas of the first server where this would make enough
+// room for the model. It returns true if the model is waiting for evicted models to be unloaded.
+func (s *SimpleScheduler) preempt(schedulerConfig SchedulerConfig, servers []*store.ServerSnapshot, model *store.ModelVersion, desiredReplicas, minReplicas int) bool {
+	logger := s.logger.WithField("func", "preempt").WithField("model", model.Key())
+	if s.isPreempting(model.Key()) {
+		logger.Debug("Model already waiting for preempted models to be unloaded")
+		return true
+	}
+
+	priority := getPriority(model)
+	for _, server := range servers {
+		candidates := s.preemptionCandidates(schedulerConfig, model, server, priority)
+		numReplicas := desiredReplicas
+		if len(candidates) < desiredReplicas {
+			if minReplicas == 0 || len(candidates) < minReplicas {
+				continue
+			}
+			numReplicas = len(candidates)
+		}
+
+		// only the chosen replicas are freed, the victims stay loaded on any other replicas
+		victims := make(map[string]*store.ModelVersion)
+		victimReplicas := make(map[string][]int)
+		for _, candidate := range candidates[0:numReplicas] {
+			for _, victim := range candidate.victims {
+				victims[victim.Key()] = victim
+				victimReplicas[victim.Key()] = append(victimReplicas[victim.Key()], candidate.replica.GetReplicaIdx())
+			}
+		}
+		if len(victims) == 0 {
+			continue
+		}
+
+		p := &preemption{server: server.Name, victims: make(map[string]uint32, len(victims)), startTime: time.Now()}
+		for name, victim := range victims {
+			p.victims[name] = victim.GetVersion()
+		}
+		// register before unloading so the unload events can not be missed
+		s.muPreemptions.Lock()
+		s.preemptions[model.Key()] = p
+		s.muPreemptions.Unlock()
+
+		for name, victim := range victims {
+			logger.
+				WithField("server", server.Name).
+				WithField("replicas", victimReplicas[name]).
+				WithField("victim", name).
+				WithField("victim_priority", getPriority(victim)).
+				WithField("priority", priority).
+				Info("Preempting lower priority model")
+			if _, err := s.store.UnloadVersionModelReplicas(name, victim.GetVersion(), victimReplicas[name]); err != nil {
+				// the model would otherwise wait for an unload that will not happen
+				logger.WithError(err).Warnf("Failed to unload preempted model %s version %d", name, victim.GetVersion())
+				s.clearPreemption(model.Key())
+				return false
+			}
+			// the failure is published on the event hub and the model will be picked up by ScheduleFailedModels
+			msg := fmt.Sprintf("Model preempted by higher priority model %s", model.Key())
+			if err := s.store.FailedScheduling(name, victim.GetVersion(), msg, false); err != nil {
+				logger.WithError(err).Warnf("Failed to mark preempted model %s as failed", name)
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// preemptionCandidates returns the replicas of a server that the model could be scheduled on if lower priority
+// models were evicted, ordered so that replicas needing the fewest evictions come first
+func (s *SimpleScheduler) preemptionCandidates(schedulerConfig SchedulerConfig, model *store.ModelVersion, server *store.ServerSnapshot, priority int32) []*preemptionCandidate {
+	var candidates []*preemptionCandidate
+	for _, replica := range sortedReplicas(server) {
+		ok := true
+		for _, replicaFilter := range schedulerConfig.replicaFilters {
+			// memory is what preemption makes available
+			if _, isMemoryFilter := replicaFilter.(filters.AvailableMemoryReplicaFilter); isMemoryFilter {
+				continue
+			}
+			if !replicaFilter.Filter(model, replica) {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+		if victims, ok := s.findVictims(model, replica, priority); ok {
+			candidates = append(candidates, &preemptionCandidate{replica: replica, victims: victims})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].victims) < len(candidates[j].victims)
+	})
+	return candidates
+}
+
+// findVictims chooses the lowest priority, and then largest, models to evict from a replica until the model fits
+func (s *SimpleScheduler) findVictims(model *store.ModelVersion, replica *store.ServerReplica, priority int32) ([]*store.ModelVersion, bool) {
+	required := int64(model.GetRequiredMemory())
+	available := int64(replica.GetAvailableMemory()) - int64(replica.GetReservedMemory())
+	if required <= available {
+		return nil, true
+	}
+
+	var lowerPriority []*store.ModelVersion
+	for _, modelVersionID := range replica.GetLoadedOrLoadingModelVersions() {
+		if modelVersionID.Name == model.Key() {
+			continue
+		}
+		loaded, err := s.store.GetModel(modelVersionID.Name)
+		if err != nil || loaded == nil {
+			continue
+		}
+		loadedVersion := loaded.GetVersion(modelVersionID.Version)
+		if loadedVersion == nil || getPriority(loadedVersion) >= priority {
+			continue
+		}
+		if !loadedVersion.GetModelReplicaState(replica.GetReplicaIdx()).IsLoadingOrLoaded() {
+			continue
+		}
+		lowerPriority = append(lowerPriority, loadedVersion)
+	}
+	sort.SliceStable(lowerPriority, func(i, j int) bool {
+		if getPriority(lowerPriority[i]) != getPriority(lowerPriority[j]) {
+			return getPriority(lowerPriority[i]) < getPriority(lowerPriority[j])
+		}
+		return lowerPriority[i].GetRequiredMemory() > lowerPriority[j].GetRequiredMemory()
+	})
+
+	var victims []*store.ModelVersion
+	for _, victim := range lowerPriority {
+		victims = append(victims, victim)
+		available += int64(victim.GetRequiredMemory())
+		if required <= available {
+			return victims, true
+		}
+	}
+	return nil, false
+}
+
+// handlePreemptedModelEvents reschedules failed models, highest priority first, once all the models preempted
+// for a model have been unloaded
+func (s *SimpleScheduler) handlePreemptedModelEvents(event coordinator.ModelEventMsg) {
+	if !s.preemptionCompleted(event.ModelName, event.ModelVersion) {
+		return
+	}
+	go func() {
+		if _, err := s.ScheduleFailedModels(); err != nil {
+			s.logger.WithError(err).Warn("Failed to schedule failed models after preemption")
+		}
+	}()
+}
+
+func (s *SimpleScheduler) preemptionCompleted(modelName string, version uint32) bool {
+	s.muPreemptions.Lock()
+	defer s.muPreemptions.Unlock()
+
+	completed := false
+	for preemptor, p := range s.preemptions {
+		victimVersion, ok := p.victims[modelName]
+		if !ok || victimVersion != version {
+			continue
+		}
+		if s.isUnloading(modelName, version) {
+			continue
+		}
+		delete(p.victims, modelName)
+		if len(p.victims) == 0 {
+			s.logger.WithField("model", preemptor).WithField("server", p.server).Debug("Preempted models unloaded")
+			delete(s.preemptions, preemptor)
+			completed = true
+		}
+	}
+	return completed
+}
+
+func (s *SimpleScheduler) isUnloading(modelName string, version uint32) bool {
+	model, err := s.store.GetModel(modelName)
+	if err != nil || model == nil {
+		return false
+	}
+	modelVersion := model.GetVersion(version)
+	if modelVersion == nil {
+		return false
+	}
+	for _, replicaStatus := range modelVersion.ReplicaState() {
+		switch replicaStatus.State {
+		case store.UnloadEnvoyRequested, store.UnloadRequested, store.Unloading:
+			return true
+		}
+	}
+	return false
+}
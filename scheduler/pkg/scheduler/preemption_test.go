@@ -0,0 +1,265 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+func newPriorityModel(name string, memory uint64, priority int32, state store.ModelReplicaState) *store.ModelSnapshot {
+	config := &pb.Model{
+		Meta:           &pb.MetaData{Name: name},
+		ModelSpec:      &pb.ModelSpec{MemoryBytes: &memory},
+		DeploymentSpec: &pb.DeploymentSpec{Replicas: 1, Priority: priority},
+	}
+	return &store.ModelSnapshot{
+		Name: name,
+		Versions: []*store.ModelVersion{
+			store.NewModelVersion(config, 1, "server1", map[int]store.ReplicaStatus{0: {State: state}}, false, store.ModelAvailable),
+		},
+	}
+}
+
+func TestPreempt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name            string
+		loaded          []*store.ModelSnapshot
+		availableMemory uint64
+		model           *store.ModelSnapshot
+		expectedVictims map[string]uint32
+	}
+
+	tests := []test{
+		{
+			name: "evicts lower priority model",
+			loaded: []*store.ModelSnapshot{
+				newPriorityModel("low", 400, 0, store.Available),
+				newPriorityModel("high", 500, 10, store.Available),
+			},
+			availableMemory: 100,
+			model:           newPriorityModel("model", 300, 5, store.ModelReplicaStateUnknown),
+			expectedVictims: map[string]uint32{"low": 1},
+		},
+		{
+			name: "does not evict equal priority model",
+			loaded: []*store.ModelSnapshot{
+				newPriorityModel("low", 400, 5, store.Available),
+			},
+			availableMemory: 100,
+			model:           newPriorityModel("model", 300, 5, store.ModelReplicaStateUnknown),
+		},
+		{
+			name: "not enough memory from lower priority models",
+			loaded: []*store.ModelSnapshot{
+				newPriorityModel("low", 400, 0, store.Available),
+				newPriorityModel("high", 500, 10, store.Available),
+			},
+			availableMemory: 100,
+			model:           newPriorityModel("model", 800, 5, store.ModelReplicaStateUnknown),
+		},
+		{
+			name: "evicts lowest priority first",
+			loaded: []*store.ModelSnapshot{
+				newPriorityModel("low1", 100, -1, store.Available),
+				newPriorityModel("low2", 300, 0, store.Available),
+				newPriorityModel("low3", 300, 1, store.Available),
+			},
+			availableMemory: 100,
+			model:           newPriorityModel("model", 450, 5, store.ModelReplicaStateUnknown),
+			expectedVictims: map[string]uint32{"low1": 1, "low2": 1},
+		},
+		{
+			name: "ignores models already unloading",
+			loaded: []*store.ModelSnapshot{
+				newPriorityModel("low", 400, 0, store.Unloading),
+			},
+			availableMemory: 100,
+			model:           newPriorityModel("model", 300, 5, store.ModelReplicaStateUnknown),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			models := map[string]*store.ModelSnapshot{test.model.Name: test.model}
+			loadedModels := map[store.ModelVersionID]bool{}
+			for _, loaded := range test.loaded {
+				models[loaded.Name] = loaded
+				loadedModels[store.ModelVersionID{Name: loaded.Name, Version: 1}] = true
+			}
+			server := &store.ServerSnapshot{
+				Name: "server1",
+				Replicas: map[int]*store.ServerReplica{
+					0: store.NewServerReplica("svc", 8080, 5001, 0, store.NewServer("server1", true), []string{}, 1000, test.availableMemory, 0, loadedModels, 100),
+				},
+				Shared:           true,
+				ExpectedReplicas: -1,
+			}
+			mockStore := &mockStore{
+				models:           models,
+				servers:          []*store.ServerSnapshot{server},
+				unloadedModels:   map[string]uint32{},
+				unloadedReplicas: map[string][]int{},
+			}
+			scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+
+			preempted := scheduler.preempt(scheduler.getSchedulerConfig(), mockStore.servers, test.model.GetLatest(), 1, 0)
+			if test.expectedVictims == nil {
+				g.Expect(preempted).To(BeFalse())
+				g.Expect(mockStore.unloadedModels).To(BeEmpty())
+				g.Expect(scheduler.isPreempting(test.model.Name)).To(BeFalse())
+				return
+			}
+			g.Expect(preempted).To(BeTrue())
+			g.Expect(mockStore.unloadedModels).To(Equal(test.expectedVictims))
+			for name := range test.expectedVictims {
+				g.Expect(mockStore.unloadedReplicas[name]).To(Equal([]int{0}))
+			}
+			g.Expect(scheduler.preemptions[test.model.Name].victims).To(Equal(test.expectedVictims))
+
+			// a model waiting on a preemption does not evict anything else
+			mockStore.unloadedModels = map[string]uint32{}
+			g.Expect(scheduler.preempt(scheduler.getSchedulerConfig(), mockStore.servers, test.model.GetLatest(), 1, 0)).To(BeTrue())
+			g.Expect(mockStore.unloadedModels).To(BeEmpty())
+		})
+	}
+}
+
+func TestPreemptOnlyChosenReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	memory := uint64(400)
+	victim := &store.ModelSnapshot{
+		Name: "low",
+		Versions: []*store.ModelVersion{
+			store.NewModelVersion(
+				&pb.Model{
+					Meta:           &pb.MetaData{Name: "low"},
+					ModelSpec:      &pb.ModelSpec{MemoryBytes: &memory},
+					DeploymentSpec: &pb.DeploymentSpec{Replicas: 2},
+				},
+				1, "server1",
+				map[int]store.ReplicaStatus{0: {State: store.Available}, 1: {State: store.Available}},
+				false, store.ModelAvailable),
+		},
+	}
+	model := newPriorityModel("model", 300, 5, store.ModelReplicaStateUnknown)
+	loadedModels := map[store.ModelVersionID]bool{{Name: "low", Version: 1}: true}
+	server := &store.ServerSnapshot{
+		Name: "server1",
+		Replicas: map[int]*store.ServerReplica{
+			0: store.NewServerReplica("svc", 8080, 5001, 0, store.NewServer("server1", true), []string{}, 1000, 100, 0, loadedModels, 100),
+			1: store.NewServerReplica("svc", 8080, 5001, 1, store.NewServer("server1", true), []string{}, 1000, 100, 0, loadedModels, 100),
+		},
+		Shared:           true,
+		ExpectedReplicas: -1,
+	}
+	mockStore := &mockStore{
+		models:           map[string]*store.ModelSnapshot{"low": victim, "model": model},
+		servers:          []*store.ServerSnapshot{server},
+		unloadedModels:   map[string]uint32{},
+		unloadedReplicas: map[string][]int{},
+	}
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+
+	g.Expect(scheduler.preempt(scheduler.getSchedulerConfig(), mockStore.servers, model.GetLatest(), 1, 0)).To(BeTrue())
+	g.Expect(mockStore.unloadedReplicas).To(Equal(map[string][]int{"low": {0}}))
+}
+
+func TestPreemptUnloadFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	model := newPriorityModel("model", 300, 5, store.ModelReplicaStateUnknown)
+	loadedModels := map[store.ModelVersionID]bool{{Name: "low", Version: 1}: true}
+	server := &store.ServerSnapshot{
+		Name: "server1",
+		Replicas: map[int]*store.ServerReplica{
+			0: store.NewServerReplica("svc", 8080, 5001, 0, store.NewServer("server1", true), []string{}, 1000, 100, 0, loadedModels, 100),
+		},
+		Shared:           true,
+		ExpectedReplicas: -1,
+	}
+	mockStore := &mockStore{
+		models: map[string]*store.ModelSnapshot{
+			"low":   newPriorityModel("low", 400, 0, store.Available),
+			"model": model,
+		},
+		servers:   []*store.ServerSnapshot{server},
+		unloadErr: fmt.Errorf("failed to find model low"),
+	}
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+
+	g.Expect(scheduler.preempt(scheduler.getSchedulerConfig(), mockStore.servers, model.GetLatest(), 1, 0)).To(BeFalse())
+	g.Expect(scheduler.isPreempting("model")).To(BeFalse())
+}
+
+func TestPreemptionTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mockStore := &mockStore{}
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+	scheduler.preemptions["model1"] = &preemption{server: "server1", victims: map[string]uint32{"low": 1}, startTime: time.Now()}
+	scheduler.preemptions["model2"] = &preemption{server: "server1", victims: map[string]uint32{"low": 1}, startTime: time.Now().Add(-2 * preemptionTimeout)}
+
+	g.Expect(scheduler.isPreempting("model1")).To(BeTrue())
+	g.Expect(scheduler.isPreempting("model2")).To(BeFalse())
+	g.Expect(scheduler.preemptions).ToNot(HaveKey("model2"))
+}
+
+func TestPreemptionCompleted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	victim1 := newPriorityModel("low1", 100, 0, store.Unloading)
+	victim2 := newPriorityModel("low2", 100, 0, store.Unloaded)
+	mockStore := &mockStore{
+		models: map[string]*store.ModelSnapshot{"low1": victim1, "low2": victim2},
+	}
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+	scheduler.preemptions["model"] = &preemption{server: "server1", victims: map[string]uint32{"low1": 1, "low2": 1}, startTime: time.Now()}
+
+	g.Expect(scheduler.preemptionCompleted("other", 1)).To(BeFalse())
+	g.Expect(scheduler.preemptionCompleted("low1", 1)).To(BeFalse())
+	g.Expect(scheduler.preemptionCompleted("low2", 1)).To(BeFalse())
+	g.Expect(scheduler.preemptions["model"].victims).To(Equal(map[string]uint32{"low1": 1}))
+
+	victim1.GetLatest().SetReplicaState(0, store.Unloaded, "")
+	g.Expect(scheduler.preemptionCompleted("low1", 1)).To(BeTrue())
+	g.Expect(scheduler.isPreempting("model")).To(BeFalse())
+}
+
+func TestGetFailedModelsByPriority(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mockStore := &mockStore{
+		models: map[string]*store.ModelSnapshot{
+			"low":    newPriorityModel("low", 100, -1, store.LoadFailed),
+			"high":   newPriorityModel("high", 100, 10, store.LoadFailed),
+			"medium": newPriorityModel("medium", 100, 0, store.LoadFailed),
+		},
+	}
+	for _, model := range mockStore.models {
+		model.GetLatest().SetModelState(store.ModelStatus{State: store.ScheduleFailed})
+	}
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), nil, nil)
+
+	failedModels, err := scheduler.getFailedModels()
+	g.Expect(err).To(BeNil())
+	g.Expect(failedModels).To(Equal([]string{"high", "medium", "low"}))
+}
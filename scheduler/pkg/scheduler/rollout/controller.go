@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+const (
+	defaultStepTrafficPercent = 10
+	defaultStepInterval       = time.Minute
+	metricsQueryTimeout       = 10 * time.Second
+	// metricsUnavailableTimeout is how long past a step a rollout is held when the metrics can not be read,
+	// after which the new version is rolled back as it can not be shown to be healthy
+	metricsUnavailableTimeout = 10 * time.Minute
+)
+
+// RolloutController progressively shifts traffic to new model versions with a canary spec. The new version
+// starts with a share of the traffic once available, which is increased each step interval while the agent
+// metrics for the version stay within the canary thresholds. If a threshold is breached, or the metrics can not
+// be read for too long, all traffic is sent back to the stable version and the new version is unloaded.
+// Progress is held in the model store, so persisted and restored with the model.
+type RolloutController struct {
+	store         store.ModelStore
+	metricsReader metrics.ModelVersionMetricsReader
+	checkInterval time.Duration
+	logger        log.FieldLogger
+}
+
+// NewRolloutController creates a controller checking rollouts every checkInterval. If metricsReader is nil
+// the traffic is shifted on schedule without checking the health of new versions.
+func NewRolloutController(
+	schedStore store.ModelStore,
+	metricsReader metrics.ModelVersionMetricsReader,
+	checkInterval time.Duration,
+	logger log.FieldLogger,
+) *RolloutController {
+	return &RolloutController{
+		store:         schedStore,
+		metricsReader: metricsReader,
+		checkInterval: checkInterval,
+		logger:        logger.WithField("source", "RolloutController"),
+	}
+}
+
+func (r *RolloutController) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Stopping rollout controller")
+			return
+		case <-ticker.C:
+			r.checkRollouts(ctx)
+		}
+	}
+}
+
+func (r *RolloutController) checkRollouts(ctx context.Context) {
+	logger := r.logger.WithField("func", "checkRollouts")
+	models, err := r.store.GetModels()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get models")
+		return
+	}
+	for _, model := range models {
+		if err := r.checkRollout(ctx, model, time.Now()); err != nil {
+			logger.WithError(err).Warnf("Failed to check rollout for model %s", model.Name)
+		}
+	}
+}
+
+func (r *RolloutController) checkRollout(ctx context.Context, model *store.ModelSnapshot, now time.Time) error {
+	logger := r.logger.WithField("func", "checkRollout").WithField("model", model.Name)
+	latest := model.GetLatest()
+	if model.Deleted || latest == nil {
+		return nil
+	}
+	canary := latest.GetDeploymentSpec().GetCanary()
+	if canary == nil || latest.ModelState().State != store.ModelAvailable {
+		return nil
+	}
+
+	rollout := latest.ModelState().Rollout
+	if rollout == nil {
+		stable := model.GetCanaryStableModel()
+		if stable == nil {
+			// nothing to roll out from
+			return nil
+		}
+		trafficPercent := getInitialTrafficPercent(canary)
+		logger.Infof("Starting rollout of version %d from version %d at %d percent", latest.GetVersion(), stable.GetVersion(), trafficPercent)
+		return r.store.SetModelRollout(model.Name, latest.GetVersion(), &store.RolloutStatus{
+			State:          store.RolloutProgressing,
+			StableVersion:  stable.GetVersion(),
+			TrafficPercent: trafficPercent,
+			Reason:         "Rollout started",
+			Timestamp:      now,
+		})
+	}
+	if rollout.State == store.RolloutRolledBack {
+		// retry unloading a rolled back version which is still loaded
+		return r.unloadRolledBack(model.Name, latest.GetVersion())
+	}
+	if rollout.State != store.RolloutProgressing {
+		return nil
+	}
+
+	if r.metricsReader != nil {
+		reason, err := r.checkThresholds(ctx, model.Name, latest.GetVersion(), canary, now.Sub(rollout.Timestamp))
+		if err != nil {
+			if now.Sub(rollout.Timestamp) < getStepInterval(canary)+metricsUnavailableTimeout {
+				// hold the rollout until the new version can be checked
+				return err
+			}
+			reason = fmt.Sprintf("Metrics unavailable: %s", err.Error())
+		}
+		if reason != "" {
+			logger.Warnf("Rolling back version %d to version %d: %s", latest.GetVersion(), rollout.StableVersion, reason)
+			err := r.store.SetModelRollout(model.Name, latest.GetVersion(), &store.RolloutStatus{
+				State:          store.RolloutRolledBack,
+				StableVersion:  rollout.StableVersion,
+				TrafficPercent: 0,
+				Reason:         reason,
+				Timestamp:      now,
+			})
+			if err != nil {
+				return err
+			}
+			return r.unloadRolledBack(model.Name, latest.GetVersion())
+		}
+	}
+
+	if now.Sub(rollout.Timestamp) < getStepInterval(canary) {
+		return nil
+	}
+	next := &store.RolloutStatus{
+		State:          store.RolloutProgressing,
+		StableVersion:  rollout.StableVersion,
+		TrafficPercent: rollout.TrafficPercent + getStepTrafficPercent(canary),
+		Reason:         "Rollout progressing",
+		Timestamp:      now,
+	}
+	if next.TrafficPercent >= 100 {
+		next.State = store.RolloutSucceeded
+		next.TrafficPercent = 100
+		next.Reason = "Rollout succeeded"
+	}
+	logger.Infof("Rollout of version %d moved to %d percent", latest.GetVersion(), next.TrafficPercent)
+	return r.store.SetModelRollout(model.Name, latest.GetVersion(), next)
+}
+
+// unloadRolledBack frees the replicas of a rolled back version, as all its traffic is sent to the stable version
+func (r *RolloutController) unloadRolledBack(modelName string, version uint32) error {
+	_, err := r.store.UnloadVersionModels(modelName, version)
+	return err
+}
+
+// checkThresholds returns the reason the version should be rolled back, or empty if it is healthy
+func (r *RolloutController) checkThresholds(ctx context.Context, modelName string, version uint32, canary *pb.CanarySpec, window time.Duration) (string, error) {
+	if canary.GetMaxErrorPercent() == 0 && canary.GetMaxLatencyMs() == 0 {
+		return "", nil
+	}
+	// the metrics need a window of at least a few scrapes to give a rate
+	window = max(window, time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, metricsQueryTimeout)
+	defer cancel()
+	versionMetrics, err := r.metricsReader.GetModelVersionMetrics(ctx, util.GetVersionedModelName(modelName, version), window)
+	if err != nil {
+		return "", err
+	}
+	if versionMetrics.Requests == 0 {
+		return "", nil
+	}
+	if canary.GetMaxErrorPercent() > 0 && versionMetrics.ErrorRate*100 > float64(canary.GetMaxErrorPercent()) {
+		return fmt.Sprintf("Error rate %.2f%% exceeded %d%%", versionMetrics.ErrorRate*100, canary.GetMaxErrorPercent()), nil
+	}
+	maxLatency := time.Duration(canary.GetMaxLatencyMs()) * time.Millisecond
+	if maxLatency > 0 && versionMetrics.MeanLatency > maxLatency {
+		return fmt.Sprintf("Mean latency %s exceeded %s", versionMetrics.MeanLatency, maxLatency), nil
+	}
+	return "", nil
+}
+
+func getInitialTrafficPercent(canary *pb.CanarySpec) uint32 {
+	if canary.GetInitialTrafficPercent() > 0 {
+		return min(canary.GetInitialTrafficPercent(), 100)
+	}
+	return getStepTrafficPercent(canary)
+}
+
+func getStepTrafficPercent(canary *pb.CanarySpec) uint32 {
+	if canary.GetStepTrafficPercent() > 0 {
+		return canary.GetStepTrafficPercent()
+	}
+	return defaultStepTrafficPercent
+}
+
+func getStepInterval(canary *pb.CanarySpec) time.Duration {
+	if canary.GetStepIntervalSeconds() > 0 {
+		return time.Duration(canary.GetStepIntervalSeconds()) * time.Second
+	}
+	return defaultStepInterval
+}
@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/metrics"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/mock"
+)
+
+type fakeMetricsReader struct {
+	metrics *metrics.ModelVersionMetrics
+	err     error
+	model   string
+}
+
+func (f *fakeMetricsReader) GetModelVersionMetrics(_ context.Context, internalModelName string, _ time.Duration) (*metrics.ModelVersionMetrics, error) {
+	f.model = internalModelName
+	return f.metrics, f.err
+}
+
+func TestCheckRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+	now := time.Now()
+
+	canary := &pb.CanarySpec{
+		InitialTrafficPercent: 10,
+		StepTrafficPercent:    30,
+		StepIntervalSeconds:   60,
+		MaxErrorPercent:       5,
+		MaxLatencyMs:          100,
+	}
+	newVersion := func(version uint32, state store.ModelState, canary *pb.CanarySpec, rollout *store.RolloutStatus) *store.ModelVersion {
+		mv := store.NewModelVersion(
+			&pb.Model{
+				Meta:           &pb.MetaData{Name: "model"},
+				DeploymentSpec: &pb.DeploymentSpec{Replicas: 1, Canary: canary},
+			},
+			version,
+			"server",
+			map[int]store.ReplicaStatus{0: {State: store.Available}},
+			false,
+			state,
+		)
+		mv.SetModelState(store.ModelStatus{State: state, Rollout: rollout})
+		return mv
+	}
+	progressing := func(trafficPercent uint32, lastChange time.Time) *store.RolloutStatus {
+		return &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1, TrafficPercent: trafficPercent, Timestamp: lastChange}
+	}
+
+	type test struct {
+		name            string
+		versions        []*store.ModelVersion
+		metrics         *metrics.ModelVersionMetrics
+		metricsErr      error
+		expectedRollout *store.RolloutStatus
+		expectedUnload  bool
+		expectedErr     bool
+	}
+
+	tests := []test{
+		{
+			name: "no canary spec",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, nil, nil),
+				newVersion(2, store.ModelAvailable, nil, nil),
+			},
+		},
+		{
+			name: "first version is not rolled out",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelAvailable, canary, nil),
+			},
+		},
+		{
+			name: "new version not yet available",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelAvailable, canary, nil),
+				newVersion(2, store.ModelProgressing, canary, nil),
+			},
+		},
+		{
+			name: "starts rollout",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, nil),
+			},
+			expectedRollout: &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1, TrafficPercent: 10, Reason: "Rollout started", Timestamp: now},
+		},
+		{
+			name: "waits for step interval",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(10, now.Add(-30*time.Second))),
+			},
+			metrics: &metrics.ModelVersionMetrics{Requests: 10, ErrorRate: 0, MeanLatency: 10 * time.Millisecond},
+		},
+		{
+			name: "steps traffic",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(10, now.Add(-time.Minute))),
+			},
+			metrics:         &metrics.ModelVersionMetrics{Requests: 10, ErrorRate: 0.01, MeanLatency: 10 * time.Millisecond},
+			expectedRollout: &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1, TrafficPercent: 40, Reason: "Rollout progressing", Timestamp: now},
+		},
+		{
+			name: "succeeds",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(70, now.Add(-time.Minute))),
+			},
+			metrics:         &metrics.ModelVersionMetrics{},
+			expectedRollout: &store.RolloutStatus{State: store.RolloutSucceeded, StableVersion: 1, TrafficPercent: 100, Reason: "Rollout succeeded", Timestamp: now},
+		},
+		{
+			name: "rolls back on error rate",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(10, now.Add(-30*time.Second))),
+			},
+			metrics:         &metrics.ModelVersionMetrics{Requests: 10, ErrorRate: 0.1, MeanLatency: 10 * time.Millisecond},
+			expectedRollout: &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1, TrafficPercent: 0, Reason: "Error rate 10.00% exceeded 5%", Timestamp: now},
+			expectedUnload:  true,
+		},
+		{
+			name: "rolls back on latency",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(10, now.Add(-time.Minute))),
+			},
+			metrics:         &metrics.ModelVersionMetrics{Requests: 10, ErrorRate: 0, MeanLatency: 200 * time.Millisecond},
+			expectedRollout: &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1, TrafficPercent: 0, Reason: "Mean latency 200ms exceeded 100ms", Timestamp: now},
+			expectedUnload:  true,
+		},
+		{
+			name: "holds when metrics are unavailable",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(10, now.Add(-time.Minute))),
+			},
+			metricsErr:  fmt.Errorf("prometheus unavailable"),
+			expectedErr: true,
+		},
+		{
+			name: "rolls back when metrics are unavailable for too long",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, progressing(10, now.Add(-time.Minute-metricsUnavailableTimeout))),
+			},
+			metricsErr:      fmt.Errorf("prometheus unavailable"),
+			expectedRollout: &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1, TrafficPercent: 0, Reason: "Metrics unavailable: prometheus unavailable", Timestamp: now},
+			expectedUnload:  true,
+		},
+		{
+			name: "unloads rolled back version still loaded",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelAvailable, canary, nil),
+				newVersion(2, store.ModelAvailable, canary, &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1}),
+			},
+			expectedUnload: true,
+		},
+		{
+			name: "rolled back version is not the stable version of a new rollout",
+			versions: []*store.ModelVersion{
+				newVersion(1, store.ModelTerminating, canary, nil),
+				newVersion(2, store.ModelTerminating, canary, &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1}),
+				newVersion(3, store.ModelAvailable, canary, nil),
+			},
+			expectedRollout: &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1, TrafficPercent: 10, Reason: "Rollout started", Timestamp: now},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := mock.NewMockModelStore(ctrl)
+			model := &store.ModelSnapshot{Name: "model", Versions: test.versions}
+			latest := model.GetLatest()
+			if test.expectedRollout != nil {
+				mockStore.EXPECT().SetModelRollout("model", latest.GetVersion(), test.expectedRollout).Return(nil)
+			}
+			if test.expectedUnload {
+				mockStore.EXPECT().UnloadVersionModels("model", latest.GetVersion()).Return(true, nil)
+			}
+			metricsReader := &fakeMetricsReader{metrics: test.metrics, err: test.metricsErr}
+			controller := NewRolloutController(mockStore, metricsReader, time.Second, log.New())
+
+			err := controller.checkRollout(context.Background(), model, now)
+			if test.expectedErr {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if metricsReader.model != "" {
+				g.Expect(metricsReader.model).To(Equal(fmt.Sprintf("model_%d", latest.GetVersion())))
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	scheduling_config "github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/config"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/filters"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/sorters"
 	store "github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
@@ -35,23 +36,46 @@ type SimpleScheduler struct {
 	synchroniser     synchroniser.Synchroniser
 	eventHub         *coordinator.EventHub
 	muScheduleFailed sync.Mutex
+	// protects SchedulerConfig, which can be replaced on scheduling config updates
+	muConfig sync.RWMutex
 	SchedulerConfig
+	muPreemptions sync.Mutex
+	// preemptions keyed by the name of the model waiting for lower priority models to be unloaded
+	preemptions map[string]*preemption
 }
 
 type SchedulerConfig struct {
-	serverFilters  []filters.ServerFilter
-	serverSorts    []sorters.ServerSorter
-	replicaFilters []filters.ReplicaFilter
-	replicaSorts   []sorters.ReplicaSorter
+	serverFilters     []filters.ServerFilter
+	serverSorts       []sorters.ServerSorter
+	replicaFilters    []filters.ReplicaFilter
+	replicaSorts      []sorters.ReplicaSorter
+	replicaSetFilters []filters.ReplicaSetFilter
 }
 
+// DefaultSchedulerConfig creates the filters and sorters of the default scheduling config
 func DefaultSchedulerConfig(store store.ModelStore) SchedulerConfig {
-	return SchedulerConfig{
-		serverFilters:  []filters.ServerFilter{filters.ServerReplicaFilter{}, filters.SharingServerFilter{}, filters.DeletedServerFilter{}, filters.ServerRequirementFilter{}},
-		replicaFilters: []filters.ReplicaFilter{filters.AvailableMemoryReplicaFilter{}, filters.ExplainerFilter{}, filters.ReplicaDrainingFilter{}},
-		serverSorts:    []sorters.ServerSorter{sorters.ModelAlreadyLoadedOnServerSorter{}},
-		replicaSorts:   []sorters.ReplicaSorter{sorters.ReplicaIndexSorter{}, sorters.AvailableMemorySorter{}, sorters.ModelAlreadyLoadedSorter{}},
+	schedulingConfig := scheduling_config.DefaultSchedulingConfig.DeepCopy()
+	schedulerConfig, err := NewSchedulerConfig(&schedulingConfig)
+	if err != nil {
+		// the default filters and sorters are built in
+		panic(err)
+	}
+	return schedulerConfig
+}
+
+// NewSchedulerConfig creates the filters and sorters selected by a scheduling config
+func NewSchedulerConfig(schedulingConfig *scheduling_config.SchedulingConfig) (SchedulerConfig, error) {
+	policies, err := schedulingConfig.Policies()
+	if err != nil {
+		return SchedulerConfig{}, err
 	}
+	return SchedulerConfig{
+		serverFilters:     policies.ServerFilters,
+		serverSorts:       policies.ServerSorters,
+		replicaFilters:    policies.ReplicaFilters,
+		replicaSorts:      policies.ReplicaSorters,
+		replicaSetFilters: policies.ReplicaSetFilters,
+	}, nil
 }
 
 func NewSimpleScheduler(logger log.FieldLogger,
@@ -66,10 +90,65 @@ func NewSimpleScheduler(logger log.FieldLogger,
 		SchedulerConfig: schedulerConfig,
 		synchroniser:    synchroniser,
 		eventHub:        eventHub,
+		preemptions:     make(map[string]*preemption),
+	}
+	if eventHub != nil {
+		eventHub.RegisterModelEventHandler(
+			preemptionEventHandlerName,
+			pendingEventsQueueSize,
+			s.logger,
+			s.handlePreemptedModelEvents,
+		)
 	}
 	return s
 }
 
+func (s *SimpleScheduler) UpdateSchedulerConfig(schedulerConfig SchedulerConfig) {
+	s.muConfig.Lock()
+	defer s.muConfig.Unlock()
+	s.SchedulerConfig = schedulerConfig
+}
+
+func (s *SimpleScheduler) getSchedulerConfig() SchedulerConfig {
+	s.muConfig.RLock()
+	defer s.muConfig.RUnlock()
+	return s.SchedulerConfig
+}
+
+// WatchSchedulingConfig applies the current scheduling config and any later updates to it.
+// Updates apply to subsequent scheduling decisions and failed models are rescheduled with them,
+// models already placed are not moved.
+func (s *SimpleScheduler) WatchSchedulingConfig(schedulingConfigHdl *scheduling_config.SchedulingConfigHandler) error {
+	initSchedulingConfig := schedulingConfigHdl.GetConfiguration()
+	schedulerConfig, err := NewSchedulerConfig(&initSchedulingConfig)
+	if err != nil {
+		return err
+	}
+	s.UpdateSchedulerConfig(schedulerConfig)
+
+	updates := make(chan scheduling_config.SchedulingConfig)
+	schedulingConfigHdl.AddListener(updates)
+	go s.handleSchedulingConfigChanges(updates)
+	return nil
+}
+
+func (s *SimpleScheduler) handleSchedulingConfigChanges(updates <-chan scheduling_config.SchedulingConfig) {
+	logger := s.logger.WithField("func", "handleSchedulingConfigChanges")
+	for newSchedulingConfig := range updates {
+		schedulerConfig, err := NewSchedulerConfig(&newSchedulingConfig)
+		if err != nil {
+			logger.WithError(err).Error("Ignoring invalid scheduling config update")
+			continue
+		}
+		logger.Info("Updating scheduler filters and sorters following scheduling config change")
+		s.UpdateSchedulerConfig(schedulerConfig)
+		// models that could not be scheduled may be schedulable with the new filters and sorters
+		if _, err := s.ScheduleFailedModels(); err != nil {
+			logger.WithError(err).Warn("Failed to schedule failed models following scheduling config change")
+		}
+	}
+}
+
 func (s *SimpleScheduler) Schedule(modelKey string) error {
 	s.synchroniser.WaitReady()
 	serverEvent, err := s.scheduleToServer(modelKey)
@@ -122,7 +201,7 @@ func (s *SimpleScheduler) getFailedModels() ([]string, error) {
 		return nil, err
 	}
 
-	var failedModels []string
+	var failed []*store.ModelSnapshot
 	for _, model := range models {
 		version := model.GetLatest()
 		if version != nil {
@@ -130,11 +209,19 @@ func (s *SimpleScheduler) getFailedModels() ([]string, error) {
 			if versionState.State == store.ModelFailed || versionState.State == store.ScheduleFailed ||
 				((versionState.State == store.ModelAvailable || versionState.State == store.ModelProgressing) &&
 					versionState.AvailableReplicas < version.GetDeploymentSpec().GetReplicas()) {
-				failedModels = append(failedModels, model.Name)
+				failed = append(failed, model)
 			}
 		}
 	}
 
+	// higher priority models are scheduled first so they get the memory released by any preempted models
+	sort.SliceStable(failed, func(i, j int) bool {
+		return getPriority(failed[i].GetLatest()) > getPriority(failed[j].GetLatest())
+	})
+	var failedModels []string
+	for _, model := range failed {
+		failedModels = append(failedModels, model.Name)
+	}
 	return failedModels, nil
 }
 
@@ -191,7 +278,8 @@ func (s *SimpleScheduler) scheduleToServer(modelName string) (*coordinator.Serve
 		return nil, nil
 	}
 
-	// Model needs to be (re)scheduled
+	// Model needs to be (re)scheduled, with the same filters and sorters throughout even if the config changes
+	schedulerConfig := s.getSchedulerConfig()
 	var filteredServers []*store.ServerSnapshot
 
 	// Get all servers
@@ -201,7 +289,7 @@ func (s *SimpleScheduler) scheduleToServer(modelName string) (*coordinator.Serve
 	}
 
 	// Filter and sort servers
-	filteredServers = s.filterServers(latestModel, servers)
+	filteredServers = s.filterServers(schedulerConfig, latestModel, servers)
 	if len(filteredServers) == 0 {
 		msg := "Failed to schedule model as no matching servers are available"
 		logger.Warn(msg)
@@ -214,7 +302,7 @@ func (s *SimpleScheduler) scheduleToServer(modelName string) (*coordinator.Serve
 	desiredReplicas := latestModel.DesiredReplicas()
 	minReplicas := latestModel.GetDeploymentSpec().GetMinReplicas()
 
-	s.sortServers(latestModel, filteredServers)
+	s.sortServers(schedulerConfig, latestModel, filteredServers)
 	logger.
 		WithField("candidate_servers", filteredServers).
 		WithField("desired_replicas", desiredReplicas).
@@ -229,11 +317,11 @@ func (s *SimpleScheduler) scheduleToServer(modelName string) (*coordinator.Serve
 	// so that if the infra changes in the future we can try to re-schedule
 
 	// For each server filter and sort replicas and attempt schedule if enough replicas
-	ok := s.findAndUpdateToServers(filteredServers, latestModel, desiredReplicas, desiredReplicas)
+	ok := s.findAndUpdateToServers(schedulerConfig, filteredServers, latestModel, desiredReplicas, desiredReplicas)
 	// Try to scheduler with min replicas if not enough replicas
 	okWithMinReplicas := false
 	if !ok && minReplicas > 0 {
-		okWithMinReplicas = s.findAndUpdateToServers(filteredServers, latestModel, desiredReplicas, int(minReplicas))
+		okWithMinReplicas = s.findAndUpdateToServers(schedulerConfig, filteredServers, latestModel, desiredReplicas, int(minReplicas))
 		if okWithMinReplicas {
 			msg := "Failed to schedule model as no matching server had enough suitable replicas, managed to schedule with min replicas"
 			logger.Warn(msg)
@@ -245,6 +333,9 @@ func (s *SimpleScheduler) scheduleToServer(modelName string) (*coordinator.Serve
 		serverEvent = s.serverScaleUp(latestModel)
 		if !okWithMinReplicas {
 			msg := "Failed to schedule model as no matching server had enough suitable replicas"
+			if s.preempt(schedulerConfig, filteredServers, latestModel, desiredReplicas, int(minReplicas)) {
+				msg = "Failed to schedule model as no matching server had enough suitable replicas, waiting for preempted lower priority models to unload"
+			}
 			logger.Warn(msg)
 			// we do not want to reset the server if it has live replicas or loading replicas
 			// in the case of loading replicas, we need to make sure that we can unload them later.
@@ -260,11 +351,12 @@ func (s *SimpleScheduler) scheduleToServer(modelName string) (*coordinator.Serve
 		}
 	}
 
+	s.clearPreemption(modelName)
 	// TODO Cleanup previous version if needed?
 	return serverEvent, nil
 }
 
-func (s *SimpleScheduler) findAndUpdateToServers(filteredServers []*store.ServerSnapshot, latestModel *store.ModelVersion, desiredReplicas, desiredMinReplicas int) bool {
+func (s *SimpleScheduler) findAndUpdateToServers(schedulerConfig SchedulerConfig, filteredServers []*store.ServerSnapshot, latestModel *store.ModelVersion, desiredReplicas, desiredMinReplicas int) bool {
 	modelName := latestModel.GetMeta().GetName()
 	logger := s.logger.WithField("func", "findAndUpdateToServers").WithField("model", modelName)
 	ok := false
@@ -276,7 +368,7 @@ func (s *SimpleScheduler) findAndUpdateToServers(filteredServers []*store.Server
 		// we need a lock here, we could have many goroutines at sorting
 		// without the store being reflected and hence sorting on stale values
 		s.muSortAndUpdate.Lock()
-		candidateReplicas = s.filterReplicas(latestModel, candidateServer)
+		candidateReplicas = s.filterReplicas(schedulerConfig, latestModel, candidateServer)
 		numServerReplicas := len(candidateReplicas.ChosenReplicas)
 		if numServerReplicas < desiredMinReplicas {
 			logger.
@@ -290,7 +382,7 @@ func (s *SimpleScheduler) findAndUpdateToServers(filteredServers []*store.Server
 			continue
 		}
 
-		s.sortReplicas(candidateReplicas)
+		s.sortReplicas(schedulerConfig, candidateReplicas)
 		numReplicas := desiredMinReplicas
 		if desiredMinReplicas != desiredReplicas {
 			numReplicas = min(numServerReplicas, desiredReplicas) // we have more replicas for the server than min, so we can use all of them
@@ -307,6 +399,7 @@ func (s *SimpleScheduler) findAndUpdateToServers(filteredServers []*store.Server
 			logger.WithField("server", candidateServer.Name).Warn("Failed to update model replicas")
 		} else {
 			logger.WithField("server", candidateServer.Name).Debug("Scheduled model onto server")
+			s.checkReplicaSet(schedulerConfig, latestModel, candidateServer, candidateReplicas.ChosenReplicas[0:numReplicas])
 			ok = true
 			break
 		}
@@ -314,6 +407,29 @@ func (s *SimpleScheduler) findAndUpdateToServers(filteredServers []*store.Server
 	return ok
 }
 
+// checkReplicaSet applies the (soft) replica set filters to the replicas a model was scheduled on,
+// recording on the model status why the placement is not as preferred
+func (s *SimpleScheduler) checkReplicaSet(schedulerConfig SchedulerConfig, model *store.ModelVersion, server *store.ServerSnapshot, replicas []*store.ServerReplica) {
+	logger := s.logger.WithField("func", "checkReplicaSet").WithField("model", model.GetMeta().GetName())
+
+	var warnings []string
+	for _, replicaSetFilter := range schedulerConfig.replicaSetFilters {
+		if !replicaSetFilter.Filter(model, server, replicas) {
+			reason := replicaSetFilter.Description(model, server, replicas)
+			logger.
+				WithField("filter", replicaSetFilter.Name()).
+				WithField("server", server.Name).
+				WithField("reason", reason).
+				Warn("Scheduled model replicas do not satisfy filter")
+			warnings = append(warnings, fmt.Sprintf("%s: %s", replicaSetFilter.Name(), reason))
+		}
+	}
+	err := s.store.SetSchedulingWarning(model.Key(), model.GetVersion(), strings.Join(warnings, "; "))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to set scheduling warning")
+	}
+}
+
 func showServerSlice(servers []*store.ServerSnapshot) string {
 	var sb strings.Builder
 	for idx, server := range servers {
@@ -325,9 +441,9 @@ func showServerSlice(servers []*store.ServerSnapshot) string {
 	return sb.String()
 }
 
-func (s *SimpleScheduler) sortServers(model *store.ModelVersion, server []*store.ServerSnapshot) {
+func (s *SimpleScheduler) sortServers(schedulerConfig SchedulerConfig, model *store.ModelVersion, server []*store.ServerSnapshot) {
 	logger := s.logger.WithField("func", "sortServers")
-	for _, sorter := range s.serverSorts {
+	for _, sorter := range schedulerConfig.serverSorts {
 		logger.Debugf("About to sort servers for %s:%d with %s: %s", model.Key(), model.GetVersion(), sorter.Name(), showServerSlice(server))
 		sort.SliceStable(server, func(i, j int) bool {
 			return sorter.IsLess(&sorters.CandidateServer{Model: model, Server: server[i]}, &sorters.CandidateServer{Model: model, Server: server[j]})
@@ -363,9 +479,9 @@ func showReplicaSlice(candidateServer *sorters.CandidateServer) string {
 	return sb.String()
 }
 
-func (s *SimpleScheduler) sortReplicas(candidateServer *sorters.CandidateServer) {
+func (s *SimpleScheduler) sortReplicas(schedulerConfig SchedulerConfig, candidateServer *sorters.CandidateServer) {
 	logger := s.logger.WithField("func", "sortReplicas")
-	for _, sorter := range s.replicaSorts {
+	for _, sorter := range schedulerConfig.replicaSorts {
 		logger.Debugf("About to sort replicas for %s:%d with %s: %s", candidateServer.Model.Key(), candidateServer.Model.GetVersion(), sorter.Name(), showReplicaSlice(candidateServer))
 		sort.SliceStable(candidateServer.ChosenReplicas, func(i, j int) bool {
 			return sorter.IsLess(&sorters.CandidateReplica{Model: candidateServer.Model, Server: candidateServer.Server, Replica: candidateServer.ChosenReplicas[i]},
@@ -376,14 +492,14 @@ func (s *SimpleScheduler) sortReplicas(candidateServer *sorters.CandidateServer)
 }
 
 // Filter servers for this model
-func (s *SimpleScheduler) filterServers(model *store.ModelVersion, servers []*store.ServerSnapshot) []*store.ServerSnapshot {
+func (s *SimpleScheduler) filterServers(schedulerConfig SchedulerConfig, model *store.ModelVersion, servers []*store.ServerSnapshot) []*store.ServerSnapshot {
 	logger := s.logger.WithField("func", "filterServer").WithField("model", model.GetMeta().GetName())
 	logger.WithField("num_servers", len(servers)).Debug("Filtering servers for model")
 
 	var filteredServers []*store.ServerSnapshot
 	for _, server := range servers {
 		ok := true
-		for _, serverFilter := range s.serverFilters {
+		for _, serverFilter := range schedulerConfig.serverFilters {
 			if !serverFilter.Filter(model, server) {
 				logger.
 					WithField("filter", serverFilter.Name()).
@@ -405,7 +521,7 @@ func (s *SimpleScheduler) filterServers(model *store.ModelVersion, servers []*st
 	return filteredServers
 }
 
-func (s *SimpleScheduler) filterReplicas(model *store.ModelVersion, server *store.ServerSnapshot) *sorters.CandidateServer {
+func (s *SimpleScheduler) filterReplicas(schedulerConfig SchedulerConfig, model *store.ModelVersion, server *store.ServerSnapshot) *sorters.CandidateServer {
 	logger := s.logger.
 		WithField("func", "filterReplicas").
 		WithField("model", model.GetMeta().GetName()).
@@ -415,7 +531,7 @@ func (s *SimpleScheduler) filterReplicas(model *store.ModelVersion, server *stor
 	candidateServer := sorters.CandidateServer{Model: model, Server: server}
 	for _, replica := range server.Replicas {
 		ok := true
-		for _, replicaFilter := range s.replicaFilters {
+		for _, replicaFilter := range schedulerConfig.replicaFilters {
 			if !replicaFilter.Filter(model, replica) {
 				logger.
 					WithField("filter", replicaFilter.Name()).
@@ -29,6 +29,8 @@ import (
 	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+	scheduling_config "github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/config"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/scheduler/sorters"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/mock"
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/synchroniser"
@@ -41,6 +43,8 @@ type mockStore struct {
 	scheduledServer   string
 	scheduledReplicas []int
 	unloadedModels    map[string]uint32
+	unloadedReplicas  map[string][]int
+	unloadErr         error
 }
 
 var _ store.ModelStore = (*mockStore)(nil)
@@ -49,6 +53,19 @@ func (f mockStore) FailedScheduling(modelID string, version uint32, reason strin
 	return nil
 }
 
+func (f mockStore) UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	if f.unloadErr != nil {
+		return false, f.unloadErr
+	}
+	if f.unloadedModels != nil {
+		f.unloadedModels[modelKey] = version
+	}
+	if f.unloadedReplicas != nil {
+		f.unloadedReplicas[modelKey] = replicaIdxs
+	}
+	return true, nil
+}
+
 func (f mockStore) UnloadVersionModels(modelKey string, version uint32) (bool, error) {
 	if f.unloadedModels != nil {
 		f.unloadedModels[modelKey] = version
@@ -146,6 +163,14 @@ func (f mockStore) SetModelGwModelState(name string, versionNumber uint32, statu
 	panic("implement me")
 }
 
+func (f mockStore) SetSchedulingWarning(modelID string, version uint32, warning string) error {
+	return nil
+}
+
+func (f mockStore) SetModelRollout(modelID string, version uint32, rollout *store.RolloutStatus) error {
+	panic("implement me")
+}
+
 func TestScheduler(t *testing.T) {
 	logger := log.New()
 	g := NewGomegaWithT(t)
@@ -992,6 +1017,8 @@ func TestScheduleFailedModels(t *testing.T) {
 			mockSync := mock2.NewMockSynchroniser(ctrl)
 
 			tt.setupMocks(mockStore, mockSync)
+			// the servers have no topology labels so the scheduled models have no scheduling warning
+			mockStore.EXPECT().SetSchedulingWarning(gomock.Any(), uint32(1), "").Return(nil).AnyTimes()
 
 			eventHub, err := coordinator.NewEventHub(log.New())
 			require.NoError(t, err)
@@ -1035,3 +1062,116 @@ func createServerSnapshot(name string, numReplicas int, availableMemory uint64)
 		ExpectedReplicas: numReplicas,
 	}
 }
+
+func TestNewSchedulerConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schedulerConfig, err := NewSchedulerConfig(&scheduling_config.DefaultSchedulingConfig)
+	g.Expect(err).To(BeNil())
+	g.Expect(schedulerConfig).To(Equal(DefaultSchedulerConfig(nil)))
+
+	binPacking := scheduling_config.DefaultSchedulingConfig.DeepCopy()
+	binPacking.ReplicaSorters = []scheduling_config.PolicyConfig{
+		{Name: "AvailableMemorySorter", Parameters: map[string]string{"binPacking": "true"}},
+	}
+	schedulerConfig, err = NewSchedulerConfig(&binPacking)
+	g.Expect(err).To(BeNil())
+	g.Expect(schedulerConfig.replicaSorts).To(Equal([]sorters.ReplicaSorter{sorters.AvailableMemorySorter{BinPacking: true}}))
+
+	scheduler := NewSimpleScheduler(log.New(), nil, DefaultSchedulerConfig(nil), nil, nil)
+	scheduler.UpdateSchedulerConfig(schedulerConfig)
+	g.Expect(scheduler.getSchedulerConfig().replicaSorts).To(Equal(schedulerConfig.replicaSorts))
+
+	binPacking.ServerFilters = []scheduling_config.PolicyConfig{{Name: "NotAFilter"}}
+	_, err = NewSchedulerConfig(&binPacking)
+	g.Expect(err).ToNot(BeNil())
+}
+
+func TestHandleSchedulingConfigChanges(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctrl := gomock.NewController(t)
+
+	mockStore := mock.NewMockModelStore(ctrl)
+	mockSync := mock2.NewMockSynchroniser(ctrl)
+	// failed models are rescheduled once, following the valid update only
+	mockSync.EXPECT().IsReady().Return(true).Times(1)
+	mockStore.EXPECT().GetModels().Return(nil, nil).Times(1)
+
+	scheduler := NewSimpleScheduler(log.New(), mockStore, DefaultSchedulerConfig(mockStore), mockSync, nil)
+
+	invalid := scheduling_config.DefaultSchedulingConfig.DeepCopy()
+	invalid.ServerFilters = []scheduling_config.PolicyConfig{{Name: "NotAFilter"}}
+	binPacking := scheduling_config.DefaultSchedulingConfig.DeepCopy()
+	binPacking.ReplicaSorters = []scheduling_config.PolicyConfig{
+		{Name: "AvailableMemorySorter", Parameters: map[string]string{"binPacking": "true"}},
+	}
+	updates := make(chan scheduling_config.SchedulingConfig, 2)
+	updates <- invalid
+	updates <- binPacking
+	close(updates)
+	scheduler.handleSchedulingConfigChanges(updates)
+
+	g.Expect(scheduler.getSchedulerConfig().replicaSorts).To(Equal([]sorters.ReplicaSorter{sorters.AvailableMemorySorter{BinPacking: true}}))
+}
+
+func TestCheckReplicaSet(t *testing.T) {
+	type test struct {
+		name            string
+		nodes           []string
+		chosen          []int
+		noFilters       bool
+		expectedWarning string
+	}
+
+	tests := []test{
+		{
+			name:            "spread across nodes",
+			nodes:           []string{"a", "b", "a"},
+			chosen:          []int{0, 1},
+			expectedWarning: "",
+		},
+		{
+			name:            "all replicas on one node",
+			nodes:           []string{"a", "b", "a"},
+			chosen:          []int{0, 2},
+			expectedWarning: "TopologySpreadFilter: model replicas per node [a:2,b:0] have skew 2, max skew is 1",
+		},
+		{
+			name:            "warning cleared without replica set filters",
+			nodes:           []string{"a", "b", "a"},
+			chosen:          []int{0, 2},
+			noFilters:       true,
+			expectedWarning: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := mock.NewMockModelStore(ctrl)
+
+			model := store.NewModelVersion(
+				&pb.Model{Meta: &pb.MetaData{Name: "model1"}},
+				1, "server1", map[int]store.ReplicaStatus{}, false, store.ModelProgressing)
+			server := &store.ServerSnapshot{Name: "server1", Replicas: map[int]*store.ServerReplica{}}
+			for idx, node := range tt.nodes {
+				server.Replicas[idx] = store.NewServerReplicaFromConfig(
+					store.NewServer("server1", true), idx, nil,
+					&agent.ReplicaConfig{TopologyLabels: map[string]string{"node": node}}, 0)
+			}
+			var chosen []*store.ServerReplica
+			for _, idx := range tt.chosen {
+				chosen = append(chosen, server.Replicas[idx])
+			}
+
+			mockStore.EXPECT().SetSchedulingWarning("model1", uint32(1), tt.expectedWarning).Return(nil)
+
+			config := DefaultSchedulerConfig(mockStore)
+			if tt.noFilters {
+				config.replicaSetFilters = nil
+			}
+			scheduler := NewSimpleScheduler(log.New(), mockStore, config, nil, nil)
+			scheduler.checkReplicaSet(config, model, server, chosen)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package sorters
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	binPackingParam  = "binPacking"
+	topologyKeyParam = "topologyKey"
+)
+
+// Factories create a sorter from the (optional) parameters given for it in the scheduling config
+type ServerSorterFactory func(params map[string]string) (ServerSorter, error)
+
+type ReplicaSorterFactory func(params map[string]string) (ReplicaSorter, error)
+
+var (
+	registryMu             sync.RWMutex
+	serverSorterFactories  = map[string]ServerSorterFactory{}
+	replicaSorterFactories = map[string]ReplicaSorterFactory{}
+)
+
+func init() {
+	registerBuiltinServerSorter(ModelAlreadyLoadedOnServerSorter{})
+
+	registerBuiltinReplicaSorter(ReplicaIndexSorter{})
+	registerBuiltinReplicaSorter(ModelAlreadyLoadedSorter{})
+	RegisterReplicaSorter(AvailableMemorySorter{}.Name(), newAvailableMemorySorter)
+	RegisterReplicaSorter(TopologySpreadSorter{}.Name(), newTopologySpreadSorter)
+}
+
+func registerBuiltinServerSorter(sorter ServerSorter) {
+	RegisterServerSorter(sorter.Name(), func(_ map[string]string) (ServerSorter, error) {
+		return sorter, nil
+	})
+}
+
+func registerBuiltinReplicaSorter(sorter ReplicaSorter) {
+	RegisterReplicaSorter(sorter.Name(), func(_ map[string]string) (ReplicaSorter, error) {
+		return sorter, nil
+	})
+}
+
+func newAvailableMemorySorter(params map[string]string) (ReplicaSorter, error) {
+	sorter := AvailableMemorySorter{}
+	if v, ok := params[binPackingParam]; ok {
+		binPacking, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter %q for %s: %w", binPackingParam, v, sorter.Name(), err)
+		}
+		sorter.BinPacking = binPacking
+	}
+	return sorter, nil
+}
+
+func newTopologySpreadSorter(params map[string]string) (ReplicaSorter, error) {
+	sorter := NewTopologySpreadSorter()
+	if v, ok := params[topologyKeyParam]; ok {
+		sorter.TopologyKey = v
+	}
+	return sorter, nil
+}
+
+// RegisterServerSorter makes a server sorter available to the scheduling config under the given name.
+// Registering a name that already exists replaces the previous factory.
+func RegisterServerSorter(name string, factory ServerSorterFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	serverSorterFactories[name] = factory
+}
+
+// RegisterReplicaSorter makes a replica sorter available to the scheduling config under the given name.
+// Registering a name that already exists replaces the previous factory.
+func RegisterReplicaSorter(name string, factory ReplicaSorterFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	replicaSorterFactories[name] = factory
+}
+
+func NewServerSorter(name string, params map[string]string) (ServerSorter, error) {
+	registryMu.RLock()
+	factory, ok := serverSorterFactories[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown server sorter %s, registered server sorters are %v", name, ServerSorterNames())
+	}
+	return factory(params)
+}
+
+func NewReplicaSorter(name string, params map[string]string) (ReplicaSorter, error) {
+	registryMu.RLock()
+	factory, ok := replicaSorterFactories[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown replica sorter %s, registered replica sorters are %v", name, ReplicaSorterNames())
+	}
+	return factory(params)
+}
+
+func ServerSorterNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(serverSorterFactories))
+	for name := range serverSorterFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ReplicaSorterNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(replicaSorterFactories))
+	for name := range replicaSorterFactories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -16,7 +16,10 @@ import (
 // Rationale - put models on replicas with more memory, including the models that are currently loading
 // note that we can double count here as available memory (returned by the agent) could include memory
 // that is allocated while the model is being loaded.
-type AvailableMemorySorter struct{}
+// With BinPacking set the order is reversed so that models are packed onto the replicas with the least memory left.
+type AvailableMemorySorter struct {
+	BinPacking bool
+}
 
 func (m AvailableMemorySorter) Name() string {
 	return "AvailableMemorySorter"
@@ -25,5 +28,8 @@ func (m AvailableMemorySorter) Name() string {
 func (m AvailableMemorySorter) IsLess(i *CandidateReplica, j *CandidateReplica) bool {
 	iMem := math.Max(0, float64(i.Replica.GetAvailableMemory()-i.Replica.GetReservedMemory()))
 	jMem := math.Max(0, float64(j.Replica.GetAvailableMemory()-j.Replica.GetReservedMemory()))
+	if m.BinPacking {
+		return iMem < jMem
+	}
 	return iMem > jMem
 }
@@ -23,6 +23,7 @@ func TestReplicaMemorySort(t *testing.T) {
 
 	type test struct {
 		name     string
+		sorter   AvailableMemorySorter
 		replicas []*CandidateReplica
 		ordering []int
 	}
@@ -53,12 +54,21 @@ func TestReplicaMemorySort(t *testing.T) {
 			},
 			ordering: []int{3, 1, 2},
 		},
+		{
+			name:   "ThreeReplicasDifferentMemoryBinPacking",
+			sorter: AvailableMemorySorter{BinPacking: true},
+			replicas: []*CandidateReplica{
+				{Model: model, Replica: store.NewServerReplica("", 8080, 5001, 1, store.NewServer("dummy", true), []string{}, 100, 100, 0, map[store.ModelVersionID]bool{}, 100)},
+				{Model: model, Replica: store.NewServerReplica("", 8080, 5001, 2, store.NewServer("dummy", true), []string{}, 100, 200, 0, map[store.ModelVersionID]bool{}, 100)},
+				{Model: model, Replica: store.NewServerReplica("", 8080, 5001, 3, store.NewServer("dummy", true), []string{}, 100, 150, 0, map[store.ModelVersionID]bool{}, 100)},
+			},
+			ordering: []int{1, 3, 2},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			sorter := AvailableMemorySorter{}
-			sort.SliceStable(test.replicas, func(i, j int) bool { return sorter.IsLess(test.replicas[i], test.replicas[j]) })
+			sort.SliceStable(test.replicas, func(i, j int) bool { return test.sorter.IsLess(test.replicas[i], test.replicas[j]) })
 			for idx, expected := range test.ordering {
 				g.Expect(test.replicas[idx].Replica.GetReplicaIdx()).To(Equal(expected))
 			}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package sorters
+
+import (
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
+
+// Rationale: spread the replicas of a model across topology domains (zones by default) so a single domain going down
+// does not take out all of them. Replicas are ranked by their position within their domain (by replica index)
+// so that taking replicas in order cycles through the domains. Servers whose replicas do not report the topology
+// label are spread across nodes instead, and servers without topology labels keep their order.
+type TopologySpreadSorter struct {
+	TopologyKey string
+}
+
+func NewTopologySpreadSorter() TopologySpreadSorter {
+	return TopologySpreadSorter{TopologyKey: util.TopologyZoneLabel}
+}
+
+func (t TopologySpreadSorter) Name() string {
+	return "TopologySpreadSorter"
+}
+
+func (t TopologySpreadSorter) IsLess(i *CandidateReplica, j *CandidateReplica) bool {
+	key := t.topologyKey(i.Server)
+	if !hasTopology(i.Server, key) {
+		return false
+	}
+	return rankInDomain(i.Server, i.Replica, key) < rankInDomain(j.Server, j.Replica, key)
+}
+
+// topologyKey is the configured key, or the node label if no replica of the server reports it
+func (t TopologySpreadSorter) topologyKey(server *store.ServerSnapshot) string {
+	if hasTopology(server, t.TopologyKey) {
+		return t.TopologyKey
+	}
+	return util.TopologyNodeLabel
+}
+
+// rankInDomain is the number of non draining replicas in the same domain with a lower replica index.
+// Replicas without the topology label are ranked together as if in a domain of their own.
+func rankInDomain(server *store.ServerSnapshot, replica *store.ServerReplica, key string) int {
+	domain := replica.GetTopologyLabels()[key]
+	rank := 0
+	for _, other := range server.Replicas {
+		if other.GetReplicaIdx() < replica.GetReplicaIdx() &&
+			!other.GetIsDraining() &&
+			other.GetTopologyLabels()[key] == domain {
+			rank++
+		}
+	}
+	return rank
+}
+
+func hasTopology(server *store.ServerSnapshot, key string) bool {
+	for _, replica := range server.Replicas {
+		if _, ok := replica.GetTopologyLabels()[key]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package sorters
+
+import (
+	"sort"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store"
+)
+
+func TestTopologySpreadSort(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name  string
+		zones []string
+		// the node of each replica if set, "" for no node label
+		nodes []string
+		// the order replicas are sorted from, by replica index, the replica index order if not set
+		initial  []int
+		ordering []int
+	}
+
+	tests := []test{
+		{
+			name:     "TwoZones",
+			zones:    []string{"a", "a", "b", "b"},
+			ordering: []int{0, 2, 1, 3},
+		},
+		{
+			name:     "ThreeZonesUneven",
+			zones:    []string{"a", "a", "a", "b", "c"},
+			ordering: []int{0, 3, 4, 1, 2},
+		},
+		{
+			name:     "NodesWithoutZones",
+			zones:    []string{"", "", ""},
+			nodes:    []string{"n1", "n1", "n2"},
+			ordering: []int{0, 2, 1},
+		},
+		{
+			name:     "ZonesPreferredOverNodes",
+			zones:    []string{"a", "a", "b"},
+			nodes:    []string{"n1", "n2", "n3"},
+			ordering: []int{0, 2, 1},
+		},
+		{
+			name:     "NoTopologyLabels",
+			zones:    []string{"", "", ""},
+			ordering: []int{0, 1, 2},
+		},
+		{
+			name:     "NoTopologyLabelsKeepsOrder",
+			zones:    []string{"", "", ""},
+			initial:  []int{2, 0, 1},
+			ordering: []int{2, 0, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := &store.ServerSnapshot{Name: "server", Replicas: map[int]*store.ServerReplica{}}
+			var replicas []*CandidateReplica
+			for idx, zone := range test.zones {
+				config := &agent.ReplicaConfig{TopologyLabels: map[string]string{}}
+				if zone != "" {
+					config.TopologyLabels["zone"] = zone
+				}
+				if test.nodes != nil && test.nodes[idx] != "" {
+					config.TopologyLabels["node"] = test.nodes[idx]
+				}
+				replica := store.NewServerReplicaFromConfig(store.NewServer("server", true), idx, nil, config, 0)
+				server.Replicas[idx] = replica
+			}
+			initial := test.initial
+			if initial == nil {
+				for idx := range test.zones {
+					initial = append(initial, idx)
+				}
+			}
+			for _, idx := range initial {
+				replicas = append(replicas, &CandidateReplica{Server: server, Replica: server.Replicas[idx]})
+			}
+			sorter := TopologySpreadSorter{TopologyKey: "zone"}
+			sort.SliceStable(replicas, func(i, j int) bool { return sorter.IsLess(replicas[i], replicas[j]) })
+			for idx, expected := range test.ordering {
+				g.Expect(replicas[idx].Replica.GetReplicaIdx()).To(Equal(expected))
+			}
+		})
+	}
+}
@@ -41,8 +41,8 @@ func TestExperimentStatusStream(t *testing.T) {
 			name: "success - experiment ok",
 			loadReq: &experiment.Experiment{
 				Name: "foo",
-				Mirror: &experiment.Mirror{
-					Name: "bar",
+				Mirrors: []*experiment.Mirror{
+					{Name: "bar"},
 				},
 			},
 			server: &SchedulerServer{
@@ -56,8 +56,8 @@ func TestExperimentStatusStream(t *testing.T) {
 			name: "failure - stream ctx cancelled",
 			loadReq: &experiment.Experiment{
 				Name: "foo",
-				Mirror: &experiment.Mirror{
-					Name: "bar",
+				Mirrors: []*experiment.Mirror{
+					{Name: "bar"},
 				},
 			},
 			server: &SchedulerServer{
@@ -72,8 +72,8 @@ func TestExperimentStatusStream(t *testing.T) {
 			name: "failure - timeout",
 			loadReq: &experiment.Experiment{
 				Name: "foo",
-				Mirror: &experiment.Mirror{
-					Name: "bar",
+				Mirrors: []*experiment.Mirror{
+					{Name: "bar"},
 				},
 			},
 			server: &SchedulerServer{
@@ -131,8 +131,8 @@ func TestExperimentStatusEvents(t *testing.T) {
 			name: "success - experiment ok",
 			loadReq: &experiment.Experiment{
 				Name: "foo",
-				Mirror: &experiment.Mirror{
-					Name: "bar",
+				Mirrors: []*experiment.Mirror{
+					{Name: "bar"},
 				},
 			},
 			timeout: 10 * time.Millisecond,
@@ -143,8 +143,8 @@ func TestExperimentStatusEvents(t *testing.T) {
 			name: "failure - timeout",
 			loadReq: &experiment.Experiment{
 				Name: "foo",
-				Mirror: &experiment.Mirror{
-					Name: "bar",
+				Mirrors: []*experiment.Mirror{
+					{Name: "bar"},
 				},
 			},
 			timeout: 1 * time.Millisecond,
@@ -540,6 +540,8 @@ func createModelVersionStatus(mv *store.ModelVersion) *pb.ModelVersionStatus {
 			ModelGwState:        pb.ModelStatus_ModelState(pb.ModelStatus_ModelState_value[modelState.ModelGwState.String()]),
 			Reason:              modelState.Reason,
 			ModelGwReason:       modelState.ModelGwReason,
+			SchedulingWarning:   modelState.SchedulingWarning,
+			Rollout:             store.CreateRolloutStatusProto(modelState.Rollout),
 			LastChangeTimestamp: timestamppb.New(modelState.Timestamp),
 			AvailableReplicas:   modelState.AvailableReplicas,
 			UnavailableReplicas: modelState.UnavailableReplicas,
@@ -552,6 +554,26 @@ func createModelVersionStatus(mv *store.ModelVersion) *pb.ModelVersionStatus {
 	return mvs
 }
 
+// createLatestModelVersionStatus reports the state of the stable version once the latest version has been
+// rolled back, as the latest version is unloaded while the stable version serves all of the traffic
+func createLatestModelVersionStatus(model *store.ModelSnapshot, latestModel *store.ModelVersion) *pb.ModelVersionStatus {
+	mvs := createModelVersionStatus(latestModel)
+	rollout := latestModel.ModelState().Rollout
+	if rollout == nil || rollout.State != store.RolloutRolledBack {
+		return mvs
+	}
+	stable := model.GetCanaryStableModel()
+	if stable == nil {
+		return mvs
+	}
+	stableStatus := createModelVersionStatus(stable)
+	stableStatus.State.Rollout = mvs.State.Rollout
+	mvs.State = stableStatus.State
+	mvs.ServerName = stableStatus.ServerName
+	mvs.ModelReplicaState = stableStatus.ModelReplicaState
+	return mvs
+}
+
 func (s *SchedulerServer) modelStatusImpl(model *store.ModelSnapshot, allVersions bool) (*pb.ModelStatusResponse, error) {
 	var modelVersionStatuses []*pb.ModelVersionStatus
 	if !allVersions {
@@ -559,7 +581,7 @@ func (s *SchedulerServer) modelStatusImpl(model *store.ModelSnapshot, allVersion
 		if latestModel == nil {
 			return nil, status.Errorf(codes.FailedPrecondition, "Failed to find model %s", model.Name)
 		}
-		modelVersionStatuses = append(modelVersionStatuses, createModelVersionStatus(latestModel))
+		modelVersionStatuses = append(modelVersionStatuses, createLatestModelVersionStatus(model, latestModel))
 	} else {
 		for _, mv := range model.Versions {
 			modelVersionStatuses = append(modelVersionStatuses, createModelVersionStatus(mv))
@@ -753,6 +775,32 @@ func (s *SchedulerServer) StopExperiment(ctx context.Context, req *pb.StopExperi
 	return &pb.StopExperimentResponse{}, nil
 }
 
+func (s *SchedulerServer) ExperimentFeedback(ctx context.Context, req *pb.ExperimentFeedbackRequest) (*pb.ExperimentFeedbackResponse, error) {
+	err := s.experimentServer.AddReward(req.GetExperimentName(), req.GetRequestId(), req.GetReward())
+	if err != nil {
+		if errors.Is(err, &experiment.ExperimentNotFound{}) {
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		}
+		if errors.Is(err, &experiment.ExperimentBanditFull{}) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return &pb.ExperimentFeedbackResponse{}, nil
+}
+
+// ExperimentRequests records the candidates of bandit experiments which served requests on the pipeline gateways.
+// Requests which can not be recorded are only logged, as the experiment may have changed since they were served.
+func (s *SchedulerServer) ExperimentRequests(ctx context.Context, req *pb.ExperimentRequestsRequest) (*pb.ExperimentRequestsResponse, error) {
+	logger := s.logger.WithField("func", "ExperimentRequests")
+	for _, request := range req.GetRequests() {
+		if err := s.experimentServer.AddRequest(request.GetExperimentName(), request.GetRequestId(), request.GetCandidate()); err != nil {
+			logger.WithError(err).Debugf("Failed to record request %s", request.GetRequestId())
+		}
+	}
+	return &pb.ExperimentRequestsResponse{}, nil
+}
+
 func (s *SchedulerServer) ExperimentStatus(
 	req *pb.ExperimentStatusRequest,
 	stream pb.Scheduler_ExperimentStatusServer,
@@ -894,3 +942,19 @@ func (s *SchedulerServer) SchedulerStatus(ctx context.Context, req *pb.Scheduler
 		ApplicationVersion: "0.0.1",
 	}, nil
 }
+
+func (s *SchedulerServer) ExplainSchedule(ctx context.Context, req *pb.ExplainScheduleRequest) (*pb.ExplainScheduleResponse, error) {
+	logger := s.logger.WithField("func", "ExplainSchedule")
+	logger.Debugf("Explain schedule for model %s", req.GetModel().GetMeta().GetName())
+	res, err := s.scheduler.ExplainSchedule(req.GetModel())
+	if err != nil {
+		switch {
+		case errors.Is(err, scheduler2.ErrInvalidExplainRequest):
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		case errors.Is(err, scheduler2.ErrExplainModelNotFound):
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	return res, nil
+}
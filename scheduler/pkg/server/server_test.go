@@ -473,6 +473,146 @@ func TestUnloadModel(t *testing.T) {
 	}
 }
 
+func TestExplainScheduleErrorCodes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name  string
+		model *pb.Model
+		code  codes.Code
+	}
+
+	smallMemory := uint64(100)
+	tests := []test{
+		{
+			name: "proposed model",
+			model: &pb.Model{
+				Meta:           &pb.MetaData{Name: "model1"},
+				ModelSpec:      &pb.ModelSpec{Uri: "gs://model", MemoryBytes: &smallMemory},
+				DeploymentSpec: &pb.DeploymentSpec{Replicas: 1},
+			},
+			code: codes.OK,
+		},
+		{
+			name:  "missing model",
+			model: nil,
+			code:  codes.InvalidArgument,
+		},
+		{
+			name:  "missing model name",
+			model: &pb.Model{ModelSpec: &pb.ModelSpec{Uri: "gs://model"}},
+			code:  codes.InvalidArgument,
+		},
+		{
+			name:  "unknown model",
+			model: &pb.Model{Meta: &pb.MetaData{Name: "model1"}},
+			code:  codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger := log.New()
+			eventHub, err := coordinator.NewEventHub(logger)
+			g.Expect(err).To(BeNil())
+			schedulerStore := store.NewMemoryStore(logger, store.NewLocalSchedulerStore(), eventHub)
+			scheduler := scheduler2.NewSimpleScheduler(logger, schedulerStore, scheduler2.DefaultSchedulerConfig(schedulerStore), nil, eventHub)
+			s := &SchedulerServer{logger: logger, scheduler: scheduler}
+
+			res, err := s.ExplainSchedule(context.Background(), &pb.ExplainScheduleRequest{Model: test.model})
+			if test.code != codes.OK {
+				g.Expect(err).ToNot(BeNil())
+				e, ok := status.FromError(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(e.Code()).To(Equal(test.code))
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(res.ModelName).To(Equal("model1"))
+			}
+		})
+	}
+}
+
+func TestModelStatusAfterRollback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	canary := &pb.CanarySpec{StepTrafficPercent: 10}
+	newVersion := func(version uint32, generation int64, state store.ModelState, availableReplicas uint32, rollout *store.RolloutStatus) *store.ModelVersion {
+		replicas := map[int]store.ReplicaStatus{}
+		if availableReplicas > 0 {
+			replicas[0] = store.ReplicaStatus{State: store.Available}
+		}
+		mv := store.NewModelVersion(
+			&pb.Model{
+				Meta: &pb.MetaData{
+					Name:           "model",
+					KubernetesMeta: &pb.KubernetesMeta{Namespace: "default", Generation: generation},
+				},
+				DeploymentSpec: &pb.DeploymentSpec{Replicas: 1, Canary: canary},
+			},
+			version,
+			"server",
+			replicas,
+			false,
+			state,
+		)
+		mv.SetModelState(store.ModelStatus{State: state, AvailableReplicas: availableReplicas, Rollout: rollout})
+		return mv
+	}
+
+	type test struct {
+		name               string
+		versions           []*store.ModelVersion
+		expectedState      pb.ModelStatus_ModelState
+		expectedReplicas   uint32
+		expectedRollout    pb.ModelRolloutStatus_RolloutState
+		expectedVersion    uint32
+		expectedGeneration int64
+	}
+
+	tests := []test{
+		{
+			name: "rolled back version reports the stable version",
+			versions: []*store.ModelVersion{
+				newVersion(1, 1, store.ModelAvailable, 1, nil),
+				newVersion(2, 2, store.ModelTerminated, 0, &store.RolloutStatus{State: store.RolloutRolledBack, StableVersion: 1}),
+			},
+			expectedState:      pb.ModelStatus_ModelAvailable,
+			expectedReplicas:   1,
+			expectedRollout:    pb.ModelRolloutStatus_RolloutRolledBack,
+			expectedVersion:    2,
+			expectedGeneration: 2,
+		},
+		{
+			name: "progressing rollout reports the latest version",
+			versions: []*store.ModelVersion{
+				newVersion(1, 1, store.ModelAvailable, 1, nil),
+				newVersion(2, 2, store.ModelProgressing, 0, &store.RolloutStatus{State: store.RolloutProgressing, StableVersion: 1}),
+			},
+			expectedState:      pb.ModelStatus_ModelProgressing,
+			expectedReplicas:   0,
+			expectedRollout:    pb.ModelRolloutStatus_RolloutProgressing,
+			expectedVersion:    2,
+			expectedGeneration: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &SchedulerServer{logger: log.New()}
+			res, err := s.modelStatusImpl(&store.ModelSnapshot{Name: "model", Versions: test.versions}, false)
+			g.Expect(err).To(BeNil())
+			g.Expect(res.Versions).To(HaveLen(1))
+			mvs := res.Versions[0]
+			g.Expect(mvs.Version).To(Equal(test.expectedVersion))
+			g.Expect(mvs.KubernetesMeta.Generation).To(Equal(test.expectedGeneration))
+			g.Expect(mvs.State.State).To(Equal(test.expectedState))
+			g.Expect(mvs.State.AvailableReplicas).To(Equal(test.expectedReplicas))
+			g.Expect(mvs.State.Rollout.State).To(Equal(test.expectedRollout))
+		})
+	}
+}
+
 func TestLoadPipeline(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type test struct {
@@ -0,0 +1,264 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/utils"
+)
+
+const (
+	defaultModelSnapshotVersion = "v0"
+	currentModelSnapshotVersion = "v1"
+	modelKeyPrefix              = "model/"
+	serverKeyPrefix             = "server/"
+)
+
+type ModelServerDBManager struct {
+	db                 *badger.DB
+	logger             logrus.FieldLogger
+	deletedResourceTTL time.Duration
+	writesMu           sync.Mutex
+	writes             map[string]dbWrite
+	writesReady        chan struct{}
+	stop               chan struct{}
+	writerDone         chan struct{}
+	stopOnce           sync.Once
+}
+
+// dbWrite is a snapshot waiting to be written, only the latest snapshot of each model or server is written
+type dbWrite struct {
+	value  []byte
+	ttl    time.Duration
+	delete bool
+}
+
+func newModelServerDbManager(path string, logger logrus.FieldLogger, deletedResourceTTL uint) (*ModelServerDBManager, error) {
+	db, err := utils.Open(path, logger, "modelDb")
+	if err != nil {
+		return nil, err
+	}
+
+	mdb := &ModelServerDBManager{
+		db:                 db,
+		logger:             logger.WithField("source", "ModelServerDBManager"),
+		deletedResourceTTL: time.Duration(deletedResourceTTL * uint(time.Second)),
+		writes:             make(map[string]dbWrite),
+		writesReady:        make(chan struct{}, 1),
+		stop:               make(chan struct{}),
+		writerDone:         make(chan struct{}),
+	}
+
+	version, err := mdb.getVersion()
+	if err != nil || version != currentModelSnapshotVersion {
+		// either the db is empty or it was written in a format we can not read,
+		// in both cases we migrate the db to the current version
+		logger.Infof("Migrating DB from version %s to %s", version, currentModelSnapshotVersion)
+		err := mdb.migrateToDBCurrentVersion()
+		if err != nil {
+			return nil, err
+		}
+	}
+	go mdb.runWriter()
+	return mdb, nil
+}
+
+// Stop writes the queued snapshots before closing the db
+func (mdb *ModelServerDBManager) Stop() error {
+	mdb.stopOnce.Do(func() {
+		close(mdb.stop)
+		<-mdb.writerDone
+	})
+	return utils.Stop(mdb.db)
+}
+
+// queueModel queues a snapshot of the model to be written by the writer, so callers holding the store lock
+// do not wait on disk I/O. The snapshot is taken when queued so it is consistent with the store.
+func (mdb *ModelServerDBManager) queueModel(name string, model *Model) error {
+	modelBytes, err := proto.Marshal(CreateModelSnapshotProto(name, model))
+	if err != nil {
+		return err
+	}
+	write := dbWrite{value: modelBytes}
+	if model.IsDeleted() {
+		write.ttl = mdb.deletedResourceTTL
+	}
+	mdb.queueWrite(string(modelKey(name)), write)
+	return nil
+}
+
+func (mdb *ModelServerDBManager) queueServer(server *Server) error {
+	serverBytes, err := proto.Marshal(CreateServerSnapshotProto(server))
+	if err != nil {
+		return err
+	}
+	mdb.queueWrite(string(serverKey(server.name)), dbWrite{value: serverBytes})
+	return nil
+}
+
+func (mdb *ModelServerDBManager) queueDeleteServer(name string) {
+	mdb.queueWrite(string(serverKey(name)), dbWrite{delete: true})
+}
+
+func (mdb *ModelServerDBManager) queueWrite(key string, write dbWrite) {
+	mdb.writesMu.Lock()
+	mdb.writes[key] = write
+	mdb.writesMu.Unlock()
+	select {
+	case mdb.writesReady <- struct{}{}:
+	default:
+	}
+}
+
+func (mdb *ModelServerDBManager) runWriter() {
+	defer close(mdb.writerDone)
+	for {
+		select {
+		case <-mdb.writesReady:
+			mdb.writeQueued()
+		case <-mdb.stop:
+			mdb.writeQueued()
+			return
+		}
+	}
+}
+
+// writeQueued writes all the queued snapshots in a single batch
+func (mdb *ModelServerDBManager) writeQueued() {
+	mdb.writesMu.Lock()
+	writes := mdb.writes
+	mdb.writes = make(map[string]dbWrite)
+	mdb.writesMu.Unlock()
+	if len(writes) == 0 {
+		return
+	}
+
+	wb := mdb.db.NewWriteBatch()
+	defer wb.Cancel()
+	for key, write := range writes {
+		var err error
+		switch {
+		case write.delete:
+			err = wb.Delete([]byte(key))
+		case write.ttl > 0:
+			err = wb.SetEntry(badger.NewEntry([]byte(key), write.value).WithTTL(write.ttl))
+		default:
+			err = wb.Set([]byte(key), write.value)
+		}
+		if err != nil {
+			mdb.logger.WithError(err).Warnf("Failed to write %s to db", key)
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		mdb.logger.WithError(err).Warnf("Failed to write %d snapshots to db", len(writes))
+	}
+}
+
+func (mdb *ModelServerDBManager) saveVersion() error {
+	return utils.SaveVersion(mdb.db, currentModelSnapshotVersion)
+}
+
+func (mdb *ModelServerDBManager) getVersion() (string, error) {
+	return utils.GetVersion(mdb.db, defaultModelSnapshotVersion)
+}
+
+func (mdb *ModelServerDBManager) restore(
+	restoreModelCb func(name string, model *Model), restoreServerCb func(server *Server),
+) error {
+	return mdb.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key := string(item.Key())
+			switch {
+			case strings.HasPrefix(key, modelKeyPrefix):
+				err := item.Value(func(v []byte) error {
+					snapshot := pb.ModelSnapshot{}
+					err := proto.Unmarshal(v, &snapshot)
+					if err != nil {
+						return err
+					}
+					restoreModelCb(snapshot.GetName(), CreateModelFromSnapshot(&snapshot))
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			case strings.HasPrefix(key, serverKeyPrefix):
+				err := item.Value(func(v []byte) error {
+					snapshot := pb.ServerSnapshot{}
+					err := proto.Unmarshal(v, &snapshot)
+					if err != nil {
+						return err
+					}
+					restoreServerCb(CreateServerFromSnapshot(&snapshot))
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			default:
+				// skip the version key
+				continue
+			}
+		}
+		return nil
+	})
+}
+
+// get model by name from db
+func (mdb *ModelServerDBManager) getModel(name string) (*Model, error) {
+	var model *Model
+	err := mdb.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(modelKey(name))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(v []byte) error {
+			snapshot := pb.ModelSnapshot{}
+			err = proto.Unmarshal(v, &snapshot)
+			if err != nil {
+				return err
+			}
+			model = CreateModelFromSnapshot(&snapshot)
+			return nil
+		})
+	})
+	return model, err
+}
+
+// migrateToDBCurrentVersion drops any existing state as there is no earlier format we can convert from.
+// Models and servers will be re-synced from the controller and the reconnecting agents.
+func (mdb *ModelServerDBManager) migrateToDBCurrentVersion() error {
+	err := mdb.db.DropAll()
+	if err != nil {
+		return err
+	}
+	return mdb.saveVersion()
+}
+
+func modelKey(name string) []byte {
+	return []byte(modelKeyPrefix + name)
+}
+
+func serverKey(name string) []byte {
+	return []byte(serverKeyPrefix + name)
+}
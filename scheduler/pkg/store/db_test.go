@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/utils"
+)
+
+func TestModelSaveWithTTL(t *testing.T) {
+	g := NewGomegaWithT(t)
+	model := &Model{
+		versions: []*ModelVersion{
+			NewModelVersion(
+				&pb.Model{Meta: &pb.MetaData{Name: "model1"}, ModelSpec: &pb.ModelSpec{}},
+				1, "server1", map[int]ReplicaStatus{0: {State: Unloaded}}, false, ModelTerminated),
+		},
+	}
+	model.SetDeleted()
+
+	path := fmt.Sprintf("%s/db", t.TempDir())
+	logger := log.New()
+	db, err := newModelServerDbManager(getModelDbFolder(path), logger, 10)
+	g.Expect(err).To(BeNil())
+	err = db.queueModel("model1", model)
+	g.Expect(err).To(BeNil())
+	db.writeQueued()
+
+	var item *badger.Item
+	err = db.db.View(func(txn *badger.Txn) error {
+		item, err = txn.Get(modelKey("model1"))
+		return err
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(item.ExpiresAt()).ToNot(BeZero())
+
+	err = db.Stop()
+	g.Expect(err).To(BeNil())
+}
+
+func TestModelServerSaveAndRestore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type test struct {
+		name    string
+		models  map[string]*Model
+		servers []*Server
+	}
+
+	ts := time.Now().UTC()
+	tests := []test{
+		{
+			name:    "no models or servers",
+			models:  map[string]*Model{},
+			servers: []*Server{},
+		},
+		{
+			name: "models with versions and servers",
+			models: map[string]*Model{
+				"model1": {
+					versions: []*ModelVersion{
+						{
+							version: 1,
+							modelDefn: &pb.Model{
+								Meta:           &pb.MetaData{Name: "model1"},
+								ModelSpec:      &pb.ModelSpec{Uri: "gs://model1", MemoryBytes: &[]uint64{100}[0]},
+								DeploymentSpec: &pb.DeploymentSpec{Replicas: 2},
+							},
+							server: "server1",
+							replicas: map[int]ReplicaStatus{
+								0: {State: Unloaded, Timestamp: ts},
+								1: {State: UnloadFailed, Reason: "failed", Timestamp: ts},
+							},
+							state: ModelStatus{State: ModelTerminateFailed, ModelGwState: ModelTerminated, Reason: "failed", Timestamp: ts},
+						},
+						{
+							version: 2,
+							modelDefn: &pb.Model{
+								Meta:           &pb.MetaData{Name: "model1"},
+								ModelSpec:      &pb.ModelSpec{Uri: "gs://model1-v2"},
+								DeploymentSpec: &pb.DeploymentSpec{Replicas: 2},
+							},
+							server: "server1",
+							replicas: map[int]ReplicaStatus{
+								0: {State: Available, Timestamp: ts},
+								1: {State: Draining, Timestamp: ts},
+							},
+							state: ModelStatus{
+								State:             ModelAvailable,
+								ModelGwState:      ModelAvailable,
+								AvailableReplicas: 1,
+								DrainingReplicas:  1,
+								Timestamp:         ts,
+								Rollout:           &RolloutStatus{State: RolloutProgressing, StableVersion: 1, TrafficPercent: 20, Reason: "Rollout progressing", Timestamp: ts},
+							},
+						},
+					},
+				},
+				"model2": {
+					versions: []*ModelVersion{
+						{
+							version: 3,
+							modelDefn: &pb.Model{
+								Meta:      &pb.MetaData{Name: "model2"},
+								ModelSpec: &pb.ModelSpec{},
+							},
+							replicas: map[int]ReplicaStatus{},
+							state:    ModelStatus{State: ScheduleFailed, ModelGwState: ModelCreate, Reason: "no servers", Timestamp: ts},
+						},
+					},
+				},
+			},
+			servers: []*Server{
+				{
+					name:             "server1",
+					replicas:         map[int]*ServerReplica{},
+					shared:           true,
+					expectedReplicas: 2,
+					minReplicas:      1,
+					maxReplicas:      3,
+					kubernetesMeta:   &pb.KubernetesMeta{Namespace: "default", Generation: 2},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := fmt.Sprintf("%s/db", t.TempDir())
+			logger := log.New()
+			db, err := newModelServerDbManager(getModelDbFolder(path), logger, 10)
+			g.Expect(err).To(BeNil())
+			for name, model := range test.models {
+				err := db.queueModel(name, model)
+				g.Expect(err).To(BeNil())
+			}
+			for _, server := range test.servers {
+				err := db.queueServer(server)
+				g.Expect(err).To(BeNil())
+			}
+			err = db.Stop()
+			g.Expect(err).To(BeNil())
+
+			ms := NewMemoryStore(logger, NewLocalSchedulerStore(), nil)
+			err = ms.InitialiseOrRestoreDB(path, 10)
+			g.Expect(err).To(BeNil())
+
+			g.Expect(len(ms.store.models)).To(Equal(len(test.models)))
+			for name, expected := range test.models {
+				actual, ok := ms.store.models[name]
+				g.Expect(ok).To(BeTrue())
+				g.Expect(actual.IsDeleted()).To(Equal(expected.IsDeleted()))
+				g.Expect(len(actual.versions)).To(Equal(len(expected.versions)))
+				for idx, mv := range expected.versions {
+					g.Expect(actual.versions[idx].GetVersion()).To(Equal(mv.GetVersion()))
+					g.Expect(actual.versions[idx].Server()).To(Equal(mv.Server()))
+					g.Expect(actual.versions[idx].ModelState()).To(Equal(mv.ModelState()))
+					g.Expect(actual.versions[idx].ReplicaState()).To(Equal(mv.ReplicaState()))
+					g.Expect(proto.Equal(actual.versions[idx].GetModel(), mv.GetModel())).To(BeTrue())
+				}
+			}
+
+			g.Expect(len(ms.store.servers)).To(Equal(len(test.servers)))
+			for _, expected := range test.servers {
+				actual, ok := ms.store.servers[expected.name]
+				g.Expect(ok).To(BeTrue())
+				g.Expect(actual.shared).To(Equal(expected.shared))
+				g.Expect(actual.expectedReplicas).To(Equal(expected.expectedReplicas))
+				g.Expect(actual.minReplicas).To(Equal(expected.minReplicas))
+				g.Expect(actual.maxReplicas).To(Equal(expected.maxReplicas))
+				g.Expect(proto.Equal(actual.kubernetesMeta, expected.kubernetesMeta)).To(BeTrue())
+				g.Expect(actual.replicas).To(BeEmpty())
+			}
+
+			err = ms.db.Stop()
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestModelServerDbMigration(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := fmt.Sprintf("%s/db", t.TempDir())
+	logger := log.New()
+
+	// a db in an unknown format is dropped
+	bdb, err := utils.Open(getModelDbFolder(path), logger, "modelDb")
+	g.Expect(err).To(BeNil())
+	err = utils.SaveVersion(bdb, "v100")
+	g.Expect(err).To(BeNil())
+	err = bdb.Update(func(txn *badger.Txn) error {
+		return txn.Set(modelKey("model1"), []byte("not a snapshot"))
+	})
+	g.Expect(err).To(BeNil())
+	err = utils.Stop(bdb)
+	g.Expect(err).To(BeNil())
+
+	db, err := newModelServerDbManager(getModelDbFolder(path), logger, 10)
+	g.Expect(err).To(BeNil())
+	version, err := db.getVersion()
+	g.Expect(err).To(BeNil())
+	g.Expect(version).To(Equal(currentModelSnapshotVersion))
+	_, err = db.getModel("model1")
+	g.Expect(err).To(Equal(badger.ErrKeyNotFound))
+
+	err = db.Stop()
+	g.Expect(err).To(BeNil())
+}
+
+func TestQueuedWritesKeepLatestSnapshot(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := fmt.Sprintf("%s/db", t.TempDir())
+	db, err := newModelServerDbManager(getModelDbFolder(path), log.New(), 10)
+	g.Expect(err).To(BeNil())
+
+	newModel := func(uri string) *Model {
+		return &Model{
+			versions: []*ModelVersion{
+				NewModelVersion(
+					&pb.Model{Meta: &pb.MetaData{Name: "model1"}, ModelSpec: &pb.ModelSpec{Uri: uri}},
+					1, "server1", map[int]ReplicaStatus{}, false, ModelAvailable),
+			},
+		}
+	}
+	// the latest snapshot queued for each key is written
+	err = db.queueModel("model1", newModel("gs://a"))
+	g.Expect(err).To(BeNil())
+	err = db.queueModel("model1", newModel("gs://b"))
+	g.Expect(err).To(BeNil())
+	err = db.queueServer(&Server{name: "server1", replicas: map[int]*ServerReplica{}})
+	g.Expect(err).To(BeNil())
+	db.queueDeleteServer("server1")
+
+	err = db.Stop()
+	g.Expect(err).To(BeNil())
+
+	db, err = newModelServerDbManager(getModelDbFolder(path), log.New(), 10)
+	g.Expect(err).To(BeNil())
+	model, err := db.getModel("model1")
+	g.Expect(err).To(BeNil())
+	g.Expect(model.Latest().GetModelSpec().GetUri()).To(Equal("gs://b"))
+	err = db.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(serverKey("server1"))
+		return err
+	})
+	g.Expect(err).To(Equal(badger.ErrKeyNotFound))
+	err = db.Stop()
+	g.Expect(err).To(BeNil())
+}
@@ -0,0 +1,368 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+)
+
+const (
+	banditEventSource           = "experiment.store.bandit"
+	banditCheckInterval         = 5 * time.Second
+	defaultBanditUpdateInterval = time.Minute
+	thompsonSamplingDraws       = 1000
+	// maxBanditRequests bounds the requests and the rewards held for an experiment between weight updates
+	maxBanditRequests = 100000
+)
+
+// banditRewards holds the requests served by the candidates of an experiment and the rewards submitted for
+// them. The candidate which served a request is recorded by the agent or pipeline gateway serving it, so clients
+// only send the request id with the reward. Rewards are kept by request id until the next weight update, so
+// repeated feedback for a request replaces the earlier reward, and are then added to the totals of the
+// candidate which served the request. Requests are kept for two update intervals, and at most maxBanditRequests
+// requests and rewards are held for each interval so a burst of traffic can not exhaust memory. The totals are saved in the
+// experiment DB at each update so the weights survive a restart of the scheduler, while the requests and the
+// rewards waiting for an update are only held in memory and are lost.
+type banditRewards struct {
+	requests         map[string]string
+	previousRequests map[string]string
+	pending          map[string]pendingReward
+	totals           map[string]*rewardTotals
+	lastUpdate       time.Time
+}
+
+type pendingReward struct {
+	reward float64
+	// the request was not recorded at the last update, the reward is dropped if it is still not at the next
+	carried bool
+}
+
+type rewardTotals struct {
+	count float64
+	sum   float64
+}
+
+func newBanditRewards(now time.Time) *banditRewards {
+	return &banditRewards{
+		requests:         make(map[string]string),
+		previousRequests: make(map[string]string),
+		pending:          make(map[string]pendingReward),
+		totals:           make(map[string]*rewardTotals),
+		lastUpdate:       now,
+	}
+}
+
+func (br *banditRewards) getCandidate(requestId string) (string, bool) {
+	if candidate, ok := br.requests[requestId]; ok {
+		return candidate, true
+	}
+	candidate, ok := br.previousRequests[requestId]
+	return candidate, ok
+}
+
+func (br *banditRewards) addPending() {
+	pending := make(map[string]pendingReward)
+	for requestId, reward := range br.pending {
+		candidate, ok := br.getCandidate(requestId)
+		if !ok {
+			// the request may be recorded after its feedback arrives so the reward waits for one more update
+			if !reward.carried {
+				pending[requestId] = pendingReward{reward: reward.reward, carried: true}
+			}
+			continue
+		}
+		// a request is only rewarded once
+		delete(br.requests, requestId)
+		delete(br.previousRequests, requestId)
+		totals, ok := br.totals[candidate]
+		if !ok {
+			totals = &rewardTotals{}
+			br.totals[candidate] = totals
+		}
+		totals.count++
+		totals.sum += reward.reward
+	}
+	br.pending = pending
+	br.previousRequests = br.requests
+	br.requests = make(map[string]string)
+}
+
+func (b *Bandit) getUpdateInterval() time.Duration {
+	if b.UpdateInterval > 0 {
+		return b.UpdateInterval
+	}
+	return defaultBanditUpdateInterval
+}
+
+func (es *ExperimentStore) getBanditExperiment(experimentName string) (*Experiment, error) {
+	experiment, ok := es.experiments[experimentName]
+	if !ok || experiment.Deleted {
+		return nil, &ExperimentNotFound{experimentName: experimentName}
+	}
+	if experiment.Bandit == nil {
+		return nil, &ExperimentInvalidFeedback{experimentName: experimentName, reason: "experiment is not a bandit"}
+	}
+	return experiment, nil
+}
+
+func (es *ExperimentStore) getBanditRewards(experimentName string) *banditRewards {
+	rewards, ok := es.banditRewards[experimentName]
+	if !ok {
+		rewards = newBanditRewards(time.Now())
+		es.banditRewards[experimentName] = rewards
+	}
+	return rewards
+}
+
+func (es *ExperimentStore) restoreBanditRewards(experimentName string, totals map[string]*rewardTotals) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	rewards := newBanditRewards(time.Now())
+	rewards.totals = totals
+	es.banditRewards[experimentName] = rewards
+}
+
+// AddRequest records the candidate of a bandit experiment which served a request
+func (es *ExperimentStore) AddRequest(experimentName string, requestId string, candidateName string) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	experiment, err := es.getBanditExperiment(experimentName)
+	if err != nil {
+		return err
+	}
+	if requestId == "" {
+		return &ExperimentInvalidFeedback{experimentName: experimentName, reason: "missing request id"}
+	}
+	found := false
+	for _, candidate := range experiment.Candidates {
+		if candidate.Name == candidateName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &ExperimentInvalidFeedback{experimentName: experimentName, reason: fmt.Sprintf("candidate %s not found", candidateName)}
+	}
+	rewards := es.getBanditRewards(experimentName)
+	if _, ok := rewards.requests[requestId]; !ok && len(rewards.requests) >= maxBanditRequests {
+		return &ExperimentBanditFull{experimentName: experimentName}
+	}
+	rewards.requests[requestId] = candidateName
+	return nil
+}
+
+// AddReward records the reward for a request served by a candidate of a bandit experiment
+func (es *ExperimentStore) AddReward(experimentName string, requestId string, reward float64) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if _, err := es.getBanditExperiment(experimentName); err != nil {
+		return err
+	}
+	if requestId == "" {
+		return &ExperimentInvalidFeedback{experimentName: experimentName, reason: "missing request id"}
+	}
+	if math.IsNaN(reward) || reward < 0 || reward > 1 {
+		return &ExperimentInvalidFeedback{experimentName: experimentName, reason: fmt.Sprintf("reward %v must be between 0 and 1", reward)}
+	}
+	rewards := es.getBanditRewards(experimentName)
+	if _, ok := rewards.pending[requestId]; !ok && len(rewards.pending) >= maxBanditRequests {
+		return &ExperimentBanditFull{experimentName: experimentName}
+	}
+	rewards.pending[requestId] = pendingReward{reward: reward}
+	return nil
+}
+
+// StartBanditUpdates periodically recomputes the weights of bandit experiments until the context is done
+func (es *ExperimentStore) StartBanditUpdates(ctx context.Context) {
+	ticker := time.NewTicker(banditCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			es.UpdateBanditWeights(now)
+		}
+	}
+}
+
+// UpdateBanditWeights recomputes the candidate weights of the bandit experiments due an update. An experiment
+// event is published for each experiment with changed weights so the new traffic split is sent to envoy.
+func (es *ExperimentStore) UpdateBanditWeights(now time.Time) {
+	evts := es.updateBanditWeightsImpl(now)
+	if es.eventHub != nil {
+		for _, evt := range evts {
+			es.eventHub.PublishExperimentEvent(banditEventSource, *evt)
+		}
+	}
+}
+
+func (es *ExperimentStore) updateBanditWeightsImpl(now time.Time) []*coordinator.ExperimentEventMsg {
+	logger := es.logger.WithField("func", "updateBanditWeights")
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	var evts []*coordinator.ExperimentEventMsg
+	for experimentName, rewards := range es.banditRewards {
+		experiment, ok := es.experiments[experimentName]
+		if !ok || experiment.Deleted || experiment.Bandit == nil {
+			delete(es.banditRewards, experimentName)
+			if es.db != nil {
+				if err := es.db.deleteBanditRewards(experimentName); err != nil {
+					logger.WithError(err).Warnf("Failed to delete bandit rewards for experiment %s", experimentName)
+				}
+			}
+			continue
+		}
+		if now.Sub(rewards.lastUpdate) < experiment.Bandit.getUpdateInterval() {
+			continue
+		}
+		rewards.lastUpdate = now
+		rewards.addPending()
+		if es.db != nil {
+			if err := es.db.saveBanditRewards(experimentName, rewards.totals); err != nil {
+				logger.WithError(err).Warnf("Failed to save bandit rewards for experiment %s", experimentName)
+			}
+		}
+
+		var weights []uint32
+		switch experiment.Bandit.Strategy {
+		case ThompsonSamplingStrategy:
+			weights = getThompsonSamplingWeights(experiment.Candidates, rewards.totals, es.rng)
+		default:
+			weights = getEpsilonGreedyWeights(experiment.Candidates, rewards.totals, float64(experiment.Bandit.Epsilon))
+		}
+		if weights == nil {
+			continue
+		}
+		changed := false
+		for idx, candidate := range experiment.Candidates {
+			if candidate.Weight != weights[idx] {
+				candidate.Weight = weights[idx]
+				changed = true
+			}
+		}
+		if changed {
+			logger.Debugf("Updated weights for experiment %s to %v", experimentName, weights)
+			if es.db != nil {
+				if err := es.db.save(experiment); err != nil {
+					logger.WithError(err).Warnf("Failed to save weights for experiment %s", experimentName)
+				}
+			}
+			evts = append(evts, es.createExperimentEventMsg(experiment, true))
+		}
+	}
+	return evts
+}
+
+// getEpsilonGreedyWeights sends all traffic not explored to the candidate with the highest mean reward.
+// Returns nil if no candidate has a reward yet.
+func getEpsilonGreedyWeights(candidates []*Candidate, totals map[string]*rewardTotals, epsilon float64) []uint32 {
+	best := -1
+	bestMean := 0.0
+	for idx, candidate := range candidates {
+		if candidateTotals, ok := totals[candidate.Name]; ok && candidateTotals.count > 0 {
+			mean := candidateTotals.sum / candidateTotals.count
+			if best == -1 || mean > bestMean {
+				best = idx
+				bestMean = mean
+			}
+		}
+	}
+	if best == -1 {
+		return nil
+	}
+	explore := uint32(epsilon * 100 / float64(len(candidates)))
+	return getWeightsWithRemainder(len(candidates), best, func(int) uint32 { return explore })
+}
+
+// getThompsonSamplingWeights weights each candidate by the probability its reward is the highest, estimated
+// from draws of the beta distribution of each candidate's rewards. Returns nil if no candidate has a reward yet.
+func getThompsonSamplingWeights(candidates []*Candidate, totals map[string]*rewardTotals, rng *rand.Rand) []uint32 {
+	alphas := make([]float64, len(candidates))
+	betas := make([]float64, len(candidates))
+	hasRewards := false
+	for idx, candidate := range candidates {
+		alphas[idx], betas[idx] = 1, 1
+		if candidateTotals, ok := totals[candidate.Name]; ok && candidateTotals.count > 0 {
+			alphas[idx] += candidateTotals.sum
+			betas[idx] += candidateTotals.count - candidateTotals.sum
+			hasRewards = true
+		}
+	}
+	if !hasRewards {
+		return nil
+	}
+
+	wins := make([]int, len(candidates))
+	for draw := 0; draw < thompsonSamplingDraws; draw++ {
+		best := 0
+		bestSample := -1.0
+		for idx := range candidates {
+			sample := sampleBeta(rng, alphas[idx], betas[idx])
+			if sample > bestSample {
+				best = idx
+				bestSample = sample
+			}
+		}
+		wins[best]++
+	}
+	mostWins := 0
+	for idx := range wins {
+		if wins[idx] > wins[mostWins] {
+			mostWins = idx
+		}
+	}
+	return getWeightsWithRemainder(len(candidates), mostWins, func(idx int) uint32 {
+		return uint32(wins[idx] * 100 / thompsonSamplingDraws)
+	})
+}
+
+// getWeightsWithRemainder gives the remainder of the 100 percent after all other weights to the favoured candidate
+func getWeightsWithRemainder(numCandidates int, favoured int, weight func(int) uint32) []uint32 {
+	weights := make([]uint32, numCandidates)
+	var total uint32
+	for idx := range weights {
+		if idx != favoured {
+			weights[idx] = weight(idx)
+			total += weights[idx]
+		}
+	}
+	weights[favoured] = 100 - total
+	return weights
+}
+
+func sampleBeta(rng *rand.Rand, alpha float64, beta float64) float64 {
+	x := sampleGamma(rng, alpha)
+	y := sampleGamma(rng, beta)
+	return x / (x + y)
+}
+
+// sampleGamma uses the Marsaglia and Tsang method, which needs a shape of at least 1
+func sampleGamma(rng *rand.Rand, shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rng.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		if math.Log(rng.Float64()) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
@@ -0,0 +1,520 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package experiment
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestAddRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newExperiment := func(bandit *Bandit) *Experiment {
+		return &Experiment{
+			Name: "a",
+			Candidates: []*Candidate{
+				{Name: "model1", Weight: 50},
+				{Name: "model2", Weight: 50},
+			},
+			Bandit: bandit,
+		}
+	}
+
+	type test struct {
+		name       string
+		experiment *Experiment
+		requestId  string
+		candidate  string
+		err        error
+	}
+
+	tests := []test{
+		{
+			name:       "request added",
+			experiment: newExperiment(&Bandit{}),
+			requestId:  "req1",
+			candidate:  "model2",
+		},
+		{
+			name:       "experiment not found",
+			experiment: &Experiment{Name: "b"},
+			requestId:  "req1",
+			candidate:  "model2",
+			err:        &ExperimentNotFound{},
+		},
+		{
+			name:       "not a bandit",
+			experiment: newExperiment(nil),
+			requestId:  "req1",
+			candidate:  "model2",
+			err:        &ExperimentInvalidFeedback{},
+		},
+		{
+			name:       "unknown candidate",
+			experiment: newExperiment(&Bandit{}),
+			requestId:  "req1",
+			candidate:  "model3",
+			err:        &ExperimentInvalidFeedback{},
+		},
+		{
+			name:       "missing request id",
+			experiment: newExperiment(&Bandit{}),
+			candidate:  "model2",
+			err:        &ExperimentInvalidFeedback{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewExperimentServer(logrus.New(), nil, nil, nil)
+			server.experiments[test.experiment.Name] = test.experiment
+
+			err := server.AddRequest("a", test.requestId, test.candidate)
+			if test.err != nil {
+				g.Expect(err).To(MatchError(test.err))
+				g.Expect(server.banditRewards).To(BeEmpty())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(server.banditRewards["a"].requests[test.requestId]).To(Equal(test.candidate))
+			}
+		})
+	}
+}
+
+func TestAddReward(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newExperiment := func(bandit *Bandit) *Experiment {
+		return &Experiment{
+			Name: "a",
+			Candidates: []*Candidate{
+				{Name: "model1", Weight: 50},
+				{Name: "model2", Weight: 50},
+			},
+			Bandit: bandit,
+		}
+	}
+
+	type test struct {
+		name       string
+		experiment *Experiment
+		requestId  string
+		reward     float64
+		err        error
+	}
+
+	tests := []test{
+		{
+			name:       "reward added",
+			experiment: newExperiment(&Bandit{}),
+			requestId:  "req1",
+			reward:     1,
+		},
+		{
+			name:       "experiment not found",
+			experiment: &Experiment{Name: "b"},
+			requestId:  "req1",
+			reward:     1,
+			err:        &ExperimentNotFound{},
+		},
+		{
+			name:       "not a bandit",
+			experiment: newExperiment(nil),
+			requestId:  "req1",
+			reward:     1,
+			err:        &ExperimentInvalidFeedback{},
+		},
+		{
+			name:       "reward out of range",
+			experiment: newExperiment(&Bandit{}),
+			requestId:  "req1",
+			reward:     2,
+			err:        &ExperimentInvalidFeedback{},
+		},
+		{
+			name:       "missing request id",
+			experiment: newExperiment(&Bandit{}),
+			reward:     1,
+			err:        &ExperimentInvalidFeedback{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewExperimentServer(logrus.New(), nil, nil, nil)
+			server.experiments[test.experiment.Name] = test.experiment
+
+			err := server.AddReward("a", test.requestId, test.reward)
+			if test.err != nil {
+				g.Expect(err).To(MatchError(test.err))
+				g.Expect(server.banditRewards).To(BeEmpty())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(server.banditRewards["a"].pending[test.requestId]).To(Equal(pendingReward{reward: test.reward}))
+			}
+		})
+	}
+}
+
+func TestUpdateBanditWeights(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type reward struct {
+		requestId string
+		candidate string
+		reward    float64
+	}
+
+	type test struct {
+		name            string
+		bandit          *Bandit
+		rewards         []reward
+		elapsed         time.Duration
+		expectedWeights []uint32
+		expectedUpdated bool
+	}
+
+	tests := []test{
+		{
+			name:   "epsilon greedy favours highest mean reward",
+			bandit: &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 0.2, UpdateInterval: time.Minute},
+			rewards: []reward{
+				{requestId: "req1", candidate: "model1", reward: 0},
+				{requestId: "req2", candidate: "model2", reward: 1},
+				{requestId: "req3", candidate: "model3", reward: 0.5},
+			},
+			elapsed:         time.Minute,
+			expectedWeights: []uint32{6, 88, 6},
+			expectedUpdated: true,
+		},
+		{
+			name:   "latest reward for a request replaces earlier one",
+			bandit: &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 0, UpdateInterval: time.Minute},
+			rewards: []reward{
+				{requestId: "req1", candidate: "model1", reward: 0.5},
+				{requestId: "req2", candidate: "model3", reward: 1},
+				{requestId: "req2", candidate: "model3", reward: 0},
+			},
+			elapsed:         time.Minute,
+			expectedWeights: []uint32{100, 0, 0},
+			expectedUpdated: true,
+		},
+		{
+			name:   "thompson sampling favours highest reward",
+			bandit: &Bandit{Strategy: ThompsonSamplingStrategy, UpdateInterval: time.Minute},
+			rewards: func() []reward {
+				var rewards []reward
+				for i := 0; i < 50; i++ {
+					rewards = append(rewards,
+						reward{requestId: fmt.Sprintf("req%d-1", i), candidate: "model1", reward: 0},
+						reward{requestId: fmt.Sprintf("req%d-2", i), candidate: "model2", reward: 1},
+						reward{requestId: fmt.Sprintf("req%d-3", i), candidate: "model3", reward: 0},
+					)
+				}
+				return rewards
+			}(),
+			elapsed:         time.Minute,
+			expectedWeights: []uint32{0, 100, 0},
+			expectedUpdated: true,
+		},
+		{
+			name:   "waits for update interval",
+			bandit: &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 0.2, UpdateInterval: time.Minute},
+			rewards: []reward{
+				{requestId: "req1", candidate: "model2", reward: 1},
+			},
+			elapsed:         30 * time.Second,
+			expectedWeights: []uint32{40, 30, 30},
+		},
+		{
+			name:            "no rewards",
+			bandit:          &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 0.2, UpdateInterval: time.Minute},
+			elapsed:         time.Minute,
+			expectedWeights: []uint32{40, 30, 30},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewExperimentServer(logrus.New(), nil, nil, nil)
+			server.rng = rand.New(rand.NewSource(1))
+			experiment := &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{Name: "model1", Weight: 40},
+					{Name: "model2", Weight: 30},
+					{Name: "model3", Weight: 30},
+				},
+				Bandit: test.bandit,
+			}
+			server.experiments[experiment.Name] = experiment
+			for _, r := range test.rewards {
+				err := server.AddRequest(experiment.Name, r.requestId, r.candidate)
+				g.Expect(err).To(BeNil())
+				err = server.AddReward(experiment.Name, r.requestId, r.reward)
+				g.Expect(err).To(BeNil())
+			}
+			start := time.Now()
+			if rewards, ok := server.banditRewards[experiment.Name]; ok {
+				rewards.lastUpdate = start
+			}
+
+			evts := server.updateBanditWeightsImpl(start.Add(test.elapsed))
+			if test.expectedUpdated {
+				g.Expect(evts).To(HaveLen(1))
+				g.Expect(evts[0].ExperimentName).To(Equal(experiment.Name))
+				g.Expect(evts[0].UpdatedExperiment).To(BeTrue())
+			} else {
+				g.Expect(evts).To(BeEmpty())
+			}
+			var weights []uint32
+			for _, candidate := range experiment.Candidates {
+				weights = append(weights, candidate.Weight)
+			}
+			g.Expect(weights).To(Equal(test.expectedWeights))
+		})
+	}
+}
+
+func TestAddPendingRewards(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type update struct {
+		requests        map[string]string
+		rewards         map[string]float64
+		expectedTotals  map[string]rewardTotals
+		expectedPending []string
+	}
+
+	type test struct {
+		name    string
+		updates []update
+	}
+
+	tests := []test{
+		{
+			name: "reward added to candidate which served the request",
+			updates: []update{
+				{
+					requests:       map[string]string{"req1": "model1", "req2": "model2"},
+					rewards:        map[string]float64{"req1": 1},
+					expectedTotals: map[string]rewardTotals{"model1": {count: 1, sum: 1}},
+				},
+			},
+		},
+		{
+			name: "reward for request served before the last update",
+			updates: []update{
+				{
+					requests: map[string]string{"req1": "model1"},
+				},
+				{
+					rewards:        map[string]float64{"req1": 0.5},
+					expectedTotals: map[string]rewardTotals{"model1": {count: 1, sum: 0.5}},
+				},
+			},
+		},
+		{
+			name: "reward waits one update for its request",
+			updates: []update{
+				{
+					rewards:         map[string]float64{"req1": 1},
+					expectedTotals:  map[string]rewardTotals{},
+					expectedPending: []string{"req1"},
+				},
+				{
+					requests:       map[string]string{"req1": "model2"},
+					expectedTotals: map[string]rewardTotals{"model2": {count: 1, sum: 1}},
+				},
+			},
+		},
+		{
+			name: "reward for unknown request dropped",
+			updates: []update{
+				{
+					rewards:         map[string]float64{"req1": 1},
+					expectedTotals:  map[string]rewardTotals{},
+					expectedPending: []string{"req1"},
+				},
+				{
+					expectedTotals: map[string]rewardTotals{},
+				},
+			},
+		},
+		{
+			name: "request only rewarded once",
+			updates: []update{
+				{
+					requests:       map[string]string{"req1": "model1"},
+					rewards:        map[string]float64{"req1": 1},
+					expectedTotals: map[string]rewardTotals{"model1": {count: 1, sum: 1}},
+				},
+				{
+					rewards:        map[string]float64{"req1": 1},
+					expectedTotals: map[string]rewardTotals{"model1": {count: 1, sum: 1}},
+					// the reward is for a request that is no longer known
+					expectedPending: []string{"req1"},
+				},
+			},
+		},
+		{
+			name: "requests forgotten after two updates",
+			updates: []update{
+				{
+					requests: map[string]string{"req1": "model1"},
+				},
+				{},
+				{
+					rewards:         map[string]float64{"req1": 1},
+					expectedTotals:  map[string]rewardTotals{},
+					expectedPending: []string{"req1"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rewards := newBanditRewards(time.Now())
+			for _, u := range test.updates {
+				for requestId, candidate := range u.requests {
+					rewards.requests[requestId] = candidate
+				}
+				for requestId, reward := range u.rewards {
+					rewards.pending[requestId] = pendingReward{reward: reward}
+				}
+				rewards.addPending()
+
+				totals := make(map[string]rewardTotals)
+				for candidate, candidateTotals := range rewards.totals {
+					totals[candidate] = *candidateTotals
+				}
+				if u.expectedTotals != nil {
+					g.Expect(totals).To(Equal(u.expectedTotals))
+				}
+				var pending []string
+				for requestId := range rewards.pending {
+					pending = append(pending, requestId)
+				}
+				g.Expect(pending).To(Equal(u.expectedPending))
+			}
+		})
+	}
+}
+
+func TestSampleBeta(t *testing.T) {
+	g := NewGomegaWithT(t)
+	rng := rand.New(rand.NewSource(1))
+
+	draws := 10000
+	sum := 0.0
+	for i := 0; i < draws; i++ {
+		sample := sampleBeta(rng, 3, 7)
+		g.Expect(sample).To(And(BeNumerically(">=", 0), BeNumerically("<=", 1)))
+		sum += sample
+	}
+	// mean of beta(3, 7) is 3/(3+7)
+	g.Expect(sum / float64(draws)).To(BeNumerically("~", 0.3, 0.01))
+}
+
+func TestBanditRequestsBounded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := NewExperimentServer(logrus.New(), nil, nil, nil)
+	server.experiments["a"] = &Experiment{
+		Name:       "a",
+		Candidates: []*Candidate{{Name: "model1", Weight: 100}},
+		Bandit:     &Bandit{},
+	}
+	for i := 0; i < maxBanditRequests; i++ {
+		g.Expect(server.AddRequest("a", fmt.Sprintf("req%d", i), "model1")).To(BeNil())
+		g.Expect(server.AddReward("a", fmt.Sprintf("req%d", i), 1)).To(BeNil())
+	}
+
+	err := server.AddRequest("a", "another", "model1")
+	g.Expect(err).To(MatchError(&ExperimentBanditFull{}))
+	err = server.AddReward("a", "another", 1)
+	g.Expect(err).To(MatchError(&ExperimentBanditFull{}))
+	// existing requests can still be updated
+	g.Expect(server.AddReward("a", "req0", 0)).To(BeNil())
+	g.Expect(server.banditRewards["a"].requests).To(HaveLen(maxBanditRequests))
+	g.Expect(server.banditRewards["a"].pending).To(HaveLen(maxBanditRequests))
+
+	// the requests are moved on at the next update so new ones are accepted
+	server.updateBanditWeightsImpl(time.Now().Add(defaultBanditUpdateInterval))
+	g.Expect(server.AddRequest("a", "another", "model1")).To(BeNil())
+}
+
+func TestBanditRewardsRestored(t *testing.T) {
+	g := NewGomegaWithT(t)
+	path := fmt.Sprintf("%s/db", t.TempDir())
+
+	newExperiment := func() *Experiment {
+		return &Experiment{
+			Name: "a",
+			Candidates: []*Candidate{
+				{Name: "model1", Weight: 50},
+				{Name: "model2", Weight: 50},
+			},
+			Bandit: &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 0.2, UpdateInterval: time.Minute},
+		}
+	}
+
+	server := NewExperimentServer(logrus.New(), nil, nil, nil)
+	err := server.InitialiseOrRestoreDB(path, 10)
+	g.Expect(err).To(BeNil())
+	err = server.StartExperiment(newExperiment())
+	g.Expect(err).To(BeNil())
+	err = server.AddRequest("a", "req1", "model2")
+	g.Expect(err).To(BeNil())
+	err = server.AddReward("a", "req1", 1)
+	g.Expect(err).To(BeNil())
+	evts := server.updateBanditWeightsImpl(time.Now().Add(time.Minute))
+	g.Expect(evts).To(HaveLen(1))
+	err = server.db.Stop()
+	g.Expect(err).To(BeNil())
+
+	// the weights and the reward totals are restored, but not the bandit rewards key as an experiment
+	server = NewExperimentServer(logrus.New(), nil, nil, nil)
+	err = server.InitialiseOrRestoreDB(path, 10)
+	g.Expect(err).To(BeNil())
+	g.Expect(server.experiments).To(HaveLen(1))
+	var weights []uint32
+	for _, candidate := range server.experiments["a"].Candidates {
+		weights = append(weights, candidate.Weight)
+	}
+	g.Expect(weights).To(Equal([]uint32{10, 90}))
+	g.Expect(server.banditRewards["a"].totals).To(Equal(map[string]*rewardTotals{"model2": {count: 1, sum: 1}}))
+
+	// the weights are learnt again from the restored totals when the experiment is updated with its original weights
+	err = server.StartExperiment(newExperiment())
+	g.Expect(err).To(BeNil())
+	evts = server.updateBanditWeightsImpl(time.Now().Add(time.Minute))
+	g.Expect(evts).To(HaveLen(1))
+	g.Expect(server.experiments["a"].Candidates[1].Weight).To(Equal(uint32(90)))
+
+	// the totals are deleted with the experiment
+	err = server.StopExperiment("a")
+	g.Expect(err).To(BeNil())
+	err = server.db.Stop()
+	g.Expect(err).To(BeNil())
+	server = NewExperimentServer(logrus.New(), nil, nil, nil)
+	err = server.InitialiseOrRestoreDB(path, 10)
+	g.Expect(err).To(BeNil())
+	g.Expect(server.banditRewards).To(BeEmpty())
+	err = server.db.Stop()
+	g.Expect(err).To(BeNil())
+}
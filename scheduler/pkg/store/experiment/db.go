@@ -10,6 +10,8 @@ the Change License after the Change Date as each is defined in accordance with t
 package experiment
 
 import (
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
@@ -24,6 +26,8 @@ import (
 const (
 	defaultExperimentSnapshotVersion = "v1"
 	currentExperimentSnapshotVersion = "v2"
+	// experiment names are DNS labels so can not clash with these keys
+	banditRewardsKeyPrefix = "__bandit_rewards__/"
 )
 
 type ExperimentDBManager struct {
@@ -110,8 +114,8 @@ func (edb *ExperimentDBManager) restore(
 		for it.Rewind(); it.Valid(); it.Next() {
 			item := it.Item()
 			key := string(item.Key())
-			if key == utils.VersionKey {
-				// skip the version key
+			if key == utils.VersionKey || strings.HasPrefix(key, banditRewardsKeyPrefix) {
+				// skip the version key and the bandit rewards, which are restored separately
 				continue
 			}
 			err := item.Value(func(v []byte) error {
@@ -143,6 +147,63 @@ func (edb *ExperimentDBManager) restore(
 	})
 }
 
+func (edb *ExperimentDBManager) saveBanditRewards(experimentName string, totals map[string]*rewardTotals) error {
+	snapshot := &scheduler.BanditRewardsSnapshot{}
+	for candidate, candidateTotals := range totals {
+		snapshot.Candidates = append(snapshot.Candidates, &scheduler.BanditRewardTotals{
+			Candidate: candidate,
+			Count:     candidateTotals.count,
+			Sum:       candidateTotals.sum,
+		})
+	}
+	sort.Slice(snapshot.Candidates, func(i, j int) bool {
+		return snapshot.Candidates[i].Candidate < snapshot.Candidates[j].Candidate
+	})
+	snapshotBytes, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return edb.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(banditRewardsKeyPrefix+experimentName), snapshotBytes)
+	})
+}
+
+func (edb *ExperimentDBManager) deleteBanditRewards(experimentName string) error {
+	return utils.Delete(edb.db, banditRewardsKeyPrefix+experimentName)
+}
+
+// restoreBanditRewards calls back with the reward totals of each bandit experiment, only the totals are saved
+// so rewards waiting for the next weight update and the recorded requests are lost on a restart
+func (edb *ExperimentDBManager) restoreBanditRewards(restoreCb func(experimentName string, totals map[string]*rewardTotals)) error {
+	return edb.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(banditRewardsKeyPrefix)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			experimentName := strings.TrimPrefix(string(item.Key()), banditRewardsKeyPrefix)
+			err := item.Value(func(v []byte) error {
+				snapshot := scheduler.BanditRewardsSnapshot{}
+				err := proto.Unmarshal(v, &snapshot)
+				if err != nil {
+					return err
+				}
+				totals := make(map[string]*rewardTotals)
+				for _, candidate := range snapshot.Candidates {
+					totals[candidate.Candidate] = &rewardTotals{count: candidate.Count, sum: candidate.Sum}
+				}
+				restoreCb(experimentName, totals)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // get experiment by name from db
 func (edb *ExperimentDBManager) get(name string) (*Experiment, error) {
 	var experiment *Experiment
@@ -34,10 +34,10 @@ func createExperimentProto(experiment *Experiment) *scheduler.Experiment {
 		})
 	}
 	var mirror *scheduler.ExperimentMirror
-	if experiment.Mirror != nil {
+	if len(experiment.Mirrors) > 0 {
 		mirror = &scheduler.ExperimentMirror{
-			Name:    experiment.Mirror.Name,
-			Percent: experiment.Mirror.Percent,
+			Name:    experiment.Mirrors[0].Name,
+			Percent: experiment.Mirrors[0].Percent,
 		}
 	}
 	var config *scheduler.ExperimentConfig
@@ -86,9 +86,11 @@ func TestSaveWithTTL(t *testing.T) {
 				Weight: 50,
 			},
 		},
-		Mirror: &Mirror{
-			Name:    "model3",
-			Percent: 90,
+		Mirrors: []*Mirror{
+			{
+				Name:    "model3",
+				Percent: 90,
+			},
 		},
 		Config: &Config{
 			StickySessions: true,
@@ -157,9 +159,11 @@ func TestSaveAndRestore(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -187,9 +191,11 @@ func TestSaveAndRestore(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -211,9 +217,11 @@ func TestSaveAndRestore(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -242,9 +250,11 @@ func TestSaveAndRestore(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "pipeline3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "pipeline3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -449,9 +459,11 @@ func TestGetExperimentFromDB(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -473,9 +485,11 @@ func TestGetExperimentFromDB(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -591,9 +605,11 @@ func TestDeleteExperimentFromDB(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -615,9 +631,11 @@ func TestDeleteExperimentFromDB(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -720,9 +738,11 @@ func TestMigrateFromV1ToV2(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -749,9 +769,11 @@ func TestMigrateFromV1ToV2(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -773,9 +795,11 @@ func TestMigrateFromV1ToV2(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "model3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "model3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -803,9 +827,11 @@ func TestMigrateFromV1ToV2(t *testing.T) {
 							Weight: 50,
 						},
 					},
-					Mirror: &Mirror{
-						Name:    "pipeline3",
-						Percent: 90,
+					Mirrors: []*Mirror{
+						{
+							Name:    "pipeline3",
+							Percent: 90,
+						},
 					},
 					Config: &Config{
 						StickySessions: true,
@@ -68,3 +68,88 @@ func (enc *ExperimentNoDuplicates) Is(tgt error) bool {
 func (enc *ExperimentNoDuplicates) Error() string {
 	return fmt.Sprintf("each candidate and mirror must be unique but found resource %s duplicated in experiment %s", enc.resource, enc.experimentName)
 }
+
+type ExperimentInvalidMatch struct {
+	experimentName string
+	candidate      string
+	reason         string
+}
+
+func (eim *ExperimentInvalidMatch) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentInvalidMatch)
+	return ok
+}
+
+func (eim *ExperimentInvalidMatch) Error() string {
+	return fmt.Sprintf("invalid match for candidate %s in experiment %s: %s", eim.candidate, eim.experimentName, eim.reason)
+}
+
+type ExperimentInvalidMirrors struct {
+	experimentName string
+	reason         string
+}
+
+func (eim *ExperimentInvalidMirrors) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentInvalidMirrors)
+	return ok
+}
+
+func (eim *ExperimentInvalidMirrors) Error() string {
+	return fmt.Sprintf("invalid mirrors for experiment %s: %s", eim.experimentName, eim.reason)
+}
+
+type ExperimentInvalidBandit struct {
+	experimentName string
+	reason         string
+}
+
+func (eib *ExperimentInvalidBandit) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentInvalidBandit)
+	return ok
+}
+
+func (eib *ExperimentInvalidBandit) Error() string {
+	return fmt.Sprintf("invalid bandit for experiment %s: %s", eib.experimentName, eib.reason)
+}
+
+type ExperimentInvalidAuth struct {
+	experimentName string
+	candidate      string
+	other          string
+}
+
+func (eia *ExperimentInvalidAuth) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentInvalidAuth)
+	return ok
+}
+
+func (eia *ExperimentInvalidAuth) Error() string {
+	return fmt.Sprintf("candidates %s and %s of experiment %s have different auth rules", eia.other, eia.candidate, eia.experimentName)
+}
+
+type ExperimentInvalidFeedback struct {
+	experimentName string
+	reason         string
+}
+
+func (eif *ExperimentInvalidFeedback) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentInvalidFeedback)
+	return ok
+}
+
+func (eif *ExperimentInvalidFeedback) Error() string {
+	return fmt.Sprintf("invalid feedback for experiment %s: %s", eif.experimentName, eif.reason)
+}
+
+type ExperimentBanditFull struct {
+	experimentName string
+}
+
+func (ebf *ExperimentBanditFull) Is(tgt error) bool {
+	_, ok := tgt.(*ExperimentBanditFull)
+	return ok
+}
+
+func (ebf *ExperimentBanditFull) Error() string {
+	return fmt.Sprintf("too many requests waiting for the next weight update of experiment %s", ebf.experimentName)
+}
@@ -26,8 +26,10 @@ type Experiment struct {
 	Default           *string
 	ResourceType      ResourceType
 	Candidates        []*Candidate
-	Mirror            *Mirror
+	Mirrors           []*Mirror
+	CaptureResponses  bool
 	Config            *Config
+	Bandit            *Bandit
 	StatusDescription string
 	KubernetesMeta    *KubernetesMeta
 }
@@ -42,8 +44,10 @@ func (e *Experiment) AreCandidatesReady() bool {
 }
 
 func (e *Experiment) IsMirrorReady() bool {
-	if e.Mirror != nil {
-		return e.Mirror.Ready
+	for _, mirror := range e.Mirrors {
+		if !mirror.Ready {
+			return false
+		}
 	}
 	return true
 }
@@ -54,9 +58,18 @@ type KubernetesMeta struct {
 }
 
 type Candidate struct {
-	Name   string
-	Weight uint32
-	Ready  bool
+	Name    string
+	Weight  uint32
+	Ready   bool
+	Matches []*Match
+}
+
+// Match is a rule on either a request header or a query parameter, with either an exact value or a regex
+type Match struct {
+	Header         string
+	QueryParameter string
+	Exact          string
+	Regex          string
 }
 
 type Mirror struct {
@@ -68,3 +81,17 @@ type Mirror struct {
 type Config struct {
 	StickySessions bool
 }
+
+type BanditStrategy uint32
+
+const (
+	EpsilonGreedyStrategy BanditStrategy = iota
+	ThompsonSamplingStrategy
+)
+
+// Bandit recomputes the candidate weights every update interval from the rewards for the requests each candidate served
+type Bandit struct {
+	Strategy       BanditStrategy
+	Epsilon        float32
+	UpdateInterval time.Duration
+}
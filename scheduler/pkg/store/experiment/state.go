@@ -58,8 +58,8 @@ func (es *ExperimentStore) addReferences(experiment *Experiment) {
 	for _, candidate := range experiment.Candidates {
 		es.addReference(candidate.Name, experiment)
 	}
-	if experiment.Mirror != nil {
-		es.addReference(experiment.Mirror.Name, experiment)
+	for _, mirror := range experiment.Mirrors {
+		es.addReference(mirror.Name, experiment)
 	}
 }
 
@@ -67,8 +67,8 @@ func (es *ExperimentStore) removeReferences(experiment *Experiment) {
 	for _, candidate := range experiment.Candidates {
 		es.removeReference(candidate.Name, experiment)
 	}
-	if experiment.Mirror != nil {
-		es.removeReference(experiment.Mirror.Name, experiment)
+	for _, mirror := range experiment.Mirrors {
+		es.removeReference(mirror.Name, experiment)
 	}
 }
 
@@ -142,13 +142,13 @@ func (es *ExperimentStore) setCandidateAndMirrorReadiness(experiment *Experiment
 						pv.State.PipelineGwStatus == pipeline2.PipelineReady
 				}
 			}
-			if experiment.Mirror != nil {
-				pipeline, err := es.pipelineStore.GetPipeline(experiment.Mirror.Name)
+			for _, mirror := range experiment.Mirrors {
+				pipeline, err := es.pipelineStore.GetPipeline(mirror.Name)
 				if err != nil {
-					logger.WithError(err).Warnf("Failed to get pipeline %s for mirror check for experiment %s", experiment.Mirror.Name, experiment.Name)
+					logger.WithError(err).Warnf("Failed to get pipeline %s for mirror check for experiment %s", mirror.Name, experiment.Name)
 				} else {
 					pv := pipeline.GetLatestPipelineVersion()
-					experiment.Mirror.Ready = pv != nil &&
+					mirror.Ready = pv != nil &&
 						pv.State.Status == pipeline2.PipelineReady &&
 						pv.State.PipelineGwStatus == pipeline2.PipelineReady
 				}
@@ -168,15 +168,15 @@ func (es *ExperimentStore) setCandidateAndMirrorReadiness(experiment *Experiment
 					}
 				}
 			}
-			if experiment.Mirror != nil {
-				model, err := es.store.GetModel(experiment.Mirror.Name)
+			for _, mirror := range experiment.Mirrors {
+				model, err := es.store.GetModel(mirror.Name)
 				if err != nil {
-					logger.WithError(err).Warnf("Failed to get model %s for mirror check for experiment %s", experiment.Mirror.Name, experiment.Name)
+					logger.WithError(err).Warnf("Failed to get model %s for mirror check for experiment %s", mirror.Name, experiment.Name)
 				} else {
 					if model.GetLatest() != nil && model.GetLatest().ModelState().State == store.ModelAvailable {
-						experiment.Mirror.Ready = true
+						mirror.Ready = true
 					} else {
-						experiment.Mirror.Ready = false
+						mirror.Ready = false
 					}
 				}
 			}
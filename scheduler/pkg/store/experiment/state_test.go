@@ -676,8 +676,10 @@ func TestSetCandidateAndMirrorModelReadiness(t *testing.T) {
 						Name: "model1",
 					},
 				},
-				Mirror: &Mirror{
-					Name: "model2",
+				Mirrors: []*Mirror{
+					{
+						Name: "model2",
+					},
 				},
 			},
 			modelStates:             map[string]store.ModelState{"model1": store.ModelAvailable, "model2": store.ModelAvailable},
@@ -706,6 +708,7 @@ func TestSetCandidateAndMirrorModelReadiness(t *testing.T) {
 type fakePipelineStore struct {
 	status           map[string]pipeline.PipelineStatus
 	pipelineGwStatus map[string]pipeline.PipelineStatus
+	auth             map[string]*pipeline.AuthSpec
 }
 
 func (f fakePipelineStore) IsLatestVersion(pipelineName string, version uint32, uid string) (bool, error) {
@@ -737,6 +740,7 @@ func (f fakePipelineStore) GetPipeline(name string) (*pipeline.Pipeline, error)
 					Status:           f.status[name],
 					PipelineGwStatus: f.pipelineGwStatus[name],
 				},
+				Auth: f.auth[name],
 			},
 		},
 	}, nil
@@ -906,8 +910,10 @@ func TestSetCandidateAndMirrorPipelineReadiness(t *testing.T) {
 						Name: "pipeline1",
 					},
 				},
-				Mirror: &Mirror{
-					Name: "pipeline2",
+				Mirrors: []*Mirror{
+					{
+						Name: "pipeline2",
+					},
 				},
 			},
 			pipelineStates:          map[string]pipeline.PipelineStatus{"pipeline1": pipeline.PipelineReady, "pipeline2": pipeline.PipelineReady},
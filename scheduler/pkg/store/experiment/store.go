@@ -11,6 +11,7 @@ package experiment
 
 import (
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"sync"
@@ -43,6 +44,8 @@ type ExperimentServer interface {
 	GetExperimentForBaselineModel(modelName string) *Experiment
 	GetExperimentForBaselinePipeline(pipelineName string) *Experiment
 	SetStatus(experimentName string, active bool, reason string) error
+	AddRequest(experimentName string, requestId string, candidateName string) error
+	AddReward(experimentName string, requestId string, reward float64) error
 }
 
 type ExperimentStore struct {
@@ -57,6 +60,8 @@ type ExperimentStore struct {
 	store              store.ModelStore
 	pipelineStore      pipeline.PipelineHandler
 	db                 *ExperimentDBManager
+	banditRewards      map[string]*banditRewards // experiment name to rewards received for bandit experiments
+	rng                *rand.Rand
 }
 
 func NewExperimentServer(logger logrus.FieldLogger, eventHub *coordinator.EventHub, store store.ModelStore, pipelineStore pipeline.PipelineHandler) *ExperimentStore {
@@ -70,6 +75,8 @@ func NewExperimentServer(logger logrus.FieldLogger, eventHub *coordinator.EventH
 		eventHub:           eventHub,
 		store:              store,
 		pipelineStore:      pipelineStore,
+		banditRewards:      make(map[string]*banditRewards),
+		rng:                rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 
 	if eventHub != nil {
@@ -121,6 +128,11 @@ func (es *ExperimentStore) InitialiseOrRestoreDB(path string, deletedResourceTTL
 		return err
 	}
 	es.db = db
+	// The bandit rewards are restored first so they are kept when their experiments are restored
+	err = es.db.restoreBanditRewards(es.restoreBanditRewards)
+	if err != nil {
+		return err
+	}
 	// If database already existed we can restore else this is a noop
 	err = es.db.restore(es.StartExperiment, es.addExperimentInMap)
 	if err != nil {
@@ -202,16 +214,16 @@ func (es *ExperimentStore) handleModelEvents(event coordinator.ModelEventMsg) {
 					}
 				}
 			}
-			if experiment.Mirror != nil {
-				if experiment.Mirror.Name == event.ModelName {
+			for _, mirror := range experiment.Mirrors {
+				if mirror.Name == event.ModelName {
 					model, err := es.store.GetModel(event.ModelName)
 					if err != nil {
 						logger.WithError(err).Warnf("Failed to get model %s for mirror check for experiment %s", event.ModelName, experiment.Name)
 					} else {
 						if model.GetLatest() != nil && model.GetLatest().ModelState().State == store.ModelAvailable {
-							experiment.Mirror.Ready = true
+							mirror.Ready = true
 						} else {
-							experiment.Mirror.Ready = false
+							mirror.Ready = false
 						}
 					}
 				}
@@ -253,14 +265,14 @@ func (es *ExperimentStore) handlePipelineEvents(event coordinator.PipelineEventM
 					}
 				}
 			}
-			if experiment.Mirror != nil {
-				if experiment.Mirror.Name == event.PipelineName {
+			for _, mirror := range experiment.Mirrors {
+				if mirror.Name == event.PipelineName {
 					p, err := es.pipelineStore.GetPipeline(event.PipelineName)
 					if err != nil {
 						logger.WithError(err).Warnf("Failed to get pipeline %s for mirror check for experiment %s", event.PipelineName, experiment.Name)
 					} else {
 						pv := p.GetLatestPipelineVersion()
-						experiment.Mirror.Ready = pv != nil &&
+						mirror.Ready = pv != nil &&
 							pv.State.Status == pipeline.PipelineReady &&
 							pv.State.PipelineGwStatus == pipeline.PipelineReady
 					}
@@ -407,6 +419,12 @@ func (es *ExperimentStore) stopExperimentImpl(experimentName string) (*coordinat
 		experiment.Deleted = true
 		experiment.DeletedAt = time.Now()
 		experiment.Active = false
+		if experiment.Bandit != nil && es.db != nil {
+			if err := es.db.deleteBanditRewards(experimentName); err != nil {
+				logger.WithError(err).Warnf("Failed to delete bandit rewards for experiment %s", experimentName)
+			}
+		}
+		delete(es.banditRewards, experimentName)
 		es.cleanExperimentState(experiment)
 		if experiment.Default != nil {
 			switch experiment.ResourceType {
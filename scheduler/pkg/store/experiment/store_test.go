@@ -450,6 +450,7 @@ func TestRestoreExperiments(t *testing.T) {
 
 type fakeModelStore struct {
 	status map[string]store.ModelState
+	auth   map[string]*scheduler.AuthSpec
 }
 
 var _ store.ModelStore = (*fakeModelStore)(nil)
@@ -462,7 +463,7 @@ func (f fakeModelStore) GetModel(key string) (*store.ModelSnapshot, error) {
 	return &store.ModelSnapshot{
 		Name: key,
 		Versions: []*store.ModelVersion{
-			store.NewModelVersion(nil, 1, "server", nil, false, f.status[key]),
+			store.NewModelVersion(&scheduler.Model{DeploymentSpec: &scheduler.DeploymentSpec{Auth: f.auth[key]}}, 1, "server", nil, false, f.status[key]),
 		},
 	}, nil
 }
@@ -495,6 +496,10 @@ func (f fakeModelStore) UpdateLoadedModels(modelKey string, version uint32, serv
 	panic("implement me")
 }
 
+func (f fakeModelStore) UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	panic("implement me")
+}
+
 func (f fakeModelStore) UnloadVersionModels(modelKey string, version uint32) (bool, error) {
 	panic("implement me")
 }
@@ -535,6 +540,14 @@ func (f fakeModelStore) SetModelGwModelState(name string, versionNumber uint32,
 	panic("implement me")
 }
 
+func (f fakeModelStore) SetSchedulingWarning(modelID string, version uint32, warning string) error {
+	panic("implement me")
+}
+
+func (f fakeModelStore) SetModelRollout(modelID string, version uint32, rollout *store.RolloutStatus) error {
+	panic("implement me")
+}
+
 func TestHandleModelEvents(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -642,8 +655,10 @@ func TestHandleModelEvents(t *testing.T) {
 						Name: "model1",
 					},
 				},
-				Mirror: &Mirror{
-					Name: "model2",
+				Mirrors: []*Mirror{
+					{
+						Name: "model2",
+					},
 				},
 			},
 			modelStates: map[string]store.ModelState{"model1": store.ModelAvailable, "model2": store.ModelAvailable},
@@ -10,25 +10,42 @@ the Change License after the Change Date as each is defined in accordance with t
 package experiment
 
 import (
+	"time"
+
 	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
 )
 
 func CreateExperimentFromRequest(request *scheduler.Experiment) *Experiment {
 	var candidates []*Candidate
-	var mirror *Mirror
+	var mirrors []*Mirror
 	var config *Config
 	var k8sMeta *KubernetesMeta
 	for _, reqCandidate := range request.Candidates {
+		var matches []*Match
+		for _, reqMatch := range reqCandidate.Matches {
+			matches = append(matches, &Match{
+				Header:         reqMatch.Header,
+				QueryParameter: reqMatch.QueryParameter,
+				Exact:          reqMatch.Exact,
+				Regex:          reqMatch.Regex,
+			})
+		}
 		candidates = append(candidates, &Candidate{
-			Name:   reqCandidate.Name,
-			Weight: reqCandidate.Weight,
+			Name:    reqCandidate.Name,
+			Weight:  reqCandidate.Weight,
+			Matches: matches,
 		})
 	}
+	// the single mirror is kept for requests from older clients and comes before any others
+	reqMirrors := request.Mirrors
 	if request.Mirror != nil {
-		mirror = &Mirror{
-			Name:    request.Mirror.Name,
-			Percent: request.Mirror.Percent,
-		}
+		reqMirrors = append([]*scheduler.ExperimentMirror{request.Mirror}, reqMirrors...)
+	}
+	for _, reqMirror := range reqMirrors {
+		mirrors = append(mirrors, &Mirror{
+			Name:    reqMirror.Name,
+			Percent: reqMirror.Percent,
+		})
 	}
 	if request.Config != nil {
 		config = &Config{
@@ -48,16 +65,33 @@ func CreateExperimentFromRequest(request *scheduler.Experiment) *Experiment {
 	case scheduler.ResourceType_MODEL:
 		resourceType = ModelResourceType
 	}
+	var bandit *Bandit
+	if request.Bandit != nil {
+		var strategy BanditStrategy
+		switch request.Bandit.Strategy {
+		case scheduler.BanditConfig_EPSILON_GREEDY:
+			strategy = EpsilonGreedyStrategy
+		case scheduler.BanditConfig_THOMPSON_SAMPLING:
+			strategy = ThompsonSamplingStrategy
+		}
+		bandit = &Bandit{
+			Strategy:       strategy,
+			Epsilon:        request.Bandit.Epsilon,
+			UpdateInterval: time.Duration(request.Bandit.UpdateIntervalSeconds) * time.Second,
+		}
+	}
 	return &Experiment{
-		Name:           request.Name,
-		Default:        request.Default,
-		ResourceType:   resourceType,
-		Active:         false, // this is always false when creating from a request
-		Deleted:        false,
-		Candidates:     candidates,
-		Mirror:         mirror,
-		Config:         config,
-		KubernetesMeta: k8sMeta,
+		Name:             request.Name,
+		Default:          request.Default,
+		ResourceType:     resourceType,
+		Active:           false, // this is always false when creating from a request
+		Deleted:          false,
+		Candidates:       candidates,
+		Mirrors:          mirrors,
+		CaptureResponses: request.CaptureResponses,
+		Config:           config,
+		Bandit:           bandit,
+		KubernetesMeta:   k8sMeta,
 	}
 }
 
@@ -70,17 +104,27 @@ func CreateExperimentFromSnapshot(request *scheduler.ExperimentSnapshot) *Experi
 func CreateExperimentSnapshotProto(experiment *Experiment) *scheduler.ExperimentSnapshot {
 	var candidates []*scheduler.ExperimentCandidate
 	for _, candidate := range experiment.Candidates {
+		var matches []*scheduler.ExperimentMatch
+		for _, match := range candidate.Matches {
+			matches = append(matches, &scheduler.ExperimentMatch{
+				Header:         match.Header,
+				QueryParameter: match.QueryParameter,
+				Exact:          match.Exact,
+				Regex:          match.Regex,
+			})
+		}
 		candidates = append(candidates, &scheduler.ExperimentCandidate{
-			Name:   candidate.Name,
-			Weight: candidate.Weight,
+			Name:    candidate.Name,
+			Weight:  candidate.Weight,
+			Matches: matches,
 		})
 	}
-	var mirror *scheduler.ExperimentMirror
-	if experiment.Mirror != nil {
-		mirror = &scheduler.ExperimentMirror{
-			Name:    experiment.Mirror.Name,
-			Percent: experiment.Mirror.Percent,
-		}
+	var mirrors []*scheduler.ExperimentMirror
+	for _, mirror := range experiment.Mirrors {
+		mirrors = append(mirrors, &scheduler.ExperimentMirror{
+			Name:    mirror.Name,
+			Percent: mirror.Percent,
+		})
 	}
 	var config *scheduler.ExperimentConfig
 	if experiment.Config != nil {
@@ -102,15 +146,32 @@ func CreateExperimentSnapshotProto(experiment *Experiment) *scheduler.Experiment
 	case ModelResourceType:
 		resourceType = scheduler.ResourceType_MODEL
 	}
+	var bandit *scheduler.BanditConfig
+	if experiment.Bandit != nil {
+		var strategy scheduler.BanditConfig_BanditStrategy
+		switch experiment.Bandit.Strategy {
+		case EpsilonGreedyStrategy:
+			strategy = scheduler.BanditConfig_EPSILON_GREEDY
+		case ThompsonSamplingStrategy:
+			strategy = scheduler.BanditConfig_THOMPSON_SAMPLING
+		}
+		bandit = &scheduler.BanditConfig{
+			Strategy:              strategy,
+			Epsilon:               experiment.Bandit.Epsilon,
+			UpdateIntervalSeconds: uint32(experiment.Bandit.UpdateInterval.Seconds()),
+		}
+	}
 	return &scheduler.ExperimentSnapshot{
 		Experiment: &scheduler.Experiment{
-			Name:           experiment.Name,
-			Default:        experiment.Default,
-			ResourceType:   resourceType,
-			Candidates:     candidates,
-			Mirror:         mirror,
-			Config:         config,
-			KubernetesMeta: k8sMeta,
+			Name:             experiment.Name,
+			Default:          experiment.Default,
+			ResourceType:     resourceType,
+			Candidates:       candidates,
+			Mirrors:          mirrors,
+			CaptureResponses: experiment.CaptureResponses,
+			Config:           config,
+			KubernetesMeta:   k8sMeta,
+			Bandit:           bandit,
 		},
 		Deleted: experiment.Deleted,
 	}
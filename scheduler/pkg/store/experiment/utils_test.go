@@ -11,6 +11,7 @@ package experiment
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
@@ -27,6 +28,132 @@ func TestCreateExperiment(t *testing.T) {
 
 	getStrPtr := func(val string) *string { return &val }
 	tests := []test{
+		{
+			name: "model with matches",
+			proto: &scheduler.Experiment{
+				Name:    "foo",
+				Default: getStrPtr("model1"),
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+					{
+						Name: "model2",
+						Matches: []*scheduler.ExperimentMatch{
+							{Header: "tenant", Exact: "a"},
+							{QueryParameter: "region", Regex: "eu-.*"},
+						},
+					},
+				},
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+					{
+						Name: "model2",
+						Matches: []*Match{
+							{Header: "tenant", Exact: "a"},
+							{QueryParameter: "region", Regex: "eu-.*"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "model with bandit",
+			proto: &scheduler.Experiment{
+				Name:    "foo",
+				Default: getStrPtr("model1"),
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Bandit: &scheduler.BanditConfig{
+					Strategy:              scheduler.BanditConfig_THOMPSON_SAMPLING,
+					Epsilon:               0.1,
+					UpdateIntervalSeconds: 30,
+				},
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 50,
+					},
+					{
+						Name:   "model2",
+						Weight: 50,
+					},
+				},
+				Bandit: &Bandit{
+					Strategy:       ThompsonSamplingStrategy,
+					Epsilon:        0.1,
+					UpdateInterval: 30 * time.Second,
+				},
+			},
+		},
+		{
+			name: "model with multiple mirrors",
+			proto: &scheduler.Experiment{
+				Name:    "foo",
+				Default: getStrPtr("model1"),
+				Candidates: []*scheduler.ExperimentCandidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+				},
+				Mirror: &scheduler.ExperimentMirror{
+					Name:    "model2",
+					Percent: 100,
+				},
+				Mirrors: []*scheduler.ExperimentMirror{
+					{
+						Name:    "model3",
+						Percent: 50,
+					},
+				},
+				CaptureResponses: true,
+			},
+			experiment: &Experiment{
+				Name:         "foo",
+				Default:      getStrPtr("model1"),
+				ResourceType: ModelResourceType,
+				Candidates: []*Candidate{
+					{
+						Name:   "model1",
+						Weight: 100,
+					},
+				},
+				Mirrors: []*Mirror{
+					{
+						Name:    "model2",
+						Percent: 100,
+					},
+					{
+						Name:    "model3",
+						Percent: 50,
+					},
+				},
+				CaptureResponses: true,
+			},
+		},
 		{
 			name: "model",
 			proto: &scheduler.Experiment{
@@ -69,9 +196,11 @@ func TestCreateExperiment(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &Mirror{
-					Name:    "model4",
-					Percent: 80,
+				Mirrors: []*Mirror{
+					{
+						Name:    "model4",
+						Percent: 80,
+					},
 				},
 				Config: &Config{
 					StickySessions: true,
@@ -96,9 +225,11 @@ func TestCreateExperiment(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &scheduler.ExperimentMirror{
-					Name:    "model4",
-					Percent: 80,
+				Mirrors: []*scheduler.ExperimentMirror{
+					{
+						Name:    "model4",
+						Percent: 80,
+					},
 				},
 				Config: &scheduler.ExperimentConfig{
 					StickySessions: true,
@@ -122,9 +253,11 @@ func TestCreateExperiment(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &Mirror{
-					Name:    "model4",
-					Percent: 80,
+				Mirrors: []*Mirror{
+					{
+						Name:    "model4",
+						Percent: 80,
+					},
 				},
 				Config: &Config{
 					StickySessions: true,
@@ -151,9 +284,11 @@ func TestCreateExperiment(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &scheduler.ExperimentMirror{
-					Name:    "pipeline4",
-					Percent: 80,
+				Mirrors: []*scheduler.ExperimentMirror{
+					{
+						Name:    "pipeline4",
+						Percent: 80,
+					},
 				},
 				Config: &scheduler.ExperimentConfig{
 					StickySessions: true,
@@ -178,9 +313,11 @@ func TestCreateExperiment(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &Mirror{
-					Name:    "pipeline4",
-					Percent: 80,
+				Mirrors: []*Mirror{
+					{
+						Name:    "pipeline4",
+						Percent: 80,
+					},
 				},
 				Config: &Config{
 					StickySessions: true,
@@ -258,9 +395,11 @@ func TestCreateExperimentFromSnapshot(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &Mirror{
-					Name:    "model4",
-					Percent: 80,
+				Mirrors: []*Mirror{
+					{
+						Name:    "model4",
+						Percent: 80,
+					},
 				},
 				Config: &Config{
 					StickySessions: true,
@@ -317,9 +456,11 @@ func TestCreateExperimentFromSnapshot(t *testing.T) {
 						Weight: 20,
 					},
 				},
-				Mirror: &Mirror{
-					Name:    "model4",
-					Percent: 80,
+				Mirrors: []*Mirror{
+					{
+						Name:    "model4",
+						Percent: 80,
+					},
 				},
 				Config: &Config{
 					StickySessions: true,
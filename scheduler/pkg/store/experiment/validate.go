@@ -9,7 +9,13 @@ the Change License after the Change Date as each is defined in accordance with t
 
 package experiment
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/util"
+)
 
 func (es *ExperimentStore) validateNoExistingDefault(experiment *Experiment) error {
 	if experiment.Default != nil {
@@ -34,7 +40,7 @@ func (es *ExperimentStore) validateNoExistingDefault(experiment *Experiment) err
 }
 
 func validateHasCandidateOrMirror(experiment *Experiment) error {
-	if len(experiment.Candidates) == 0 && experiment.Mirror == nil {
+	if len(experiment.Candidates) == 0 && len(experiment.Mirrors) == 0 {
 		return &ExperimentNoCandidatesOrMirrors{experimentName: experiment.Name}
 	}
 	return nil
@@ -60,11 +66,141 @@ func validateNoDuplicateNames(experiment *Experiment) error {
 		}
 		names[candidate.Name] = true
 	}
-	if experiment.Mirror != nil {
-		if _, ok := names[experiment.Mirror.Name]; ok {
-			return &ExperimentNoDuplicates{experimentName: experiment.Name, resource: experiment.Mirror.Name}
+	for _, mirror := range experiment.Mirrors {
+		if _, ok := names[mirror.Name]; ok {
+			return &ExperimentNoDuplicates{experimentName: experiment.Name, resource: mirror.Name}
+		}
+		names[mirror.Name] = true
+	}
+	return nil
+}
+
+func validateMatches(experiment *Experiment) error {
+	for _, candidate := range experiment.Candidates {
+		for _, match := range candidate.Matches {
+			if err := validateMatch(match); err != nil {
+				return &ExperimentInvalidMatch{experimentName: experiment.Name, candidate: candidate.Name, reason: err.Error()}
+			}
+		}
+	}
+	return nil
+}
+
+func validateMatch(match *Match) error {
+	if (match.Header == "") == (match.QueryParameter == "") {
+		return fmt.Errorf("exactly one of header or queryParameter must be set")
+	}
+	if (match.Exact == "") == (match.Regex == "") {
+		return fmt.Errorf("exactly one of exact or regex must be set")
+	}
+	if match.Regex != "" {
+		// Envoy uses RE2 which shares its syntax with go regular expressions
+		if _, err := regexp.Compile(match.Regex); err != nil {
+			return fmt.Errorf("invalid regex %s: %w", match.Regex, err)
+		}
+	}
+	return nil
+}
+
+func validateMirrors(experiment *Experiment) error {
+	if len(experiment.Mirrors) > util.MaxExperimentMirrors {
+		return &ExperimentInvalidMirrors{experimentName: experiment.Name, reason: fmt.Sprintf("at most %d mirrors are allowed", util.MaxExperimentMirrors)}
+	}
+	if experiment.CaptureResponses {
+		if len(experiment.Mirrors) == 0 {
+			return &ExperimentInvalidMirrors{experimentName: experiment.Name, reason: "capturing responses needs a mirror to compare with"}
+		}
+		// responses are captured by the agents of the model servers
+		if experiment.ResourceType != ModelResourceType {
+			return &ExperimentInvalidMirrors{experimentName: experiment.Name, reason: "capturing responses is only supported for model experiments"}
+		}
+	}
+	return nil
+}
+
+func validateBandit(experiment *Experiment) error {
+	if experiment.Bandit == nil {
+		return nil
+	}
+	if len(experiment.Candidates) < 2 {
+		return &ExperimentInvalidBandit{experimentName: experiment.Name, reason: "at least 2 candidates are needed"}
+	}
+	if experiment.Bandit.Epsilon < 0 || experiment.Bandit.Epsilon > 1 {
+		return &ExperimentInvalidBandit{experimentName: experiment.Name, reason: fmt.Sprintf("epsilon %v must be between 0 and 1", experiment.Bandit.Epsilon)}
+	}
+	return nil
+}
+
+// candidateAuth is the auth rules of a candidate with its audiences and groups sorted, nil when it has none
+type candidateAuth struct {
+	audiences []string
+	groups    []string
+}
+
+func newCandidateAuth(audiences []string, groups []string) *candidateAuth {
+	return &candidateAuth{
+		audiences: slices.Sorted(slices.Values(audiences)),
+		groups:    slices.Sorted(slices.Values(groups)),
+	}
+}
+
+func (ca *candidateAuth) equal(other *candidateAuth) bool {
+	if ca == nil || other == nil {
+		return ca == other
+	}
+	return slices.Equal(ca.audiences, other.audiences) && slices.Equal(ca.groups, other.groups)
+}
+
+// getCandidateAuth returns the auth rules of the latest version of a candidate, false when the candidate does not exist
+func (es *ExperimentStore) getCandidateAuth(experiment *Experiment, name string) (*candidateAuth, bool) {
+	switch experiment.ResourceType {
+	case PipelineResourceType:
+		if es.pipelineStore == nil {
+			return nil, false
+		}
+		p, err := es.pipelineStore.GetPipeline(name)
+		if err != nil || p == nil || p.GetLatestPipelineVersion() == nil {
+			return nil, false
+		}
+		auth := p.GetLatestPipelineVersion().Auth
+		if auth == nil {
+			return nil, true
+		}
+		return newCandidateAuth(auth.Audiences, auth.Groups), true
+	default:
+		if es.store == nil {
+			return nil, false
+		}
+		model, err := es.store.GetModel(name)
+		if err != nil || model == nil || model.GetLatest() == nil {
+			return nil, false
+		}
+		auth := model.GetLatest().GetDeploymentSpec().GetAuth()
+		if auth == nil {
+			return nil, true
+		}
+		return newCandidateAuth(auth.Audiences, auth.Groups), true
+	}
+}
+
+// validateCandidateAuth rejects candidates with different auth rules, as requests to the experiment can be
+// routed to any of them. Candidates that do not exist yet are not checked, envoy requires callers to match
+// the rules of every candidate.
+func (es *ExperimentStore) validateCandidateAuth(experiment *Experiment) error {
+	var first string
+	var firstAuth *candidateAuth
+	for _, candidate := range experiment.Candidates {
+		auth, ok := es.getCandidateAuth(experiment, candidate.Name)
+		if !ok {
+			continue
+		}
+		if first == "" {
+			first, firstAuth = candidate.Name, auth
+			continue
+		}
+		if !auth.equal(firstAuth) {
+			return &ExperimentInvalidAuth{experimentName: experiment.Name, candidate: candidate.Name, other: first}
 		}
-		names[experiment.Mirror.Name] = true
 	}
 	return nil
 }
@@ -82,5 +218,17 @@ func (es *ExperimentStore) validate(experiment *Experiment) error {
 	if err := validateNoDuplicateNames(experiment); err != nil {
 		return err
 	}
+	if err := validateMatches(experiment); err != nil {
+		return err
+	}
+	if err := validateMirrors(experiment); err != nil {
+		return err
+	}
+	if err := validateBandit(experiment); err != nil {
+		return err
+	}
+	if err := es.validateCandidateAuth(experiment); err != nil {
+		return err
+	}
 	return nil
 }
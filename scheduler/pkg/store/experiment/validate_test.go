@@ -13,6 +13,10 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/pipeline"
 )
 
 func TestValidateExperiment(t *testing.T) {
@@ -63,8 +67,10 @@ func TestValidateExperiment(t *testing.T) {
 						Name: "model2",
 					},
 				},
-				Mirror: &Mirror{
-					Name: "model2",
+				Mirrors: []*Mirror{
+					{
+						Name: "model2",
+					},
 				},
 			},
 			err: &ExperimentNoDuplicates{experimentName: "a", resource: "model2"},
@@ -150,8 +156,10 @@ func TestValidateExperiment(t *testing.T) {
 			},
 			experiment: &Experiment{
 				Name: "a",
-				Mirror: &Mirror{
-					Name: "model",
+				Mirrors: []*Mirror{
+					{
+						Name: "model",
+					},
 				},
 			},
 		},
@@ -167,6 +175,320 @@ func TestValidateExperiment(t *testing.T) {
 			},
 			err: &ExperimentDefaultNotFound{experimentName: "a", defaultResource: "model1"},
 		},
+		{
+			name: "valid matches",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:    "a",
+				Default: getStrPtr("model1"),
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name:    "model2",
+						Matches: []*Match{{Header: "tenant", Exact: "a"}, {QueryParameter: "region", Regex: "eu-.*"}},
+					},
+				},
+			},
+		},
+		{
+			name: "match on header and query parameter",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:    "a",
+				Default: getStrPtr("model1"),
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name:    "model2",
+						Matches: []*Match{{Header: "tenant", QueryParameter: "region", Exact: "a"}},
+					},
+				},
+			},
+			err: &ExperimentInvalidMatch{experimentName: "a", candidate: "model2", reason: "exactly one of header or queryParameter must be set"},
+		},
+		{
+			name: "match without value",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:    "a",
+				Default: getStrPtr("model1"),
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name:    "model2",
+						Matches: []*Match{{Header: "tenant"}},
+					},
+				},
+			},
+			err: &ExperimentInvalidMatch{experimentName: "a", candidate: "model2", reason: "exactly one of exact or regex must be set"},
+		},
+		{
+			name: "match with invalid regex",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:    "a",
+				Default: getStrPtr("model1"),
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name:    "model2",
+						Matches: []*Match{{Header: "tenant", Regex: "a("}},
+					},
+				},
+			},
+			err: &ExperimentInvalidMatch{experimentName: "a", candidate: "model2", reason: "invalid regex a(: error parsing regexp: missing closing ): `a(`"},
+		},
+		{
+			name: "valid bandit",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:    "a",
+				Default: getStrPtr("model1"),
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name: "model2",
+					},
+				},
+				Bandit: &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 0.1},
+			},
+		},
+		{
+			name: "bandit with one candidate",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+				},
+				Bandit: &Bandit{Strategy: ThompsonSamplingStrategy},
+			},
+			err: &ExperimentInvalidBandit{experimentName: "a", reason: "at least 2 candidates are needed"},
+		},
+		{
+			name: "bandit with invalid epsilon",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name: "model2",
+					},
+				},
+				Bandit: &Bandit{Strategy: EpsilonGreedyStrategy, Epsilon: 1.5},
+			},
+			err: &ExperimentInvalidBandit{experimentName: "a", reason: "epsilon 1.5 must be between 0 and 1"},
+		},
+		{
+			name: "valid capture with mirrors",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:    "a",
+				Default: getStrPtr("model1"),
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+				},
+				Mirrors: []*Mirror{
+					{
+						Name: "model2",
+					},
+					{
+						Name: "model3",
+					},
+				},
+				CaptureResponses: true,
+			},
+		},
+		{
+			name: "too many mirrors",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Mirrors: []*Mirror{
+					{Name: "model1"},
+					{Name: "model2"},
+					{Name: "model3"},
+					{Name: "model4"},
+					{Name: "model5"},
+				},
+			},
+			err: &ExperimentInvalidMirrors{experimentName: "a", reason: "at most 4 mirrors are allowed"},
+		},
+		{
+			name: "capture without mirror",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+				},
+				CaptureResponses: true,
+			},
+			err: &ExperimentInvalidMirrors{experimentName: "a", reason: "capturing responses needs a mirror to compare with"},
+		},
+		{
+			name: "capture for pipelines",
+			store: &ExperimentStore{
+				pipelineBaselines: map[string]*Experiment{},
+				experiments:       map[string]*Experiment{},
+			},
+			experiment: &Experiment{
+				Name:         "a",
+				ResourceType: PipelineResourceType,
+				Candidates: []*Candidate{
+					{
+						Name: "pipeline1",
+					},
+				},
+				Mirrors: []*Mirror{
+					{
+						Name: "pipeline2",
+					},
+				},
+				CaptureResponses: true,
+			},
+			err: &ExperimentInvalidMirrors{experimentName: "a", reason: "capturing responses is only supported for model experiments"},
+		},
+		{
+			name: "candidates with the same auth",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+				store: fakeModelStore{auth: map[string]*scheduler.AuthSpec{
+					"model1": {Audiences: []string{"iris", "wine"}, Groups: []string{"a"}},
+					"model2": {Audiences: []string{"wine", "iris"}, Groups: []string{"a"}},
+				}},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name: "model2",
+					},
+				},
+			},
+		},
+		{
+			name: "candidates with different audiences",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+				store: fakeModelStore{auth: map[string]*scheduler.AuthSpec{
+					"model1": {Audiences: []string{"iris"}},
+					"model2": {Audiences: []string{"wine"}},
+				}},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name: "model2",
+					},
+				},
+			},
+			err: &ExperimentInvalidAuth{experimentName: "a", candidate: "model2", other: "model1"},
+		},
+		{
+			name: "candidate without auth",
+			store: &ExperimentStore{
+				modelBaselines: map[string]*Experiment{},
+				experiments:    map[string]*Experiment{},
+				store: fakeModelStore{auth: map[string]*scheduler.AuthSpec{
+					"model1": {Groups: []string{"a"}},
+				}},
+			},
+			experiment: &Experiment{
+				Name: "a",
+				Candidates: []*Candidate{
+					{
+						Name: "model1",
+					},
+					{
+						Name: "model2",
+					},
+				},
+			},
+			err: &ExperimentInvalidAuth{experimentName: "a", candidate: "model2", other: "model1"},
+		},
+		{
+			name: "pipeline candidates with different audiences",
+			store: &ExperimentStore{
+				pipelineBaselines: map[string]*Experiment{},
+				experiments:       map[string]*Experiment{},
+				pipelineStore: fakePipelineStore{auth: map[string]*pipeline.AuthSpec{
+					"pipeline1": {Audiences: []string{"iris"}},
+					"pipeline2": {Audiences: []string{"wine"}},
+				}},
+			},
+			experiment: &Experiment{
+				Name:         "a",
+				ResourceType: PipelineResourceType,
+				Candidates: []*Candidate{
+					{
+						Name: "pipeline1",
+					},
+					{
+						Name: "pipeline2",
+					},
+				},
+			},
+			err: &ExperimentInvalidAuth{experimentName: "a", candidate: "pipeline2", other: "pipeline1"},
+		},
 	}
 
 	for _, test := range tests {
@@ -29,6 +29,7 @@ type MemoryStore struct {
 	store    *LocalSchedulerStore
 	logger   log.FieldLogger
 	eventHub *coordinator.EventHub
+	db       *ModelServerDBManager
 }
 
 func NewMemoryStore(
@@ -122,11 +123,13 @@ func (m *MemoryStore) UpdateModel(req *pb.LoadModelRequest) error {
 		model = &Model{}
 		m.store.models[modelName] = model
 		m.addNextModelVersion(model, req.GetModel())
+		m.persistModel(modelName)
 	} else if model.IsDeleted() {
 		if model.Inactive() {
 			model = &Model{}
 			m.store.models[modelName] = model
 			m.addNextModelVersion(model, req.GetModel())
+			m.persistModel(modelName)
 		} else {
 			return fmt.Errorf(
 				"Model %s is in process of deletion - new model can not be created",
@@ -141,6 +144,7 @@ func (m *MemoryStore) UpdateModel(req *pb.LoadModelRequest) error {
 		} else if meq.ModelSpecDiffers {
 			logger.Debugf("Model %s model spec differs - adding new version of model", modelName)
 			m.addNextModelVersion(model, req.GetModel())
+			m.persistModel(modelName)
 			return nil
 		} else if meq.DeploymentSpecDiffers {
 			logger.Debugf(
@@ -153,6 +157,7 @@ func (m *MemoryStore) UpdateModel(req *pb.LoadModelRequest) error {
 			// Update just kubernetes meta
 			model.Latest().UpdateKubernetesMeta(req.GetModel().GetMeta().GetKubernetesMeta())
 		}
+		m.persistModel(modelName)
 	}
 	return nil
 }
@@ -227,6 +232,7 @@ func (m *MemoryStore) removeModelImpl(req *pb.UnloadModelRequest) error {
 		model.SetDeleted()
 		m.setModelGwStatusToTerminate(true, model.Latest())
 		m.updateModelStatus(true, true, model.Latest(), model.GetLastAvailableModelVersion())
+		m.persistModel(modelName)
 		return nil
 	} else {
 		return fmt.Errorf("Model %s not found", req.GetModel().GetName())
@@ -414,6 +420,7 @@ func (m *MemoryStore) updateLoadedModelsImpl(
 		logger.Debugf("Updating model status for model %s server %s", modelKey, serverKey)
 		modelVersion.SetServer(serverKey)
 		m.updateModelStatus(true, model.IsDeleted(), modelVersion, model.GetLastAvailableModelVersion())
+		m.persistModel(modelKey)
 
 		return &coordinator.ModelEventMsg{
 				ModelName:    modelVersion.GetMeta().GetName(),
@@ -427,7 +434,19 @@ func (m *MemoryStore) updateLoadedModelsImpl(
 }
 
 func (m *MemoryStore) UnloadVersionModels(modelKey string, version uint32) (bool, error) {
-	evt, updated, err := m.unloadVersionModelsImpl(modelKey, version)
+	return m.unloadVersionModels(modelKey, version, nil)
+}
+
+// UnloadVersionModelReplicas unloads a model version from only the given replicas of its server
+func (m *MemoryStore) UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	if len(replicaIdxs) == 0 {
+		return false, nil
+	}
+	return m.unloadVersionModels(modelKey, version, replicaIdxs)
+}
+
+func (m *MemoryStore) unloadVersionModels(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	evt, updated, err := m.unloadVersionModelsImpl(modelKey, version, replicaIdxs)
 	if err != nil {
 		return updated, err
 	}
@@ -440,7 +459,8 @@ func (m *MemoryStore) UnloadVersionModels(modelKey string, version uint32) (bool
 	return updated, nil
 }
 
-func (m *MemoryStore) unloadVersionModelsImpl(modelKey string, version uint32) (*coordinator.ModelEventMsg, bool, error) {
+// unloadVersionModelsImpl unloads a model version from the given replicas, or all its replicas if none are given
+func (m *MemoryStore) unloadVersionModelsImpl(modelKey string, version uint32, replicaIdxs []int) (*coordinator.ModelEventMsg, bool, error) {
 	logger := m.logger.WithField("func", "UnloadVersionModels")
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -455,8 +475,19 @@ func (m *MemoryStore) unloadVersionModelsImpl(modelKey string, version uint32) (
 		return nil, false, fmt.Errorf("version not found for model %s, version %d", modelKey, version)
 	}
 
+	var selected map[int]bool
+	if replicaIdxs != nil {
+		selected = make(map[int]bool, len(replicaIdxs))
+		for _, replicaIdx := range replicaIdxs {
+			selected[replicaIdx] = true
+		}
+	}
+
 	updated := false
 	for replicaIdx, existingState := range modelVersion.ReplicaState() {
+		if selected != nil && !selected[replicaIdx] {
+			continue
+		}
 		if !existingState.State.UnloadingOrUnloaded() {
 			logger.Debugf(
 				"Setting model %s version %d on server %s replica %d to UnloadRequested was %s",
@@ -478,6 +509,7 @@ func (m *MemoryStore) unloadVersionModelsImpl(modelKey string, version uint32) (
 	if updated {
 		logger.Debugf("Calling update model status for model %s version %d", modelKey, version)
 		m.updateModelStatus(false, model.IsDeleted(), modelVersion, model.GetLastAvailableModelVersion())
+		m.persistModel(modelKey)
 		return &coordinator.ModelEventMsg{
 			ModelName:    modelVersion.GetMeta().GetName(),
 			ModelVersion: modelVersion.GetVersion(),
@@ -590,6 +622,7 @@ func (m *MemoryStore) updateModelStateImpl(
 		}
 
 		m.updateModelStatus(isLatest, model.IsDeleted(), modelVersion, model.GetLastAvailableModelVersion())
+		m.persistModel(modelKey)
 		modelEvt := &coordinator.ModelEventMsg{
 			ModelName:    modelVersion.GetMeta().GetName(),
 			ModelVersion: modelVersion.GetVersion(),
@@ -678,11 +711,15 @@ func (m *MemoryStore) addServerReplicaImpl(request *agent.AgentSubscribeRequest)
 		modelVersion.replicas[int(request.ReplicaIdx)] = ReplicaStatus{State: Loaded}
 		modelVersion.SetServer(request.ServerName)
 		m.updateModelStatus(true, false, modelVersion, model.GetLastAvailableModelVersion())
+		m.persistModel(modelVersion.GetMeta().GetName())
 		evts = append(evts, coordinator.ModelEventMsg{
 			ModelName:    modelVersion.GetMeta().GetName(),
 			ModelVersion: modelVersion.GetVersion(),
 		})
 	}
+	// drop any restored model state on this replica that the agent did not confirm
+	evts = append(evts, m.reconcileRestoredServerReplica(request.ServerName, int(request.ReplicaIdx), loadedModels)...)
+	m.persistServer(request.ServerName)
 
 	serverEvt := coordinator.ServerEventMsg{
 		ServerName:    request.ServerName,
@@ -725,6 +762,7 @@ func (m *MemoryStore) removeServerReplicaImpl(serverName string, replicaIdx int)
 	// TODO we should not reschedule models on servers with dedicated models, e.g. non shareable servers
 	if len(server.replicas) == 0 {
 		delete(m.store.servers, serverName)
+		m.persistServer(serverName)
 	}
 	loadedModelsRemoved, loadedEvts := m.removeModelfromServerReplica(serverReplica.loadedModels, replicaIdx)
 	loadingModelsRemoved, loadingEtvs := m.removeModelfromServerReplica(serverReplica.loadingModels, replicaIdx)
@@ -759,6 +797,7 @@ func (m *MemoryStore) removeModelfromServerReplica(lModels map[ModelVersionID]bo
 						model.Latest().GetVersion() == modelVersion.GetVersion(),
 						model.IsDeleted(), modelVersion, model.GetLastAvailableModelVersion())
 					m.UnlockModel(modelVersionID.Name)
+					m.persistModel(modelVersionID.Name)
 					// send an event to progress the deletion
 					evts = append(
 						evts,
@@ -768,6 +807,7 @@ func (m *MemoryStore) removeModelfromServerReplica(lModels map[ModelVersionID]bo
 						},
 					)
 				} else {
+					m.persistModel(modelVersionID.Name)
 					modelNames = append(modelNames, modelVersionID.Name)
 				}
 			} else {
@@ -821,6 +861,7 @@ func (m *MemoryStore) findModelsToReSchedule(models map[ModelVersionID]bool, rep
 			modelVersion := model.GetVersion(modelVersionID.Version)
 			if modelVersion != nil {
 				modelVersion.SetReplicaState(replicaIdx, Draining, "trigger to drain")
+				m.persistModel(modelVersionID.Name)
 				modelsReSchedule = append(modelsReSchedule, modelVersionID.Name)
 				continue
 			}
@@ -847,6 +888,7 @@ func (m *MemoryStore) ServerNotify(request *pb.ServerNotify) error {
 	server.SetMinReplicas(int(request.MinReplicas))
 	server.SetMaxReplicas(int(request.MaxReplicas))
 	server.SetKubernetesMeta(request.KubernetesMeta)
+	m.persistServer(request.Name)
 	return nil
 }
 
@@ -923,6 +965,7 @@ func (m *MemoryStore) setModelGwModelStateImpl(name string, versionNumber uint32
 	if modelVersion.state.ModelGwState != status || modelVersion.state.ModelGwReason != reason {
 		modelVersion.state.ModelGwState = status
 		modelVersion.state.ModelGwReason = reason
+		m.persistModel(name)
 		evt := &coordinator.ModelEventMsg{
 			ModelName:    modelVersion.GetMeta().GetName(),
 			ModelVersion: modelVersion.GetVersion(),
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+)
+
+const (
+	modelDbFolder           = "modeldb"
+	modelRestoreEventSource = "memory.restore.model.reconcile"
+)
+
+func getModelDbFolder(basePath string) string {
+	return filepath.Join(basePath, modelDbFolder)
+}
+
+func (m *MemoryStore) InitialiseOrRestoreDB(path string, deletedResourceTTL uint) error {
+	logger := m.logger.WithField("func", "initialiseDB")
+	modelDbPath := getModelDbFolder(path)
+	logger.Infof("Initialise DB at %s", modelDbPath)
+	err := os.MkdirAll(modelDbPath, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	db, err := newModelServerDbManager(modelDbPath, m.logger, deletedResourceTTL)
+	if err != nil {
+		return err
+	}
+	m.db = db
+	// If database already existed we can restore else this is a noop
+	return m.db.restore(m.restoreModel, m.restoreServer)
+}
+
+// StopDB writes the model and server snapshots waiting to be written and closes the db
+func (m *MemoryStore) StopDB() error {
+	if m.db == nil {
+		return nil
+	}
+	return m.db.Stop()
+}
+
+// Restored models keep their versions and replica states. The replicas they refer to are only trusted
+// once the matching agent reconnects and confirms the models it has loaded, see reconcileRestoredServerReplica.
+// No events are published on restore as server replicas are not connected yet.
+func (m *MemoryStore) restoreModel(name string, model *Model) {
+	logger := m.logger.WithField("func", "restoreModel")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.store.models[name] = model
+	for _, mv := range model.versions {
+		if !mv.HasServer() {
+			continue
+		}
+		for replicaIdx := range mv.ReplicaState() {
+			replicas, ok := m.store.restoredServerReplicas[mv.Server()]
+			if !ok {
+				replicas = make(map[int]struct{})
+				m.store.restoredServerReplicas[mv.Server()] = replicas
+			}
+			replicas[replicaIdx] = struct{}{}
+		}
+	}
+	logger.Debugf("Restored model %s with %d versions", name, len(model.versions))
+}
+
+func (m *MemoryStore) restoreServer(server *Server) {
+	logger := m.logger.WithField("func", "restoreServer")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.store.servers[server.name] = server
+	logger.Debugf("Restored server %s", server.name)
+}
+
+// ReconcileRestoredState removes restored model replica assignments for server replicas that have not
+// reconnected, e.g. once all expected servers are ready. Affected models will then be picked up
+// for rescheduling as they no longer have the desired number of replicas.
+func (m *MemoryStore) ReconcileRestoredState() {
+	logger := m.logger.WithField("func", "ReconcileRestoredState")
+
+	m.mu.Lock()
+	var evts []coordinator.ModelEventMsg
+	for serverName, replicas := range m.store.restoredServerReplicas {
+		for replicaIdx := range replicas {
+			logger.Infof("Server replica %s:%d did not reconnect after restore", serverName, replicaIdx)
+			evts = append(evts, m.reconcileRestoredServerReplica(serverName, replicaIdx, nil)...)
+		}
+	}
+	m.mu.Unlock()
+
+	if m.eventHub != nil {
+		for _, evt := range evts {
+			m.eventHub.PublishModelEvent(modelRestoreEventSource, evt)
+		}
+	}
+}
+
+// reconcileRestoredServerReplica drops restored replica states on a server replica that the agent did not
+// report as loaded. It is called with the store lock held and is a noop for replicas that were not restored.
+func (m *MemoryStore) reconcileRestoredServerReplica(serverName string, replicaIdx int, loadedModels map[ModelVersionID]bool) []coordinator.ModelEventMsg {
+	logger := m.logger.WithField("func", "reconcileRestoredServerReplica")
+	replicas, ok := m.store.restoredServerReplicas[serverName]
+	if !ok {
+		return nil
+	}
+	if _, ok := replicas[replicaIdx]; !ok {
+		return nil
+	}
+	delete(replicas, replicaIdx)
+	if len(replicas) == 0 {
+		delete(m.store.restoredServerReplicas, serverName)
+	}
+
+	var evts []coordinator.ModelEventMsg
+	for modelName, model := range m.store.models {
+		updated := false
+		for _, mv := range model.versions {
+			if mv.Server() != serverName {
+				continue
+			}
+			replicaState, ok := mv.ReplicaState()[replicaIdx]
+			if !ok || replicaState.State.Inactive() {
+				continue
+			}
+			if loadedModels[ModelVersionID{Name: modelName, Version: mv.GetVersion()}] {
+				continue
+			}
+			logger.Debugf(
+				"Removing restored state %s for model %s:%d on server %s replica %d",
+				replicaState.State.String(), modelName, mv.GetVersion(), serverName, replicaIdx,
+			)
+			mv.DeleteReplica(replicaIdx)
+			m.updateModelStatus(model.Latest() == mv, model.IsDeleted(), mv, model.GetLastAvailableModelVersion())
+			evts = append(evts, coordinator.ModelEventMsg{
+				ModelName:    modelName,
+				ModelVersion: mv.GetVersion(),
+			})
+			updated = true
+		}
+		if updated {
+			m.persistModel(modelName)
+		}
+	}
+	return evts
+}
+
+// persistModel is called with the store lock held, the snapshot is written asynchronously
+func (m *MemoryStore) persistModel(name string) {
+	if m.db == nil {
+		return
+	}
+	model, ok := m.store.models[name]
+	if !ok {
+		return
+	}
+	if err := m.db.queueModel(name, model); err != nil {
+		m.logger.WithError(err).Warnf("Failed to save model %s to db", name)
+	}
+}
+
+// persistServer is called with the store lock held, the snapshot is written asynchronously
+func (m *MemoryStore) persistServer(name string) {
+	if m.db == nil {
+		return
+	}
+	server, ok := m.store.servers[name]
+	if !ok {
+		m.db.queueDeleteServer(name)
+		return
+	}
+	if err := m.db.queueServer(server); err != nil {
+		m.logger.WithError(err).Warnf("Failed to save server %s to db", name)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/seldonio/seldon-core/apis/go/v2/mlops/agent"
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/coordinator"
+)
+
+func TestMemoryStorePersistsAcrossRestart(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := fmt.Sprintf("%s/db", t.TempDir())
+	logger := log.New()
+
+	ms := NewMemoryStore(logger, NewLocalSchedulerStore(), nil)
+	err := ms.InitialiseOrRestoreDB(path, 10)
+	g.Expect(err).To(BeNil())
+
+	err = ms.ServerNotify(&pb.ServerNotify{Name: "server1", ExpectedReplicas: 1, Shared: true})
+	g.Expect(err).To(BeNil())
+	err = ms.AddServerReplica(&agent.AgentSubscribeRequest{
+		ServerName:    "server1",
+		ReplicaIdx:    0,
+		Shared:        true,
+		ReplicaConfig: &agent.ReplicaConfig{MemoryBytes: 1000},
+	})
+	g.Expect(err).To(BeNil())
+	err = ms.UpdateModel(&pb.LoadModelRequest{
+		Model: &pb.Model{
+			Meta:           &pb.MetaData{Name: "model1"},
+			ModelSpec:      &pb.ModelSpec{},
+			DeploymentSpec: &pb.DeploymentSpec{Replicas: 1},
+		},
+	})
+	g.Expect(err).To(BeNil())
+	server, err := ms.GetServer("server1", false, false)
+	g.Expect(err).To(BeNil())
+	err = ms.UpdateLoadedModels("model1", 1, "server1", []*ServerReplica{server.Replicas[0]})
+	g.Expect(err).To(BeNil())
+	err = ms.UpdateModelState("model1", 1, "server1", 0, nil, LoadRequested, Loading, "", nil)
+	g.Expect(err).To(BeNil())
+	err = ms.UpdateModelState("model1", 1, "server1", 0, nil, Loading, Loaded, "", nil)
+	g.Expect(err).To(BeNil())
+	err = ms.db.Stop()
+	g.Expect(err).To(BeNil())
+
+	restored := NewMemoryStore(logger, NewLocalSchedulerStore(), nil)
+	err = restored.InitialiseOrRestoreDB(path, 10)
+	g.Expect(err).To(BeNil())
+
+	model, err := restored.GetModel("model1")
+	g.Expect(err).To(BeNil())
+	g.Expect(model.GetLatest()).ToNot(BeNil())
+	g.Expect(model.GetLatest().GetVersion()).To(Equal(uint32(1)))
+	g.Expect(model.GetLatest().Server()).To(Equal("server1"))
+	g.Expect(model.GetLatest().GetModelReplicaState(0)).To(Equal(Loaded))
+
+	restoredServer, err := restored.GetServer("server1", false, false)
+	g.Expect(err).To(BeNil())
+	g.Expect(restoredServer.ExpectedReplicas).To(Equal(1))
+	g.Expect(restoredServer.Shared).To(BeTrue())
+	// replicas are only added back when agents reconnect
+	g.Expect(restoredServer.Replicas).To(BeEmpty())
+	g.Expect(restored.store.restoredServerReplicas).To(HaveKey("server1"))
+
+	err = restored.db.Stop()
+	g.Expect(err).To(BeNil())
+}
+
+func TestReconcileRestoredServerReplica(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                 string
+		loadedModels         []*agent.ModelVersion
+		reconnect            bool
+		expectedReplicaState map[string]map[int]ReplicaStatus
+		expectedModelState   map[string]ModelState
+		expectedModelEvents  int64
+	}
+
+	newModel := func(name string, replicas map[int]ReplicaStatus, state ModelState) *Model {
+		return &Model{
+			versions: []*ModelVersion{
+				{
+					version: 1,
+					modelDefn: &pb.Model{
+						Meta:           &pb.MetaData{Name: name},
+						ModelSpec:      &pb.ModelSpec{},
+						DeploymentSpec: &pb.DeploymentSpec{Replicas: 1},
+					},
+					server:   "server1",
+					replicas: replicas,
+					state:    ModelStatus{State: state},
+				},
+			},
+		}
+	}
+
+	tests := []test{
+		{
+			name:      "agent confirms restored model",
+			reconnect: true,
+			loadedModels: []*agent.ModelVersion{
+				{Model: &pb.Model{Meta: &pb.MetaData{Name: "model1"}, ModelSpec: &pb.ModelSpec{}}, Version: 1},
+			},
+			expectedReplicaState: map[string]map[int]ReplicaStatus{
+				"model1": {0: {State: Loaded}},
+				"model2": {0: {State: LoadFailed, Reason: "failed"}},
+			},
+			expectedModelState: map[string]ModelState{
+				"model1": ModelProgressing,
+				"model2": ModelFailed,
+			},
+			expectedModelEvents: 1,
+		},
+		{
+			name:         "agent does not report restored model",
+			reconnect:    true,
+			loadedModels: []*agent.ModelVersion{},
+			expectedReplicaState: map[string]map[int]ReplicaStatus{
+				"model1": {},
+				"model2": {0: {State: LoadFailed, Reason: "failed"}},
+			},
+			expectedModelState: map[string]ModelState{
+				"model1": ModelProgressing,
+				"model2": ModelFailed,
+			},
+			expectedModelEvents: 1,
+		},
+		{
+			name:      "agent does not reconnect",
+			reconnect: false,
+			expectedReplicaState: map[string]map[int]ReplicaStatus{
+				"model1": {},
+				"model2": {0: {State: LoadFailed, Reason: "failed"}},
+			},
+			expectedModelState: map[string]ModelState{
+				"model1": ModelProgressing,
+				"model2": ModelFailed,
+			},
+			expectedModelEvents: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger := log.New()
+			eventHub, err := coordinator.NewEventHub(logger)
+			g.Expect(err).To(BeNil())
+
+			modelEvents := int64(0)
+			eventHub.RegisterModelEventHandler(
+				"handler-model",
+				10,
+				logger,
+				func(event coordinator.ModelEventMsg) { atomic.AddInt64(&modelEvents, 1) },
+			)
+
+			ms := NewMemoryStore(logger, NewLocalSchedulerStore(), eventHub)
+			ms.restoreServer(NewServer("server1", true))
+			ms.restoreModel("model1", newModel("model1", map[int]ReplicaStatus{0: {State: Available}}, ModelAvailable))
+			ms.restoreModel("model2", newModel("model2", map[int]ReplicaStatus{0: {State: LoadFailed, Reason: "failed"}}, ModelFailed))
+
+			if test.reconnect {
+				err = ms.AddServerReplica(&agent.AgentSubscribeRequest{
+					ServerName:    "server1",
+					ReplicaIdx:    0,
+					Shared:        true,
+					LoadedModels:  test.loadedModels,
+					ReplicaConfig: &agent.ReplicaConfig{},
+				})
+				g.Expect(err).To(BeNil())
+			}
+			ms.ReconcileRestoredState()
+			g.Expect(ms.store.restoredServerReplicas).To(BeEmpty())
+
+			for modelName, expectedReplicas := range test.expectedReplicaState {
+				model, err := ms.GetModel(modelName)
+				g.Expect(err).To(BeNil())
+				replicas := model.GetLatest().ReplicaState()
+				g.Expect(len(replicas)).To(Equal(len(expectedReplicas)))
+				for idx, expected := range expectedReplicas {
+					g.Expect(replicas[idx].State).To(Equal(expected.State))
+					g.Expect(replicas[idx].Reason).To(Equal(expected.Reason))
+				}
+				g.Expect(model.GetLatest().ModelState().State).To(Equal(test.expectedModelState[modelName]))
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			g.Expect(atomic.LoadInt64(&modelEvents)).To(Equal(test.expectedModelEvents))
+		})
+	}
+}
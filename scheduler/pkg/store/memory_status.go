@@ -17,9 +17,11 @@ import (
 )
 
 const (
-	modelFailureEventSource = "memory.status.scheduling.failed"
-	modelUpdateEventSource  = "memory.status.model.update"
-	serverUpdateEventSource = "memory.status.server.update"
+	modelFailureEventSource           = "memory.status.scheduling.failed"
+	modelSchedulingWarningEventSource = "memory.status.scheduling.warning"
+	modelRolloutEventSource           = "memory.status.rollout"
+	modelUpdateEventSource            = "memory.status.model.update"
+	serverUpdateEventSource           = "memory.status.server.update"
 )
 
 type modelVersionStateStatistics struct {
@@ -104,10 +106,12 @@ func updateModelState(isLatest bool, modelVersion *ModelVersion, prevModelVersio
 		ModelGwState:        modelVersion.state.ModelGwState,
 		Reason:              modelReason,
 		ModelGwReason:       modelVersion.state.ModelGwReason,
+		SchedulingWarning:   modelVersion.state.SchedulingWarning,
 		Timestamp:           modelTimestamp,
 		AvailableReplicas:   stats.replicasAvailable,
 		UnavailableReplicas: stats.replicasLoading + stats.replicasLoadFailed,
 		DrainingReplicas:    stats.replicasDraining,
+		Rollout:             modelVersion.state.Rollout,
 	}
 }
 
@@ -135,11 +139,13 @@ func (m *MemoryStore) FailedScheduling(modelID string, version uint32, reason st
 				Timestamp:           time.Now(),
 				AvailableReplicas:   availableReplicas,
 				UnavailableReplicas: modelVersion.GetModel().GetDeploymentSpec().GetReplicas() - availableReplicas,
+				Rollout:             modelVersion.state.Rollout,
 			}
 			// make sure we reset server but only if there are no available replicas
 			if reset {
 				modelVersion.SetServer("")
 			}
+			m.persistModel(modelID)
 
 			m.eventHub.PublishModelEvent(
 				modelFailureEventSource,
@@ -156,6 +162,70 @@ func (m *MemoryStore) FailedScheduling(modelID string, version uint32, reason st
 	return fmt.Errorf("model %s found, version %d not found", modelID, version)
 }
 
+// SetSchedulingWarning records (or clears with an empty warning) why a scheduled model version
+// is not placed as preferred, e.g. its replicas could not be spread across zones.
+func (m *MemoryStore) SetSchedulingWarning(modelID string, version uint32, warning string) error {
+	m.mu.Lock()
+	model, ok := m.store.models[modelID]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("model %s not found", modelID)
+	}
+	modelVersion := model.GetVersion(version)
+	if modelVersion == nil {
+		m.mu.Unlock()
+		return fmt.Errorf("model %s found, version %d not found", modelID, version)
+	}
+	if modelVersion.state.SchedulingWarning == warning {
+		m.mu.Unlock()
+		return nil
+	}
+	modelVersion.state.SchedulingWarning = warning
+	m.persistModel(modelID)
+	m.mu.Unlock()
+
+	if m.eventHub != nil {
+		m.eventHub.PublishModelEvent(
+			modelSchedulingWarningEventSource,
+			coordinator.ModelEventMsg{
+				ModelName:    modelVersion.GetMeta().GetName(),
+				ModelVersion: modelVersion.GetVersion(),
+			},
+		)
+	}
+	return nil
+}
+
+// SetModelRollout records the progress of a canary rollout of a model version. The event published allows
+// the traffic split to be updated and, once the rollout has finished, previous versions to be removed.
+func (m *MemoryStore) SetModelRollout(modelID string, version uint32, rollout *RolloutStatus) error {
+	m.mu.Lock()
+	model, ok := m.store.models[modelID]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("model %s not found", modelID)
+	}
+	modelVersion := model.GetVersion(version)
+	if modelVersion == nil {
+		m.mu.Unlock()
+		return fmt.Errorf("model %s found, version %d not found", modelID, version)
+	}
+	modelVersion.state.Rollout = rollout
+	m.persistModel(modelID)
+	m.mu.Unlock()
+
+	if m.eventHub != nil {
+		m.eventHub.PublishModelEvent(
+			modelRolloutEventSource,
+			coordinator.ModelEventMsg{
+				ModelName:    modelVersion.GetMeta().GetName(),
+				ModelVersion: modelVersion.GetVersion(),
+			},
+		)
+	}
+	return nil
+}
+
 func (m *MemoryStore) updateModelStatus(isLatest bool, deleted bool, modelVersion *ModelVersion, prevModelVersion *ModelVersion) {
 	logger := m.logger.WithField("func", "updateModelStatus")
 	stats := calcModelVersionStatistics(modelVersion, deleted)
@@ -190,5 +260,6 @@ func (m *MemoryStore) UnloadModelGwVersionModels(modelKey string, version uint32
 	}
 
 	m.setModelGwStatusToTerminate(false, modelVersion)
+	m.persistModel(modelKey)
 	return true, nil
 }
@@ -242,3 +242,40 @@ func TestUpdateStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestSetSchedulingWarning(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	eventHub, err := coordinator.NewEventHub(logger)
+	g.Expect(err).To(BeNil())
+
+	ms := NewMemoryStore(logger, NewLocalSchedulerStore(), eventHub)
+	err = ms.UpdateModel(&pb.LoadModelRequest{
+		Model: &pb.Model{
+			Meta:           &pb.MetaData{Name: "model1"},
+			ModelSpec:      &pb.ModelSpec{},
+			DeploymentSpec: &pb.DeploymentSpec{Replicas: 1},
+		},
+	})
+	g.Expect(err).To(BeNil())
+
+	err = ms.SetSchedulingWarning("model1", 1, "replicas not spread")
+	g.Expect(err).To(BeNil())
+	model, err := ms.GetModel("model1")
+	g.Expect(err).To(BeNil())
+	g.Expect(model.GetLatest().ModelState().SchedulingWarning).To(Equal("replicas not spread"))
+
+	// the warning is kept when the model status is recomputed
+	ms.updateModelStatus(true, false, ms.store.models["model1"].Latest(), nil)
+	g.Expect(ms.store.models["model1"].Latest().ModelState().SchedulingWarning).To(Equal("replicas not spread"))
+
+	err = ms.SetSchedulingWarning("model1", 1, "")
+	g.Expect(err).To(BeNil())
+	g.Expect(ms.store.models["model1"].Latest().ModelState().SchedulingWarning).To(BeEmpty())
+
+	err = ms.SetSchedulingWarning("model1", 2, "")
+	g.Expect(err).ToNot(BeNil())
+	err = ms.SetSchedulingWarning("model2", 1, "")
+	g.Expect(err).ToNot(BeNil())
+}
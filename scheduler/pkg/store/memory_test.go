@@ -535,6 +535,42 @@ func TestRemoveModel(t *testing.T) {
 	}
 }
 
+func TestUnloadVersionModelReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	logger := log.New()
+	eventHub, err := coordinator.NewEventHub(logger)
+	g.Expect(err).To(BeNil())
+	ms := NewMemoryStore(logger, &LocalSchedulerStore{
+		models: map[string]*Model{
+			"model": {
+				versions: []*ModelVersion{
+					NewModelVersion(
+						&pb.Model{Meta: &pb.MetaData{Name: "model"}, DeploymentSpec: &pb.DeploymentSpec{Replicas: 2}},
+						1, "server",
+						map[int]ReplicaStatus{0: {State: Available}, 1: {State: Available}},
+						false, ModelAvailable),
+				},
+			},
+		},
+		servers: map[string]*Server{},
+	}, eventHub)
+
+	updated, err := ms.UnloadVersionModelReplicas("model", 1, []int{1})
+	g.Expect(err).To(BeNil())
+	g.Expect(updated).To(BeTrue())
+	modelVersion := ms.store.models["model"].GetVersion(1)
+	g.Expect(modelVersion.GetModelReplicaState(0)).To(Equal(Available))
+	g.Expect(modelVersion.GetModelReplicaState(1)).To(Equal(UnloadRequested))
+
+	updated, err = ms.UnloadVersionModelReplicas("model", 1, nil)
+	g.Expect(err).To(BeNil())
+	g.Expect(updated).To(BeFalse())
+
+	_, err = ms.UnloadVersionModelReplicas("unknown", 1, []int{0})
+	g.Expect(err).ToNot(BeNil())
+}
+
 func TestUpdateLoadedModels(t *testing.T) {
 	g := NewGomegaWithT(t)
 	memBytes := uint64(1)
@@ -26,6 +26,8 @@ type LocalSchedulerStore struct {
 	servers                map[string]*Server
 	models                 map[string]*Model
 	failedToScheduleModels map[string]bool
+	// server replicas referenced by models restored from the db that have not reconnected yet
+	restoredServerReplicas map[string]map[int]struct{}
 }
 
 func NewLocalSchedulerStore() *LocalSchedulerStore {
@@ -33,6 +35,7 @@ func NewLocalSchedulerStore() *LocalSchedulerStore {
 	m.servers = make(map[string]*Server)
 	m.models = make(map[string]*Model)
 	m.failedToScheduleModels = make(map[string]bool)
+	m.restoredServerReplicas = make(map[string]map[int]struct{})
 	return &m
 }
 
@@ -65,10 +68,21 @@ type ModelStatus struct {
 	ModelGwState        ModelState
 	Reason              string
 	ModelGwReason       string
+	SchedulingWarning   string
 	AvailableReplicas   uint32
 	UnavailableReplicas uint32
 	DrainingReplicas    uint32
 	Timestamp           time.Time
+	// Rollout is replaced rather than modified so it can be shared between copies of the status
+	Rollout *RolloutStatus
+}
+
+type RolloutStatus struct {
+	State          RolloutState
+	StableVersion  uint32
+	TrafficPercent uint32
+	Reason         string
+	Timestamp      time.Time
 }
 
 type ReplicaStatus struct {
@@ -185,6 +199,8 @@ type ServerReplica struct {
 	// precomputed values to speed up ops on scheduler
 	uniqueLoadedModels map[string]bool
 	isDraining         bool
+	// where the replica runs, e.g. zone and node, as reported by the agent
+	topologyLabels map[string]string
 }
 
 func NewServerReplica(inferenceSvc string,
@@ -234,6 +250,7 @@ func NewServerReplicaFromConfig(server *Server, replicaIdx int, loadedModels map
 		overCommitPercentage: config.GetOverCommitPercentage(),
 		uniqueLoadedModels:   toUniqueModels(loadedModels),
 		isDraining:           false,
+		topologyLabels:       config.GetTopologyLabels(),
 	}
 }
 
@@ -297,6 +314,23 @@ var replicaStates = []ModelReplicaState{
 	Draining,
 }
 
+type RolloutState uint32
+
+//go:generate go tool stringer -type=RolloutState
+const (
+	RolloutStateUnknown RolloutState = iota
+	RolloutProgressing
+	RolloutSucceeded
+	RolloutRolledBack
+)
+
+var rolloutStates = []RolloutState{
+	RolloutStateUnknown,
+	RolloutProgressing,
+	RolloutSucceeded,
+	RolloutRolledBack,
+}
+
 // LoadedUnavailable is included as we can try to move state to Available via an Envoy update
 func (m ModelReplicaState) CanReceiveTraffic() bool {
 	return (m == Loaded || m == Available || m == LoadedUnavailable || m == Draining)
@@ -728,6 +762,10 @@ func (s *ServerReplica) GetCapabilities() []string {
 	return s.capabilities
 }
 
+func (s *ServerReplica) GetTopologyLabels() map[string]string {
+	return s.topologyLabels
+}
+
 func (s *ServerReplica) GetServerName() string {
 	return s.serverName
 }
@@ -236,6 +236,34 @@ func (mr *MockModelStoreMockRecorder) SetModelGwModelState(name, versionNumber,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModelGwModelState", reflect.TypeOf((*MockModelStore)(nil).SetModelGwModelState), name, versionNumber, status, reason, source)
 }
 
+// SetModelRollout mocks base method.
+func (m *MockModelStore) SetModelRollout(modelID string, version uint32, rollout *store.RolloutStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetModelRollout", modelID, version, rollout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetModelRollout indicates an expected call of SetModelRollout.
+func (mr *MockModelStoreMockRecorder) SetModelRollout(modelID, version, rollout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModelRollout", reflect.TypeOf((*MockModelStore)(nil).SetModelRollout), modelID, version, rollout)
+}
+
+// SetSchedulingWarning mocks base method.
+func (m *MockModelStore) SetSchedulingWarning(modelID string, version uint32, warning string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSchedulingWarning", modelID, version, warning)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSchedulingWarning indicates an expected call of SetSchedulingWarning.
+func (mr *MockModelStoreMockRecorder) SetSchedulingWarning(modelID, version, warning any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedulingWarning", reflect.TypeOf((*MockModelStore)(nil).SetSchedulingWarning), modelID, version, warning)
+}
+
 // UnloadModelGwVersionModels mocks base method.
 func (m *MockModelStore) UnloadModelGwVersionModels(modelKey string, version uint32) (bool, error) {
 	m.ctrl.T.Helper()
@@ -251,6 +279,21 @@ func (mr *MockModelStoreMockRecorder) UnloadModelGwVersionModels(modelKey, versi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadModelGwVersionModels", reflect.TypeOf((*MockModelStore)(nil).UnloadModelGwVersionModels), modelKey, version)
 }
 
+// UnloadVersionModelReplicas mocks base method.
+func (m *MockModelStore) UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnloadVersionModelReplicas", modelKey, version, replicaIdxs)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnloadVersionModelReplicas indicates an expected call of UnloadVersionModelReplicas.
+func (mr *MockModelStoreMockRecorder) UnloadVersionModelReplicas(modelKey, version, replicaIdxs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadVersionModelReplicas", reflect.TypeOf((*MockModelStore)(nil).UnloadVersionModelReplicas), modelKey, version, replicaIdxs)
+}
+
 // UnloadVersionModels mocks base method.
 func (m *MockModelStore) UnloadVersionModels(modelKey string, version uint32) (bool, error) {
 	m.ctrl.T.Helper()
@@ -203,3 +203,42 @@ type PipelineNameValidationErr struct {
 func (pnve *PipelineNameValidationErr) Error() string {
 	return fmt.Sprintf("pipeline %s does not have a valid name - it must be alphanmumeric and cannot contain dots (.)", pnve.pipeline)
 }
+
+type PipelineEnsembleErr struct {
+	pipeline string
+	step     string
+	reason   string
+}
+
+func (pee *PipelineEnsembleErr) Error() string {
+	if pee.step != "" {
+		return fmt.Sprintf("ensemble pipeline %s step %s is invalid. %s", pee.pipeline, pee.step, pee.reason)
+	}
+	return fmt.Sprintf("ensemble pipeline %s is invalid. %s", pee.pipeline, pee.reason)
+}
+
+type PipelineSynchronousErr struct {
+	pipeline string
+	step     string
+	reason   string
+}
+
+func (pse *PipelineSynchronousErr) Error() string {
+	if pse.step != "" {
+		return fmt.Sprintf("synchronous pipeline %s step %s is invalid. %s", pse.pipeline, pse.step, pse.reason)
+	}
+	return fmt.Sprintf("synchronous pipeline %s is invalid. %s", pse.pipeline, pse.reason)
+}
+
+type PipelineStreamingErr struct {
+	pipeline string
+	step     string
+	reason   string
+}
+
+func (pse *PipelineStreamingErr) Error() string {
+	if pse.step != "" {
+		return fmt.Sprintf("pipeline %s step %s can not be streamed. %s", pse.pipeline, pse.step, pse.reason)
+	}
+	return fmt.Sprintf("pipeline %s can not be streamed. %s", pse.pipeline, pse.reason)
+}
@@ -57,12 +57,28 @@ type PipelineVersion struct {
 	KubernetesMeta  *KubernetesMeta
 	AllowCycles     bool
 	MaxStepRevisits uint32
+	Auth            *AuthSpec
+	RateLimit       *RateLimitSpec
+	Routing         *RoutingSpec
+	Ensemble        *EnsembleSpec
 }
 
 func (pv *PipelineVersion) String() string {
 	return fmt.Sprintf("%s:%d (%s)", pv.Name, pv.Version, pv.UID)
 }
 
+// IsSynchronous reports whether the pipeline gateway runs the steps of the pipeline by calling the models
+// directly, without Kafka
+func (pv *PipelineVersion) IsSynchronous() bool {
+	return pv.DataflowSepec != nil && pv.DataflowSepec.Mode == DataflowSynchronous
+}
+
+// InferredByGateway reports whether the pipeline gateway infers the pipeline itself, so no dataflow engine
+// is needed
+func (pv *PipelineVersion) InferredByGateway() bool {
+	return pv.Ensemble != nil || pv.IsSynchronous()
+}
+
 type KubernetesMeta struct {
 	Namespace  string
 	Generation int64
@@ -146,6 +162,82 @@ type PipelineInput struct {
 	TriggersJoinType JoinType
 }
 
+type DataflowMode uint32
+
+const (
+	DataflowKafka DataflowMode = iota
+	DataflowSynchronous
+)
+
 type DataflowSpec struct {
 	CleanTopicsOnDelete bool
+	Mode                DataflowMode
+}
+
+type AuthSpec struct {
+	Audiences []string
+	Groups    []string
+}
+
+type RateLimitSpec struct {
+	RequestsPerUnit uint32
+	Unit            time.Duration
+	Burst           uint32
+	Header          string
+	Tenants         []TenantRateLimit
+}
+
+type TenantRateLimit struct {
+	Value           string
+	RequestsPerUnit uint32
+	Burst           uint32
+}
+
+type LoadBalancer uint32
+
+const (
+	LeastRequestLoadBalancer LoadBalancer = iota
+	RoundRobinLoadBalancer
+	RingHashLoadBalancer
+	RandomLoadBalancer
+)
+
+type RoutingSpec struct {
+	Timeout          time.Duration
+	ConnectTimeout   time.Duration
+	Retry            *RetrySpec
+	OutlierDetection *OutlierDetectionSpec
+	LoadBalancer     LoadBalancer
+	HashHeader       string
+}
+
+type RetrySpec struct {
+	RetryOn             []string
+	MaxRetries          *uint32
+	PerTryTimeout       time.Duration
+	BackoffBaseInterval time.Duration
+	BudgetPercent       *uint32
+}
+
+type OutlierDetectionSpec struct {
+	Consecutive5xx     uint32
+	Interval           time.Duration
+	BaseEjectionTime   time.Duration
+	MaxEjectionPercent uint32
+}
+
+type EnsembleAggregation uint32
+
+const (
+	EnsembleMean EnsembleAggregation = iota
+	EnsembleVote
+	EnsembleConcat
+	EnsembleFirstSuccessful
+)
+
+// EnsembleSpec makes the pipeline gateway infer the steps of the pipeline in parallel, without Kafka, and
+// aggregate their responses
+type EnsembleSpec struct {
+	Aggregation EnsembleAggregation
+	Timeout     time.Duration
 }
@@ -68,6 +68,10 @@ func (f fakeModelStore) UpdateLoadedModels(modelKey string, version uint32, serv
 	panic("implement me")
 }
 
+func (f fakeModelStore) UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error) {
+	panic("implement me")
+}
+
 func (f fakeModelStore) UnloadVersionModels(modelKey string, version uint32) (bool, error) {
 	panic("implement me")
 }
@@ -109,6 +113,14 @@ func (f fakeModelStore) SetModelGwModelState(name string, versionNumber uint32,
 	panic("implement me")
 }
 
+func (f fakeModelStore) SetSchedulingWarning(modelID string, version uint32, warning string) error {
+	panic("implement me")
+}
+
+func (f fakeModelStore) SetModelRollout(modelID string, version uint32, rollout *store.RolloutStatus) error {
+	panic("implement me")
+}
+
 func TestUpdatePipelineModelAvailable(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type test struct {
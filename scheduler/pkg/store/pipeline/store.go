@@ -244,6 +244,10 @@ func (ps *PipelineStore) addPipelineImpl(req *scheduler.Pipeline) (*coordinator.
 				LastVersion: 0,
 			}
 		} else {
+			// Ensembles and pipelines share the store so one can not replace the other
+			if (req.Ensemble != nil) != (latestPipeline.Ensemble != nil) {
+				return nil, &PipelineEnsembleErr{pipeline: req.Name, reason: ensembleNameReason}
+			}
 			// Handle repeat Kubernetes resource calls for same generation
 			if ps.generationMatches(req, latestPipeline) {
 				return nil, nil
@@ -438,6 +438,41 @@ func TestAddPipeline(t *testing.T) {
 			},
 			expectedVersion: 2,
 		},
+		{
+			name: "ensemble with name of existing pipeline",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name: "step1",
+					},
+				},
+				Ensemble: &scheduler.EnsembleSpec{},
+			},
+			store: &PipelineStore{
+				logger: logrus.New(),
+				pipelines: map[string]*Pipeline{
+					"pipeline": {
+						Name:        "pipeline",
+						LastVersion: 1,
+						Versions: []*PipelineVersion{
+							{
+								Name:    "pipeline",
+								Version: 1,
+								State: &PipelineState{
+									Status: PipelineReady,
+								},
+							},
+						},
+					},
+				},
+				modelStatusHandler: ModelStatusHandler{
+					modelReferences: map[string]map[string]void{},
+					store:           fakeModelStore{status: map[string]store.ModelState{}},
+				},
+			},
+			err: &PipelineEnsembleErr{pipeline: "pipeline", reason: ensembleNameReason},
+		},
 		{
 			name: "version added when previous terminated",
 			proto: &scheduler.Pipeline{
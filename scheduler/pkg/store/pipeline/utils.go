@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/rs/xid"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -108,6 +109,22 @@ func CreateProtoFromPipelineVersion(pv *PipelineVersion) *scheduler.Pipeline {
 			Generation: pv.KubernetesMeta.Generation,
 		}
 	}
+	var protoDataflowSpec *scheduler.DataflowSpec
+	if pv.DataflowSepec != nil {
+		protoDataflowSpec = &scheduler.DataflowSpec{
+			CleanTopicsOnDelete: pv.DataflowSepec.CleanTopicsOnDelete,
+		}
+		if pv.DataflowSepec.Mode == DataflowSynchronous {
+			protoDataflowSpec.Mode = scheduler.DataflowSpec_SYNCHRONOUS
+		}
+	}
+	var protoAuth *scheduler.AuthSpec
+	if pv.Auth != nil {
+		protoAuth = &scheduler.AuthSpec{
+			Audiences: pv.Auth.Audiences,
+			Groups:    pv.Auth.Groups,
+		}
+	}
 	return &scheduler.Pipeline{
 		Name:           pv.Name,
 		Uid:            pv.UID,
@@ -115,8 +132,185 @@ func CreateProtoFromPipelineVersion(pv *PipelineVersion) *scheduler.Pipeline {
 		Input:          protoInput,
 		Steps:          protoSteps,
 		Output:         protoOutput,
+		DataflowSpec:   protoDataflowSpec,
 		KubernetesMeta: kubernetesMeta,
+		Auth:           protoAuth,
+		RateLimit:      createProtoRateLimit(pv.RateLimit),
+		Routing:        createProtoRouting(pv.Routing),
+		Ensemble:       createProtoEnsemble(pv.Ensemble),
+	}
+}
+
+func createProtoRateLimit(rateLimit *RateLimitSpec) *scheduler.RateLimitSpec {
+	if rateLimit == nil {
+		return nil
+	}
+	protoRateLimit := &scheduler.RateLimitSpec{
+		RequestsPerUnit: rateLimit.RequestsPerUnit,
+		Burst:           rateLimit.Burst,
+		Header:          rateLimit.Header,
+	}
+	switch rateLimit.Unit {
+	case time.Minute:
+		protoRateLimit.Unit = scheduler.RateLimitSpec_MINUTE
+	case time.Hour:
+		protoRateLimit.Unit = scheduler.RateLimitSpec_HOUR
+	default:
+		protoRateLimit.Unit = scheduler.RateLimitSpec_SECOND
+	}
+	for _, tenant := range rateLimit.Tenants {
+		protoRateLimit.Tenants = append(protoRateLimit.Tenants, &scheduler.TenantRateLimit{
+			Value:           tenant.Value,
+			RequestsPerUnit: tenant.RequestsPerUnit,
+			Burst:           tenant.Burst,
+		})
+	}
+	return protoRateLimit
+}
+
+func createRateLimitFromProto(protoRateLimit *scheduler.RateLimitSpec) *RateLimitSpec {
+	if protoRateLimit == nil {
+		return nil
+	}
+	rateLimit := &RateLimitSpec{
+		RequestsPerUnit: protoRateLimit.RequestsPerUnit,
+		Burst:           protoRateLimit.Burst,
+		Header:          protoRateLimit.Header,
+	}
+	switch protoRateLimit.Unit {
+	case scheduler.RateLimitSpec_MINUTE:
+		rateLimit.Unit = time.Minute
+	case scheduler.RateLimitSpec_HOUR:
+		rateLimit.Unit = time.Hour
+	default:
+		rateLimit.Unit = time.Second
+	}
+	for _, tenant := range protoRateLimit.Tenants {
+		rateLimit.Tenants = append(rateLimit.Tenants, TenantRateLimit{
+			Value:           tenant.Value,
+			RequestsPerUnit: tenant.RequestsPerUnit,
+			Burst:           tenant.Burst,
+		})
+	}
+	return rateLimit
+}
+
+func createProtoRouting(routing *RoutingSpec) *scheduler.RoutingSpec {
+	if routing == nil {
+		return nil
+	}
+	protoRouting := &scheduler.RoutingSpec{
+		TimeoutMs:        uint32(routing.Timeout.Milliseconds()),
+		ConnectTimeoutMs: uint32(routing.ConnectTimeout.Milliseconds()),
+		HashHeader:       routing.HashHeader,
+	}
+	switch routing.LoadBalancer {
+	case RoundRobinLoadBalancer:
+		protoRouting.LoadBalancer = scheduler.RoutingSpec_ROUND_ROBIN
+	case RingHashLoadBalancer:
+		protoRouting.LoadBalancer = scheduler.RoutingSpec_RING_HASH
+	case RandomLoadBalancer:
+		protoRouting.LoadBalancer = scheduler.RoutingSpec_RANDOM
+	default:
+		protoRouting.LoadBalancer = scheduler.RoutingSpec_LEAST_REQUEST
+	}
+	if routing.Retry != nil {
+		protoRouting.Retry = &scheduler.RetrySpec{
+			RetryOn:               routing.Retry.RetryOn,
+			MaxRetries:            routing.Retry.MaxRetries,
+			PerTryTimeoutMs:       uint32(routing.Retry.PerTryTimeout.Milliseconds()),
+			BackoffBaseIntervalMs: uint32(routing.Retry.BackoffBaseInterval.Milliseconds()),
+			BudgetPercent:         routing.Retry.BudgetPercent,
+		}
 	}
+	if routing.OutlierDetection != nil {
+		protoRouting.OutlierDetection = &scheduler.OutlierDetectionSpec{
+			Consecutive5Xx:          routing.OutlierDetection.Consecutive5xx,
+			IntervalSeconds:         uint32(routing.OutlierDetection.Interval.Seconds()),
+			BaseEjectionTimeSeconds: uint32(routing.OutlierDetection.BaseEjectionTime.Seconds()),
+			MaxEjectionPercent:      routing.OutlierDetection.MaxEjectionPercent,
+		}
+	}
+	return protoRouting
+}
+
+func createRoutingFromProto(protoRouting *scheduler.RoutingSpec) *RoutingSpec {
+	if protoRouting == nil {
+		return nil
+	}
+	routing := &RoutingSpec{
+		Timeout:        time.Duration(protoRouting.TimeoutMs) * time.Millisecond,
+		ConnectTimeout: time.Duration(protoRouting.ConnectTimeoutMs) * time.Millisecond,
+		HashHeader:     protoRouting.HashHeader,
+	}
+	switch protoRouting.LoadBalancer {
+	case scheduler.RoutingSpec_ROUND_ROBIN:
+		routing.LoadBalancer = RoundRobinLoadBalancer
+	case scheduler.RoutingSpec_RING_HASH:
+		routing.LoadBalancer = RingHashLoadBalancer
+	case scheduler.RoutingSpec_RANDOM:
+		routing.LoadBalancer = RandomLoadBalancer
+	default:
+		routing.LoadBalancer = LeastRequestLoadBalancer
+	}
+	if protoRouting.Retry != nil {
+		routing.Retry = &RetrySpec{
+			RetryOn:             protoRouting.Retry.RetryOn,
+			MaxRetries:          protoRouting.Retry.MaxRetries,
+			PerTryTimeout:       time.Duration(protoRouting.Retry.PerTryTimeoutMs) * time.Millisecond,
+			BackoffBaseInterval: time.Duration(protoRouting.Retry.BackoffBaseIntervalMs) * time.Millisecond,
+			BudgetPercent:       protoRouting.Retry.BudgetPercent,
+		}
+	}
+	if protoRouting.OutlierDetection != nil {
+		routing.OutlierDetection = &OutlierDetectionSpec{
+			Consecutive5xx:     protoRouting.OutlierDetection.Consecutive5Xx,
+			Interval:           time.Duration(protoRouting.OutlierDetection.IntervalSeconds) * time.Second,
+			BaseEjectionTime:   time.Duration(protoRouting.OutlierDetection.BaseEjectionTimeSeconds) * time.Second,
+			MaxEjectionPercent: protoRouting.OutlierDetection.MaxEjectionPercent,
+		}
+	}
+	return routing
+}
+
+func createProtoEnsemble(ensemble *EnsembleSpec) *scheduler.EnsembleSpec {
+	if ensemble == nil {
+		return nil
+	}
+	protoEnsemble := &scheduler.EnsembleSpec{
+		TimeoutMs: uint32(ensemble.Timeout.Milliseconds()),
+	}
+	switch ensemble.Aggregation {
+	case EnsembleVote:
+		protoEnsemble.Aggregation = scheduler.EnsembleSpec_VOTE
+	case EnsembleConcat:
+		protoEnsemble.Aggregation = scheduler.EnsembleSpec_CONCAT
+	case EnsembleFirstSuccessful:
+		protoEnsemble.Aggregation = scheduler.EnsembleSpec_FIRST_SUCCESSFUL
+	default:
+		protoEnsemble.Aggregation = scheduler.EnsembleSpec_MEAN
+	}
+	return protoEnsemble
+}
+
+func createEnsembleFromProto(protoEnsemble *scheduler.EnsembleSpec) *EnsembleSpec {
+	if protoEnsemble == nil {
+		return nil
+	}
+	ensemble := &EnsembleSpec{
+		Timeout: time.Duration(protoEnsemble.TimeoutMs) * time.Millisecond,
+	}
+	switch protoEnsemble.Aggregation {
+	case scheduler.EnsembleSpec_VOTE:
+		ensemble.Aggregation = EnsembleVote
+	case scheduler.EnsembleSpec_CONCAT:
+		ensemble.Aggregation = EnsembleConcat
+	case scheduler.EnsembleSpec_FIRST_SUCCESSFUL:
+		ensemble.Aggregation = EnsembleFirstSuccessful
+	default:
+		ensemble.Aggregation = EnsembleMean
+	}
+	return ensemble
 }
 
 func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*PipelineVersion, error) {
@@ -210,6 +404,16 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 		dataflowSpec = &DataflowSpec{
 			CleanTopicsOnDelete: pipelineProto.DataflowSpec.CleanTopicsOnDelete,
 		}
+		if pipelineProto.DataflowSpec.Mode == scheduler.DataflowSpec_SYNCHRONOUS {
+			dataflowSpec.Mode = DataflowSynchronous
+		}
+	}
+	var auth *AuthSpec
+	if pipelineProto.Auth != nil {
+		auth = &AuthSpec{
+			Audiences: pipelineProto.Auth.Audiences,
+			Groups:    pipelineProto.Auth.Groups,
+		}
 	}
 
 	pv := &PipelineVersion{
@@ -224,6 +428,10 @@ func CreatePipelineVersionFromProto(pipelineProto *scheduler.Pipeline) (*Pipelin
 		KubernetesMeta:  kubernetesMeta,
 		AllowCycles:     pipelineProto.AllowCycles,
 		MaxStepRevisits: pipelineProto.MaxStepRevisits,
+		Auth:            auth,
+		RateLimit:       createRateLimitFromProto(pipelineProto.RateLimit),
+		Routing:         createRoutingFromProto(pipelineProto.Routing),
+		Ensemble:        createEnsembleFromProto(pipelineProto.Ensemble),
 	}
 	// Ensure we have a UID
 	if pv.UID == "" {
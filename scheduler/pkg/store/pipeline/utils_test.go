@@ -11,6 +11,7 @@ package pipeline
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
@@ -304,6 +305,193 @@ func TestCreatePipelineFromProto(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "simple with auth",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				Auth: &scheduler.AuthSpec{
+					Audiences: []string{"pipeline"},
+					Groups:    []string{"data-science"},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				State: &PipelineState{},
+				Auth: &AuthSpec{
+					Audiences: []string{"pipeline"},
+					Groups:    []string{"data-science"},
+				},
+			},
+		},
+		{
+			name: "simple with rate limit",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				RateLimit: &scheduler.RateLimitSpec{
+					RequestsPerUnit: 100,
+					Unit:            scheduler.RateLimitSpec_MINUTE,
+					Header:          "x-api-key",
+					Tenants: []*scheduler.TenantRateLimit{
+						{Value: "batch", RequestsPerUnit: 10, Burst: 20},
+					},
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				State: &PipelineState{},
+				RateLimit: &RateLimitSpec{
+					RequestsPerUnit: 100,
+					Unit:            time.Minute,
+					Header:          "x-api-key",
+					Tenants: []TenantRateLimit{
+						{Value: "batch", RequestsPerUnit: 10, Burst: 20},
+					},
+				},
+			},
+		},
+		{
+			name: "simple with routing",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				Routing: &scheduler.RoutingSpec{
+					TimeoutMs: 2500,
+					Retry: &scheduler.RetrySpec{
+						RetryOn:         []string{"reset"},
+						PerTryTimeoutMs: 500,
+					},
+					OutlierDetection: &scheduler.OutlierDetectionSpec{
+						Consecutive5Xx:  3,
+						IntervalSeconds: 5,
+					},
+					LoadBalancer: scheduler.RoutingSpec_RANDOM,
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				State: &PipelineState{},
+				Routing: &RoutingSpec{
+					Timeout: 2500 * time.Millisecond,
+					Retry: &RetrySpec{
+						RetryOn:       []string{"reset"},
+						PerTryTimeout: 500 * time.Millisecond,
+					},
+					OutlierDetection: &OutlierDetectionSpec{
+						Consecutive5xx: 3,
+						Interval:       5 * time.Second,
+					},
+					LoadBalancer: RandomLoadBalancer,
+				},
+			},
+		},
+		{
+			name: "simple with ensemble",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:   "step1",
+						Inputs: []string{},
+					},
+					{
+						Name:   "step2",
+						Inputs: []string{},
+					},
+				},
+				Ensemble: &scheduler.EnsembleSpec{
+					Aggregation: scheduler.EnsembleSpec_FIRST_SUCCESSFUL,
+					TimeoutMs:   1500,
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:   "step1",
+						Inputs: []string{},
+					},
+					"step2": {
+						Name:   "step2",
+						Inputs: []string{},
+					},
+				},
+				State: &PipelineState{},
+				Ensemble: &EnsembleSpec{
+					Aggregation: EnsembleFirstSuccessful,
+					Timeout:     1500 * time.Millisecond,
+				},
+			},
+		},
+		{
+			name: "simple with synchronous dataflow",
+			proto: &scheduler.Pipeline{
+				Name: "pipeline",
+				Steps: []*scheduler.PipelineStep{
+					{
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				DataflowSpec: &scheduler.DataflowSpec{
+					CleanTopicsOnDelete: true,
+					Mode:                scheduler.DataflowSpec_SYNCHRONOUS,
+				},
+			},
+			pipeline: &PipelineVersion{
+				Name:    "pipeline",
+				Version: 1,
+				Steps: map[string]*PipelineStep{
+					"step1": {
+						Name:   "step1",
+						Inputs: []string{},
+					},
+				},
+				State: &PipelineState{},
+				DataflowSepec: &DataflowSpec{
+					CleanTopicsOnDelete: true,
+					Mode:                DataflowSynchronous,
+				},
+			},
+		},
 		{
 			name: "multi input",
 			proto: &scheduler.Pipeline{
@@ -364,6 +552,15 @@ func TestCreatePipelineFromProto(t *testing.T) {
 				for k, v := range pv.Steps {
 					g.Expect(test.pipeline.Steps[k]).To(Equal(v))
 				}
+				g.Expect(pv.Auth).To(Equal(test.pipeline.Auth))
+				g.Expect(pv.RateLimit).To(Equal(test.pipeline.RateLimit))
+				g.Expect(CreateProtoFromPipelineVersion(pv).RateLimit).To(Equal(test.proto.RateLimit))
+				g.Expect(pv.Routing).To(Equal(test.pipeline.Routing))
+				g.Expect(CreateProtoFromPipelineVersion(pv).Routing).To(Equal(test.proto.Routing))
+				g.Expect(pv.Ensemble).To(Equal(test.pipeline.Ensemble))
+				g.Expect(CreateProtoFromPipelineVersion(pv).Ensemble).To(Equal(test.proto.Ensemble))
+				g.Expect(pv.DataflowSepec).To(Equal(test.pipeline.DataflowSepec))
+				g.Expect(CreateProtoFromPipelineVersion(pv).DataflowSpec).To(Equal(test.proto.DataflowSpec))
 			} else {
 				g.Expect(err.Error()).To(Equal(test.err.Error()))
 			}
@@ -10,6 +10,7 @@ the Change License after the Change Date as each is defined in accordance with t
 package pipeline
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/seldonio/seldon-core/scheduler/v2/pkg/store/utils"
@@ -59,6 +60,12 @@ func validate(pv *PipelineVersion) error {
 	if err := checkPipelineInput(pv); err != nil {
 		return err
 	}
+	if err := checkEnsemble(pv); err != nil {
+		return err
+	}
+	if err := checkSynchronous(pv); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -308,3 +315,95 @@ func checkPipelineOutputs(pv *PipelineVersion) error {
 	}
 	return nil
 }
+
+const (
+	ensembleInputReason  = "An ensemble sends the request of the pipeline to all its steps and can not have an input"
+	ensembleOutputReason = "An ensemble aggregates the responses of all its steps and can not have an output"
+	ensembleStepReason   = "The steps of an ensemble can not have inputs, triggers, a tensor map or batching"
+	ensembleNameReason   = "A pipeline and an ensemble can not have the same name"
+)
+
+// checkEnsemble checks the steps of an ensemble all receive the request of the pipeline, as the pipeline
+// gateway infers them in parallel without Kafka
+func checkEnsemble(pv *PipelineVersion) error {
+	if pv.Ensemble == nil {
+		return nil
+	}
+	if pv.Input != nil {
+		return &PipelineEnsembleErr{pipeline: pv.Name, reason: ensembleInputReason}
+	}
+	if pv.Output != nil {
+		return &PipelineEnsembleErr{pipeline: pv.Name, reason: ensembleOutputReason}
+	}
+	for _, v := range pv.Steps {
+		if len(v.Inputs) > 0 || len(v.Triggers) > 0 || len(v.TensorMap) > 0 || v.Batch != nil {
+			return &PipelineEnsembleErr{pipeline: pv.Name, step: v.Name, reason: ensembleStepReason}
+		}
+	}
+	return nil
+}
+
+const (
+	synchronousInputReason  = "Inputs from other pipelines are read from Kafka and can not be used by a synchronous pipeline"
+	synchronousOutputReason = "A synchronous pipeline responds with its output which must be specified"
+	synchronousCyclesReason = "A synchronous pipeline can not have cycles"
+	synchronousBatchReason  = "The steps of a synchronous pipeline can not have batching"
+)
+
+// checkSynchronous checks the pipeline only uses what the pipeline gateway can run without Kafka.
+// Ensembles are always run by the pipeline gateway and are checked by checkEnsemble.
+func checkSynchronous(pv *PipelineVersion) error {
+	if !pv.IsSynchronous() || pv.Ensemble != nil {
+		return nil
+	}
+	if pv.Input != nil {
+		return &PipelineSynchronousErr{pipeline: pv.Name, reason: synchronousInputReason}
+	}
+	if pv.Output == nil || len(pv.Output.Steps) == 0 {
+		return &PipelineSynchronousErr{pipeline: pv.Name, reason: synchronousOutputReason}
+	}
+	if pv.AllowCycles {
+		return &PipelineSynchronousErr{pipeline: pv.Name, reason: synchronousCyclesReason}
+	}
+	for _, v := range pv.Steps {
+		if v.Batch != nil {
+			return &PipelineSynchronousErr{pipeline: pv.Name, step: v.Name, reason: synchronousBatchReason}
+		}
+	}
+	return nil
+}
+
+const (
+	streamingOutputReason     = "A streamed pipeline must have a single output step whose model streams its outputs"
+	streamingStepOutputReason = "The outputs of the streamed step can not be used by other steps as each partial response would be seen as a separate output"
+	streamingBatchReason      = "The streamed step can not have batching"
+)
+
+// GetStreamingStep returns the step of the pipeline whose model streams its partial responses when the pipeline
+// is streamed. Only the outputs of the last step can be streamed, as every partial response published to the
+// output topic of a model is a complete output for the dataflow engine, so a streamed step must be the only
+// output step of the pipeline and its outputs can not be used by other steps.
+func GetStreamingStep(pv *PipelineVersion) (string, error) {
+	if pv.Output == nil || len(pv.Output.Steps) != 1 {
+		return "", &PipelineStreamingErr{pipeline: pv.Name, reason: streamingOutputReason}
+	}
+	parts := strings.Split(pv.Output.Steps[0], StepNameSeperator)
+	if len(parts) < 2 || parts[1] != StepOutputSpecifier {
+		return "", &PipelineStreamingErr{pipeline: pv.Name, reason: streamingOutputReason}
+	}
+	step, ok := pv.Steps[parts[0]]
+	if !ok {
+		return "", &PipelineStreamingErr{pipeline: pv.Name, reason: streamingOutputReason}
+	}
+	for _, v := range pv.Steps {
+		for _, ref := range slices.Concat(v.Inputs, v.Triggers) {
+			if getStepNameFromInput(ref) == step.Name {
+				return "", &PipelineStreamingErr{pipeline: pv.Name, step: step.Name, reason: streamingStepOutputReason}
+			}
+		}
+	}
+	if step.Batch != nil {
+		return "", &PipelineStreamingErr{pipeline: pv.Name, step: step.Name, reason: streamingBatchReason}
+	}
+	return step.Name, nil
+}
@@ -920,3 +920,322 @@ func TestCheckName(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckEnsemble(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []validateTest{
+		{
+			name: "not an ensemble",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+					"b": {
+						Name:   "b",
+						Inputs: []string{"a.outputs"},
+					},
+				},
+			},
+		},
+		{
+			name: "valid ensemble",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+					"b": {
+						Name: "b",
+					},
+				},
+				Ensemble: &EnsembleSpec{Aggregation: EnsembleVote},
+			},
+		},
+		{
+			name: "ensemble with output",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+				},
+				Output:   &PipelineOutput{Steps: []string{"a.outputs"}},
+				Ensemble: &EnsembleSpec{},
+			},
+			err: &PipelineEnsembleErr{pipeline: "test", reason: ensembleOutputReason},
+		},
+		{
+			name: "ensemble step with inputs",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+					"b": {
+						Name:   "b",
+						Inputs: []string{"a.outputs"},
+					},
+				},
+				Ensemble: &EnsembleSpec{},
+			},
+			err: &PipelineEnsembleErr{pipeline: "test", step: "b", reason: ensembleStepReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkEnsemble(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+			err = validate(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestCheckSynchronous(t *testing.T) {
+	g := NewGomegaWithT(t)
+	synchronous := &DataflowSpec{Mode: DataflowSynchronous}
+	tests := []validateTest{
+		{
+			name: "valid synchronous pipeline",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+					"b": {
+						Name:     "b",
+						Inputs:   []string{"a.outputs.out1"},
+						Triggers: []string{"test.inputs.trigger"},
+					},
+				},
+				Output:        &PipelineOutput{Steps: []string{"b.outputs"}},
+				DataflowSepec: synchronous,
+			},
+		},
+		{
+			name: "kafka pipeline without output",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+				},
+				DataflowSepec: &DataflowSpec{CleanTopicsOnDelete: true},
+			},
+		},
+		{
+			name: "synchronous pipeline without output",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+				},
+				DataflowSepec: synchronous,
+			},
+			err: &PipelineSynchronousErr{pipeline: "test", reason: synchronousOutputReason},
+		},
+		{
+			name: "synchronous pipeline with input",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Input: &PipelineInput{
+					ExternalInputs: []string{"other.outputs"},
+				},
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+				},
+				Output:        &PipelineOutput{Steps: []string{"a.outputs"}},
+				DataflowSepec: synchronous,
+			},
+			err: &PipelineSynchronousErr{pipeline: "test", reason: synchronousInputReason},
+		},
+		{
+			name: "synchronous pipeline with cycles",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:   "a",
+						Inputs: []string{"b.outputs"},
+					},
+					"b": {
+						Name:   "b",
+						Inputs: []string{"a.outputs"},
+					},
+				},
+				Output:        &PipelineOutput{Steps: []string{"b.outputs"}},
+				AllowCycles:   true,
+				DataflowSepec: synchronous,
+			},
+			err: &PipelineSynchronousErr{pipeline: "test", reason: synchronousCyclesReason},
+		},
+		{
+			name: "synchronous pipeline step with batch",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name:  "a",
+						Batch: &Batch{},
+					},
+				},
+				Output:        &PipelineOutput{Steps: []string{"a.outputs"}},
+				DataflowSepec: synchronous,
+			},
+			err: &PipelineSynchronousErr{pipeline: "test", step: "a", reason: synchronousBatchReason},
+		},
+		{
+			name: "synchronous ensemble",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {
+						Name: "a",
+					},
+				},
+				Ensemble:      &EnsembleSpec{},
+				DataflowSepec: synchronous,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkSynchronous(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+			err = validate(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
+
+func TestGetStreamingStep(t *testing.T) {
+	type test struct {
+		name            string
+		pipelineVersion *PipelineVersion
+		step            string
+		err             error
+	}
+	tests := []test{
+		{
+			name: "last step of multi-step pipeline",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b", Inputs: []string{"a.outputs"}},
+					"llm": {
+						Name:     "llm",
+						Inputs:   []string{"b.outputs.prompt"},
+						Triggers: []string{"a.outputs.ok"},
+					},
+				},
+				Output: &PipelineOutput{
+					Steps:     []string{"llm.outputs"},
+					TensorMap: map[string]string{"llm.outputs.out": "text"},
+				},
+			},
+			step: "llm",
+		},
+		{
+			name: "no output",
+			pipelineVersion: &PipelineVersion{
+				Name:  "test",
+				Steps: map[string]*PipelineStep{"a": {Name: "a"}},
+			},
+			err: &PipelineStreamingErr{pipeline: "test", reason: streamingOutputReason},
+		},
+		{
+			name: "join of output steps",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b"},
+				},
+				Output: &PipelineOutput{Steps: []string{"a.outputs", "b.outputs"}},
+			},
+			err: &PipelineStreamingErr{pipeline: "test", reason: streamingOutputReason},
+		},
+		{
+			name: "output of step inputs",
+			pipelineVersion: &PipelineVersion{
+				Name:   "test",
+				Steps:  map[string]*PipelineStep{"a": {Name: "a"}},
+				Output: &PipelineOutput{Steps: []string{"a.inputs"}},
+			},
+			err: &PipelineStreamingErr{pipeline: "test", reason: streamingOutputReason},
+		},
+		{
+			name: "output step used as input",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b", Inputs: []string{"a.outputs"}},
+				},
+				Output: &PipelineOutput{Steps: []string{"a.outputs"}},
+			},
+			err: &PipelineStreamingErr{pipeline: "test", step: "a", reason: streamingStepOutputReason},
+		},
+		{
+			name: "output step used as trigger",
+			pipelineVersion: &PipelineVersion{
+				Name: "test",
+				Steps: map[string]*PipelineStep{
+					"a": {Name: "a"},
+					"b": {Name: "b", Triggers: []string{"a.outputs.ok"}},
+				},
+				Output: &PipelineOutput{Steps: []string{"a.outputs"}},
+			},
+			err: &PipelineStreamingErr{pipeline: "test", step: "a", reason: streamingStepOutputReason},
+		},
+		{
+			name: "output step with batch",
+			pipelineVersion: &PipelineVersion{
+				Name:   "test",
+				Steps:  map[string]*PipelineStep{"a": {Name: "a", Batch: &Batch{}}},
+				Output: &PipelineOutput{Steps: []string{"a.outputs"}},
+			},
+			err: &PipelineStreamingErr{pipeline: "test", step: "a", reason: streamingBatchReason},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			step, err := GetStreamingStep(test.pipelineVersion)
+			if test.err == nil {
+				g.Expect(err).To(BeNil())
+				g.Expect(step).To(Equal(test.step))
+			} else {
+				g.Expect(err.Error()).To(Equal(test.err.Error()))
+			}
+		})
+	}
+}
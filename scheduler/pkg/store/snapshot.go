@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed by
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package store
+
+import (
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/seldonio/seldon-core/apis/go/v2/mlops/scheduler"
+)
+
+var modelStates = []ModelState{
+	ModelStateUnknown,
+	ModelProgressing,
+	ModelAvailable,
+	ModelFailed,
+	ModelTerminating,
+	ModelTerminated,
+	ModelTerminateFailed,
+	ScheduleFailed,
+	ModelScaledDown,
+	ModelCreate,
+	ModelTerminate,
+}
+
+// the proto enums do not share the ordering of the store enums, so we map them by name
+func modelStateFromProto(state pb.ModelStatus_ModelState) ModelState {
+	for _, s := range modelStates {
+		if s.String() == state.String() {
+			return s
+		}
+	}
+	return ModelStateUnknown
+}
+
+func modelReplicaStateFromProto(state pb.ModelReplicaStatus_ModelReplicaState) ModelReplicaState {
+	for _, s := range replicaStates {
+		if s.String() == state.String() {
+			return s
+		}
+	}
+	return ModelReplicaStateUnknown
+}
+
+func rolloutStateFromProto(state pb.ModelRolloutStatus_RolloutState) RolloutState {
+	for _, s := range rolloutStates {
+		if s.String() == state.String() {
+			return s
+		}
+	}
+	return RolloutStateUnknown
+}
+
+func modelStateToProto(state ModelState) pb.ModelStatus_ModelState {
+	return pb.ModelStatus_ModelState(pb.ModelStatus_ModelState_value[state.String()])
+}
+
+func modelReplicaStateToProto(state ModelReplicaState) pb.ModelReplicaStatus_ModelReplicaState {
+	return pb.ModelReplicaStatus_ModelReplicaState(pb.ModelReplicaStatus_ModelReplicaState_value[state.String()])
+}
+
+func rolloutStateToProto(state RolloutState) pb.ModelRolloutStatus_RolloutState {
+	return pb.ModelRolloutStatus_RolloutState(pb.ModelRolloutStatus_RolloutState_value[state.String()])
+}
+
+func CreateRolloutStatusProto(rollout *RolloutStatus) *pb.ModelRolloutStatus {
+	if rollout == nil {
+		return nil
+	}
+	return &pb.ModelRolloutStatus{
+		State:               rolloutStateToProto(rollout.State),
+		StableVersion:       rollout.StableVersion,
+		TrafficPercent:      rollout.TrafficPercent,
+		Reason:              rollout.Reason,
+		LastChangeTimestamp: timestamppb.New(rollout.Timestamp),
+	}
+}
+
+func createRolloutStatusFromProto(rollout *pb.ModelRolloutStatus) *RolloutStatus {
+	if rollout == nil {
+		return nil
+	}
+	return &RolloutStatus{
+		State:          rolloutStateFromProto(rollout.GetState()),
+		StableVersion:  rollout.GetStableVersion(),
+		TrafficPercent: rollout.GetTrafficPercent(),
+		Reason:         rollout.GetReason(),
+		Timestamp:      rollout.GetLastChangeTimestamp().AsTime(),
+	}
+}
+
+func CreateModelSnapshotProto(name string, model *Model) *pb.ModelSnapshot {
+	versions := make([]*pb.ModelVersionStatus, len(model.versions))
+	for idx, mv := range model.versions {
+		versions[idx] = createModelVersionSnapshotProto(mv)
+	}
+	return &pb.ModelSnapshot{
+		Name:     name,
+		Versions: versions,
+		Deleted:  model.IsDeleted(),
+	}
+}
+
+func createModelVersionSnapshotProto(mv *ModelVersion) *pb.ModelVersionStatus {
+	mv.mu.RLock()
+	defer mv.mu.RUnlock()
+
+	replicas := make(map[int32]*pb.ModelReplicaStatus, len(mv.replicas))
+	for idx, replica := range mv.replicas {
+		replicas[int32(idx)] = &pb.ModelReplicaStatus{
+			State:               modelReplicaStateToProto(replica.State),
+			Reason:              replica.Reason,
+			LastChangeTimestamp: timestamppb.New(replica.Timestamp),
+		}
+	}
+	return &pb.ModelVersionStatus{
+		Version:           mv.version,
+		ServerName:        mv.Server(),
+		ModelReplicaState: replicas,
+		State: &pb.ModelStatus{
+			State:               modelStateToProto(mv.state.State),
+			Reason:              mv.state.Reason,
+			AvailableReplicas:   mv.state.AvailableReplicas,
+			UnavailableReplicas: mv.state.UnavailableReplicas,
+			LastChangeTimestamp: timestamppb.New(mv.state.Timestamp),
+			ModelGwState:        modelStateToProto(mv.state.ModelGwState),
+			ModelGwReason:       mv.state.ModelGwReason,
+			SchedulingWarning:   mv.state.SchedulingWarning,
+			Rollout:             CreateRolloutStatusProto(mv.state.Rollout),
+		},
+		ModelDefn: proto.Clone(mv.modelDefn).(*pb.Model),
+	}
+}
+
+func CreateModelFromSnapshot(snapshot *pb.ModelSnapshot) *Model {
+	model := &Model{}
+	for _, mvs := range snapshot.GetVersions() {
+		model.versions = append(model.versions, createModelVersionFromSnapshot(mvs))
+	}
+	sort.SliceStable(model.versions, func(i, j int) bool {
+		return model.versions[i].GetVersion() < model.versions[j].GetVersion()
+	})
+	if snapshot.GetDeleted() {
+		model.SetDeleted()
+	}
+	return model
+}
+
+func createModelVersionFromSnapshot(snapshot *pb.ModelVersionStatus) *ModelVersion {
+	replicas := make(map[int]ReplicaStatus, len(snapshot.GetModelReplicaState()))
+	var draining uint32
+	for idx, replica := range snapshot.GetModelReplicaState() {
+		state := modelReplicaStateFromProto(replica.GetState())
+		if state == Draining {
+			draining++
+		}
+		replicas[int(idx)] = ReplicaStatus{
+			State:     state,
+			Reason:    replica.GetReason(),
+			Timestamp: replica.GetLastChangeTimestamp().AsTime(),
+		}
+	}
+	return &ModelVersion{
+		modelDefn: snapshot.GetModelDefn(),
+		version:   snapshot.GetVersion(),
+		server:    snapshot.GetServerName(),
+		replicas:  replicas,
+		state: ModelStatus{
+			State:               modelStateFromProto(snapshot.GetState().GetState()),
+			ModelGwState:        modelStateFromProto(snapshot.GetState().GetModelGwState()),
+			Reason:              snapshot.GetState().GetReason(),
+			ModelGwReason:       snapshot.GetState().GetModelGwReason(),
+			SchedulingWarning:   snapshot.GetState().GetSchedulingWarning(),
+			AvailableReplicas:   snapshot.GetState().GetAvailableReplicas(),
+			UnavailableReplicas: snapshot.GetState().GetUnavailableReplicas(),
+			DrainingReplicas:    draining,
+			Timestamp:           snapshot.GetState().GetLastChangeTimestamp().AsTime(),
+			Rollout:             createRolloutStatusFromProto(snapshot.GetState().GetRollout()),
+		},
+		mu: sync.RWMutex{},
+	}
+}
+
+func CreateServerSnapshotProto(server *Server) *pb.ServerSnapshot {
+	var kubernetesMeta *pb.KubernetesMeta
+	if server.kubernetesMeta != nil {
+		kubernetesMeta = proto.Clone(server.kubernetesMeta).(*pb.KubernetesMeta)
+	}
+	return &pb.ServerSnapshot{
+		Name:             server.name,
+		Shared:           server.shared,
+		ExpectedReplicas: int32(server.expectedReplicas),
+		MinReplicas:      int32(server.minReplicas),
+		MaxReplicas:      int32(server.maxReplicas),
+		KubernetesMeta:   kubernetesMeta,
+	}
+}
+
+// replicas are not part of the snapshot, they are added back as agents reconnect
+func CreateServerFromSnapshot(snapshot *pb.ServerSnapshot) *Server {
+	server := NewServer(snapshot.GetName(), snapshot.GetShared())
+	server.SetExpectedReplicas(int(snapshot.GetExpectedReplicas()))
+	server.SetMinReplicas(int(snapshot.GetMinReplicas()))
+	server.SetMaxReplicas(int(snapshot.GetMaxReplicas()))
+	server.SetKubernetesMeta(snapshot.GetKubernetesMeta())
+	return server
+}
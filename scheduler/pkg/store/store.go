@@ -135,6 +135,33 @@ func (m *ModelSnapshot) GetVersionsBeforeLastModelGwAvailable() []*ModelVersion
 	return nil
 }
 
+// GetCanaryStableModel returns the version that traffic is being shifted away from while the latest version
+// is rolled out as a canary, or nil if the latest version can receive all of the traffic
+func (m *ModelSnapshot) GetCanaryStableModel() *ModelVersion {
+	latest := m.GetLatest()
+	if latest == nil || latest.GetDeploymentSpec().GetCanary() == nil {
+		return nil
+	}
+	if rollout := latest.ModelState().Rollout; rollout != nil {
+		if rollout.State == RolloutSucceeded {
+			return nil
+		}
+		return m.GetVersion(rollout.StableVersion)
+	}
+	// the rollout has not started so the stable version is the newest previous version still serving,
+	// ignoring versions that were themselves rolled back
+	for idx := len(m.Versions) - 2; idx >= 0; idx-- {
+		mv := m.Versions[idx]
+		if rollout := mv.ModelState().Rollout; rollout != nil && rollout.State == RolloutRolledBack {
+			continue
+		}
+		if mv.HasLiveReplicas() {
+			return mv
+		}
+	}
+	return nil
+}
+
 //go:generate go tool mockgen -source=./store.go -destination=./mock/store.go -package=mock ModelStore
 type ModelStore interface {
 	UpdateModel(config *pb.LoadModelRequest) error
@@ -147,6 +174,7 @@ type ModelStore interface {
 	GetServer(serverKey string, shallow bool, modelDetails bool) (*ServerSnapshot, error)
 	UpdateLoadedModels(modelKey string, version uint32, serverKey string, replicas []*ServerReplica) error
 	UnloadVersionModels(modelKey string, version uint32) (bool, error)
+	UnloadVersionModelReplicas(modelKey string, version uint32, replicaIdxs []int) (bool, error)
 	UnloadModelGwVersionModels(modelKey string, version uint32) (bool, error)
 	UpdateModelState(modelKey string, version uint32, serverKey string, replicaIdx int, availableMemory *uint64, expectedState, desiredState ModelReplicaState, reason string, runtimeInfo *pb.ModelRuntimeInfo) error
 	AddServerReplica(request *pba.AgentSubscribeRequest) error
@@ -154,6 +182,8 @@ type ModelStore interface {
 	RemoveServerReplica(serverName string, replicaIdx int) ([]string, error) // return previously loaded models
 	DrainServerReplica(serverName string, replicaIdx int) ([]string, error)  // return previously loaded models
 	FailedScheduling(modelID string, version uint32, reason string, reset bool) error
+	SetSchedulingWarning(modelID string, version uint32, warning string) error
+	SetModelRollout(modelID string, version uint32, rollout *RolloutStatus) error
 	GetAllModels() []string
 	SetModelGwModelState(name string, versionNumber uint32, status ModelState, reason string, source string) error
 }
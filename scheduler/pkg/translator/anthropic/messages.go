@@ -0,0 +1,526 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package anthropic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator"
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator/openai"
+)
+
+// AnthropicMessagesTranslator translates Anthropic Messages API requests to OpenAI chat completions, which
+// are then translated to OIP, and the chat completions of the model back to messages
+type AnthropicMessagesTranslator struct {
+	chatCompletions openai.OpenAIChatCompletionsTranslator
+}
+
+const (
+	modelKey                  = "model"
+	messagesKey               = "messages"
+	systemKey                 = "system"
+	roleKey                   = "role"
+	contentKey                = "content"
+	typeKey                   = "type"
+	textKey                   = "text"
+	idKey                     = "id"
+	nameKey                   = "name"
+	inputKey                  = "input"
+	toolsKey                  = "tools"
+	toolChoiceKey             = "tool_choice"
+	toolCallsKey              = "tool_calls"
+	toolCallIdKey             = "tool_call_id"
+	functionKey               = "function"
+	argumentsKey              = "arguments"
+	maxTokensKey              = "max_tokens"
+	stopKey                   = "stop"
+	stopSequencesKey          = "stop_sequences"
+	metadataKey               = "metadata"
+	userIdKey                 = "user_id"
+	disableParallelToolUseKey = "disable_parallel_tool_use"
+)
+
+// request fields passed as is to chat completions
+var passThroughKeys = []string{
+	modelKey, maxTokensKey, "temperature", "top_p", "stream",
+}
+
+func (t *AnthropicMessagesTranslator) TranslateToOIP(req *http.Request) (*http.Request, error) {
+	jsonBody, err := translator.ConvertRequestToJsonBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	chatBody, err := messagesToChatCompletions(jsonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	chatReq, err := translator.NewJsonRequest(req, chatBody)
+	if err != nil {
+		return nil, err
+	}
+	return t.chatCompletions.TranslateToOIP(chatReq)
+}
+
+func (t *AnthropicMessagesTranslator) TranslateFromOIP(res *http.Response) (*http.Response, error) {
+	if translator.IsServerSentEvent(res) {
+		return translator.TranslateChatCompletionStream(res, &messagesStreamHandler{})
+	}
+
+	completion, isGzipped, err := translator.ParseChatCompletionResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	content := []any{}
+	if completion.Content != "" {
+		content = append(content, textBlock(completion.Content))
+	}
+	for _, toolCall := range completion.ToolCalls {
+		block, err := toolUseBlock(toolCall, true)
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, block)
+	}
+
+	message := messagesMessage(completion, content, stopReason(completion.FinishReason))
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Anthropic Messages API response: %w", err)
+	}
+	return translator.CreateResponseFromContent(string(data), res.StatusCode, res.Header, isGzipped)
+}
+
+func messagesToChatCompletions(jsonBody map[string]any) (map[string]any, error) {
+	chatBody := make(map[string]any)
+	for _, key := range passThroughKeys {
+		if value, ok := jsonBody[key]; ok {
+			chatBody[key] = value
+		}
+	}
+	if stopSequences, ok := jsonBody[stopSequencesKey]; ok {
+		chatBody[stopKey] = stopSequences
+	}
+	if metadata, ok := jsonBody[metadataKey].(map[string]any); ok {
+		if userId, ok := metadata[userIdKey]; ok {
+			chatBody["user"] = userId
+		}
+	}
+
+	var messages []any
+	if system, ok := jsonBody[systemKey]; ok {
+		systemContent, err := systemToChat(system)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, map[string]any{roleKey: "system", contentKey: systemContent})
+	}
+
+	messagesList, ok := jsonBody[messagesKey].([]any)
+	if !ok {
+		return nil, fmt.Errorf("`%s` field not found or not an array", messagesKey)
+	}
+	for i, message := range messagesList {
+		chatMessages, err := messageToChat(message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to translate message %d: %v", i, err)
+		}
+		messages = append(messages, chatMessages...)
+	}
+	chatBody[messagesKey] = messages
+
+	if tools, ok := jsonBody[toolsKey].([]any); ok && len(tools) > 0 {
+		chatTools, err := toolsToChat(tools)
+		if err != nil {
+			return nil, err
+		}
+		chatBody[toolsKey] = chatTools
+	}
+
+	if toolChoice, ok := jsonBody[toolChoiceKey].(map[string]any); ok {
+		chatToolChoice, err := toolChoiceToChat(toolChoice)
+		if err != nil {
+			return nil, err
+		}
+		chatBody[toolChoiceKey] = chatToolChoice
+		if disable, _ := toolChoice[disableParallelToolUseKey].(bool); disable {
+			chatBody["parallel_tool_calls"] = false
+		}
+	}
+	return chatBody, nil
+}
+
+func systemToChat(system any) (any, error) {
+	switch s := system.(type) {
+	case string:
+		return s, nil
+	case []any:
+		parts := make([]any, len(s))
+		for i, block := range s {
+			blockMap, ok := block.(map[string]any)
+			if !ok || blockMap[typeKey] != textKey {
+				return nil, fmt.Errorf("only text blocks are supported in `%s`", systemKey)
+			}
+			parts[i] = map[string]any{typeKey: textKey, textKey: blockMap[textKey]}
+		}
+		return parts, nil
+	default:
+		return nil, fmt.Errorf("`%s` field is not a string or an array", systemKey)
+	}
+}
+
+// messageToChat translates a message to chat completion messages, tool results are sent in user
+// messages in the Messages API but are separate tool messages, which come first, in chat completions
+func messageToChat(message any) ([]any, error) {
+	msgMap, ok := message.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("message is not an object")
+	}
+	role, ok := msgMap[roleKey].(string)
+	if !ok {
+		return nil, fmt.Errorf("field '%s' not found in message", roleKey)
+	}
+
+	var blocks []any
+	switch c := msgMap[contentKey].(type) {
+	case string:
+		return []any{map[string]any{roleKey: role, contentKey: c}}, nil
+	case []any:
+		blocks = c
+	default:
+		return nil, fmt.Errorf("unsupported content type: %T", msgMap[contentKey])
+	}
+
+	var toolResults, parts, toolCalls []any
+	for i, block := range blocks {
+		blockMap, ok := block.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse content block %d", i)
+		}
+
+		blockType, _ := blockMap[typeKey].(string)
+		switch blockType {
+		case textKey:
+			parts = append(parts, map[string]any{typeKey: textKey, textKey: blockMap[textKey]})
+		case "image":
+			part, err := imageToChat(blockMap)
+			if err != nil {
+				return nil, fmt.Errorf("failed to translate content block %d: %v", i, err)
+			}
+			parts = append(parts, part)
+		case "tool_use":
+			arguments, err := json.Marshal(blockMap[inputKey])
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal input of content block %d: %v", i, err)
+			}
+			toolCalls = append(toolCalls, map[string]any{
+				idKey:   blockMap[idKey],
+				typeKey: functionKey,
+				functionKey: map[string]any{
+					nameKey:      blockMap[nameKey],
+					argumentsKey: string(arguments),
+				},
+			})
+		case "tool_result":
+			content, err := toolResultContent(blockMap[contentKey])
+			if err != nil {
+				return nil, fmt.Errorf("failed to translate content block %d: %v", i, err)
+			}
+			toolResults = append(toolResults, map[string]any{
+				roleKey:       "tool",
+				toolCallIdKey: blockMap["tool_use_id"],
+				contentKey:    content,
+			})
+		default:
+			return nil, fmt.Errorf("unsupported content block type %s in content block %d", blockType, i)
+		}
+	}
+
+	messages := toolResults
+	if len(parts) > 0 || len(toolCalls) > 0 {
+		chatMessage := map[string]any{roleKey: role}
+		if len(parts) > 0 {
+			chatMessage[contentKey] = parts
+		}
+		if len(toolCalls) > 0 {
+			chatMessage[toolCallsKey] = toolCalls
+		}
+		messages = append(messages, chatMessage)
+	}
+	return messages, nil
+}
+
+func imageToChat(block map[string]any) (map[string]any, error) {
+	source, ok := block["source"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("image `source` not found")
+	}
+
+	var url string
+	switch source[typeKey] {
+	case "base64":
+		url = fmt.Sprintf("data:%v;base64,%v", source["media_type"], source["data"])
+	case "url":
+		url, _ = source["url"].(string)
+	default:
+		return nil, fmt.Errorf("unsupported image source type %v", source[typeKey])
+	}
+	return map[string]any{typeKey: "image_url", "image_url": map[string]any{"url": url}}, nil
+}
+
+func toolResultContent(content any) (string, error) {
+	switch c := content.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return c, nil
+	case []any:
+		texts := make([]string, 0, len(c))
+		for _, block := range c {
+			blockMap, ok := block.(map[string]any)
+			if !ok || blockMap[typeKey] != textKey {
+				return "", fmt.Errorf("only text blocks are supported in tool results")
+			}
+			text, _ := blockMap[textKey].(string)
+			texts = append(texts, text)
+		}
+		return strings.Join(texts, "\n"), nil
+	default:
+		return "", fmt.Errorf("unsupported tool result content type: %T", content)
+	}
+}
+
+func toolsToChat(tools []any) ([]any, error) {
+	chatTools := make([]any, len(tools))
+	for i, tool := range tools {
+		toolMap, ok := tool.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse tool %d", i)
+		}
+		// server tools such as web search have a type, client tools have an input schema instead
+		if toolType, ok := toolMap[typeKey]; ok && toolType != "custom" {
+			return nil, fmt.Errorf("unsupported tool type %v in tool %d, only client tools can be translated to chat completions", toolType, i)
+		}
+
+		function := map[string]any{nameKey: toolMap[nameKey]}
+		if description, ok := toolMap["description"]; ok {
+			function["description"] = description
+		}
+		if inputSchema, ok := toolMap["input_schema"]; ok {
+			function["parameters"] = inputSchema
+		}
+		chatTools[i] = map[string]any{typeKey: functionKey, functionKey: function}
+	}
+	return chatTools, nil
+}
+
+func toolChoiceToChat(toolChoice map[string]any) (any, error) {
+	switch toolChoice[typeKey] {
+	case "auto":
+		return "auto", nil
+	case "any":
+		return "required", nil
+	case "none":
+		return "none", nil
+	case "tool":
+		return map[string]any{
+			typeKey:     functionKey,
+			functionKey: map[string]any{nameKey: toolChoice[nameKey]},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported `%s` type %v", toolChoiceKey, toolChoice[typeKey])
+	}
+}
+
+func stopReason(finishReason string) string {
+	switch finishReason {
+	case "length":
+		return "max_tokens"
+	case "tool_calls", "function_call":
+		return "tool_use"
+	case "content_filter":
+		return "refusal"
+	default:
+		return "end_turn"
+	}
+}
+
+func textBlock(text string) map[string]any {
+	return map[string]any{typeKey: textKey, textKey: text}
+}
+
+func toolUseId(toolCall translator.ToolCall) string {
+	if toolCall.Id != "" {
+		return toolCall.Id
+	}
+	return fmt.Sprintf("toolu_%d", toolCall.Index)
+}
+
+// toolUseBlock creates the block of a tool call, with its parsed arguments as input if complete
+// or an empty input as sent at the start of a stream
+func toolUseBlock(toolCall translator.ToolCall, complete bool) (map[string]any, error) {
+	input := map[string]any{}
+	if complete && toolCall.Arguments != "" {
+		if err := json.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
+			return nil, fmt.Errorf("failed to parse arguments of tool call %s: %w", toolCall.Name, err)
+		}
+	}
+	return map[string]any{
+		typeKey:  "tool_use",
+		idKey:    toolUseId(toolCall),
+		nameKey:  toolCall.Name,
+		inputKey: input,
+	}, nil
+}
+
+func messagesUsage(completion *translator.ChatCompletion) map[string]any {
+	usage := map[string]any{"input_tokens": 0, "output_tokens": 0}
+	if completion.Usage != nil {
+		usage["input_tokens"] = completion.Usage.PromptTokens
+		usage["output_tokens"] = completion.Usage.CompletionTokens
+	}
+	return usage
+}
+
+func messagesMessage(completion *translator.ChatCompletion, content []any, stopReason any) map[string]any {
+	return map[string]any{
+		idKey:           completion.Id,
+		typeKey:         "message",
+		roleKey:         "assistant",
+		modelKey:        completion.Model,
+		contentKey:      content,
+		"stop_reason":   stopReason,
+		"stop_sequence": nil,
+		"usage":         messagesUsage(completion),
+	}
+}
+
+// messagesStreamHandler converts chat completion chunks to Messages API events, streaming one content
+// block at a time and stopping it when the chunks move on to another block
+type messagesStreamHandler struct {
+	started    bool
+	err        error
+	completion translator.ChatCompletion
+	blocks     int
+	// current is the index of the streamed block, -1 if none
+	current int
+	// currentToolCall is the index of the tool call of the streamed block, nil for text blocks
+	currentToolCall *int
+	hasToolCalls    bool
+}
+
+func (h *messagesStreamHandler) event(name string, payload map[string]any) string {
+	payload[typeKey] = name
+	event, err := translator.FormatSSEEvent(name, payload)
+	if err != nil && h.err == nil {
+		h.err = err
+	}
+	return event
+}
+
+func (h *messagesStreamHandler) start() []string {
+	if h.started {
+		return nil
+	}
+	h.started = true
+	h.current = -1
+	return []string{
+		h.event("message_start", map[string]any{"message": messagesMessage(&h.completion, []any{}, nil)}),
+	}
+}
+
+func (h *messagesStreamHandler) OnChunk(chunk *translator.ChatCompletion) ([]string, error) {
+	if h.completion.Id == "" {
+		h.completion.Id = chunk.Id
+		h.completion.Model = chunk.Model
+	}
+	if chunk.FinishReason != "" {
+		h.completion.FinishReason = chunk.FinishReason
+	}
+	if chunk.Usage != nil {
+		h.completion.Usage = chunk.Usage
+	}
+
+	events := h.start()
+	if chunk.Content != "" {
+		if h.current == -1 || h.currentToolCall != nil {
+			events = append(events, h.stopBlock()...)
+			events = append(events, h.startBlock(textBlock(""), nil))
+		}
+		events = append(events, h.event("content_block_delta", map[string]any{
+			"index": h.current,
+			"delta": map[string]any{typeKey: "text_delta", textKey: chunk.Content},
+		}))
+	}
+	for _, toolCall := range chunk.ToolCalls {
+		if h.currentToolCall == nil || *h.currentToolCall != toolCall.Index {
+			block, err := toolUseBlock(toolCall, false)
+			if err != nil {
+				return nil, err
+			}
+			index := toolCall.Index
+			events = append(events, h.stopBlock()...)
+			events = append(events, h.startBlock(block, &index))
+			h.hasToolCalls = true
+		}
+		if toolCall.Arguments != "" {
+			events = append(events, h.event("content_block_delta", map[string]any{
+				"index": h.current,
+				"delta": map[string]any{typeKey: "input_json_delta", "partial_json": toolCall.Arguments},
+			}))
+		}
+	}
+	return events, h.err
+}
+
+func (h *messagesStreamHandler) OnDone() ([]string, error) {
+	events := h.start()
+	events = append(events, h.stopBlock()...)
+
+	finishReason := h.completion.FinishReason
+	if finishReason == "" && h.hasToolCalls {
+		finishReason = "tool_calls"
+	}
+	usage := messagesUsage(&h.completion)
+	delete(usage, "input_tokens")
+	events = append(events,
+		h.event("message_delta", map[string]any{
+			"delta": map[string]any{"stop_reason": stopReason(finishReason), "stop_sequence": nil},
+			"usage": usage,
+		}),
+		h.event("message_stop", map[string]any{}),
+	)
+	return events, h.err
+}
+
+func (h *messagesStreamHandler) startBlock(block map[string]any, toolCall *int) string {
+	h.current = h.blocks
+	h.currentToolCall = toolCall
+	h.blocks++
+	return h.event("content_block_start", map[string]any{
+		"index":         h.current,
+		"content_block": block,
+	})
+}
+
+func (h *messagesStreamHandler) stopBlock() []string {
+	if h.current == -1 {
+		return nil
+	}
+	index := h.current
+	h.current = -1
+	h.currentToolCall = nil
+	return []string{h.event("content_block_stop", map[string]any{"index": index})}
+}
@@ -0,0 +1,499 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package anthropic
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+// marshal and unmarshal to compare with the JSON decoded values of the translator
+func toJsonMap(g *WithT, content any) map[string]any {
+	data, err := json.Marshal(content)
+	g.Expect(err).To(BeNil())
+	var res map[string]any
+	g.Expect(json.Unmarshal(data, &res)).To(BeNil())
+	return res
+}
+
+func TestMessagesToChatCompletions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                string
+		messagesContent     map[string]any
+		expectedChatContent map[string]any
+		err                 bool
+	}
+
+	tests := []test{
+		{
+			name: "system-and-string-content",
+			messagesContent: map[string]any{
+				"model":          "claude",
+				"max_tokens":     1024,
+				"system":         "You are a helpful assistant.",
+				"stop_sequences": []string{"\n\nHuman:"},
+				"top_k":          5,
+				"metadata":       map[string]any{"user_id": "user-1"},
+				"messages": []any{
+					map[string]any{"role": "user", "content": "Hello!"},
+				},
+			},
+			expectedChatContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"stop":       []string{"\n\nHuman:"},
+				"user":       "user-1",
+				"messages": []any{
+					map[string]any{"role": "system", "content": "You are a helpful assistant."},
+					map[string]any{"role": "user", "content": "Hello!"},
+				},
+			},
+		},
+		{
+			name: "multi-part-content",
+			messagesContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"system":     []any{map[string]any{"type": "text", "text": "Be brief."}},
+				"messages": []any{
+					map[string]any{
+						"role": "user",
+						"content": []any{
+							map[string]any{"type": "text", "text": "What is in these images?"},
+							map[string]any{"type": "image", "source": map[string]any{"type": "base64", "media_type": "image/png", "data": "aGVsbG8="}},
+							map[string]any{"type": "image", "source": map[string]any{"type": "url", "url": "https://example.com/cat.png"}},
+						},
+					},
+				},
+			},
+			expectedChatContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"messages": []any{
+					map[string]any{"role": "system", "content": []any{map[string]any{"type": "text", "text": "Be brief."}}},
+					map[string]any{
+						"role": "user",
+						"content": []any{
+							map[string]any{"type": "text", "text": "What is in these images?"},
+							map[string]any{"type": "image_url", "image_url": map[string]any{"url": "data:image/png;base64,aGVsbG8="}},
+							map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/cat.png"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tool-use-and-results",
+			messagesContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"messages": []any{
+					map[string]any{"role": "user", "content": "Weather in Paris?"},
+					map[string]any{
+						"role": "assistant",
+						"content": []any{
+							map[string]any{"type": "text", "text": "Let me check."},
+							map[string]any{"type": "tool_use", "id": "toolu_1", "name": "get_weather", "input": map[string]any{"city": "Paris"}},
+						},
+					},
+					map[string]any{
+						"role": "user",
+						"content": []any{
+							map[string]any{"type": "tool_result", "tool_use_id": "toolu_1", "content": []any{map[string]any{"type": "text", "text": "sunny"}}},
+							map[string]any{"type": "text", "text": "And tomorrow?"},
+						},
+					},
+				},
+				"tools": []any{
+					map[string]any{
+						"name":         "get_weather",
+						"description":  "Get the weather",
+						"input_schema": map[string]any{"type": "object"},
+					},
+				},
+				"tool_choice": map[string]any{"type": "any", "disable_parallel_tool_use": true},
+			},
+			expectedChatContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"messages": []any{
+					map[string]any{"role": "user", "content": "Weather in Paris?"},
+					map[string]any{
+						"role":    "assistant",
+						"content": []any{map[string]any{"type": "text", "text": "Let me check."}},
+						"tool_calls": []any{
+							map[string]any{"id": "toolu_1", "type": "function", "function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`}},
+						},
+					},
+					map[string]any{"role": "tool", "tool_call_id": "toolu_1", "content": "sunny"},
+					map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "And tomorrow?"}}},
+				},
+				"tools": []any{
+					map[string]any{
+						"type": "function",
+						"function": map[string]any{
+							"name":        "get_weather",
+							"description": "Get the weather",
+							"parameters":  map[string]any{"type": "object"},
+						},
+					},
+				},
+				"tool_choice":         "required",
+				"parallel_tool_calls": false,
+			},
+		},
+		{
+			name: "server-tool",
+			messagesContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+				"messages":   []any{map[string]any{"role": "user", "content": "Hello!"}},
+				"tools":      []any{map[string]any{"type": "web_search_20250305", "name": "web_search"}},
+			},
+			err: true,
+		},
+		{
+			name: "no-messages",
+			messagesContent: map[string]any{
+				"model":      "claude",
+				"max_tokens": 1024,
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chatContent, err := messagesToChatCompletions(toJsonMap(g, test.messagesContent))
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(toJsonMap(g, chatContent)).To(Equal(toJsonMap(g, test.expectedChatContent)))
+		})
+	}
+}
+
+func TestMessagesRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	messagesContent := map[string]any{
+		"model":      "claude",
+		"max_tokens": 1024,
+		"messages": []any{
+			map[string]any{"role": "user", "content": "Hello!"},
+		},
+	}
+	expectedOipContent := map[string]any{
+		"inputs": []map[string]any{
+			{
+				"name":     "role",
+				"shape":    []int{1},
+				"datatype": "BYTES",
+				"data":     []string{"user"},
+			},
+			{
+				"name":     "content",
+				"shape":    []int{1},
+				"datatype": "BYTES",
+				"data":     []string{"Hello!"},
+			},
+			{
+				"name":     "type",
+				"shape":    []int{1},
+				"datatype": "BYTES",
+				"data":     []string{"text"},
+			},
+		},
+		"parameters": map[string]any{
+			"llm_parameters": map[string]any{"max_tokens": 1024},
+			"kwargs":         map[string]any{"max_tokens": 1024},
+		},
+	}
+
+	body, err := json.Marshal(messagesContent)
+	g.Expect(err).To(BeNil())
+	req := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/v2/models/claude/infer/v1/messages"},
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   io.NopCloser(bytes.NewReader(body)),
+	}
+
+	messagesTranslator := &AnthropicMessagesTranslator{}
+	oipReq, err := messagesTranslator.TranslateToOIP(req)
+	g.Expect(err).To(BeNil())
+	g.Expect(oipReq.URL.Path).To(Equal("/v2/models/claude/infer"))
+
+	oipReqBody, err := io.ReadAll(oipReq.Body)
+	g.Expect(err).To(BeNil())
+	expectedOipBody, err := json.Marshal(expectedOipContent)
+	g.Expect(err).To(BeNil())
+	g.Expect(oipReqBody).To(Equal(expectedOipBody))
+}
+
+func TestMessagesResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name             string
+		oipResponse      map[string]any
+		expectedMessages map[string]any
+		err              bool
+	}
+
+	tests := []test{
+		{
+			name: "api-response",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"Hello!"}}],` +
+								`"usage":{"prompt_tokens":21,"completion_tokens":9,"total_tokens":30}}`,
+						},
+					},
+				},
+			},
+			expectedMessages: map[string]any{
+				"id":            "chatcmpl-1",
+				"type":          "message",
+				"role":          "assistant",
+				"model":         "gpt-4.1",
+				"content":       []any{map[string]any{"type": "text", "text": "Hello!"}},
+				"stop_reason":   "end_turn",
+				"stop_sequence": nil,
+				"usage":         map[string]any{"input_tokens": 21, "output_tokens": 9},
+			},
+		},
+		{
+			name: "api-response-tool-use",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant","content":"Let me check.",` +
+								`"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":\"Paris\"}"}}]}}]}`,
+						},
+					},
+				},
+			},
+			expectedMessages: map[string]any{
+				"id":    "chatcmpl-1",
+				"type":  "message",
+				"role":  "assistant",
+				"model": "gpt-4.1",
+				"content": []any{
+					map[string]any{"type": "text", "text": "Let me check."},
+					map[string]any{"type": "tool_use", "id": "call_1", "name": "get_weather", "input": map[string]any{"city": "Paris"}},
+				},
+				"stop_reason":   "tool_use",
+				"stop_sequence": nil,
+				"usage":         map[string]any{"input_tokens": 0, "output_tokens": 0},
+			},
+		},
+		{
+			name: "local-response",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "local-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "role",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"assistant"},
+					},
+					{
+						"name":     "content",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"Hello!"},
+					},
+				},
+			},
+			expectedMessages: map[string]any{
+				"id":            "aa65a0ac",
+				"type":          "message",
+				"role":          "assistant",
+				"model":         "local-chat-completions",
+				"content":       []any{map[string]any{"type": "text", "text": "Hello!"}},
+				"stop_reason":   "end_turn",
+				"stop_sequence": nil,
+				"usage":         map[string]any{"input_tokens": 0, "output_tokens": 0},
+			},
+		},
+		{
+			name: "invalid-tool-arguments",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant",` +
+								`"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"ci"}}]}}]}`,
+						},
+					},
+				},
+			},
+			err: true,
+		},
+	}
+
+	messagesTranslator := &AnthropicMessagesTranslator{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oipResponseBody, err := json.Marshal(test.oipResponse)
+			g.Expect(err).To(BeNil())
+
+			oipResp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(oipResponseBody)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}
+
+			resp, err := messagesTranslator.TranslateFromOIP(oipResp)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+
+			respBody, err := io.ReadAll(resp.Body)
+			g.Expect(err).To(BeNil())
+
+			var respContent map[string]any
+			g.Expect(json.Unmarshal(respBody, &respContent)).To(BeNil())
+			g.Expect(respContent).To(Equal(toJsonMap(g, test.expectedMessages)))
+		})
+	}
+}
+
+func TestMessagesStreamResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	oipChunk := func(role string, content string) string {
+		data, err := json.Marshal(map[string]any{
+			"id":         "aa65a0ac",
+			"model_name": "local-chat-completions",
+			"outputs": []map[string]any{
+				{"name": "role", "datatype": "BYTES", "shape": []int{1, 1}, "data": []string{role}},
+				{"name": "content", "datatype": "BYTES", "shape": []int{1, 1}, "data": []string{content}},
+			},
+		})
+		g.Expect(err).To(BeNil())
+		return "data: " + string(data) + "\n\n"
+	}
+	toolCallChunk := func(toolCall string) string {
+		data, err := json.Marshal(map[string]any{
+			"id":         "aa65a0ac",
+			"model_name": "local-chat-completions",
+			"outputs": []map[string]any{
+				{"name": "tool_calls", "datatype": "BYTES", "shape": []int{1, 1}, "data": []string{toolCall}},
+			},
+		})
+		g.Expect(err).To(BeNil())
+		return "data: " + string(data) + "\n\n"
+	}
+
+	sseBody := oipChunk("assistant", "Hel") +
+		oipChunk("assistant", "lo") +
+		toolCallChunk(`{"index":0,"id":"call_1","function":{"name":"get_weather","arguments":"{\"city\":"}}`) +
+		toolCallChunk(`{"index":0,"function":{"arguments":"\"Paris\"}"}}`)
+
+	oipResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(sseBody)),
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+	}
+
+	messagesTranslator := &AnthropicMessagesTranslator{}
+	resp, err := messagesTranslator.TranslateFromOIP(oipResp)
+	g.Expect(err).To(BeNil())
+
+	respBody, err := io.ReadAll(resp.Body)
+	g.Expect(err).To(BeNil())
+
+	expectedEvents := []struct {
+		name    string
+		payload map[string]any
+	}{
+		{"message_start", map[string]any{
+			"type": "message_start",
+			"message": map[string]any{
+				"id":            "aa65a0ac",
+				"type":          "message",
+				"role":          "assistant",
+				"model":         "local-chat-completions",
+				"content":       []any{},
+				"stop_reason":   nil,
+				"stop_sequence": nil,
+				"usage":         map[string]any{"input_tokens": 0, "output_tokens": 0},
+			},
+		}},
+		{"content_block_start", map[string]any{"type": "content_block_start", "index": 0, "content_block": map[string]any{"type": "text", "text": ""}}},
+		{"content_block_delta", map[string]any{"type": "content_block_delta", "index": 0, "delta": map[string]any{"type": "text_delta", "text": "Hel"}}},
+		{"content_block_delta", map[string]any{"type": "content_block_delta", "index": 0, "delta": map[string]any{"type": "text_delta", "text": "lo"}}},
+		{"content_block_stop", map[string]any{"type": "content_block_stop", "index": 0}},
+		{"content_block_start", map[string]any{
+			"type":          "content_block_start",
+			"index":         1,
+			"content_block": map[string]any{"type": "tool_use", "id": "call_1", "name": "get_weather", "input": map[string]any{}},
+		}},
+		{"content_block_delta", map[string]any{"type": "content_block_delta", "index": 1, "delta": map[string]any{"type": "input_json_delta", "partial_json": `{"city":`}}},
+		{"content_block_delta", map[string]any{"type": "content_block_delta", "index": 1, "delta": map[string]any{"type": "input_json_delta", "partial_json": `"Paris"}`}}},
+		{"content_block_stop", map[string]any{"type": "content_block_stop", "index": 1}},
+		{"message_delta", map[string]any{
+			"type":  "message_delta",
+			"delta": map[string]any{"stop_reason": "tool_use", "stop_sequence": nil},
+			"usage": map[string]any{"output_tokens": 0},
+		}},
+		{"message_stop", map[string]any{"type": "message_stop"}},
+	}
+
+	events := strings.Split(strings.TrimSuffix(string(respBody), "\n\n"), "\n\n")
+	g.Expect(events).To(HaveLen(len(expectedEvents)))
+	for i, event := range events {
+		lines := strings.SplitN(event, "\n", 2)
+		g.Expect(lines).To(HaveLen(2))
+		g.Expect(lines[0]).To(Equal("event: " + expectedEvents[i].name))
+
+		var payload map[string]any
+		g.Expect(json.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data: ")), &payload)).To(BeNil())
+		g.Expect(payload).To(Equal(toJsonMap(g, expectedEvents[i].payload)))
+	}
+}
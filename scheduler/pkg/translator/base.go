@@ -152,6 +152,8 @@ func translateStreamFromOIP(res *http.Response) (*http.Response, error) {
 	// Transform the first line to check if we have output_all key
 	translated, err := translateLine(line)
 	if err != nil {
+		// the body is left open as translators can carry on with their own format from the first
+		// line, the caller closes it if the translation fails
 		res.Header.Set(FirstLineKey, line)
 		return nil, fmt.Errorf("failed to translate first line: %w", err)
 	}
@@ -0,0 +1,251 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package translator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	idKey        = "id"
+	modelNameKey = "model_name"
+	roleKey      = "role"
+	contentKey   = "content"
+	toolCallsKey = "tool_calls"
+)
+
+// ChatCompletion is the output of an OIP-serving LLM, either parsed from the original OpenAI chat
+// completion (or chunk) of the API runtime in `output_all` or from the tensors of local runtimes
+type ChatCompletion struct {
+	Id      string
+	Model   string
+	Created int64
+	Role    string
+	Content string
+	// ToolCalls of a chunk only hold the fragments sent in that chunk
+	ToolCalls    []ToolCall
+	FinishReason string
+	Usage        *Usage
+}
+
+type ToolCall struct {
+	Index     int
+	Id        string
+	Name      string
+	Arguments string
+}
+
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+type openAIChatCompletion struct {
+	Id      string `json:"id"`
+	Model   string `json:"model"`
+	Created int64  `json:"created"`
+	Choices []struct {
+		Message      *openAIMessage `json:"message"`
+		Delta        *openAIMessage `json:"delta"`
+		FinishReason *string        `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+type openAIMessage struct {
+	Role      string           `json:"role"`
+	Content   *string          `json:"content"`
+	ToolCalls []openAIToolCall `json:"tool_calls"`
+}
+
+type openAIToolCall struct {
+	Index    *int   `json:"index"`
+	Id       string `json:"id"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+func (c *openAIToolCall) toToolCall(position int) ToolCall {
+	index := position
+	if c.Index != nil {
+		index = *c.Index
+	}
+	return ToolCall{
+		Index:     index,
+		Id:        c.Id,
+		Name:      c.Function.Name,
+		Arguments: c.Function.Arguments,
+	}
+}
+
+func toToolCalls(toolCalls []openAIToolCall) []ToolCall {
+	if len(toolCalls) == 0 {
+		return nil
+	}
+	res := make([]ToolCall, len(toolCalls))
+	for i := range toolCalls {
+		res[i] = toolCalls[i].toToolCall(i)
+	}
+	return res
+}
+
+// ParseChatCompletion parses the OIP inference response (or streamed chunk) of an LLM
+func ParseChatCompletion(jsonBody map[string]any) (*ChatCompletion, error) {
+	outputs, ok := jsonBody[OutputsKey].([]any)
+	if !ok {
+		return nil, fmt.Errorf("`%s` field not found or not an array in the response", OutputsKey)
+	}
+
+	if content, err := parseOutputAll(outputs); err == nil {
+		return parseOpenAIChatCompletion(content)
+	}
+	return parseChatCompletionTensors(jsonBody, outputs)
+}
+
+func parseOpenAIChatCompletion(content string) (*ChatCompletion, error) {
+	var completion openAIChatCompletion
+	if err := json.Unmarshal([]byte(content), &completion); err != nil {
+		return nil, fmt.Errorf("failed to parse %s as a chat completion: %w", OutputAllKey, err)
+	}
+
+	res := &ChatCompletion{
+		Id:      completion.Id,
+		Model:   completion.Model,
+		Created: completion.Created,
+	}
+	if completion.Usage != nil {
+		res.Usage = &Usage{
+			PromptTokens:     completion.Usage.PromptTokens,
+			CompletionTokens: completion.Usage.CompletionTokens,
+		}
+	}
+	// usage chunks of streams have no choices
+	if len(completion.Choices) == 0 {
+		return res, nil
+	}
+
+	choice := completion.Choices[0]
+	message := choice.Message
+	if message == nil {
+		message = choice.Delta
+	}
+	if message != nil {
+		res.Role = message.Role
+		if message.Content != nil {
+			res.Content = *message.Content
+		}
+		res.ToolCalls = toToolCalls(message.ToolCalls)
+	}
+	if choice.FinishReason != nil {
+		res.FinishReason = *choice.FinishReason
+	}
+	return res, nil
+}
+
+func parseChatCompletionTensors(jsonBody map[string]any, outputs []any) (*ChatCompletion, error) {
+	res := &ChatCompletion{}
+	res.Id, _ = jsonBody[idKey].(string)
+	res.Model, _ = jsonBody[modelNameKey].(string)
+
+	if role, err := ExtractTensorContentFromResponse(outputs, roleKey); err == nil {
+		res.Role = role
+	}
+
+	content, contentErr := ExtractTensorContentFromResponse(outputs, contentKey)
+	if contentErr == nil {
+		res.Content = content
+	}
+
+	tensor, toolCallsErr := ExtractTensorByName(outputs, toolCallsKey)
+	if toolCallsErr == nil {
+		toolCalls, err := parseToolCallsTensor(tensor)
+		if err != nil {
+			return nil, err
+		}
+		res.ToolCalls = toolCalls
+	}
+
+	if contentErr != nil && toolCallsErr != nil {
+		return nil, fmt.Errorf("neither `%s` nor `%s` output tensor found in the response", contentKey, toolCallsKey)
+	}
+	return res, nil
+}
+
+// the tool calls tensor holds one JSON encoded tool call, or list of tool calls, per element
+func parseToolCallsTensor(tensor map[string]any) ([]ToolCall, error) {
+	data, ok := tensor[DataKey].([]any)
+	if !ok {
+		return nil, fmt.Errorf("`%s` field not found or not an array in output tensor %s", DataKey, toolCallsKey)
+	}
+
+	var toolCalls []openAIToolCall
+	for _, item := range data {
+		str, ok := item.(string)
+		if !ok || str == "" {
+			continue
+		}
+		var list []openAIToolCall
+		if err := json.Unmarshal([]byte(str), &list); err == nil {
+			toolCalls = append(toolCalls, list...)
+			continue
+		}
+		var toolCall openAIToolCall
+		if err := json.Unmarshal([]byte(str), &toolCall); err != nil {
+			return nil, fmt.Errorf("failed to parse tool call in output tensor %s: %w", toolCallsKey, err)
+		}
+		toolCalls = append(toolCalls, toolCall)
+	}
+	return toToolCalls(toolCalls), nil
+}
+
+// ParseChatCompletionResponse parses the OIP inference response of an LLM, returning whether it was gzipped
+func ParseChatCompletionResponse(res *http.Response) (*ChatCompletion, bool, error) {
+	jsonBody, isGzipped, err := DecompressIfNeededAndConvertToJSON(res)
+	if err != nil {
+		return nil, isGzipped, fmt.Errorf("failed to decompress and parse the response: %w", err)
+	}
+
+	completion, err := ParseChatCompletion(jsonBody)
+	if err != nil {
+		return nil, isGzipped, err
+	}
+	if completion.FinishReason == "" {
+		completion.FinishReason = "stop"
+		if len(completion.ToolCalls) > 0 {
+			completion.FinishReason = "tool_calls"
+		}
+	}
+	return completion, isGzipped, nil
+}
+
+// NewJsonRequest creates a copy of the request with the JSON body, used by translators of other APIs
+// to build an OpenAI chat completions request
+func NewJsonRequest(req *http.Request, jsonBody map[string]any) (*http.Request, error) {
+	data, err := json.Marshal(jsonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	newReq, err := http.NewRequest(req.Method, req.URL.String(), io.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+	newReq.Header = req.Header.Clone()
+	return newReq, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package translator
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	SSEEventPrefix = "event: "
+	SSEDone        = "[DONE]"
+)
+
+// ChatCompletionStreamHandler converts the chunks of a streamed chat completion to the server sent
+// events of another API, events may depend on the previous chunks so a handler is used for one stream only
+type ChatCompletionStreamHandler interface {
+	OnChunk(chunk *ChatCompletion) ([]string, error)
+	// OnDone returns the events closing the stream once all chunks are handled
+	OnDone() ([]string, error)
+}
+
+// FormatSSEEvent formats a named server sent event with a JSON payload
+func FormatSSEEvent(name string, data any) (string, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal event %s: %w", name, err)
+	}
+	return fmt.Sprintf("%s%s\n%s%s%s", SSEEventPrefix, name, SSEPrefix, payload, SSESuffix), nil
+}
+
+func parseChatCompletionEvent(line string) (*ChatCompletion, error) {
+	line = strings.TrimSpace(strings.TrimPrefix(line, SSEPrefix))
+	if line == "" || line == SSEDone {
+		return nil, nil
+	}
+	jsonLine, err := GetJsonBody([]byte(line))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSE line: %w", err)
+	}
+	return ParseChatCompletion(jsonLine)
+}
+
+func handleChatCompletionEvent(line string, handler ChatCompletionStreamHandler) (string, error) {
+	chunk, err := parseChatCompletionEvent(line)
+	if err != nil || chunk == nil {
+		return "", err
+	}
+	events, err := handler.OnChunk(chunk)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(events, ""), nil
+}
+
+// TranslateChatCompletionStream translates the OIP server sent events of a streamed LLM response with the
+// handler, the first event is translated before returning so a response which can't be parsed is an error
+func TranslateChatCompletionStream(res *http.Response, handler ChatCompletionStreamHandler) (*http.Response, error) {
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Split(SplitSSE)
+
+	var translated string
+	if scanner.Scan() {
+		var err error
+		if translated, err = handleChatCompletionEvent(scanner.Text(), handler); err != nil {
+			res.Body.Close()
+			return nil, fmt.Errorf("failed to translate first line: %w", err)
+		}
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer res.Body.Close()
+
+		if _, err := pw.Write([]byte(translated)); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		for scanner.Scan() {
+			translated, err := handleChatCompletionEvent(scanner.Text(), handler)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err := pw.Write([]byte(translated)); err != nil {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		events, err := handler.OnDone()
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err := pw.Write([]byte(strings.Join(events, ""))); err != nil {
+			return
+		}
+		pw.Close()
+	}()
+
+	header := res.Header.Clone()
+	header.Del("Content-Length")
+	return &http.Response{
+		StatusCode: res.StatusCode,
+		Header:     header,
+		Body:       pr,
+	}, nil
+}
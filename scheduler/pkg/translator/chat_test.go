@@ -0,0 +1,286 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package translator
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseChatCompletion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name        string
+		oipResponse map[string]any
+		expected    *ChatCompletion
+		err         bool
+	}
+
+	tests := []test{
+		{
+			name: "api-response",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1732716978,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"Hello!"}}],` +
+								`"usage":{"prompt_tokens":21,"completion_tokens":9,"total_tokens":30}}`,
+						},
+					},
+				},
+			},
+			expected: &ChatCompletion{
+				Id:           "chatcmpl-1",
+				Model:        "gpt-4.1",
+				Created:      1732716978,
+				Role:         "assistant",
+				Content:      "Hello!",
+				FinishReason: "stop",
+				Usage:        &Usage{PromptTokens: 21, CompletionTokens: 9},
+			},
+		},
+		{
+			name: "api-response-tool-calls",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant","content":null,` +
+								`"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":\"Paris\"}"}},` +
+								`{"id":"call_2","type":"function","function":{"name":"get_time","arguments":"{}"}}]}}]}`,
+						},
+					},
+				},
+			},
+			expected: &ChatCompletion{
+				Id:      "chatcmpl-1",
+				Model:   "gpt-4.1",
+				Created: 1,
+				Role:    "assistant",
+				ToolCalls: []ToolCall{
+					{Index: 0, Id: "call_1", Name: "get_weather", Arguments: `{"city":"Paris"}`},
+					{Index: 1, Id: "call_2", Name: "get_time", Arguments: "{}"},
+				},
+				FinishReason: "tool_calls",
+			},
+		},
+		{
+			name: "api-chunk-tool-call-fragment",
+			oipResponse: map[string]any{
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","object":"chat.completion.chunk",` +
+								`"choices":[{"index":0,"finish_reason":null,"delta":{"tool_calls":[{"index":1,"function":{"arguments":"{\"ci"}}]}}]}`,
+						},
+					},
+				},
+			},
+			expected: &ChatCompletion{
+				Id:        "chatcmpl-1",
+				Model:     "gpt-4.1",
+				ToolCalls: []ToolCall{{Index: 1, Arguments: `{"ci`}},
+			},
+		},
+		{
+			name: "api-chunk-usage",
+			oipResponse: map[string]any{
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","object":"chat.completion.chunk","choices":[],` +
+								`"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`,
+						},
+					},
+				},
+			},
+			expected: &ChatCompletion{
+				Id:    "chatcmpl-1",
+				Model: "gpt-4.1",
+				Usage: &Usage{PromptTokens: 5, CompletionTokens: 2},
+			},
+		},
+		{
+			name: "local-response",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "local-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "role",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"assistant"},
+					},
+					{
+						"name":     "content",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"Hello!"},
+					},
+				},
+			},
+			expected: &ChatCompletion{
+				Id:      "aa65a0ac",
+				Model:   "local-chat-completions",
+				Role:    "assistant",
+				Content: "Hello!",
+			},
+		},
+		{
+			name: "local-response-tool-calls",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "local-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "role",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"assistant"},
+					},
+					{
+						"name":     "tool_calls",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{}"}}]`,
+						},
+					},
+				},
+			},
+			expected: &ChatCompletion{
+				Id:        "aa65a0ac",
+				Model:     "local-chat-completions",
+				Role:      "assistant",
+				ToolCalls: []ToolCall{{Index: 0, Id: "call_1", Name: "get_weather", Arguments: "{}"}},
+			},
+		},
+		{
+			name: "no-content",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "local-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "role",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"assistant"},
+					},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.oipResponse)
+			g.Expect(err).To(BeNil())
+			jsonBody, err := GetJsonBody(data)
+			g.Expect(err).To(BeNil())
+
+			completion, err := ParseChatCompletion(jsonBody)
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(completion).To(Equal(test.expected))
+		})
+	}
+}
+
+type recordingStreamHandler struct {
+	chunks []*ChatCompletion
+}
+
+func (h *recordingStreamHandler) OnChunk(chunk *ChatCompletion) ([]string, error) {
+	h.chunks = append(h.chunks, chunk)
+	return []string{chunk.Content + "\n"}, nil
+}
+
+func (h *recordingStreamHandler) OnDone() ([]string, error) {
+	return []string{"done\n"}, nil
+}
+
+func TestTranslateChatCompletionStream(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chunk := func(content string) string {
+		data, err := json.Marshal(map[string]any{
+			"id":         "aa65a0ac",
+			"model_name": "local-chat-completions",
+			"outputs": []map[string]any{
+				{"name": "role", "datatype": "BYTES", "shape": []int{1}, "data": []string{"assistant"}},
+				{"name": "content", "datatype": "BYTES", "shape": []int{1}, "data": []string{content}},
+			},
+		})
+		g.Expect(err).To(BeNil())
+		return SSEPrefix + string(data) + SSESuffix
+	}
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":   []string{"text/event-stream"},
+			"Content-Length": []string{"1000"},
+		},
+		Body: io.NopCloser(strings.NewReader(chunk("Hel") + chunk("lo") + SSEPrefix + SSEDone + SSESuffix)),
+	}
+
+	handler := &recordingStreamHandler{}
+	translated, err := TranslateChatCompletionStream(res, handler)
+	g.Expect(err).To(BeNil())
+	g.Expect(translated.Header.Get("Content-Length")).To(Equal(""))
+
+	body, err := io.ReadAll(translated.Body)
+	g.Expect(err).To(BeNil())
+	g.Expect(string(body)).To(Equal("Hel\nlo\ndone\n"))
+	g.Expect(handler.chunks).To(HaveLen(2))
+
+	event, err := FormatSSEEvent("message_stop", map[string]any{"type": "message_stop"})
+	g.Expect(err).To(BeNil())
+	g.Expect(event).To(Equal("event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n"))
+
+	// a stream which is not OIP is an error before any event is sent
+	res = &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body:       io.NopCloser(strings.NewReader("data: not json\n\n")),
+	}
+	_, err = TranslateChatCompletionStream(res, &recordingStreamHandler{})
+	g.Expect(err).ToNot(BeNil())
+}
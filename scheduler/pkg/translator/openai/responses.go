@@ -0,0 +1,562 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/seldonio/seldon-core/scheduler/v2/pkg/translator"
+)
+
+// OpenAIResponsesTranslator translates OpenAI Responses API requests to chat completions, which are
+// then translated to OIP, and the chat completions of the model back to responses
+type OpenAIResponsesTranslator struct {
+	chatCompletions OpenAIChatCompletionsTranslator
+}
+
+const (
+	instructionsKey       = "instructions"
+	previousResponseIdKey = "previous_response_id"
+	maxOutputTokensKey    = "max_output_tokens"
+	maxTokensKey          = "max_tokens"
+	textKey               = "text"
+	formatKey             = "format"
+	responseFormatKey     = "response_format"
+	functionKey           = "function"
+	nameKey               = "name"
+	argumentsKey          = "arguments"
+	callIdKey             = "call_id"
+	outputKey             = "output"
+)
+
+// request fields passed as is to chat completions
+var responsesPassThroughKeys = []string{
+	modelKey, "temperature", "top_p", "stream", "user", "seed", parallelToolCallsKey,
+}
+
+func (t *OpenAIResponsesTranslator) TranslateToOIP(req *http.Request) (*http.Request, error) {
+	jsonBody, err := translator.ConvertRequestToJsonBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	chatBody, err := responsesToChatCompletions(jsonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	chatReq, err := translator.NewJsonRequest(req, chatBody)
+	if err != nil {
+		return nil, err
+	}
+	return t.chatCompletions.TranslateToOIP(chatReq)
+}
+
+func (t *OpenAIResponsesTranslator) TranslateFromOIP(res *http.Response) (*http.Response, error) {
+	if translator.IsServerSentEvent(res) {
+		return translator.TranslateChatCompletionStream(res, &responsesStreamHandler{})
+	}
+
+	completion, isGzipped, err := translator.ParseChatCompletionResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []any
+	if completion.Content != "" || len(completion.ToolCalls) == 0 {
+		output = append(output, responsesMessageItem(completion.Id, len(output), completion.Content, "completed"))
+	}
+	for _, toolCall := range completion.ToolCalls {
+		output = append(output, responsesFunctionCallItem(toolCall, "completed"))
+	}
+
+	content, err := json.Marshal(responsesResponse(completion, output, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OpenAI Responses API response: %w", err)
+	}
+	return translator.CreateResponseFromContent(string(content), res.StatusCode, res.Header, isGzipped)
+}
+
+func responsesToChatCompletions(jsonBody map[string]any) (map[string]any, error) {
+	if id, ok := jsonBody[previousResponseIdKey]; ok && id != nil {
+		return nil, fmt.Errorf("`%s` is not supported, the conversation must be sent in `%s`", previousResponseIdKey, inputKey)
+	}
+
+	chatBody := make(map[string]any)
+	for _, key := range responsesPassThroughKeys {
+		if value, ok := jsonBody[key]; ok {
+			chatBody[key] = value
+		}
+	}
+	if maxOutputTokens, ok := jsonBody[maxOutputTokensKey]; ok {
+		chatBody[maxTokensKey] = maxOutputTokens
+	}
+
+	var messages []any
+	if instructions, ok := jsonBody[instructionsKey].(string); ok && instructions != "" {
+		messages = append(messages, map[string]any{roleKey: "system", contentKey: instructions})
+	}
+	inputMessages, err := responsesInputToMessages(jsonBody[inputKey])
+	if err != nil {
+		return nil, err
+	}
+	chatBody[messagesKey] = append(messages, inputMessages...)
+
+	if tools, ok := jsonBody[toolsKey].([]any); ok && len(tools) > 0 {
+		chatTools, err := responsesToolsToChat(tools)
+		if err != nil {
+			return nil, err
+		}
+		chatBody[toolsKey] = chatTools
+	}
+
+	if toolChoice, ok := jsonBody[toolChoiceKey]; ok {
+		chatToolChoice, err := responsesToolChoiceToChat(toolChoice)
+		if err != nil {
+			return nil, err
+		}
+		chatBody[toolChoiceKey] = chatToolChoice
+	}
+
+	if text, ok := jsonBody[textKey].(map[string]any); ok {
+		if responseFormat := responsesTextFormatToChat(text); responseFormat != nil {
+			chatBody[responseFormatKey] = responseFormat
+		}
+	}
+	return chatBody, nil
+}
+
+func responsesInputToMessages(input any) ([]any, error) {
+	switch in := input.(type) {
+	case string:
+		return []any{map[string]any{roleKey: "user", contentKey: in}}, nil
+	case []any:
+		var messages []any
+		for i, item := range in {
+			itemMap, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("failed to parse input item %d in OpenAI Responses API request", i)
+			}
+
+			itemType, _ := itemMap[typeKey].(string)
+			switch itemType {
+			case "", "message":
+				role, ok := itemMap[roleKey].(string)
+				if !ok {
+					return nil, fmt.Errorf("field '%s' not found in input item %d", roleKey, i)
+				}
+				content, err := responsesContentToChat(itemMap[contentKey])
+				if err != nil {
+					return nil, fmt.Errorf("failed to get content in input item %d: %v", i, err)
+				}
+				messages = append(messages, map[string]any{roleKey: role, contentKey: content})
+			case "function_call":
+				toolCall := map[string]any{
+					idKey:   itemMap[callIdKey],
+					typeKey: functionKey,
+					functionKey: map[string]any{
+						nameKey:      itemMap[nameKey],
+						argumentsKey: itemMap[argumentsKey],
+					},
+				}
+				// parallel function calls are a single assistant message in chat completions
+				if last := lastToolCallsMessage(messages); last != nil {
+					last[toolCallsKey] = append(last[toolCallsKey].([]any), toolCall)
+					continue
+				}
+				messages = append(messages, map[string]any{
+					roleKey:      "assistant",
+					toolCallsKey: []any{toolCall},
+				})
+			case "function_call_output":
+				messages = append(messages, map[string]any{
+					roleKey:       "tool",
+					toolCallIdKey: itemMap[callIdKey],
+					contentKey:    itemMap[outputKey],
+				})
+			default:
+				return nil, fmt.Errorf("unsupported input item type %s in input item %d", itemType, i)
+			}
+		}
+		return messages, nil
+	default:
+		return nil, fmt.Errorf("`%s` field not found or not a string or an array", inputKey)
+	}
+}
+
+func lastToolCallsMessage(messages []any) map[string]any {
+	if len(messages) == 0 {
+		return nil
+	}
+	last, _ := messages[len(messages)-1].(map[string]any)
+	if _, ok := last[toolCallsKey]; !ok {
+		return nil
+	}
+	return last
+}
+
+func responsesContentToChat(content any) (any, error) {
+	switch c := content.(type) {
+	case string:
+		return c, nil
+	case []any:
+		parts := make([]any, len(c))
+		for i, part := range c {
+			partMap, ok := part.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("failed to parse content part %d", i)
+			}
+
+			partType, _ := partMap[typeKey].(string)
+			switch partType {
+			case "input_text", "output_text":
+				parts[i] = map[string]any{typeKey: "text", "text": partMap["text"]}
+			case "input_image":
+				imageURL, ok := partMap["image_url"].(string)
+				if !ok {
+					return nil, fmt.Errorf("only images with an `image_url` are supported in content part %d", i)
+				}
+				image := map[string]any{"url": imageURL}
+				if detail, ok := partMap["detail"]; ok {
+					image["detail"] = detail
+				}
+				parts[i] = map[string]any{typeKey: "image_url", "image_url": image}
+			case "input_file":
+				file := make(map[string]any)
+				for _, key := range []string{"file_data", "file_id", "filename"} {
+					if value, ok := partMap[key]; ok {
+						file[key] = value
+					}
+				}
+				parts[i] = map[string]any{typeKey: "file", "file": file}
+			default:
+				return nil, fmt.Errorf("unsupported content part type %s in content part %d", partType, i)
+			}
+		}
+		return parts, nil
+	default:
+		return nil, fmt.Errorf("unsupported content type: %T", content)
+	}
+}
+
+func responsesToolsToChat(tools []any) ([]any, error) {
+	chatTools := make([]any, len(tools))
+	for i, tool := range tools {
+		toolMap, ok := tool.(map[string]any)
+		if !ok || toolMap[typeKey] != functionKey {
+			return nil, fmt.Errorf("unsupported tool %d, only function tools can be translated to chat completions", i)
+		}
+
+		function := make(map[string]any)
+		for _, key := range []string{nameKey, "description", "parameters", "strict"} {
+			if value, ok := toolMap[key]; ok {
+				function[key] = value
+			}
+		}
+		chatTools[i] = map[string]any{typeKey: functionKey, functionKey: function}
+	}
+	return chatTools, nil
+}
+
+func responsesToolChoiceToChat(toolChoice any) (any, error) {
+	switch c := toolChoice.(type) {
+	case string:
+		return c, nil
+	case map[string]any:
+		if c[typeKey] == functionKey {
+			return map[string]any{
+				typeKey:     functionKey,
+				functionKey: map[string]any{nameKey: c[nameKey]},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported `%s`, only function tools can be translated to chat completions", toolChoiceKey)
+}
+
+func responsesTextFormatToChat(text map[string]any) map[string]any {
+	format, ok := text[formatKey].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	switch format[typeKey] {
+	case "json_object":
+		return map[string]any{typeKey: "json_object"}
+	case "json_schema":
+		schema := make(map[string]any)
+		for _, key := range []string{nameKey, "description", "schema", "strict"} {
+			if value, ok := format[key]; ok {
+				schema[key] = value
+			}
+		}
+		return map[string]any{typeKey: "json_schema", "json_schema": schema}
+	default:
+		return nil
+	}
+}
+
+func responsesMessageItem(id string, outputIndex int, text string, status string) map[string]any {
+	content := []any{}
+	if status == "completed" {
+		content = append(content, responsesOutputText(text))
+	}
+	return map[string]any{
+		typeKey:    "message",
+		idKey:      fmt.Sprintf("msg_%s_%d", id, outputIndex),
+		"status":   status,
+		roleKey:    "assistant",
+		contentKey: content,
+	}
+}
+
+func responsesOutputText(text string) map[string]any {
+	return map[string]any{
+		typeKey:       "output_text",
+		"text":        text,
+		"annotations": []any{},
+	}
+}
+
+func toolCallId(toolCall translator.ToolCall) string {
+	if toolCall.Id != "" {
+		return toolCall.Id
+	}
+	return fmt.Sprintf("call_%d", toolCall.Index)
+}
+
+func responsesFunctionCallItem(toolCall translator.ToolCall, status string) map[string]any {
+	callId := toolCallId(toolCall)
+	return map[string]any{
+		typeKey:      "function_call",
+		idKey:        "fc_" + callId,
+		callIdKey:    callId,
+		nameKey:      toolCall.Name,
+		argumentsKey: toolCall.Arguments,
+		"status":     status,
+	}
+}
+
+func responsesResponse(completion *translator.ChatCompletion, output []any, inProgress bool) map[string]any {
+	if output == nil {
+		output = []any{}
+	}
+	response := map[string]any{
+		idKey:                completion.Id,
+		"object":             "response",
+		"created_at":         completion.Created,
+		"status":             "completed",
+		modelKey:             completion.Model,
+		outputKey:            output,
+		"error":              nil,
+		"incomplete_details": nil,
+	}
+
+	switch {
+	case inProgress:
+		response["status"] = "in_progress"
+	case completion.FinishReason == "length":
+		response["status"] = "incomplete"
+		response["incomplete_details"] = map[string]any{"reason": "max_output_tokens"}
+	case completion.FinishReason == "content_filter":
+		response["status"] = "incomplete"
+		response["incomplete_details"] = map[string]any{"reason": "content_filter"}
+	}
+
+	if completion.Usage != nil {
+		response["usage"] = map[string]any{
+			"input_tokens":  completion.Usage.PromptTokens,
+			"output_tokens": completion.Usage.CompletionTokens,
+			"total_tokens":  completion.Usage.PromptTokens + completion.Usage.CompletionTokens,
+		}
+	}
+	return response
+}
+
+// responsesStreamHandler converts chat completion chunks to Responses API events, streaming one output
+// item at a time and completing it when the chunks move on to another item
+type responsesStreamHandler struct {
+	started        bool
+	sequenceNumber int
+	err            error
+	// completion accumulates the fields of the whole response
+	completion translator.ChatCompletion
+	output     []any
+	current    *responsesStreamItem
+}
+
+type responsesStreamItem struct {
+	outputIndex int
+	// toolCall is nil for message items
+	toolCall *translator.ToolCall
+	item     map[string]any
+	text     strings.Builder
+}
+
+func (h *responsesStreamHandler) event(name string, payload map[string]any) string {
+	payload[typeKey] = name
+	payload["sequence_number"] = h.sequenceNumber
+	h.sequenceNumber++
+	event, err := translator.FormatSSEEvent(name, payload)
+	if err != nil && h.err == nil {
+		h.err = err
+	}
+	return event
+}
+
+func (h *responsesStreamHandler) start() []string {
+	if h.started {
+		return nil
+	}
+	h.started = true
+	return []string{
+		h.event("response.created", map[string]any{"response": responsesResponse(&h.completion, nil, true)}),
+		h.event("response.in_progress", map[string]any{"response": responsesResponse(&h.completion, nil, true)}),
+	}
+}
+
+func (h *responsesStreamHandler) OnChunk(chunk *translator.ChatCompletion) ([]string, error) {
+	if h.completion.Id == "" {
+		h.completion.Id = chunk.Id
+		h.completion.Model = chunk.Model
+		h.completion.Created = chunk.Created
+	}
+	if chunk.FinishReason != "" {
+		h.completion.FinishReason = chunk.FinishReason
+	}
+	if chunk.Usage != nil {
+		h.completion.Usage = chunk.Usage
+	}
+
+	events := h.start()
+	if chunk.Content != "" {
+		if h.current == nil || h.current.toolCall != nil {
+			events = append(events, h.completeItem()...)
+			events = append(events, h.addMessageItem()...)
+		}
+		h.current.text.WriteString(chunk.Content)
+		events = append(events, h.event("response.output_text.delta", map[string]any{
+			"item_id":       h.current.item[idKey],
+			"output_index":  h.current.outputIndex,
+			"content_index": 0,
+			"delta":         chunk.Content,
+		}))
+	}
+	for _, toolCall := range chunk.ToolCalls {
+		if h.current == nil || h.current.toolCall == nil || h.current.toolCall.Index != toolCall.Index {
+			events = append(events, h.completeItem()...)
+			events = append(events, h.addFunctionCallItem(toolCall)...)
+		}
+		if toolCall.Arguments != "" {
+			h.current.text.WriteString(toolCall.Arguments)
+			events = append(events, h.event("response.function_call_arguments.delta", map[string]any{
+				"item_id":      h.current.item[idKey],
+				"output_index": h.current.outputIndex,
+				"delta":        toolCall.Arguments,
+			}))
+		}
+	}
+	return events, h.err
+}
+
+func (h *responsesStreamHandler) OnDone() ([]string, error) {
+	events := h.start()
+	events = append(events, h.completeItem()...)
+
+	response := responsesResponse(&h.completion, h.output, false)
+	name := "response.completed"
+	if response["status"] == "incomplete" {
+		name = "response.incomplete"
+	}
+	events = append(events, h.event(name, map[string]any{"response": response}))
+	return events, h.err
+}
+
+func (h *responsesStreamHandler) addMessageItem() []string {
+	outputIndex := len(h.output)
+	h.current = &responsesStreamItem{
+		outputIndex: outputIndex,
+		item:        responsesMessageItem(h.completion.Id, outputIndex, "", "in_progress"),
+	}
+	return []string{
+		h.event("response.output_item.added", map[string]any{
+			"output_index": outputIndex,
+			"item":         h.current.item,
+		}),
+		h.event("response.content_part.added", map[string]any{
+			"item_id":       h.current.item[idKey],
+			"output_index":  outputIndex,
+			"content_index": 0,
+			"part":          responsesOutputText(""),
+		}),
+	}
+}
+
+func (h *responsesStreamHandler) addFunctionCallItem(toolCall translator.ToolCall) []string {
+	h.current = &responsesStreamItem{
+		outputIndex: len(h.output),
+		toolCall:    &toolCall,
+		item:        responsesFunctionCallItem(translator.ToolCall{Index: toolCall.Index, Id: toolCall.Id, Name: toolCall.Name}, "in_progress"),
+	}
+	return []string{
+		h.event("response.output_item.added", map[string]any{
+			"output_index": h.current.outputIndex,
+			"item":         h.current.item,
+		}),
+	}
+}
+
+// completeItem sends the done events of the item being streamed, if any, and adds it to the output
+func (h *responsesStreamHandler) completeItem() []string {
+	current := h.current
+	if current == nil {
+		return nil
+	}
+	h.current = nil
+
+	var events []string
+	var item map[string]any
+	if current.toolCall == nil {
+		text := current.text.String()
+		item = responsesMessageItem(h.completion.Id, current.outputIndex, text, "completed")
+		events = append(events,
+			h.event("response.output_text.done", map[string]any{
+				"item_id":       item[idKey],
+				"output_index":  current.outputIndex,
+				"content_index": 0,
+				"text":          text,
+			}),
+			h.event("response.content_part.done", map[string]any{
+				"item_id":       item[idKey],
+				"output_index":  current.outputIndex,
+				"content_index": 0,
+				"part":          responsesOutputText(text),
+			}),
+		)
+	} else {
+		toolCall := *current.toolCall
+		toolCall.Arguments = current.text.String()
+		item = responsesFunctionCallItem(toolCall, "completed")
+		events = append(events, h.event("response.function_call_arguments.done", map[string]any{
+			"item_id":      item[idKey],
+			"output_index": current.outputIndex,
+			"arguments":    toolCall.Arguments,
+		}))
+	}
+
+	h.output = append(h.output, item)
+	events = append(events, h.event("response.output_item.done", map[string]any{
+		"output_index": current.outputIndex,
+		"item":         item,
+	}))
+	return events
+}
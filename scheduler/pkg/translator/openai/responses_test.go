@@ -0,0 +1,553 @@
+/*
+Copyright (c) 2024 Seldon Technologies Ltd.
+
+Use of this software is governed BY
+(1) the license included in the LICENSE file or
+(2) if the license included in the LICENSE file is the Business Source License 1.1,
+the Change License after the Change Date as each is defined in accordance with the LICENSE file.
+*/
+
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+// marshal and unmarshal to compare with the JSON decoded values of the translators
+func toJsonMap(g *WithT, content any) map[string]any {
+	data, err := json.Marshal(content)
+	g.Expect(err).To(BeNil())
+	var res map[string]any
+	g.Expect(json.Unmarshal(data, &res)).To(BeNil())
+	return res
+}
+
+func TestResponsesToChatCompletions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name                string
+		responsesContent    map[string]any
+		expectedChatContent map[string]any
+		err                 bool
+	}
+
+	tests := []test{
+		{
+			name: "string-input-with-instructions",
+			responsesContent: map[string]any{
+				"model":             "gpt-4.1",
+				"instructions":      "You are a helpful assistant.",
+				"input":             "Hello!",
+				"max_output_tokens": 100,
+				"temperature":       0.5,
+				"store":             false,
+			},
+			expectedChatContent: map[string]any{
+				"model": "gpt-4.1",
+				"messages": []any{
+					map[string]any{"role": "system", "content": "You are a helpful assistant."},
+					map[string]any{"role": "user", "content": "Hello!"},
+				},
+				"max_tokens":  100,
+				"temperature": 0.5,
+			},
+		},
+		{
+			name: "multi-part-content",
+			responsesContent: map[string]any{
+				"model": "gpt-4.1",
+				"input": []any{
+					map[string]any{
+						"role": "user",
+						"content": []any{
+							map[string]any{"type": "input_text", "text": "What is in this image?"},
+							map[string]any{"type": "input_image", "image_url": "https://example.com/cat.png", "detail": "low"},
+						},
+					},
+				},
+			},
+			expectedChatContent: map[string]any{
+				"model": "gpt-4.1",
+				"messages": []any{
+					map[string]any{
+						"role": "user",
+						"content": []any{
+							map[string]any{"type": "text", "text": "What is in this image?"},
+							map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/cat.png", "detail": "low"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tools-and-function-calls",
+			responsesContent: map[string]any{
+				"model": "gpt-4.1",
+				"input": []any{
+					map[string]any{"type": "message", "role": "user", "content": "Weather in Paris and London?"},
+					map[string]any{"type": "function_call", "call_id": "call_1", "name": "get_weather", "arguments": `{"city":"Paris"}`},
+					map[string]any{"type": "function_call", "call_id": "call_2", "name": "get_weather", "arguments": `{"city":"London"}`},
+					map[string]any{"type": "function_call_output", "call_id": "call_1", "output": "sunny"},
+					map[string]any{"type": "function_call_output", "call_id": "call_2", "output": "rainy"},
+				},
+				"tools": []any{
+					map[string]any{
+						"type":        "function",
+						"name":        "get_weather",
+						"description": "Get the weather",
+						"parameters":  map[string]any{"type": "object"},
+					},
+				},
+				"tool_choice":         map[string]any{"type": "function", "name": "get_weather"},
+				"parallel_tool_calls": true,
+				"text": map[string]any{
+					"format": map[string]any{"type": "json_schema", "name": "weather", "schema": map[string]any{"type": "object"}},
+				},
+			},
+			expectedChatContent: map[string]any{
+				"model": "gpt-4.1",
+				"messages": []any{
+					map[string]any{"role": "user", "content": "Weather in Paris and London?"},
+					map[string]any{
+						"role": "assistant",
+						"tool_calls": []any{
+							map[string]any{"id": "call_1", "type": "function", "function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`}},
+							map[string]any{"id": "call_2", "type": "function", "function": map[string]any{"name": "get_weather", "arguments": `{"city":"London"}`}},
+						},
+					},
+					map[string]any{"role": "tool", "tool_call_id": "call_1", "content": "sunny"},
+					map[string]any{"role": "tool", "tool_call_id": "call_2", "content": "rainy"},
+				},
+				"tools": []any{
+					map[string]any{
+						"type": "function",
+						"function": map[string]any{
+							"name":        "get_weather",
+							"description": "Get the weather",
+							"parameters":  map[string]any{"type": "object"},
+						},
+					},
+				},
+				"tool_choice":         map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}},
+				"parallel_tool_calls": true,
+				"response_format": map[string]any{
+					"type":        "json_schema",
+					"json_schema": map[string]any{"name": "weather", "schema": map[string]any{"type": "object"}},
+				},
+			},
+		},
+		{
+			name: "previous-response-id",
+			responsesContent: map[string]any{
+				"model":                "gpt-4.1",
+				"input":                "Hello!",
+				"previous_response_id": "resp_1",
+			},
+			err: true,
+		},
+		{
+			name: "built-in-tool",
+			responsesContent: map[string]any{
+				"model": "gpt-4.1",
+				"input": "Hello!",
+				"tools": []any{map[string]any{"type": "web_search_preview"}},
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chatContent, err := responsesToChatCompletions(toJsonMap(g, test.responsesContent))
+			if test.err {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(toJsonMap(g, chatContent)).To(Equal(toJsonMap(g, test.expectedChatContent)))
+		})
+	}
+}
+
+func TestResponsesRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	responsesContent := map[string]any{
+		"model":        "gpt-4.1",
+		"instructions": "You are a helpful assistant.",
+		"input":        "Hello!",
+	}
+	expectedOipContent := map[string]any{
+		"inputs": []map[string]any{
+			{
+				"name":     "role",
+				"shape":    []int{2},
+				"datatype": "BYTES",
+				"data":     []string{"system", "user"},
+			},
+			{
+				"name":     "content",
+				"shape":    []int{2},
+				"datatype": "BYTES",
+				"data": []string{
+					"[\"You are a helpful assistant.\"]",
+					"[\"Hello!\"]",
+				},
+			},
+			{
+				"name":     "type",
+				"shape":    []int{2},
+				"datatype": "BYTES",
+				"data": []string{
+					"[\"text\"]",
+					"[\"text\"]",
+				},
+			},
+		},
+		"parameters": map[string]any{
+			"llm_parameters": map[string]any{},
+			"kwargs":         map[string]any{},
+		},
+	}
+
+	body, err := json.Marshal(responsesContent)
+	g.Expect(err).To(BeNil())
+	req := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/v2/models/gpt-4.1/infer/responses"},
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   io.NopCloser(bytes.NewReader(body)),
+	}
+
+	responsesTranslator := &OpenAIResponsesTranslator{}
+	oipReq, err := responsesTranslator.TranslateToOIP(req)
+	g.Expect(err).To(BeNil())
+	g.Expect(oipReq.URL.Path).To(Equal("/v2/models/gpt-4.1/infer"))
+
+	oipReqBody, err := io.ReadAll(oipReq.Body)
+	g.Expect(err).To(BeNil())
+	expectedOipBody, err := json.Marshal(expectedOipContent)
+	g.Expect(err).To(BeNil())
+	g.Expect(oipReqBody).To(Equal(expectedOipBody))
+}
+
+func TestResponsesResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type test struct {
+		name              string
+		oipResponse       map[string]any
+		expectedResponses map[string]any
+	}
+
+	tests := []test{
+		{
+			name: "api-response",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1732716978,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"Hello!"}}],` +
+								`"usage":{"prompt_tokens":21,"completion_tokens":9,"total_tokens":30}}`,
+						},
+					},
+				},
+			},
+			expectedResponses: map[string]any{
+				"id":         "chatcmpl-1",
+				"object":     "response",
+				"created_at": 1732716978,
+				"status":     "completed",
+				"model":      "gpt-4.1",
+				"output": []any{
+					map[string]any{
+						"type":   "message",
+						"id":     "msg_chatcmpl-1_0",
+						"status": "completed",
+						"role":   "assistant",
+						"content": []any{
+							map[string]any{"type": "output_text", "text": "Hello!", "annotations": []any{}},
+						},
+					},
+				},
+				"error":              nil,
+				"incomplete_details": nil,
+				"usage": map[string]any{
+					"input_tokens":  21,
+					"output_tokens": 9,
+					"total_tokens":  30,
+				},
+			},
+		},
+		{
+			name: "api-response-function-call",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant","content":null,` +
+								`"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":\"Paris\"}"}}]}}]}`,
+						},
+					},
+				},
+			},
+			expectedResponses: map[string]any{
+				"id":         "chatcmpl-1",
+				"object":     "response",
+				"created_at": 1,
+				"status":     "completed",
+				"model":      "gpt-4.1",
+				"output": []any{
+					map[string]any{
+						"type":      "function_call",
+						"id":        "fc_call_1",
+						"call_id":   "call_1",
+						"name":      "get_weather",
+						"arguments": `{"city":"Paris"}`,
+						"status":    "completed",
+					},
+				},
+				"error":              nil,
+				"incomplete_details": nil,
+			},
+		},
+		{
+			name: "api-response-max-tokens",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "openai-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "output_all",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data": []string{
+							`{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion",` +
+								`"choices":[{"index":0,"finish_reason":"length","message":{"role":"assistant","content":"Hel"}}]}`,
+						},
+					},
+				},
+			},
+			expectedResponses: map[string]any{
+				"id":         "chatcmpl-1",
+				"object":     "response",
+				"created_at": 1,
+				"status":     "incomplete",
+				"model":      "gpt-4.1",
+				"output": []any{
+					map[string]any{
+						"type":   "message",
+						"id":     "msg_chatcmpl-1_0",
+						"status": "completed",
+						"role":   "assistant",
+						"content": []any{
+							map[string]any{"type": "output_text", "text": "Hel", "annotations": []any{}},
+						},
+					},
+				},
+				"error":              nil,
+				"incomplete_details": map[string]any{"reason": "max_output_tokens"},
+			},
+		},
+		{
+			name: "local-response",
+			oipResponse: map[string]any{
+				"id":         "aa65a0ac",
+				"model_name": "local-chat-completions",
+				"outputs": []map[string]any{
+					{
+						"name":     "role",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"assistant"},
+					},
+					{
+						"name":     "content",
+						"datatype": "BYTES",
+						"shape":    []int{1, 1},
+						"data":     []string{"Hello!"},
+					},
+				},
+			},
+			expectedResponses: map[string]any{
+				"id":         "aa65a0ac",
+				"object":     "response",
+				"created_at": 0,
+				"status":     "completed",
+				"model":      "local-chat-completions",
+				"output": []any{
+					map[string]any{
+						"type":   "message",
+						"id":     "msg_aa65a0ac_0",
+						"status": "completed",
+						"role":   "assistant",
+						"content": []any{
+							map[string]any{"type": "output_text", "text": "Hello!", "annotations": []any{}},
+						},
+					},
+				},
+				"error":              nil,
+				"incomplete_details": nil,
+			},
+		},
+	}
+
+	responsesTranslator := &OpenAIResponsesTranslator{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oipResponseBody, err := json.Marshal(test.oipResponse)
+			g.Expect(err).To(BeNil())
+
+			oipResp := &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(oipResponseBody)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}
+
+			resp, err := responsesTranslator.TranslateFromOIP(oipResp)
+			g.Expect(err).To(BeNil())
+
+			respBody, err := io.ReadAll(resp.Body)
+			g.Expect(err).To(BeNil())
+
+			var respContent map[string]any
+			g.Expect(json.Unmarshal(respBody, &respContent)).To(BeNil())
+			g.Expect(respContent).To(Equal(toJsonMap(g, test.expectedResponses)))
+		})
+	}
+}
+
+// parseSSEEvents returns the names and payloads of the events of a stream
+func parseSSEEvents(g *WithT, body string) ([]string, []map[string]any) {
+	var names []string
+	var payloads []map[string]any
+	for _, event := range strings.Split(strings.TrimSuffix(body, "\n\n"), "\n\n") {
+		lines := strings.SplitN(event, "\n", 2)
+		g.Expect(lines).To(HaveLen(2))
+		names = append(names, strings.TrimPrefix(lines[0], "event: "))
+
+		var payload map[string]any
+		g.Expect(json.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data: ")), &payload)).To(BeNil())
+		payloads = append(payloads, payload)
+	}
+	return names, payloads
+}
+
+func oipChunk(g *WithT, chatCompletionChunk string) string {
+	data, err := json.Marshal(map[string]any{
+		"id":         "aa65a0ac",
+		"model_name": "openai-chat-completions",
+		"outputs": []map[string]any{
+			{
+				"name":     "output_all",
+				"datatype": "BYTES",
+				"shape":    []int{1, 1},
+				"data":     []string{chatCompletionChunk},
+			},
+		},
+	})
+	g.Expect(err).To(BeNil())
+	return "data: " + string(data) + "\n\n"
+}
+
+func TestResponsesStreamResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chunkPrefix := `{"id":"chatcmpl-1","model":"gpt-4.1","created":1,"object":"chat.completion.chunk","choices":[{"index":0,`
+	sseBody := oipChunk(g, chunkPrefix+`"finish_reason":null,"delta":{"role":"assistant","content":"Hel"}}]}`) +
+		oipChunk(g, chunkPrefix+`"finish_reason":null,"delta":{"content":"lo"}}]}`) +
+		oipChunk(g, chunkPrefix+`"finish_reason":null,"delta":{"tool_calls":[{"index":0,"id":"call_1","function":{"name":"get_weather","arguments":""}}]}}]}`) +
+		oipChunk(g, chunkPrefix+`"finish_reason":null,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"city\":"}}]}}]}`) +
+		oipChunk(g, chunkPrefix+`"finish_reason":null,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"Paris\"}"}}]}}]}`) +
+		oipChunk(g, chunkPrefix+`"finish_reason":"tool_calls","delta":{}}]}`)
+
+	oipResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(sseBody)),
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+	}
+
+	responsesTranslator := &OpenAIResponsesTranslator{}
+	resp, err := responsesTranslator.TranslateFromOIP(oipResp)
+	g.Expect(err).To(BeNil())
+
+	respBody, err := io.ReadAll(resp.Body)
+	g.Expect(err).To(BeNil())
+
+	names, payloads := parseSSEEvents(g, string(respBody))
+	g.Expect(names).To(Equal([]string{
+		"response.created",
+		"response.in_progress",
+		"response.output_item.added",
+		"response.content_part.added",
+		"response.output_text.delta",
+		"response.output_text.delta",
+		"response.output_text.done",
+		"response.content_part.done",
+		"response.output_item.done",
+		"response.output_item.added",
+		"response.function_call_arguments.delta",
+		"response.function_call_arguments.delta",
+		"response.function_call_arguments.done",
+		"response.output_item.done",
+		"response.completed",
+	}))
+	for i, payload := range payloads {
+		g.Expect(payload["type"]).To(Equal(names[i]))
+		g.Expect(payload["sequence_number"]).To(Equal(float64(i)))
+	}
+
+	g.Expect(payloads[4]["delta"]).To(Equal("Hel"))
+	g.Expect(payloads[6]["text"]).To(Equal("Hello"))
+	g.Expect(payloads[9]["output_index"]).To(Equal(float64(1)))
+	g.Expect(payloads[12]["arguments"]).To(Equal(`{"city":"Paris"}`))
+	g.Expect(payloads[14]["response"]).To(Equal(toJsonMap(g, map[string]any{
+		"id":         "chatcmpl-1",
+		"object":     "response",
+		"created_at": 1,
+		"status":     "completed",
+		"model":      "gpt-4.1",
+		"output": []any{
+			map[string]any{
+				"type":   "message",
+				"id":     "msg_chatcmpl-1_0",
+				"status": "completed",
+				"role":   "assistant",
+				"content": []any{
+					map[string]any{"type": "output_text", "text": "Hello", "annotations": []any{}},
+				},
+			},
+			map[string]any{
+				"type":      "function_call",
+				"id":        "fc_call_1",
+				"call_id":   "call_1",
+				"name":      "get_weather",
+				"arguments": `{"city":"Paris"}`,
+				"status":    "completed",
+			},
+		},
+		"error":              nil,
+		"incomplete_details": nil,
+	})))
+}
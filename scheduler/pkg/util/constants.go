@@ -20,11 +20,20 @@ const (
 	SeldonInternalModelHeader  = "seldon-internal-model"
 	SeldonLoggingHeader        = "Seldon-Logging"
 	SeldonRouteHeader          = "x-seldon-route"
+	SeldonTenantHeader         = "x-seldon-tenant"
+	SeldonCaptureHeader        = "seldon-capture"
+	SeldonShadowHeader         = "seldon-shadow"
+	SeldonBanditHeader         = "seldon-bandit"
 	ExternalHeaderPrefix       = "x-"
 	SeldonModelHeaderSuffix    = "model"
 	SeldonPipelineHeaderSuffix = "pipeline"
 )
 
+// Experiments
+const (
+	MaxExperimentMirrors = 4
+)
+
 // REST
 const (
 	DefaultReverseProxyHTTPPort = 9999
@@ -46,6 +55,12 @@ const (
 	GRPCControlPlaneTimeout      = 1 * time.Minute // For control plane operations except load/unload
 )
 
+// Topology labels reported by agents for their server replica
+const (
+	TopologyZoneLabel = "zone"
+	TopologyNodeLabel = "node"
+)
+
 // K8s API
 const (
 	K8sTimeoutDefault = 2 * time.Minute
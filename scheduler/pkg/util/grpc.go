@@ -10,8 +10,17 @@ the Change License after the Change Date as each is defined in accordance with t
 package util
 
 import (
+	"fmt"
+
 	"github.com/cenkalti/backoff/v4"
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
+
+	v2 "github.com/seldonio/seldon-core/apis/go/v2/mlops/v2_dataplane"
 )
 
 func GetClientKeepAliveParameters() keepalive.ClientParameters {
@@ -36,3 +45,41 @@ func GetClientExponentialBackoff() *backoff.ExponentialBackOff {
 	backOffExp.InitialInterval = backOffExpInitialInterval
 	return backOffExp
 }
+
+// GetInferenceGrpcClient creates an OIP client for the models behind envoy at host:port, retrying
+// failed connections to envoy
+func GetInferenceGrpcClient(host string, port int, tlsOptions *TLSOptions) (v2.GRPCInferenceServiceClient, error) {
+	retryOpts := []grpc_retry.CallOption{
+		grpc_retry.WithBackoff(grpc_retry.BackoffExponential(GRPCRetryBackoff)),
+		grpc_retry.WithMax(GRPCRetryMaxCount), // retry envoy connection
+	}
+
+	var creds credentials.TransportCredentials
+	if tlsOptions.TLS {
+		creds = tlsOptions.Cert.CreateClientTransportCredentials()
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithKeepaliveParams(GetClientKeepAliveParameters()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(GRPCMaxMsgSizeBytes),
+			grpc.MaxCallSendMsgSize(GRPCMaxMsgSizeBytes),
+		),
+		grpc.WithStatsHandler(
+			otelgrpc.NewClientHandler(),
+		),
+		grpc.WithUnaryInterceptor(
+			grpc_retry.UnaryClientInterceptor(retryOpts...),
+		),
+	}
+
+	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", host, port), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2.NewGRPCInferenceServiceClient(conn), nil
+}